@@ -0,0 +1,102 @@
+package grpcsvc
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
+)
+
+// CopyObject writes a new blob by streaming each source from the cradle that
+// holds it, which may be this one. The blob is only committed once every
+// source has yielded exactly its recorded size; otherwise it is discarded.
+func (s *Service) CopyObject(ctx context.Context, req *servicev1.CopyObjectRequest) (*servicev1.CopyObjectResponse, error) {
+	bucket := req.GetBucket()
+	objectID := req.GetObjectId()
+	size := req.GetSize()
+
+	if bucket == "" || objectID == "" {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "bucket and object_id are required"))
+	}
+	if len(req.GetSources()) == 0 {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "at least one source is required"))
+	}
+
+	loggrpc.SetAttrs(ctx,
+		slog.String("bucket", bucket),
+		slog.String("object_id", objectID),
+		slog.Int64("size", size),
+		slog.Int("sources", len(req.GetSources())),
+	)
+
+	writer, err := s.newWriter(s.objectsRoot, bucket, objectID)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	var committed bool
+	defer func() {
+		if !committed {
+			writer.Abort()
+		}
+	}()
+
+	digest := md5.New()
+	dst := io.MultiWriter(writer, digest)
+
+	var total int64
+	for _, src := range req.GetSources() {
+		n, err := s.copySource(ctx, dst, src)
+		if err != nil {
+			return nil, loggrpc.SetError(ctx, err)
+		}
+		total += n
+	}
+
+	if total != size {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.DataLoss, fmt.Sprintf("copied %d bytes, want %d", total, size)))
+	}
+
+	if err := writer.Commit(); err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+	committed = true
+
+	s.log.InfoContext(ctx, "copy complete", "bytes_written", total)
+
+	return &servicev1.CopyObjectResponse{
+		BytesWritten:  total,
+		CommittedAtMs: time.Now().UnixMilli(),
+		Md5:           digest.Sum(nil),
+	}, nil
+}
+
+// copySource streams one source blob into dst and returns its length. A
+// source that has gone missing is reported as NotFound.
+func (s *Service) copySource(ctx context.Context, dst io.Writer, src *servicev1.CopySource) (int64, error) {
+	body, err := s.openSource(ctx, src.GetCradleAddress(), src.GetBucket(), src.GetObjectId())
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return 0, status.Error(codes.NotFound, "source object not found")
+		}
+		return 0, status.Error(codes.Unavailable, err.Error())
+	}
+	defer body.Close()
+
+	n, err := io.Copy(dst, body)
+	if err != nil {
+		return n, status.Error(codes.Internal, err.Error())
+	}
+	if n != src.GetSize() {
+		return n, status.Error(codes.DataLoss, fmt.Sprintf("source %s has %d bytes, want %d", src.GetObjectId(), n, src.GetSize()))
+	}
+	return n, nil
+}
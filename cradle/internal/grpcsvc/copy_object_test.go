@@ -0,0 +1,172 @@
+package grpcsvc
+
+import (
+	"context"
+	"crypto/md5"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
+)
+
+func TestService_CopyObject(t *testing.T) {
+	t.Parallel()
+
+	// Blobs held by the fake peers, keyed by address/bucket/object_id.
+	peers := map[string]string{
+		"cradle-a:8082/photos/obj-1": "hello ",
+		"cradle-b:8082/docs/obj-2":   "world",
+	}
+
+	type tc struct {
+		name        string
+		req         *servicev1.CopyObjectRequest
+		openErr     error
+		wantCode    codes.Code
+		wantMessage string
+		wantContent string
+	}
+
+	cases := []tc{
+		{
+			name: "copies a single source",
+			req: &servicev1.CopyObjectRequest{
+				ObjectId: "obj-new", Bucket: "backup", Size: 6,
+				Sources: []*servicev1.CopySource{
+					{CradleAddress: "cradle-a:8082", ObjectId: "obj-1", Bucket: "photos", Size: 6},
+				},
+			},
+			wantContent: "hello ",
+		},
+		{
+			name: "concatenates sources from several cradles",
+			req: &servicev1.CopyObjectRequest{
+				ObjectId: "obj-new", Bucket: "backup", Size: 11,
+				Sources: []*servicev1.CopySource{
+					{CradleAddress: "cradle-a:8082", ObjectId: "obj-1", Bucket: "photos", Size: 6},
+					{CradleAddress: "cradle-b:8082", ObjectId: "obj-2", Bucket: "docs", Size: 5},
+				},
+			},
+			wantContent: "hello world",
+		},
+		{
+			name:        "missing object_id returns InvalidArgument",
+			req:         &servicev1.CopyObjectRequest{Bucket: "backup", Size: 6},
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "bucket and object_id are required",
+		},
+		{
+			name:        "no sources returns InvalidArgument",
+			req:         &servicev1.CopyObjectRequest{ObjectId: "obj-new", Bucket: "backup", Size: 6},
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "at least one source is required",
+		},
+		{
+			name: "missing source returns NotFound",
+			req: &servicev1.CopyObjectRequest{
+				ObjectId: "obj-new", Bucket: "backup", Size: 6,
+				Sources: []*servicev1.CopySource{
+					{CradleAddress: "cradle-a:8082", ObjectId: "obj-gone", Bucket: "photos", Size: 6},
+				},
+			},
+			wantCode:    codes.NotFound,
+			wantMessage: "source object not found",
+		},
+		{
+			name: "unreachable peer returns Unavailable",
+			req: &servicev1.CopyObjectRequest{
+				ObjectId: "obj-new", Bucket: "backup", Size: 6,
+				Sources: []*servicev1.CopySource{
+					{CradleAddress: "cradle-a:8082", ObjectId: "obj-1", Bucket: "photos", Size: 6},
+				},
+			},
+			openErr:     errors.New("connection refused"),
+			wantCode:    codes.Unavailable,
+			wantMessage: "connection refused",
+		},
+		{
+			name: "source shorter than recorded returns DataLoss",
+			req: &servicev1.CopyObjectRequest{
+				ObjectId: "obj-new", Bucket: "backup", Size: 10,
+				Sources: []*servicev1.CopySource{
+					{CradleAddress: "cradle-a:8082", ObjectId: "obj-1", Bucket: "photos", Size: 10},
+				},
+			},
+			wantCode:    codes.DataLoss,
+			wantMessage: "source obj-1 has 6 bytes, want 10",
+		},
+		{
+			name: "total different from size returns DataLoss",
+			req: &servicev1.CopyObjectRequest{
+				ObjectId: "obj-new", Bucket: "backup", Size: 7,
+				Sources: []*servicev1.CopySource{
+					{CradleAddress: "cradle-a:8082", ObjectId: "obj-1", Bucket: "photos", Size: 6},
+				},
+			},
+			wantCode:    codes.DataLoss,
+			wantMessage: "copied 6 bytes, want 7",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			objectsRoot := t.TempDir()
+
+			svc := New(newDiscardLogger())
+			svc.objectsRoot = objectsRoot
+			svc.openSource = func(ctx context.Context, address, bucket, objectID string) (io.ReadCloser, error) {
+				if c.openErr != nil {
+					return nil, c.openErr
+				}
+				content, ok := peers[address+"/"+bucket+"/"+objectID]
+				if !ok {
+					return nil, status.Error(codes.NotFound, "object not found")
+				}
+				return io.NopCloser(strings.NewReader(content)), nil
+			}
+
+			resp, err := svc.CopyObject(context.Background(), c.req)
+
+			bucketDir := filepath.Join(objectsRoot, c.req.GetBucket())
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				entries, _ := os.ReadDir(bucketDir)
+				if len(entries) != 0 {
+					t.Fatalf("bucket dir has %d entries after failed copy, want none", len(entries))
+				}
+				return
+			}
+
+			assertNoError(t, err)
+
+			if resp.GetBytesWritten() != int64(len(c.wantContent)) {
+				t.Fatalf("bytes written: got %d, want %d", resp.GetBytesWritten(), len(c.wantContent))
+			}
+			if resp.GetCommittedAtMs() == 0 {
+				t.Fatalf("committed_at_ms: got 0, want a timestamp")
+			}
+			wantMD5 := md5.Sum([]byte(c.wantContent))
+			if string(resp.GetMd5()) != string(wantMD5[:]) {
+				t.Fatalf("md5: got %x, want %x", resp.GetMd5(), wantMD5)
+			}
+
+			got, err := os.ReadFile(filepath.Join(bucketDir, c.req.GetObjectId()))
+			if err != nil {
+				t.Fatalf("read copied blob: %v", err)
+			}
+			if string(got) != c.wantContent {
+				t.Fatalf("content: got %q, want %q", got, c.wantContent)
+			}
+		})
+	}
+}
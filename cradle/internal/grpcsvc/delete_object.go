@@ -0,0 +1,34 @@
+package grpcsvc
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
+)
+
+// DeleteObject removes an object's data from disk. Deleting an object that is
+// already gone succeeds so Gantry can retry until it sees a confirmation.
+func (s *Service) DeleteObject(ctx context.Context, req *servicev1.DeleteObjectRequest) (*servicev1.DeleteObjectResponse, error) {
+	bucket := req.GetBucket()
+	objectID := req.GetObjectId()
+
+	if bucket == "" || objectID == "" {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "bucket and object_id are required"))
+	}
+
+	loggrpc.SetAttrs(ctx,
+		slog.String("bucket", bucket),
+		slog.String("object_id", objectID),
+	)
+
+	if err := s.removeObject(s.objectsRoot, bucket, objectID); err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	return &servicev1.DeleteObjectResponse{}, nil
+}
@@ -0,0 +1,105 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
+)
+
+func TestService_DeleteObject(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		bucket      string
+		objectID    string
+		content     string // if set, written to objectsRoot/bucket/objectID before the call
+		removeErr   error
+		wantErr     bool
+		wantCode    codes.Code
+		wantMessage string
+	}
+
+	cases := []tc{
+		{
+			name:     "removes object from disk",
+			bucket:   "photos",
+			objectID: "obj-123",
+			content:  "hello world",
+		},
+		{
+			name:     "missing object succeeds",
+			bucket:   "photos",
+			objectID: "obj-missing",
+		},
+		{
+			name:        "missing bucket returns InvalidArgument",
+			objectID:    "obj-123",
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "bucket and object_id are required",
+		},
+		{
+			name:        "remove error returns Internal",
+			bucket:      "photos",
+			objectID:    "obj-789",
+			removeErr:   errors.New("permission denied"),
+			wantErr:     true,
+			wantCode:    codes.Internal,
+			wantMessage: "permission denied",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			objectsRoot := t.TempDir()
+
+			if c.content != "" {
+				bucketDir := filepath.Join(objectsRoot, c.bucket)
+				if err := os.MkdirAll(bucketDir, 0755); err != nil {
+					t.Fatalf("setup failed: %v", err)
+				}
+				if err := os.WriteFile(filepath.Join(bucketDir, c.objectID), []byte(c.content), 0644); err != nil {
+					t.Fatalf("setup failed: %v", err)
+				}
+			}
+
+			svc := New(newDiscardLogger())
+			svc.objectsRoot = objectsRoot
+
+			if c.removeErr != nil {
+				svc.removeObject = func(objRoot, bucket, objectID string) error {
+					return c.removeErr
+				}
+			}
+
+			resp, err := svc.DeleteObject(context.Background(), &servicev1.DeleteObjectRequest{
+				Bucket:   c.bucket,
+				ObjectId: c.objectID,
+			})
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+
+			assertNoError(t, err)
+			if resp == nil {
+				t.Fatal("response: got nil")
+			}
+
+			path := filepath.Join(objectsRoot, c.bucket, c.objectID)
+			if _, err := os.Stat(path); !os.IsNotExist(err) {
+				t.Fatalf("expected %s to be removed, stat err = %v", path, err)
+			}
+		})
+	}
+}
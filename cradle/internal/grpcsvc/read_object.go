@@ -0,0 +1,82 @@
+package grpcsvc
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
+)
+
+const readChunkSize = 64 * 1024
+
+func (s *Service) ReadObject(req *servicev1.ReadObjectRequest, stream servicev1.CradleService_ReadObjectServer) error {
+	ctx := stream.Context()
+
+	bucket := req.GetBucket()
+	objectID := req.GetObjectId()
+	offset := req.GetOffset()
+	length := req.GetLength()
+
+	if bucket == "" || objectID == "" {
+		return loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "bucket and object_id are required"))
+	}
+	if offset < 0 || length < 0 {
+		return loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "offset and length must not be negative"))
+	}
+
+	loggrpc.SetAttrs(ctx,
+		slog.String("bucket", bucket),
+		slog.String("object_id", objectID),
+	)
+	if offset > 0 || length > 0 {
+		loggrpc.SetAttrs(ctx, slog.Int64("offset", offset), slog.Int64("length", length))
+	}
+
+	reader, err := s.newReader(s.objectsRoot, bucket, objectID)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return loggrpc.SetError(ctx, status.Error(codes.NotFound, "object not found"))
+		}
+		return loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+	defer reader.Close()
+
+	// Seeking past the end is not an error; the read below just hits EOF and
+	// the stream ends empty.
+	var src io.Reader = reader
+	if offset > 0 {
+		if _, err := reader.Seek(offset, io.SeekStart); err != nil {
+			return loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+		}
+	}
+	if length > 0 {
+		src = io.LimitReader(reader, length)
+	}
+
+	buf := make([]byte, readChunkSize)
+	var total int64
+
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			if sendErr := stream.Send(&servicev1.ReadObjectResponse{Chunk: buf[:n]}); sendErr != nil {
+				return loggrpc.SetError(ctx, sendErr)
+			}
+			total += int64(n)
+		}
+		if errors.Is(err, io.EOF) {
+			s.log.InfoContext(ctx, "read stream complete", "bytes_sent", total)
+			loggrpc.SetAttrs(ctx, slog.Int64("bytes_sent", total))
+			return nil
+		}
+		if err != nil {
+			return loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ratdaddy/blockcloset/cradle/internal/storage"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
+)
+
+func TestService_ReadObject(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name         string
+		bucket       string
+		objectID     string
+		offset       int64
+		length       int64
+		content      string // if set, written to objectsRoot/bucket/objectID before the call
+		newReaderErr error
+		wantErr      bool
+		wantCode     codes.Code
+		wantMessage  string
+		wantContent  string
+		wantChunks   int
+	}
+
+	cases := []tc{
+		{
+			name:        "streams object contents",
+			bucket:      "photos",
+			objectID:    "obj-123",
+			content:     "hello world",
+			wantContent: "hello world",
+			wantChunks:  1,
+		},
+		{
+			name:        "splits large objects into chunks",
+			bucket:      "photos",
+			objectID:    "obj-456",
+			content:     strings.Repeat("a", readChunkSize+10),
+			wantContent: strings.Repeat("a", readChunkSize+10),
+			wantChunks:  2,
+		},
+		{
+			name:        "reads the requested range",
+			bucket:      "photos",
+			objectID:    "obj-range",
+			offset:      6,
+			length:      3,
+			content:     "hello world",
+			wantContent: "wor",
+			wantChunks:  1,
+		},
+		{
+			name:        "zero length reads from offset to the end",
+			bucket:      "photos",
+			objectID:    "obj-tail",
+			offset:      6,
+			content:     "hello world",
+			wantContent: "world",
+			wantChunks:  1,
+		},
+		{
+			name:        "offset past the end streams nothing",
+			bucket:      "photos",
+			objectID:    "obj-short",
+			offset:      100,
+			content:     "hello world",
+			wantContent: "",
+			wantChunks:  0,
+		},
+		{
+			name:        "negative offset returns InvalidArgument",
+			bucket:      "photos",
+			objectID:    "obj-123",
+			offset:      -1,
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "offset and length must not be negative",
+		},
+		{
+			name:        "missing object returns NotFound",
+			bucket:      "photos",
+			objectID:    "obj-missing",
+			wantErr:     true,
+			wantCode:    codes.NotFound,
+			wantMessage: "object not found",
+		},
+		{
+			name:        "missing object_id returns InvalidArgument",
+			bucket:      "photos",
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "bucket and object_id are required",
+		},
+		{
+			name:         "NewReader error returns Internal",
+			bucket:       "photos",
+			objectID:     "obj-789",
+			newReaderErr: errors.New("permission denied"),
+			wantErr:      true,
+			wantCode:     codes.Internal,
+			wantMessage:  "permission denied",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			objectsRoot := t.TempDir()
+
+			if c.content != "" {
+				bucketDir := filepath.Join(objectsRoot, c.bucket)
+				if err := os.MkdirAll(bucketDir, 0755); err != nil {
+					t.Fatalf("setup failed: %v", err)
+				}
+				if err := os.WriteFile(filepath.Join(bucketDir, c.objectID), []byte(c.content), 0644); err != nil {
+					t.Fatalf("setup failed: %v", err)
+				}
+			}
+
+			svc := New(newDiscardLogger())
+			svc.objectsRoot = objectsRoot
+
+			if c.newReaderErr != nil {
+				svc.newReader = func(objRoot, bucket, objectID string) (*storage.Reader, error) {
+					return nil, c.newReaderErr
+				}
+			}
+
+			stream := &readObjectStreamFake{ctx: context.Background()}
+
+			err := svc.ReadObject(&servicev1.ReadObjectRequest{
+				Bucket:   c.bucket,
+				ObjectId: c.objectID,
+				Offset:   c.offset,
+				Length:   c.length,
+			}, stream)
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				if len(stream.chunks) != 0 {
+					t.Fatalf("sent %d chunks, want none", len(stream.chunks))
+				}
+				return
+			}
+
+			assertNoError(t, err)
+
+			if len(stream.chunks) != c.wantChunks {
+				t.Fatalf("chunks sent: got %d, want %d", len(stream.chunks), c.wantChunks)
+			}
+
+			if got := strings.Join(stream.chunks, ""); got != c.wantContent {
+				t.Fatalf("content: got %d bytes, want %d bytes", len(got), len(c.wantContent))
+			}
+		})
+	}
+}
+
+type readObjectStreamFake struct {
+	ctx    context.Context
+	chunks []string
+}
+
+func (f *readObjectStreamFake) Send(resp *servicev1.ReadObjectResponse) error {
+	// Copy the chunk because the service reuses its read buffer.
+	f.chunks = append(f.chunks, string(resp.GetChunk()))
+	return nil
+}
+
+func (f *readObjectStreamFake) SetHeader(metadata.MD) error  { return nil }
+func (f *readObjectStreamFake) SendHeader(metadata.MD) error { return nil }
+func (f *readObjectStreamFake) SetTrailer(metadata.MD)       {}
+
+func (f *readObjectStreamFake) Context() context.Context {
+	if f.ctx == nil {
+		return context.Background()
+	}
+	return f.ctx
+}
+
+func (f *readObjectStreamFake) SendMsg(any) error { return nil }
+func (f *readObjectStreamFake) RecvMsg(any) error { return nil }
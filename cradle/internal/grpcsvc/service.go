@@ -1,30 +1,39 @@
 package grpcsvc
 
 import (
+	"context"
+	"io"
 	"log/slog"
 	"syscall"
 
 	"google.golang.org/grpc"
 
 	"github.com/ratdaddy/blockcloset/cradle/internal/config"
+	"github.com/ratdaddy/blockcloset/cradle/internal/peer"
 	"github.com/ratdaddy/blockcloset/cradle/internal/storage"
 	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
 )
 
 type Service struct {
 	servicev1.UnimplementedCradleServiceServer
-	log         *slog.Logger
-	objectsRoot string
-	newWriter   func(objectsRoot, bucket, objectID string) (*storage.Writer, error)
+	log            *slog.Logger
+	objectsRoot    string
+	newWriter      func(objectsRoot, bucket, objectID string) (*storage.Writer, error)
+	newReader      func(objectsRoot, bucket, objectID string) (*storage.Reader, error)
+	removeObject   func(objectsRoot, bucket, objectID string) error
 	availableBytes func(path string) (uint64, error)
+	openSource     func(ctx context.Context, address, bucket, objectID string) (io.ReadCloser, error)
 }
 
 func New(log *slog.Logger) *Service {
 	return &Service{
-		log:         log,
-		objectsRoot: config.ObjectsRoot,
-		newWriter:   storage.NewWriter,
+		log:            log,
+		objectsRoot:    config.ObjectsRoot,
+		newWriter:      storage.NewWriter,
+		newReader:      storage.NewReader,
+		removeObject:   storage.Remove,
 		availableBytes: availableBytes,
+		openSource:     peer.New().Open,
 	}
 }
 
@@ -0,0 +1,129 @@
+// Package peer reads blobs from other cradle servers, which lets a copy pull
+// its source bytes directly instead of through flatbed.
+package peer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
+)
+
+// Client keeps one connection per peer address.
+type Client struct {
+	dialOpts []grpc.DialOption
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func New(opts ...grpc.DialOption) *Client {
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+	dialOpts = append(dialOpts, opts...)
+
+	return &Client{dialOpts: dialOpts, conns: make(map[string]*grpc.ClientConn)}
+}
+
+func (c *Client) conn(address string) (*grpc.ClientConn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cc, ok := c.conns[address]; ok {
+		return cc, nil
+	}
+	cc, err := grpc.NewClient(address, c.dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	c.conns[address] = cc
+	return cc, nil
+}
+
+// Open streams a whole blob from the cradle at address. The first chunk is
+// received before returning so a missing blob is reported here, with the
+// peer's NotFound status, rather than on the first Read.
+func (c *Client) Open(ctx context.Context, address, bucket, objectID string) (io.ReadCloser, error) {
+	cc, err := c.conn(address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := servicev1.NewCradleServiceClient(cc).ReadObject(ctx, &servicev1.ReadObjectRequest{
+		ObjectId: objectID,
+		Bucket:   bucket,
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	r := &blobReader{stream: stream, cancel: cancel}
+
+	resp, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		r.done = true
+		return r, nil
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	r.buf = resp.GetChunk()
+
+	return r, nil
+}
+
+// Close closes every peer connection.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var errs []error
+	for address, cc := range c.conns {
+		errs = append(errs, cc.Close())
+		delete(c.conns, address)
+	}
+	return errors.Join(errs...)
+}
+
+type blobReader struct {
+	stream servicev1.CradleService_ReadObjectClient
+	cancel context.CancelFunc
+	buf    []byte
+	done   bool
+}
+
+func (r *blobReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		resp, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			r.done = true
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, err
+		}
+		r.buf = resp.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *blobReader) Close() error {
+	r.cancel()
+	return nil
+}
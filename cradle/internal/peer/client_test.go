@@ -0,0 +1,105 @@
+package peer
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
+)
+
+type fakePeer struct {
+	servicev1.UnimplementedCradleServiceServer
+	blobs map[string][]string
+}
+
+func (p *fakePeer) ReadObject(req *servicev1.ReadObjectRequest, stream servicev1.CradleService_ReadObjectServer) error {
+	chunks, ok := p.blobs[req.GetBucket()+"/"+req.GetObjectId()]
+	if !ok {
+		return status.Error(codes.NotFound, "object not found")
+	}
+	for _, chunk := range chunks {
+		if err := stream.Send(&servicev1.ReadObjectResponse{Chunk: []byte(chunk)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestClient(t *testing.T, peer *fakePeer) *Client {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	servicev1.RegisterCradleServiceServer(srv, peer)
+
+	go func() {
+		if err := srv.Serve(lis); err != nil && err != grpc.ErrServerStopped {
+			panic(err)
+		}
+	}()
+
+	client := New(grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}))
+
+	t.Cleanup(func() {
+		_ = client.Close()
+		srv.Stop()
+		_ = lis.Close()
+	})
+
+	return client
+}
+
+func TestClient_Open(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, &fakePeer{blobs: map[string][]string{
+		"photos/obj-123": {"hello ", "world"},
+		"photos/empty":   nil,
+	}})
+
+	cases := []struct {
+		name     string
+		objectID string
+		want     string
+		wantCode codes.Code
+	}{
+		{name: "streams every chunk", objectID: "obj-123", want: "hello world"},
+		{name: "empty blob reads as EOF", objectID: "empty", want: ""},
+		{name: "missing blob returns NotFound", objectID: "obj-missing", wantCode: codes.NotFound},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := client.Open(context.Background(), "passthrough:///bufnet", "photos", c.objectID)
+			if c.wantCode != codes.OK {
+				if status.Code(err) != c.wantCode {
+					t.Fatalf("Open error: got %v, want code %v", err, c.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Open: %v", err)
+			}
+			defer body.Close()
+
+			got, err := io.ReadAll(body)
+			if err != nil {
+				t.Fatalf("ReadAll: %v", err)
+			}
+			if string(got) != c.want {
+				t.Fatalf("content: got %q, want %q", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Reader handles reading committed object data from disk.
+type Reader struct {
+	File *os.File
+	Path string
+}
+
+// NewReader opens the committed object for the given bucket and object ID.
+func NewReader(objectsRoot, bucket, objectID string) (*Reader, error) {
+	path := filepath.Join(objectsRoot, bucket, objectID)
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{
+		File: f,
+		Path: path,
+	}, nil
+}
+
+// Read reads data from the object file.
+func (r *Reader) Read(p []byte) (int, error) {
+	return r.File.Read(p)
+}
+
+// Seek sets the offset for the next Read, as io.Seeker.
+func (r *Reader) Seek(offset int64, whence int) (int64, error) {
+	return r.File.Seek(offset, whence)
+}
+
+// Close closes the object file.
+func (r *Reader) Close() error {
+	return r.File.Close()
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewReader(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name     string
+		bucket   string
+		objectID string
+		setup    func(t *testing.T, objectsRoot string)
+		want     string
+		wantErr  bool
+	}
+
+	cases := []tc{
+		{
+			name:     "reads committed object",
+			bucket:   "photos",
+			objectID: "obj-123",
+			setup: func(t *testing.T, objectsRoot string) {
+				bucketDir := filepath.Join(objectsRoot, "photos")
+				if err := os.MkdirAll(bucketDir, 0755); err != nil {
+					t.Fatalf("setup failed: %v", err)
+				}
+				if err := os.WriteFile(filepath.Join(bucketDir, "obj-123"), []byte("hello world"), 0644); err != nil {
+					t.Fatalf("setup failed: %v", err)
+				}
+			},
+			want: "hello world",
+		},
+		{
+			name:     "missing object returns not exist",
+			bucket:   "photos",
+			objectID: "obj-missing",
+			wantErr:  true,
+		},
+		{
+			name:     "ignores uncommitted temp file",
+			bucket:   "docs",
+			objectID: "obj-456",
+			setup: func(t *testing.T, objectsRoot string) {
+				bucketDir := filepath.Join(objectsRoot, "docs")
+				if err := os.MkdirAll(bucketDir, 0755); err != nil {
+					t.Fatalf("setup failed: %v", err)
+				}
+				if err := os.WriteFile(filepath.Join(bucketDir, ".obj-456.part"), []byte("partial"), 0644); err != nil {
+					t.Fatalf("setup failed: %v", err)
+				}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			objectsRoot := t.TempDir()
+
+			if c.setup != nil {
+				c.setup(t, objectsRoot)
+			}
+
+			r, err := NewReader(objectsRoot, c.bucket, c.objectID)
+
+			if c.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if !os.IsNotExist(err) {
+					t.Fatalf("expected not-exist error, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer r.Close()
+
+			wantPath := filepath.Join(objectsRoot, c.bucket, c.objectID)
+			if r.Path != wantPath {
+				t.Fatalf("Path: got %q, want %q", r.Path, wantPath)
+			}
+
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("read failed: %v", err)
+			}
+			if string(got) != c.want {
+				t.Fatalf("content: got %q, want %q", string(got), c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Remove deletes the committed object for the given bucket and object ID.
+// A missing object is not an error so callers can retry deletes safely.
+func Remove(objectsRoot, bucket, objectID string) error {
+	path := filepath.Join(objectsRoot, bucket, objectID)
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
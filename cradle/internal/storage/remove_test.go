@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRemove(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name     string
+		bucket   string
+		objectID string
+		setup    func(t *testing.T, objectsRoot string)
+	}
+
+	cases := []tc{
+		{
+			name:     "removes committed object",
+			bucket:   "photos",
+			objectID: "obj-123",
+			setup: func(t *testing.T, objectsRoot string) {
+				bucketDir := filepath.Join(objectsRoot, "photos")
+				if err := os.MkdirAll(bucketDir, 0755); err != nil {
+					t.Fatalf("setup failed: %v", err)
+				}
+				if err := os.WriteFile(filepath.Join(bucketDir, "obj-123"), []byte("hello world"), 0644); err != nil {
+					t.Fatalf("setup failed: %v", err)
+				}
+			},
+		},
+		{
+			name:     "missing object succeeds",
+			bucket:   "photos",
+			objectID: "obj-missing",
+		},
+		{
+			name:     "missing bucket directory succeeds",
+			bucket:   "never-written",
+			objectID: "obj-456",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			objectsRoot := t.TempDir()
+
+			if c.setup != nil {
+				c.setup(t, objectsRoot)
+			}
+
+			if err := Remove(objectsRoot, c.bucket, c.objectID); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			path := filepath.Join(objectsRoot, c.bucket, c.objectID)
+			if _, err := os.Stat(path); !os.IsNotExist(err) {
+				t.Fatalf("expected %s to be removed, stat err = %v", path, err)
+			}
+		})
+	}
+}
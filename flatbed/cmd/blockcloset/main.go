@@ -0,0 +1,143 @@
+// Command blockcloset holds client-side tools for a Block Closet cluster.
+//
+//	blockcloset presign [flags] bucket/key
+//
+// presign prints a URL that lets anyone GET, HEAD or PUT one object until it
+// expires, signed with an access key stored in gantry.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/config"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/sigv4"
+)
+
+// accessKeyLookup is the part of the gantry client presign needs.
+type accessKeyLookup interface {
+	GetAccessKey(ctx context.Context, accessKeyID string) (gantry.AccessKey, error)
+}
+
+var (
+	gantryClient = func(addr string) (accessKeyLookup, func() error, error) {
+		c, err := gantry.New(context.Background(), addr)
+		if err != nil {
+			return nil, nil, err
+		}
+		return c, c.Close, nil
+	}
+	now = time.Now
+)
+
+func main() {
+	config.Init()
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, "usage: blockcloset presign [flags] bucket/key")
+		return 2
+	}
+
+	switch args[0] {
+	case "presign":
+		return presign(args[1:], stdout, stderr)
+	default:
+		fmt.Fprintf(stderr, "blockcloset: unknown command %q\n", args[0])
+		return 2
+	}
+}
+
+func presign(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("presign", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: blockcloset presign [flags] bucket/key")
+		fs.PrintDefaults()
+	}
+
+	accessKeyID := fs.String("access-key-id", os.Getenv("BLOCKCLOSET_ACCESS_KEY_ID"), "access key to sign with (default $BLOCKCLOSET_ACCESS_KEY_ID)")
+	endpoint := fs.String("endpoint", fmt.Sprintf("http://localhost:%d", config.FlatbedPort), "flatbed URL the link points at")
+	gantryAddr := fs.String("gantry", config.GantryAddr, "gantry address to look the access key up in")
+	method := fs.String("method", http.MethodGet, "HTTP method the URL allows: GET, HEAD or PUT")
+	expires := fs.Duration("expires", time.Hour, "how long the URL stays valid, at most 168h")
+	region := fs.String("region", "us-east-1", "region in the credential scope")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	target, err := presignTarget(fs.Args(), *endpoint, strings.ToUpper(*method), *accessKeyID, *expires)
+	if err != nil {
+		fmt.Fprintf(stderr, "blockcloset presign: %v\n", err)
+		return 2
+	}
+
+	keys, closeKeys, err := gantryClient(*gantryAddr)
+	if err != nil {
+		fmt.Fprintf(stderr, "blockcloset presign: gantry dial: %v\n", err)
+		return 1
+	}
+	defer closeKeys()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	key, err := keys.GetAccessKey(ctx, *accessKeyID)
+	if err != nil {
+		fmt.Fprintf(stderr, "blockcloset presign: look up access key %s: %v\n", *accessKeyID, err)
+		return 1
+	}
+
+	sigv4.Presign(target, key.SecretAccessKey, sigv4.Credential{
+		AccessKeyID: *accessKeyID,
+		Region:      *region,
+		Service:     "s3",
+	}, now(), *expires)
+
+	fmt.Fprintln(stdout, target.URL.String())
+	return 0
+}
+
+// presignTarget validates the presign arguments and builds the request the
+// URL will be signed for.
+func presignTarget(args []string, endpoint, method, accessKeyID string, expires time.Duration) (*http.Request, error) {
+	if len(args) != 1 {
+		return nil, errors.New("expected a single bucket/key argument")
+	}
+	bucket, key, ok := strings.Cut(strings.TrimPrefix(args[0], "/"), "/")
+	if !ok || bucket == "" || key == "" {
+		return nil, fmt.Errorf("%q is not bucket/key", args[0])
+	}
+	if accessKeyID == "" {
+		return nil, errors.New("-access-key-id is required")
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut:
+	default:
+		return nil, fmt.Errorf("method %s can't be presigned", method)
+	}
+	if expires < time.Second || expires > sigv4.MaxExpires {
+		return nil, fmt.Errorf("expires %s is outside 1s to %s", expires, sigv4.MaxExpires)
+	}
+
+	base, err := url.Parse(endpoint)
+	if err != nil || base.Host == "" {
+		return nil, fmt.Errorf("bad endpoint %q", endpoint)
+	}
+	base.Path = strings.TrimSuffix(base.Path, "/") + "/" + bucket + "/" + key
+	base.RawPath = ""
+
+	return http.NewRequest(method, base.String(), nil)
+}
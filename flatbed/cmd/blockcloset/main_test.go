@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/middleware"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+)
+
+func TestRun_Presign(t *testing.T) {
+	origGantry, origNow := gantryClient, now
+	defer func() { gantryClient, now = origGantry, origNow }()
+
+	fg := testutil.NewGantryStub()
+	fg.GetAccessKeyFn = func(ctx context.Context, accessKeyID string) (gantry.AccessKey, error) {
+		if accessKeyID != "AKIDTEST" {
+			return gantry.AccessKey{}, status.Error(codes.NotFound, "access key not found")
+		}
+		return gantry.AccessKey{AccessKeyID: accessKeyID, SecretAccessKey: testutil.StubSecretAccessKey}, nil
+	}
+	var gotAddr string
+	gantryClient = func(addr string) (accessKeyLookup, func() error, error) {
+		gotAddr = addr
+		return fg, func() error { return nil }, nil
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantMethod string
+		wantPath   string
+		wantStderr string
+	}{
+		{
+			name:       "GET link",
+			args:       []string{"presign", "-access-key-id", "AKIDTEST", "-gantry", "gantry:8081", "photos/2024/beach day.jpg"},
+			wantMethod: http.MethodGet,
+			wantPath:   "/photos/2024/beach day.jpg",
+		},
+		{
+			name:       "PUT link on another endpoint",
+			args:       []string{"presign", "-access-key-id", "AKIDTEST", "-gantry", "gantry:8081", "-method", "put", "-endpoint", "https://closet.example.com", "uploads/new.bin"},
+			wantMethod: http.MethodPut,
+			wantPath:   "/uploads/new.bin",
+		},
+		{
+			name:       "no command",
+			args:       nil,
+			wantCode:   2,
+			wantStderr: "usage: blockcloset presign",
+		},
+		{
+			name:       "unknown command",
+			args:       []string{"sign"},
+			wantCode:   2,
+			wantStderr: `unknown command "sign"`,
+		},
+		{
+			name:       "missing key",
+			args:       []string{"presign", "-access-key-id", "AKIDTEST", "photos"},
+			wantCode:   2,
+			wantStderr: `"photos" is not bucket/key`,
+		},
+		{
+			name:       "missing access key id",
+			args:       []string{"presign", "photos/a.jpg"},
+			wantCode:   2,
+			wantStderr: "-access-key-id is required",
+		},
+		{
+			name:       "method that can't be presigned",
+			args:       []string{"presign", "-access-key-id", "AKIDTEST", "-method", "DELETE", "photos/a.jpg"},
+			wantCode:   2,
+			wantStderr: "method DELETE can't be presigned",
+		},
+		{
+			name:       "expires too long",
+			args:       []string{"presign", "-access-key-id", "AKIDTEST", "-expires", "200h", "photos/a.jpg"},
+			wantCode:   2,
+			wantStderr: "outside 1s to 168h0m0s",
+		},
+		{
+			name:       "unknown access key",
+			args:       []string{"presign", "-access-key-id", "AKIDNOPE", "photos/a.jpg"},
+			wantCode:   1,
+			wantStderr: "look up access key AKIDNOPE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signedAt := time.Now().Add(-time.Minute)
+			now = func() time.Time { return signedAt }
+
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, &stdout, &stderr)
+
+			if code != tt.wantCode {
+				t.Fatalf("exit code = %d, want %d; stderr %s", code, tt.wantCode, stderr.String())
+			}
+			if tt.wantCode != 0 {
+				if !strings.Contains(stderr.String(), tt.wantStderr) {
+					t.Fatalf("stderr = %q, want it to contain %q", stderr.String(), tt.wantStderr)
+				}
+				return
+			}
+			if gotAddr != "gantry:8081" {
+				t.Fatalf("gantry addr = %q, want %q", gotAddr, "gantry:8081")
+			}
+
+			// The printed URL must pass flatbed's own authentication.
+			req := httptest.NewRequest(tt.wantMethod, strings.TrimSpace(stdout.String()), nil)
+			var gotPath string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+			})
+			rr := httptest.NewRecorder()
+			middleware.SigV4Auth(fg)(next).ServeHTTP(rr, req)
+
+			if rr.Code != http.StatusOK {
+				t.Fatalf("presigned %s %s: status %d, body %s", tt.wantMethod, stdout.String(), rr.Code, rr.Body.String())
+			}
+			if gotPath != tt.wantPath {
+				t.Fatalf("path = %q, want %q", gotPath, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestPresignTarget_BadEndpoint(t *testing.T) {
+	t.Parallel()
+
+	_, err := presignTarget([]string{"photos/a.jpg"}, "not a url", http.MethodGet, "AKIDTEST", time.Hour)
+	if err == nil || !strings.Contains(err.Error(), "bad endpoint") {
+		t.Fatalf("presignTarget error = %v, want bad endpoint", err)
+	}
+}
@@ -8,16 +8,18 @@ import (
 	"os"
 
 	"github.com/ratdaddy/blockcloset/flatbed/internal/config"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/corscache"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/cradle"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/keycache"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
 )
 
 var (
 	buildHandler = func(g handlers.GantryClient, c handlers.CradleClient) http.Handler {
-		return httpapi.NewRouter(handlers.NewHandlers(g, c))
+		return httpapi.NewRouter(handlers.NewHandlers(g, c), keycache.New(g, config.AccessKeyCacheTTL), corscache.New(g, config.CORSCacheTTL))
 	}
 	gantryClient = func(addr string) (handlers.GantryClient, error) {
 		return gantry.New(context.Background(), addr)
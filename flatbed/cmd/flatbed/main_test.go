@@ -1,8 +1,10 @@
 package main
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
@@ -38,6 +40,7 @@ func TestMain_WiresAddressAndHandler(t *testing.T) {
 		method           string
 		target           string
 		headers          map[string]string
+		body             string
 		wantStatus       int
 		callName         string
 		callCount        func(*testutil.GantryStub) int
@@ -71,13 +74,131 @@ func TestMain_WiresAddressAndHandler(t *testing.T) {
 			callCount:       (*testutil.GantryStub).PlanWriteCount,
 			cradleCallCount: (*testutil.CradleStub).WriteObjectCount,
 		},
+		{
+			name:   "E2E - PutObject aws-chunked",
+			method: http.MethodPut,
+			target: "/demo-bucket/demo-key",
+			headers: map[string]string{
+				"Content-Encoding":             "aws-chunked",
+				"X-Amz-Decoded-Content-Length": "5",
+			},
+			body:            "5\r\nhello\r\n0\r\n\r\n",
+			wantStatus:      http.StatusOK,
+			callName:        "gantry resolve write",
+			callCount:       (*testutil.GantryStub).PlanWriteCount,
+			cradleCallCount: (*testutil.CradleStub).WriteObjectCount,
+		},
+		{
+			name:            "E2E - GetObject",
+			method:          http.MethodGet,
+			target:          "/demo-bucket/demo-key",
+			wantStatus:      http.StatusOK,
+			callName:        "gantry lookup object",
+			callCount:       (*testutil.GantryStub).LookupObjectCount,
+			cradleCallCount: (*testutil.CradleStub).ReadObjectCount,
+		},
+		{
+			name:       "E2E - HeadBucket",
+			method:     http.MethodHead,
+			target:     "/demo-bucket",
+			wantStatus: http.StatusOK,
+			callName:   "gantry get bucket",
+			callCount:  (*testutil.GantryStub).GetBucketCount,
+		},
+		{
+			name:       "E2E - HeadObject",
+			method:     http.MethodHead,
+			target:     "/demo-bucket/demo-key",
+			wantStatus: http.StatusOK,
+			callName:   "gantry lookup object",
+			callCount:  (*testutil.GantryStub).LookupObjectCount,
+		},
+		{
+			name:       "E2E - DeleteObject",
+			method:     http.MethodDelete,
+			target:     "/demo-bucket/demo-key",
+			wantStatus: http.StatusNoContent,
+			callName:   "gantry delete object",
+			callCount:  (*testutil.GantryStub).DeleteObjectCount,
+		},
+		{
+			name:       "E2E - DeleteBucket",
+			method:     http.MethodDelete,
+			target:     "/demo-bucket",
+			wantStatus: http.StatusNoContent,
+			callName:   "gantry delete bucket",
+			callCount:  (*testutil.GantryStub).DeleteBucketCount,
+		},
+		{
+			name:       "E2E - ListObjectsV2",
+			method:     http.MethodGet,
+			target:     "/demo-bucket?list-type=2&prefix=demo",
+			wantStatus: http.StatusOK,
+			callName:   "gantry list objects",
+			callCount:  (*testutil.GantryStub).ListObjectsCount,
+		},
+		{
+			name:       "E2E - CreateMultipartUpload",
+			method:     http.MethodPost,
+			target:     "/demo-bucket/demo-key?uploads",
+			wantStatus: http.StatusOK,
+			callName:   "gantry create multipart upload",
+			callCount:  (*testutil.GantryStub).CreateMultipartUploadCount,
+		},
+		{
+			name:            "E2E - UploadPart",
+			method:          http.MethodPut,
+			target:          "/demo-bucket/demo-key?partNumber=1&uploadId=demo-upload",
+			headers:         map[string]string{"Content-Length": "1024"},
+			wantStatus:      http.StatusOK,
+			callName:        "gantry plan part",
+			callCount:       (*testutil.GantryStub).PlanPartCount,
+			cradleCallCount: (*testutil.CradleStub).WriteObjectCount,
+		},
+		{
+			name:       "E2E - CompleteMultipartUpload",
+			method:     http.MethodPost,
+			target:     "/demo-bucket/demo-key?uploadId=demo-upload",
+			body:       "<CompleteMultipartUpload><Part><PartNumber>1</PartNumber><ETag>\"stub-blob-id\"</ETag></Part></CompleteMultipartUpload>",
+			wantStatus: http.StatusOK,
+			callName:   "gantry complete multipart upload",
+			callCount:  (*testutil.GantryStub).CompleteMultipartUploadCount,
+		},
+		{
+			name:       "E2E - AbortMultipartUpload",
+			method:     http.MethodDelete,
+			target:     "/demo-bucket/demo-key?uploadId=demo-upload",
+			wantStatus: http.StatusNoContent,
+			callName:   "gantry abort multipart upload",
+			callCount:  (*testutil.GantryStub).AbortMultipartUploadCount,
+		},
+		{
+			name:       "E2E - ListMultipartUploads",
+			method:     http.MethodGet,
+			target:     "/demo-bucket?uploads",
+			wantStatus: http.StatusOK,
+			callName:   "gantry list multipart uploads",
+			callCount:  (*testutil.GantryStub).ListMultipartUploadsCount,
+		},
+		{
+			name:       "E2E - ListParts",
+			method:     http.MethodGet,
+			target:     "/demo-bucket/demo-key?uploadId=demo-upload",
+			wantStatus: http.StatusOK,
+			callName:   "gantry list parts",
+			callCount:  (*testutil.GantryStub).ListPartsCount,
+		},
 	}
 
 	listenAndServe = func(addr string, h http.Handler) error {
 		gotAddr = addr
 
 		for _, tt := range tests {
-			req := httptest.NewRequest(tt.method, tt.target, nil)
+			var body io.Reader
+			if tt.body != "" {
+				body = strings.NewReader(tt.body)
+			}
+			req := httptest.NewRequest(tt.method, tt.target, body)
 			for k, v := range tt.headers {
 				req.Header.Set(k, v)
 			}
@@ -94,7 +215,7 @@ func TestMain_WiresAddressAndHandler(t *testing.T) {
 			}
 			if tt.cradleCallCount != nil {
 				if got := tt.cradleCallCount(fc); got != 1 {
-					t.Fatalf("%s: cradle write object count got %d, want 1", tt.name, got)
+					t.Fatalf("%s: cradle call count got %d, want 1", tt.name, got)
 				}
 			}
 
@@ -102,10 +223,23 @@ func TestMain_WiresAddressAndHandler(t *testing.T) {
 			fg.CreateCalls = nil
 			fg.ListCalls = 0
 			fg.PlanWriteCalls = nil
+			fg.LookupObjectCalls = nil
+			fg.GetBucketCalls = nil
+			fg.DeleteObjectCalls = nil
+			fg.DeleteBucketCalls = nil
+			fg.ListObjectsCalls = nil
+			fg.CreateMultipartUploadCalls = nil
+			fg.PlanPartCalls = nil
+			fg.CommitPartCalls = nil
+			fg.CompleteMultipartCalls = nil
+			fg.AbortMultipartUploadCalls = nil
+			fg.ListMultipartUploadsCalls = nil
+			fg.ListPartsCalls = nil
 			fg.CreateFn = nil
 			fg.ListFn = nil
 			fc.WriteObjectCalls = nil
 			fc.WriteObjectFn = nil
+			fc.ReadObjectCalls = nil
 		}
 		served = true
 		return nil
@@ -0,0 +1,278 @@
+// Package awschunked decodes the aws-chunked content encoding that AWS SDKs
+// use for streaming uploads. Each chunk is framed as
+//
+//	hex-size[;chunk-signature=sig]\r\n<data>\r\n
+//
+// and the body ends with a zero-size chunk followed by optional trailers
+// (such as x-amz-checksum-crc32) and a blank line.
+package awschunked
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/checksum"
+)
+
+// Values of x-amz-content-sha256 that announce an aws-chunked body.
+const (
+	StreamingSigned          = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	StreamingSignedTrailer   = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD-TRAILER"
+	StreamingUnsignedTrailer = "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
+)
+
+const (
+	headerContentSHA256 = "x-amz-content-sha256"
+	trailerSignature    = "x-amz-trailer-signature"
+)
+
+var (
+	ErrMalformed          = errors.New("awschunked: malformed chunk encoding")
+	ErrIncompleteBody     = errors.New("awschunked: decoded length mismatch")
+	ErrSignatureMismatch  = errors.New("awschunked: chunk signature mismatch")
+	ErrChecksumMismatch   = errors.New("awschunked: trailing checksum mismatch")
+	ErrUnsupportedTrailer = errors.New("awschunked: unsupported trailer")
+)
+
+// IsChunked reports whether r carries an aws-chunked body, either through
+// Content-Encoding or a STREAMING- payload hash.
+func IsChunked(r *http.Request) bool {
+	if strings.HasPrefix(r.Header.Get(headerContentSHA256), "STREAMING-") {
+		return true
+	}
+	for _, v := range r.Header.Values("Content-Encoding") {
+		for _, enc := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(enc), "aws-chunked") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Options configures a Reader.
+type Options struct {
+	// DecodedLength is the payload size from x-amz-decoded-content-length.
+	DecodedLength int64
+	// Trailer is the checksum trailer announced in x-amz-trailer, if any.
+	Trailer string
+	// Verifier checks chunk and trailer signatures. When nil, signatures
+	// are parsed but not checked.
+	Verifier Verifier
+}
+
+// Reader yields the decoded payload of an aws-chunked body. It returns
+// io.EOF only after the final chunk, trailers and signatures have checked
+// out, so a caller that reads to EOF has a verified payload.
+type Reader struct {
+	br       *bufio.Reader
+	opts     Options
+	checksum hash.Hash
+
+	remaining int64
+	total     int64
+	signature string
+	chunkHash hash.Hash
+	err       error
+}
+
+// NewReader decodes body as configured by opts. It fails with
+// ErrUnsupportedTrailer if opts.Trailer names a checksum we cannot verify.
+func NewReader(body io.Reader, opts Options) (*Reader, error) {
+	r := &Reader{
+		br:        bufio.NewReader(body),
+		opts:      opts,
+		chunkHash: sha256.New(),
+	}
+	if name := strings.ToLower(strings.TrimSpace(opts.Trailer)); name != "" {
+		algorithm, ok := checksum.FromHeader(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnsupportedTrailer, opts.Trailer)
+		}
+		r.opts.Trailer = name
+		r.checksum, _ = checksum.New(algorithm)
+	}
+	return r, nil
+}
+
+func (r *Reader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	for r.remaining == 0 {
+		if err := r.nextChunk(); err != nil {
+			r.err = err
+			return 0, err
+		}
+	}
+
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+	n, err := r.br.Read(p)
+	r.remaining -= int64(n)
+	r.total += int64(n)
+	r.chunkHash.Write(p[:n])
+	if r.checksum != nil {
+		r.checksum.Write(p[:n])
+	}
+
+	if err == io.EOF {
+		err = fmt.Errorf("%w: body ended mid-chunk", ErrMalformed)
+	}
+	if err == nil && r.remaining == 0 {
+		err = r.endChunk()
+	}
+	if err != nil {
+		r.err = err
+	}
+	return n, err
+}
+
+// nextChunk reads the next chunk header. On the final chunk it reads and
+// checks the trailers and returns io.EOF.
+func (r *Reader) nextChunk() error {
+	line, err := r.readLine()
+	if err != nil {
+		return err
+	}
+
+	sizeField, ext, _ := strings.Cut(line, ";")
+	size, err := strconv.ParseInt(strings.TrimSpace(sizeField), 16, 64)
+	if err != nil || size < 0 {
+		return fmt.Errorf("%w: bad chunk size %q", ErrMalformed, sizeField)
+	}
+
+	r.signature = ""
+	if ext != "" {
+		name, value, _ := strings.Cut(ext, "=")
+		if strings.TrimSpace(name) == "chunk-signature" {
+			r.signature = strings.TrimSpace(value)
+		}
+	}
+	r.chunkHash.Reset()
+
+	if r.total+size > r.opts.DecodedLength {
+		return ErrIncompleteBody
+	}
+	if size > 0 {
+		r.remaining = size
+		return nil
+	}
+
+	if err := r.verifyChunk(); err != nil {
+		return err
+	}
+	if r.total != r.opts.DecodedLength {
+		return ErrIncompleteBody
+	}
+	if err := r.readTrailers(); err != nil {
+		return err
+	}
+	return io.EOF
+}
+
+// endChunk consumes the CRLF after a chunk's data and checks its signature.
+func (r *Reader) endChunk() error {
+	line, err := r.readLine()
+	if err != nil {
+		return err
+	}
+	if line != "" {
+		return fmt.Errorf("%w: chunk data longer than its size", ErrMalformed)
+	}
+	return r.verifyChunk()
+}
+
+func (r *Reader) verifyChunk() error {
+	if r.opts.Verifier == nil {
+		return nil
+	}
+	return r.opts.Verifier.VerifyChunk(r.signature, r.chunkHash.Sum(nil))
+}
+
+// readTrailers reads the trailer section up to its blank line, then checks
+// the announced checksum and, when signed, the trailer signature.
+func (r *Reader) readTrailers() error {
+	var (
+		signed       bytes.Buffer
+		signature    string
+		trailerValue string
+	)
+	for {
+		line, err := r.readLine()
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			// Some clients end the body without the closing blank line.
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if line == "" {
+			break
+		}
+
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return fmt.Errorf("%w: bad trailer %q", ErrMalformed, line)
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+		value = strings.TrimSpace(value)
+
+		if name == trailerSignature {
+			signature = value
+			continue
+		}
+		signed.WriteString(name + ":" + value + "\n")
+		if name == r.opts.Trailer {
+			trailerValue = value
+		}
+	}
+
+	if r.checksum != nil {
+		if trailerValue == "" {
+			return fmt.Errorf("%w: %s trailer missing", ErrMalformed, r.opts.Trailer)
+		}
+		if trailerValue != checksum.Sum(r.checksum) {
+			return fmt.Errorf("%w: %s", ErrChecksumMismatch, r.opts.Trailer)
+		}
+	}
+
+	if r.opts.Verifier != nil && signed.Len() > 0 {
+		sum := sha256.Sum256(signed.Bytes())
+		return r.opts.Verifier.VerifyTrailer(signature, sum[:])
+	}
+	return nil
+}
+
+// readLine returns the next CRLF-terminated line without its terminator.
+// Running out of body before a line starts is reported as ErrMalformed
+// wrapping io.ErrUnexpectedEOF.
+func (r *Reader) readLine() (string, error) {
+	line, err := r.br.ReadSlice('\n')
+	if errors.Is(err, bufio.ErrBufferFull) {
+		return "", fmt.Errorf("%w: line too long", ErrMalformed)
+	}
+	if err == io.EOF {
+		if len(line) == 0 {
+			return "", fmt.Errorf("%w: %w", ErrMalformed, io.ErrUnexpectedEOF)
+		}
+		return "", fmt.Errorf("%w: unterminated line", ErrMalformed)
+	}
+	if err != nil {
+		return "", err
+	}
+	if !bytes.HasSuffix(line, []byte("\r\n")) {
+		return "", fmt.Errorf("%w: line not terminated by CRLF", ErrMalformed)
+	}
+	return string(line[:len(line)-2]), nil
+}
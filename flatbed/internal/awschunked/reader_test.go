@@ -0,0 +1,285 @@
+package awschunked_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/awschunked"
+)
+
+// The signed example from the AWS SigV4 streaming upload documentation:
+// 66560 bytes of 'a' sent as a 65536-byte chunk, a 1024-byte chunk and the
+// final empty chunk.
+const (
+	exampleSecret   = "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"
+	exampleDatetime = "20130524T000000Z"
+	exampleScope    = "20130524/us-east-1/s3/aws4_request"
+	exampleSeed     = "4f232c4386841ef735655705268965c44a0e4690baa4adea153f7db9fa80a0a9"
+	exampleSig1     = "ad80c730a21e5b8d04586a2213dd63b9a0e99e0e2307b0ade35a65485a288648"
+	exampleSig2     = "0055627c9e194cb4542bae2aa5492e3c1575bbb81b612b7d234b86a503ef5497"
+	exampleSigFinal = "b6c6ea8a5354eaf15b3cb7646744f4275b71ea724fed81ceb9323e279d449df9"
+)
+
+func exampleSigningKey() []byte {
+	key := []byte("AWS4" + exampleSecret)
+	for _, part := range []string{"20130524", "us-east-1", "s3", "aws4_request"} {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(part))
+		key = mac.Sum(nil)
+	}
+	return key
+}
+
+func exampleBody(sig1, sig2, sigFinal string) string {
+	return fmt.Sprintf("10000;chunk-signature=%s\r\n%s\r\n", sig1, strings.Repeat("a", 65536)) +
+		fmt.Sprintf("400;chunk-signature=%s\r\n%s\r\n", sig2, strings.Repeat("a", 1024)) +
+		fmt.Sprintf("0;chunk-signature=%s\r\n\r\n", sigFinal)
+}
+
+func TestReader(t *testing.T) {
+	t.Parallel()
+
+	const payload = "hello world"
+
+	cases := []struct {
+		name    string
+		body    string
+		opts    awschunked.Options
+		want    string
+		wantErr error
+	}{
+		{
+			name: "unsigned chunks",
+			body: "6\r\nhello \r\n5\r\nworld\r\n0\r\n\r\n",
+			opts: awschunked.Options{DecodedLength: 11},
+			want: payload,
+		},
+		{
+			name: "signatures ignored without verifier",
+			body: "b;chunk-signature=abc\r\nhello world\r\n0;chunk-signature=def\r\n\r\n",
+			opts: awschunked.Options{DecodedLength: 11},
+			want: payload,
+		},
+		{
+			name: "crc32 trailer",
+			body: "b\r\nhello world\r\n0\r\nx-amz-checksum-crc32:DUoRhQ==\r\n\r\n",
+			opts: awschunked.Options{DecodedLength: 11, Trailer: "x-amz-checksum-crc32"},
+			want: payload,
+		},
+		{
+			name: "crc32c trailer",
+			body: "b\r\nhello world\r\n0\r\nx-amz-checksum-crc32c:yZRlqg==\r\n\r\n",
+			opts: awschunked.Options{DecodedLength: 11, Trailer: "x-amz-checksum-crc32c"},
+			want: payload,
+		},
+		{
+			name: "sha256 trailer without closing blank line",
+			body: "b\r\nhello world\r\n0\r\nx-amz-checksum-sha256:uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek=\r\n",
+			opts: awschunked.Options{DecodedLength: 11, Trailer: "x-amz-checksum-sha256"},
+			want: payload,
+		},
+		{
+			name:    "trailer checksum mismatch",
+			body:    "b\r\nhello world\r\n0\r\nx-amz-checksum-crc32:AAAAAA==\r\n\r\n",
+			opts:    awschunked.Options{DecodedLength: 11, Trailer: "x-amz-checksum-crc32"},
+			wantErr: awschunked.ErrChecksumMismatch,
+		},
+		{
+			name:    "announced trailer missing",
+			body:    "b\r\nhello world\r\n0\r\n\r\n",
+			opts:    awschunked.Options{DecodedLength: 11, Trailer: "x-amz-checksum-crc32"},
+			wantErr: awschunked.ErrMalformed,
+		},
+		{
+			name:    "payload shorter than decoded length",
+			body:    "5\r\nhello\r\n0\r\n\r\n",
+			opts:    awschunked.Options{DecodedLength: 11},
+			wantErr: awschunked.ErrIncompleteBody,
+		},
+		{
+			name:    "payload longer than decoded length",
+			body:    "b\r\nhello world\r\n0\r\n\r\n",
+			opts:    awschunked.Options{DecodedLength: 5},
+			wantErr: awschunked.ErrIncompleteBody,
+		},
+		{
+			name:    "bad chunk size",
+			body:    "zz\r\nhello\r\n0\r\n\r\n",
+			opts:    awschunked.Options{DecodedLength: 5},
+			wantErr: awschunked.ErrMalformed,
+		},
+		{
+			name:    "chunk data overruns its size",
+			body:    "3\r\nhello\r\n0\r\n\r\n",
+			opts:    awschunked.Options{DecodedLength: 5},
+			wantErr: awschunked.ErrMalformed,
+		},
+		{
+			name:    "body ends mid-chunk",
+			body:    "b\r\nhello",
+			opts:    awschunked.Options{DecodedLength: 11},
+			wantErr: awschunked.ErrMalformed,
+		},
+		{
+			name:    "body ends before final chunk",
+			body:    "b\r\nhello world\r\n",
+			opts:    awschunked.Options{DecodedLength: 11},
+			wantErr: awschunked.ErrMalformed,
+		},
+		{
+			name: "signed example",
+			body: exampleBody(exampleSig1, exampleSig2, exampleSigFinal),
+			opts: awschunked.Options{
+				DecodedLength: 66560,
+				Verifier:      awschunked.NewSigV4Verifier(exampleSigningKey(), exampleDatetime, exampleScope, exampleSeed),
+			},
+			want: strings.Repeat("a", 66560),
+		},
+		{
+			name: "signed example with tampered chunk signature",
+			body: exampleBody(exampleSig1, exampleSig1, exampleSigFinal),
+			opts: awschunked.Options{
+				DecodedLength: 66560,
+				Verifier:      awschunked.NewSigV4Verifier(exampleSigningKey(), exampleDatetime, exampleScope, exampleSeed),
+			},
+			wantErr: awschunked.ErrSignatureMismatch,
+		},
+		{
+			name: "signed example with wrong seed",
+			body: exampleBody(exampleSig1, exampleSig2, exampleSigFinal),
+			opts: awschunked.Options{
+				DecodedLength: 66560,
+				Verifier:      awschunked.NewSigV4Verifier(exampleSigningKey(), exampleDatetime, exampleScope, exampleSig1),
+			},
+			wantErr: awschunked.ErrSignatureMismatch,
+		},
+		{
+			name: "signed chunk missing its signature",
+			body: exampleBody("", exampleSig2, exampleSigFinal),
+			opts: awschunked.Options{
+				DecodedLength: 66560,
+				Verifier:      awschunked.NewSigV4Verifier(exampleSigningKey(), exampleDatetime, exampleScope, exampleSeed),
+			},
+			wantErr: awschunked.ErrSignatureMismatch,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := awschunked.NewReader(strings.NewReader(c.body), c.opts)
+			if err != nil {
+				t.Fatalf("NewReader: %v", err)
+			}
+
+			got, err := io.ReadAll(r)
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("ReadAll error = %v, want %v", err, c.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReadAll: %v", err)
+			}
+			if string(got) != c.want {
+				t.Fatalf("payload = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+// recordingVerifier accepts every signature and records what it was asked
+// to check.
+type recordingVerifier struct {
+	chunks  []string
+	trailer string
+}
+
+func (v *recordingVerifier) VerifyChunk(signature string, chunkHash []byte) error {
+	v.chunks = append(v.chunks, signature)
+	return nil
+}
+
+func (v *recordingVerifier) VerifyTrailer(signature string, trailerHash []byte) error {
+	sum := sha256.Sum256([]byte("x-amz-checksum-crc32:DUoRhQ==\n"))
+	if !hmac.Equal(trailerHash, sum[:]) {
+		return fmt.Errorf("trailer hash = %x, want %x", trailerHash, sum)
+	}
+	v.trailer = signature
+	return nil
+}
+
+func TestReader_SignedTrailer(t *testing.T) {
+	t.Parallel()
+
+	body := "b;chunk-signature=sig1\r\nhello world\r\n0;chunk-signature=sig2\r\n" +
+		"x-amz-checksum-crc32:DUoRhQ==\r\nx-amz-trailer-signature:sig3\r\n\r\n"
+
+	v := &recordingVerifier{}
+	r, err := awschunked.NewReader(strings.NewReader(body), awschunked.Options{
+		DecodedLength: 11,
+		Trailer:       "x-amz-checksum-crc32",
+		Verifier:      v,
+	})
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	if _, err := io.ReadAll(r); err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+
+	if got := strings.Join(v.chunks, ","); got != "sig1,sig2" {
+		t.Fatalf("chunk signatures = %q, want %q", got, "sig1,sig2")
+	}
+	if v.trailer != "sig3" {
+		t.Fatalf("trailer signature = %q, want %q", v.trailer, "sig3")
+	}
+}
+
+func TestNewReader_UnsupportedTrailer(t *testing.T) {
+	t.Parallel()
+
+	_, err := awschunked.NewReader(strings.NewReader(""), awschunked.Options{Trailer: "x-amz-checksum-md5"})
+	if !errors.Is(err, awschunked.ErrUnsupportedTrailer) {
+		t.Fatalf("NewReader error = %v, want %v", err, awschunked.ErrUnsupportedTrailer)
+	}
+}
+
+func TestIsChunked(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		headers map[string]string
+		want    bool
+	}{
+		{name: "plain upload", headers: map[string]string{"Content-Type": "text/plain"}},
+		{name: "aws-chunked encoding", headers: map[string]string{"Content-Encoding": "aws-chunked"}, want: true},
+		{name: "aws-chunked among encodings", headers: map[string]string{"Content-Encoding": "gzip, aws-chunked"}, want: true},
+		{name: "streaming payload hash", headers: map[string]string{"X-Amz-Content-Sha256": awschunked.StreamingUnsignedTrailer}, want: true},
+		{name: "unsigned payload", headers: map[string]string{"X-Amz-Content-Sha256": "UNSIGNED-PAYLOAD"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPut, "/bucket/key", nil)
+			for k, v := range c.headers {
+				req.Header.Set(k, v)
+			}
+			if got := awschunked.IsChunked(req); got != c.want {
+				t.Fatalf("IsChunked = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
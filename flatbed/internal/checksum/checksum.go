@@ -0,0 +1,80 @@
+// Package checksum implements the additional checksums S3 clients send
+// alongside an upload, either in an x-amz-checksum-* header or as an
+// aws-chunked trailer of the same name.
+package checksum
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"hash"
+	"hash/crc32"
+	"strings"
+)
+
+// Algorithms as S3 names them in x-amz-checksum-algorithm.
+const (
+	CRC32  = "CRC32"
+	CRC32C = "CRC32C"
+	SHA1   = "SHA1"
+	SHA256 = "SHA256"
+)
+
+// Algorithms lists the supported algorithms.
+var Algorithms = []string{CRC32, CRC32C, SHA1, SHA256}
+
+const headerPrefix = "x-amz-checksum-"
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// New returns a hash computing algorithm, which is matched
+// case-insensitively, and reports whether the algorithm is supported.
+func New(algorithm string) (hash.Hash, bool) {
+	switch strings.ToUpper(algorithm) {
+	case CRC32:
+		return crc32.NewIEEE(), true
+	case CRC32C:
+		return crc32.New(castagnoli), true
+	case SHA1:
+		return sha1.New(), true
+	case SHA256:
+		return sha256.New(), true
+	}
+	return nil, false
+}
+
+// Header returns the header or trailer name that carries algorithm's value,
+// such as x-amz-checksum-crc32.
+func Header(algorithm string) string {
+	return headerPrefix + strings.ToLower(algorithm)
+}
+
+// FromHeader returns the algorithm carried by an x-amz-checksum-* header or
+// trailer name, and false if name isn't one of them.
+func FromHeader(name string) (string, bool) {
+	suffix, ok := strings.CutPrefix(strings.ToLower(strings.TrimSpace(name)), headerPrefix)
+	if !ok {
+		return "", false
+	}
+	algorithm := strings.ToUpper(suffix)
+	if _, ok := New(algorithm); !ok {
+		return "", false
+	}
+	return algorithm, true
+}
+
+// Valid reports whether value is a base64 digest of the size algorithm
+// produces.
+func Valid(algorithm, value string) bool {
+	h, ok := New(algorithm)
+	if !ok {
+		return false
+	}
+	digest, err := base64.StdEncoding.DecodeString(value)
+	return err == nil && len(digest) == h.Size()
+}
+
+// Sum returns the base64 digest S3 sends for h.
+func Sum(h hash.Hash) string {
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
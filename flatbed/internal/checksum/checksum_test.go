@@ -0,0 +1,86 @@
+package checksum_test
+
+import (
+	"testing"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/checksum"
+)
+
+func TestSum(t *testing.T) {
+	t.Parallel()
+
+	// Digests of "hello world".
+	cases := map[string]string{
+		checksum.CRC32:  "DUoRhQ==",
+		checksum.CRC32C: "yZRlqg==",
+		checksum.SHA1:   "Kq5sNclPz7QV2+lfQIuc6R7oRu0=",
+		checksum.SHA256: "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek=",
+	}
+
+	for _, algorithm := range checksum.Algorithms {
+		h, ok := checksum.New(algorithm)
+		if !ok {
+			t.Fatalf("New(%q): not supported", algorithm)
+		}
+		h.Write([]byte("hello world"))
+		if got := checksum.Sum(h); got != cases[algorithm] {
+			t.Fatalf("%s: got %q, want %q", algorithm, got, cases[algorithm])
+		}
+		if !checksum.Valid(algorithm, cases[algorithm]) {
+			t.Fatalf("Valid(%s, %q) = false, want true", algorithm, cases[algorithm])
+		}
+	}
+
+	if _, ok := checksum.New("MD5"); ok {
+		t.Fatal("New(MD5): supported, want unsupported")
+	}
+}
+
+func TestFromHeader(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		want string
+		ok   bool
+	}{
+		{name: "x-amz-checksum-crc32", want: checksum.CRC32, ok: true},
+		{name: "X-Amz-Checksum-Crc32c", want: checksum.CRC32C, ok: true},
+		{name: " x-amz-checksum-sha256 ", want: checksum.SHA256, ok: true},
+		{name: "x-amz-checksum-md5"},
+		{name: "x-amz-checksum-mode"},
+		{name: "content-md5"},
+	}
+
+	for _, c := range cases {
+		got, ok := checksum.FromHeader(c.name)
+		if got != c.want || ok != c.ok {
+			t.Fatalf("FromHeader(%q) = %q, %v; want %q, %v", c.name, got, ok, c.want, c.ok)
+		}
+	}
+
+	if got := checksum.Header(checksum.CRC32C); got != "x-amz-checksum-crc32c" {
+		t.Fatalf("Header(CRC32C) = %q", got)
+	}
+}
+
+func TestValid(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		algorithm string
+		value     string
+		want      bool
+	}{
+		{algorithm: checksum.CRC32, value: "DUoRhQ==", want: true},
+		{algorithm: checksum.CRC32, value: "Kq5sNclPz7QV2+lfQIuc6R7oRu0="},
+		{algorithm: checksum.SHA1, value: "not base64!"},
+		{algorithm: "MD5", value: "XrY7u+Ae7tCTyyK7j1rNww=="},
+	}
+
+	for _, c := range cases {
+		if got := checksum.Valid(c.algorithm, c.value); got != c.want {
+			t.Fatalf("Valid(%s, %q) = %v, want %v", c.algorithm, c.value, got, c.want)
+		}
+	}
+}
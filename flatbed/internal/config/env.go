@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type envVal string
@@ -30,13 +31,23 @@ const (
 	LogConcise logVerbosityVal = "concise"
 )
 
+type authModeVal string
+
+const (
+	AuthSigV4     authModeVal = "sigv4"
+	AuthAnonymous authModeVal = "anonymous"
+)
+
 var (
 	AppEnv             envVal
 	LogFormat          logFormatVal
 	LogVerbosity       logVerbosityVal
+	AuthMode           authModeVal
 	FlatbedPort        int
 	GantryAddr         string
 	PutObjectChunkSize int = 8192
+	AccessKeyCacheTTL  time.Duration
+	CORSCacheTTL       time.Duration
 )
 
 func Init() {
@@ -46,9 +57,11 @@ func Init() {
 	case EnvDevelopment, EnvTest:
 		LogFormat = LogPretty
 		LogVerbosity = LogConcise
+		AuthMode = AuthAnonymous
 	default:
 		LogFormat = LogJSON
 		LogVerbosity = LogVerbose
+		AuthMode = AuthSigV4
 	}
 
 	FlatbedPort = 8080
@@ -71,6 +84,15 @@ func Init() {
 		}
 	}
 
+	if v := strings.ToLower(strings.TrimSpace(os.Getenv("FLATBED_AUTH"))); v != "" {
+		switch v {
+		case "sigv4":
+			AuthMode = AuthSigV4
+		case "anonymous":
+			AuthMode = AuthAnonymous
+		}
+	}
+
 	if v := strings.TrimSpace(os.Getenv("FLATBED_PORT")); v != "" {
 		if port, err := strconv.Atoi(v); err == nil && port > 0 && port < 65536 {
 			FlatbedPort = port
@@ -81,6 +103,20 @@ func Init() {
 		GantryAddr = v
 	}
 
+	AccessKeyCacheTTL = time.Minute
+	if v := strings.TrimSpace(os.Getenv("FLATBED_ACCESS_KEY_CACHE_TTL")); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			AccessKeyCacheTTL = d
+		}
+	}
+
+	CORSCacheTTL = 10 * time.Second
+	if v := strings.TrimSpace(os.Getenv("FLATBED_CORS_CACHE_TTL")); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			CORSCacheTTL = d
+		}
+	}
+
 	if v := strings.TrimSpace(os.Getenv("PUT_OBJECT_CHUNK_SIZE")); v != "" {
 		if size, err := strconv.Atoi(v); err == nil && size > 0 {
 			PutObjectChunkSize = size
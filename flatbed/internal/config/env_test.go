@@ -1,6 +1,9 @@
 package config
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestAppEnvDefaultsToTestInGoTest(t *testing.T) {
 	t.Setenv("APP_ENV", "")
@@ -11,3 +14,83 @@ func TestAppEnvDefaultsToTestInGoTest(t *testing.T) {
 		t.Fatalf("expected default AppEnv to be \"test\" when running tests, got %q", AppEnv)
 	}
 }
+
+func TestAuthMode(t *testing.T) {
+	cases := []struct {
+		name   string
+		appEnv string
+		auth   string
+		want   authModeVal
+	}{
+		{name: "development defaults to anonymous", appEnv: "development", want: AuthAnonymous},
+		{name: "production defaults to sigv4", appEnv: "production", want: AuthSigV4},
+		{name: "development can require sigv4", appEnv: "development", auth: "sigv4", want: AuthSigV4},
+		{name: "production can run anonymous", appEnv: "production", auth: "ANONYMOUS", want: AuthAnonymous},
+		{name: "unknown value keeps the default", appEnv: "production", auth: "none", want: AuthSigV4},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Setenv("APP_ENV", c.appEnv)
+			t.Setenv("FLATBED_AUTH", c.auth)
+
+			Init()
+
+			if AuthMode != c.want {
+				t.Fatalf("AuthMode = %q, want %q", AuthMode, c.want)
+			}
+		})
+	}
+}
+
+func TestAccessKeyCacheTTL(t *testing.T) {
+	cases := []struct {
+		name string
+		env  string
+		want time.Duration
+	}{
+		{name: "defaults to a minute", want: time.Minute},
+		{name: "parses a duration", env: "5m", want: 5 * time.Minute},
+		{name: "zero disables the cache", env: "0s", want: 0},
+		{name: "negative keeps the default", env: "-1s", want: time.Minute},
+		{name: "garbage keeps the default", env: "soon", want: time.Minute},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Setenv("FLATBED_ACCESS_KEY_CACHE_TTL", c.env)
+
+			Init()
+
+			if AccessKeyCacheTTL != c.want {
+				t.Fatalf("AccessKeyCacheTTL = %s, want %s", AccessKeyCacheTTL, c.want)
+			}
+		})
+	}
+}
+
+func TestCORSCacheTTL(t *testing.T) {
+	cases := []struct {
+		name string
+		env  string
+		want time.Duration
+	}{
+		{name: "defaults to ten seconds", want: 10 * time.Second},
+		{name: "parses a duration", env: "1m", want: time.Minute},
+		{name: "zero disables the cache", env: "0s", want: 0},
+		{name: "negative keeps the default", env: "-1s", want: 10 * time.Second},
+		{name: "garbage keeps the default", env: "soon", want: 10 * time.Second},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Setenv("FLATBED_CORS_CACHE_TTL", c.env)
+
+			Init()
+
+			if CORSCacheTTL != c.want {
+				t.Fatalf("CORSCacheTTL = %s, want %s", CORSCacheTTL, c.want)
+			}
+		})
+	}
+}
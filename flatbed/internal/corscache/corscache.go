@@ -0,0 +1,89 @@
+// Package corscache keeps recently looked up bucket CORS rules so flatbed
+// doesn't ask gantry for them on every browser request.
+package corscache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+)
+
+// Lookup fetches a bucket's CORS rules from gantry.
+type Lookup interface {
+	LookupBucketCors(ctx context.Context, bucket string) ([]gantry.CORSRule, error)
+}
+
+// maxEntries bounds the cache. Preflights are unauthenticated, so the
+// bucket names it is asked about are anyone's choice.
+const maxEntries = 10000
+
+type entry struct {
+	rules   []gantry.CORSRule
+	expires time.Time
+}
+
+// Cache remembers the rules found by the underlying Lookup for a fixed TTL,
+// including a bucket having none. Failed lookups are not cached. A changed
+// CORS configuration takes effect once the bucket's entry expires. Expired
+// entries are dropped on insert, and once the cache holds maxEntries live
+// ones an arbitrary entry makes room for the new one.
+type Cache struct {
+	next       Lookup
+	ttl        time.Duration
+	now        func() time.Time
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+// New returns a Cache in front of next. A ttl of zero or less disables
+// caching.
+func New(next Lookup, ttl time.Duration) *Cache {
+	return &Cache{
+		next:       next,
+		ttl:        ttl,
+		now:        time.Now,
+		maxEntries: maxEntries,
+		entries:    make(map[string]entry),
+	}
+}
+
+func (c *Cache) LookupBucketCors(ctx context.Context, bucket string) ([]gantry.CORSRule, error) {
+	if c.ttl <= 0 {
+		return c.next.LookupBucketCors(ctx, bucket)
+	}
+
+	now := c.now()
+
+	c.mu.Lock()
+	e, ok := c.entries[bucket]
+	c.mu.Unlock()
+	if ok && now.Before(e.expires) {
+		return e.rules, nil
+	}
+
+	rules, err := c.next.LookupBucketCors(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	for name, old := range c.entries {
+		if !now.Before(old.expires) {
+			delete(c.entries, name)
+		}
+	}
+	if _, ok := c.entries[bucket]; !ok && len(c.entries) >= c.maxEntries {
+		for name := range c.entries {
+			delete(c.entries, name)
+			break
+		}
+	}
+	c.entries[bucket] = entry{rules: rules, expires: now.Add(c.ttl)}
+	c.mu.Unlock()
+
+	return rules, nil
+}
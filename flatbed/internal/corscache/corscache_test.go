@@ -0,0 +1,119 @@
+package corscache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+)
+
+func TestCache_LookupBucketCors(t *testing.T) {
+	t.Parallel()
+
+	rules := []gantry.CORSRule{{AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET"}}}
+
+	tests := []struct {
+		name      string
+		ttl       time.Duration
+		elapsed   time.Duration
+		rules     []gantry.CORSRule
+		err       error
+		wantCalls int
+	}{
+		{name: "second lookup is cached", ttl: 10 * time.Second, elapsed: 5 * time.Second, rules: rules, wantCalls: 1},
+		{name: "no rules are cached too", ttl: 10 * time.Second, elapsed: 5 * time.Second, wantCalls: 1},
+		{name: "expired entry is fetched again", ttl: 10 * time.Second, elapsed: 10 * time.Second, rules: rules, wantCalls: 2},
+		{name: "zero ttl disables caching", ttl: 0, rules: rules, wantCalls: 2},
+		{name: "invalid bucket is not cached", ttl: 10 * time.Second, err: status.Error(codes.InvalidArgument, "InvalidBucketName"), wantCalls: 2},
+		{name: "gantry error is not cached", ttl: 10 * time.Second, err: errors.New("gantry down"), wantCalls: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fg := testutil.NewGantryStub()
+			fg.LookupBucketCorsFn = func(context.Context, string) ([]gantry.CORSRule, error) {
+				return tt.rules, tt.err
+			}
+
+			clock := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+			c := New(fg, tt.ttl)
+			c.now = func() time.Time { return clock }
+
+			for i := 0; i < 2; i++ {
+				got, err := c.LookupBucketCors(context.Background(), "gallery")
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("lookup %d: err = %v, want %v", i, err, tt.err)
+				}
+				if len(got) != len(tt.rules) {
+					t.Fatalf("lookup %d: rules = %+v, want %+v", i, got, tt.rules)
+				}
+				clock = clock.Add(tt.elapsed)
+			}
+
+			if got := len(fg.LookupBucketCorsCalls); got != tt.wantCalls {
+				t.Fatalf("gantry lookups = %d, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCache_BucketsCachedSeparately(t *testing.T) {
+	t.Parallel()
+
+	fg := testutil.NewGantryStub()
+	fg.LookupBucketCorsFn = func(_ context.Context, bucket string) ([]gantry.CORSRule, error) {
+		return []gantry.CORSRule{{ID: bucket}}, nil
+	}
+	c := New(fg, time.Minute)
+
+	for _, bucket := range []string{"gallery", "uploads", "gallery"} {
+		rules, err := c.LookupBucketCors(context.Background(), bucket)
+		if err != nil {
+			t.Fatalf("LookupBucketCors(%s): %v", bucket, err)
+		}
+		if len(rules) != 1 || rules[0].ID != bucket {
+			t.Fatalf("LookupBucketCors(%s) returned %+v", bucket, rules)
+		}
+	}
+
+	if got := len(fg.LookupBucketCorsCalls); got != 2 {
+		t.Fatalf("gantry lookups = %d, want 2", got)
+	}
+}
+
+func TestCache_BoundedSize(t *testing.T) {
+	t.Parallel()
+
+	fg := testutil.NewGantryStub()
+	clock := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	c := New(fg, time.Minute)
+	c.now = func() time.Time { return clock }
+	c.maxEntries = 3
+
+	for i := 0; i < 10; i++ {
+		if _, err := c.LookupBucketCors(context.Background(), fmt.Sprintf("probe-%d", i)); err != nil {
+			t.Fatalf("LookupBucketCors(probe-%d): %v", i, err)
+		}
+		if got := len(c.entries); got > c.maxEntries {
+			t.Fatalf("entries after %d lookups = %d, want at most %d", i+1, got, c.maxEntries)
+		}
+	}
+
+	// Once they expire, old entries make room without evicting live ones.
+	clock = clock.Add(time.Minute)
+	if _, err := c.LookupBucketCors(context.Background(), "gallery"); err != nil {
+		t.Fatalf("LookupBucketCors(gallery): %v", err)
+	}
+	if got := len(c.entries); got != 1 {
+		t.Fatalf("entries after expiry = %d, want 1", got)
+	}
+}
@@ -0,0 +1,51 @@
+package cradle
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
+)
+
+// CopySource is one blob a copy reads, in order, from the cradle holding it.
+type CopySource struct {
+	CradleAddress string
+	BlobID        string
+	Bucket        string
+	Size          int64
+}
+
+// CopyObject asks the cradle at address to store objectID in bucket by
+// reading sources directly from their cradles, so the bytes never pass
+// through flatbed. The result's MD5 covers the copied bytes.
+func (c *Client) CopyObject(ctx context.Context, address, objectID, bucket string, size int64, sources []CopySource) (WriteResult, error) {
+	conn, err := c.pool.GetConn(ctx, address)
+	if err != nil {
+		return WriteResult{}, err
+	}
+
+	req := &servicev1.CopyObjectRequest{
+		ObjectId: objectID,
+		Bucket:   bucket,
+		Size:     size,
+		Sources:  make([]*servicev1.CopySource, 0, len(sources)),
+	}
+	for _, src := range sources {
+		req.Sources = append(req.Sources, &servicev1.CopySource{
+			CradleAddress: src.CradleAddress,
+			ObjectId:      src.BlobID,
+			Bucket:        src.Bucket,
+			Size:          src.Size,
+		})
+	}
+
+	resp, err := servicev1.NewCradleServiceClient(conn).CopyObject(ctx, req)
+	if err != nil {
+		return WriteResult{}, err
+	}
+
+	return WriteResult{
+		BytesWritten:   resp.GetBytesWritten(),
+		LastModifiedMs: resp.GetCommittedAtMs(),
+		MD5:            resp.GetMd5(),
+	}, nil
+}
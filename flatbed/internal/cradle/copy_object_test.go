@@ -0,0 +1,85 @@
+package cradle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
+)
+
+func TestClientCopyObject(t *testing.T) {
+	t.Parallel()
+
+	const address = "localhost:9444"
+
+	sources := []CopySource{
+		{CradleAddress: "cradle-a:8082", BlobID: "blob-1", Bucket: "photos", Size: 6},
+		{CradleAddress: "cradle-b:8082", BlobID: "blob-2", Bucket: "photos", Size: 5},
+	}
+
+	t.Run("sends sources and returns the result", func(t *testing.T) {
+		t.Parallel()
+
+		client, svc := newTestClient(t)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		t.Cleanup(cancel)
+
+		svc.SetCopyObjectHook(func(req *servicev1.CopyObjectRequest) (*servicev1.CopyObjectResponse, error) {
+			return &servicev1.CopyObjectResponse{BytesWritten: 11, CommittedAtMs: 1234567890, Md5: []byte{0xde, 0xad}}, nil
+		})
+
+		got, err := client.CopyObject(requestid.WithRequestID(ctx, "req-abc"), address, "obj-new", "backup", 11, sources)
+		if err != nil {
+			t.Fatalf("CopyObject: %v", err)
+		}
+
+		want := WriteResult{BytesWritten: 11, LastModifiedMs: 1234567890, MD5: []byte{0xde, 0xad}}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("result mismatch (-want +got):\n%s", diff)
+		}
+
+		call, ok := svc.LastCopyObjectCall()
+		if !ok {
+			t.Fatal("no CopyObject call recorded")
+		}
+		wantReq := &servicev1.CopyObjectRequest{
+			ObjectId: "obj-new",
+			Bucket:   "backup",
+			Size:     11,
+			Sources: []*servicev1.CopySource{
+				{CradleAddress: "cradle-a:8082", ObjectId: "blob-1", Bucket: "photos", Size: 6},
+				{CradleAddress: "cradle-b:8082", ObjectId: "blob-2", Bucket: "photos", Size: 5},
+			},
+		}
+		if diff := cmp.Diff(wantReq, call.Request, protocmp.Transform()); diff != "" {
+			t.Fatalf("request mismatch (-want +got):\n%s", diff)
+		}
+		if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+			t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+		}
+	})
+
+	t.Run("returns the cradle error", func(t *testing.T) {
+		t.Parallel()
+
+		client, svc := newTestClient(t)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		t.Cleanup(cancel)
+
+		svc.SetCopyObjectHook(func(*servicev1.CopyObjectRequest) (*servicev1.CopyObjectResponse, error) {
+			return nil, status.Error(codes.NotFound, "source object not found")
+		})
+
+		_, err := client.CopyObject(ctx, address, "obj-new", "backup", 11, sources)
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("CopyObject error code: got %v, want %v", status.Code(err), codes.NotFound)
+		}
+	})
+}
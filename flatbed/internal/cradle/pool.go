@@ -24,6 +24,7 @@ func NewPool() *Pool {
 			return grpc.NewClient(address,
 				grpc.WithTransportCredentials(insecure.NewCredentials()),
 				grpc.WithChainStreamInterceptor(requestIDStreamInterceptor()),
+				grpc.WithChainUnaryInterceptor(requestIDUnaryInterceptor()),
 			)
 		},
 	}
@@ -74,3 +75,12 @@ func requestIDStreamInterceptor() grpc.StreamClientInterceptor {
 		return streamer(ctx, desc, cc, method, opts...)
 	}
 }
+
+func requestIDUnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if id := requestid.RequestIDFromContext(ctx); id != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "x-request-id", id)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,82 @@
+package cradle
+
+import (
+	"context"
+	"io"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
+)
+
+// ReadObject opens a read stream for an object on the given cradle server.
+// Only length bytes starting at offset are sent; a zero length reads to the
+// end of the blob. The first chunk is received before returning so that
+// errors such as a missing object surface before the caller commits to a
+// response.
+func (c *Client) ReadObject(ctx context.Context, address, objectID, bucket string, offset, length int64) (io.ReadCloser, error) {
+	conn, err := c.pool.GetConn(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	serviceClient := servicev1.NewCradleServiceClient(conn)
+	stream, err := serviceClient.ReadObject(ctx, &servicev1.ReadObjectRequest{
+		ObjectId: objectID,
+		Bucket:   bucket,
+		Offset:   offset,
+		Length:   length,
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	r := &objectReader{stream: stream, cancel: cancel}
+
+	resp, err := stream.Recv()
+	if err == io.EOF {
+		r.done = true
+		return r, nil
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	r.buf = resp.GetChunk()
+
+	return r, nil
+}
+
+type objectReader struct {
+	stream servicev1.CradleService_ReadObjectClient
+	cancel context.CancelFunc
+	buf    []byte
+	done   bool
+}
+
+func (r *objectReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		resp, err := r.stream.Recv()
+		if err == io.EOF {
+			r.done = true
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, err
+		}
+		r.buf = resp.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *objectReader) Close() error {
+	r.cancel()
+	return nil
+}
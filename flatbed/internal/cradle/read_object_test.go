@@ -0,0 +1,101 @@
+package cradle
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
+)
+
+func TestClientReadObject(t *testing.T) {
+	t.Parallel()
+
+	const address = "localhost:9444"
+
+	type tc struct {
+		name     string
+		chunks   []string
+		hookErr  error
+		wantErr  codes.Code
+		wantBody string
+	}
+
+	cases := []tc{
+		{
+			name:     "reassembles streamed chunks",
+			chunks:   []string{"hello ", "world"},
+			wantBody: "hello world",
+		},
+		{
+			name:    "missing object returns error before reading",
+			hookErr: status.Error(codes.NotFound, "object not found"),
+			wantErr: codes.NotFound,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, svc := newTestClient(t)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			svc.SetReadObjectHook(func(_ *servicev1.ReadObjectRequest, stream servicev1.CradleService_ReadObjectServer) error {
+				if c.hookErr != nil {
+					return c.hookErr
+				}
+				for _, chunk := range c.chunks {
+					if err := stream.Send(&servicev1.ReadObjectResponse{Chunk: []byte(chunk)}); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+
+			body, err := client.ReadObject(requestid.WithRequestID(ctx, "req-abc"), address, "01JXXXXXXXXXXXXXXXXXXXXXXXXX", "photos", 6, 5)
+
+			if c.wantErr != codes.OK {
+				if status.Code(err) != c.wantErr {
+					t.Fatalf("ReadObject error code: got %v, want %v", status.Code(err), c.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReadObject: %v", err)
+			}
+			defer body.Close()
+
+			got, err := io.ReadAll(body)
+			if err != nil {
+				t.Fatalf("ReadAll: %v", err)
+			}
+			if string(got) != c.wantBody {
+				t.Fatalf("body: got %q, want %q", string(got), c.wantBody)
+			}
+
+			call, ok := svc.LastReadObjectCall()
+			if !ok {
+				t.Fatal("no ReadObject call recorded")
+			}
+			if call.ObjectID != "01JXXXXXXXXXXXXXXXXXXXXXXXXX" {
+				t.Fatalf("ObjectID: got %q, want %q", call.ObjectID, "01JXXXXXXXXXXXXXXXXXXXXXXXXX")
+			}
+			if call.Bucket != "photos" {
+				t.Fatalf("Bucket: got %q, want %q", call.Bucket, "photos")
+			}
+			if call.Offset != 6 || call.Length != 5 {
+				t.Fatalf("Offset/Length: got %d/%d, want 6/5", call.Offset, call.Length)
+			}
+			if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+				t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
 
 	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
 )
@@ -25,12 +26,29 @@ type writeObjectCall struct {
 	Chunks   [][]byte
 }
 
+type readObjectCall struct {
+	Metadata metadata.MD
+	ObjectID string
+	Bucket   string
+	Offset   int64
+	Length   int64
+}
+
+type copyObjectCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.CopyObjectRequest
+}
+
 type captureCradleService struct {
 	servicev1.UnimplementedCradleServiceServer
 
 	mu               sync.Mutex
 	writeObjectCalls []writeObjectCall
 	writeObjectHook  func(servicev1.CradleService_WriteObjectServer) error
+	readObjectCalls  []readObjectCall
+	readObjectHook   func(*servicev1.ReadObjectRequest, servicev1.CradleService_ReadObjectServer) error
+	copyObjectCalls  []copyObjectCall
+	copyObjectHook   func(*servicev1.CopyObjectRequest) (*servicev1.CopyObjectResponse, error)
 }
 
 func newCaptureCradleService() *captureCradleService {
@@ -40,6 +58,8 @@ func newCaptureCradleService() *captureCradleService {
 func (s *captureCradleService) Reset() {
 	s.mu.Lock()
 	s.writeObjectCalls = nil
+	s.readObjectCalls = nil
+	s.copyObjectCalls = nil
 	s.mu.Unlock()
 }
 
@@ -125,6 +145,79 @@ func (s *captureCradleService) WriteObject(stream servicev1.CradleService_WriteO
 	})
 }
 
+func (s *captureCradleService) LastReadObjectCall() (readObjectCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.readObjectCalls) == 0 {
+		return readObjectCall{}, false
+	}
+	return s.readObjectCalls[len(s.readObjectCalls)-1], true
+}
+
+func (s *captureCradleService) SetReadObjectHook(fn func(*servicev1.ReadObjectRequest, servicev1.CradleService_ReadObjectServer) error) {
+	s.mu.Lock()
+	s.readObjectHook = fn
+	s.mu.Unlock()
+}
+
+func (s *captureCradleService) ReadObject(req *servicev1.ReadObjectRequest, stream servicev1.CradleService_ReadObjectServer) error {
+	call := readObjectCall{
+		ObjectID: req.GetObjectId(),
+		Bucket:   req.GetBucket(),
+		Offset:   req.GetOffset(),
+		Length:   req.GetLength(),
+	}
+
+	if md, ok := metadata.FromIncomingContext(stream.Context()); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.readObjectCalls = append(s.readObjectCalls, call)
+	hook := s.readObjectHook
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(req, stream)
+	}
+
+	return stream.Send(&servicev1.ReadObjectResponse{Chunk: []byte("hello world")})
+}
+
+func (s *captureCradleService) LastCopyObjectCall() (copyObjectCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.copyObjectCalls) == 0 {
+		return copyObjectCall{}, false
+	}
+	return s.copyObjectCalls[len(s.copyObjectCalls)-1], true
+}
+
+func (s *captureCradleService) SetCopyObjectHook(fn func(*servicev1.CopyObjectRequest) (*servicev1.CopyObjectResponse, error)) {
+	s.mu.Lock()
+	s.copyObjectHook = fn
+	s.mu.Unlock()
+}
+
+func (s *captureCradleService) CopyObject(ctx context.Context, req *servicev1.CopyObjectRequest) (*servicev1.CopyObjectResponse, error) {
+	call := copyObjectCall{Request: proto.Clone(req).(*servicev1.CopyObjectRequest)}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.copyObjectCalls = append(s.copyObjectCalls, call)
+	hook := s.copyObjectHook
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(req)
+	}
+
+	return &servicev1.CopyObjectResponse{BytesWritten: req.GetSize(), CommittedAtMs: 1234567890}, nil
+}
+
 func newTestClient(t *testing.T) (*Client, *captureCradleService) {
 	t.Helper()
 
@@ -149,6 +242,7 @@ func newTestClient(t *testing.T) (*Client, *captureCradleService) {
 		return grpc.NewClient(address,
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
 			grpc.WithChainStreamInterceptor(requestIDStreamInterceptor()),
+			grpc.WithChainUnaryInterceptor(requestIDUnaryInterceptor()),
 			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
 				return lis.DialContext(ctx)
 			}))
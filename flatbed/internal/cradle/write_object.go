@@ -1,24 +1,81 @@
 package cradle
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
+	"errors"
 	"fmt"
+	"hash"
 	"io"
 
+	"github.com/ratdaddy/blockcloset/flatbed/internal/checksum"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/config"
 	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
 )
 
-func (c *Client) WriteObject(ctx context.Context, address, objectID, bucket string, size int64, body io.Reader) (int64, int64, error) {
+// ErrBadDigest reports that the streamed bytes don't match the Content-MD5
+// or x-amz-checksum-* value the client sent.
+var ErrBadDigest = errors.New("content digest mismatch")
+
+// ErrIncompleteBody reports that the body ended before, or ran past, the
+// size the client declared.
+var ErrIncompleteBody = errors.New("body length doesn't match the declared size")
+
+// Digests are the integrity checks a client sent with an upload.
+type Digests struct {
+	// MD5 is the decoded Content-MD5, or nil if none was sent.
+	MD5 []byte
+
+	// ChecksumAlgorithm names an additional checksum to compute, and
+	// Checksum is the base64 value it must match. Checksum is empty when
+	// the value arrives in an aws-chunked trailer, which the chunk decoder
+	// verifies instead.
+	ChecksumAlgorithm string
+	Checksum          string
+}
+
+// WriteResult describes a blob Cradle stored.
+type WriteResult struct {
+	BytesWritten   int64
+	LastModifiedMs int64
+
+	// MD5 is the digest of the bytes streamed to Cradle.
+	MD5 []byte
+	// Checksum is the base64 digest for Digests.ChecksumAlgorithm, or empty
+	// if none was requested.
+	Checksum string
+}
+
+// WriteObject streams body to the cradle at address, computing its MD5 and
+// any requested checksum on the way through. When a digest doesn't match
+// the one the client sent, or the body isn't size bytes long, the stream is
+// cancelled so Cradle discards the blob and ErrBadDigest or
+// ErrIncompleteBody is returned.
+func (c *Client) WriteObject(ctx context.Context, address, objectID, bucket string, size int64, body io.Reader, digests Digests) (WriteResult, error) {
+	digest := md5.New()
+	var sink io.Writer = digest
+	var extra hash.Hash
+	if digests.ChecksumAlgorithm != "" {
+		var ok bool
+		if extra, ok = checksum.New(digests.ChecksumAlgorithm); !ok {
+			return WriteResult{}, fmt.Errorf("unsupported checksum algorithm %q", digests.ChecksumAlgorithm)
+		}
+		sink = io.MultiWriter(digest, extra)
+	}
+
 	conn, err := c.pool.GetConn(ctx, address)
 	if err != nil {
-		return 0, 0, err
+		return WriteResult{}, err
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	serviceClient := servicev1.NewCradleServiceClient(conn)
 	stream, err := serviceClient.WriteObject(ctx)
 	if err != nil {
-		return 0, 0, err
+		return WriteResult{}, err
 	}
 
 	// Send metadata
@@ -32,7 +89,7 @@ func (c *Client) WriteObject(ctx context.Context, address, objectID, bucket stri
 		},
 	})
 	if err != nil {
-		return 0, 0, err
+		return WriteResult{}, err
 	}
 
 	// Stream chunks
@@ -42,31 +99,56 @@ func (c *Client) WriteObject(ctx context.Context, address, objectID, bucket stri
 		n, err := body.Read(buf)
 		if n > 0 {
 			totalBytesRead += int64(n)
+			if totalBytesRead > size {
+				return WriteResult{}, fmt.Errorf("%w: read more than %d bytes", ErrIncompleteBody, size)
+			}
+			sink.Write(buf[:n])
 			if err = stream.Send(&servicev1.WriteObjectRequest{
 				Payload: &servicev1.WriteObjectRequest_Chunk{
 					Chunk: buf[:n],
 				},
 			}); err != nil {
-				return 0, 0, err
+				return WriteResult{}, err
 			}
 		}
 		if err == io.EOF {
 			break
 		}
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return WriteResult{}, fmt.Errorf("%w: %v", ErrIncompleteBody, err)
+		}
 		if err != nil {
-			return 0, 0, err
+			return WriteResult{}, err
 		}
 	}
 
 	// Validate size matches what was declared
 	if totalBytesRead != size {
-		return 0, 0, fmt.Errorf("size mismatch: read %d bytes, expected %d", totalBytesRead, size)
+		return WriteResult{}, fmt.Errorf("%w: read %d bytes, expected %d", ErrIncompleteBody, totalBytesRead, size)
+	}
+
+	sum := digest.Sum(nil)
+	if digests.MD5 != nil && !bytes.Equal(sum, digests.MD5) {
+		return WriteResult{}, ErrBadDigest
+	}
+
+	var extraSum string
+	if extra != nil {
+		extraSum = checksum.Sum(extra)
+		if digests.Checksum != "" && extraSum != digests.Checksum {
+			return WriteResult{}, ErrBadDigest
+		}
 	}
 
 	resp, err := stream.CloseAndRecv()
 	if err != nil {
-		return 0, 0, err
+		return WriteResult{}, err
 	}
 
-	return resp.GetBytesWritten(), resp.GetCommittedAtMs(), nil
+	return WriteResult{
+		BytesWritten:   resp.GetBytesWritten(),
+		LastModifiedMs: resp.GetCommittedAtMs(),
+		MD5:            sum,
+		Checksum:       extraSum,
+	}, nil
 }
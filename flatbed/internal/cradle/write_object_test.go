@@ -3,8 +3,12 @@ package cradle
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"errors"
+	"io"
 	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
@@ -21,8 +25,12 @@ func TestClientWriteObject(t *testing.T) {
 		bucket           string
 		size             int64
 		body             string
+		bodyErr          error
+		digests          Digests
 		wantErr          bool
+		wantErrIs        error
 		wantBytesWritten int64
+		wantChecksum     string
 		wantChunkCount   int
 	}
 
@@ -37,13 +45,94 @@ func TestClientWriteObject(t *testing.T) {
 			wantChunkCount:   1,
 		},
 		{
-			name:     "size mismatch returns error",
-			objectID: "01JYYYYYYYYYYYYYYYYYYYYYYYYY",
+			name:             "matching content MD5",
+			objectID:         "01JZZZZZZZZZZZZZZZZZZZZZZZZZ",
+			bucket:           "photos",
+			size:             11,
+			body:             "hello world",
+			digests:          Digests{MD5: md5Sum("hello world")},
+			wantBytesWritten: 11,
+			wantChunkCount:   1,
+		},
+		{
+			name:      "short body returns ErrIncompleteBody",
+			objectID:  "01JYYYYYYYYYYYYYYYYYYYYYYYYY",
+			bucket:    "photos",
+			size:      100,
+			body:      "hello world",
+			wantErr:   true,
+			wantErrIs: ErrIncompleteBody,
+		},
+		{
+			name:      "long body returns ErrIncompleteBody",
+			objectID:  "01JSSSSSSSSSSSSSSSSSSSSSSSSS",
+			bucket:    "photos",
+			size:      5,
+			body:      "hello world",
+			wantErr:   true,
+			wantErrIs: ErrIncompleteBody,
+		},
+		{
+			name:      "truncated request body returns ErrIncompleteBody",
+			objectID:  "01JRRRRRRRRRRRRRRRRRRRRRRRRR",
+			bucket:    "photos",
+			size:      11,
+			body:      "hello",
+			bodyErr:   io.ErrUnexpectedEOF,
+			wantErr:   true,
+			wantErrIs: ErrIncompleteBody,
+		},
+		{
+			name:     "body read failure returns error",
+			objectID: "01JQQQQQQQQQQQQQQQQQQQQQQQQQ",
 			bucket:   "photos",
-			size:     100,
-			body:     "hello world",
+			size:     11,
+			body:     "hello",
+			bodyErr:  errors.New("connection reset"),
 			wantErr:  true,
 		},
+		{
+			name:      "content MD5 mismatch returns ErrBadDigest",
+			objectID:  "01JWWWWWWWWWWWWWWWWWWWWWWWWW",
+			bucket:    "photos",
+			size:      11,
+			body:      "hello world",
+			digests:   Digests{MD5: md5Sum("goodbye world")},
+			wantErr:   true,
+			wantErrIs: ErrBadDigest,
+		},
+		{
+			name:             "computes requested checksum without a value to check",
+			objectID:         "01JVVVVVVVVVVVVVVVVVVVVVVVVV",
+			bucket:           "photos",
+			size:             11,
+			body:             "hello world",
+			digests:          Digests{ChecksumAlgorithm: "CRC32C"},
+			wantBytesWritten: 11,
+			wantChecksum:     "yZRlqg==",
+			wantChunkCount:   1,
+		},
+		{
+			name:             "matching checksum",
+			objectID:         "01JUUUUUUUUUUUUUUUUUUUUUUUUU",
+			bucket:           "photos",
+			size:             11,
+			body:             "hello world",
+			digests:          Digests{ChecksumAlgorithm: "SHA256", Checksum: "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek="},
+			wantBytesWritten: 11,
+			wantChecksum:     "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek=",
+			wantChunkCount:   1,
+		},
+		{
+			name:      "checksum mismatch returns ErrBadDigest",
+			objectID:  "01JTTTTTTTTTTTTTTTTTTTTTTTTT",
+			bucket:    "photos",
+			size:      11,
+			body:      "hello world",
+			digests:   Digests{ChecksumAlgorithm: "CRC32", Checksum: "AAAAAA=="},
+			wantErr:   true,
+			wantErrIs: ErrBadDigest,
+		},
 	}
 
 	for _, c := range cases {
@@ -54,26 +143,43 @@ func TestClientWriteObject(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 			t.Cleanup(cancel)
 
-			bytesWritten, committedAtMs, err := client.WriteObject(
+			var body io.Reader = strings.NewReader(c.body)
+			if c.bodyErr != nil {
+				body = io.MultiReader(body, iotest.ErrReader(c.bodyErr))
+			}
+
+			result, err := client.WriteObject(
 				requestid.WithRequestID(ctx, "req-abc"),
-				address, c.objectID, c.bucket, c.size, strings.NewReader(c.body),
+				address, c.objectID, c.bucket, c.size, body, c.digests,
 			)
 
 			if c.wantErr {
 				if err == nil {
 					t.Fatal("WriteObject returned nil error, want error")
 				}
+				if c.wantErrIs != nil && !errors.Is(err, c.wantErrIs) {
+					t.Fatalf("WriteObject error: got %v, want %v", err, c.wantErrIs)
+				}
+				if calls := svc.WriteObjectCalls(); len(calls) != 0 {
+					t.Fatalf("cradle completed %d writes, want none", len(calls))
+				}
 				return
 			}
 			if err != nil {
 				t.Fatalf("WriteObject: %v", err)
 			}
 
-			if bytesWritten != c.wantBytesWritten {
-				t.Fatalf("bytesWritten: got %d, want %d", bytesWritten, c.wantBytesWritten)
+			if result.BytesWritten != c.wantBytesWritten {
+				t.Fatalf("bytesWritten: got %d, want %d", result.BytesWritten, c.wantBytesWritten)
 			}
-			if committedAtMs != 1234567890 {
-				t.Fatalf("committedAtMs: got %d, want 1234567890", committedAtMs)
+			if result.LastModifiedMs != 1234567890 {
+				t.Fatalf("committedAtMs: got %d, want 1234567890", result.LastModifiedMs)
+			}
+			if want := md5Sum(c.body); !bytes.Equal(result.MD5, want) {
+				t.Fatalf("MD5: got %x, want %x", result.MD5, want)
+			}
+			if result.Checksum != c.wantChecksum {
+				t.Fatalf("Checksum: got %q, want %q", result.Checksum, c.wantChecksum)
 			}
 
 			call, ok := svc.LastWriteObjectCall()
@@ -107,7 +213,7 @@ func TestClientWriteObject(t *testing.T) {
 
 			svc.Reset()
 
-			_, _, err = client.WriteObject(ctx, address, c.objectID, c.bucket, c.size, strings.NewReader(c.body))
+			_, err = client.WriteObject(ctx, address, c.objectID, c.bucket, c.size, strings.NewReader(c.body), Digests{})
 			if err != nil {
 				t.Fatalf("WriteObject (no request id): %v", err)
 			}
@@ -118,3 +224,8 @@ func TestClientWriteObject(t *testing.T) {
 		})
 	}
 }
+
+func md5Sum(s string) []byte {
+	sum := md5.Sum([]byte(s))
+	return sum[:]
+}
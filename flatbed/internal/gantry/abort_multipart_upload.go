@@ -0,0 +1,16 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (c *Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	_, err := c.svc.AbortMultipartUpload(ctx, &servicev1.AbortMultipartUploadRequest{
+		Bucket:   bucket,
+		Key:      key,
+		UploadId: uploadID,
+	})
+	return err
+}
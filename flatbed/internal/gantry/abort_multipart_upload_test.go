@@ -0,0 +1,33 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+)
+
+func TestClientAbortMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	if err := client.AbortMultipartUpload(requestid.WithRequestID(ctx, "req-abc"), "videos", "big.mp4", "upload-1"); err != nil {
+		t.Fatalf("AbortMultipartUpload: %v", err)
+	}
+
+	call, ok := svc.LastAbortMultipartUploadCall()
+	if !ok {
+		t.Fatal("no AbortMultipartUpload call recorded")
+	}
+	req := call.Request
+	if req.GetBucket() != "videos" || req.GetKey() != "big.mp4" || req.GetUploadId() != "upload-1" {
+		t.Fatalf("request = %v", req)
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
@@ -0,0 +1,27 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (c *Client) PutBucketACL(ctx context.Context, bucket, acl string) error {
+	_, err := c.svc.PutBucketAcl(ctx, &servicev1.PutBucketAclRequest{
+		Bucket: bucket,
+		Acl:    acl,
+	})
+	return err
+}
+
+func (c *Client) GetBucketACL(ctx context.Context, bucket string) (BucketACL, error) {
+	resp, err := c.svc.GetBucketAcl(ctx, &servicev1.GetBucketAclRequest{Bucket: bucket})
+	if err != nil {
+		return BucketACL{}, err
+	}
+	return BucketACL{
+		OwnerID:   resp.GetOwnerId(),
+		OwnerName: resp.GetOwnerName(),
+		ACL:       resp.GetAcl(),
+	}, nil
+}
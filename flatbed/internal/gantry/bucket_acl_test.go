@@ -0,0 +1,58 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientPutBucketACL(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	if err := client.PutBucketACL(ctx, "my-bucket", "public-read"); err != nil {
+		t.Fatalf("PutBucketACL: %v", err)
+	}
+
+	call, ok := svc.LastPutBucketAclCall()
+	if !ok {
+		t.Fatal("no PutBucketAcl call recorded")
+	}
+	if call.Request.GetBucket() != "my-bucket" || call.Request.GetAcl() != "public-read" {
+		t.Fatalf("request = %+v", call.Request)
+	}
+}
+
+func TestClientGetBucketACL(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetGetBucketAclHook(func(context.Context, *servicev1.GetBucketAclRequest) (*servicev1.GetBucketAclResponse, error) {
+		return &servicev1.GetBucketAclResponse{OwnerId: "user-alice", OwnerName: "alice", Acl: "authenticated-read"}, nil
+	})
+
+	got, err := client.GetBucketACL(ctx, "my-bucket")
+	if err != nil {
+		t.Fatalf("GetBucketACL: %v", err)
+	}
+	want := BucketACL{OwnerID: "user-alice", OwnerName: "alice", ACL: "authenticated-read"}
+	if got != want {
+		t.Fatalf("GetBucketACL = %+v, want %+v", got, want)
+	}
+
+	call, ok := svc.LastGetBucketAclCall()
+	if !ok {
+		t.Fatal("no GetBucketAcl call recorded")
+	}
+	if call.Request.GetBucket() != "my-bucket" {
+		t.Fatalf("request Bucket = %q, want my-bucket", call.Request.GetBucket())
+	}
+}
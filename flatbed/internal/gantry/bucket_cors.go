@@ -0,0 +1,65 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (c *Client) PutBucketCors(ctx context.Context, bucket string, rules []CORSRule) error {
+	req := &servicev1.PutBucketCorsRequest{
+		Bucket: bucket,
+		Rules:  make([]*servicev1.CorsRule, 0, len(rules)),
+	}
+	for _, r := range rules {
+		req.Rules = append(req.Rules, &servicev1.CorsRule{
+			Id:             r.ID,
+			AllowedOrigins: r.AllowedOrigins,
+			AllowedMethods: r.AllowedMethods,
+			AllowedHeaders: r.AllowedHeaders,
+			ExposeHeaders:  r.ExposeHeaders,
+			MaxAgeSeconds:  r.MaxAgeSeconds,
+		})
+	}
+
+	_, err := c.svc.PutBucketCors(ctx, req)
+	return err
+}
+
+func (c *Client) GetBucketCors(ctx context.Context, bucket string) ([]CORSRule, error) {
+	resp, err := c.svc.GetBucketCors(ctx, &servicev1.GetBucketCorsRequest{Bucket: bucket})
+	if err != nil {
+		return nil, err
+	}
+	return corsRulesFromProto(resp.GetRules()), nil
+}
+
+func (c *Client) DeleteBucketCors(ctx context.Context, bucket string) error {
+	_, err := c.svc.DeleteBucketCors(ctx, &servicev1.DeleteBucketCorsRequest{Bucket: bucket})
+	return err
+}
+
+// LookupBucketCors returns the bucket's CORS rules whoever the caller is, or
+// no rules when it has no CORS configuration.
+func (c *Client) LookupBucketCors(ctx context.Context, bucket string) ([]CORSRule, error) {
+	resp, err := c.svc.LookupBucketCors(ctx, &servicev1.LookupBucketCorsRequest{Bucket: bucket})
+	if err != nil {
+		return nil, err
+	}
+	return corsRulesFromProto(resp.GetRules()), nil
+}
+
+func corsRulesFromProto(rules []*servicev1.CorsRule) []CORSRule {
+	out := make([]CORSRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, CORSRule{
+			ID:             r.GetId(),
+			AllowedOrigins: r.GetAllowedOrigins(),
+			AllowedMethods: r.GetAllowedMethods(),
+			AllowedHeaders: r.GetAllowedHeaders(),
+			ExposeHeaders:  r.GetExposeHeaders(),
+			MaxAgeSeconds:  r.GetMaxAgeSeconds(),
+		})
+	}
+	return out
+}
@@ -0,0 +1,123 @@
+package gantry
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientPutBucketCors(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	rules := []CORSRule{
+		{ID: "gallery", AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET", "HEAD"}, AllowedHeaders: []string{"*"}, ExposeHeaders: []string{"ETag"}, MaxAgeSeconds: 3000},
+	}
+	if err := client.PutBucketCors(ctx, "my-bucket", rules); err != nil {
+		t.Fatalf("PutBucketCors: %v", err)
+	}
+
+	call, ok := svc.LastPutBucketCorsCall()
+	if !ok {
+		t.Fatal("no PutBucketCors call recorded")
+	}
+	want := &servicev1.PutBucketCorsRequest{
+		Bucket: "my-bucket",
+		Rules: []*servicev1.CorsRule{
+			{Id: "gallery", AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET", "HEAD"}, AllowedHeaders: []string{"*"}, ExposeHeaders: []string{"ETag"}, MaxAgeSeconds: 3000},
+		},
+	}
+	if !proto.Equal(call.Request, want) {
+		t.Fatalf("request = %v, want %v", call.Request, want)
+	}
+}
+
+func TestClientGetBucketCors(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetGetBucketCorsHook(func(context.Context, *servicev1.GetBucketCorsRequest) (*servicev1.GetBucketCorsResponse, error) {
+		return &servicev1.GetBucketCorsResponse{Rules: []*servicev1.CorsRule{
+			{Id: "gallery", AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, MaxAgeSeconds: 60},
+		}}, nil
+	})
+
+	got, err := client.GetBucketCors(ctx, "my-bucket")
+	if err != nil {
+		t.Fatalf("GetBucketCors: %v", err)
+	}
+	want := []CORSRule{{ID: "gallery", AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, MaxAgeSeconds: 60}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("GetBucketCors = %+v, want %+v", got, want)
+	}
+
+	call, ok := svc.LastGetBucketCorsCall()
+	if !ok {
+		t.Fatal("no GetBucketCors call recorded")
+	}
+	if call.Request.GetBucket() != "my-bucket" {
+		t.Fatalf("request Bucket = %q, want my-bucket", call.Request.GetBucket())
+	}
+}
+
+func TestClientDeleteBucketCors(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	if err := client.DeleteBucketCors(ctx, "my-bucket"); err != nil {
+		t.Fatalf("DeleteBucketCors: %v", err)
+	}
+
+	call, ok := svc.LastDeleteBucketCorsCall()
+	if !ok {
+		t.Fatal("no DeleteBucketCors call recorded")
+	}
+	if call.Request.GetBucket() != "my-bucket" {
+		t.Fatalf("request Bucket = %q, want my-bucket", call.Request.GetBucket())
+	}
+}
+
+func TestClientLookupBucketCors(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetLookupBucketCorsHook(func(context.Context, *servicev1.LookupBucketCorsRequest) (*servicev1.LookupBucketCorsResponse, error) {
+		return &servicev1.LookupBucketCorsResponse{Rules: []*servicev1.CorsRule{
+			{AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET"}, ExposeHeaders: []string{"ETag"}},
+		}}, nil
+	})
+
+	got, err := client.LookupBucketCors(ctx, "my-bucket")
+	if err != nil {
+		t.Fatalf("LookupBucketCors: %v", err)
+	}
+	want := []CORSRule{{AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET"}, ExposeHeaders: []string{"ETag"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("LookupBucketCors = %+v, want %+v", got, want)
+	}
+
+	call, ok := svc.LastLookupBucketCorsCall()
+	if !ok {
+		t.Fatal("no LookupBucketCors call recorded")
+	}
+	if call.Request.GetBucket() != "my-bucket" {
+		t.Fatalf("request Bucket = %q, want my-bucket", call.Request.GetBucket())
+	}
+}
@@ -0,0 +1,71 @@
+package gantry
+
+import (
+	"context"
+	"time"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (c *Client) PutBucketLifecycleConfiguration(ctx context.Context, bucket string, rules []LifecycleRule) error {
+	req := &servicev1.PutBucketLifecycleConfigurationRequest{
+		Bucket: bucket,
+		Rules:  make([]*servicev1.LifecycleRule, 0, len(rules)),
+	}
+	for _, r := range rules {
+		rule := &servicev1.LifecycleRule{
+			Id:                        r.ID,
+			Enabled:                   r.Enabled,
+			Prefix:                    r.Prefix,
+			Tags:                      r.Tags,
+			ExpirationDays:            r.ExpirationDays,
+			ExpiredObjectDeleteMarker: r.ExpiredObjectDeleteMarker,
+			NoncurrentDays:            r.NoncurrentDays,
+			NewerNoncurrentVersions:   r.NewerNoncurrentVersions,
+		}
+		if !r.ExpirationDate.IsZero() {
+			rule.ExpirationDateRfc3339 = r.ExpirationDate.UTC().Format(time.RFC3339)
+		}
+		req.Rules = append(req.Rules, rule)
+	}
+
+	_, err := c.svc.PutBucketLifecycleConfiguration(ctx, req)
+	return err
+}
+
+func (c *Client) GetBucketLifecycleConfiguration(ctx context.Context, bucket string) ([]LifecycleRule, error) {
+	resp, err := c.svc.GetBucketLifecycleConfiguration(ctx, &servicev1.GetBucketLifecycleConfigurationRequest{Bucket: bucket})
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]LifecycleRule, 0, len(resp.GetRules()))
+	for _, r := range resp.GetRules() {
+		var date time.Time
+		if ts := r.GetExpirationDateRfc3339(); ts != "" {
+			date, err = time.Parse(time.RFC3339, ts)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		rules = append(rules, LifecycleRule{
+			ID:                        r.GetId(),
+			Enabled:                   r.GetEnabled(),
+			Prefix:                    r.GetPrefix(),
+			Tags:                      r.GetTags(),
+			ExpirationDays:            r.GetExpirationDays(),
+			ExpirationDate:            date,
+			ExpiredObjectDeleteMarker: r.GetExpiredObjectDeleteMarker(),
+			NoncurrentDays:            r.GetNoncurrentDays(),
+			NewerNoncurrentVersions:   r.GetNewerNoncurrentVersions(),
+		})
+	}
+
+	return rules, nil
+}
+
+func (c *Client) DeleteBucketLifecycle(ctx context.Context, bucket string) error {
+	_, err := c.svc.DeleteBucketLifecycle(ctx, &servicev1.DeleteBucketLifecycleRequest{Bucket: bucket})
+	return err
+}
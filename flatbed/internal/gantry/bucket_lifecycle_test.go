@@ -0,0 +1,98 @@
+package gantry
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientPutBucketLifecycleConfiguration(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	rules := []LifecycleRule{
+		{ID: "footage", Enabled: true, Prefix: "footage/", ExpirationDays: 30},
+		{Tags: map[string]string{"retain": "short"}, ExpirationDate: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), NoncurrentDays: 7, NewerNoncurrentVersions: 2},
+	}
+	if err := client.PutBucketLifecycleConfiguration(ctx, "my-bucket", rules); err != nil {
+		t.Fatalf("PutBucketLifecycleConfiguration: %v", err)
+	}
+
+	call, ok := svc.LastPutBucketLifecycleCall()
+	if !ok {
+		t.Fatal("no PutBucketLifecycleConfiguration call recorded")
+	}
+	want := &servicev1.PutBucketLifecycleConfigurationRequest{
+		Bucket: "my-bucket",
+		Rules: []*servicev1.LifecycleRule{
+			{Id: "footage", Enabled: true, Prefix: "footage/", ExpirationDays: 30},
+			{Tags: map[string]string{"retain": "short"}, ExpirationDateRfc3339: "2026-01-01T00:00:00Z", NoncurrentDays: 7, NewerNoncurrentVersions: 2},
+		},
+	}
+	if !proto.Equal(call.Request, want) {
+		t.Fatalf("request = %v, want %v", call.Request, want)
+	}
+}
+
+func TestClientGetBucketLifecycleConfiguration(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetGetBucketLifecycleHook(func(context.Context, *servicev1.GetBucketLifecycleConfigurationRequest) (*servicev1.GetBucketLifecycleConfigurationResponse, error) {
+		return &servicev1.GetBucketLifecycleConfigurationResponse{Rules: []*servicev1.LifecycleRule{
+			{Id: "footage", Enabled: true, Prefix: "footage/", ExpirationDays: 30},
+			{ExpirationDateRfc3339: "2026-01-01T00:00:00Z"},
+		}}, nil
+	})
+
+	got, err := client.GetBucketLifecycleConfiguration(ctx, "my-bucket")
+	if err != nil {
+		t.Fatalf("GetBucketLifecycleConfiguration: %v", err)
+	}
+	want := []LifecycleRule{
+		{ID: "footage", Enabled: true, Prefix: "footage/", ExpirationDays: 30},
+		{ExpirationDate: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("GetBucketLifecycleConfiguration = %+v, want %+v", got, want)
+	}
+
+	call, ok := svc.LastGetBucketLifecycleCall()
+	if !ok {
+		t.Fatal("no GetBucketLifecycleConfiguration call recorded")
+	}
+	if call.Request.GetBucket() != "my-bucket" {
+		t.Fatalf("request Bucket = %q, want my-bucket", call.Request.GetBucket())
+	}
+}
+
+func TestClientDeleteBucketLifecycle(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	if err := client.DeleteBucketLifecycle(ctx, "my-bucket"); err != nil {
+		t.Fatalf("DeleteBucketLifecycle: %v", err)
+	}
+
+	call, ok := svc.LastDeleteBucketLifecycleCall()
+	if !ok {
+		t.Fatal("no DeleteBucketLifecycle call recorded")
+	}
+	if call.Request.GetBucket() != "my-bucket" {
+		t.Fatalf("request Bucket = %q, want my-bucket", call.Request.GetBucket())
+	}
+}
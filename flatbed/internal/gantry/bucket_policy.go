@@ -0,0 +1,28 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (c *Client) PutBucketPolicy(ctx context.Context, bucket, policy string) error {
+	_, err := c.svc.PutBucketPolicy(ctx, &servicev1.PutBucketPolicyRequest{
+		Bucket: bucket,
+		Policy: policy,
+	})
+	return err
+}
+
+func (c *Client) GetBucketPolicy(ctx context.Context, bucket string) (string, error) {
+	resp, err := c.svc.GetBucketPolicy(ctx, &servicev1.GetBucketPolicyRequest{Bucket: bucket})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetPolicy(), nil
+}
+
+func (c *Client) DeleteBucketPolicy(ctx context.Context, bucket string) error {
+	_, err := c.svc.DeleteBucketPolicy(ctx, &servicev1.DeleteBucketPolicyRequest{Bucket: bucket})
+	return err
+}
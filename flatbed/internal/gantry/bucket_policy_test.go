@@ -0,0 +1,88 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/identity"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+const testPolicy = `{"Version":"2012-10-17","Statement":[]}`
+
+func TestClientPutBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	ctx = identity.WithUserID(requestid.WithRequestID(ctx, "req-abc"), "user-alice")
+	if err := client.PutBucketPolicy(ctx, "my-bucket", testPolicy); err != nil {
+		t.Fatalf("PutBucketPolicy: %v", err)
+	}
+
+	call, ok := svc.LastPutBucketPolicyCall()
+	if !ok {
+		t.Fatal("no PutBucketPolicy call recorded")
+	}
+	if call.Request.GetBucket() != "my-bucket" || call.Request.GetPolicy() != testPolicy {
+		t.Fatalf("request = %+v", call.Request)
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+	if meta := call.Metadata.Get("x-blockcloset-user-id"); len(meta) != 1 || meta[0] != "user-alice" {
+		t.Fatalf("x-blockcloset-user-id = %v, want [user-alice]", meta)
+	}
+}
+
+func TestClientGetBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetGetBucketPolicyHook(func(context.Context, *servicev1.GetBucketPolicyRequest) (*servicev1.GetBucketPolicyResponse, error) {
+		return &servicev1.GetBucketPolicyResponse{Policy: testPolicy}, nil
+	})
+
+	got, err := client.GetBucketPolicy(ctx, "my-bucket")
+	if err != nil {
+		t.Fatalf("GetBucketPolicy: %v", err)
+	}
+	if got != testPolicy {
+		t.Fatalf("GetBucketPolicy = %q, want %q", got, testPolicy)
+	}
+
+	call, ok := svc.LastGetBucketPolicyCall()
+	if !ok {
+		t.Fatal("no GetBucketPolicy call recorded")
+	}
+	if call.Request.GetBucket() != "my-bucket" {
+		t.Fatalf("request Bucket = %q, want my-bucket", call.Request.GetBucket())
+	}
+}
+
+func TestClientDeleteBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	if err := client.DeleteBucketPolicy(ctx, "my-bucket"); err != nil {
+		t.Fatalf("DeleteBucketPolicy: %v", err)
+	}
+
+	call, ok := svc.LastDeleteBucketPolicyCall()
+	if !ok {
+		t.Fatal("no DeleteBucketPolicy call recorded")
+	}
+	if call.Request.GetBucket() != "my-bucket" {
+		t.Fatalf("request Bucket = %q, want my-bucket", call.Request.GetBucket())
+	}
+}
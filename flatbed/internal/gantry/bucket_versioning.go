@@ -0,0 +1,25 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (c *Client) PutBucketVersioning(ctx context.Context, bucket, status string) error {
+	_, err := c.svc.PutBucketVersioning(ctx, &servicev1.PutBucketVersioningRequest{
+		Bucket: bucket,
+		Status: status,
+	})
+	return err
+}
+
+// GetBucketVersioning returns "Enabled", "Suspended", or "" for a bucket
+// that has never had versioning configured.
+func (c *Client) GetBucketVersioning(ctx context.Context, bucket string) (string, error) {
+	resp, err := c.svc.GetBucketVersioning(ctx, &servicev1.GetBucketVersioningRequest{Bucket: bucket})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetStatus(), nil
+}
@@ -0,0 +1,57 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientPutBucketVersioning(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	if err := client.PutBucketVersioning(ctx, "my-bucket", "Enabled"); err != nil {
+		t.Fatalf("PutBucketVersioning: %v", err)
+	}
+
+	call, ok := svc.LastPutBucketVersioningCall()
+	if !ok {
+		t.Fatal("no PutBucketVersioning call recorded")
+	}
+	if call.Request.GetBucket() != "my-bucket" || call.Request.GetStatus() != "Enabled" {
+		t.Fatalf("request = %+v", call.Request)
+	}
+}
+
+func TestClientGetBucketVersioning(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetGetBucketVersioningHook(func(context.Context, *servicev1.GetBucketVersioningRequest) (*servicev1.GetBucketVersioningResponse, error) {
+		return &servicev1.GetBucketVersioningResponse{Status: "Suspended"}, nil
+	})
+
+	got, err := client.GetBucketVersioning(ctx, "my-bucket")
+	if err != nil {
+		t.Fatalf("GetBucketVersioning: %v", err)
+	}
+	if got != "Suspended" {
+		t.Fatalf("GetBucketVersioning = %q, want Suspended", got)
+	}
+
+	call, ok := svc.LastGetBucketVersioningCall()
+	if !ok {
+		t.Fatal("no GetBucketVersioning call recorded")
+	}
+	if call.Request.GetBucket() != "my-bucket" {
+		t.Fatalf("request Bucket = %q, want my-bucket", call.Request.GetBucket())
+	}
+}
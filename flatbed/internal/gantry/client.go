@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/ratdaddy/blockcloset/flatbed/internal/identity"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
 	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
 )
@@ -19,7 +20,7 @@ type Client struct {
 func New(ctx context.Context, address string, opts ...grpc.DialOption) (*Client, error) {
 	dialOpts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithChainUnaryInterceptor(requestIDUnaryInterceptor()),
+		grpc.WithChainUnaryInterceptor(requestIDUnaryInterceptor(), callerUnaryInterceptor()),
 	}
 
 	dialOpts = append(dialOpts, opts...)
@@ -41,6 +42,17 @@ func requestIDUnaryInterceptor() grpc.UnaryClientInterceptor {
 	}
 }
 
+// callerUnaryInterceptor tells gantry which user an authenticated request
+// is made on behalf of.
+func callerUnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if id := identity.UserIDFromContext(ctx); id != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "x-blockcloset-user-id", id)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
 func (c *Client) Close() error {
 	if c.cc != nil {
 		return c.cc.Close()
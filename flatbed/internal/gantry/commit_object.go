@@ -6,11 +6,22 @@ import (
 	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
 )
 
-func (c *Client) CommitObject(ctx context.Context, objectID string, size int64, lastModifiedMs int64) error {
-	_, err := c.svc.CommitObject(ctx, &servicev1.CommitObjectRequest{
-		ObjectId:       objectID,
-		Size:           size,
-		LastModifiedMs: lastModifiedMs,
+// CommitObject promotes a written object to COMMITTED, recording its ETag
+// and checksum. With commit.IfNoneMatch set, Gantry refuses the commit if
+// the key already has a committed version. It returns the new version ID,
+// which is empty if the bucket was never versioned.
+func (c *Client) CommitObject(ctx context.Context, objectID string, commit ObjectCommit) (string, error) {
+	resp, err := c.svc.CommitObject(ctx, &servicev1.CommitObjectRequest{
+		ObjectId:          objectID,
+		Size:              commit.Size,
+		LastModifiedMs:    commit.LastModifiedMs,
+		IfNoneMatch:       commit.IfNoneMatch,
+		Etag:              commit.ETag,
+		ChecksumAlgorithm: commit.ChecksumAlgorithm,
+		Checksum:          commit.Checksum,
 	})
-	return err
+	if err != nil {
+		return "", err
+	}
+	return resp.GetVersionId(), nil
 }
@@ -19,11 +19,25 @@ func TestClientCommitObject(t *testing.T) {
 		objectID         = "test-object-id"
 		size      int64  = 1024
 		lastModMs int64  = 1234567890000
+		etag             = "5eb63bbbe01eeed093cb22bb8f5acdc3"
+		checksum         = "DUoRhQ=="
 	)
 
-	if err := client.CommitObject(requestid.WithRequestID(ctx, "req-abc"), objectID, size, lastModMs); err != nil {
+	commit := ObjectCommit{
+		Size:              size,
+		LastModifiedMs:    lastModMs,
+		ETag:              etag,
+		ChecksumAlgorithm: "CRC32",
+		Checksum:          checksum,
+		IfNoneMatch:       true,
+	}
+	versionID, err := client.CommitObject(requestid.WithRequestID(ctx, "req-abc"), objectID, commit)
+	if err != nil {
 		t.Fatalf("CommitObject: %v", err)
 	}
+	if versionID != "test-version-1" {
+		t.Fatalf("versionID = %q, want test-version-1", versionID)
+	}
 
 	call, ok := svc.LastCommitObjectCall()
 	if !ok {
@@ -38,6 +52,15 @@ func TestClientCommitObject(t *testing.T) {
 	if call.Request.GetLastModifiedMs() != lastModMs {
 		t.Fatalf("request LastModifiedMs = %d, want %d", call.Request.GetLastModifiedMs(), lastModMs)
 	}
+	if call.Request.GetEtag() != etag {
+		t.Fatalf("request Etag = %q, want %q", call.Request.GetEtag(), etag)
+	}
+	if call.Request.GetChecksumAlgorithm() != "CRC32" || call.Request.GetChecksum() != checksum {
+		t.Fatalf("request checksum = %s %q, want CRC32 %q", call.Request.GetChecksumAlgorithm(), call.Request.GetChecksum(), checksum)
+	}
+	if !call.Request.GetIfNoneMatch() {
+		t.Fatal("request IfNoneMatch = false, want true")
+	}
 	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
 		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
 	}
@@ -0,0 +1,22 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// CommitPart records a written part blob along with etag, the hex MD5 of
+// its bytes, and returns the part's ETag.
+func (c *Client) CommitPart(ctx context.Context, blobID string, size int64, lastModifiedMs int64, etag string) (string, error) {
+	resp, err := c.svc.CommitPart(ctx, &servicev1.CommitPartRequest{
+		BlobId:         blobID,
+		Size:           size,
+		LastModifiedMs: lastModifiedMs,
+		Etag:           etag,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetEtag(), nil
+}
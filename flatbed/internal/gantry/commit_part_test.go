@@ -0,0 +1,39 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+)
+
+func TestClientCommitPart(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	const md5Hex = "5eb63bbbe01eeed093cb22bb8f5acdc3"
+
+	etag, err := client.CommitPart(requestid.WithRequestID(ctx, "req-abc"), "blob-1", 1024, 1735689600000, md5Hex)
+	if err != nil {
+		t.Fatalf("CommitPart: %v", err)
+	}
+	if etag != md5Hex {
+		t.Fatalf("etag = %q, want %q", etag, md5Hex)
+	}
+
+	call, ok := svc.LastCommitPartCall()
+	if !ok {
+		t.Fatal("no CommitPart call recorded")
+	}
+	req := call.Request
+	if req.GetBlobId() != "blob-1" || req.GetSize() != 1024 || req.GetLastModifiedMs() != 1735689600000 || req.GetEtag() != md5Hex {
+		t.Fatalf("request = %v", req)
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
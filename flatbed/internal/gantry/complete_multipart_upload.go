@@ -0,0 +1,29 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// CompleteMultipartUpload assembles parts into the object and returns its ETag
+// and version ID.
+func (c *Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string, parts []CompletedPart) (etag, versionID string, err error) {
+	req := &servicev1.CompleteMultipartUploadRequest{
+		Bucket:   bucket,
+		Key:      key,
+		UploadId: uploadID,
+	}
+	for _, p := range parts {
+		req.Parts = append(req.Parts, &servicev1.CompletedPart{
+			PartNumber: p.PartNumber,
+			Etag:       p.ETag,
+		})
+	}
+
+	resp, err := c.svc.CompleteMultipartUpload(ctx, req)
+	if err != nil {
+		return "", "", err
+	}
+	return resp.GetEtag(), resp.GetVersionId(), nil
+}
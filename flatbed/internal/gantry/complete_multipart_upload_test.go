@@ -0,0 +1,53 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+)
+
+func TestClientCompleteMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	parts := []CompletedPart{
+		{PartNumber: 1, ETag: `"blob-1"`},
+		{PartNumber: 2, ETag: `"blob-2"`},
+	}
+
+	etag, versionID, err := client.CompleteMultipartUpload(requestid.WithRequestID(ctx, "req-abc"), "videos", "big.mp4", "upload-1", parts)
+	if err != nil {
+		t.Fatalf("CompleteMultipartUpload: %v", err)
+	}
+	if etag != "test-etag-2" {
+		t.Fatalf("etag = %q, want %q", etag, "test-etag-2")
+	}
+	if versionID != "test-version-2" {
+		t.Fatalf("versionID = %q, want %q", versionID, "test-version-2")
+	}
+
+	call, ok := svc.LastCompleteMultipartUploadCall()
+	if !ok {
+		t.Fatal("no CompleteMultipartUpload call recorded")
+	}
+	req := call.Request
+	if req.GetBucket() != "videos" || req.GetKey() != "big.mp4" || req.GetUploadId() != "upload-1" {
+		t.Fatalf("request = %v", req)
+	}
+	if len(req.GetParts()) != len(parts) {
+		t.Fatalf("parts = %v, want %d", req.GetParts(), len(parts))
+	}
+	for i, p := range req.GetParts() {
+		if p.GetPartNumber() != parts[i].PartNumber || p.GetEtag() != parts[i].ETag {
+			t.Fatalf("part %d = %v, want %+v", i, p, parts[i])
+		}
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
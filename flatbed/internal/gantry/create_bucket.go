@@ -6,8 +6,11 @@ import (
 	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
 )
 
-func (c *Client) CreateBucket(ctx context.Context, name string) (string, error) {
-	resp, err := c.svc.CreateBucket(ctx, &servicev1.CreateBucketRequest{Name: name})
+// CreateBucket creates a bucket owned by the caller with the given canned
+// ACL; an empty acl means private. objectLock also enables object lock, and
+// with it versioning.
+func (c *Client) CreateBucket(ctx context.Context, name, acl string, objectLock bool) (string, error) {
+	resp, err := c.svc.CreateBucket(ctx, &servicev1.CreateBucketRequest{Name: name, Acl: acl, ObjectLockEnabled: objectLock})
 	if err != nil {
 		return "", err
 	}
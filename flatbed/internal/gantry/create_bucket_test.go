@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ratdaddy/blockcloset/flatbed/internal/identity"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
 )
 
@@ -17,7 +18,8 @@ func TestClientCreateBucket(t *testing.T) {
 
 	const name = "test-bucket"
 
-	gotName, err := client.CreateBucket(requestid.WithRequestID(ctx, "req-abc"), name)
+	ctx = identity.WithUserID(requestid.WithRequestID(ctx, "req-abc"), "user-alice")
+	gotName, err := client.CreateBucket(ctx, name, "public-read", true)
 	if err != nil {
 		t.Fatalf("CreateBucket: %v", err)
 	}
@@ -32,7 +34,16 @@ func TestClientCreateBucket(t *testing.T) {
 	if call.Request.GetName() != name {
 		t.Fatalf("request Name = %q, want %q", call.Request.GetName(), name)
 	}
+	if call.Request.GetAcl() != "public-read" {
+		t.Fatalf("request Acl = %q, want public-read", call.Request.GetAcl())
+	}
+	if !call.Request.GetObjectLockEnabled() {
+		t.Fatal("request ObjectLockEnabled = false, want true")
+	}
 	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
 		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
 	}
+	if meta := call.Metadata.Get("x-blockcloset-user-id"); len(meta) != 1 || meta[0] != "user-alice" {
+		t.Fatalf("x-blockcloset-user-id = %v, want [user-alice]", meta)
+	}
 }
@@ -0,0 +1,20 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (c *Client) CreateMultipartUpload(ctx context.Context, bucket, key, contentType string, metadata ObjectMetadata) (string, error) {
+	resp, err := c.svc.CreateMultipartUpload(ctx, &servicev1.CreateMultipartUploadRequest{
+		Bucket:      bucket,
+		Key:         key,
+		ContentType: contentType,
+		Metadata:    metadataProto(metadata),
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetUploadId(), nil
+}
@@ -0,0 +1,40 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+)
+
+func TestClientCreateMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	uploadID, err := client.CreateMultipartUpload(requestid.WithRequestID(ctx, "req-abc"), "videos", "big.mp4", "video/mp4",
+		ObjectMetadata{CacheControl: "max-age=60", User: map[string]string{"camera": "front"}})
+	if err != nil {
+		t.Fatalf("CreateMultipartUpload: %v", err)
+	}
+	if uploadID != "test-upload-id" {
+		t.Fatalf("upload id = %q, want %q", uploadID, "test-upload-id")
+	}
+
+	call, ok := svc.LastCreateMultipartUploadCall()
+	if !ok {
+		t.Fatal("no CreateMultipartUpload call recorded")
+	}
+	if call.Request.GetBucket() != "videos" || call.Request.GetKey() != "big.mp4" || call.Request.GetContentType() != "video/mp4" {
+		t.Fatalf("request = %v", call.Request)
+	}
+	if md := call.Request.GetMetadata(); md.GetCacheControl() != "max-age=60" || md.GetUserMetadata()["camera"] != "front" {
+		t.Fatalf("metadata = %v", md)
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
@@ -0,0 +1,14 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (c *Client) DeleteBucket(ctx context.Context, name string) error {
+	_, err := c.svc.DeleteBucket(ctx, &servicev1.DeleteBucketRequest{
+		Name: name,
+	})
+	return err
+}
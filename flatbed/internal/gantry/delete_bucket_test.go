@@ -0,0 +1,37 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+)
+
+func TestClientDeleteBucket(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	const bucket = "photos"
+
+	if err := client.DeleteBucket(requestid.WithRequestID(ctx, "req-abc"), bucket); err != nil {
+		t.Fatalf("DeleteBucket: %v", err)
+	}
+
+	call, ok := svc.LastDeleteBucketCall()
+	if !ok {
+		t.Fatal("no DeleteBucket call recorded")
+	}
+	if call.Request.GetName() != bucket {
+		t.Fatalf("request Name = %q, want %q", call.Request.GetName(), bucket)
+	}
+	if call.Request.GetForce() {
+		t.Fatal("request Force = true, want false")
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
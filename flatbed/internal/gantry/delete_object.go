@@ -0,0 +1,28 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// DeleteObject deletes the current version of the object, leaving a delete
+// marker in a versioned bucket, or permanently removes the version named by
+// versionID when it is non-empty. bypassGovernance asks to remove a version
+// under a GOVERNANCE retention.
+func (c *Client) DeleteObject(ctx context.Context, bucket, key, versionID string, bypassGovernance bool) (ObjectDeletion, error) {
+	resp, err := c.svc.DeleteObject(ctx, &servicev1.DeleteObjectRequest{
+		Bucket:    bucket,
+		Key:       key,
+		VersionId: versionID,
+
+		BypassGovernanceRetention: bypassGovernance,
+	})
+	if err != nil {
+		return ObjectDeletion{}, err
+	}
+	return ObjectDeletion{
+		VersionID:    resp.GetVersionId(),
+		DeleteMarker: resp.GetDeleteMarker(),
+	}, nil
+}
@@ -0,0 +1,83 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientDeleteObject(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	const (
+		bucket = "photos"
+		key    = "vacation/sunset.jpg"
+	)
+
+	got, err := client.DeleteObject(requestid.WithRequestID(ctx, "req-abc"), bucket, key, "", false)
+	if err != nil {
+		t.Fatalf("DeleteObject: %v", err)
+	}
+	if got != (ObjectDeletion{}) {
+		t.Fatalf("DeleteObject = %+v, want zero value", got)
+	}
+
+	call, ok := svc.LastDeleteObjectCall()
+	if !ok {
+		t.Fatal("no DeleteObject call recorded")
+	}
+	if call.Request.GetBucket() != bucket {
+		t.Fatalf("request Bucket = %q, want %q", call.Request.GetBucket(), bucket)
+	}
+	if call.Request.GetKey() != key {
+		t.Fatalf("request Key = %q, want %q", call.Request.GetKey(), key)
+	}
+	if call.Request.GetBypassGovernanceRetention() {
+		t.Fatal("request BypassGovernanceRetention = true, want false")
+	}
+	if call.Request.GetVersionId() != "" {
+		t.Fatalf("request VersionId = %q, want empty", call.Request.GetVersionId())
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
+
+func TestClientDeleteObjectVersion(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetDeleteObjectHook(func(_ context.Context, req *servicev1.DeleteObjectRequest) (*servicev1.DeleteObjectResponse, error) {
+		return &servicev1.DeleteObjectResponse{VersionId: req.GetVersionId(), DeleteMarker: true}, nil
+	})
+
+	got, err := client.DeleteObject(ctx, "photos", "sunset.jpg", "01JMARKERXXXXXXXXXXXXXXXXX", true)
+	if err != nil {
+		t.Fatalf("DeleteObject: %v", err)
+	}
+	want := ObjectDeletion{VersionID: "01JMARKERXXXXXXXXXXXXXXXXX", DeleteMarker: true}
+	if got != want {
+		t.Fatalf("DeleteObject = %+v, want %+v", got, want)
+	}
+
+	call, ok := svc.LastDeleteObjectCall()
+	if !ok {
+		t.Fatal("no DeleteObject call recorded")
+	}
+	if call.Request.GetVersionId() != want.VersionID {
+		t.Fatalf("request VersionId = %q, want %q", call.Request.GetVersionId(), want.VersionID)
+	}
+	if !call.Request.GetBypassGovernanceRetention() {
+		t.Fatal("request BypassGovernanceRetention = false, want true")
+	}
+}
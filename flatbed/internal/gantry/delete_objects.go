@@ -0,0 +1,41 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// DeleteObjects deletes many keys or versions of a bucket in one call, each
+// as DeleteObject would. Objects that can't be deleted are reported in the
+// result's Errors rather than failing the call.
+func (c *Client) DeleteObjects(ctx context.Context, bucket string, objects []ObjectIdentifier, bypassGovernance bool) (ObjectDeletions, error) {
+	req := &servicev1.DeleteObjectsRequest{
+		Bucket:  bucket,
+		Objects: make([]*servicev1.ObjectIdentifier, 0, len(objects)),
+
+		BypassGovernanceRetention: bypassGovernance,
+	}
+	for _, obj := range objects {
+		req.Objects = append(req.Objects, &servicev1.ObjectIdentifier{Key: obj.Key, VersionId: obj.VersionID})
+	}
+
+	resp, err := c.svc.DeleteObjects(ctx, req)
+	if err != nil {
+		return ObjectDeletions{}, err
+	}
+
+	var out ObjectDeletions
+	for _, d := range resp.GetDeleted() {
+		out.Deleted = append(out.Deleted, DeletedObject{
+			Key:                   d.GetKey(),
+			VersionID:             d.GetVersionId(),
+			DeleteMarker:          d.GetDeleteMarker(),
+			DeleteMarkerVersionID: d.GetDeleteMarkerVersionId(),
+		})
+	}
+	for _, e := range resp.GetErrors() {
+		out.Errors = append(out.Errors, DeleteObjectError{Key: e.GetKey(), VersionID: e.GetVersionId(), Code: e.GetCode()})
+	}
+	return out, nil
+}
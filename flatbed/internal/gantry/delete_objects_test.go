@@ -0,0 +1,53 @@
+package gantry
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientDeleteObjects(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetDeleteObjectsHook(func(context.Context, *servicev1.DeleteObjectsRequest) (*servicev1.DeleteObjectsResponse, error) {
+		return &servicev1.DeleteObjectsResponse{
+			Deleted: []*servicev1.DeletedObject{{Key: "a.tar", DeleteMarker: true, DeleteMarkerVersionId: "marker-1"}},
+			Errors:  []*servicev1.DeleteObjectError{{Key: "private/b.tar", VersionId: "version-1", Code: "AccessDenied"}},
+		}, nil
+	})
+
+	got, err := client.DeleteObjects(ctx, "backups", []ObjectIdentifier{{Key: "a.tar"}, {Key: "private/b.tar", VersionID: "version-1"}}, true)
+	if err != nil {
+		t.Fatalf("DeleteObjects: %v", err)
+	}
+	want := ObjectDeletions{
+		Deleted: []DeletedObject{{Key: "a.tar", DeleteMarker: true, DeleteMarkerVersionID: "marker-1"}},
+		Errors:  []DeleteObjectError{{Key: "private/b.tar", VersionID: "version-1", Code: "AccessDenied"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("DeleteObjects = %+v, want %+v", got, want)
+	}
+
+	call, ok := svc.LastDeleteObjectsCall()
+	if !ok {
+		t.Fatal("no DeleteObjects call recorded")
+	}
+	wantReq := &servicev1.DeleteObjectsRequest{
+		Bucket:  "backups",
+		Objects: []*servicev1.ObjectIdentifier{{Key: "a.tar"}, {Key: "private/b.tar", VersionId: "version-1"}},
+
+		BypassGovernanceRetention: true,
+	}
+	if !proto.Equal(call.Request, wantReq) {
+		t.Fatalf("request = %v, want %v", call.Request, wantReq)
+	}
+}
@@ -0,0 +1,22 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// GetAccessKey returns accessKeyID with its secret and owner. An unknown or
+// inactive key comes back as a NotFound status.
+func (c *Client) GetAccessKey(ctx context.Context, accessKeyID string) (AccessKey, error) {
+	resp, err := c.svc.GetAccessKey(ctx, &servicev1.GetAccessKeyRequest{AccessKeyId: accessKeyID})
+	if err != nil {
+		return AccessKey{}, err
+	}
+	return AccessKey{
+		AccessKeyID:     resp.GetAccessKeyId(),
+		SecretAccessKey: resp.GetSecretAccessKey(),
+		UserID:          resp.GetUserId(),
+		UserName:        resp.GetUserName(),
+	}, nil
+}
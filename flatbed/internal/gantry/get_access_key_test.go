@@ -0,0 +1,52 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientGetAccessKey(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	const (
+		accessKeyID = "AKIDEXAMPLE"
+		secret      = "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"
+	)
+
+	svc.SetGetAccessKeyHook(func(_ context.Context, req *servicev1.GetAccessKeyRequest) (*servicev1.GetAccessKeyResponse, error) {
+		return &servicev1.GetAccessKeyResponse{
+			AccessKeyId:     req.GetAccessKeyId(),
+			SecretAccessKey: secret,
+			UserId:          "user-admin",
+			UserName:        "admin",
+		}, nil
+	})
+
+	got, err := client.GetAccessKey(requestid.WithRequestID(ctx, "req-abc"), accessKeyID)
+	if err != nil {
+		t.Fatalf("GetAccessKey: %v", err)
+	}
+	want := AccessKey{AccessKeyID: accessKeyID, SecretAccessKey: secret, UserID: "user-admin", UserName: "admin"}
+	if got != want {
+		t.Fatalf("GetAccessKey = %+v, want %+v", got, want)
+	}
+
+	call, ok := svc.LastGetAccessKeyCall()
+	if !ok {
+		t.Fatal("no GetAccessKey call recorded")
+	}
+	if call.Request.GetAccessKeyId() != accessKeyID {
+		t.Fatalf("request AccessKeyId = %q, want %q", call.Request.GetAccessKeyId(), accessKeyID)
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
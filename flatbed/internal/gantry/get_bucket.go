@@ -0,0 +1,29 @@
+package gantry
+
+import (
+	"context"
+	"time"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (c *Client) GetBucket(ctx context.Context, name string) (Bucket, error) {
+	resp, err := c.svc.GetBucket(ctx, &servicev1.GetBucketRequest{Name: name})
+	if err != nil {
+		return Bucket{}, err
+	}
+
+	b := resp.GetBucket()
+	var createdAt time.Time
+	if ts := b.GetCreatedAtRfc3339(); ts != "" {
+		createdAt, err = time.Parse(time.RFC3339, ts)
+		if err != nil {
+			return Bucket{}, err
+		}
+	}
+
+	return Bucket{
+		Name:      b.GetName(),
+		CreatedAt: createdAt,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+	bucketv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/bucket/v1"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientGetBucket(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetGetBucketHook(func(_ context.Context, _ *servicev1.GetBucketRequest) (*servicev1.GetBucketResponse, error) {
+		return &servicev1.GetBucketResponse{
+			Bucket: &bucketv1.Bucket{Name: "photos", CreatedAtRfc3339: "2025-01-01T01:02:03Z"},
+		}, nil
+	})
+
+	want := Bucket{Name: "photos", CreatedAt: parseTime(t, "2025-01-01T01:02:03Z")}
+
+	got, err := client.GetBucket(requestid.WithRequestID(ctx, "req-abc"), "photos")
+	if err != nil {
+		t.Fatalf("GetBucket: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("GetBucket diff (-want +got):\n%s", diff)
+	}
+
+	call, ok := svc.LastGetBucketCall()
+	if !ok {
+		t.Fatal("no GetBucket call recorded")
+	}
+	if call.Request.GetName() != "photos" {
+		t.Fatalf("request Name = %q, want %q", call.Request.GetName(), "photos")
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
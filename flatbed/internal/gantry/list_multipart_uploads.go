@@ -0,0 +1,40 @@
+package gantry
+
+import (
+	"context"
+	"time"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (c *Client) ListMultipartUploads(ctx context.Context, bucket string, params ListMultipartUploadsParams) (MultipartUploadListing, error) {
+	maxUploads := params.MaxUploads
+	resp, err := c.svc.ListMultipartUploads(ctx, &servicev1.ListMultipartUploadsRequest{
+		Bucket:         bucket,
+		Prefix:         params.Prefix,
+		Delimiter:      params.Delimiter,
+		MaxUploads:     &maxUploads,
+		KeyMarker:      params.KeyMarker,
+		UploadIdMarker: params.UploadIDMarker,
+	})
+	if err != nil {
+		return MultipartUploadListing{}, err
+	}
+
+	listing := MultipartUploadListing{
+		Uploads:            make([]MultipartUpload, 0, len(resp.GetUploads())),
+		CommonPrefixes:     resp.GetCommonPrefixes(),
+		IsTruncated:        resp.GetIsTruncated(),
+		NextKeyMarker:      resp.GetNextKeyMarker(),
+		NextUploadIDMarker: resp.GetNextUploadIdMarker(),
+	}
+	for _, upload := range resp.GetUploads() {
+		listing.Uploads = append(listing.Uploads, MultipartUpload{
+			Key:       upload.GetKey(),
+			UploadID:  upload.GetUploadId(),
+			Initiated: time.UnixMilli(upload.GetInitiatedMs()).UTC(),
+		})
+	}
+
+	return listing, nil
+}
@@ -0,0 +1,90 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientListMultipartUploads(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetListMultipartUploadsHook(func(_ context.Context, _ *servicev1.ListMultipartUploadsRequest) (*servicev1.ListMultipartUploadsResponse, error) {
+		return &servicev1.ListMultipartUploadsResponse{
+			Uploads: []*servicev1.MultipartUpload{
+				{
+					Key:         "videos/big.mp4",
+					UploadId:    "01JUPLOADXXXXXXXXXXXXXXXXX",
+					InitiatedMs: 1735689600000,
+				},
+			},
+			CommonPrefixes:     []string{"videos/2024/"},
+			IsTruncated:        true,
+			NextKeyMarker:      "videos/big.mp4",
+			NextUploadIdMarker: "01JUPLOADXXXXXXXXXXXXXXXXX",
+		}, nil
+	})
+
+	const bucket = "photos"
+
+	params := ListMultipartUploadsParams{
+		Prefix:         "videos/",
+		Delimiter:      "/",
+		MaxUploads:     2,
+		KeyMarker:      "videos/a.mp4",
+		UploadIDMarker: "01JPREVXXXXXXXXXXXXXXXXXXX",
+	}
+
+	want := MultipartUploadListing{
+		Uploads: []MultipartUpload{
+			{
+				Key:       "videos/big.mp4",
+				UploadID:  "01JUPLOADXXXXXXXXXXXXXXXXX",
+				Initiated: parseTime(t, "2025-01-01T00:00:00Z"),
+			},
+		},
+		CommonPrefixes:     []string{"videos/2024/"},
+		IsTruncated:        true,
+		NextKeyMarker:      "videos/big.mp4",
+		NextUploadIDMarker: "01JUPLOADXXXXXXXXXXXXXXXXX",
+	}
+
+	got, err := client.ListMultipartUploads(requestid.WithRequestID(ctx, "req-abc"), bucket, params)
+	if err != nil {
+		t.Fatalf("ListMultipartUploads: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("ListMultipartUploads diff (-want +got):\n%s", diff)
+	}
+
+	call, ok := svc.LastListMultipartUploadsCall()
+	if !ok {
+		t.Fatal("no ListMultipartUploads call recorded")
+	}
+	req := call.Request
+	if req.GetBucket() != bucket {
+		t.Fatalf("request Bucket = %q, want %q", req.GetBucket(), bucket)
+	}
+	if req.GetPrefix() != params.Prefix || req.GetDelimiter() != params.Delimiter {
+		t.Fatalf("request Prefix/Delimiter = %q/%q, want %q/%q", req.GetPrefix(), req.GetDelimiter(), params.Prefix, params.Delimiter)
+	}
+	if req.MaxUploads == nil || req.GetMaxUploads() != params.MaxUploads {
+		t.Fatalf("request MaxUploads = %v, want %d", req.MaxUploads, params.MaxUploads)
+	}
+	if req.GetKeyMarker() != params.KeyMarker || req.GetUploadIdMarker() != params.UploadIDMarker {
+		t.Fatalf("request KeyMarker/UploadIdMarker = %q/%q, want %q/%q",
+			req.GetKeyMarker(), req.GetUploadIdMarker(), params.KeyMarker, params.UploadIDMarker)
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
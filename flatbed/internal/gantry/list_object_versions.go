@@ -0,0 +1,46 @@
+package gantry
+
+import (
+	"context"
+	"time"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (c *Client) ListObjectVersions(ctx context.Context, bucket string, params ListObjectVersionsParams) (ObjectVersionListing, error) {
+	maxKeys := params.MaxKeys
+	resp, err := c.svc.ListObjectVersions(ctx, &servicev1.ListObjectVersionsRequest{
+		Bucket:          bucket,
+		Prefix:          params.Prefix,
+		Delimiter:       params.Delimiter,
+		MaxKeys:         &maxKeys,
+		KeyMarker:       params.KeyMarker,
+		VersionIdMarker: params.VersionIDMarker,
+	})
+	if err != nil {
+		return ObjectVersionListing{}, err
+	}
+
+	listing := ObjectVersionListing{
+		Versions:            make([]Object, 0, len(resp.GetVersions())),
+		CommonPrefixes:      resp.GetCommonPrefixes(),
+		IsTruncated:         resp.GetIsTruncated(),
+		NextKeyMarker:       resp.GetNextKeyMarker(),
+		NextVersionIDMarker: resp.GetNextVersionIdMarker(),
+	}
+	for _, v := range resp.GetVersions() {
+		listing.Versions = append(listing.Versions, Object{
+			ID:           v.GetObjectId(),
+			Key:          v.GetKey(),
+			Size:         v.GetSize(),
+			LastModified: time.UnixMilli(v.GetLastModifiedMs()).UTC(),
+			ContentType:  v.GetContentType(),
+			ETag:         v.GetEtag(),
+			VersionID:    v.GetVersionId(),
+			IsLatest:     v.GetIsLatest(),
+			DeleteMarker: v.GetDeleteMarker(),
+		})
+	}
+
+	return listing, nil
+}
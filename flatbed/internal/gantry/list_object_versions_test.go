@@ -0,0 +1,111 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+	objectv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/object/v1"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientListObjectVersions(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetListObjectVersionsHook(func(_ context.Context, _ *servicev1.ListObjectVersionsRequest) (*servicev1.ListObjectVersionsResponse, error) {
+		return &servicev1.ListObjectVersionsResponse{
+			Versions: []*objectv1.Object{
+				{
+					ObjectId:       "01JMARKERXXXXXXXXXXXXXXXXX",
+					Key:            "docs/report.docx",
+					LastModifiedMs: 1735689600000,
+					VersionId:      "01JMARKERXXXXXXXXXXXXXXXXX",
+					IsLatest:       true,
+					DeleteMarker:   true,
+				},
+				{
+					ObjectId:       "01JVERSIONXXXXXXXXXXXXXXXX",
+					Key:            "docs/report.docx",
+					Size:           2048,
+					LastModifiedMs: 1735689600000,
+					Etag:           "5eb63bbbe01eeed093cb22bb8f5acdc3",
+					VersionId:      "01JVERSIONXXXXXXXXXXXXXXXX",
+				},
+			},
+			CommonPrefixes:      []string{"docs/2024/"},
+			IsTruncated:         true,
+			NextKeyMarker:       "docs/report.docx",
+			NextVersionIdMarker: "01JVERSIONXXXXXXXXXXXXXXXX",
+		}, nil
+	})
+
+	params := ListObjectVersionsParams{
+		Prefix:          "docs/",
+		Delimiter:       "/",
+		MaxKeys:         3,
+		KeyMarker:       "docs/a.txt",
+		VersionIDMarker: "01JPREVXXXXXXXXXXXXXXXXXXX",
+	}
+
+	want := ObjectVersionListing{
+		Versions: []Object{
+			{
+				ID:           "01JMARKERXXXXXXXXXXXXXXXXX",
+				Key:          "docs/report.docx",
+				LastModified: parseTime(t, "2025-01-01T00:00:00Z"),
+				VersionID:    "01JMARKERXXXXXXXXXXXXXXXXX",
+				IsLatest:     true,
+				DeleteMarker: true,
+			},
+			{
+				ID:           "01JVERSIONXXXXXXXXXXXXXXXX",
+				Key:          "docs/report.docx",
+				Size:         2048,
+				LastModified: parseTime(t, "2025-01-01T00:00:00Z"),
+				ETag:         "5eb63bbbe01eeed093cb22bb8f5acdc3",
+				VersionID:    "01JVERSIONXXXXXXXXXXXXXXXX",
+			},
+		},
+		CommonPrefixes:      []string{"docs/2024/"},
+		IsTruncated:         true,
+		NextKeyMarker:       "docs/report.docx",
+		NextVersionIDMarker: "01JVERSIONXXXXXXXXXXXXXXXX",
+	}
+
+	got, err := client.ListObjectVersions(requestid.WithRequestID(ctx, "req-abc"), "photos", params)
+	if err != nil {
+		t.Fatalf("ListObjectVersions: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("ListObjectVersions diff (-want +got):\n%s", diff)
+	}
+
+	call, ok := svc.LastListObjectVersionsCall()
+	if !ok {
+		t.Fatal("no ListObjectVersions call recorded")
+	}
+	req := call.Request
+	if req.GetBucket() != "photos" {
+		t.Fatalf("request Bucket = %q, want photos", req.GetBucket())
+	}
+	if req.GetPrefix() != params.Prefix || req.GetDelimiter() != params.Delimiter {
+		t.Fatalf("request Prefix/Delimiter = %q/%q, want %q/%q", req.GetPrefix(), req.GetDelimiter(), params.Prefix, params.Delimiter)
+	}
+	if req.MaxKeys == nil || req.GetMaxKeys() != params.MaxKeys {
+		t.Fatalf("request MaxKeys = %v, want %d", req.MaxKeys, params.MaxKeys)
+	}
+	if req.GetKeyMarker() != params.KeyMarker || req.GetVersionIdMarker() != params.VersionIDMarker {
+		t.Fatalf("request KeyMarker/VersionIdMarker = %q/%q, want %q/%q",
+			req.GetKeyMarker(), req.GetVersionIdMarker(), params.KeyMarker, params.VersionIDMarker)
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
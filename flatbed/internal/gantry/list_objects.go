@@ -0,0 +1,42 @@
+package gantry
+
+import (
+	"context"
+	"time"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (c *Client) ListObjects(ctx context.Context, bucket string, params ListObjectsParams) (ObjectListing, error) {
+	maxKeys := params.MaxKeys
+	resp, err := c.svc.ListObjects(ctx, &servicev1.ListObjectsRequest{
+		Bucket:            bucket,
+		Prefix:            params.Prefix,
+		Delimiter:         params.Delimiter,
+		MaxKeys:           &maxKeys,
+		StartAfter:        params.StartAfter,
+		ContinuationToken: params.ContinuationToken,
+	})
+	if err != nil {
+		return ObjectListing{}, err
+	}
+
+	listing := ObjectListing{
+		Objects:               make([]Object, 0, len(resp.GetObjects())),
+		CommonPrefixes:        resp.GetCommonPrefixes(),
+		IsTruncated:           resp.GetIsTruncated(),
+		NextContinuationToken: resp.GetNextContinuationToken(),
+	}
+	for _, obj := range resp.GetObjects() {
+		listing.Objects = append(listing.Objects, Object{
+			ID:           obj.GetObjectId(),
+			Key:          obj.GetKey(),
+			Size:         obj.GetSize(),
+			LastModified: time.UnixMilli(obj.GetLastModifiedMs()).UTC(),
+			ContentType:  obj.GetContentType(),
+			ETag:         obj.GetEtag(),
+		})
+	}
+
+	return listing, nil
+}
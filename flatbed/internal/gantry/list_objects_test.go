@@ -0,0 +1,91 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+	objectv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/object/v1"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientListObjects(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetListObjectsHook(func(_ context.Context, _ *servicev1.ListObjectsRequest) (*servicev1.ListObjectsResponse, error) {
+		return &servicev1.ListObjectsResponse{
+			Objects: []*objectv1.Object{
+				{
+					ObjectId:       "01JXXXXXXXXXXXXXXXXXXXXXXXXX",
+					Key:            "vacation/sunset.jpg",
+					Size:           204800,
+					LastModifiedMs: 1735689600000,
+				},
+			},
+			CommonPrefixes:        []string{"vacation/2024/"},
+			IsTruncated:           true,
+			NextContinuationToken: "next-token",
+		}, nil
+	})
+
+	const bucket = "photos"
+
+	params := ListObjectsParams{
+		Prefix:            "vacation/",
+		Delimiter:         "/",
+		MaxKeys:           2,
+		StartAfter:        "vacation/a.jpg",
+		ContinuationToken: "prev-token",
+	}
+
+	want := ObjectListing{
+		Objects: []Object{
+			{
+				ID:           "01JXXXXXXXXXXXXXXXXXXXXXXXXX",
+				Key:          "vacation/sunset.jpg",
+				Size:         204800,
+				LastModified: parseTime(t, "2025-01-01T00:00:00Z"),
+			},
+		},
+		CommonPrefixes:        []string{"vacation/2024/"},
+		IsTruncated:           true,
+		NextContinuationToken: "next-token",
+	}
+
+	got, err := client.ListObjects(requestid.WithRequestID(ctx, "req-abc"), bucket, params)
+	if err != nil {
+		t.Fatalf("ListObjects: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("ListObjects diff (-want +got):\n%s", diff)
+	}
+
+	call, ok := svc.LastListObjectsCall()
+	if !ok {
+		t.Fatal("no ListObjects call recorded")
+	}
+	req := call.Request
+	if req.GetBucket() != bucket {
+		t.Fatalf("request Bucket = %q, want %q", req.GetBucket(), bucket)
+	}
+	if req.GetPrefix() != params.Prefix || req.GetDelimiter() != params.Delimiter {
+		t.Fatalf("request Prefix/Delimiter = %q/%q, want %q/%q", req.GetPrefix(), req.GetDelimiter(), params.Prefix, params.Delimiter)
+	}
+	if req.MaxKeys == nil || req.GetMaxKeys() != params.MaxKeys {
+		t.Fatalf("request MaxKeys = %v, want %d", req.MaxKeys, params.MaxKeys)
+	}
+	if req.GetStartAfter() != params.StartAfter || req.GetContinuationToken() != params.ContinuationToken {
+		t.Fatalf("request StartAfter/ContinuationToken = %q/%q, want %q/%q",
+			req.GetStartAfter(), req.GetContinuationToken(), params.StartAfter, params.ContinuationToken)
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
@@ -0,0 +1,38 @@
+package gantry
+
+import (
+	"context"
+	"time"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (c *Client) ListParts(ctx context.Context, bucket, key, uploadID string, params ListPartsParams) (PartListing, error) {
+	maxParts := params.MaxParts
+	resp, err := c.svc.ListParts(ctx, &servicev1.ListPartsRequest{
+		Bucket:           bucket,
+		Key:              key,
+		UploadId:         uploadID,
+		PartNumberMarker: params.PartNumberMarker,
+		MaxParts:         &maxParts,
+	})
+	if err != nil {
+		return PartListing{}, err
+	}
+
+	listing := PartListing{
+		Parts:                make([]Part, 0, len(resp.GetParts())),
+		IsTruncated:          resp.GetIsTruncated(),
+		NextPartNumberMarker: resp.GetNextPartNumberMarker(),
+	}
+	for _, part := range resp.GetParts() {
+		listing.Parts = append(listing.Parts, Part{
+			PartNumber:   part.GetPartNumber(),
+			ETag:         part.GetEtag(),
+			Size:         part.GetSize(),
+			LastModified: time.UnixMilli(part.GetLastModifiedMs()).UTC(),
+		})
+	}
+
+	return listing, nil
+}
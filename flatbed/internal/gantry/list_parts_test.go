@@ -0,0 +1,85 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientListParts(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetListPartsHook(func(_ context.Context, _ *servicev1.ListPartsRequest) (*servicev1.ListPartsResponse, error) {
+		return &servicev1.ListPartsResponse{
+			Parts: []*servicev1.UploadedPart{
+				{
+					PartNumber:     3,
+					Etag:           "01JBLOBXXXXXXXXXXXXXXXXXXX",
+					Size:           5242880,
+					LastModifiedMs: 1735689600000,
+				},
+			},
+			IsTruncated:          true,
+			NextPartNumberMarker: 3,
+		}, nil
+	})
+
+	const (
+		bucket   = "photos"
+		key      = "videos/big.mp4"
+		uploadID = "01JUPLOADXXXXXXXXXXXXXXXXX"
+	)
+
+	params := ListPartsParams{
+		PartNumberMarker: 2,
+		MaxParts:         1,
+	}
+
+	want := PartListing{
+		Parts: []Part{
+			{
+				PartNumber:   3,
+				ETag:         "01JBLOBXXXXXXXXXXXXXXXXXXX",
+				Size:         5242880,
+				LastModified: parseTime(t, "2025-01-01T00:00:00Z"),
+			},
+		},
+		IsTruncated:          true,
+		NextPartNumberMarker: 3,
+	}
+
+	got, err := client.ListParts(requestid.WithRequestID(ctx, "req-abc"), bucket, key, uploadID, params)
+	if err != nil {
+		t.Fatalf("ListParts: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("ListParts diff (-want +got):\n%s", diff)
+	}
+
+	call, ok := svc.LastListPartsCall()
+	if !ok {
+		t.Fatal("no ListParts call recorded")
+	}
+	req := call.Request
+	if req.GetBucket() != bucket || req.GetKey() != key || req.GetUploadId() != uploadID {
+		t.Fatalf("request = %s/%s/%s, want %s/%s/%s", req.GetBucket(), req.GetKey(), req.GetUploadId(), bucket, key, uploadID)
+	}
+	if req.GetPartNumberMarker() != params.PartNumberMarker {
+		t.Fatalf("request PartNumberMarker = %d, want %d", req.GetPartNumberMarker(), params.PartNumberMarker)
+	}
+	if req.MaxParts == nil || req.GetMaxParts() != params.MaxParts {
+		t.Fatalf("request MaxParts = %v, want %d", req.MaxParts, params.MaxParts)
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
@@ -0,0 +1,59 @@
+package gantry
+
+import (
+	"context"
+	"time"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// LookupObject returns the current version of the object, or the version
+// named by versionID when it is non-empty.
+func (c *Client) LookupObject(ctx context.Context, bucket, key, versionID string) (Object, error) {
+	resp, err := c.svc.LookupObject(ctx, &servicev1.LookupObjectRequest{
+		Bucket:    bucket,
+		Key:       key,
+		VersionId: versionID,
+	})
+	if err != nil {
+		return Object{}, err
+	}
+
+	obj := resp.GetObject()
+	object := Object{
+		ID:            obj.GetObjectId(),
+		Key:           obj.GetKey(),
+		Size:          obj.GetSize(),
+		LastModified:  time.UnixMilli(obj.GetLastModifiedMs()).UTC(),
+		CradleAddress: obj.GetCradleAddress(),
+		ContentType:   obj.GetContentType(),
+		ETag:          obj.GetEtag(),
+
+		ChecksumAlgorithm: obj.GetChecksumAlgorithm(),
+		Checksum:          obj.GetChecksum(),
+
+		VersionID: obj.GetVersionId(),
+		Tags:      obj.GetTags(),
+		Lock:      ObjectLock{Mode: obj.GetObjectLockMode(), LegalHold: obj.GetObjectLockLegalHold()},
+	}
+	if object.Lock.Mode != "" {
+		object.Lock.RetainUntil = time.UnixMilli(obj.GetObjectLockRetainUntilMs()).UTC()
+	}
+	if md := obj.GetMetadata(); md != nil {
+		object.Metadata = ObjectMetadata{
+			ContentEncoding:    md.GetContentEncoding(),
+			ContentDisposition: md.GetContentDisposition(),
+			CacheControl:       md.GetCacheControl(),
+			Expires:            md.GetExpires(),
+			User:               md.GetUserMetadata(),
+		}
+	}
+	for _, p := range obj.GetParts() {
+		object.Parts = append(object.Parts, ObjectPart{
+			BlobID:        p.GetBlobId(),
+			Size:          p.GetSize(),
+			CradleAddress: p.GetCradleAddress(),
+		})
+	}
+	return object, nil
+}
@@ -0,0 +1,140 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+	objectv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/object/v1"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientLookupObject(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetLookupObjectHook(func(_ context.Context, _ *servicev1.LookupObjectRequest) (*servicev1.LookupObjectResponse, error) {
+		return &servicev1.LookupObjectResponse{
+			Object: &objectv1.Object{
+				ObjectId:       "01JXXXXXXXXXXXXXXXXXXXXXXXXX",
+				Key:            "vacation/sunset.jpg",
+				Size:           204800,
+				LastModifiedMs: 1735689600000,
+				CradleAddress:  "cradle.internal:9002",
+				ContentType:    "image/jpeg",
+
+				ChecksumAlgorithm: "SHA1",
+				Checksum:          "Kq5sNclPz7QV2+lfQIuc6R7oRu0=",
+				Metadata: &objectv1.ObjectMetadata{
+					CacheControl: "no-cache",
+					UserMetadata: map[string]string{"color": "blue"},
+				},
+				VersionId: "01JVERSIONXXXXXXXXXXXXXXXX",
+
+				ObjectLockMode:          "COMPLIANCE",
+				ObjectLockRetainUntilMs: 1743465600000,
+				ObjectLockLegalHold:     true,
+			},
+		}, nil
+	})
+
+	const (
+		bucket = "photos"
+		key    = "vacation/sunset.jpg"
+	)
+
+	want := Object{
+		ID:            "01JXXXXXXXXXXXXXXXXXXXXXXXXX",
+		Key:           key,
+		Size:          204800,
+		LastModified:  parseTime(t, "2025-01-01T00:00:00Z"),
+		CradleAddress: "cradle.internal:9002",
+		ContentType:   "image/jpeg",
+
+		ChecksumAlgorithm: "SHA1",
+		Checksum:          "Kq5sNclPz7QV2+lfQIuc6R7oRu0=",
+		Metadata: ObjectMetadata{
+			CacheControl: "no-cache",
+			User:         map[string]string{"color": "blue"},
+		},
+		VersionID: "01JVERSIONXXXXXXXXXXXXXXXX",
+		Lock:      ObjectLock{Mode: "COMPLIANCE", RetainUntil: parseTime(t, "2025-04-01T00:00:00Z"), LegalHold: true},
+	}
+
+	got, err := client.LookupObject(requestid.WithRequestID(ctx, "req-abc"), bucket, key, "01JVERSIONXXXXXXXXXXXXXXXX")
+	if err != nil {
+		t.Fatalf("LookupObject: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("LookupObject diff (-want +got):\n%s", diff)
+	}
+
+	call, ok := svc.LastLookupObjectCall()
+	if !ok {
+		t.Fatal("no LookupObject call recorded")
+	}
+	if call.Request.GetBucket() != bucket {
+		t.Fatalf("request Bucket = %q, want %q", call.Request.GetBucket(), bucket)
+	}
+	if call.Request.GetKey() != key {
+		t.Fatalf("request Key = %q, want %q", call.Request.GetKey(), key)
+	}
+	if call.Request.GetVersionId() != "01JVERSIONXXXXXXXXXXXXXXXX" {
+		t.Fatalf("request VersionId = %q, want 01JVERSIONXXXXXXXXXXXXXXXX", call.Request.GetVersionId())
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
+
+func TestClientLookupObjectMultipart(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetLookupObjectHook(func(_ context.Context, _ *servicev1.LookupObjectRequest) (*servicev1.LookupObjectResponse, error) {
+		return &servicev1.LookupObjectResponse{
+			Object: &objectv1.Object{
+				ObjectId:       "upload-1",
+				Key:            "big.mp4",
+				Size:           5243904,
+				LastModifiedMs: 1735689600000,
+				CradleAddress:  "cradle-a:9002",
+				Etag:           "816d195b2f5dfc10ee04d6966a3b0766-2",
+				Parts: []*objectv1.ObjectPart{
+					{BlobId: "blob-1", Size: 5242880, CradleAddress: "cradle-a:9002"},
+					{BlobId: "blob-2", Size: 1024, CradleAddress: "cradle-b:9002"},
+				},
+			},
+		}, nil
+	})
+
+	want := Object{
+		ID:            "upload-1",
+		Key:           "big.mp4",
+		Size:          5243904,
+		LastModified:  parseTime(t, "2025-01-01T00:00:00Z"),
+		CradleAddress: "cradle-a:9002",
+		ETag:          "816d195b2f5dfc10ee04d6966a3b0766-2",
+		Parts: []ObjectPart{
+			{BlobID: "blob-1", Size: 5242880, CradleAddress: "cradle-a:9002"},
+			{BlobID: "blob-2", Size: 1024, CradleAddress: "cradle-b:9002"},
+		},
+	}
+
+	got, err := client.LookupObject(ctx, "videos", "big.mp4", "")
+	if err != nil {
+		t.Fatalf("LookupObject: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("LookupObject diff (-want +got):\n%s", diff)
+	}
+}
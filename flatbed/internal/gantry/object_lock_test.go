@@ -0,0 +1,170 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientPutObjectLockConfiguration(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	err := client.PutObjectLockConfiguration(ctx, "backups", ObjectLockConfiguration{DefaultMode: "COMPLIANCE", DefaultDays: 90})
+	if err != nil {
+		t.Fatalf("PutObjectLockConfiguration: %v", err)
+	}
+
+	call, ok := svc.LastPutObjectLockConfigurationCall()
+	if !ok {
+		t.Fatal("no PutObjectLockConfiguration call recorded")
+	}
+	want := &servicev1.PutObjectLockConfigurationRequest{
+		Bucket:        "backups",
+		Configuration: &servicev1.ObjectLockConfiguration{DefaultMode: "COMPLIANCE", DefaultDays: 90},
+	}
+	if !proto.Equal(call.Request, want) {
+		t.Fatalf("request = %v, want %v", call.Request, want)
+	}
+}
+
+func TestClientGetObjectLockConfiguration(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetGetObjectLockConfigurationHook(func(context.Context, *servicev1.GetObjectLockConfigurationRequest) (*servicev1.GetObjectLockConfigurationResponse, error) {
+		return &servicev1.GetObjectLockConfigurationResponse{
+			Configuration: &servicev1.ObjectLockConfiguration{DefaultMode: "GOVERNANCE", DefaultYears: 1},
+		}, nil
+	})
+
+	got, err := client.GetObjectLockConfiguration(ctx, "backups")
+	if err != nil {
+		t.Fatalf("GetObjectLockConfiguration: %v", err)
+	}
+	if want := (ObjectLockConfiguration{DefaultMode: "GOVERNANCE", DefaultYears: 1}); got != want {
+		t.Fatalf("GetObjectLockConfiguration = %+v, want %+v", got, want)
+	}
+
+	call, ok := svc.LastGetObjectLockConfigurationCall()
+	if !ok {
+		t.Fatal("no GetObjectLockConfiguration call recorded")
+	}
+	if call.Request.GetBucket() != "backups" {
+		t.Fatalf("request Bucket = %q, want backups", call.Request.GetBucket())
+	}
+}
+
+func TestClientPutObjectRetention(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	retainUntil := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	versionID, err := client.PutObjectRetention(ctx, "backups", "db.tar", "01JVERSIONXXXXXXXXXXXXXXXX", "GOVERNANCE", retainUntil, true)
+	if err != nil {
+		t.Fatalf("PutObjectRetention: %v", err)
+	}
+	if versionID != "01JVERSIONXXXXXXXXXXXXXXXX" {
+		t.Fatalf("version ID = %q, want 01JVERSIONXXXXXXXXXXXXXXXX", versionID)
+	}
+
+	call, ok := svc.LastPutObjectRetentionCall()
+	if !ok {
+		t.Fatal("no PutObjectRetention call recorded")
+	}
+	want := &servicev1.PutObjectRetentionRequest{
+		Bucket:        "backups",
+		Key:           "db.tar",
+		VersionId:     "01JVERSIONXXXXXXXXXXXXXXXX",
+		Mode:          "GOVERNANCE",
+		RetainUntilMs: retainUntil.UnixMilli(),
+
+		BypassGovernanceRetention: true,
+	}
+	if !proto.Equal(call.Request, want) {
+		t.Fatalf("request = %v, want %v", call.Request, want)
+	}
+}
+
+func TestClientGetObjectRetention(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetGetObjectRetentionHook(func(_ context.Context, req *servicev1.GetObjectRetentionRequest) (*servicev1.GetObjectRetentionResponse, error) {
+		return &servicev1.GetObjectRetentionResponse{VersionId: req.GetVersionId(), Mode: "COMPLIANCE", RetainUntilMs: 1743465600000}, nil
+	})
+
+	got, err := client.GetObjectRetention(ctx, "backups", "db.tar", "null")
+	if err != nil {
+		t.Fatalf("GetObjectRetention: %v", err)
+	}
+	want := ObjectRetention{VersionID: "null", Mode: "COMPLIANCE", RetainUntil: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)}
+	if got != want {
+		t.Fatalf("GetObjectRetention = %+v, want %+v", got, want)
+	}
+
+	call, ok := svc.LastGetObjectRetentionCall()
+	if !ok {
+		t.Fatal("no GetObjectRetention call recorded")
+	}
+	if call.Request.GetBucket() != "backups" || call.Request.GetKey() != "db.tar" || call.Request.GetVersionId() != "null" {
+		t.Fatalf("request = %v, want backups/db.tar version null", call.Request)
+	}
+}
+
+func TestClientPutObjectLegalHold(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	if _, err := client.PutObjectLegalHold(ctx, "backups", "db.tar", "", true); err != nil {
+		t.Fatalf("PutObjectLegalHold: %v", err)
+	}
+
+	call, ok := svc.LastPutObjectLegalHoldCall()
+	if !ok {
+		t.Fatal("no PutObjectLegalHold call recorded")
+	}
+	want := &servicev1.PutObjectLegalHoldRequest{Bucket: "backups", Key: "db.tar", On: true}
+	if !proto.Equal(call.Request, want) {
+		t.Fatalf("request = %v, want %v", call.Request, want)
+	}
+}
+
+func TestClientGetObjectLegalHold(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetGetObjectLegalHoldHook(func(context.Context, *servicev1.GetObjectLegalHoldRequest) (*servicev1.GetObjectLegalHoldResponse, error) {
+		return &servicev1.GetObjectLegalHoldResponse{VersionId: "01JVERSIONXXXXXXXXXXXXXXXX", On: true}, nil
+	})
+
+	got, err := client.GetObjectLegalHold(ctx, "backups", "db.tar", "")
+	if err != nil {
+		t.Fatalf("GetObjectLegalHold: %v", err)
+	}
+	if want := (ObjectLegalHold{VersionID: "01JVERSIONXXXXXXXXXXXXXXXX", On: true}); got != want {
+		t.Fatalf("GetObjectLegalHold = %+v, want %+v", got, want)
+	}
+}
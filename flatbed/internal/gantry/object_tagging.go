@@ -0,0 +1,47 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// PutObjectTagging replaces the tags of the current version of the object,
+// or of the version named by versionID when it is non-empty, and returns the
+// version tagged.
+func (c *Client) PutObjectTagging(ctx context.Context, bucket, key, versionID string, tags map[string]string) (string, error) {
+	resp, err := c.svc.PutObjectTagging(ctx, &servicev1.PutObjectTaggingRequest{
+		Bucket:    bucket,
+		Key:       key,
+		VersionId: versionID,
+		Tags:      tags,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetVersionId(), nil
+}
+
+func (c *Client) GetObjectTagging(ctx context.Context, bucket, key, versionID string) (ObjectTagging, error) {
+	resp, err := c.svc.GetObjectTagging(ctx, &servicev1.GetObjectTaggingRequest{
+		Bucket:    bucket,
+		Key:       key,
+		VersionId: versionID,
+	})
+	if err != nil {
+		return ObjectTagging{}, err
+	}
+	return ObjectTagging{VersionID: resp.GetVersionId(), Tags: resp.GetTags()}, nil
+}
+
+func (c *Client) DeleteObjectTagging(ctx context.Context, bucket, key, versionID string) (string, error) {
+	resp, err := c.svc.DeleteObjectTagging(ctx, &servicev1.DeleteObjectTaggingRequest{
+		Bucket:    bucket,
+		Key:       key,
+		VersionId: versionID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetVersionId(), nil
+}
@@ -0,0 +1,93 @@
+package gantry
+
+import (
+	"context"
+	"maps"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestClientPutObjectTagging(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetPutObjectTaggingHook(func(context.Context, *servicev1.PutObjectTaggingRequest) (*servicev1.PutObjectTaggingResponse, error) {
+		return &servicev1.PutObjectTaggingResponse{VersionId: "01JVERSIONXXXXXXXXXXXXXXXX"}, nil
+	})
+
+	versionID, err := client.PutObjectTagging(ctx, "backups", "db.tar", "", map[string]string{"host": "web-1"})
+	if err != nil {
+		t.Fatalf("PutObjectTagging: %v", err)
+	}
+	if versionID != "01JVERSIONXXXXXXXXXXXXXXXX" {
+		t.Fatalf("version ID = %q, want 01JVERSIONXXXXXXXXXXXXXXXX", versionID)
+	}
+
+	call, ok := svc.LastPutObjectTaggingCall()
+	if !ok {
+		t.Fatal("no PutObjectTagging call recorded")
+	}
+	want := &servicev1.PutObjectTaggingRequest{Bucket: "backups", Key: "db.tar", Tags: map[string]string{"host": "web-1"}}
+	if !proto.Equal(call.Request, want) {
+		t.Fatalf("request = %v, want %v", call.Request, want)
+	}
+}
+
+func TestClientGetObjectTagging(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc.SetGetObjectTaggingHook(func(_ context.Context, req *servicev1.GetObjectTaggingRequest) (*servicev1.GetObjectTaggingResponse, error) {
+		return &servicev1.GetObjectTaggingResponse{VersionId: req.GetVersionId(), Tags: map[string]string{"host": "web-1"}}, nil
+	})
+
+	got, err := client.GetObjectTagging(ctx, "backups", "db.tar", "null")
+	if err != nil {
+		t.Fatalf("GetObjectTagging: %v", err)
+	}
+	if got.VersionID != "null" || !maps.Equal(got.Tags, map[string]string{"host": "web-1"}) {
+		t.Fatalf("GetObjectTagging = %+v, want version null tagged host web-1", got)
+	}
+
+	call, ok := svc.LastGetObjectTaggingCall()
+	if !ok {
+		t.Fatal("no GetObjectTagging call recorded")
+	}
+	if call.Request.GetBucket() != "backups" || call.Request.GetKey() != "db.tar" || call.Request.GetVersionId() != "null" {
+		t.Fatalf("request = %v, want backups/db.tar version null", call.Request)
+	}
+}
+
+func TestClientDeleteObjectTagging(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	versionID, err := client.DeleteObjectTagging(ctx, "backups", "db.tar", "01JVERSIONXXXXXXXXXXXXXXXX")
+	if err != nil {
+		t.Fatalf("DeleteObjectTagging: %v", err)
+	}
+	if versionID != "01JVERSIONXXXXXXXXXXXXXXXX" {
+		t.Fatalf("version ID = %q, want 01JVERSIONXXXXXXXXXXXXXXXX", versionID)
+	}
+
+	call, ok := svc.LastDeleteObjectTaggingCall()
+	if !ok {
+		t.Fatal("no DeleteObjectTagging call recorded")
+	}
+	if call.Request.GetBucket() != "backups" || call.Request.GetKey() != "db.tar" {
+		t.Fatalf("request = %v, want backups/db.tar", call.Request)
+	}
+}
@@ -0,0 +1,22 @@
+package gantry
+
+import (
+	"context"
+
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+	writeplanv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/write_plan/v1"
+)
+
+func (c *Client) PlanPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, size int64) (*writeplanv1.WritePlan, error) {
+	resp, err := c.svc.PlanPart(ctx, &servicev1.PlanPartRequest{
+		Bucket:     bucket,
+		Key:        key,
+		UploadId:   uploadID,
+		PartNumber: partNumber,
+		Size:       size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetWritePlan(), nil
+}
@@ -0,0 +1,38 @@
+package gantry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+)
+
+func TestClientPlanPart(t *testing.T) {
+	t.Parallel()
+
+	client, svc := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	plan, err := client.PlanPart(requestid.WithRequestID(ctx, "req-abc"), "videos", "big.mp4", "upload-1", 3, 5242880)
+	if err != nil {
+		t.Fatalf("PlanPart: %v", err)
+	}
+	if plan.GetObjectId() != "test-blob-id" || plan.GetCradleAddress() != "localhost:9002" {
+		t.Fatalf("write plan = %v", plan)
+	}
+
+	call, ok := svc.LastPlanPartCall()
+	if !ok {
+		t.Fatal("no PlanPart call recorded")
+	}
+	req := call.Request
+	if req.GetBucket() != "videos" || req.GetKey() != "big.mp4" || req.GetUploadId() != "upload-1" ||
+		req.GetPartNumber() != 3 || req.GetSize() != 5242880 {
+		t.Fatalf("request = %v", req)
+	}
+	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
+		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
+	}
+}
@@ -3,18 +3,41 @@ package gantry
 import (
 	"context"
 
+	objectv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/object/v1"
 	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
 	writeplanv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/write_plan/v1"
 )
 
-func (c *Client) PlanWrite(ctx context.Context, bucket, key string, size int64) (*writeplanv1.WritePlan, error) {
-	resp, err := c.svc.PlanWrite(ctx, &servicev1.PlanWriteRequest{
-		Bucket: bucket,
-		Key:    key,
-		Size:   size,
-	})
+func (c *Client) PlanWrite(ctx context.Context, bucket, key string, size int64, contentType string, metadata ObjectMetadata, tags map[string]string, lock ObjectLock) (*writeplanv1.WritePlan, error) {
+	req := &servicev1.PlanWriteRequest{
+		Bucket:      bucket,
+		Key:         key,
+		Size:        size,
+		ContentType: contentType,
+		Metadata:    metadataProto(metadata),
+		Tags:        tags,
+
+		ObjectLockMode:      lock.Mode,
+		ObjectLockLegalHold: lock.LegalHold,
+	}
+	if lock.Mode != "" {
+		req.ObjectLockRetainUntilMs = lock.RetainUntil.UnixMilli()
+	}
+
+	resp, err := c.svc.PlanWrite(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 	return resp.GetWritePlan(), nil
 }
+
+// metadataProto is the wire form of md.
+func metadataProto(md ObjectMetadata) *objectv1.ObjectMetadata {
+	return &objectv1.ObjectMetadata{
+		ContentEncoding:    md.ContentEncoding,
+		ContentDisposition: md.ContentDisposition,
+		CacheControl:       md.CacheControl,
+		Expires:            md.Expires,
+		UserMetadata:       md.User,
+	}
+}
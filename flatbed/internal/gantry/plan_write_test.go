@@ -27,12 +27,19 @@ func TestClientPlanWrite(t *testing.T) {
 	})
 
 	const (
-		bucket = "photos"
-		key    = "vacation/sunset.jpg"
-		size   = int64(204800)
+		bucket      = "photos"
+		key         = "vacation/sunset.jpg"
+		size        = int64(204800)
+		contentType = "image/jpeg"
 	)
 
-	plan, err := client.PlanWrite(requestid.WithRequestID(ctx, "req-abc"), bucket, key, size)
+	metadata := ObjectMetadata{
+		ContentDisposition: "inline",
+		User:               map[string]string{"color": "blue"},
+	}
+
+	plan, err := client.PlanWrite(requestid.WithRequestID(ctx, "req-abc"), bucket, key, size, contentType, metadata, map[string]string{"host": "web-1"},
+		ObjectLock{Mode: "GOVERNANCE", RetainUntil: time.UnixMilli(1743465600000), LegalHold: true})
 	if err != nil {
 		t.Fatalf("PlanWrite: %v", err)
 	}
@@ -56,6 +63,19 @@ func TestClientPlanWrite(t *testing.T) {
 	if call.Request.GetSize() != size {
 		t.Fatalf("request Size = %d, want %d", call.Request.GetSize(), size)
 	}
+	if call.Request.GetContentType() != contentType {
+		t.Fatalf("request ContentType = %q, want %q", call.Request.GetContentType(), contentType)
+	}
+	if md := call.Request.GetMetadata(); md.GetContentDisposition() != "inline" || md.GetUserMetadata()["color"] != "blue" {
+		t.Fatalf("request Metadata = %v, want inline disposition and color blue", md)
+	}
+	if tags := call.Request.GetTags(); len(tags) != 1 || tags["host"] != "web-1" {
+		t.Fatalf("request Tags = %v, want host web-1", tags)
+	}
+	if call.Request.GetObjectLockMode() != "GOVERNANCE" || call.Request.GetObjectLockRetainUntilMs() != 1743465600000 || !call.Request.GetObjectLockLegalHold() {
+		t.Fatalf("request object lock = %s until %d hold %t, want GOVERNANCE until 1743465600000 hold true",
+			call.Request.GetObjectLockMode(), call.Request.GetObjectLockRetainUntilMs(), call.Request.GetObjectLockLegalHold())
+	}
 	if meta := call.Metadata.Get("x-request-id"); len(meta) != 1 || meta[0] != "req-abc" {
 		t.Fatalf("x-request-id = %v, want [req-abc]", meta)
 	}
@@ -13,6 +13,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	bucketv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/bucket/v1"
+	objectv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/object/v1"
 	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
 	writeplanv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/write_plan/v1"
 )
@@ -39,6 +40,196 @@ type commitObjectCall struct {
 	Request  *servicev1.CommitObjectRequest
 }
 
+type getBucketCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.GetBucketRequest
+}
+
+type lookupObjectCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.LookupObjectRequest
+}
+
+type deleteObjectCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.DeleteObjectRequest
+}
+
+type deleteBucketCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.DeleteBucketRequest
+}
+
+type listObjectsCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.ListObjectsRequest
+}
+
+type createMultipartUploadCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.CreateMultipartUploadRequest
+}
+
+type planPartCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.PlanPartRequest
+}
+
+type commitPartCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.CommitPartRequest
+}
+
+type completeMultipartUploadCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.CompleteMultipartUploadRequest
+}
+
+type abortMultipartUploadCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.AbortMultipartUploadRequest
+}
+
+type listMultipartUploadsCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.ListMultipartUploadsRequest
+}
+
+type listPartsCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.ListPartsRequest
+}
+
+type getAccessKeyCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.GetAccessKeyRequest
+}
+
+type putBucketPolicyCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.PutBucketPolicyRequest
+}
+
+type getBucketPolicyCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.GetBucketPolicyRequest
+}
+
+type deleteBucketPolicyCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.DeleteBucketPolicyRequest
+}
+
+type putBucketAclCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.PutBucketAclRequest
+}
+
+type getBucketAclCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.GetBucketAclRequest
+}
+
+type putBucketVersioningCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.PutBucketVersioningRequest
+}
+
+type getBucketVersioningCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.GetBucketVersioningRequest
+}
+
+type putBucketLifecycleCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.PutBucketLifecycleConfigurationRequest
+}
+
+type getBucketLifecycleCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.GetBucketLifecycleConfigurationRequest
+}
+
+type deleteBucketLifecycleCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.DeleteBucketLifecycleRequest
+}
+
+type putBucketCorsCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.PutBucketCorsRequest
+}
+
+type getBucketCorsCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.GetBucketCorsRequest
+}
+
+type deleteBucketCorsCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.DeleteBucketCorsRequest
+}
+
+type lookupBucketCorsCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.LookupBucketCorsRequest
+}
+
+type putObjectTaggingCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.PutObjectTaggingRequest
+}
+
+type getObjectTaggingCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.GetObjectTaggingRequest
+}
+
+type deleteObjectTaggingCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.DeleteObjectTaggingRequest
+}
+
+type deleteObjectsCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.DeleteObjectsRequest
+}
+
+type listObjectVersionsCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.ListObjectVersionsRequest
+}
+
+type putObjectLockConfigurationCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.PutObjectLockConfigurationRequest
+}
+
+type getObjectLockConfigurationCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.GetObjectLockConfigurationRequest
+}
+
+type putObjectRetentionCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.PutObjectRetentionRequest
+}
+
+type getObjectRetentionCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.GetObjectRetentionRequest
+}
+
+type putObjectLegalHoldCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.PutObjectLegalHoldRequest
+}
+
+type getObjectLegalHoldCall struct {
+	Metadata metadata.MD
+	Request  *servicev1.GetObjectLegalHoldRequest
+}
+
 type captureGantryService struct {
 	servicev1.UnimplementedGantryServiceServer
 
@@ -50,6 +241,75 @@ type captureGantryService struct {
 	planWriteCalls      []planWriteCall
 	planWriteHookFn     func(context.Context, *servicev1.PlanWriteRequest) (*servicev1.PlanWriteResponse, error)
 	commitObjectCalls   []commitObjectCall
+	getBucketCalls      []getBucketCall
+	getBucketHookFn     func(context.Context, *servicev1.GetBucketRequest) (*servicev1.GetBucketResponse, error)
+	lookupObjectCalls   []lookupObjectCall
+	lookupObjectHookFn  func(context.Context, *servicev1.LookupObjectRequest) (*servicev1.LookupObjectResponse, error)
+	deleteObjectCalls   []deleteObjectCall
+	deleteObjectHookFn  func(context.Context, *servicev1.DeleteObjectRequest) (*servicev1.DeleteObjectResponse, error)
+	deleteBucketCalls   []deleteBucketCall
+	listObjectsCalls    []listObjectsCall
+	listObjectsHookFn   func(context.Context, *servicev1.ListObjectsRequest) (*servicev1.ListObjectsResponse, error)
+
+	createMultipartUploadCalls   []createMultipartUploadCall
+	planPartCalls                []planPartCall
+	commitPartCalls              []commitPartCall
+	completeMultipartUploadCalls []completeMultipartUploadCall
+	abortMultipartUploadCalls    []abortMultipartUploadCall
+	listMultipartUploadsCalls    []listMultipartUploadsCall
+	listMultipartUploadsHookFn   func(context.Context, *servicev1.ListMultipartUploadsRequest) (*servicev1.ListMultipartUploadsResponse, error)
+	listPartsCalls               []listPartsCall
+	listPartsHookFn              func(context.Context, *servicev1.ListPartsRequest) (*servicev1.ListPartsResponse, error)
+	getAccessKeyCalls            []getAccessKeyCall
+	getAccessKeyHookFn           func(context.Context, *servicev1.GetAccessKeyRequest) (*servicev1.GetAccessKeyResponse, error)
+
+	putBucketPolicyCalls    []putBucketPolicyCall
+	getBucketPolicyCalls    []getBucketPolicyCall
+	getBucketPolicyHookFn   func(context.Context, *servicev1.GetBucketPolicyRequest) (*servicev1.GetBucketPolicyResponse, error)
+	deleteBucketPolicyCalls []deleteBucketPolicyCall
+	putBucketAclCalls       []putBucketAclCall
+	getBucketAclCalls       []getBucketAclCall
+	getBucketAclHookFn      func(context.Context, *servicev1.GetBucketAclRequest) (*servicev1.GetBucketAclResponse, error)
+
+	putBucketVersioningCalls  []putBucketVersioningCall
+	getBucketVersioningCalls  []getBucketVersioningCall
+	getBucketVersioningHookFn func(context.Context, *servicev1.GetBucketVersioningRequest) (*servicev1.GetBucketVersioningResponse, error)
+	listObjectVersionsCalls   []listObjectVersionsCall
+	listObjectVersionsHookFn  func(context.Context, *servicev1.ListObjectVersionsRequest) (*servicev1.ListObjectVersionsResponse, error)
+
+	putBucketLifecycleCalls    []putBucketLifecycleCall
+	getBucketLifecycleCalls    []getBucketLifecycleCall
+	getBucketLifecycleHookFn   func(context.Context, *servicev1.GetBucketLifecycleConfigurationRequest) (*servicev1.GetBucketLifecycleConfigurationResponse, error)
+	deleteBucketLifecycleCalls []deleteBucketLifecycleCall
+
+	putBucketCorsCalls     []putBucketCorsCall
+	getBucketCorsCalls     []getBucketCorsCall
+	getBucketCorsHookFn    func(context.Context, *servicev1.GetBucketCorsRequest) (*servicev1.GetBucketCorsResponse, error)
+	deleteBucketCorsCalls  []deleteBucketCorsCall
+	lookupBucketCorsCalls  []lookupBucketCorsCall
+	lookupBucketCorsHookFn func(context.Context, *servicev1.LookupBucketCorsRequest) (*servicev1.LookupBucketCorsResponse, error)
+
+	putObjectTaggingCalls    []putObjectTaggingCall
+	putObjectTaggingHookFn   func(context.Context, *servicev1.PutObjectTaggingRequest) (*servicev1.PutObjectTaggingResponse, error)
+	getObjectTaggingCalls    []getObjectTaggingCall
+	getObjectTaggingHookFn   func(context.Context, *servicev1.GetObjectTaggingRequest) (*servicev1.GetObjectTaggingResponse, error)
+	deleteObjectTaggingCalls []deleteObjectTaggingCall
+
+	deleteObjectsCalls  []deleteObjectsCall
+	deleteObjectsHookFn func(context.Context, *servicev1.DeleteObjectsRequest) (*servicev1.DeleteObjectsResponse, error)
+
+	putObjectLockConfigurationCalls  []putObjectLockConfigurationCall
+	putObjectLockConfigurationHookFn func(context.Context, *servicev1.PutObjectLockConfigurationRequest) (*servicev1.PutObjectLockConfigurationResponse, error)
+	getObjectLockConfigurationCalls  []getObjectLockConfigurationCall
+	getObjectLockConfigurationHookFn func(context.Context, *servicev1.GetObjectLockConfigurationRequest) (*servicev1.GetObjectLockConfigurationResponse, error)
+	putObjectRetentionCalls          []putObjectRetentionCall
+	putObjectRetentionHookFn         func(context.Context, *servicev1.PutObjectRetentionRequest) (*servicev1.PutObjectRetentionResponse, error)
+	getObjectRetentionCalls          []getObjectRetentionCall
+	getObjectRetentionHookFn         func(context.Context, *servicev1.GetObjectRetentionRequest) (*servicev1.GetObjectRetentionResponse, error)
+	putObjectLegalHoldCalls          []putObjectLegalHoldCall
+	putObjectLegalHoldHookFn         func(context.Context, *servicev1.PutObjectLegalHoldRequest) (*servicev1.PutObjectLegalHoldResponse, error)
+	getObjectLegalHoldCalls          []getObjectLegalHoldCall
+	getObjectLegalHoldHookFn         func(context.Context, *servicev1.GetObjectLegalHoldRequest) (*servicev1.GetObjectLegalHoldResponse, error)
 }
 
 func newCaptureGantryService() *captureGantryService {
@@ -62,6 +322,44 @@ func (s *captureGantryService) Reset() {
 	s.listBucketCalls = nil
 	s.planWriteCalls = nil
 	s.commitObjectCalls = nil
+	s.getBucketCalls = nil
+	s.lookupObjectCalls = nil
+	s.deleteObjectCalls = nil
+	s.deleteBucketCalls = nil
+	s.listObjectsCalls = nil
+	s.createMultipartUploadCalls = nil
+	s.planPartCalls = nil
+	s.commitPartCalls = nil
+	s.completeMultipartUploadCalls = nil
+	s.abortMultipartUploadCalls = nil
+	s.listMultipartUploadsCalls = nil
+	s.listPartsCalls = nil
+	s.getAccessKeyCalls = nil
+	s.putBucketPolicyCalls = nil
+	s.getBucketPolicyCalls = nil
+	s.deleteBucketPolicyCalls = nil
+	s.putBucketAclCalls = nil
+	s.getBucketAclCalls = nil
+	s.putBucketVersioningCalls = nil
+	s.getBucketVersioningCalls = nil
+	s.listObjectVersionsCalls = nil
+	s.putBucketLifecycleCalls = nil
+	s.getBucketLifecycleCalls = nil
+	s.deleteBucketLifecycleCalls = nil
+	s.putBucketCorsCalls = nil
+	s.getBucketCorsCalls = nil
+	s.deleteBucketCorsCalls = nil
+	s.lookupBucketCorsCalls = nil
+	s.putObjectTaggingCalls = nil
+	s.getObjectTaggingCalls = nil
+	s.deleteObjectTaggingCalls = nil
+	s.deleteObjectsCalls = nil
+	s.putObjectLockConfigurationCalls = nil
+	s.getObjectLockConfigurationCalls = nil
+	s.putObjectRetentionCalls = nil
+	s.getObjectRetentionCalls = nil
+	s.putObjectLegalHoldCalls = nil
+	s.getObjectLegalHoldCalls = nil
 	s.mu.Unlock()
 }
 
@@ -154,6 +452,42 @@ func (s *captureGantryService) SetListBucketsHook(fn func(context.Context, *serv
 	s.mu.Unlock()
 }
 
+func (s *captureGantryService) GetBucket(ctx context.Context, req *servicev1.GetBucketRequest) (*servicev1.GetBucketResponse, error) {
+	call := getBucketCall{
+		Request: proto.Clone(req).(*servicev1.GetBucketRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.getBucketCalls = append(s.getBucketCalls, call)
+	hook := s.getBucketHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.GetBucketResponse{Bucket: &bucketv1.Bucket{Name: req.GetName()}}, nil
+}
+
+func (s *captureGantryService) LastGetBucketCall() (getBucketCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.getBucketCalls) == 0 {
+		return getBucketCall{}, false
+	}
+	return s.getBucketCalls[len(s.getBucketCalls)-1], true
+}
+
+func (s *captureGantryService) SetGetBucketHook(fn func(context.Context, *servicev1.GetBucketRequest) (*servicev1.GetBucketResponse, error)) {
+	s.mu.Lock()
+	s.getBucketHookFn = fn
+	s.mu.Unlock()
+}
+
 func (s *captureGantryService) PlanWrite(ctx context.Context, req *servicev1.PlanWriteRequest) (*servicev1.PlanWriteResponse, error) {
 	call := planWriteCall{
 		Request: proto.Clone(req).(*servicev1.PlanWriteRequest),
@@ -217,7 +551,7 @@ func (s *captureGantryService) CommitObject(ctx context.Context, req *servicev1.
 	s.commitObjectCalls = append(s.commitObjectCalls, call)
 	s.mu.Unlock()
 
-	return &servicev1.CommitObjectResponse{}, nil
+	return &servicev1.CommitObjectResponse{VersionId: "test-version-1"}, nil
 }
 
 func (s *captureGantryService) LastCommitObjectCall() (commitObjectCall, bool) {
@@ -229,6 +563,48 @@ func (s *captureGantryService) LastCommitObjectCall() (commitObjectCall, bool) {
 	return s.commitObjectCalls[len(s.commitObjectCalls)-1], true
 }
 
+func (s *captureGantryService) LookupObject(ctx context.Context, req *servicev1.LookupObjectRequest) (*servicev1.LookupObjectResponse, error) {
+	call := lookupObjectCall{
+		Request: proto.Clone(req).(*servicev1.LookupObjectRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.lookupObjectCalls = append(s.lookupObjectCalls, call)
+	hook := s.lookupObjectHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.LookupObjectResponse{
+		Object: &objectv1.Object{
+			ObjectId:      "test-object-id",
+			Key:           req.GetKey(),
+			CradleAddress: "localhost:9002",
+		},
+	}, nil
+}
+
+func (s *captureGantryService) LastLookupObjectCall() (lookupObjectCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.lookupObjectCalls) == 0 {
+		return lookupObjectCall{}, false
+	}
+	return s.lookupObjectCalls[len(s.lookupObjectCalls)-1], true
+}
+
+func (s *captureGantryService) SetLookupObjectHook(fn func(context.Context, *servicev1.LookupObjectRequest) (*servicev1.LookupObjectResponse, error)) {
+	s.mu.Lock()
+	s.lookupObjectHookFn = fn
+	s.mu.Unlock()
+}
+
 func parseTime(t *testing.T, v string) time.Time {
 	t.Helper()
 	ts, err := time.Parse(time.RFC3339, v)
@@ -272,3 +648,1139 @@ func newTestClient(t *testing.T) (*Client, *captureGantryService) {
 
 	return client, svc
 }
+
+func (s *captureGantryService) DeleteObject(ctx context.Context, req *servicev1.DeleteObjectRequest) (*servicev1.DeleteObjectResponse, error) {
+	call := deleteObjectCall{
+		Request: proto.Clone(req).(*servicev1.DeleteObjectRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.deleteObjectCalls = append(s.deleteObjectCalls, call)
+	hook := s.deleteObjectHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.DeleteObjectResponse{}, nil
+}
+
+func (s *captureGantryService) SetDeleteObjectHook(fn func(context.Context, *servicev1.DeleteObjectRequest) (*servicev1.DeleteObjectResponse, error)) {
+	s.mu.Lock()
+	s.deleteObjectHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) LastDeleteObjectCall() (deleteObjectCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.deleteObjectCalls) == 0 {
+		return deleteObjectCall{}, false
+	}
+	return s.deleteObjectCalls[len(s.deleteObjectCalls)-1], true
+}
+
+func (s *captureGantryService) DeleteBucket(ctx context.Context, req *servicev1.DeleteBucketRequest) (*servicev1.DeleteBucketResponse, error) {
+	call := deleteBucketCall{
+		Request: proto.Clone(req).(*servicev1.DeleteBucketRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.deleteBucketCalls = append(s.deleteBucketCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.DeleteBucketResponse{}, nil
+}
+
+func (s *captureGantryService) LastDeleteBucketCall() (deleteBucketCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.deleteBucketCalls) == 0 {
+		return deleteBucketCall{}, false
+	}
+	return s.deleteBucketCalls[len(s.deleteBucketCalls)-1], true
+}
+
+func (s *captureGantryService) ListObjects(ctx context.Context, req *servicev1.ListObjectsRequest) (*servicev1.ListObjectsResponse, error) {
+	call := listObjectsCall{
+		Request: proto.Clone(req).(*servicev1.ListObjectsRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.listObjectsCalls = append(s.listObjectsCalls, call)
+	hook := s.listObjectsHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.ListObjectsResponse{}, nil
+}
+
+func (s *captureGantryService) LastListObjectsCall() (listObjectsCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.listObjectsCalls) == 0 {
+		return listObjectsCall{}, false
+	}
+	return s.listObjectsCalls[len(s.listObjectsCalls)-1], true
+}
+
+func (s *captureGantryService) SetListObjectsHook(fn func(context.Context, *servicev1.ListObjectsRequest) (*servicev1.ListObjectsResponse, error)) {
+	s.mu.Lock()
+	s.listObjectsHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) CreateMultipartUpload(ctx context.Context, req *servicev1.CreateMultipartUploadRequest) (*servicev1.CreateMultipartUploadResponse, error) {
+	call := createMultipartUploadCall{
+		Request: proto.Clone(req).(*servicev1.CreateMultipartUploadRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.createMultipartUploadCalls = append(s.createMultipartUploadCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.CreateMultipartUploadResponse{UploadId: "test-upload-id"}, nil
+}
+
+func (s *captureGantryService) LastCreateMultipartUploadCall() (createMultipartUploadCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.createMultipartUploadCalls) == 0 {
+		return createMultipartUploadCall{}, false
+	}
+	return s.createMultipartUploadCalls[len(s.createMultipartUploadCalls)-1], true
+}
+
+func (s *captureGantryService) PlanPart(ctx context.Context, req *servicev1.PlanPartRequest) (*servicev1.PlanPartResponse, error) {
+	call := planPartCall{
+		Request: proto.Clone(req).(*servicev1.PlanPartRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.planPartCalls = append(s.planPartCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.PlanPartResponse{
+		WritePlan: &writeplanv1.WritePlan{
+			ObjectId:      "test-blob-id",
+			CradleAddress: "localhost:9002",
+		},
+	}, nil
+}
+
+func (s *captureGantryService) LastPlanPartCall() (planPartCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.planPartCalls) == 0 {
+		return planPartCall{}, false
+	}
+	return s.planPartCalls[len(s.planPartCalls)-1], true
+}
+
+func (s *captureGantryService) CommitPart(ctx context.Context, req *servicev1.CommitPartRequest) (*servicev1.CommitPartResponse, error) {
+	call := commitPartCall{
+		Request: proto.Clone(req).(*servicev1.CommitPartRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.commitPartCalls = append(s.commitPartCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.CommitPartResponse{Etag: req.GetEtag()}, nil
+}
+
+func (s *captureGantryService) LastCommitPartCall() (commitPartCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.commitPartCalls) == 0 {
+		return commitPartCall{}, false
+	}
+	return s.commitPartCalls[len(s.commitPartCalls)-1], true
+}
+
+func (s *captureGantryService) CompleteMultipartUpload(ctx context.Context, req *servicev1.CompleteMultipartUploadRequest) (*servicev1.CompleteMultipartUploadResponse, error) {
+	call := completeMultipartUploadCall{
+		Request: proto.Clone(req).(*servicev1.CompleteMultipartUploadRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.completeMultipartUploadCalls = append(s.completeMultipartUploadCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.CompleteMultipartUploadResponse{Etag: "test-etag-2", VersionId: "test-version-2"}, nil
+}
+
+func (s *captureGantryService) LastCompleteMultipartUploadCall() (completeMultipartUploadCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.completeMultipartUploadCalls) == 0 {
+		return completeMultipartUploadCall{}, false
+	}
+	return s.completeMultipartUploadCalls[len(s.completeMultipartUploadCalls)-1], true
+}
+
+func (s *captureGantryService) AbortMultipartUpload(ctx context.Context, req *servicev1.AbortMultipartUploadRequest) (*servicev1.AbortMultipartUploadResponse, error) {
+	call := abortMultipartUploadCall{
+		Request: proto.Clone(req).(*servicev1.AbortMultipartUploadRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.abortMultipartUploadCalls = append(s.abortMultipartUploadCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.AbortMultipartUploadResponse{}, nil
+}
+
+func (s *captureGantryService) LastAbortMultipartUploadCall() (abortMultipartUploadCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.abortMultipartUploadCalls) == 0 {
+		return abortMultipartUploadCall{}, false
+	}
+	return s.abortMultipartUploadCalls[len(s.abortMultipartUploadCalls)-1], true
+}
+
+func (s *captureGantryService) ListMultipartUploads(ctx context.Context, req *servicev1.ListMultipartUploadsRequest) (*servicev1.ListMultipartUploadsResponse, error) {
+	call := listMultipartUploadsCall{
+		Request: proto.Clone(req).(*servicev1.ListMultipartUploadsRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.listMultipartUploadsCalls = append(s.listMultipartUploadsCalls, call)
+	hook := s.listMultipartUploadsHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.ListMultipartUploadsResponse{}, nil
+}
+
+func (s *captureGantryService) LastListMultipartUploadsCall() (listMultipartUploadsCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.listMultipartUploadsCalls) == 0 {
+		return listMultipartUploadsCall{}, false
+	}
+	return s.listMultipartUploadsCalls[len(s.listMultipartUploadsCalls)-1], true
+}
+
+func (s *captureGantryService) SetListMultipartUploadsHook(fn func(context.Context, *servicev1.ListMultipartUploadsRequest) (*servicev1.ListMultipartUploadsResponse, error)) {
+	s.mu.Lock()
+	s.listMultipartUploadsHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) ListParts(ctx context.Context, req *servicev1.ListPartsRequest) (*servicev1.ListPartsResponse, error) {
+	call := listPartsCall{
+		Request: proto.Clone(req).(*servicev1.ListPartsRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.listPartsCalls = append(s.listPartsCalls, call)
+	hook := s.listPartsHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.ListPartsResponse{}, nil
+}
+
+func (s *captureGantryService) LastListPartsCall() (listPartsCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.listPartsCalls) == 0 {
+		return listPartsCall{}, false
+	}
+	return s.listPartsCalls[len(s.listPartsCalls)-1], true
+}
+
+func (s *captureGantryService) SetListPartsHook(fn func(context.Context, *servicev1.ListPartsRequest) (*servicev1.ListPartsResponse, error)) {
+	s.mu.Lock()
+	s.listPartsHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) GetAccessKey(ctx context.Context, req *servicev1.GetAccessKeyRequest) (*servicev1.GetAccessKeyResponse, error) {
+	call := getAccessKeyCall{
+		Request: proto.Clone(req).(*servicev1.GetAccessKeyRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.getAccessKeyCalls = append(s.getAccessKeyCalls, call)
+	hook := s.getAccessKeyHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.GetAccessKeyResponse{AccessKeyId: req.GetAccessKeyId()}, nil
+}
+
+func (s *captureGantryService) LastGetAccessKeyCall() (getAccessKeyCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.getAccessKeyCalls) == 0 {
+		return getAccessKeyCall{}, false
+	}
+	return s.getAccessKeyCalls[len(s.getAccessKeyCalls)-1], true
+}
+
+func (s *captureGantryService) SetGetAccessKeyHook(fn func(context.Context, *servicev1.GetAccessKeyRequest) (*servicev1.GetAccessKeyResponse, error)) {
+	s.mu.Lock()
+	s.getAccessKeyHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) PutBucketPolicy(ctx context.Context, req *servicev1.PutBucketPolicyRequest) (*servicev1.PutBucketPolicyResponse, error) {
+	call := putBucketPolicyCall{
+		Request: proto.Clone(req).(*servicev1.PutBucketPolicyRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.putBucketPolicyCalls = append(s.putBucketPolicyCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.PutBucketPolicyResponse{}, nil
+}
+
+func (s *captureGantryService) LastPutBucketPolicyCall() (putBucketPolicyCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.putBucketPolicyCalls) == 0 {
+		return putBucketPolicyCall{}, false
+	}
+	return s.putBucketPolicyCalls[len(s.putBucketPolicyCalls)-1], true
+}
+
+func (s *captureGantryService) GetBucketPolicy(ctx context.Context, req *servicev1.GetBucketPolicyRequest) (*servicev1.GetBucketPolicyResponse, error) {
+	call := getBucketPolicyCall{
+		Request: proto.Clone(req).(*servicev1.GetBucketPolicyRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.getBucketPolicyCalls = append(s.getBucketPolicyCalls, call)
+	hook := s.getBucketPolicyHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.GetBucketPolicyResponse{}, nil
+}
+
+func (s *captureGantryService) LastGetBucketPolicyCall() (getBucketPolicyCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.getBucketPolicyCalls) == 0 {
+		return getBucketPolicyCall{}, false
+	}
+	return s.getBucketPolicyCalls[len(s.getBucketPolicyCalls)-1], true
+}
+
+func (s *captureGantryService) SetGetBucketPolicyHook(fn func(context.Context, *servicev1.GetBucketPolicyRequest) (*servicev1.GetBucketPolicyResponse, error)) {
+	s.mu.Lock()
+	s.getBucketPolicyHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) DeleteBucketPolicy(ctx context.Context, req *servicev1.DeleteBucketPolicyRequest) (*servicev1.DeleteBucketPolicyResponse, error) {
+	call := deleteBucketPolicyCall{
+		Request: proto.Clone(req).(*servicev1.DeleteBucketPolicyRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.deleteBucketPolicyCalls = append(s.deleteBucketPolicyCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.DeleteBucketPolicyResponse{}, nil
+}
+
+func (s *captureGantryService) LastDeleteBucketPolicyCall() (deleteBucketPolicyCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.deleteBucketPolicyCalls) == 0 {
+		return deleteBucketPolicyCall{}, false
+	}
+	return s.deleteBucketPolicyCalls[len(s.deleteBucketPolicyCalls)-1], true
+}
+
+func (s *captureGantryService) PutBucketAcl(ctx context.Context, req *servicev1.PutBucketAclRequest) (*servicev1.PutBucketAclResponse, error) {
+	call := putBucketAclCall{
+		Request: proto.Clone(req).(*servicev1.PutBucketAclRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.putBucketAclCalls = append(s.putBucketAclCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.PutBucketAclResponse{}, nil
+}
+
+func (s *captureGantryService) LastPutBucketAclCall() (putBucketAclCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.putBucketAclCalls) == 0 {
+		return putBucketAclCall{}, false
+	}
+	return s.putBucketAclCalls[len(s.putBucketAclCalls)-1], true
+}
+
+func (s *captureGantryService) GetBucketAcl(ctx context.Context, req *servicev1.GetBucketAclRequest) (*servicev1.GetBucketAclResponse, error) {
+	call := getBucketAclCall{
+		Request: proto.Clone(req).(*servicev1.GetBucketAclRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.getBucketAclCalls = append(s.getBucketAclCalls, call)
+	hook := s.getBucketAclHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.GetBucketAclResponse{}, nil
+}
+
+func (s *captureGantryService) LastGetBucketAclCall() (getBucketAclCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.getBucketAclCalls) == 0 {
+		return getBucketAclCall{}, false
+	}
+	return s.getBucketAclCalls[len(s.getBucketAclCalls)-1], true
+}
+
+func (s *captureGantryService) SetGetBucketAclHook(fn func(context.Context, *servicev1.GetBucketAclRequest) (*servicev1.GetBucketAclResponse, error)) {
+	s.mu.Lock()
+	s.getBucketAclHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) PutBucketVersioning(ctx context.Context, req *servicev1.PutBucketVersioningRequest) (*servicev1.PutBucketVersioningResponse, error) {
+	call := putBucketVersioningCall{
+		Request: proto.Clone(req).(*servicev1.PutBucketVersioningRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.putBucketVersioningCalls = append(s.putBucketVersioningCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.PutBucketVersioningResponse{}, nil
+}
+
+func (s *captureGantryService) LastPutBucketVersioningCall() (putBucketVersioningCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.putBucketVersioningCalls) == 0 {
+		return putBucketVersioningCall{}, false
+	}
+	return s.putBucketVersioningCalls[len(s.putBucketVersioningCalls)-1], true
+}
+
+func (s *captureGantryService) GetBucketVersioning(ctx context.Context, req *servicev1.GetBucketVersioningRequest) (*servicev1.GetBucketVersioningResponse, error) {
+	call := getBucketVersioningCall{
+		Request: proto.Clone(req).(*servicev1.GetBucketVersioningRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.getBucketVersioningCalls = append(s.getBucketVersioningCalls, call)
+	hook := s.getBucketVersioningHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.GetBucketVersioningResponse{}, nil
+}
+
+func (s *captureGantryService) LastGetBucketVersioningCall() (getBucketVersioningCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.getBucketVersioningCalls) == 0 {
+		return getBucketVersioningCall{}, false
+	}
+	return s.getBucketVersioningCalls[len(s.getBucketVersioningCalls)-1], true
+}
+
+func (s *captureGantryService) SetGetBucketVersioningHook(fn func(context.Context, *servicev1.GetBucketVersioningRequest) (*servicev1.GetBucketVersioningResponse, error)) {
+	s.mu.Lock()
+	s.getBucketVersioningHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) ListObjectVersions(ctx context.Context, req *servicev1.ListObjectVersionsRequest) (*servicev1.ListObjectVersionsResponse, error) {
+	call := listObjectVersionsCall{
+		Request: proto.Clone(req).(*servicev1.ListObjectVersionsRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.listObjectVersionsCalls = append(s.listObjectVersionsCalls, call)
+	hook := s.listObjectVersionsHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.ListObjectVersionsResponse{}, nil
+}
+
+func (s *captureGantryService) LastListObjectVersionsCall() (listObjectVersionsCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.listObjectVersionsCalls) == 0 {
+		return listObjectVersionsCall{}, false
+	}
+	return s.listObjectVersionsCalls[len(s.listObjectVersionsCalls)-1], true
+}
+
+func (s *captureGantryService) SetListObjectVersionsHook(fn func(context.Context, *servicev1.ListObjectVersionsRequest) (*servicev1.ListObjectVersionsResponse, error)) {
+	s.mu.Lock()
+	s.listObjectVersionsHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) PutBucketLifecycleConfiguration(ctx context.Context, req *servicev1.PutBucketLifecycleConfigurationRequest) (*servicev1.PutBucketLifecycleConfigurationResponse, error) {
+	call := putBucketLifecycleCall{
+		Request: proto.Clone(req).(*servicev1.PutBucketLifecycleConfigurationRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.putBucketLifecycleCalls = append(s.putBucketLifecycleCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.PutBucketLifecycleConfigurationResponse{}, nil
+}
+
+func (s *captureGantryService) LastPutBucketLifecycleCall() (putBucketLifecycleCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.putBucketLifecycleCalls) == 0 {
+		return putBucketLifecycleCall{}, false
+	}
+	return s.putBucketLifecycleCalls[len(s.putBucketLifecycleCalls)-1], true
+}
+
+func (s *captureGantryService) GetBucketLifecycleConfiguration(ctx context.Context, req *servicev1.GetBucketLifecycleConfigurationRequest) (*servicev1.GetBucketLifecycleConfigurationResponse, error) {
+	call := getBucketLifecycleCall{
+		Request: proto.Clone(req).(*servicev1.GetBucketLifecycleConfigurationRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.getBucketLifecycleCalls = append(s.getBucketLifecycleCalls, call)
+	hook := s.getBucketLifecycleHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.GetBucketLifecycleConfigurationResponse{}, nil
+}
+
+func (s *captureGantryService) LastGetBucketLifecycleCall() (getBucketLifecycleCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.getBucketLifecycleCalls) == 0 {
+		return getBucketLifecycleCall{}, false
+	}
+	return s.getBucketLifecycleCalls[len(s.getBucketLifecycleCalls)-1], true
+}
+
+func (s *captureGantryService) SetGetBucketLifecycleHook(fn func(context.Context, *servicev1.GetBucketLifecycleConfigurationRequest) (*servicev1.GetBucketLifecycleConfigurationResponse, error)) {
+	s.mu.Lock()
+	s.getBucketLifecycleHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) DeleteBucketLifecycle(ctx context.Context, req *servicev1.DeleteBucketLifecycleRequest) (*servicev1.DeleteBucketLifecycleResponse, error) {
+	call := deleteBucketLifecycleCall{
+		Request: proto.Clone(req).(*servicev1.DeleteBucketLifecycleRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.deleteBucketLifecycleCalls = append(s.deleteBucketLifecycleCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.DeleteBucketLifecycleResponse{}, nil
+}
+
+func (s *captureGantryService) LastDeleteBucketLifecycleCall() (deleteBucketLifecycleCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.deleteBucketLifecycleCalls) == 0 {
+		return deleteBucketLifecycleCall{}, false
+	}
+	return s.deleteBucketLifecycleCalls[len(s.deleteBucketLifecycleCalls)-1], true
+}
+
+func (s *captureGantryService) PutBucketCors(ctx context.Context, req *servicev1.PutBucketCorsRequest) (*servicev1.PutBucketCorsResponse, error) {
+	call := putBucketCorsCall{
+		Request: proto.Clone(req).(*servicev1.PutBucketCorsRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.putBucketCorsCalls = append(s.putBucketCorsCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.PutBucketCorsResponse{}, nil
+}
+
+func (s *captureGantryService) LastPutBucketCorsCall() (putBucketCorsCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.putBucketCorsCalls) == 0 {
+		return putBucketCorsCall{}, false
+	}
+	return s.putBucketCorsCalls[len(s.putBucketCorsCalls)-1], true
+}
+
+func (s *captureGantryService) GetBucketCors(ctx context.Context, req *servicev1.GetBucketCorsRequest) (*servicev1.GetBucketCorsResponse, error) {
+	call := getBucketCorsCall{
+		Request: proto.Clone(req).(*servicev1.GetBucketCorsRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.getBucketCorsCalls = append(s.getBucketCorsCalls, call)
+	hook := s.getBucketCorsHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.GetBucketCorsResponse{}, nil
+}
+
+func (s *captureGantryService) LastGetBucketCorsCall() (getBucketCorsCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.getBucketCorsCalls) == 0 {
+		return getBucketCorsCall{}, false
+	}
+	return s.getBucketCorsCalls[len(s.getBucketCorsCalls)-1], true
+}
+
+func (s *captureGantryService) SetGetBucketCorsHook(fn func(context.Context, *servicev1.GetBucketCorsRequest) (*servicev1.GetBucketCorsResponse, error)) {
+	s.mu.Lock()
+	s.getBucketCorsHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) DeleteBucketCors(ctx context.Context, req *servicev1.DeleteBucketCorsRequest) (*servicev1.DeleteBucketCorsResponse, error) {
+	call := deleteBucketCorsCall{
+		Request: proto.Clone(req).(*servicev1.DeleteBucketCorsRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.deleteBucketCorsCalls = append(s.deleteBucketCorsCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.DeleteBucketCorsResponse{}, nil
+}
+
+func (s *captureGantryService) LastDeleteBucketCorsCall() (deleteBucketCorsCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.deleteBucketCorsCalls) == 0 {
+		return deleteBucketCorsCall{}, false
+	}
+	return s.deleteBucketCorsCalls[len(s.deleteBucketCorsCalls)-1], true
+}
+
+func (s *captureGantryService) LookupBucketCors(ctx context.Context, req *servicev1.LookupBucketCorsRequest) (*servicev1.LookupBucketCorsResponse, error) {
+	call := lookupBucketCorsCall{
+		Request: proto.Clone(req).(*servicev1.LookupBucketCorsRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.lookupBucketCorsCalls = append(s.lookupBucketCorsCalls, call)
+	hook := s.lookupBucketCorsHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.LookupBucketCorsResponse{}, nil
+}
+
+func (s *captureGantryService) LastLookupBucketCorsCall() (lookupBucketCorsCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.lookupBucketCorsCalls) == 0 {
+		return lookupBucketCorsCall{}, false
+	}
+	return s.lookupBucketCorsCalls[len(s.lookupBucketCorsCalls)-1], true
+}
+
+func (s *captureGantryService) SetLookupBucketCorsHook(fn func(context.Context, *servicev1.LookupBucketCorsRequest) (*servicev1.LookupBucketCorsResponse, error)) {
+	s.mu.Lock()
+	s.lookupBucketCorsHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) PutObjectTagging(ctx context.Context, req *servicev1.PutObjectTaggingRequest) (*servicev1.PutObjectTaggingResponse, error) {
+	call := putObjectTaggingCall{
+		Request: proto.Clone(req).(*servicev1.PutObjectTaggingRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.putObjectTaggingCalls = append(s.putObjectTaggingCalls, call)
+	hook := s.putObjectTaggingHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.PutObjectTaggingResponse{}, nil
+}
+
+func (s *captureGantryService) LastPutObjectTaggingCall() (putObjectTaggingCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.putObjectTaggingCalls) == 0 {
+		return putObjectTaggingCall{}, false
+	}
+	return s.putObjectTaggingCalls[len(s.putObjectTaggingCalls)-1], true
+}
+
+func (s *captureGantryService) SetPutObjectTaggingHook(fn func(context.Context, *servicev1.PutObjectTaggingRequest) (*servicev1.PutObjectTaggingResponse, error)) {
+	s.mu.Lock()
+	s.putObjectTaggingHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) GetObjectTagging(ctx context.Context, req *servicev1.GetObjectTaggingRequest) (*servicev1.GetObjectTaggingResponse, error) {
+	call := getObjectTaggingCall{
+		Request: proto.Clone(req).(*servicev1.GetObjectTaggingRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.getObjectTaggingCalls = append(s.getObjectTaggingCalls, call)
+	hook := s.getObjectTaggingHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.GetObjectTaggingResponse{}, nil
+}
+
+func (s *captureGantryService) LastGetObjectTaggingCall() (getObjectTaggingCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.getObjectTaggingCalls) == 0 {
+		return getObjectTaggingCall{}, false
+	}
+	return s.getObjectTaggingCalls[len(s.getObjectTaggingCalls)-1], true
+}
+
+func (s *captureGantryService) SetGetObjectTaggingHook(fn func(context.Context, *servicev1.GetObjectTaggingRequest) (*servicev1.GetObjectTaggingResponse, error)) {
+	s.mu.Lock()
+	s.getObjectTaggingHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) DeleteObjectTagging(ctx context.Context, req *servicev1.DeleteObjectTaggingRequest) (*servicev1.DeleteObjectTaggingResponse, error) {
+	call := deleteObjectTaggingCall{
+		Request: proto.Clone(req).(*servicev1.DeleteObjectTaggingRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.deleteObjectTaggingCalls = append(s.deleteObjectTaggingCalls, call)
+	s.mu.Unlock()
+
+	return &servicev1.DeleteObjectTaggingResponse{VersionId: req.GetVersionId()}, nil
+}
+
+func (s *captureGantryService) LastDeleteObjectTaggingCall() (deleteObjectTaggingCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.deleteObjectTaggingCalls) == 0 {
+		return deleteObjectTaggingCall{}, false
+	}
+	return s.deleteObjectTaggingCalls[len(s.deleteObjectTaggingCalls)-1], true
+}
+
+func (s *captureGantryService) DeleteObjects(ctx context.Context, req *servicev1.DeleteObjectsRequest) (*servicev1.DeleteObjectsResponse, error) {
+	call := deleteObjectsCall{
+		Request: proto.Clone(req).(*servicev1.DeleteObjectsRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.deleteObjectsCalls = append(s.deleteObjectsCalls, call)
+	hook := s.deleteObjectsHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.DeleteObjectsResponse{}, nil
+}
+
+func (s *captureGantryService) LastDeleteObjectsCall() (deleteObjectsCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.deleteObjectsCalls) == 0 {
+		return deleteObjectsCall{}, false
+	}
+	return s.deleteObjectsCalls[len(s.deleteObjectsCalls)-1], true
+}
+
+func (s *captureGantryService) SetDeleteObjectsHook(fn func(context.Context, *servicev1.DeleteObjectsRequest) (*servicev1.DeleteObjectsResponse, error)) {
+	s.mu.Lock()
+	s.deleteObjectsHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) PutObjectLockConfiguration(ctx context.Context, req *servicev1.PutObjectLockConfigurationRequest) (*servicev1.PutObjectLockConfigurationResponse, error) {
+	call := putObjectLockConfigurationCall{
+		Request: proto.Clone(req).(*servicev1.PutObjectLockConfigurationRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.putObjectLockConfigurationCalls = append(s.putObjectLockConfigurationCalls, call)
+	hook := s.putObjectLockConfigurationHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.PutObjectLockConfigurationResponse{}, nil
+}
+
+func (s *captureGantryService) LastPutObjectLockConfigurationCall() (putObjectLockConfigurationCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.putObjectLockConfigurationCalls) == 0 {
+		return putObjectLockConfigurationCall{}, false
+	}
+	return s.putObjectLockConfigurationCalls[len(s.putObjectLockConfigurationCalls)-1], true
+}
+
+func (s *captureGantryService) SetPutObjectLockConfigurationHook(fn func(context.Context, *servicev1.PutObjectLockConfigurationRequest) (*servicev1.PutObjectLockConfigurationResponse, error)) {
+	s.mu.Lock()
+	s.putObjectLockConfigurationHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) GetObjectLockConfiguration(ctx context.Context, req *servicev1.GetObjectLockConfigurationRequest) (*servicev1.GetObjectLockConfigurationResponse, error) {
+	call := getObjectLockConfigurationCall{
+		Request: proto.Clone(req).(*servicev1.GetObjectLockConfigurationRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.getObjectLockConfigurationCalls = append(s.getObjectLockConfigurationCalls, call)
+	hook := s.getObjectLockConfigurationHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.GetObjectLockConfigurationResponse{}, nil
+}
+
+func (s *captureGantryService) LastGetObjectLockConfigurationCall() (getObjectLockConfigurationCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.getObjectLockConfigurationCalls) == 0 {
+		return getObjectLockConfigurationCall{}, false
+	}
+	return s.getObjectLockConfigurationCalls[len(s.getObjectLockConfigurationCalls)-1], true
+}
+
+func (s *captureGantryService) SetGetObjectLockConfigurationHook(fn func(context.Context, *servicev1.GetObjectLockConfigurationRequest) (*servicev1.GetObjectLockConfigurationResponse, error)) {
+	s.mu.Lock()
+	s.getObjectLockConfigurationHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) PutObjectRetention(ctx context.Context, req *servicev1.PutObjectRetentionRequest) (*servicev1.PutObjectRetentionResponse, error) {
+	call := putObjectRetentionCall{
+		Request: proto.Clone(req).(*servicev1.PutObjectRetentionRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.putObjectRetentionCalls = append(s.putObjectRetentionCalls, call)
+	hook := s.putObjectRetentionHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.PutObjectRetentionResponse{VersionId: req.GetVersionId()}, nil
+}
+
+func (s *captureGantryService) LastPutObjectRetentionCall() (putObjectRetentionCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.putObjectRetentionCalls) == 0 {
+		return putObjectRetentionCall{}, false
+	}
+	return s.putObjectRetentionCalls[len(s.putObjectRetentionCalls)-1], true
+}
+
+func (s *captureGantryService) SetPutObjectRetentionHook(fn func(context.Context, *servicev1.PutObjectRetentionRequest) (*servicev1.PutObjectRetentionResponse, error)) {
+	s.mu.Lock()
+	s.putObjectRetentionHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) GetObjectRetention(ctx context.Context, req *servicev1.GetObjectRetentionRequest) (*servicev1.GetObjectRetentionResponse, error) {
+	call := getObjectRetentionCall{
+		Request: proto.Clone(req).(*servicev1.GetObjectRetentionRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.getObjectRetentionCalls = append(s.getObjectRetentionCalls, call)
+	hook := s.getObjectRetentionHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.GetObjectRetentionResponse{}, nil
+}
+
+func (s *captureGantryService) LastGetObjectRetentionCall() (getObjectRetentionCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.getObjectRetentionCalls) == 0 {
+		return getObjectRetentionCall{}, false
+	}
+	return s.getObjectRetentionCalls[len(s.getObjectRetentionCalls)-1], true
+}
+
+func (s *captureGantryService) SetGetObjectRetentionHook(fn func(context.Context, *servicev1.GetObjectRetentionRequest) (*servicev1.GetObjectRetentionResponse, error)) {
+	s.mu.Lock()
+	s.getObjectRetentionHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) PutObjectLegalHold(ctx context.Context, req *servicev1.PutObjectLegalHoldRequest) (*servicev1.PutObjectLegalHoldResponse, error) {
+	call := putObjectLegalHoldCall{
+		Request: proto.Clone(req).(*servicev1.PutObjectLegalHoldRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.putObjectLegalHoldCalls = append(s.putObjectLegalHoldCalls, call)
+	hook := s.putObjectLegalHoldHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.PutObjectLegalHoldResponse{VersionId: req.GetVersionId()}, nil
+}
+
+func (s *captureGantryService) LastPutObjectLegalHoldCall() (putObjectLegalHoldCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.putObjectLegalHoldCalls) == 0 {
+		return putObjectLegalHoldCall{}, false
+	}
+	return s.putObjectLegalHoldCalls[len(s.putObjectLegalHoldCalls)-1], true
+}
+
+func (s *captureGantryService) SetPutObjectLegalHoldHook(fn func(context.Context, *servicev1.PutObjectLegalHoldRequest) (*servicev1.PutObjectLegalHoldResponse, error)) {
+	s.mu.Lock()
+	s.putObjectLegalHoldHookFn = fn
+	s.mu.Unlock()
+}
+
+func (s *captureGantryService) GetObjectLegalHold(ctx context.Context, req *servicev1.GetObjectLegalHoldRequest) (*servicev1.GetObjectLegalHoldResponse, error) {
+	call := getObjectLegalHoldCall{
+		Request: proto.Clone(req).(*servicev1.GetObjectLegalHoldRequest),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		call.Metadata = md.Copy()
+	}
+
+	s.mu.Lock()
+	s.getObjectLegalHoldCalls = append(s.getObjectLegalHoldCalls, call)
+	hook := s.getObjectLegalHoldHookFn
+	s.mu.Unlock()
+
+	if hook != nil {
+		return hook(ctx, req)
+	}
+
+	return &servicev1.GetObjectLegalHoldResponse{}, nil
+}
+
+func (s *captureGantryService) LastGetObjectLegalHoldCall() (getObjectLegalHoldCall, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.getObjectLegalHoldCalls) == 0 {
+		return getObjectLegalHoldCall{}, false
+	}
+	return s.getObjectLegalHoldCalls[len(s.getObjectLegalHoldCalls)-1], true
+}
+
+func (s *captureGantryService) SetGetObjectLegalHoldHook(fn func(context.Context, *servicev1.GetObjectLegalHoldRequest) (*servicev1.GetObjectLegalHoldResponse, error)) {
+	s.mu.Lock()
+	s.getObjectLegalHoldHookFn = fn
+	s.mu.Unlock()
+}
@@ -6,3 +6,270 @@ type Bucket struct {
 	Name      string
 	CreatedAt time.Time
 }
+
+type Object struct {
+	ID            string
+	Key           string
+	Size          int64
+	LastModified  time.Time
+	CradleAddress string
+	ContentType   string
+	ETag          string
+	Parts         []ObjectPart
+
+	// ChecksumAlgorithm and Checksum are the x-amz-checksum-* value the
+	// object was uploaded with, if any.
+	ChecksumAlgorithm string
+	Checksum          string
+
+	Metadata ObjectMetadata
+
+	// VersionID is empty for an object in a bucket that has never had
+	// versioning configured. IsLatest and DeleteMarker are only set on
+	// entries from ListObjectVersions.
+	VersionID    string
+	IsLatest     bool
+	DeleteMarker bool
+
+	// Tags and Lock are set by LookupObject.
+	Tags map[string]string
+	Lock ObjectLock
+}
+
+// ObjectLock is the retention and legal hold of one version of an object.
+// Mode is "GOVERNANCE", "COMPLIANCE", or empty for no retention.
+type ObjectLock struct {
+	Mode        string
+	RetainUntil time.Time
+	LegalHold   bool
+}
+
+// ObjectLockConfiguration is a bucket's object lock configuration: the
+// retention applied to versions written without one. DefaultMode is empty
+// for no default retention, else one of DefaultDays and DefaultYears is set.
+type ObjectLockConfiguration struct {
+	DefaultMode  string
+	DefaultDays  int32
+	DefaultYears int32
+}
+
+// ObjectRetention is the outcome of GetObjectRetention. VersionID is empty
+// in a bucket that has never had versioning configured.
+type ObjectRetention struct {
+	VersionID   string
+	Mode        string
+	RetainUntil time.Time
+}
+
+// ObjectLegalHold is the outcome of GetObjectLegalHold.
+type ObjectLegalHold struct {
+	VersionID string
+	On        bool
+}
+
+// ObjectTagging is the outcome of GetObjectTagging. VersionID is empty in a
+// bucket that has never had versioning configured.
+type ObjectTagging struct {
+	VersionID string
+	Tags      map[string]string
+}
+
+// ObjectMetadata holds the headers other than Content-Type that S3 stores
+// with an object and replays on GET and HEAD.
+type ObjectMetadata struct {
+	ContentEncoding    string
+	ContentDisposition string
+	CacheControl       string
+	Expires            string
+	// User holds x-amz-meta-* values keyed by the lowercased name after the
+	// prefix.
+	User map[string]string
+}
+
+// ObjectCommit describes a blob written to a cradle, for committing it as
+// the object's live version.
+type ObjectCommit struct {
+	Size           int64
+	LastModifiedMs int64
+	// ETag is the hex MD5 of the object's bytes.
+	ETag string
+	// ChecksumAlgorithm and Checksum carry the verified x-amz-checksum-*
+	// value, if the client sent one.
+	ChecksumAlgorithm string
+	Checksum          string
+	// IfNoneMatch makes the commit fail if the key already has a committed
+	// version.
+	IfNoneMatch bool
+}
+
+// ObjectDeletion is the outcome of DeleteObject. VersionID is the delete
+// marker created, or the version removed when one was named; it is empty
+// if the bucket was never versioned.
+type ObjectDeletion struct {
+	VersionID    string
+	DeleteMarker bool
+}
+
+// ObjectIdentifier names a key, or one version of it, for DeleteObjects.
+type ObjectIdentifier struct {
+	Key       string
+	VersionID string
+}
+
+// DeletedObject is one object DeleteObjects deleted. VersionID is the
+// version the request named, and DeleteMarkerVersionID the delete marker
+// created or removed when DeleteMarker is set.
+type DeletedObject struct {
+	Key                   string
+	VersionID             string
+	DeleteMarker          bool
+	DeleteMarkerVersionID string
+}
+
+// DeleteObjectError is one object DeleteObjects could not delete, with the
+// S3 error code why.
+type DeleteObjectError struct {
+	Key       string
+	VersionID string
+	Code      string
+}
+
+// ObjectDeletions is the outcome of DeleteObjects, with every object of the
+// request in either Deleted or Errors.
+type ObjectDeletions struct {
+	Deleted []DeletedObject
+	Errors  []DeleteObjectError
+}
+
+// ObjectPart is one part blob of a multipart object.
+type ObjectPart struct {
+	BlobID        string
+	Size          int64
+	CradleAddress string
+}
+
+// CompletedPart names an uploaded part to include when completing a
+// multipart upload.
+type CompletedPart struct {
+	PartNumber int32
+	ETag       string
+}
+
+type ListObjectsParams struct {
+	Prefix            string
+	Delimiter         string
+	MaxKeys           int32
+	StartAfter        string
+	ContinuationToken string
+}
+
+type ObjectListing struct {
+	Objects               []Object
+	CommonPrefixes        []string
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+type ListMultipartUploadsParams struct {
+	Prefix         string
+	Delimiter      string
+	MaxUploads     int32
+	KeyMarker      string
+	UploadIDMarker string
+}
+
+// MultipartUpload is an upload that has been started but not yet completed
+// or aborted.
+type MultipartUpload struct {
+	Key       string
+	UploadID  string
+	Initiated time.Time
+}
+
+type MultipartUploadListing struct {
+	Uploads            []MultipartUpload
+	CommonPrefixes     []string
+	IsTruncated        bool
+	NextKeyMarker      string
+	NextUploadIDMarker string
+}
+
+type ListObjectVersionsParams struct {
+	Prefix          string
+	Delimiter       string
+	MaxKeys         int32
+	KeyMarker       string
+	VersionIDMarker string
+}
+
+// ObjectVersionListing holds versions and delete markers, newest first
+// within each key.
+type ObjectVersionListing struct {
+	Versions            []Object
+	CommonPrefixes      []string
+	IsTruncated         bool
+	NextKeyMarker       string
+	NextVersionIDMarker string
+}
+
+type ListPartsParams struct {
+	PartNumberMarker int32
+	MaxParts         int32
+}
+
+// Part is an uploaded part of an in-progress multipart upload.
+type Part struct {
+	PartNumber   int32
+	ETag         string
+	Size         int64
+	LastModified time.Time
+}
+
+type PartListing struct {
+	Parts                []Part
+	IsTruncated          bool
+	NextPartNumberMarker int32
+}
+
+// AccessKey is an active access key, its secret, and the user it signs
+// requests for.
+type AccessKey struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	UserID          string
+	UserName        string
+}
+
+// BucketACL is a bucket's owner and canned ACL. OwnerID is empty for a
+// bucket created without authentication.
+type BucketACL struct {
+	OwnerID   string
+	OwnerName string
+	ACL       string
+}
+
+// LifecycleRule is one rule of a bucket lifecycle configuration, as gantry
+// validates and applies it. ExpirationDate is the zero time when the rule
+// expires current versions by ExpirationDays instead, or not at all.
+type LifecycleRule struct {
+	ID                        string
+	Enabled                   bool
+	Prefix                    string
+	Tags                      map[string]string
+	ExpirationDays            int32
+	ExpirationDate            time.Time
+	ExpiredObjectDeleteMarker bool
+	NoncurrentDays            int32
+	NewerNoncurrentVersions   int32
+}
+
+// CORSRule is one rule of a bucket CORS configuration. Origins and headers
+// may hold one * wildcard; MaxAgeSeconds is 0 when unset.
+type CORSRule struct {
+	ID             string
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	ExposeHeaders  []string
+	MaxAgeSeconds  int32
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+// AbortMultipartUpload serves DELETE /{bucket}/{key}?uploadId=ID. Uploaded
+// parts are removed from their cradles later by Gantry's cleanup worker.
+func (h *Handlers) AbortMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+	key := r.PathValue("key")
+	uploadID := r.URL.Query().Get("uploadId")
+
+	// Validate bucket name
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	// Validate key
+	if err := h.KeyValidator.ValidateKey(key); err != nil {
+		respond.Error(w, r, "InvalidKeyName", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Gantry.AbortMultipartUpload(r.Context(), bucket, key, uploadID); err != nil {
+		respondUploadError(w, r, err)
+		return
+	}
+
+	logger.LogResult(r, fmt.Sprintf("upload <%s> aborted", uploadID))
+	w.WriteHeader(http.StatusNoContent)
+}
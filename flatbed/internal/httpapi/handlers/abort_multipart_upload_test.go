@@ -0,0 +1,128 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestAbortMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		bucket         string
+		key            string
+		gantryErr      error
+		wantStatus     int
+		wantAborts     int
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:       "abort -> 204",
+			bucket:     "photos",
+			key:        "videos/big.mp4",
+			wantStatus: http.StatusNoContent,
+			wantAborts: 1,
+		},
+		{
+			name:           "invalid bucket name -> 400",
+			bucket:         "INVALID-BUCKET",
+			key:            "videos/big.mp4",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidBucketName",
+		},
+		{
+			name:           "invalid key -> 400",
+			bucket:         "photos",
+			key:            "file\x00name",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidKeyName",
+		},
+		{
+			name:   "gantry bucket not found -> 404 NoSuchBucket",
+			bucket: "nonexistent-bucket",
+			key:    "videos/big.mp4",
+			gantryErr: objectLookupErr(codes.NotFound, "bucket not found",
+				servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, "nonexistent-bucket", "videos/big.mp4"),
+			wantStatus:     http.StatusNotFound,
+			wantAborts:     1,
+			wantBodySubstr: "NoSuchBucket",
+		},
+		{
+			name:           "gantry unknown upload -> 404 NoSuchUpload",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			gantryErr:      status.Error(codes.NotFound, "NoSuchUpload"),
+			wantStatus:     http.StatusNotFound,
+			wantAborts:     1,
+			wantBodySubstr: "NoSuchUpload",
+		},
+		{
+			name:           "gantry unexpected error -> 500",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			gantryErr:      status.Error(codes.Internal, "unexpected database error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantAborts:     1,
+			wantBodySubstr: "InternalError",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			if c.gantryErr != nil {
+				gantryStub.AbortMultipartUploadFn = func(context.Context, string, string, string) error {
+					return c.gantryErr
+				}
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/?uploadId=upload-1", nil)
+			req.SetPathValue("bucket", c.bucket)
+			req.SetPathValue("key", c.key)
+			rec := httptest.NewRecorder()
+
+			h.AbortMultipartUpload(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+
+			if got := gantryStub.AbortMultipartUploadCount(); got != c.wantAborts {
+				t.Fatalf("AbortMultipartUpload calls: got %d, want %d", got, c.wantAborts)
+			}
+			if c.wantAborts > 0 {
+				call := gantryStub.AbortMultipartUploadCalls[0]
+				if call.Bucket != c.bucket || call.Key != c.key || call.UploadID != "upload-1" {
+					t.Fatalf("AbortMultipartUpload call: got %+v, want %s/%s upload-1", call, c.bucket, c.key)
+				}
+			}
+
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
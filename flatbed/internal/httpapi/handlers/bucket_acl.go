@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+const (
+	xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+	allUsersGroup           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	authenticatedUsersGroup = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+type accessControlPolicy struct {
+	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ AccessControlPolicy" json:"-"`
+	Owner   aclOwner `xml:"Owner" json:"Owner"`
+	Grants  []grant  `xml:"AccessControlList>Grant" json:"Grants"`
+}
+
+type aclOwner struct {
+	ID          string `xml:"ID" json:"ID"`
+	DisplayName string `xml:"DisplayName,omitempty" json:"DisplayName,omitempty"`
+}
+
+type grant struct {
+	Grantee    grantee `xml:"Grantee" json:"Grantee"`
+	Permission string  `xml:"Permission" json:"Permission"`
+}
+
+type grantee struct {
+	XMLNSXsi    string `xml:"xmlns:xsi,attr" json:"-"`
+	Type        string `xml:"xsi:type,attr" json:"Type"`
+	ID          string `xml:"ID,omitempty" json:"ID,omitempty"`
+	DisplayName string `xml:"DisplayName,omitempty" json:"DisplayName,omitempty"`
+	URI         string `xml:"URI,omitempty" json:"URI,omitempty"`
+}
+
+// PutBucketAcl serves PUT /{bucket}?acl. Only canned ACLs in the x-amz-acl
+// header are supported; explicit grants, in headers or an
+// AccessControlPolicy body, are not.
+func (h *Handlers) PutBucketAcl(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	if r.ContentLength > 0 || hasGrantHeader(r.Header) {
+		respond.Error(w, r, "NotImplemented", http.StatusNotImplemented)
+		return
+	}
+
+	acl := r.Header.Get("x-amz-acl")
+	if acl == "" {
+		respond.Error(w, r, "MissingSecurityHeader", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Gantry.PutBucketACL(r.Context(), bucket, acl); err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	logger.LogResult(r, fmt.Sprintf("bucket <%s> acl set to %s", bucket, acl))
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetBucketAcl serves GET /{bucket}?acl, expanding the bucket's canned ACL
+// into the grants S3 reports for it.
+func (h *Handlers) GetBucketAcl(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	acl, err := h.Gantry.GetBucketACL(r.Context(), bucket)
+	if err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	owner := aclOwner{ID: acl.OwnerID, DisplayName: acl.OwnerName}
+	result := accessControlPolicy{
+		Owner: owner,
+		Grants: []grant{{
+			Grantee:    grantee{XMLNSXsi: xsiNamespace, Type: "CanonicalUser", ID: owner.ID, DisplayName: owner.DisplayName},
+			Permission: "FULL_CONTROL",
+		}},
+	}
+
+	groupGrant := func(uri, permission string) grant {
+		return grant{Grantee: grantee{XMLNSXsi: xsiNamespace, Type: "Group", URI: uri}, Permission: permission}
+	}
+	switch acl.ACL {
+	case "public-read":
+		result.Grants = append(result.Grants, groupGrant(allUsersGroup, "READ"))
+	case "public-read-write":
+		result.Grants = append(result.Grants, groupGrant(allUsersGroup, "READ"), groupGrant(allUsersGroup, "WRITE"))
+	case "authenticated-read":
+		result.Grants = append(result.Grants, groupGrant(authenticatedUsersGroup, "READ"))
+	}
+
+	if err := respond.Encode(w, r, http.StatusOK, result); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
+
+func hasGrantHeader(header http.Header) bool {
+	for name := range header {
+		if strings.HasPrefix(strings.ToLower(name), "x-amz-grant-") {
+			return true
+		}
+	}
+	return false
+}
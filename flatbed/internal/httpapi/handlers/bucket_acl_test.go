@@ -0,0 +1,166 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+)
+
+func TestPutBucketAcl(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		header         map[string]string
+		body           string
+		gantryErr      error
+		wantStatus     int
+		wantACL        string
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{name: "canned acl -> 200", header: map[string]string{"x-amz-acl": "public-read"}, wantStatus: http.StatusOK, wantACL: "public-read"},
+		{name: "missing x-amz-acl -> 400", wantStatus: http.StatusBadRequest, wantBodySubstr: "MissingSecurityHeader"},
+		{name: "grant header -> 501", header: map[string]string{"x-amz-grant-read": "uri=http://acs.amazonaws.com/groups/global/AllUsers"}, wantStatus: http.StatusNotImplemented, wantBodySubstr: "NotImplemented"},
+		{name: "acl body -> 501", body: "<AccessControlPolicy/>", wantStatus: http.StatusNotImplemented, wantBodySubstr: "NotImplemented"},
+		{name: "unknown acl -> 400", header: map[string]string{"x-amz-acl": "everyone"}, gantryErr: status.Error(codes.InvalidArgument, "InvalidArgument"), wantStatus: http.StatusBadRequest, wantACL: "everyone", wantBodySubstr: "InvalidArgument"},
+		{name: "not the owner -> 403", header: map[string]string{"x-amz-acl": "private"}, gantryErr: status.Error(codes.PermissionDenied, "AccessDenied"), wantStatus: http.StatusForbidden, wantACL: "private", wantBodySubstr: "AccessDenied"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.PutBucketACLFn = func(context.Context, string, string) error { return c.gantryErr }
+			h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+			req := httptest.NewRequest(http.MethodPut, "/photos?acl", strings.NewReader(c.body))
+			req.SetPathValue("bucket", "photos")
+			for k, v := range c.header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			h.PutBucketAcl(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			calls := gantryStub.PutBucketACLCalls
+			if c.wantACL == "" && len(calls) != 0 {
+				t.Fatalf("PutBucketACL calls: got %+v, want none", calls)
+			}
+			if c.wantACL != "" && (len(calls) != 1 || calls[0].Bucket != "photos" || calls[0].Value != c.wantACL) {
+				t.Fatalf("PutBucketACL calls: got %+v, want one with %q", calls, c.wantACL)
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetBucketAcl(t *testing.T) {
+	t.Parallel()
+
+	type grantee struct {
+		Type string `xml:"type,attr"`
+		ID   string `xml:"ID"`
+		URI  string `xml:"URI"`
+	}
+	type grant struct {
+		Grantee    grantee `xml:"Grantee"`
+		Permission string  `xml:"Permission"`
+	}
+	type policy struct {
+		OwnerID   string  `xml:"Owner>ID"`
+		OwnerName string  `xml:"Owner>DisplayName"`
+		Grants    []grant `xml:"AccessControlList>Grant"`
+	}
+
+	const (
+		allUsers  = "http://acs.amazonaws.com/groups/global/AllUsers"
+		authUsers = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+	)
+
+	type tc struct {
+		name       string
+		acl        string
+		wantGrants []grant
+	}
+
+	owner := grant{Grantee: grantee{Type: "CanonicalUser", ID: "user-alice"}, Permission: "FULL_CONTROL"}
+	cases := []tc{
+		{name: "private", acl: "private", wantGrants: []grant{owner}},
+		{name: "public-read", acl: "public-read", wantGrants: []grant{owner, {Grantee: grantee{Type: "Group", URI: allUsers}, Permission: "READ"}}},
+		{name: "public-read-write", acl: "public-read-write", wantGrants: []grant{owner, {Grantee: grantee{Type: "Group", URI: allUsers}, Permission: "READ"}, {Grantee: grantee{Type: "Group", URI: allUsers}, Permission: "WRITE"}}},
+		{name: "authenticated-read", acl: "authenticated-read", wantGrants: []grant{owner, {Grantee: grantee{Type: "Group", URI: authUsers}, Permission: "READ"}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.GetBucketACLFn = func(context.Context, string) (gantry.BucketACL, error) {
+				return gantry.BucketACL{OwnerID: "user-alice", OwnerName: "alice", ACL: c.acl}, nil
+			}
+			h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+			rec := httptest.NewRecorder()
+			h.GetBucketAcl(rec, reqWithBucket(t, http.MethodGet, "photos"))
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status: got %d, want %d", rec.Code, http.StatusOK)
+			}
+			if !strings.Contains(rec.Body.String(), `xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"`) {
+				t.Fatalf("body missing xsi namespace: %s", rec.Body.String())
+			}
+
+			var got policy
+			if err := xml.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("unmarshal: %v\n%s", err, rec.Body.String())
+			}
+			if got.OwnerID != "user-alice" || got.OwnerName != "alice" {
+				t.Fatalf("owner: got %q %q", got.OwnerID, got.OwnerName)
+			}
+			if len(got.Grants) != len(c.wantGrants) {
+				t.Fatalf("grants: got %+v, want %+v", got.Grants, c.wantGrants)
+			}
+			for i := range c.wantGrants {
+				if got.Grants[i] != c.wantGrants[i] {
+					t.Fatalf("grant %d: got %+v, want %+v", i, got.Grants[i], c.wantGrants[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGetBucketAcl_GantryError(t *testing.T) {
+	t.Parallel()
+
+	gantryStub := testutil.NewGantryStub()
+	gantryStub.GetBucketACLFn = func(context.Context, string) (gantry.BucketACL, error) {
+		return gantry.BucketACL{}, status.Error(codes.NotFound, "NoSuchBucket")
+	}
+	h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+	rec := httptest.NewRecorder()
+	h.GetBucketAcl(rec, reqWithBucket(t, http.MethodGet, "photos"))
+
+	if rec.Code != http.StatusNotFound || !strings.Contains(rec.Body.String(), "NoSuchBucket") {
+		t.Fatalf("got %d %s, want 404 NoSuchBucket", rec.Code, rec.Body.String())
+	}
+}
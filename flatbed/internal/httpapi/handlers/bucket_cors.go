@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+// maxCORSBodyBytes bounds the PutBucketCors body, as S3 limits a CORS
+// configuration to 64 KB.
+const maxCORSBodyBytes = 64 * 1024
+
+// corsConfigurationRequest is the request body of PutBucketCors, matched
+// without a namespace like completeMultipartUpload.
+type corsConfigurationRequest struct {
+	XMLName xml.Name   `xml:"CORSConfiguration"`
+	Rules   []corsRule `xml:"CORSRule"`
+}
+
+type corsConfiguration struct {
+	XMLName xml.Name   `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CORSConfiguration" json:"-"`
+	Rules   []corsRule `xml:"CORSRule" json:"CORSRules"`
+}
+
+type corsRule struct {
+	ID             string   `xml:"ID,omitempty" json:"ID,omitempty"`
+	AllowedHeaders []string `xml:"AllowedHeader" json:"AllowedHeaders,omitempty"`
+	AllowedMethods []string `xml:"AllowedMethod" json:"AllowedMethods"`
+	AllowedOrigins []string `xml:"AllowedOrigin" json:"AllowedOrigins"`
+	ExposeHeaders  []string `xml:"ExposeHeader" json:"ExposeHeaders,omitempty"`
+	MaxAgeSeconds  int32    `xml:"MaxAgeSeconds,omitempty" json:"MaxAgeSeconds,omitempty"`
+}
+
+// PutBucketCors serves PUT /{bucket}?cors. Gantry validates and stores the
+// rules; the CORS middleware applies them.
+func (h *Handlers) PutBucketCors(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	var body corsConfigurationRequest
+	if err := xml.NewDecoder(http.MaxBytesReader(w, r.Body, maxCORSBodyBytes)).Decode(&body); err != nil || len(body.Rules) == 0 {
+		respond.Error(w, r, "MalformedXML", http.StatusBadRequest)
+		return
+	}
+
+	rules := make([]gantry.CORSRule, 0, len(body.Rules))
+	for _, rule := range body.Rules {
+		// Every rule needs an AllowedOrigin and an AllowedMethod to
+		// validate against S3's schema.
+		if len(rule.AllowedOrigins) == 0 || len(rule.AllowedMethods) == 0 {
+			respond.Error(w, r, "MalformedXML", http.StatusBadRequest)
+			return
+		}
+		rules = append(rules, gantry.CORSRule{
+			ID:             rule.ID,
+			AllowedOrigins: rule.AllowedOrigins,
+			AllowedMethods: rule.AllowedMethods,
+			AllowedHeaders: rule.AllowedHeaders,
+			ExposeHeaders:  rule.ExposeHeaders,
+			MaxAgeSeconds:  rule.MaxAgeSeconds,
+		})
+	}
+
+	if err := h.Gantry.PutBucketCors(r.Context(), bucket, rules); err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	logger.LogResult(r, fmt.Sprintf("bucket <%s> cors set with %d rules", bucket, len(rules)))
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetBucketCors serves GET /{bucket}?cors.
+func (h *Handlers) GetBucketCors(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	rules, err := h.Gantry.GetBucketCors(r.Context(), bucket)
+	if err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	out := corsConfiguration{Rules: make([]corsRule, 0, len(rules))}
+	for _, rule := range rules {
+		out.Rules = append(out.Rules, corsRule{
+			ID:             rule.ID,
+			AllowedHeaders: rule.AllowedHeaders,
+			AllowedMethods: rule.AllowedMethods,
+			AllowedOrigins: rule.AllowedOrigins,
+			ExposeHeaders:  rule.ExposeHeaders,
+			MaxAgeSeconds:  rule.MaxAgeSeconds,
+		})
+	}
+
+	if err := respond.Encode(w, r, http.StatusOK, out); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
+
+// DeleteBucketCors serves DELETE /{bucket}?cors.
+func (h *Handlers) DeleteBucketCors(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Gantry.DeleteBucketCors(r.Context(), bucket); err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	logger.LogResult(r, fmt.Sprintf("bucket <%s> cors deleted", bucket))
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,180 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+)
+
+func TestPutBucketCors(t *testing.T) {
+	t.Parallel()
+
+	const galleryBody = `<CORSConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><CORSRule><ID>gallery</ID><AllowedHeader>*</AllowedHeader><AllowedMethod>GET</AllowedMethod><AllowedMethod>HEAD</AllowedMethod><AllowedOrigin>https://gallery.example.com</AllowedOrigin><ExposeHeader>ETag</ExposeHeader><MaxAgeSeconds>3000</MaxAgeSeconds></CORSRule></CORSConfiguration>`
+	gallery := gantry.CORSRule{
+		ID:             "gallery",
+		AllowedOrigins: []string{"https://gallery.example.com"},
+		AllowedMethods: []string{"GET", "HEAD"},
+		AllowedHeaders: []string{"*"},
+		ExposeHeaders:  []string{"ETag"},
+		MaxAgeSeconds:  3000,
+	}
+
+	type tc struct {
+		name           string
+		body           string
+		gantryErr      error
+		wantStatus     int
+		wantRules      []gantry.CORSRule
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{name: "rules -> 200", body: galleryBody, wantStatus: http.StatusOK, wantRules: []gantry.CORSRule{gallery}},
+		{
+			name:       "without namespace -> 200",
+			body:       `<CORSConfiguration><CORSRule><AllowedMethod>GET</AllowedMethod><AllowedOrigin>*</AllowedOrigin></CORSRule></CORSConfiguration>`,
+			wantStatus: http.StatusOK,
+			wantRules:  []gantry.CORSRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}}},
+		},
+		{name: "malformed body -> 400", body: "<CORSConfiguration>", wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{name: "no rules -> 400", body: "<CORSConfiguration></CORSConfiguration>", wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{name: "rule without origin -> 400", body: `<CORSConfiguration><CORSRule><AllowedMethod>GET</AllowedMethod></CORSRule></CORSConfiguration>`, wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{name: "rule without method -> 400", body: `<CORSConfiguration><CORSRule><AllowedOrigin>*</AllowedOrigin></CORSRule></CORSConfiguration>`, wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{
+			name:           "rejected by gantry -> 400",
+			body:           `<CORSConfiguration><CORSRule><AllowedMethod>PATCH</AllowedMethod><AllowedOrigin>*</AllowedOrigin></CORSRule></CORSConfiguration>`,
+			gantryErr:      status.Error(codes.InvalidArgument, "InvalidRequest"),
+			wantStatus:     http.StatusBadRequest,
+			wantRules:      []gantry.CORSRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"PATCH"}}},
+			wantBodySubstr: "InvalidRequest",
+		},
+		{name: "not the owner -> 403", body: galleryBody, gantryErr: status.Error(codes.PermissionDenied, "AccessDenied"), wantStatus: http.StatusForbidden, wantRules: []gantry.CORSRule{gallery}, wantBodySubstr: "AccessDenied"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.PutBucketCorsFn = func(context.Context, string, []gantry.CORSRule) error { return c.gantryErr }
+			h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+			req := httptest.NewRequest(http.MethodPut, "/photos?cors", strings.NewReader(c.body))
+			req.SetPathValue("bucket", "photos")
+			rec := httptest.NewRecorder()
+			h.PutBucketCors(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d; body: %s", rec.Code, c.wantStatus, rec.Body.String())
+			}
+			calls := gantryStub.PutBucketCorsCalls
+			if c.wantRules == nil {
+				if len(calls) != 0 {
+					t.Fatalf("PutBucketCors calls: got %+v, want none", calls)
+				}
+			} else if len(calls) != 1 || calls[0].Bucket != "photos" || !reflect.DeepEqual(calls[0].Rules, c.wantRules) {
+				t.Fatalf("PutBucketCors calls: got %+v, want rules %+v", calls, c.wantRules)
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetBucketCors(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name       string
+		rules      []gantry.CORSRule
+		gantryErr  error
+		wantStatus int
+		wantBody   string
+	}
+
+	cases := []tc{
+		{
+			name: "rules",
+			rules: []gantry.CORSRule{
+				{ID: "gallery", AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET", "HEAD"}, AllowedHeaders: []string{"*"}, ExposeHeaders: []string{"ETag"}, MaxAgeSeconds: 3000},
+				{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `<CORSConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><CORSRule><ID>gallery</ID><AllowedHeader>*</AllowedHeader><AllowedMethod>GET</AllowedMethod><AllowedMethod>HEAD</AllowedMethod><AllowedOrigin>https://gallery.example.com</AllowedOrigin><ExposeHeader>ETag</ExposeHeader><MaxAgeSeconds>3000</MaxAgeSeconds></CORSRule><CORSRule><AllowedMethod>GET</AllowedMethod><AllowedOrigin>*</AllowedOrigin></CORSRule></CORSConfiguration>`,
+		},
+		{name: "never configured -> 404", gantryErr: status.Error(codes.NotFound, "NoSuchCORSConfiguration"), wantStatus: http.StatusNotFound, wantBody: "NoSuchCORSConfiguration"},
+		{name: "not the owner -> 403", gantryErr: status.Error(codes.PermissionDenied, "AccessDenied"), wantStatus: http.StatusForbidden, wantBody: "AccessDenied"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.GetBucketCorsFn = func(context.Context, string) ([]gantry.CORSRule, error) {
+				return c.rules, c.gantryErr
+			}
+			h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+			rec := httptest.NewRecorder()
+			h.GetBucketCors(rec, reqWithBucket(t, http.MethodGet, "photos"))
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			if !strings.Contains(rec.Body.String(), c.wantBody) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBody, rec.Body.String())
+			}
+			if calls := gantryStub.GetBucketCorsCalls; len(calls) != 1 || calls[0] != "photos" {
+				t.Fatalf("GetBucketCors calls: got %v, want [photos]", calls)
+			}
+		})
+	}
+}
+
+func TestDeleteBucketCors(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name       string
+		gantryErr  error
+		wantStatus int
+	}
+
+	cases := []tc{
+		{name: "deleted -> 204", wantStatus: http.StatusNoContent},
+		{name: "missing bucket -> 404", gantryErr: status.Error(codes.NotFound, "NoSuchBucket"), wantStatus: http.StatusNotFound},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.DeleteBucketCorsFn = func(context.Context, string) error { return c.gantryErr }
+			h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+			rec := httptest.NewRecorder()
+			h.DeleteBucketCors(rec, reqWithBucket(t, http.MethodDelete, "photos"))
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			if calls := gantryStub.DeleteBucketCorsCalls; len(calls) != 1 || calls[0] != "photos" {
+				t.Fatalf("DeleteBucketCors calls: got %v, want [photos]", calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+// maxLifecycleBodyBytes bounds the PutBucketLifecycleConfiguration body,
+// leaving room for S3's limit of 1000 rules.
+const maxLifecycleBodyBytes = 1024 * 1024
+
+// lifecycleConfigurationRequest is the request body of
+// PutBucketLifecycleConfiguration, matched without a namespace like
+// completeMultipartUpload.
+type lifecycleConfigurationRequest struct {
+	XMLName xml.Name        `xml:"LifecycleConfiguration"`
+	Rules   []lifecycleRule `xml:"Rule"`
+}
+
+type lifecycleConfiguration struct {
+	XMLName xml.Name        `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LifecycleConfiguration" json:"-"`
+	Rules   []lifecycleRule `xml:"Rule" json:"Rules"`
+}
+
+// lifecycleRule is a Rule element, both as put and as returned. Prefix is
+// the deprecated form of a prefix Filter, accepted but never returned. The
+// actions there is no support for are only decoded to be rejected.
+type lifecycleRule struct {
+	ID                          string                       `xml:"ID,omitempty" json:"ID,omitempty"`
+	Filter                      *lifecycleFilter             `xml:"Filter,omitempty" json:"Filter,omitempty"`
+	Prefix                      *string                      `xml:"Prefix,omitempty" json:"-"`
+	Status                      string                       `xml:"Status" json:"Status"`
+	Expiration                  *lifecycleExpiration         `xml:"Expiration,omitempty" json:"Expiration,omitempty"`
+	NoncurrentVersionExpiration *noncurrentVersionExpiration `xml:"NoncurrentVersionExpiration,omitempty" json:"NoncurrentVersionExpiration,omitempty"`
+
+	Transitions                    []struct{} `xml:"Transition,omitempty" json:"-"`
+	NoncurrentVersionTransitions   []struct{} `xml:"NoncurrentVersionTransition,omitempty" json:"-"`
+	AbortIncompleteMultipartUpload *struct{}  `xml:"AbortIncompleteMultipartUpload,omitempty" json:"-"`
+}
+
+// lifecycleFilter holds one of Prefix, Tag or And. An empty filter selects
+// every object.
+type lifecycleFilter struct {
+	Prefix *string       `xml:"Prefix,omitempty" json:"Prefix,omitempty"`
+	Tag    *lifecycleTag `xml:"Tag,omitempty" json:"Tag,omitempty"`
+	And    *lifecycleAnd `xml:"And,omitempty" json:"And,omitempty"`
+
+	ObjectSizeGreaterThan *int64 `xml:"ObjectSizeGreaterThan,omitempty" json:"-"`
+	ObjectSizeLessThan    *int64 `xml:"ObjectSizeLessThan,omitempty" json:"-"`
+}
+
+type lifecycleAnd struct {
+	Prefix string         `xml:"Prefix,omitempty" json:"Prefix,omitempty"`
+	Tags   []lifecycleTag `xml:"Tag" json:"Tags"`
+
+	ObjectSizeGreaterThan *int64 `xml:"ObjectSizeGreaterThan,omitempty" json:"-"`
+	ObjectSizeLessThan    *int64 `xml:"ObjectSizeLessThan,omitempty" json:"-"`
+}
+
+type lifecycleTag struct {
+	Key   string `xml:"Key" json:"Key"`
+	Value string `xml:"Value" json:"Value"`
+}
+
+type lifecycleExpiration struct {
+	Date                      string `xml:"Date,omitempty" json:"Date,omitempty"`
+	Days                      int32  `xml:"Days,omitempty" json:"Days,omitempty"`
+	ExpiredObjectDeleteMarker bool   `xml:"ExpiredObjectDeleteMarker,omitempty" json:"ExpiredObjectDeleteMarker,omitempty"`
+}
+
+type noncurrentVersionExpiration struct {
+	NoncurrentDays          int32 `xml:"NoncurrentDays" json:"NoncurrentDays"`
+	NewerNoncurrentVersions int32 `xml:"NewerNoncurrentVersions,omitempty" json:"NewerNoncurrentVersions,omitempty"`
+}
+
+// PutBucketLifecycleConfiguration serves PUT /{bucket}?lifecycle. Gantry
+// validates and applies the rules. There is only the STANDARD storage class,
+// so transitions are rejected, as are size filters and aborting incomplete
+// multipart uploads, which gantry does on its own schedule.
+func (h *Handlers) PutBucketLifecycleConfiguration(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	var body lifecycleConfigurationRequest
+	if err := xml.NewDecoder(http.MaxBytesReader(w, r.Body, maxLifecycleBodyBytes)).Decode(&body); err != nil || len(body.Rules) == 0 {
+		respond.Error(w, r, "MalformedXML", http.StatusBadRequest)
+		return
+	}
+
+	rules := make([]gantry.LifecycleRule, 0, len(body.Rules))
+	for _, rule := range body.Rules {
+		parsed, code := parseLifecycleRule(rule)
+		if code != "" {
+			respond.Error(w, r, code, lifecycleErrorStatus(code))
+			return
+		}
+		rules = append(rules, parsed)
+	}
+
+	if err := h.Gantry.PutBucketLifecycleConfiguration(r.Context(), bucket, rules); err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	logger.LogResult(r, fmt.Sprintf("bucket <%s> lifecycle set with %d rules", bucket, len(rules)))
+	w.WriteHeader(http.StatusOK)
+}
+
+// parseLifecycleRule converts a Rule element for gantry, or returns the S3
+// error code it is rejected with.
+func parseLifecycleRule(rule lifecycleRule) (gantry.LifecycleRule, string) {
+	switch {
+	case rule.Status != "Enabled" && rule.Status != "Disabled":
+		return gantry.LifecycleRule{}, "MalformedXML"
+	case rule.Prefix != nil && rule.Filter != nil:
+		return gantry.LifecycleRule{}, "MalformedXML"
+	case len(rule.Transitions) > 0 || len(rule.NoncurrentVersionTransitions) > 0:
+		return gantry.LifecycleRule{}, "InvalidStorageClass"
+	case rule.AbortIncompleteMultipartUpload != nil:
+		return gantry.LifecycleRule{}, "NotImplemented"
+	}
+
+	parsed := gantry.LifecycleRule{ID: rule.ID, Enabled: rule.Status == "Enabled"}
+	if rule.Prefix != nil {
+		parsed.Prefix = *rule.Prefix
+	}
+
+	if f := rule.Filter; f != nil {
+		if f.ObjectSizeGreaterThan != nil || f.ObjectSizeLessThan != nil {
+			return gantry.LifecycleRule{}, "NotImplemented"
+		}
+
+		set := 0
+		if f.Prefix != nil {
+			set++
+			parsed.Prefix = *f.Prefix
+		}
+		if f.Tag != nil {
+			set++
+			parsed.Tags = map[string]string{f.Tag.Key: f.Tag.Value}
+		}
+		if f.And != nil {
+			set++
+			if f.And.ObjectSizeGreaterThan != nil || f.And.ObjectSizeLessThan != nil {
+				return gantry.LifecycleRule{}, "NotImplemented"
+			}
+			parsed.Prefix = f.And.Prefix
+			parsed.Tags = make(map[string]string, len(f.And.Tags))
+			for _, tag := range f.And.Tags {
+				if _, dup := parsed.Tags[tag.Key]; dup {
+					return gantry.LifecycleRule{}, "InvalidRequest"
+				}
+				parsed.Tags[tag.Key] = tag.Value
+			}
+		}
+		if set > 1 {
+			return gantry.LifecycleRule{}, "MalformedXML"
+		}
+	}
+
+	if e := rule.Expiration; e != nil {
+		parsed.ExpirationDays = e.Days
+		parsed.ExpiredObjectDeleteMarker = e.ExpiredObjectDeleteMarker
+		if e.Date != "" {
+			date, err := time.Parse(time.RFC3339, e.Date)
+			if err != nil {
+				return gantry.LifecycleRule{}, "InvalidArgument"
+			}
+			parsed.ExpirationDate = date
+		}
+	}
+
+	if n := rule.NoncurrentVersionExpiration; n != nil {
+		parsed.NoncurrentDays = n.NoncurrentDays
+		parsed.NewerNoncurrentVersions = n.NewerNoncurrentVersions
+	}
+
+	return parsed, ""
+}
+
+func lifecycleErrorStatus(code string) int {
+	if code == "NotImplemented" {
+		return http.StatusNotImplemented
+	}
+	return http.StatusBadRequest
+}
+
+// GetBucketLifecycleConfiguration serves GET /{bucket}?lifecycle. Rules are
+// returned with the Filter form of their prefix and tags.
+func (h *Handlers) GetBucketLifecycleConfiguration(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	rules, err := h.Gantry.GetBucketLifecycleConfiguration(r.Context(), bucket)
+	if err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	out := lifecycleConfiguration{Rules: make([]lifecycleRule, 0, len(rules))}
+	for _, rule := range rules {
+		out.Rules = append(out.Rules, lifecycleRuleOf(rule))
+	}
+
+	if err := respond.Encode(w, r, http.StatusOK, out); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
+
+func lifecycleRuleOf(rule gantry.LifecycleRule) lifecycleRule {
+	out := lifecycleRule{ID: rule.ID, Status: "Disabled"}
+	if rule.Enabled {
+		out.Status = "Enabled"
+	}
+
+	switch {
+	case len(rule.Tags) == 0:
+		prefix := rule.Prefix
+		out.Filter = &lifecycleFilter{Prefix: &prefix}
+	case len(rule.Tags) == 1 && rule.Prefix == "":
+		for k, v := range rule.Tags {
+			out.Filter = &lifecycleFilter{Tag: &lifecycleTag{Key: k, Value: v}}
+		}
+	default:
+		and := &lifecycleAnd{Prefix: rule.Prefix}
+		for k, v := range rule.Tags {
+			and.Tags = append(and.Tags, lifecycleTag{Key: k, Value: v})
+		}
+		sort.Slice(and.Tags, func(i, j int) bool { return and.Tags[i].Key < and.Tags[j].Key })
+		out.Filter = &lifecycleFilter{And: and}
+	}
+
+	if rule.ExpirationDays > 0 || !rule.ExpirationDate.IsZero() || rule.ExpiredObjectDeleteMarker {
+		out.Expiration = &lifecycleExpiration{
+			Days:                      rule.ExpirationDays,
+			ExpiredObjectDeleteMarker: rule.ExpiredObjectDeleteMarker,
+		}
+		if !rule.ExpirationDate.IsZero() {
+			out.Expiration.Date = rule.ExpirationDate.UTC().Format("2006-01-02T15:04:05.000Z")
+		}
+	}
+
+	if rule.NoncurrentDays > 0 {
+		out.NoncurrentVersionExpiration = &noncurrentVersionExpiration{
+			NoncurrentDays:          rule.NoncurrentDays,
+			NewerNoncurrentVersions: rule.NewerNoncurrentVersions,
+		}
+	}
+
+	return out
+}
+
+// DeleteBucketLifecycle serves DELETE /{bucket}?lifecycle.
+func (h *Handlers) DeleteBucketLifecycle(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Gantry.DeleteBucketLifecycle(r.Context(), bucket); err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	logger.LogResult(r, fmt.Sprintf("bucket <%s> lifecycle deleted", bucket))
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,220 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+)
+
+func TestPutBucketLifecycleConfiguration(t *testing.T) {
+	t.Parallel()
+
+	rule := func(inner string) string {
+		return `<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Rule>` + inner + `</Rule></LifecycleConfiguration>`
+	}
+
+	type tc struct {
+		name           string
+		body           string
+		gantryErr      error
+		wantStatus     int
+		wantRules      []gantry.LifecycleRule
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:       "prefix filter with days -> 200",
+			body:       rule(`<ID>footage</ID><Filter><Prefix>footage/</Prefix></Filter><Status>Enabled</Status><Expiration><Days>30</Days></Expiration>`),
+			wantStatus: http.StatusOK,
+			wantRules:  []gantry.LifecycleRule{{ID: "footage", Enabled: true, Prefix: "footage/", ExpirationDays: 30}},
+		},
+		{
+			name:       "deprecated prefix without namespace -> 200",
+			body:       `<LifecycleConfiguration><Rule><Prefix>logs/</Prefix><Status>Disabled</Status><Expiration><Days>1</Days></Expiration></Rule></LifecycleConfiguration>`,
+			wantStatus: http.StatusOK,
+			wantRules:  []gantry.LifecycleRule{{Prefix: "logs/", ExpirationDays: 1}},
+		},
+		{
+			name:       "tag filter with date -> 200",
+			body:       rule(`<Filter><Tag><Key>retain</Key><Value>short</Value></Tag></Filter><Status>Enabled</Status><Expiration><Date>2030-01-01T00:00:00Z</Date></Expiration>`),
+			wantStatus: http.StatusOK,
+			wantRules:  []gantry.LifecycleRule{{Enabled: true, Tags: map[string]string{"retain": "short"}, ExpirationDate: time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)}},
+		},
+		{
+			name:       "and filter with noncurrent expiration -> 200",
+			body:       rule(`<Filter><And><Prefix>a/</Prefix><Tag><Key>k1</Key><Value>v1</Value></Tag><Tag><Key>k2</Key><Value>v2</Value></Tag></And></Filter><Status>Enabled</Status><NoncurrentVersionExpiration><NoncurrentDays>7</NoncurrentDays><NewerNoncurrentVersions>3</NewerNoncurrentVersions></NoncurrentVersionExpiration>`),
+			wantStatus: http.StatusOK,
+			wantRules:  []gantry.LifecycleRule{{Enabled: true, Prefix: "a/", Tags: map[string]string{"k1": "v1", "k2": "v2"}, NoncurrentDays: 7, NewerNoncurrentVersions: 3}},
+		},
+		{
+			name:       "empty filter with delete marker expiry -> 200",
+			body:       rule(`<Filter></Filter><Status>Enabled</Status><Expiration><ExpiredObjectDeleteMarker>true</ExpiredObjectDeleteMarker></Expiration>`),
+			wantStatus: http.StatusOK,
+			wantRules:  []gantry.LifecycleRule{{Enabled: true, ExpiredObjectDeleteMarker: true}},
+		},
+		{name: "malformed body -> 400", body: "<LifecycleConfiguration>", wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{name: "no rules -> 400", body: "<LifecycleConfiguration></LifecycleConfiguration>", wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{name: "bad status -> 400", body: rule(`<Filter/><Status>On</Status><Expiration><Days>1</Days></Expiration>`), wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{name: "prefix and filter -> 400", body: rule(`<Prefix>a/</Prefix><Filter/><Status>Enabled</Status><Expiration><Days>1</Days></Expiration>`), wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{name: "two filter elements -> 400", body: rule(`<Filter><Prefix>a/</Prefix><Tag><Key>k</Key><Value>v</Value></Tag></Filter><Status>Enabled</Status><Expiration><Days>1</Days></Expiration>`), wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{name: "duplicate tag keys -> 400", body: rule(`<Filter><And><Tag><Key>k</Key><Value>1</Value></Tag><Tag><Key>k</Key><Value>2</Value></Tag></And></Filter><Status>Enabled</Status><Expiration><Days>1</Days></Expiration>`), wantStatus: http.StatusBadRequest, wantBodySubstr: "InvalidRequest"},
+		{name: "bad date -> 400", body: rule(`<Filter/><Status>Enabled</Status><Expiration><Date>next week</Date></Expiration>`), wantStatus: http.StatusBadRequest, wantBodySubstr: "InvalidArgument"},
+		{name: "transition -> 400", body: rule(`<Filter/><Status>Enabled</Status><Transition><Days>30</Days><StorageClass>GLACIER</StorageClass></Transition>`), wantStatus: http.StatusBadRequest, wantBodySubstr: "InvalidStorageClass"},
+		{name: "abort incomplete uploads -> 501", body: rule(`<Filter/><Status>Enabled</Status><AbortIncompleteMultipartUpload><DaysAfterInitiation>7</DaysAfterInitiation></AbortIncompleteMultipartUpload>`), wantStatus: http.StatusNotImplemented, wantBodySubstr: "NotImplemented"},
+		{name: "size filter -> 501", body: rule(`<Filter><ObjectSizeGreaterThan>1024</ObjectSizeGreaterThan></Filter><Status>Enabled</Status><Expiration><Days>1</Days></Expiration>`), wantStatus: http.StatusNotImplemented, wantBodySubstr: "NotImplemented"},
+		{
+			name:           "rejected by gantry -> 400",
+			body:           rule(`<Filter/><Status>Enabled</Status><Expiration><Days>-1</Days></Expiration>`),
+			gantryErr:      status.Error(codes.InvalidArgument, "InvalidArgument"),
+			wantStatus:     http.StatusBadRequest,
+			wantRules:      []gantry.LifecycleRule{{Enabled: true, ExpirationDays: -1}},
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "not the owner -> 403",
+			body:           rule(`<Filter/><Status>Enabled</Status><Expiration><Days>1</Days></Expiration>`),
+			gantryErr:      status.Error(codes.PermissionDenied, "AccessDenied"),
+			wantStatus:     http.StatusForbidden,
+			wantRules:      []gantry.LifecycleRule{{Enabled: true, ExpirationDays: 1}},
+			wantBodySubstr: "AccessDenied",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.PutBucketLifecycleFn = func(context.Context, string, []gantry.LifecycleRule) error { return c.gantryErr }
+			h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+			req := httptest.NewRequest(http.MethodPut, "/photos?lifecycle", strings.NewReader(c.body))
+			req.SetPathValue("bucket", "photos")
+			rec := httptest.NewRecorder()
+			h.PutBucketLifecycleConfiguration(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d; body: %s", rec.Code, c.wantStatus, rec.Body.String())
+			}
+			calls := gantryStub.PutBucketLifecycleCalls
+			if c.wantRules == nil {
+				if len(calls) != 0 {
+					t.Fatalf("PutBucketLifecycleConfiguration calls: got %+v, want none", calls)
+				}
+			} else if len(calls) != 1 || calls[0].Bucket != "photos" || !reflect.DeepEqual(calls[0].Rules, c.wantRules) {
+				t.Fatalf("PutBucketLifecycleConfiguration calls: got %+v, want rules %+v", calls, c.wantRules)
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetBucketLifecycleConfiguration(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name       string
+		rules      []gantry.LifecycleRule
+		gantryErr  error
+		wantStatus int
+		wantBody   string
+	}
+
+	cases := []tc{
+		{
+			name:       "prefix rule",
+			rules:      []gantry.LifecycleRule{{ID: "footage", Enabled: true, Prefix: "footage/", ExpirationDays: 30}},
+			wantStatus: http.StatusOK,
+			wantBody:   `<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Rule><ID>footage</ID><Filter><Prefix>footage/</Prefix></Filter><Status>Enabled</Status><Expiration><Days>30</Days></Expiration></Rule></LifecycleConfiguration>`,
+		},
+		{
+			name:       "tag rule with date",
+			rules:      []gantry.LifecycleRule{{Tags: map[string]string{"retain": "short"}, ExpirationDate: time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)}},
+			wantStatus: http.StatusOK,
+			wantBody:   `<Rule><Filter><Tag><Key>retain</Key><Value>short</Value></Tag></Filter><Status>Disabled</Status><Expiration><Date>2030-01-01T00:00:00.000Z</Date></Expiration></Rule>`,
+		},
+		{
+			name:       "and rule with noncurrent expiration",
+			rules:      []gantry.LifecycleRule{{Enabled: true, Prefix: "a/", Tags: map[string]string{"k2": "v2", "k1": "v1"}, NoncurrentDays: 7, NewerNoncurrentVersions: 3}},
+			wantStatus: http.StatusOK,
+			wantBody:   `<Filter><And><Prefix>a/</Prefix><Tag><Key>k1</Key><Value>v1</Value></Tag><Tag><Key>k2</Key><Value>v2</Value></Tag></And></Filter><Status>Enabled</Status><NoncurrentVersionExpiration><NoncurrentDays>7</NoncurrentDays><NewerNoncurrentVersions>3</NewerNoncurrentVersions></NoncurrentVersionExpiration>`,
+		},
+		{name: "never configured -> 404", gantryErr: status.Error(codes.NotFound, "NoSuchLifecycleConfiguration"), wantStatus: http.StatusNotFound, wantBody: "NoSuchLifecycleConfiguration"},
+		{name: "missing bucket -> 404", gantryErr: status.Error(codes.NotFound, "NoSuchBucket"), wantStatus: http.StatusNotFound, wantBody: "NoSuchBucket"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.GetBucketLifecycleFn = func(context.Context, string) ([]gantry.LifecycleRule, error) {
+				return c.rules, c.gantryErr
+			}
+			h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+			rec := httptest.NewRecorder()
+			h.GetBucketLifecycleConfiguration(rec, reqWithBucket(t, http.MethodGet, "photos"))
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			if !strings.Contains(rec.Body.String(), c.wantBody) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBody, rec.Body.String())
+			}
+			if calls := gantryStub.GetBucketLifecycleCalls; len(calls) != 1 || calls[0] != "photos" {
+				t.Fatalf("GetBucketLifecycleConfiguration calls: got %v, want [photos]", calls)
+			}
+		})
+	}
+}
+
+func TestDeleteBucketLifecycle(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name       string
+		gantryErr  error
+		wantStatus int
+	}
+
+	cases := []tc{
+		{name: "deleted -> 204", wantStatus: http.StatusNoContent},
+		{name: "not the owner -> 403", gantryErr: status.Error(codes.PermissionDenied, "AccessDenied"), wantStatus: http.StatusForbidden},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.DeleteBucketLifecycleFn = func(context.Context, string) error { return c.gantryErr }
+			h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+			rec := httptest.NewRecorder()
+			h.DeleteBucketLifecycle(rec, reqWithBucket(t, http.MethodDelete, "photos"))
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			if calls := gantryStub.DeleteBucketLifecycleCalls; len(calls) != 1 || calls[0] != "photos" {
+				t.Fatalf("DeleteBucketLifecycle calls: got %v, want [photos]", calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+// maxPolicyBytes is S3's bucket policy size limit. Gantry enforces it too;
+// reading one byte past it lets an oversized policy reach gantry and be
+// rejected as MalformedPolicy.
+const maxPolicyBytes = 20 * 1024
+
+// PutBucketPolicy serves PUT /{bucket}?policy. The document is validated
+// and stored by Gantry.
+func (h *Handlers) PutBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	policy, err := io.ReadAll(io.LimitReader(r.Body, maxPolicyBytes+1))
+	if err != nil {
+		respond.Error(w, r, "IncompleteBody", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Gantry.PutBucketPolicy(r.Context(), bucket, string(policy)); err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	logger.LogResult(r, fmt.Sprintf("bucket <%s> policy set", bucket))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetBucketPolicy serves GET /{bucket}?policy, returning the policy JSON as
+// it was put.
+func (h *Handlers) GetBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	policy, err := h.Gantry.GetBucketPolicy(r.Context(), bucket)
+	if err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.WriteString(w, policy); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
+
+// DeleteBucketPolicy serves DELETE /{bucket}?policy.
+func (h *Handlers) DeleteBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Gantry.DeleteBucketPolicy(r.Context(), bucket); err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	logger.LogResult(r, fmt.Sprintf("bucket <%s> policy deleted", bucket))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// respondBucketConfigError maps a failed policy or ACL call to Gantry to the
+// matching S3 error response. Gantry names the S3 error code in the status
+// message; FailedPrecondition is a conflict with the bucket's state, such as
+// InvalidBucketState.
+func respondBucketConfigError(w http.ResponseWriter, r *http.Request, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+		return
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		respond.Error(w, r, st.Message(), http.StatusNotFound)
+	case codes.InvalidArgument:
+		respond.Error(w, r, st.Message(), http.StatusBadRequest)
+	case codes.PermissionDenied:
+		respond.Error(w, r, "AccessDenied", http.StatusForbidden)
+	case codes.FailedPrecondition:
+		respond.Error(w, r, st.Message(), http.StatusConflict)
+	default:
+		logger.LogGantryError(r, err)
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,142 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+)
+
+const testPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::photos/*"}]}`
+
+func TestPutBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		bucket         string
+		gantryErr      error
+		wantStatus     int
+		wantPuts       int
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{name: "policy stored -> 204", bucket: "photos", wantStatus: http.StatusNoContent, wantPuts: 1},
+		{name: "invalid bucket name -> 400", bucket: "INVALID-BUCKET", wantStatus: http.StatusBadRequest, wantBodySubstr: "InvalidBucketName"},
+		{name: "malformed policy -> 400", bucket: "photos", gantryErr: status.Error(codes.InvalidArgument, "MalformedPolicy"), wantStatus: http.StatusBadRequest, wantPuts: 1, wantBodySubstr: "MalformedPolicy"},
+		{name: "not the owner -> 403", bucket: "photos", gantryErr: status.Error(codes.PermissionDenied, "AccessDenied"), wantStatus: http.StatusForbidden, wantPuts: 1, wantBodySubstr: "AccessDenied"},
+		{name: "missing bucket -> 404", bucket: "photos", gantryErr: status.Error(codes.NotFound, "NoSuchBucket"), wantStatus: http.StatusNotFound, wantPuts: 1, wantBodySubstr: "NoSuchBucket"},
+		{name: "gantry failure -> 500", bucket: "photos", gantryErr: status.Error(codes.Internal, "db down"), wantStatus: http.StatusInternalServerError, wantPuts: 1, wantBodySubstr: "InternalError"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.PutBucketPolicyFn = func(context.Context, string, string) error { return c.gantryErr }
+			h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+			req := httptest.NewRequest(http.MethodPut, "/"+c.bucket+"?policy", strings.NewReader(testPolicy))
+			req.SetPathValue("bucket", c.bucket)
+			rec := httptest.NewRecorder()
+			h.PutBucketPolicy(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			if got := len(gantryStub.PutBucketPolicyCalls); got != c.wantPuts {
+				t.Fatalf("PutBucketPolicy calls: got %d, want %d", got, c.wantPuts)
+			}
+			if c.wantPuts > 0 {
+				call := gantryStub.PutBucketPolicyCalls[0]
+				if call.Bucket != c.bucket || call.Value != testPolicy {
+					t.Fatalf("PutBucketPolicy call: got %+v", call)
+				}
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		gantryErr      error
+		wantStatus     int
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{name: "policy returned as JSON", wantStatus: http.StatusOK, wantBodySubstr: testPolicy},
+		{name: "no policy -> 404 NoSuchBucketPolicy", gantryErr: status.Error(codes.NotFound, "NoSuchBucketPolicy"), wantStatus: http.StatusNotFound, wantBodySubstr: "NoSuchBucketPolicy"},
+		{name: "not the owner -> 403", gantryErr: status.Error(codes.PermissionDenied, "AccessDenied"), wantStatus: http.StatusForbidden, wantBodySubstr: "AccessDenied"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.GetBucketPolicyFn = func(context.Context, string) (string, error) {
+				if c.gantryErr != nil {
+					return "", c.gantryErr
+				}
+				return testPolicy, nil
+			}
+			h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+			rec := httptest.NewRecorder()
+			h.GetBucketPolicy(rec, reqWithBucket(t, http.MethodGet, "photos"))
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			if len(gantryStub.GetBucketPolicyCalls) != 1 || gantryStub.GetBucketPolicyCalls[0] != "photos" {
+				t.Fatalf("GetBucketPolicy calls: got %v, want [photos]", gantryStub.GetBucketPolicyCalls)
+			}
+			if c.gantryErr == nil {
+				if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+					t.Fatalf("Content-Type: got %q, want application/json", ct)
+				}
+				if rec.Body.String() != testPolicy {
+					t.Fatalf("body: got %q, want %q", rec.Body.String(), testPolicy)
+				}
+				return
+			}
+			if !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestDeleteBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	gantryStub := testutil.NewGantryStub()
+	h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+	rec := httptest.NewRecorder()
+	h.DeleteBucketPolicy(rec, reqWithBucket(t, http.MethodDelete, "photos"))
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("status: got %d, want %d", rec.Code, http.StatusNoContent)
+	}
+	if len(gantryStub.DeleteBucketPolicyCalls) != 1 || gantryStub.DeleteBucketPolicyCalls[0] != "photos" {
+		t.Fatalf("DeleteBucketPolicy calls: got %v, want [photos]", gantryStub.DeleteBucketPolicyCalls)
+	}
+}
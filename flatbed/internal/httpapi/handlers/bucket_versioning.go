@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+// maxVersioningBodyBytes bounds the PutBucketVersioning body, which only
+// ever holds two short elements.
+const maxVersioningBodyBytes = 4 * 1024
+
+// versioningConfigurationRequest is the request body of
+// PutBucketVersioning, matched without a namespace like
+// completeMultipartUpload.
+type versioningConfigurationRequest struct {
+	XMLName   xml.Name `xml:"VersioningConfiguration"`
+	Status    string   `xml:"Status"`
+	MFADelete string   `xml:"MfaDelete"`
+}
+
+type versioningConfiguration struct {
+	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ VersioningConfiguration" json:"-"`
+	Status  string   `xml:"Status,omitempty" json:"Status,omitempty"`
+}
+
+// PutBucketVersioning serves PUT /{bucket}?versioning. Gantry validates the
+// status; MFA delete is not supported.
+func (h *Handlers) PutBucketVersioning(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	var body versioningConfigurationRequest
+	if err := xml.NewDecoder(http.MaxBytesReader(w, r.Body, maxVersioningBodyBytes)).Decode(&body); err != nil {
+		respond.Error(w, r, "MalformedXML", http.StatusBadRequest)
+		return
+	}
+
+	if body.MFADelete == "Enabled" {
+		respond.Error(w, r, "NotImplemented", http.StatusNotImplemented)
+		return
+	}
+
+	if err := h.Gantry.PutBucketVersioning(r.Context(), bucket, body.Status); err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	logger.LogResult(r, fmt.Sprintf("bucket <%s> versioning set to %s", bucket, body.Status))
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetBucketVersioning serves GET /{bucket}?versioning. A bucket that never
+// had versioning configured gets an empty VersioningConfiguration.
+func (h *Handlers) GetBucketVersioning(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	versioning, err := h.Gantry.GetBucketVersioning(r.Context(), bucket)
+	if err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	if err := respond.Encode(w, r, http.StatusOK, versioningConfiguration{Status: versioning}); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
@@ -0,0 +1,111 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+)
+
+func TestPutBucketVersioning(t *testing.T) {
+	t.Parallel()
+
+	const enabledBody = `<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>Enabled</Status></VersioningConfiguration>`
+
+	type tc struct {
+		name           string
+		body           string
+		gantryErr      error
+		wantStatus     int
+		wantCalls      []testutil.BucketConfigCall
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{name: "enable -> 200", body: enabledBody, wantStatus: http.StatusOK, wantCalls: []testutil.BucketConfigCall{{Bucket: "photos", Value: "Enabled"}}},
+		{name: "suspend without namespace -> 200", body: "<VersioningConfiguration><Status>Suspended</Status></VersioningConfiguration>", wantStatus: http.StatusOK, wantCalls: []testutil.BucketConfigCall{{Bucket: "photos", Value: "Suspended"}}},
+		{name: "malformed body -> 400", body: "<VersioningConfiguration>", wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{name: "wrong root element -> 400", body: "<Tagging/>", wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{name: "mfa delete -> 501", body: "<VersioningConfiguration><Status>Enabled</Status><MfaDelete>Enabled</MfaDelete></VersioningConfiguration>", wantStatus: http.StatusNotImplemented, wantBodySubstr: "NotImplemented"},
+		{name: "bad status -> 400", body: "<VersioningConfiguration><Status>On</Status></VersioningConfiguration>", gantryErr: status.Error(codes.InvalidArgument, "IllegalVersioningConfigurationException"), wantStatus: http.StatusBadRequest, wantCalls: []testutil.BucketConfigCall{{Bucket: "photos", Value: "On"}}, wantBodySubstr: "IllegalVersioningConfigurationException"},
+		{name: "suspend with object lock -> 409", body: "<VersioningConfiguration><Status>Suspended</Status></VersioningConfiguration>", gantryErr: status.Error(codes.FailedPrecondition, "InvalidBucketState"), wantStatus: http.StatusConflict, wantCalls: []testutil.BucketConfigCall{{Bucket: "photos", Value: "Suspended"}}, wantBodySubstr: "InvalidBucketState"},
+		{name: "not the owner -> 403", body: enabledBody, gantryErr: status.Error(codes.PermissionDenied, "AccessDenied"), wantStatus: http.StatusForbidden, wantCalls: []testutil.BucketConfigCall{{Bucket: "photos", Value: "Enabled"}}, wantBodySubstr: "AccessDenied"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.PutBucketVersioningFn = func(context.Context, string, string) error { return c.gantryErr }
+			h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+			req := httptest.NewRequest(http.MethodPut, "/photos?versioning", strings.NewReader(c.body))
+			req.SetPathValue("bucket", "photos")
+			rec := httptest.NewRecorder()
+			h.PutBucketVersioning(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d; body: %s", rec.Code, c.wantStatus, rec.Body.String())
+			}
+			calls := gantryStub.PutBucketVersioningCalls
+			if len(calls) != len(c.wantCalls) || (len(calls) == 1 && calls[0] != c.wantCalls[0]) {
+				t.Fatalf("PutBucketVersioning calls: got %+v, want %+v", calls, c.wantCalls)
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetBucketVersioning(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name       string
+		status     string
+		gantryErr  error
+		wantStatus int
+		wantBody   string
+	}
+
+	cases := []tc{
+		{name: "enabled", status: "Enabled", wantStatus: http.StatusOK, wantBody: `<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>Enabled</Status></VersioningConfiguration>`},
+		{name: "never configured", wantStatus: http.StatusOK, wantBody: `<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></VersioningConfiguration>`},
+		{name: "missing bucket -> 404", gantryErr: status.Error(codes.NotFound, "NoSuchBucket"), wantStatus: http.StatusNotFound, wantBody: "NoSuchBucket"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.GetBucketVersioningFn = func(context.Context, string) (string, error) {
+				return c.status, c.gantryErr
+			}
+			h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+			rec := httptest.NewRecorder()
+			h.GetBucketVersioning(rec, reqWithBucket(t, http.MethodGet, "photos"))
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			if !strings.Contains(rec.Body.String(), c.wantBody) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBody, rec.Body.String())
+			}
+			if calls := gantryStub.GetBucketVersioningCalls; len(calls) != 1 || calls[0] != "photos" {
+				t.Fatalf("GetBucketVersioning calls: got %v, want [photos]", calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+// CompleteMultipartUpload serves POST /{bucket}/{key}?uploadId=ID. The part
+// list is passed to Gantry as sent; ordering, ETag and size checks happen
+// there so the object row is only written once they all pass.
+func (h *Handlers) CompleteMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+	key := r.PathValue("key")
+	uploadID := r.URL.Query().Get("uploadId")
+
+	// Validate bucket name
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	// Validate key
+	if err := h.KeyValidator.ValidateKey(key); err != nil {
+		respond.Error(w, r, "InvalidKeyName", http.StatusBadRequest)
+		return
+	}
+
+	var body completeMultipartUpload
+	if err := xml.NewDecoder(http.MaxBytesReader(w, r.Body, maxCompleteBodyBytes)).Decode(&body); err != nil {
+		respond.Error(w, r, "MalformedXML", http.StatusBadRequest)
+		return
+	}
+
+	parts := make([]gantry.CompletedPart, 0, len(body.Parts))
+	for _, p := range body.Parts {
+		parts = append(parts, gantry.CompletedPart{PartNumber: p.PartNumber, ETag: p.ETag})
+	}
+
+	etag, versionID, err := h.Gantry.CompleteMultipartUpload(r.Context(), bucket, key, uploadID, parts)
+	if err != nil {
+		respondUploadError(w, r, err)
+		return
+	}
+
+	logger.LogResult(r, fmt.Sprintf("upload <%s> completed as <%s/%s>", uploadID, bucket, key))
+	setVersionHeader(w, versionID)
+	respond.Encode(w, r, http.StatusOK, completeMultipartUploadResult{
+		Location: "/" + bucket + "/" + key,
+		Bucket:   bucket,
+		Key:      key,
+		ETag:     `"` + etag + `"`,
+	})
+}
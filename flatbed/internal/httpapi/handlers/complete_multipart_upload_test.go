@@ -0,0 +1,188 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+)
+
+const completeBody = `<CompleteMultipartUpload xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Part><PartNumber>1</PartNumber><ETag>"blob-1"</ETag></Part>
+  <Part><PartNumber>2</PartNumber><ETag>"blob-2"</ETag></Part>
+</CompleteMultipartUpload>`
+
+func TestCompleteMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		bucket         string
+		key            string
+		body           string
+		versionID      string
+		gantryErr      error
+		wantStatus     int
+		wantCompletes  int
+		wantParts      []gantry.CompletedPart
+		wantBodySubstr []string
+	}
+
+	cases := []tc{
+		{
+			name:          "complete -> 200 with multipart ETag",
+			bucket:        "photos",
+			key:           "videos/big.mp4",
+			body:          completeBody,
+			wantStatus:    http.StatusOK,
+			wantCompletes: 1,
+			wantParts: []gantry.CompletedPart{
+				{PartNumber: 1, ETag: `"blob-1"`},
+				{PartNumber: 2, ETag: `"blob-2"`},
+			},
+			wantBodySubstr: []string{
+				"<CompleteMultipartUploadResult",
+				"<Location>/photos/videos/big.mp4</Location>",
+				"<ETag>&#34;stub-etag-1&#34;</ETag>",
+			},
+		},
+		{
+			name:          "versioned bucket -> 200 with x-amz-version-id",
+			bucket:        "photos",
+			key:           "videos/big.mp4",
+			body:          completeBody,
+			versionID:     "01JVERSION",
+			wantStatus:    http.StatusOK,
+			wantCompletes: 1,
+		},
+		{
+			name:           "malformed body -> 400 MalformedXML",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			body:           "<CompleteMultipartUpload><Part>",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: []string{"MalformedXML"},
+		},
+		{
+			name:           "wrong root element -> 400 MalformedXML",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			body:           "<Delete></Delete>",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: []string{"MalformedXML"},
+		},
+		{
+			name:           "invalid bucket name -> 400",
+			bucket:         "INVALID-BUCKET",
+			key:            "videos/big.mp4",
+			body:           completeBody,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: []string{"InvalidBucketName"},
+		},
+		{
+			name:           "gantry invalid part -> 400 InvalidPart",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			body:           completeBody,
+			gantryErr:      status.Error(codes.InvalidArgument, "InvalidPart"),
+			wantStatus:     http.StatusBadRequest,
+			wantCompletes:  1,
+			wantBodySubstr: []string{"InvalidPart", "could not be found"},
+		},
+		{
+			name:           "gantry part too small -> 400 EntityTooSmall",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			body:           completeBody,
+			gantryErr:      status.Error(codes.InvalidArgument, "EntityTooSmall"),
+			wantStatus:     http.StatusBadRequest,
+			wantCompletes:  1,
+			wantBodySubstr: []string{"EntityTooSmall"},
+		},
+		{
+			name:           "gantry unknown upload -> 404 NoSuchUpload",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			body:           completeBody,
+			gantryErr:      status.Error(codes.NotFound, "NoSuchUpload"),
+			wantStatus:     http.StatusNotFound,
+			wantCompletes:  1,
+			wantBodySubstr: []string{"NoSuchUpload"},
+		},
+		{
+			name:           "gantry unexpected error -> 500",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			body:           completeBody,
+			gantryErr:      status.Error(codes.Internal, "unexpected database error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantCompletes:  1,
+			wantBodySubstr: []string{"InternalError"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.CompleteMultipartUploadFn = func(context.Context, string, string, string, []gantry.CompletedPart) (string, string, error) {
+				if c.gantryErr != nil {
+					return "", "", c.gantryErr
+				}
+				return "stub-etag-1", c.versionID, nil
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/?uploadId=upload-1", strings.NewReader(c.body))
+			req.SetPathValue("bucket", c.bucket)
+			req.SetPathValue("key", c.key)
+			rec := httptest.NewRecorder()
+
+			h.CompleteMultipartUpload(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+
+			if got := gantryStub.CompleteMultipartUploadCount(); got != c.wantCompletes {
+				t.Fatalf("CompleteMultipartUpload calls: got %d, want %d", got, c.wantCompletes)
+			}
+			if c.wantCompletes > 0 {
+				call := gantryStub.CompleteMultipartCalls[0]
+				if call.Bucket != c.bucket || call.Key != c.key || call.UploadID != "upload-1" {
+					t.Fatalf("CompleteMultipartUpload call: got %+v, want %s/%s upload-1", call, c.bucket, c.key)
+				}
+				if c.wantParts != nil && !reflect.DeepEqual(call.Parts, c.wantParts) {
+					t.Fatalf("parts: got %+v, want %+v", call.Parts, c.wantParts)
+				}
+			}
+
+			if got := rec.Header().Get("x-amz-version-id"); got != c.versionID {
+				t.Fatalf("x-amz-version-id: got %q, want %q", got, c.versionID)
+			}
+
+			for _, want := range c.wantBodySubstr {
+				if !strings.Contains(rec.Body.String(), want) {
+					t.Fatalf("body: expected substring %q, got %q", want, rec.Body.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+)
+
+func TestGetObject_Conditional(t *testing.T) {
+	t.Parallel()
+
+	// The default LookupObject stub returns ETag "stub-object-id" and a
+	// last-modified time of 1234567890 ms.
+	lastModified := time.UnixMilli(1234567890).UTC()
+	before := lastModified.Add(-time.Hour).Format(http.TimeFormat)
+	same := lastModified.Format(http.TimeFormat)
+	after := lastModified.Add(time.Hour).Format(http.TimeFormat)
+
+	type tc struct {
+		name       string
+		method     string
+		headers    map[string]string
+		wantStatus int
+	}
+
+	cases := []tc{
+		{
+			name:       "If-Match with current ETag -> 200",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-Match": `"stub-object-id"`},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "If-Match list containing current ETag -> 200",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-Match": `"other", "stub-object-id"`},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "If-Match with stale ETag -> 412",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-Match": `"other"`},
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:       "If-Match wildcard -> 200",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-Match": "*"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "If-Unmodified-Since before last modified -> 412",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-Unmodified-Since": before},
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:       "If-Unmodified-Since at last modified -> 200",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-Unmodified-Since": same},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "matching If-Match overrides failing If-Unmodified-Since",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-Match": `"stub-object-id"`, "If-Unmodified-Since": before},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "If-None-Match with current ETag -> 304",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-None-Match": `"stub-object-id"`},
+			wantStatus: http.StatusNotModified,
+		},
+		{
+			name:       "If-None-Match weak ETag -> 304",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-None-Match": `W/"stub-object-id"`},
+			wantStatus: http.StatusNotModified,
+		},
+		{
+			name:       "If-None-Match with other ETag -> 200",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-None-Match": `"other"`},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "If-Modified-Since at last modified -> 304",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-Modified-Since": same},
+			wantStatus: http.StatusNotModified,
+		},
+		{
+			name:       "If-Modified-Since before last modified -> 200",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-Modified-Since": before},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "non-matching If-None-Match overrides If-Modified-Since",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-None-Match": `"other"`, "If-Modified-Since": after},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "unparseable date is ignored",
+			method:     http.MethodGet,
+			headers:    map[string]string{"If-Modified-Since": "yesterday"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "HEAD If-None-Match with current ETag -> 304",
+			method:     http.MethodHead,
+			headers:    map[string]string{"If-None-Match": `"stub-object-id"`},
+			wantStatus: http.StatusNotModified,
+		},
+		{
+			name:       "HEAD If-Match with stale ETag -> 412",
+			method:     http.MethodHead,
+			headers:    map[string]string{"If-Match": `"other"`},
+			wantStatus: http.StatusPreconditionFailed,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			cradleStub := testutil.NewCradleStub()
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          testutil.NewGantryStub(),
+				Cradle:          cradleStub,
+			}
+
+			req := httptest.NewRequest(c.method, "/", nil)
+			req.SetPathValue("bucket", "photos")
+			req.SetPathValue("key", "vacation/sunset.jpg")
+			for name, value := range c.headers {
+				req.Header.Set(name, value)
+			}
+			rec := httptest.NewRecorder()
+
+			if c.method == http.MethodHead {
+				h.HeadObject(rec, req)
+			} else {
+				h.GetObject(rec, req)
+			}
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+
+			wantReads := 0
+			if c.method == http.MethodGet && c.wantStatus == http.StatusOK {
+				wantReads = 1
+			}
+			if got := cradleStub.ReadObjectCount(); got != wantReads {
+				t.Fatalf("ReadObject calls: got %d, want %d", got, wantReads)
+			}
+
+			switch c.wantStatus {
+			case http.StatusNotModified:
+				if got := rec.Header().Get("ETag"); got != `"stub-object-id"` {
+					t.Fatalf("ETag: got %q", got)
+				}
+				if rec.Body.Len() != 0 {
+					t.Fatalf("body: got %q, want empty", rec.Body.String())
+				}
+			case http.StatusPreconditionFailed:
+				if !strings.Contains(rec.Body.String(), "PreconditionFailed") {
+					t.Fatalf("body: expected PreconditionFailed, got %q", rec.Body.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/cradle"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+const copySourceHeaderPrefix = "x-amz-copy-source-"
+
+type copyObjectResult struct {
+	XMLName      xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CopyObjectResult" json:"-"`
+	ETag         string   `xml:"ETag" json:"ETag"`
+	LastModified string   `xml:"LastModified" json:"LastModified"`
+}
+
+// CopyObject serves PUT /{bucket}/{key} with x-amz-copy-source. The source is
+// looked up through Gantry like a GET, so the caller needs read access to it,
+// and the destination is planned like a PUT. The destination cradle then
+// reads the source blobs straight from the cradles holding them, so the
+// bytes never pass through flatbed.
+func (h *Handlers) CopyObject(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+	key := r.PathValue("key")
+
+	// Validate bucket name
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	// Validate key
+	if err := h.KeyValidator.ValidateKey(key); err != nil {
+		respond.Error(w, r, "InvalidKeyName", http.StatusBadRequest)
+		return
+	}
+
+	srcBucket, srcKey, srcVersionID, ok := parseCopySource(r.Header.Get("x-amz-copy-source"))
+	if !ok || h.BucketValidator.ValidateBucketName(srcBucket) != nil || h.KeyValidator.ValidateKey(srcKey) != nil {
+		respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+		return
+	}
+
+	replace := false
+	switch r.Header.Get("x-amz-metadata-directive") {
+	case "", "COPY":
+	case "REPLACE":
+		replace = true
+	default:
+		respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+		return
+	}
+
+	// x-amz-tagging is only read when the tagging directive replaces the
+	// source's tags.
+	var tags map[string]string
+	replaceTags := false
+	switch r.Header.Get("x-amz-tagging-directive") {
+	case "", "COPY":
+	case "REPLACE":
+		if tags, ok = uploadTags(w, r); !ok {
+			return
+		}
+		replaceTags = true
+	default:
+		respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+		return
+	}
+
+	// Like S3, the copy takes its object lock from the request, never from
+	// the source.
+	lock, ok := uploadObjectLock(w, r)
+	if !ok {
+		return
+	}
+
+	// S3 rejects a copy onto itself that would change nothing. Copying an
+	// older version over the current one restores it, so that is allowed.
+	if srcBucket == bucket && srcKey == key && srcVersionID == "" && !replace && !replaceTags {
+		respond.Error(w, r, "InvalidRequest", http.StatusBadRequest)
+		return
+	}
+
+	src, err := h.Gantry.LookupObject(r.Context(), srcBucket, srcKey, srcVersionID)
+	if err != nil {
+		respondLookupError(w, r, err)
+		return
+	}
+
+	if !checkCopySourcePreconditions(w, r, src) {
+		return
+	}
+
+	contentType, metadata := src.ContentType, src.Metadata
+	if replace {
+		contentType, metadata = r.Header.Get("Content-Type"), uploadMetadata(r)
+	}
+	if !replaceTags {
+		tags = src.Tags
+	}
+
+	writePlan, err := h.Gantry.PlanWrite(r.Context(), bucket, key, src.Size, contentType, metadata, tags, lock)
+	if err != nil {
+		respondPlanError(w, r, err)
+		return
+	}
+
+	objectID := writePlan.GetObjectId()
+	cradleAddress := writePlan.GetCradleAddress()
+
+	logger.LogWritePlan(r, objectID, cradleAddress, src.Size)
+
+	parts := objectParts(src)
+	sources := make([]cradle.CopySource, 0, len(parts))
+	for _, part := range parts {
+		sources = append(sources, cradle.CopySource{
+			CradleAddress: part.CradleAddress,
+			BlobID:        part.BlobID,
+			Bucket:        srcBucket,
+			Size:          part.Size,
+		})
+	}
+
+	written, err := h.Cradle.CopyObject(r.Context(), cradleAddress, objectID, bucket, src.Size, sources)
+	if err != nil {
+		// The source was replaced or deleted after the lookup.
+		if status.Code(err) == codes.NotFound {
+			respond.Error(w, r, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		logger.LogCradleError(r, err)
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+		return
+	}
+
+	if written.BytesWritten != src.Size {
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+		return
+	}
+
+	// The bytes are unchanged, so the source's checksum still holds. The
+	// ETag is recomputed since a multipart source's isn't a content MD5.
+	etag := hex.EncodeToString(written.MD5)
+	commit := gantry.ObjectCommit{
+		Size:              written.BytesWritten,
+		LastModifiedMs:    written.LastModifiedMs,
+		ETag:              etag,
+		ChecksumAlgorithm: src.ChecksumAlgorithm,
+		Checksum:          src.Checksum,
+	}
+	versionID, err := h.Gantry.CommitObject(r.Context(), objectID, commit)
+	if err != nil {
+		// Gantry refuses to replace a version under object lock.
+		if status.Code(err) == codes.PermissionDenied {
+			respond.Error(w, r, "AccessDenied", http.StatusForbidden)
+			return
+		}
+		logger.LogGantryError(r, err)
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+		return
+	}
+
+	lastModified := time.UnixMilli(written.LastModifiedMs)
+	logger.LogResult(r, fmt.Sprintf("copied <%s/%s> to <%s/%s>", srcBucket, srcKey, bucket, key))
+	if src.VersionID != "" {
+		w.Header().Set("x-amz-copy-source-version-id", src.VersionID)
+	}
+	setVersionHeader(w, versionID)
+	respond.Encode(w, r, http.StatusOK, copyObjectResult{
+		ETag:         `"` + etag + `"`,
+		LastModified: lastModified.UTC().Format("2006-01-02T15:04:05.000Z"),
+	})
+}
+
+// parseCopySource splits an x-amz-copy-source value, "bucket/key" with an
+// optional leading slash and ?versionId= suffix, into its parts. The key is
+// URL-decoded; the query is split off first so an encoded "?" stays in it.
+func parseCopySource(value string) (bucket, key, versionID string, ok bool) {
+	path, query, _ := strings.Cut(value, "?")
+	if query != "" {
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			return "", "", "", false
+		}
+		versionID = values.Get("versionId")
+	}
+
+	path, err := url.PathUnescape(path)
+	if err != nil {
+		return "", "", "", false
+	}
+
+	bucket, key, _ = strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	if bucket == "" || key == "" {
+		return "", "", "", false
+	}
+	return bucket, key, versionID, true
+}
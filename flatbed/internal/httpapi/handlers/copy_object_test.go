@@ -0,0 +1,495 @@
+package handlers_test
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/cradle"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+	writeplanv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/write_plan/v1"
+)
+
+func copySourceObject() gantry.Object {
+	return gantry.Object{
+		ID:                "source-object-id",
+		Key:               "vacation/sunset.jpg",
+		Size:              int64(len(testutil.StubObjectBody)),
+		LastModified:      time.UnixMilli(1234567890).UTC(),
+		CradleAddress:     "cradle-a:9002",
+		ContentType:       "image/jpeg",
+		ETag:              "source-etag",
+		ChecksumAlgorithm: "CRC32",
+		Checksum:          "YdFDLw==",
+		Metadata: gantry.ObjectMetadata{
+			CacheControl: "max-age=60",
+			User:         map[string]string{"color": "orange"},
+		},
+		Tags: map[string]string{"host": "web-1"},
+	}
+}
+
+func TestCopyObject(t *testing.T) {
+	t.Parallel()
+
+	stubSum := md5.Sum([]byte(testutil.StubObjectBody))
+	copiedETag := hex.EncodeToString(stubSum[:])
+	size := int64(len(testutil.StubObjectBody))
+
+	multipartSource := copySourceObject()
+	multipartSource.ETag = "multipart-etag-2"
+	multipartSource.ChecksumAlgorithm, multipartSource.Checksum = "", ""
+	multipartSource.Parts = []gantry.ObjectPart{
+		{BlobID: "blob-1", Size: 10, CradleAddress: "cradle-a:9002"},
+		{BlobID: "blob-2", Size: size - 10, CradleAddress: "cradle-b:9002"},
+	}
+
+	versionedSource := copySourceObject()
+	versionedSource.VersionID = "01JOLDVERSION"
+
+	heldSource := copySourceObject()
+	heldSource.Lock = gantry.ObjectLock{LegalHold: true}
+
+	type tc struct {
+		name           string
+		key            string
+		headers        map[string]string
+		source         *gantry.Object
+		lookupErr      error
+		planErr        error
+		copyErr        error
+		commitErr      error
+		wantStatus     int
+		wantBodySubstr string
+		wantLookup     *testutil.LookupObjectCall
+		wantPlan       *testutil.PlanWriteCall
+		wantSources    []cradle.CopySource
+		wantCommit     *testutil.CommitObjectCall
+		wantHeaders    map[string]string
+	}
+
+	cases := []tc{
+		{
+			name:           "copies bytes and metadata from the source",
+			key:            "backup/sunset.jpg",
+			headers:        map[string]string{"x-amz-copy-source": "photos/vacation/sunset.jpg"},
+			wantStatus:     http.StatusOK,
+			wantBodySubstr: "<ETag>&#34;" + copiedETag + "&#34;</ETag><LastModified>1970-01-15T06:56:07.890Z</LastModified>",
+			wantLookup:     &testutil.LookupObjectCall{Bucket: "photos", Key: "vacation/sunset.jpg"},
+			wantPlan: &testutil.PlanWriteCall{
+				Bucket:      "archive",
+				Key:         "backup/sunset.jpg",
+				Size:        size,
+				ContentType: "image/jpeg",
+				Metadata:    copySourceObject().Metadata,
+				Tags:        copySourceObject().Tags,
+			},
+			wantSources: []cradle.CopySource{
+				{CradleAddress: "cradle-a:9002", BlobID: "source-object-id", Bucket: "photos", Size: size},
+			},
+			wantCommit: &testutil.CommitObjectCall{
+				ObjectID:          "stub-object-id",
+				Size:              size,
+				LastModifiedMs:    1234567890,
+				ETag:              copiedETag,
+				ChecksumAlgorithm: "CRC32",
+				Checksum:          "YdFDLw==",
+			},
+		},
+		{
+			name: "REPLACE takes metadata from the request",
+			key:  "backup/sunset.jpg",
+			headers: map[string]string{
+				"x-amz-copy-source":        "photos/vacation/sunset.jpg",
+				"x-amz-metadata-directive": "REPLACE",
+				"Content-Type":             "image/png",
+				"x-amz-meta-season":        "summer",
+			},
+			wantStatus: http.StatusOK,
+			wantPlan: &testutil.PlanWriteCall{
+				Bucket:      "archive",
+				Key:         "backup/sunset.jpg",
+				Size:        size,
+				ContentType: "image/png",
+				Metadata:    gantry.ObjectMetadata{User: map[string]string{"season": "summer"}},
+				Tags:        copySourceObject().Tags,
+			},
+		},
+		{
+			name:   "object lock comes from the request, not the source",
+			key:    "backup/sunset.jpg",
+			source: &heldSource,
+			headers: map[string]string{
+				"x-amz-copy-source":                   "photos/vacation/sunset.jpg",
+				"x-amz-object-lock-mode":              "GOVERNANCE",
+				"x-amz-object-lock-retain-until-date": "2025-04-01T00:00:00Z",
+			},
+			wantStatus: http.StatusOK,
+			wantPlan: &testutil.PlanWriteCall{
+				Bucket:      "archive",
+				Key:         "backup/sunset.jpg",
+				Size:        size,
+				ContentType: "image/jpeg",
+				Metadata:    copySourceObject().Metadata,
+				Tags:        copySourceObject().Tags,
+				Lock:        gantry.ObjectLock{Mode: "GOVERNANCE", RetainUntil: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "tagging REPLACE takes tags from the request",
+			key:  "backup/sunset.jpg",
+			headers: map[string]string{
+				"x-amz-copy-source":       "photos/vacation/sunset.jpg",
+				"x-amz-tagging-directive": "REPLACE",
+				"x-amz-tagging":           "host=web-2&tier=cold",
+			},
+			wantStatus: http.StatusOK,
+			wantPlan: &testutil.PlanWriteCall{
+				Bucket:      "archive",
+				Key:         "backup/sunset.jpg",
+				Size:        size,
+				ContentType: "image/jpeg",
+				Metadata:    copySourceObject().Metadata,
+				Tags:        map[string]string{"host": "web-2", "tier": "cold"},
+			},
+		},
+		{
+			name:       "multipart source copies every part in order",
+			key:        "backup/sunset.jpg",
+			headers:    map[string]string{"x-amz-copy-source": "photos/vacation/sunset.jpg"},
+			source:     &multipartSource,
+			wantStatus: http.StatusOK,
+			wantSources: []cradle.CopySource{
+				{CradleAddress: "cradle-a:9002", BlobID: "blob-1", Bucket: "photos", Size: 10},
+				{CradleAddress: "cradle-b:9002", BlobID: "blob-2", Bucket: "photos", Size: size - 10},
+			},
+			wantCommit: &testutil.CommitObjectCall{
+				ObjectID:       "stub-object-id",
+				Size:           size,
+				LastModifiedMs: 1234567890,
+				ETag:           copiedETag,
+			},
+		},
+		{
+			name:       "leading slash and encoded key are decoded",
+			key:        "backup/sunset.jpg",
+			headers:    map[string]string{"x-amz-copy-source": "/photos/vacation/my%20sunset%3F.jpg?versionId=null"},
+			wantStatus: http.StatusOK,
+			wantLookup: &testutil.LookupObjectCall{Bucket: "photos", Key: "vacation/my sunset?.jpg", VersionID: "null"},
+		},
+		{
+			name:       "versionId copies that version",
+			key:        "backup/sunset.jpg",
+			headers:    map[string]string{"x-amz-copy-source": "photos/vacation/sunset.jpg?versionId=01JOLDVERSION"},
+			source:     &versionedSource,
+			wantStatus: http.StatusOK,
+			wantLookup: &testutil.LookupObjectCall{Bucket: "photos", Key: "vacation/sunset.jpg", VersionID: "01JOLDVERSION"},
+			wantHeaders: map[string]string{
+				"x-amz-copy-source-version-id": "01JOLDVERSION",
+				"x-amz-version-id":             "01JNEWVERSION",
+			},
+		},
+		{
+			name:    "missing source version -> 404 NoSuchVersion",
+			key:     "backup/sunset.jpg",
+			headers: map[string]string{"x-amz-copy-source": "photos/vacation/sunset.jpg?versionId=01JGONE"},
+			lookupErr: versionLookupErr(servicev1.ObjectLookupError_REASON_VERSION_NOT_FOUND,
+				"photos", "vacation/sunset.jpg", "01JGONE"),
+			wantStatus:     http.StatusNotFound,
+			wantBodySubstr: "NoSuchVersion",
+		},
+		{
+			name:           "copy source without a key -> 400",
+			key:            "backup/sunset.jpg",
+			headers:        map[string]string{"x-amz-copy-source": "photos"},
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "invalid source bucket -> 400",
+			key:            "backup/sunset.jpg",
+			headers:        map[string]string{"x-amz-copy-source": "NOT_A_BUCKET/key"},
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name: "unknown metadata directive -> 400",
+			key:  "backup/sunset.jpg",
+			headers: map[string]string{
+				"x-amz-copy-source":        "photos/vacation/sunset.jpg",
+				"x-amz-metadata-directive": "MERGE",
+			},
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name: "unknown tagging directive -> 400",
+			key:  "backup/sunset.jpg",
+			headers: map[string]string{
+				"x-amz-copy-source":       "photos/vacation/sunset.jpg",
+				"x-amz-tagging-directive": "MERGE",
+			},
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "copy onto itself without REPLACE -> 400",
+			key:            "vacation/sunset.jpg",
+			headers:        map[string]string{"x-amz-copy-source": "archive/vacation/sunset.jpg"},
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidRequest",
+		},
+		{
+			name: "copy onto itself with REPLACE succeeds",
+			key:  "vacation/sunset.jpg",
+			headers: map[string]string{
+				"x-amz-copy-source":        "archive/vacation/sunset.jpg",
+				"x-amz-metadata-directive": "REPLACE",
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "missing source -> 404 NoSuchKey",
+			key:     "backup/sunset.jpg",
+			headers: map[string]string{"x-amz-copy-source": "photos/vacation/missing.jpg"},
+			lookupErr: objectLookupErr(codes.NotFound, "object not found",
+				servicev1.ObjectLookupError_REASON_OBJECT_NOT_FOUND, "photos", "vacation/missing.jpg"),
+			wantStatus:     http.StatusNotFound,
+			wantBodySubstr: "NoSuchKey",
+		},
+		{
+			name:           "unreadable source -> 403",
+			key:            "backup/sunset.jpg",
+			headers:        map[string]string{"x-amz-copy-source": "photos/vacation/sunset.jpg"},
+			lookupErr:      status.Error(codes.PermissionDenied, "access denied"),
+			wantStatus:     http.StatusForbidden,
+			wantBodySubstr: "AccessDenied",
+		},
+		{
+			name: "matching copy-source-if-match copies",
+			key:  "backup/sunset.jpg",
+			headers: map[string]string{
+				"x-amz-copy-source":          "photos/vacation/sunset.jpg",
+				"x-amz-copy-source-if-match": `"source-etag"`,
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "copy-source-if-match mismatch -> 412",
+			key:  "backup/sunset.jpg",
+			headers: map[string]string{
+				"x-amz-copy-source":          "photos/vacation/sunset.jpg",
+				"x-amz-copy-source-if-match": `"other-etag"`,
+			},
+			wantStatus:     http.StatusPreconditionFailed,
+			wantBodySubstr: "PreconditionFailed",
+		},
+		{
+			name: "copy-source-if-none-match match -> 412",
+			key:  "backup/sunset.jpg",
+			headers: map[string]string{
+				"x-amz-copy-source":               "photos/vacation/sunset.jpg",
+				"x-amz-copy-source-if-none-match": `"source-etag"`,
+			},
+			wantStatus:     http.StatusPreconditionFailed,
+			wantBodySubstr: "PreconditionFailed",
+		},
+		{
+			name: "source modified after copy-source-if-unmodified-since -> 412",
+			key:  "backup/sunset.jpg",
+			headers: map[string]string{
+				"x-amz-copy-source":                     "photos/vacation/sunset.jpg",
+				"x-amz-copy-source-if-unmodified-since": "Wed, 14 Jan 1970 00:00:00 GMT",
+			},
+			wantStatus:     http.StatusPreconditionFailed,
+			wantBodySubstr: "PreconditionFailed",
+		},
+		{
+			name: "source unmodified since copy-source-if-modified-since -> 412",
+			key:  "backup/sunset.jpg",
+			headers: map[string]string{
+				"x-amz-copy-source":                   "photos/vacation/sunset.jpg",
+				"x-amz-copy-source-if-modified-since": "Fri, 16 Jan 1970 00:00:00 GMT",
+			},
+			wantStatus:     http.StatusPreconditionFailed,
+			wantBodySubstr: "PreconditionFailed",
+		},
+		{
+			name:           "missing destination bucket -> 404 NoSuchBucket",
+			key:            "backup/sunset.jpg",
+			headers:        map[string]string{"x-amz-copy-source": "photos/vacation/sunset.jpg"},
+			planErr:        status.Error(codes.NotFound, "bucket not found"),
+			wantStatus:     http.StatusNotFound,
+			wantBodySubstr: "NoSuchBucket",
+		},
+		{
+			name:           "source gone before the copy -> 404 NoSuchKey",
+			key:            "backup/sunset.jpg",
+			headers:        map[string]string{"x-amz-copy-source": "photos/vacation/sunset.jpg"},
+			copyErr:        status.Error(codes.NotFound, "source object not found"),
+			wantStatus:     http.StatusNotFound,
+			wantBodySubstr: "NoSuchKey",
+		},
+		{
+			name:           "cradle copy failure -> 500",
+			key:            "backup/sunset.jpg",
+			headers:        map[string]string{"x-amz-copy-source": "photos/vacation/sunset.jpg"},
+			copyErr:        errors.New("connection refused"),
+			wantStatus:     http.StatusInternalServerError,
+			wantBodySubstr: "InternalError",
+		},
+		{
+			name: "malformed object lock header -> 400 InvalidArgument",
+			key:  "backup/sunset.jpg",
+			headers: map[string]string{
+				"x-amz-copy-source":            "photos/vacation/sunset.jpg",
+				"x-amz-object-lock-legal-hold": "maybe",
+			},
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "locked destination version -> 403 AccessDenied",
+			key:            "backup/sunset.jpg",
+			headers:        map[string]string{"x-amz-copy-source": "photos/vacation/sunset.jpg"},
+			commitErr:      status.Error(codes.PermissionDenied, "AccessDenied"),
+			wantStatus:     http.StatusForbidden,
+			wantBodySubstr: "AccessDenied",
+		},
+		{
+			name:           "commit failure -> 500",
+			key:            "backup/sunset.jpg",
+			headers:        map[string]string{"x-amz-copy-source": "photos/vacation/sunset.jpg"},
+			commitErr:      status.Error(codes.Internal, "database error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantBodySubstr: "InternalError",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := copySourceObject()
+			if c.source != nil {
+				source = *c.source
+			}
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.LookupObjectFn = func(context.Context, string, string, string) (gantry.Object, error) {
+				if c.lookupErr != nil {
+					return gantry.Object{}, c.lookupErr
+				}
+				return source, nil
+			}
+			if c.planErr != nil {
+				gantryStub.PlanWriteFn = func(context.Context, string, string, int64, string, gantry.ObjectMetadata, map[string]string, gantry.ObjectLock) (*writeplanv1.WritePlan, error) {
+					return nil, c.planErr
+				}
+			}
+			gantryStub.CommitObjectFn = func(context.Context, string, gantry.ObjectCommit) (string, error) {
+				if c.commitErr != nil {
+					return "", c.commitErr
+				}
+				if source.VersionID != "" {
+					return "01JNEWVERSION", nil
+				}
+				return "", nil
+			}
+
+			cradleStub := testutil.NewCradleStub()
+			if c.copyErr != nil {
+				cradleStub.CopyObjectFn = func(context.Context, string, string, string, int64, []cradle.CopySource) (cradle.WriteResult, error) {
+					return cradle.WriteResult{}, c.copyErr
+				}
+			}
+
+			h := handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          cradleStub,
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/archive/"+c.key, nil)
+			req.SetPathValue("bucket", "archive")
+			req.SetPathValue("key", c.key)
+			for name, value := range c.headers {
+				req.Header.Set(name, value)
+			}
+
+			rec := httptest.NewRecorder()
+			h.CopyObject(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d; body: %s", rec.Code, c.wantStatus, rec.Body.String())
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: got %q, want to contain %q", rec.Body.String(), c.wantBodySubstr)
+			}
+
+			if c.wantStatus != http.StatusOK {
+				if cradleStub.CopyObjectCount() != 0 && c.copyErr == nil && c.commitErr == nil {
+					t.Fatalf("cradle copies: got %d, want 0", cradleStub.CopyObjectCount())
+				}
+				if gantryStub.CommitObjectCount() != 0 && c.commitErr == nil {
+					t.Fatalf("commits: got %d, want 0", gantryStub.CommitObjectCount())
+				}
+				return
+			}
+
+			if c.wantLookup != nil {
+				if diff := cmp.Diff([]testutil.LookupObjectCall{*c.wantLookup}, gantryStub.LookupObjectCalls); diff != "" {
+					t.Fatalf("lookup calls mismatch (-want +got):\n%s", diff)
+				}
+			}
+			if c.wantPlan != nil {
+				if diff := cmp.Diff([]testutil.PlanWriteCall{*c.wantPlan}, gantryStub.PlanWriteCalls); diff != "" {
+					t.Fatalf("plan calls mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if cradleStub.CopyObjectCount() != 1 {
+				t.Fatalf("cradle copies: got %d, want 1", cradleStub.CopyObjectCount())
+			}
+			copyCall := cradleStub.CopyObjectCalls[0]
+			if copyCall.Address != "localhost:9002" || copyCall.ObjectID != "stub-object-id" || copyCall.Bucket != "archive" {
+				t.Fatalf("copy destination: got %s %s/%s, want localhost:9002 archive/stub-object-id",
+					copyCall.Address, copyCall.Bucket, copyCall.ObjectID)
+			}
+			if c.wantSources != nil {
+				if diff := cmp.Diff(c.wantSources, copyCall.Sources); diff != "" {
+					t.Fatalf("copy sources mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if c.wantCommit != nil {
+				if diff := cmp.Diff([]testutil.CommitObjectCall{*c.wantCommit}, gantryStub.CommitObjectCalls); diff != "" {
+					t.Fatalf("commit calls mismatch (-want +got):\n%s", diff)
+				}
+			}
+			for name, want := range c.wantHeaders {
+				if got := rec.Header().Get(name); got != want {
+					t.Fatalf("%s: got %q, want %q", name, got, want)
+				}
+			}
+			if cradleStub.WriteObjectCount() != 0 || cradleStub.ReadObjectCount() != 0 {
+				t.Fatalf("bytes passed through flatbed: %d writes, %d reads", cradleStub.WriteObjectCount(), cradleStub.ReadObjectCount())
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,7 +21,8 @@ func (h *Handlers) CreateBucket(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if _, err := h.Gantry.CreateBucket(r.Context(), bucket); err != nil {
+	objectLock := strings.EqualFold(r.Header.Get("x-amz-bucket-object-lock-enabled"), "true")
+	if _, err := h.Gantry.CreateBucket(r.Context(), bucket, r.Header.Get("x-amz-acl"), objectLock); err != nil {
 		st, ok := status.FromError(err)
 		if !ok {
 			respond.Error(w, r, "InternalError", http.StatusInternalServerError)
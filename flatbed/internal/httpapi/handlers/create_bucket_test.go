@@ -151,7 +151,7 @@ func TestCreateBucket_ValidationGantryAndResponse(t *testing.T) {
 			v := &stubValidator{err: c.validatorErr}
 			g := testutil.NewGantryStub()
 			if c.gantryErr != nil {
-				g.CreateFn = func(context.Context, string) (string, error) {
+				g.CreateFn = func(context.Context, string, string, bool) (string, error) {
 					return "", c.gantryErr
 				}
 			}
@@ -197,3 +197,43 @@ func TestCreateBucket_ValidationGantryAndResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateBucket_PassesCannedACL(t *testing.T) {
+	t.Parallel()
+
+	g := testutil.NewGantryStub()
+	h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: g, Cradle: testutil.NewCradleStub()}
+
+	req := reqWithBucket(t, http.MethodPut, "public-bucket")
+	req.Header.Set("x-amz-acl", "public-read")
+	rec := httptest.NewRecorder()
+
+	h.CreateBucket(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status: got %d, want %d", rec.Code, http.StatusCreated)
+	}
+	if len(g.CreateACLs) != 1 || g.CreateACLs[0] != "public-read" {
+		t.Fatalf("gantry create_bucket acls = %#v; want [public-read]", g.CreateACLs)
+	}
+}
+
+func TestCreateBucket_PassesObjectLock(t *testing.T) {
+	t.Parallel()
+
+	g := testutil.NewGantryStub()
+	h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: g, Cradle: testutil.NewCradleStub()}
+
+	req := reqWithBucket(t, http.MethodPut, "backups")
+	req.Header.Set("x-amz-bucket-object-lock-enabled", "true")
+	rec := httptest.NewRecorder()
+
+	h.CreateBucket(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status: got %d, want %d", rec.Code, http.StatusCreated)
+	}
+	if len(g.CreateObjectLocks) != 1 || !g.CreateObjectLocks[0] {
+		t.Fatalf("gantry create_bucket object locks = %#v; want [true]", g.CreateObjectLocks)
+	}
+}
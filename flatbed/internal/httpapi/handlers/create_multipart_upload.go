@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+// CreateMultipartUpload serves POST /{bucket}/{key}?uploads. The upload's
+// Content-Type and other stored headers are fixed here and applied to the
+// object on completion.
+func (h *Handlers) CreateMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+	key := r.PathValue("key")
+
+	// Validate bucket name
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	// Validate key
+	if err := h.KeyValidator.ValidateKey(key); err != nil {
+		respond.Error(w, r, "InvalidKeyName", http.StatusBadRequest)
+		return
+	}
+
+	uploadID, err := h.Gantry.CreateMultipartUpload(r.Context(), bucket, key, r.Header.Get("Content-Type"), uploadMetadata(r))
+	if err != nil {
+		respondUploadError(w, r, err)
+		return
+	}
+
+	logger.LogResult(r, fmt.Sprintf("upload <%s> created for <%s/%s>", uploadID, bucket, key))
+	respond.Encode(w, r, http.StatusOK, initiateMultipartUploadResult{
+		Bucket:   bucket,
+		Key:      key,
+		UploadID: uploadID,
+	})
+}
@@ -0,0 +1,183 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestCreateMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		bucket         string
+		key            string
+		contentType    string
+		headers        map[string]string
+		wantMetadata   gantry.ObjectMetadata
+		gantryErr      error
+		wantStatus     int
+		wantCreates    int
+		wantBodySubstr []string
+	}
+
+	cases := []tc{
+		{
+			name:        "create -> 200 with upload id",
+			bucket:      "photos",
+			key:         "videos/big.mp4",
+			contentType: "video/mp4",
+			wantStatus:  http.StatusOK,
+			wantCreates: 1,
+			wantBodySubstr: []string{
+				"<InitiateMultipartUploadResult",
+				"<Bucket>photos</Bucket>",
+				"<Key>videos/big.mp4</Key>",
+				"<UploadId>stub-upload-id</UploadId>",
+			},
+		},
+		{
+			name:        "stored headers are passed to gantry",
+			bucket:      "photos",
+			key:         "videos/big.mp4",
+			contentType: "video/mp4",
+			headers: map[string]string{
+				"Cache-Control":       "max-age=60",
+				"Content-Disposition": `attachment; filename="big.mp4"`,
+				"Content-Encoding":    "gzip",
+				"Expires":             "Thu, 01 Jan 2026 00:00:00 GMT",
+				"X-Amz-Meta-Camera":   "front",
+			},
+			wantMetadata: gantry.ObjectMetadata{
+				CacheControl:       "max-age=60",
+				ContentDisposition: `attachment; filename="big.mp4"`,
+				ContentEncoding:    "gzip",
+				Expires:            "Thu, 01 Jan 2026 00:00:00 GMT",
+				User:               map[string]string{"camera": "front"},
+			},
+			wantStatus:     http.StatusOK,
+			wantCreates:    1,
+			wantBodySubstr: []string{"<UploadId>stub-upload-id</UploadId>"},
+		},
+		{
+			name:           "gantry metadata too large -> 400",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			gantryErr:      status.Error(codes.InvalidArgument, "MetadataTooLarge"),
+			wantStatus:     http.StatusBadRequest,
+			wantCreates:    1,
+			wantBodySubstr: []string{"MetadataTooLarge"},
+		},
+		{
+			name:           "invalid bucket name -> 400",
+			bucket:         "INVALID-BUCKET",
+			key:            "videos/big.mp4",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: []string{"InvalidBucketName"},
+		},
+		{
+			name:           "invalid key -> 400",
+			bucket:         "photos",
+			key:            "file\x00name",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: []string{"InvalidKeyName"},
+		},
+		{
+			name:   "gantry bucket not found -> 404 NoSuchBucket",
+			bucket: "nonexistent-bucket",
+			key:    "videos/big.mp4",
+			gantryErr: objectLookupErr(codes.NotFound, "bucket not found",
+				servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, "nonexistent-bucket", "videos/big.mp4"),
+			wantStatus:     http.StatusNotFound,
+			wantCreates:    1,
+			wantBodySubstr: []string{"NoSuchBucket"},
+		},
+		{
+			name:           "gantry permission denied -> 403",
+			bucket:         "forbidden",
+			key:            "videos/big.mp4",
+			gantryErr:      status.Error(codes.PermissionDenied, "access denied"),
+			wantStatus:     http.StatusForbidden,
+			wantCreates:    1,
+			wantBodySubstr: []string{"AccessDenied"},
+		},
+		{
+			name:           "gantry unexpected error -> 500",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			gantryErr:      status.Error(codes.Internal, "unexpected database error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantCreates:    1,
+			wantBodySubstr: []string{"InternalError"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			if c.gantryErr != nil {
+				gantryStub.CreateMultipartUploadFn = func(context.Context, string, string, string, gantry.ObjectMetadata) (string, error) {
+					return "", c.gantryErr
+				}
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/?uploads", nil)
+			req.SetPathValue("bucket", c.bucket)
+			req.SetPathValue("key", c.key)
+			if c.contentType != "" {
+				req.Header.Set("Content-Type", c.contentType)
+			}
+			for name, value := range c.headers {
+				req.Header.Set(name, value)
+			}
+			rec := httptest.NewRecorder()
+
+			h.CreateMultipartUpload(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+
+			if got := gantryStub.CreateMultipartUploadCount(); got != c.wantCreates {
+				t.Fatalf("CreateMultipartUpload calls: got %d, want %d", got, c.wantCreates)
+			}
+			if c.wantCreates > 0 {
+				call := gantryStub.CreateMultipartUploadCalls[0]
+				if call.Bucket != c.bucket || call.Key != c.key || call.ContentType != c.contentType {
+					t.Fatalf("CreateMultipartUpload call: got %+v, want %s/%s %q", call, c.bucket, c.key, c.contentType)
+				}
+				if !reflect.DeepEqual(call.Metadata, c.wantMetadata) {
+					t.Fatalf("CreateMultipartUpload metadata: got %+v, want %+v", call.Metadata, c.wantMetadata)
+				}
+			}
+
+			for _, want := range c.wantBodySubstr {
+				if !strings.Contains(rec.Body.String(), want) {
+					t.Fatalf("body: expected substring %q, got %q", want, rec.Body.String())
+				}
+			}
+		})
+	}
+}
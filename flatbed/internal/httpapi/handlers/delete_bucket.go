@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+func (h *Handlers) DeleteBucket(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Gantry.DeleteBucket(r.Context(), bucket); err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+			return
+		}
+
+		switch st.Code() {
+		case codes.FailedPrecondition:
+			respond.Error(w, r, "BucketNotEmpty", http.StatusConflict)
+		case codes.NotFound:
+			respond.Error(w, r, "NoSuchBucket", http.StatusNotFound)
+		case codes.InvalidArgument:
+			respond.Error(w, r, st.Message(), http.StatusBadRequest)
+		case codes.PermissionDenied:
+			respond.Error(w, r, "AccessDenied", http.StatusForbidden)
+		default:
+			logger.LogGantryError(r, err)
+			respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	logger.LogResult(r, fmt.Sprintf("bucket <%s> deleted", bucket))
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,125 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+)
+
+func TestDeleteBucket(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		bucket         string
+		gantryErr      error
+		wantStatus     int
+		wantDeletes    int
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:        "empty bucket -> 204",
+			bucket:      "photos",
+			wantStatus:  http.StatusNoContent,
+			wantDeletes: 1,
+		},
+		{
+			name:           "invalid bucket name -> 400",
+			bucket:         "INVALID-BUCKET",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidBucketName",
+		},
+		{
+			name:           "gantry bucket not empty -> 409 BucketNotEmpty",
+			bucket:         "photos",
+			gantryErr:      status.Error(codes.FailedPrecondition, "delete bucket: bucket not empty"),
+			wantStatus:     http.StatusConflict,
+			wantDeletes:    1,
+			wantBodySubstr: "BucketNotEmpty",
+		},
+		{
+			name:           "gantry bucket not found -> 404 NoSuchBucket",
+			bucket:         "nonexistent-bucket",
+			gantryErr:      status.Error(codes.NotFound, "bucket not found"),
+			wantStatus:     http.StatusNotFound,
+			wantDeletes:    1,
+			wantBodySubstr: "NoSuchBucket",
+		},
+		{
+			name:           "gantry invalid argument -> 400",
+			bucket:         "bad",
+			gantryErr:      status.Error(codes.InvalidArgument, "InvalidBucketName"),
+			wantStatus:     http.StatusBadRequest,
+			wantDeletes:    1,
+			wantBodySubstr: "InvalidBucketName",
+		},
+		{
+			name:           "gantry permission denied -> 403",
+			bucket:         "forbidden",
+			gantryErr:      status.Error(codes.PermissionDenied, "access denied"),
+			wantStatus:     http.StatusForbidden,
+			wantDeletes:    1,
+			wantBodySubstr: "AccessDenied",
+		},
+		{
+			name:           "gantry unexpected error -> 500",
+			bucket:         "photos",
+			gantryErr:      status.Error(codes.Internal, "unexpected database error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantDeletes:    1,
+			wantBodySubstr: "InternalError",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			if c.gantryErr != nil {
+				gantryStub.DeleteBucketFn = func(context.Context, string) error {
+					return c.gantryErr
+				}
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			req.SetPathValue("bucket", c.bucket)
+			rec := httptest.NewRecorder()
+
+			h.DeleteBucket(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+
+			if got := gantryStub.DeleteBucketCount(); got != c.wantDeletes {
+				t.Fatalf("DeleteBucket calls: got %d, want %d", got, c.wantDeletes)
+			}
+			if c.wantDeletes > 0 && gantryStub.DeleteBucketCalls[0] != c.bucket {
+				t.Fatalf("DeleteBucket call: got %q, want %q", gantryStub.DeleteBucketCalls[0], c.bucket)
+			}
+
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+// DeleteObject only asks Gantry to retire the key; the blob is removed from
+// its cradle later by Gantry's cleanup worker. In a versioned bucket that
+// leaves a delete marker, unless ?versionId= names a version to remove.
+func (h *Handlers) DeleteObject(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+	key := r.PathValue("key")
+
+	// Validate bucket name
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	// Validate key
+	if err := h.KeyValidator.ValidateKey(key); err != nil {
+		respond.Error(w, r, "InvalidKeyName", http.StatusBadRequest)
+		return
+	}
+
+	// Gantry reports a missing bucket the same way LookupObject does; a
+	// missing key or version succeeds, matching S3. A version under object
+	// lock is refused with AccessDenied.
+	versionID := r.URL.Query().Get("versionId")
+	deletion, err := h.Gantry.DeleteObject(r.Context(), bucket, key, versionID, bypassGovernance(r))
+	if err != nil {
+		respondLookupError(w, r, err)
+		return
+	}
+
+	if deletion.DeleteMarker {
+		w.Header().Set("x-amz-delete-marker", "true")
+	}
+	setVersionHeader(w, deletion.VersionID)
+
+	if versionID != "" {
+		logger.LogResult(r, fmt.Sprintf("version <%s> of object <%s/%s> deleted", versionID, bucket, key))
+	} else {
+		logger.LogResult(r, fmt.Sprintf("object <%s/%s> deleted", bucket, key))
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,177 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestDeleteObject(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		bucket         string
+		key            string
+		target         string
+		deletion       gantry.ObjectDeletion
+		gantryErr      error
+		wantStatus     int
+		wantDeletes    int
+		wantVersionID  string
+		bypass         bool
+		wantHeaders    map[string]string
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:        "delete -> 204",
+			bucket:      "photos",
+			key:         "vacation/sunset.jpg",
+			wantStatus:  http.StatusNoContent,
+			wantDeletes: 1,
+			wantHeaders: map[string]string{"x-amz-version-id": "", "x-amz-delete-marker": ""},
+		},
+		{
+			name:        "versioned delete -> 204 with delete marker",
+			bucket:      "photos",
+			key:         "vacation/sunset.jpg",
+			deletion:    gantry.ObjectDeletion{VersionID: "01JMARKER", DeleteMarker: true},
+			wantStatus:  http.StatusNoContent,
+			wantDeletes: 1,
+			wantHeaders: map[string]string{"x-amz-version-id": "01JMARKER", "x-amz-delete-marker": "true"},
+		},
+		{
+			name:          "delete version -> 204 with version id",
+			bucket:        "photos",
+			key:           "vacation/sunset.jpg",
+			target:        "/?versionId=01JVERSION",
+			deletion:      gantry.ObjectDeletion{VersionID: "01JVERSION"},
+			wantStatus:    http.StatusNoContent,
+			wantDeletes:   1,
+			wantVersionID: "01JVERSION",
+			wantHeaders:   map[string]string{"x-amz-version-id": "01JVERSION", "x-amz-delete-marker": ""},
+		},
+		{
+			name:          "bypass governance retention is passed to gantry",
+			bucket:        "backups",
+			key:           "db.tar",
+			target:        "/?versionId=01JVERSION",
+			deletion:      gantry.ObjectDeletion{VersionID: "01JVERSION"},
+			bypass:        true,
+			wantStatus:    http.StatusNoContent,
+			wantDeletes:   1,
+			wantVersionID: "01JVERSION",
+		},
+		{
+			name:           "invalid bucket name -> 400",
+			bucket:         "INVALID-BUCKET",
+			key:            "vacation/sunset.jpg",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidBucketName",
+		},
+		{
+			name:           "invalid key -> 400",
+			bucket:         "photos",
+			key:            "file\x00name",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidKeyName",
+		},
+		{
+			name:   "gantry bucket not found -> 404 NoSuchBucket",
+			bucket: "nonexistent-bucket",
+			key:    "vacation/sunset.jpg",
+			gantryErr: objectLookupErr(codes.NotFound, "bucket not found",
+				servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, "nonexistent-bucket", "vacation/sunset.jpg"),
+			wantStatus:     http.StatusNotFound,
+			wantDeletes:    1,
+			wantBodySubstr: "NoSuchBucket",
+		},
+		{
+			name:           "gantry permission denied -> 403",
+			bucket:         "forbidden",
+			key:            "vacation/sunset.jpg",
+			gantryErr:      status.Error(codes.PermissionDenied, "access denied"),
+			wantStatus:     http.StatusForbidden,
+			wantDeletes:    1,
+			wantBodySubstr: "AccessDenied",
+		},
+		{
+			name:           "gantry unexpected error -> 500",
+			bucket:         "photos",
+			key:            "vacation/sunset.jpg",
+			gantryErr:      status.Error(codes.Internal, "unexpected database error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantDeletes:    1,
+			wantBodySubstr: "InternalError",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.DeleteObjectFn = func(context.Context, string, string, string, bool) (gantry.ObjectDeletion, error) {
+				return c.deletion, c.gantryErr
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			target := c.target
+			if target == "" {
+				target = "/"
+			}
+			req := httptest.NewRequest(http.MethodDelete, target, nil)
+			req.SetPathValue("bucket", c.bucket)
+			req.SetPathValue("key", c.key)
+			if c.bypass {
+				req.Header.Set("x-amz-bypass-governance-retention", "true")
+			}
+			rec := httptest.NewRecorder()
+
+			h.DeleteObject(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+
+			if got := gantryStub.DeleteObjectCount(); got != c.wantDeletes {
+				t.Fatalf("DeleteObject calls: got %d, want %d", got, c.wantDeletes)
+			}
+			if c.wantDeletes > 0 {
+				call := gantryStub.DeleteObjectCalls[0]
+				if call.Bucket != c.bucket || call.Key != c.key || call.VersionID != c.wantVersionID || call.BypassGovernance != c.bypass {
+					t.Fatalf("DeleteObject call: got %+v, want %s/%s version %q bypass %t", call, c.bucket, c.key, c.wantVersionID, c.bypass)
+				}
+			}
+
+			for name, want := range c.wantHeaders {
+				if got := rec.Header().Get(name); got != want {
+					t.Fatalf("%s: got %q, want %q", name, got, want)
+				}
+			}
+
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
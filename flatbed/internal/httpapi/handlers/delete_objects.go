@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+// maxDeleteBodyBytes bounds the DeleteObjects body, leaving room for S3's
+// limit of 1000 keys of up to 1024 bytes each.
+const maxDeleteBodyBytes = 2 * 1024 * 1024
+
+// deleteRequest is the request body of DeleteObjects, matched without a
+// namespace like completeMultipartUpload.
+type deleteRequest struct {
+	XMLName xml.Name           `xml:"Delete"`
+	Quiet   bool               `xml:"Quiet"`
+	Objects []objectIdentifier `xml:"Object"`
+}
+
+type objectIdentifier struct {
+	Key       string `xml:"Key"`
+	VersionID string `xml:"VersionId"`
+}
+
+type deleteResult struct {
+	XMLName xml.Name        `xml:"http://s3.amazonaws.com/doc/2006-03-01/ DeleteResult" json:"-"`
+	Deleted []deletedObject `xml:"Deleted" json:"Deleted,omitempty"`
+	Errors  []deleteError   `xml:"Error" json:"Errors,omitempty"`
+}
+
+type deletedObject struct {
+	Key                   string `xml:"Key" json:"Key"`
+	VersionID             string `xml:"VersionId,omitempty" json:"VersionId,omitempty"`
+	DeleteMarker          bool   `xml:"DeleteMarker,omitempty" json:"DeleteMarker,omitempty"`
+	DeleteMarkerVersionID string `xml:"DeleteMarkerVersionId,omitempty" json:"DeleteMarkerVersionId,omitempty"`
+}
+
+type deleteError struct {
+	Key       string `xml:"Key" json:"Key"`
+	VersionID string `xml:"VersionId,omitempty" json:"VersionId,omitempty"`
+	Code      string `xml:"Code" json:"Code"`
+	Message   string `xml:"Message" json:"Message"`
+}
+
+// DeleteObjects serves POST /{bucket}?delete, deleting up to 1000 keys or
+// versions with one Gantry call. Keys that can't be deleted are reported in
+// the result without failing the request, and Quiet mode leaves out the keys
+// that were deleted. A Content-MD5 header, which S3 requires, is checked
+// when sent.
+func (h *Handlers) DeleteObjects(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	raw, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxDeleteBodyBytes))
+	if err != nil {
+		respond.Error(w, r, "MalformedXML", http.StatusBadRequest)
+		return
+	}
+
+	if header := r.Header.Get("Content-MD5"); header != "" {
+		digest, err := base64.StdEncoding.DecodeString(header)
+		if err != nil || len(digest) != md5.Size {
+			respond.Error(w, r, "InvalidDigest", http.StatusBadRequest)
+			return
+		}
+		if sum := md5.Sum(raw); !bytes.Equal(digest, sum[:]) {
+			respond.Error(w, r, "BadDigest", http.StatusBadRequest)
+			return
+		}
+	}
+
+	var body deleteRequest
+	if err := xml.Unmarshal(raw, &body); err != nil {
+		respond.Error(w, r, "MalformedXML", http.StatusBadRequest)
+		return
+	}
+
+	objects := make([]gantry.ObjectIdentifier, 0, len(body.Objects))
+	for _, obj := range body.Objects {
+		objects = append(objects, gantry.ObjectIdentifier{Key: obj.Key, VersionID: obj.VersionID})
+	}
+
+	deletions, err := h.Gantry.DeleteObjects(r.Context(), bucket, objects, bypassGovernance(r))
+	if err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	out := deleteResult{}
+	if !body.Quiet {
+		for _, d := range deletions.Deleted {
+			out.Deleted = append(out.Deleted, deletedObject(d))
+		}
+	}
+	for _, e := range deletions.Errors {
+		out.Errors = append(out.Errors, deleteError{Key: e.Key, VersionID: e.VersionID, Code: e.Code, Message: respond.Message(e.Code)})
+	}
+
+	logger.LogResult(r, fmt.Sprintf("%d objects deleted from bucket <%s>, %d failed", len(deletions.Deleted), bucket, len(deletions.Errors)))
+	if err := respond.Encode(w, r, http.StatusOK, out); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
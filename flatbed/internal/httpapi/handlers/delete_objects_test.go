@@ -0,0 +1,128 @@
+package handlers_test
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+)
+
+func TestDeleteObjects(t *testing.T) {
+	t.Parallel()
+
+	const twoKeys = `<Delete xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Object><Key>a.txt</Key></Object><Object><Key>b.txt</Key><VersionId>v1</VersionId></Object></Delete>`
+	bothKeys := []gantry.ObjectIdentifier{{Key: "a.txt"}, {Key: "b.txt", VersionID: "v1"}}
+	mixed := gantry.ObjectDeletions{
+		Deleted: []gantry.DeletedObject{{Key: "a.txt", DeleteMarker: true, DeleteMarkerVersionID: "m1"}},
+		Errors:  []gantry.DeleteObjectError{{Key: "b.txt", VersionID: "v1", Code: "AccessDenied"}},
+	}
+	sum := md5.Sum([]byte(twoKeys))
+
+	type tc struct {
+		name        string
+		body        string
+		contentMD5  string
+		bypass      bool
+		deletions   gantry.ObjectDeletions
+		gantryErr   error
+		wantStatus  int
+		wantObjects []gantry.ObjectIdentifier
+		wantBody    []string
+		notInBody   []string
+	}
+
+	cases := []tc{
+		{
+			name:        "deleted and failed keys -> 200",
+			body:        twoKeys,
+			deletions:   mixed,
+			wantStatus:  http.StatusOK,
+			wantObjects: bothKeys,
+			wantBody: []string{
+				`<DeleteResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`,
+				`<Deleted><Key>a.txt</Key><DeleteMarker>true</DeleteMarker><DeleteMarkerVersionId>m1</DeleteMarkerVersionId></Deleted>`,
+				`<Error><Key>b.txt</Key><VersionId>v1</VersionId><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`,
+			},
+		},
+		{
+			name:        "quiet reports only errors",
+			body:        `<Delete><Quiet>true</Quiet><Object><Key>a.txt</Key></Object><Object><Key>b.txt</Key><VersionId>v1</VersionId></Object></Delete>`,
+			deletions:   mixed,
+			wantStatus:  http.StatusOK,
+			wantObjects: bothKeys,
+			wantBody:    []string{"<Code>AccessDenied</Code>"},
+			notInBody:   []string{"<Deleted>"},
+		},
+		{name: "bypass governance retention -> 200", body: twoKeys, bypass: true, deletions: mixed, wantStatus: http.StatusOK, wantObjects: bothKeys},
+		{name: "matching Content-MD5 -> 200", body: twoKeys, contentMD5: base64.StdEncoding.EncodeToString(sum[:]), wantStatus: http.StatusOK, wantObjects: bothKeys},
+		{name: "mismatched Content-MD5 -> 400", body: twoKeys, contentMD5: base64.StdEncoding.EncodeToString(make([]byte, md5.Size)), wantStatus: http.StatusBadRequest, wantBody: []string{"BadDigest"}},
+		{name: "invalid Content-MD5 -> 400", body: twoKeys, contentMD5: "not-a-digest", wantStatus: http.StatusBadRequest, wantBody: []string{"InvalidDigest"}},
+		{name: "malformed body -> 400", body: "<Delete><Object>", wantStatus: http.StatusBadRequest, wantBody: []string{"MalformedXML"}},
+		{
+			name:        "rejected by gantry -> 400",
+			body:        "<Delete></Delete>",
+			gantryErr:   status.Error(codes.InvalidArgument, "MalformedXML"),
+			wantStatus:  http.StatusBadRequest,
+			wantObjects: []gantry.ObjectIdentifier{},
+			wantBody:    []string{"MalformedXML"},
+		},
+		{name: "missing bucket -> 404", body: twoKeys, gantryErr: status.Error(codes.NotFound, "NoSuchBucket"), wantStatus: http.StatusNotFound, wantObjects: bothKeys, wantBody: []string{"NoSuchBucket"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.DeleteObjectsFn = func(context.Context, string, []gantry.ObjectIdentifier, bool) (gantry.ObjectDeletions, error) {
+				return c.deletions, c.gantryErr
+			}
+			h := &handlers.Handlers{BucketValidator: validation.DefaultBucketNameValidator{}, Gantry: gantryStub}
+
+			req := httptest.NewRequest(http.MethodPost, "/photos?delete", strings.NewReader(c.body))
+			req.SetPathValue("bucket", "photos")
+			if c.contentMD5 != "" {
+				req.Header.Set("Content-MD5", c.contentMD5)
+			}
+			if c.bypass {
+				req.Header.Set("x-amz-bypass-governance-retention", "true")
+			}
+			rec := httptest.NewRecorder()
+			h.DeleteObjects(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d; body: %s", rec.Code, c.wantStatus, rec.Body.String())
+			}
+			calls := gantryStub.DeleteObjectsCalls
+			if c.wantObjects == nil {
+				if len(calls) != 0 {
+					t.Fatalf("DeleteObjects calls: got %+v, want none", calls)
+				}
+			} else if len(calls) != 1 || calls[0].Bucket != "photos" || !reflect.DeepEqual(calls[0].Objects, c.wantObjects) || calls[0].BypassGovernance != c.bypass {
+				t.Fatalf("DeleteObjects calls: got %+v, want objects %+v bypass %t", calls, c.wantObjects, c.bypass)
+			}
+			for _, want := range c.wantBody {
+				if !strings.Contains(rec.Body.String(), want) {
+					t.Fatalf("body: expected substring %q, got %q", want, rec.Body.String())
+				}
+			}
+			for _, unwanted := range c.notInBody {
+				if strings.Contains(rec.Body.String(), unwanted) {
+					t.Fatalf("body: unexpected substring %q in %q", unwanted, rec.Body.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// defaultContentType is served for objects stored without a Content-Type so
+// net/http doesn't sniff one from the body.
+const defaultContentType = "application/octet-stream"
+
+func (h *Handlers) GetObject(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+	key := r.PathValue("key")
+
+	// Validate bucket name
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	// Validate key
+	if err := h.KeyValidator.ValidateKey(key); err != nil {
+		respond.Error(w, r, "InvalidKeyName", http.StatusBadRequest)
+		return
+	}
+
+	obj, err := h.Gantry.LookupObject(r.Context(), bucket, key, r.URL.Query().Get("versionId"))
+	if err != nil {
+		respondLookupError(w, r, err)
+		return
+	}
+
+	logger.LogObjectLocation(r, obj.ID, obj.CradleAddress, obj.Size)
+
+	if !checkPreconditions(w, r, obj) {
+		return
+	}
+
+	parts := objectParts(obj)
+	reads := wholeReads(parts)
+
+	br, ranged, err := parseRange(r.Header.Get("Range"), obj.Size)
+	if errors.Is(err, errUnsatisfiableRange) {
+		w.Header().Set("Content-Range", "bytes */"+strconv.FormatInt(obj.Size, 10))
+		respond.Error(w, r, "InvalidRange", http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+	if ranged {
+		reads = rangeReads(parts, br)
+	}
+
+	// Only the first blob is opened before the headers go out, so a cradle
+	// that is down for it still gets a clean 500.
+	first := reads[0]
+	body, err := h.Cradle.ReadObject(r.Context(), first.part.CradleAddress, first.part.BlobID, bucket, first.offset, first.length)
+	if err != nil {
+		logger.LogCradleError(r, err)
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+		return
+	}
+
+	setObjectHeaders(w, obj)
+	if !ranged && checksumModeEnabled(r) {
+		setChecksumHeader(w, obj.ChecksumAlgorithm, obj.Checksum)
+	}
+	if ranged {
+		w.Header().Set("Content-Length", strconv.FormatInt(br.length(), 10))
+		w.Header().Set("Content-Range", br.contentRange(obj.Size))
+		w.WriteHeader(http.StatusPartialContent)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	// Headers are already sent, so a failure here can only be logged; the
+	// client sees a short body against the declared Content-Length.
+	for i, read := range reads {
+		if i > 0 {
+			body, err = h.Cradle.ReadObject(r.Context(), read.part.CradleAddress, read.part.BlobID, bucket, read.offset, read.length)
+			if err != nil {
+				logger.LogCradleError(r, err)
+				return
+			}
+		}
+
+		_, err := io.Copy(w, body)
+		body.Close()
+		if err != nil {
+			logger.LogCradleError(r, err)
+			return
+		}
+	}
+}
+
+// objectParts returns the blobs that make up obj in read order. An object
+// written with a single PUT is its own only part.
+func objectParts(obj gantry.Object) []gantry.ObjectPart {
+	if len(obj.Parts) > 0 {
+		return obj.Parts
+	}
+	return []gantry.ObjectPart{{BlobID: obj.ID, Size: obj.Size, CradleAddress: obj.CradleAddress}}
+}
+
+// setObjectHeaders writes the metadata headers shared by GET and HEAD.
+func setObjectHeaders(w http.ResponseWriter, obj gantry.Object) {
+	contentType := obj.ContentType
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(obj.Size, 10))
+	w.Header().Set("Accept-Ranges", "bytes")
+	w.Header().Set("ETag", objectETag(obj))
+	w.Header().Set("Last-Modified", formatLastModified(obj.LastModified))
+	setVersionHeader(w, obj.VersionID)
+	setMetadataHeaders(w, obj.Metadata)
+	if len(obj.Tags) > 0 {
+		w.Header().Set("x-amz-tagging-count", strconv.Itoa(len(obj.Tags)))
+	}
+	setObjectLockHeaders(w, obj.Lock)
+}
+
+// setVersionHeader reports the version a request read or wrote. Objects in
+// a bucket that was never versioned have none.
+func setVersionHeader(w http.ResponseWriter, versionID string) {
+	if versionID != "" {
+		w.Header().Set("x-amz-version-id", versionID)
+	}
+}
+
+// checksumModeEnabled reports whether the client asked for the object's
+// stored checksum with x-amz-checksum-mode: ENABLED.
+func checksumModeEnabled(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("x-amz-checksum-mode"), "ENABLED")
+}
+
+// objectETag returns the quoted ETag for obj. Objects written before Gantry
+// stored content digests have no ETag and use their object ID.
+func objectETag(obj gantry.Object) string {
+	etag := obj.ETag
+	if etag == "" {
+		etag = obj.ID
+	}
+	return `"` + etag + `"`
+}
+
+// respondLookupError maps an object lookup failure from Gantry to the
+// matching S3 error response.
+func respondLookupError(w http.ResponseWriter, r *http.Request, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+		return
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		detail := lookupDetail(st)
+		switch detail.GetReason() {
+		case servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND:
+			respond.Error(w, r, "NoSuchBucket", http.StatusNotFound)
+		case servicev1.ObjectLookupError_REASON_VERSION_NOT_FOUND:
+			respond.Error(w, r, "NoSuchVersion", http.StatusNotFound)
+		case servicev1.ObjectLookupError_REASON_DELETE_MARKER:
+			// S3 refuses to read a delete marker by version ID and says
+			// which version it was.
+			w.Header().Set("x-amz-delete-marker", "true")
+			setVersionHeader(w, detail.GetVersionId())
+			respond.Error(w, r, "MethodNotAllowed", http.StatusMethodNotAllowed)
+		default:
+			respond.Error(w, r, "NoSuchKey", http.StatusNotFound)
+		}
+	case codes.InvalidArgument:
+		respond.Error(w, r, st.Message(), http.StatusBadRequest)
+	case codes.PermissionDenied:
+		respond.Error(w, r, "AccessDenied", http.StatusForbidden)
+	default:
+		logger.LogGantryError(r, err)
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+	}
+}
+
+func lookupReason(st *status.Status) servicev1.ObjectLookupError_Reason {
+	return lookupDetail(st).GetReason()
+}
+
+// lookupDetail returns the ObjectLookupError attached to st, or nil.
+func lookupDetail(st *status.Status) *servicev1.ObjectLookupError {
+	for _, detail := range st.Details() {
+		if lookupErr, ok := detail.(*servicev1.ObjectLookupError); ok {
+			return lookupErr
+		}
+	}
+	return nil
+}
+
+func formatLastModified(t time.Time) string {
+	return t.UTC().Format(time.RFC1123)
+}
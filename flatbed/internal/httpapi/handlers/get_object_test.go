@@ -0,0 +1,764 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func objectLookupErr(code codes.Code, message string, reason servicev1.ObjectLookupError_Reason, bucket, key string) error {
+	st := status.New(code, message)
+	detail := &servicev1.ObjectLookupError{
+		Reason: reason,
+		Bucket: bucket,
+		Key:    key,
+	}
+	st, err := st.WithDetails(detail)
+	if err != nil {
+		panic(err)
+	}
+	return st.Err()
+}
+
+// versionLookupErr is objectLookupErr for a lookup that named a version.
+func versionLookupErr(reason servicev1.ObjectLookupError_Reason, bucket, key, versionID string) error {
+	st, err := status.New(codes.NotFound, "version lookup failed").WithDetails(&servicev1.ObjectLookupError{
+		Reason:    reason,
+		Bucket:    bucket,
+		Key:       key,
+		VersionId: versionID,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return st.Err()
+}
+
+func TestGetObject(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name            string
+		bucket          string
+		key             string
+		lookupErr       error
+		cradleErr       error
+		wantStatus      int
+		wantLookups     int
+		wantCradleCalls int
+		wantBody        string
+		wantBodySubstr  string
+		wantHeaders     map[string]string
+	}
+
+	cases := []tc{
+		{
+			name:            "committed object streams body with headers",
+			bucket:          "photos",
+			key:             "vacation/sunset.jpg",
+			wantStatus:      http.StatusOK,
+			wantLookups:     1,
+			wantCradleCalls: 1,
+			wantBody:        testutil.StubObjectBody,
+			wantHeaders: map[string]string{
+				"Content-Length": "16",
+				"Content-Type":   "text/plain",
+				"ETag":           `"stub-object-id"`,
+				"Last-Modified":  time.UnixMilli(1234567890).UTC().Format(time.RFC1123),
+				"Accept-Ranges":  "bytes",
+			},
+		},
+		{
+			name:           "invalid bucket name -> 400",
+			bucket:         "INVALID-BUCKET",
+			key:            "vacation/sunset.jpg",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidBucketName",
+		},
+		{
+			name:           "invalid key -> 400",
+			bucket:         "photos",
+			key:            "file\x00name",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidKeyName",
+		},
+		{
+			name:   "gantry bucket not found -> 404 NoSuchBucket",
+			bucket: "nonexistent-bucket",
+			key:    "vacation/sunset.jpg",
+			lookupErr: objectLookupErr(codes.NotFound, "bucket not found",
+				servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, "nonexistent-bucket", "vacation/sunset.jpg"),
+			wantStatus:     http.StatusNotFound,
+			wantLookups:    1,
+			wantBodySubstr: "NoSuchBucket",
+		},
+		{
+			name:   "gantry object not found -> 404 NoSuchKey",
+			bucket: "photos",
+			key:    "vacation/missing.jpg",
+			lookupErr: objectLookupErr(codes.NotFound, "object not found",
+				servicev1.ObjectLookupError_REASON_OBJECT_NOT_FOUND, "photos", "vacation/missing.jpg"),
+			wantStatus:     http.StatusNotFound,
+			wantLookups:    1,
+			wantBodySubstr: "NoSuchKey",
+		},
+		{
+			name:           "gantry unexpected error -> 500",
+			bucket:         "photos",
+			key:            "vacation/sunset.jpg",
+			lookupErr:      status.Error(codes.Internal, "unexpected database error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantLookups:    1,
+			wantBodySubstr: "InternalError",
+		},
+		{
+			name:            "cradle read failure -> 500",
+			bucket:          "photos",
+			key:             "vacation/sunset.jpg",
+			cradleErr:       errors.New("connection refused"),
+			wantStatus:      http.StatusInternalServerError,
+			wantLookups:     1,
+			wantCradleCalls: 1,
+			wantBodySubstr:  "InternalError",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			if c.lookupErr != nil {
+				gantryStub.LookupObjectFn = func(context.Context, string, string, string) (gantry.Object, error) {
+					return gantry.Object{}, c.lookupErr
+				}
+			}
+
+			cradleStub := testutil.NewCradleStub()
+			if c.cradleErr != nil {
+				cradleStub.ReadObjectFn = func(context.Context, string, string, string, int64, int64) (io.ReadCloser, error) {
+					return nil, c.cradleErr
+				}
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          cradleStub,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.SetPathValue("bucket", c.bucket)
+			req.SetPathValue("key", c.key)
+			rec := httptest.NewRecorder()
+
+			h.GetObject(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+
+			if got := gantryStub.LookupObjectCount(); got != c.wantLookups {
+				t.Fatalf("LookupObject calls: got %d, want %d", got, c.wantLookups)
+			}
+			if c.wantLookups > 0 {
+				call := gantryStub.LookupObjectCalls[0]
+				if call.Bucket != c.bucket || call.Key != c.key {
+					t.Fatalf("LookupObject call: got %+v, want %s/%s", call, c.bucket, c.key)
+				}
+			}
+
+			if got := cradleStub.ReadObjectCount(); got != c.wantCradleCalls {
+				t.Fatalf("ReadObject calls: got %d, want %d", got, c.wantCradleCalls)
+			}
+			if c.wantCradleCalls > 0 {
+				call := cradleStub.ReadObjectCalls[0]
+				if call.Address != "localhost:9002" {
+					t.Fatalf("ReadObject address: got %q, want %q", call.Address, "localhost:9002")
+				}
+				if call.ObjectID != "stub-object-id" {
+					t.Fatalf("ReadObject objectID: got %q, want %q", call.ObjectID, "stub-object-id")
+				}
+				if call.Bucket != c.bucket {
+					t.Fatalf("ReadObject bucket: got %q, want %q", call.Bucket, c.bucket)
+				}
+			}
+
+			for name, want := range c.wantHeaders {
+				if got := rec.Header().Get(name); got != want {
+					t.Fatalf("%s: got %q, want %q", name, got, want)
+				}
+			}
+
+			body := rec.Body.String()
+			if c.wantBody != "" && body != c.wantBody {
+				t.Fatalf("body: got %q, want %q", body, c.wantBody)
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(body, c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, body)
+			}
+		})
+	}
+}
+
+func TestGetObject_Multipart(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name            string
+		failBlob        string
+		wantStatus      int
+		wantCradleCalls int
+		wantBody        string
+	}
+
+	cases := []tc{
+		{
+			name:            "parts stream in order",
+			wantStatus:      http.StatusOK,
+			wantCradleCalls: 2,
+			wantBody:        "first-second",
+		},
+		{
+			name:            "first part read failure -> 500",
+			failBlob:        "blob-1",
+			wantStatus:      http.StatusInternalServerError,
+			wantCradleCalls: 1,
+		},
+		{
+			name:            "later part read failure truncates body",
+			failBlob:        "blob-2",
+			wantStatus:      http.StatusOK,
+			wantCradleCalls: 2,
+			wantBody:        "first-",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.LookupObjectFn = func(context.Context, string, string, string) (gantry.Object, error) {
+				return gantry.Object{
+					ID:            "upload-1",
+					Key:           "videos/big.mp4",
+					Size:          12,
+					ContentType:   "video/mp4",
+					ETag:          "0123456789abcdef0123456789abcdef-2",
+					CradleAddress: "localhost:9002",
+					Parts: []gantry.ObjectPart{
+						{BlobID: "blob-1", Size: 6, CradleAddress: "localhost:9002"},
+						{BlobID: "blob-2", Size: 6, CradleAddress: "localhost:9003"},
+					},
+				}, nil
+			}
+
+			bodies := map[string]string{"blob-1": "first-", "blob-2": "second"}
+			cradleStub := testutil.NewCradleStub()
+			cradleStub.ReadObjectFn = func(_ context.Context, _ string, objectID string, _ string, _, _ int64) (io.ReadCloser, error) {
+				if objectID == c.failBlob {
+					return nil, errors.New("connection refused")
+				}
+				return io.NopCloser(strings.NewReader(bodies[objectID])), nil
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          cradleStub,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.SetPathValue("bucket", "photos")
+			req.SetPathValue("key", "videos/big.mp4")
+			rec := httptest.NewRecorder()
+
+			h.GetObject(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+
+			if got := cradleStub.ReadObjectCount(); got != c.wantCradleCalls {
+				t.Fatalf("ReadObject calls: got %d, want %d", got, c.wantCradleCalls)
+			}
+			if c.wantCradleCalls == 2 {
+				call := cradleStub.ReadObjectCalls[1]
+				if call.Address != "localhost:9003" || call.ObjectID != "blob-2" {
+					t.Fatalf("second ReadObject call: got %+v, want blob-2 at localhost:9003", call)
+				}
+			}
+
+			if c.wantStatus != http.StatusOK {
+				return
+			}
+
+			if got := rec.Header().Get("ETag"); got != `"0123456789abcdef0123456789abcdef-2"` {
+				t.Fatalf("ETag: got %q", got)
+			}
+			if got := rec.Body.String(); got != c.wantBody {
+				t.Fatalf("body: got %q, want %q", got, c.wantBody)
+			}
+		})
+	}
+}
+
+func TestGetObject_Range(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name             string
+		rangeHeader      string
+		wantStatus       int
+		wantBody         string
+		wantContentRange string
+		wantReads        []testutil.ReadObjectCall
+	}
+
+	// testutil.StubObjectBody is "stub object body", 16 bytes.
+	cases := []tc{
+		{
+			name:             "closed range -> 206",
+			rangeHeader:      "bytes=5-10",
+			wantStatus:       http.StatusPartialContent,
+			wantBody:         "object",
+			wantContentRange: "bytes 5-10/16",
+			wantReads:        []testutil.ReadObjectCall{{ObjectID: "stub-object-id", Offset: 5, Length: 6}},
+		},
+		{
+			name:             "open-ended range reads to the end",
+			rangeHeader:      "bytes=12-",
+			wantStatus:       http.StatusPartialContent,
+			wantBody:         "body",
+			wantContentRange: "bytes 12-15/16",
+			wantReads:        []testutil.ReadObjectCall{{ObjectID: "stub-object-id", Offset: 12, Length: 4}},
+		},
+		{
+			name:             "suffix range returns the last bytes",
+			rangeHeader:      "bytes=-4",
+			wantStatus:       http.StatusPartialContent,
+			wantBody:         "body",
+			wantContentRange: "bytes 12-15/16",
+			wantReads:        []testutil.ReadObjectCall{{ObjectID: "stub-object-id", Offset: 12, Length: 4}},
+		},
+		{
+			name:             "end past the object is clamped",
+			rangeHeader:      "bytes=12-100",
+			wantStatus:       http.StatusPartialContent,
+			wantBody:         "body",
+			wantContentRange: "bytes 12-15/16",
+			wantReads:        []testutil.ReadObjectCall{{ObjectID: "stub-object-id", Offset: 12, Length: 4}},
+		},
+		{
+			name:             "start past the object -> 416",
+			rangeHeader:      "bytes=16-20",
+			wantStatus:       http.StatusRequestedRangeNotSatisfiable,
+			wantContentRange: "bytes */16",
+		},
+		{
+			name:             "zero-length suffix -> 416",
+			rangeHeader:      "bytes=-0",
+			wantStatus:       http.StatusRequestedRangeNotSatisfiable,
+			wantContentRange: "bytes */16",
+		},
+		{
+			name:        "multiple ranges are ignored",
+			rangeHeader: "bytes=0-1,4-5",
+			wantStatus:  http.StatusOK,
+			wantBody:    testutil.StubObjectBody,
+			wantReads:   []testutil.ReadObjectCall{{ObjectID: "stub-object-id"}},
+		},
+		{
+			name:        "malformed range is ignored",
+			rangeHeader: "bytes=10-2",
+			wantStatus:  http.StatusOK,
+			wantBody:    testutil.StubObjectBody,
+			wantReads:   []testutil.ReadObjectCall{{ObjectID: "stub-object-id"}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			cradleStub := testutil.NewCradleStub()
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          cradleStub,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.SetPathValue("bucket", "photos")
+			req.SetPathValue("key", "vacation/sunset.jpg")
+			req.Header.Set("Range", c.rangeHeader)
+			rec := httptest.NewRecorder()
+
+			h.GetObject(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			if got := rec.Header().Get("Content-Range"); got != c.wantContentRange {
+				t.Fatalf("Content-Range: got %q, want %q", got, c.wantContentRange)
+			}
+
+			if c.wantStatus == http.StatusRequestedRangeNotSatisfiable {
+				if got := cradleStub.ReadObjectCount(); got != 0 {
+					t.Fatalf("ReadObject calls: got %d, want 0", got)
+				}
+				if !strings.Contains(rec.Body.String(), "InvalidRange") {
+					t.Fatalf("body: expected InvalidRange, got %q", rec.Body.String())
+				}
+				return
+			}
+
+			if got := rec.Body.String(); got != c.wantBody {
+				t.Fatalf("body: got %q, want %q", got, c.wantBody)
+			}
+			if got := rec.Header().Get("Content-Length"); got != strconv.Itoa(len(c.wantBody)) {
+				t.Fatalf("Content-Length: got %q, want %d", got, len(c.wantBody))
+			}
+
+			if len(cradleStub.ReadObjectCalls) != len(c.wantReads) {
+				t.Fatalf("ReadObject calls: got %d, want %d", len(cradleStub.ReadObjectCalls), len(c.wantReads))
+			}
+			for i, want := range c.wantReads {
+				got := cradleStub.ReadObjectCalls[i]
+				if got.ObjectID != want.ObjectID || got.Offset != want.Offset || got.Length != want.Length {
+					t.Fatalf("ReadObject call %d: got %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetObject_MultipartRange(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		rangeHeader string
+		wantBody    string
+		wantReads   []testutil.ReadObjectCall
+	}
+
+	cases := []tc{
+		{
+			name:        "range inside one part reads only that part",
+			rangeHeader: "bytes=7-9",
+			wantBody:    "eco",
+			wantReads:   []testutil.ReadObjectCall{{ObjectID: "blob-2", Offset: 1, Length: 3}},
+		},
+		{
+			name:        "range spanning parts reads the tail and head",
+			rangeHeader: "bytes=3-8",
+			wantBody:    "st-sec",
+			wantReads: []testutil.ReadObjectCall{
+				{ObjectID: "blob-1", Offset: 3, Length: 3},
+				{ObjectID: "blob-2", Offset: 0, Length: 3},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.LookupObjectFn = func(context.Context, string, string, string) (gantry.Object, error) {
+				return gantry.Object{
+					ID:   "upload-1",
+					Key:  "videos/big.mp4",
+					Size: 12,
+					Parts: []gantry.ObjectPart{
+						{BlobID: "blob-1", Size: 6, CradleAddress: "localhost:9002"},
+						{BlobID: "blob-2", Size: 6, CradleAddress: "localhost:9003"},
+					},
+				}, nil
+			}
+
+			bodies := map[string]string{"blob-1": "first-", "blob-2": "second"}
+			cradleStub := testutil.NewCradleStub()
+			cradleStub.ReadObjectFn = func(_ context.Context, _ string, objectID string, _ string, offset, length int64) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader(bodies[objectID][offset : offset+length])), nil
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          cradleStub,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.SetPathValue("bucket", "photos")
+			req.SetPathValue("key", "videos/big.mp4")
+			req.Header.Set("Range", c.rangeHeader)
+			rec := httptest.NewRecorder()
+
+			h.GetObject(rec, req)
+
+			if rec.Code != http.StatusPartialContent {
+				t.Fatalf("status: got %d, want %d", rec.Code, http.StatusPartialContent)
+			}
+			if got := rec.Body.String(); got != c.wantBody {
+				t.Fatalf("body: got %q, want %q", got, c.wantBody)
+			}
+
+			if len(cradleStub.ReadObjectCalls) != len(c.wantReads) {
+				t.Fatalf("ReadObject calls: got %d, want %d", len(cradleStub.ReadObjectCalls), len(c.wantReads))
+			}
+			for i, want := range c.wantReads {
+				got := cradleStub.ReadObjectCalls[i]
+				if got.ObjectID != want.ObjectID || got.Offset != want.Offset || got.Length != want.Length {
+					t.Fatalf("ReadObject call %d: got %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetObject_ChecksumMode(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name         string
+		method       string
+		checksumMode string
+		rangeHeader  string
+		wantChecksum string
+	}
+
+	const crc32 = "dX1yaA=="
+
+	cases := []tc{
+		{
+			name:         "GET with checksum mode returns the stored checksum",
+			method:       http.MethodGet,
+			checksumMode: "ENABLED",
+			wantChecksum: crc32,
+		},
+		{
+			name:         "HEAD with checksum mode returns the stored checksum",
+			method:       http.MethodHead,
+			checksumMode: "enabled",
+			wantChecksum: crc32,
+		},
+		{
+			name:   "checksum is omitted without checksum mode",
+			method: http.MethodGet,
+		},
+		{
+			name:         "ranged GET omits the whole-object checksum",
+			method:       http.MethodGet,
+			checksumMode: "ENABLED",
+			rangeHeader:  "bytes=0-3",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.LookupObjectFn = func(_ context.Context, _, key, _ string) (gantry.Object, error) {
+				return gantry.Object{
+					ID:                "stub-object-id",
+					Key:               key,
+					Size:              int64(len(testutil.StubObjectBody)),
+					LastModified:      time.UnixMilli(1234567890).UTC(),
+					CradleAddress:     "localhost:9002",
+					ChecksumAlgorithm: "CRC32",
+					Checksum:          crc32,
+				}, nil
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			req := httptest.NewRequest(c.method, "/", nil)
+			req.SetPathValue("bucket", "photos")
+			req.SetPathValue("key", "vacation/sunset.jpg")
+			if c.checksumMode != "" {
+				req.Header.Set("x-amz-checksum-mode", c.checksumMode)
+			}
+			if c.rangeHeader != "" {
+				req.Header.Set("Range", c.rangeHeader)
+			}
+			rec := httptest.NewRecorder()
+
+			if c.method == http.MethodHead {
+				h.HeadObject(rec, req)
+			} else {
+				h.GetObject(rec, req)
+			}
+
+			if got := rec.Header().Get("x-amz-checksum-crc32"); got != c.wantChecksum {
+				t.Fatalf("x-amz-checksum-crc32: got %q, want %q", got, c.wantChecksum)
+			}
+		})
+	}
+}
+
+func TestGetObject_Version(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		method         string
+		target         string
+		objectVersion  string
+		lookupErr      error
+		wantStatus     int
+		wantVersionID  string
+		wantHeaders    map[string]string
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:          "GET ?versionId reads that version",
+			method:        http.MethodGet,
+			target:        "/?versionId=01JOLD",
+			objectVersion: "01JOLD",
+			wantStatus:    http.StatusOK,
+			wantVersionID: "01JOLD",
+			wantHeaders:   map[string]string{"x-amz-version-id": "01JOLD"},
+		},
+		{
+			name:          "HEAD ?versionId reads that version",
+			method:        http.MethodHead,
+			target:        "/?versionId=01JOLD",
+			objectVersion: "01JOLD",
+			wantStatus:    http.StatusOK,
+			wantVersionID: "01JOLD",
+			wantHeaders:   map[string]string{"x-amz-version-id": "01JOLD"},
+		},
+		{
+			name:          "GET in a versioned bucket reports the current version",
+			method:        http.MethodGet,
+			target:        "/",
+			objectVersion: "01JCURRENT",
+			wantStatus:    http.StatusOK,
+			wantHeaders:   map[string]string{"x-amz-version-id": "01JCURRENT"},
+		},
+		{
+			name:        "GET in an unversioned bucket has no version header",
+			method:      http.MethodGet,
+			target:      "/",
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{"x-amz-version-id": ""},
+		},
+		{
+			name:   "missing version -> 404 NoSuchVersion",
+			method: http.MethodGet,
+			target: "/?versionId=01JGONE",
+			lookupErr: versionLookupErr(servicev1.ObjectLookupError_REASON_VERSION_NOT_FOUND,
+				"photos", "vacation/sunset.jpg", "01JGONE"),
+			wantStatus:     http.StatusNotFound,
+			wantVersionID:  "01JGONE",
+			wantBodySubstr: "NoSuchVersion",
+		},
+		{
+			name:   "GET delete marker -> 405 MethodNotAllowed",
+			method: http.MethodGet,
+			target: "/?versionId=01JMARKER",
+			lookupErr: versionLookupErr(servicev1.ObjectLookupError_REASON_DELETE_MARKER,
+				"photos", "vacation/sunset.jpg", "01JMARKER"),
+			wantStatus:     http.StatusMethodNotAllowed,
+			wantVersionID:  "01JMARKER",
+			wantHeaders:    map[string]string{"x-amz-delete-marker": "true", "x-amz-version-id": "01JMARKER"},
+			wantBodySubstr: "MethodNotAllowed",
+		},
+		{
+			name:   "HEAD delete marker -> 405",
+			method: http.MethodHead,
+			target: "/?versionId=01JMARKER",
+			lookupErr: versionLookupErr(servicev1.ObjectLookupError_REASON_DELETE_MARKER,
+				"photos", "vacation/sunset.jpg", "01JMARKER"),
+			wantStatus:    http.StatusMethodNotAllowed,
+			wantVersionID: "01JMARKER",
+			wantHeaders:   map[string]string{"x-amz-delete-marker": "true", "x-amz-version-id": "01JMARKER"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.LookupObjectFn = func(_ context.Context, _, key, _ string) (gantry.Object, error) {
+				if c.lookupErr != nil {
+					return gantry.Object{}, c.lookupErr
+				}
+				return gantry.Object{
+					ID:            "stub-object-id",
+					Key:           key,
+					Size:          int64(len(testutil.StubObjectBody)),
+					LastModified:  time.UnixMilli(1234567890).UTC(),
+					CradleAddress: "localhost:9002",
+					VersionID:     c.objectVersion,
+				}, nil
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			req := httptest.NewRequest(c.method, c.target, nil)
+			req.SetPathValue("bucket", "photos")
+			req.SetPathValue("key", "vacation/sunset.jpg")
+			rec := httptest.NewRecorder()
+
+			if c.method == http.MethodHead {
+				h.HeadObject(rec, req)
+			} else {
+				h.GetObject(rec, req)
+			}
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d; body: %s", rec.Code, c.wantStatus, rec.Body.String())
+			}
+			if got := gantryStub.LookupObjectCalls[0].VersionID; got != c.wantVersionID {
+				t.Fatalf("lookup VersionID: got %q, want %q", got, c.wantVersionID)
+			}
+			for name, want := range c.wantHeaders {
+				if got := rec.Header().Get(name); got != want {
+					t.Fatalf("%s: got %q, want %q", name, got, want)
+				}
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
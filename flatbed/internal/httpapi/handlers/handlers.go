@@ -3,7 +3,9 @@ package handlers
 import (
 	"context"
 	"io"
+	"time"
 
+	"github.com/ratdaddy/blockcloset/flatbed/internal/cradle"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
 	"github.com/ratdaddy/blockcloset/pkg/validation"
 	writeplanv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/write_plan/v1"
@@ -11,15 +13,55 @@ import (
 
 // GantryClient defines the operations needed from the Gantry service.
 type GantryClient interface {
-	CreateBucket(ctx context.Context, name string) (string, error)
+	CreateBucket(ctx context.Context, name, acl string, objectLock bool) (string, error)
 	ListBuckets(ctx context.Context) ([]gantry.Bucket, error)
-	PlanWrite(ctx context.Context, bucket, key string, size int64) (*writeplanv1.WritePlan, error)
-	CommitObject(ctx context.Context, objectID string, size int64, lastModifiedMs int64) error
+	GetBucket(ctx context.Context, name string) (gantry.Bucket, error)
+	DeleteBucket(ctx context.Context, name string) error
+	PutBucketPolicy(ctx context.Context, bucket, policy string) error
+	GetBucketPolicy(ctx context.Context, bucket string) (string, error)
+	DeleteBucketPolicy(ctx context.Context, bucket string) error
+	PutBucketACL(ctx context.Context, bucket, acl string) error
+	GetBucketACL(ctx context.Context, bucket string) (gantry.BucketACL, error)
+	PutBucketVersioning(ctx context.Context, bucket, status string) error
+	GetBucketVersioning(ctx context.Context, bucket string) (string, error)
+	PutBucketLifecycleConfiguration(ctx context.Context, bucket string, rules []gantry.LifecycleRule) error
+	GetBucketLifecycleConfiguration(ctx context.Context, bucket string) ([]gantry.LifecycleRule, error)
+	DeleteBucketLifecycle(ctx context.Context, bucket string) error
+	PutBucketCors(ctx context.Context, bucket string, rules []gantry.CORSRule) error
+	GetBucketCors(ctx context.Context, bucket string) ([]gantry.CORSRule, error)
+	DeleteBucketCors(ctx context.Context, bucket string) error
+	LookupBucketCors(ctx context.Context, bucket string) ([]gantry.CORSRule, error)
+	PlanWrite(ctx context.Context, bucket, key string, size int64, contentType string, metadata gantry.ObjectMetadata, tags map[string]string, lock gantry.ObjectLock) (*writeplanv1.WritePlan, error)
+	CommitObject(ctx context.Context, objectID string, commit gantry.ObjectCommit) (string, error)
+	LookupObject(ctx context.Context, bucket, key, versionID string) (gantry.Object, error)
+	DeleteObject(ctx context.Context, bucket, key, versionID string, bypassGovernance bool) (gantry.ObjectDeletion, error)
+	DeleteObjects(ctx context.Context, bucket string, objects []gantry.ObjectIdentifier, bypassGovernance bool) (gantry.ObjectDeletions, error)
+	PutObjectTagging(ctx context.Context, bucket, key, versionID string, tags map[string]string) (string, error)
+	GetObjectTagging(ctx context.Context, bucket, key, versionID string) (gantry.ObjectTagging, error)
+	DeleteObjectTagging(ctx context.Context, bucket, key, versionID string) (string, error)
+	PutObjectLockConfiguration(ctx context.Context, bucket string, cfg gantry.ObjectLockConfiguration) error
+	GetObjectLockConfiguration(ctx context.Context, bucket string) (gantry.ObjectLockConfiguration, error)
+	PutObjectRetention(ctx context.Context, bucket, key, versionID, mode string, retainUntil time.Time, bypassGovernance bool) (string, error)
+	GetObjectRetention(ctx context.Context, bucket, key, versionID string) (gantry.ObjectRetention, error)
+	PutObjectLegalHold(ctx context.Context, bucket, key, versionID string, on bool) (string, error)
+	GetObjectLegalHold(ctx context.Context, bucket, key, versionID string) (gantry.ObjectLegalHold, error)
+	ListObjects(ctx context.Context, bucket string, params gantry.ListObjectsParams) (gantry.ObjectListing, error)
+	ListObjectVersions(ctx context.Context, bucket string, params gantry.ListObjectVersionsParams) (gantry.ObjectVersionListing, error)
+	CreateMultipartUpload(ctx context.Context, bucket, key, contentType string, metadata gantry.ObjectMetadata) (string, error)
+	PlanPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, size int64) (*writeplanv1.WritePlan, error)
+	CommitPart(ctx context.Context, blobID string, size int64, lastModifiedMs int64, etag string) (string, error)
+	CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string, parts []gantry.CompletedPart) (etag, versionID string, err error)
+	AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error
+	ListMultipartUploads(ctx context.Context, bucket string, params gantry.ListMultipartUploadsParams) (gantry.MultipartUploadListing, error)
+	ListParts(ctx context.Context, bucket, key, uploadID string, params gantry.ListPartsParams) (gantry.PartListing, error)
+	GetAccessKey(ctx context.Context, accessKeyID string) (gantry.AccessKey, error)
 }
 
 // CradleClient defines the operations needed from the Cradle service.
 type CradleClient interface {
-	WriteObject(ctx context.Context, address, objectID, bucket string, size int64, body io.Reader) (int64, int64, error)
+	WriteObject(ctx context.Context, address, objectID, bucket string, size int64, body io.Reader, digests cradle.Digests) (cradle.WriteResult, error)
+	ReadObject(ctx context.Context, address, objectID, bucket string, offset, length int64) (io.ReadCloser, error)
+	CopyObject(ctx context.Context, address, objectID, bucket string, size int64, sources []cradle.CopySource) (cradle.WriteResult, error)
 }
 
 // Handlers provides HTTP handler implementations for S3-compatible operations.
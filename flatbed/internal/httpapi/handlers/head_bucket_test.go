@@ -0,0 +1,113 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+)
+
+func TestHeadBucket(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		bucket         string
+		gantryErr      error
+		wantStatus     int
+		wantGets       int
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:       "existing bucket -> 200",
+			bucket:     "photos",
+			wantStatus: http.StatusOK,
+			wantGets:   1,
+		},
+		{
+			name:           "invalid bucket name -> 400",
+			bucket:         "INVALID-BUCKET",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidBucketName",
+		},
+		{
+			name:           "gantry not found -> 404 NoSuchBucket",
+			bucket:         "nonexistent-bucket",
+			gantryErr:      status.Error(codes.NotFound, "bucket not found"),
+			wantStatus:     http.StatusNotFound,
+			wantGets:       1,
+			wantBodySubstr: "NoSuchBucket",
+		},
+		{
+			name:           "gantry invalid argument -> 400",
+			bucket:         "bad",
+			gantryErr:      status.Error(codes.InvalidArgument, "InvalidBucketName"),
+			wantStatus:     http.StatusBadRequest,
+			wantGets:       1,
+			wantBodySubstr: "InvalidBucketName",
+		},
+		{
+			name:           "gantry permission denied -> 403",
+			bucket:         "forbidden",
+			gantryErr:      status.Error(codes.PermissionDenied, "access denied"),
+			wantStatus:     http.StatusForbidden,
+			wantGets:       1,
+			wantBodySubstr: "AccessDenied",
+		},
+		{
+			name:           "gantry unexpected error -> 500",
+			bucket:         "photos",
+			gantryErr:      status.Error(codes.Internal, "unexpected database error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantGets:       1,
+			wantBodySubstr: "InternalError",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			if c.gantryErr != nil {
+				gantryStub.GetBucketFn = func(context.Context, string) (gantry.Bucket, error) {
+					return gantry.Bucket{}, c.gantryErr
+				}
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				Gantry:          gantryStub,
+			}
+
+			rec := httptest.NewRecorder()
+			h.HeadBucket(rec, reqWithBucket(t, http.MethodHead, c.bucket))
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+
+			if got := gantryStub.GetBucketCount(); got != c.wantGets {
+				t.Fatalf("GetBucket calls: got %d, want %d", got, c.wantGets)
+			}
+			if c.wantGets > 0 && gantryStub.GetBucketCalls[0] != c.bucket {
+				t.Fatalf("GetBucket name: got %q, want %q", gantryStub.GetBucketCalls[0], c.bucket)
+			}
+
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+)
+
+// HeadObject answers from Gantry's object metadata alone; no cradle is
+// contacted since no bytes are returned.
+func (h *Handlers) HeadObject(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+	key := r.PathValue("key")
+
+	// Validate bucket name
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	// Validate key
+	if err := h.KeyValidator.ValidateKey(key); err != nil {
+		respond.Error(w, r, "InvalidKeyName", http.StatusBadRequest)
+		return
+	}
+
+	obj, err := h.Gantry.LookupObject(r.Context(), bucket, key, r.URL.Query().Get("versionId"))
+	if err != nil {
+		respondLookupError(w, r, err)
+		return
+	}
+
+	if !checkPreconditions(w, r, obj) {
+		return
+	}
+
+	setObjectHeaders(w, obj)
+	if checksumModeEnabled(r) {
+		setChecksumHeader(w, obj.ChecksumAlgorithm, obj.Checksum)
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,236 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestHeadObject(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		bucket         string
+		key            string
+		lookupObj      *gantry.Object
+		lookupErr      error
+		wantStatus     int
+		wantLookups    int
+		wantBodySubstr string
+		wantHeaders    map[string]string
+	}
+
+	cases := []tc{
+		{
+			name:        "committed object returns metadata headers",
+			bucket:      "photos",
+			key:         "vacation/sunset.jpg",
+			wantStatus:  http.StatusOK,
+			wantLookups: 1,
+			wantHeaders: map[string]string{
+				"Content-Length": "16",
+				"Content-Type":   "text/plain",
+				"ETag":           `"stub-object-id"`,
+				"Last-Modified":  time.UnixMilli(1234567890).UTC().Format(time.RFC1123),
+			},
+		},
+		{
+			name:   "object without content type falls back to octet-stream",
+			bucket: "photos",
+			key:    "vacation/sunset.jpg",
+			lookupObj: &gantry.Object{
+				ID:           "obj-1",
+				Size:         42,
+				LastModified: time.UnixMilli(1234567890).UTC(),
+			},
+			wantStatus:  http.StatusOK,
+			wantLookups: 1,
+			wantHeaders: map[string]string{
+				"Content-Length": "42",
+				"Content-Type":   "application/octet-stream",
+				"ETag":           `"obj-1"`,
+			},
+		},
+		{
+			name:   "stored metadata is replayed",
+			bucket: "photos",
+			key:    "notes.txt",
+			lookupObj: &gantry.Object{
+				ID:           "obj-2",
+				Size:         42,
+				LastModified: time.UnixMilli(1234567890).UTC(),
+				ContentType:  "text/plain",
+				Metadata: gantry.ObjectMetadata{
+					ContentEncoding:    "gzip",
+					ContentDisposition: "attachment",
+					CacheControl:       "no-cache",
+					Expires:            "Thu, 01 Dec 2094 16:00:00 GMT",
+					User:               map[string]string{"color": "blue"},
+				},
+				Tags: map[string]string{"host": "web-1", "tier": "cold"},
+			},
+			wantStatus:  http.StatusOK,
+			wantLookups: 1,
+			wantHeaders: map[string]string{
+				"Content-Type":        "text/plain",
+				"Content-Encoding":    "gzip",
+				"Content-Disposition": "attachment",
+				"Cache-Control":       "no-cache",
+				"Expires":             "Thu, 01 Dec 2094 16:00:00 GMT",
+				"X-Amz-Meta-Color":    "blue",
+				"X-Amz-Tagging-Count": "2",
+			},
+		},
+		{
+			name:   "object lock is reported",
+			bucket: "backups",
+			key:    "db.tar",
+			lookupObj: &gantry.Object{
+				ID:           "obj-3",
+				Size:         42,
+				LastModified: time.UnixMilli(1234567890).UTC(),
+				Lock: gantry.ObjectLock{
+					Mode:        "COMPLIANCE",
+					RetainUntil: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC),
+					LegalHold:   true,
+				},
+			},
+			wantStatus:  http.StatusOK,
+			wantLookups: 1,
+			wantHeaders: map[string]string{
+				"X-Amz-Object-Lock-Mode":              "COMPLIANCE",
+				"X-Amz-Object-Lock-Retain-Until-Date": "2025-04-01T00:00:00.000Z",
+				"X-Amz-Object-Lock-Legal-Hold":        "ON",
+			},
+		},
+		{
+			name:   "object without a lock has no lock headers",
+			bucket: "backups",
+			key:    "db.tar",
+			lookupObj: &gantry.Object{
+				ID:           "obj-4",
+				Size:         42,
+				LastModified: time.UnixMilli(1234567890).UTC(),
+			},
+			wantStatus:  http.StatusOK,
+			wantLookups: 1,
+			wantHeaders: map[string]string{
+				"X-Amz-Object-Lock-Mode":              "",
+				"X-Amz-Object-Lock-Retain-Until-Date": "",
+				"X-Amz-Object-Lock-Legal-Hold":        "",
+			},
+		},
+		{
+			name:           "invalid bucket name -> 400",
+			bucket:         "INVALID-BUCKET",
+			key:            "vacation/sunset.jpg",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidBucketName",
+		},
+		{
+			name:           "invalid key -> 400",
+			bucket:         "photos",
+			key:            "file\x00name",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidKeyName",
+		},
+		{
+			name:   "gantry bucket not found -> 404 NoSuchBucket",
+			bucket: "nonexistent-bucket",
+			key:    "vacation/sunset.jpg",
+			lookupErr: objectLookupErr(codes.NotFound, "bucket not found",
+				servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, "nonexistent-bucket", "vacation/sunset.jpg"),
+			wantStatus:     http.StatusNotFound,
+			wantLookups:    1,
+			wantBodySubstr: "NoSuchBucket",
+		},
+		{
+			name:   "gantry object not found -> 404 NoSuchKey",
+			bucket: "photos",
+			key:    "vacation/missing.jpg",
+			lookupErr: objectLookupErr(codes.NotFound, "object not found",
+				servicev1.ObjectLookupError_REASON_OBJECT_NOT_FOUND, "photos", "vacation/missing.jpg"),
+			wantStatus:     http.StatusNotFound,
+			wantLookups:    1,
+			wantBodySubstr: "NoSuchKey",
+		},
+		{
+			name:           "gantry unexpected error -> 500",
+			bucket:         "photos",
+			key:            "vacation/sunset.jpg",
+			lookupErr:      status.Error(codes.Internal, "unexpected database error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantLookups:    1,
+			wantBodySubstr: "InternalError",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			if c.lookupErr != nil || c.lookupObj != nil {
+				gantryStub.LookupObjectFn = func(context.Context, string, string, string) (gantry.Object, error) {
+					if c.lookupErr != nil {
+						return gantry.Object{}, c.lookupErr
+					}
+					return *c.lookupObj, nil
+				}
+			}
+
+			cradleStub := testutil.NewCradleStub()
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          cradleStub,
+			}
+
+			req := httptest.NewRequest(http.MethodHead, "/", nil)
+			req.SetPathValue("bucket", c.bucket)
+			req.SetPathValue("key", c.key)
+			rec := httptest.NewRecorder()
+
+			h.HeadObject(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+
+			if got := gantryStub.LookupObjectCount(); got != c.wantLookups {
+				t.Fatalf("LookupObject calls: got %d, want %d", got, c.wantLookups)
+			}
+			if got := cradleStub.ReadObjectCount(); got != 0 {
+				t.Fatalf("ReadObject calls: got %d, want 0", got)
+			}
+
+			for name, want := range c.wantHeaders {
+				if got := rec.Header().Get(name); got != want {
+					t.Fatalf("%s: got %q, want %q", name, got, want)
+				}
+			}
+
+			if c.wantStatus == http.StatusOK && rec.Body.Len() != 0 {
+				t.Fatalf("body: got %q, want empty", rec.Body.String())
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
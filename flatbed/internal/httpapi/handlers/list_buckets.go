@@ -1,7 +1,7 @@
 package handlers
 
 import (
-	"encoding/json"
+	"encoding/xml"
 	"net/http"
 	"time"
 
@@ -9,6 +9,16 @@ import (
 	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
 )
 
+type listAllMyBucketsResult struct {
+	XMLName xml.Name     `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListAllMyBucketsResult" json:"-"`
+	Buckets []listBucket `xml:"Buckets>Bucket" json:"Buckets"`
+}
+
+type listBucket struct {
+	Name         string `xml:"Name" json:"Name"`
+	CreationDate string `xml:"CreationDate" json:"CreationDate"`
+}
+
 func (h *Handlers) ListBuckets(w http.ResponseWriter, r *http.Request) {
 	buckets, err := h.Gantry.ListBuckets(r.Context())
 	if err != nil {
@@ -17,25 +27,15 @@ func (h *Handlers) ListBuckets(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	type respBucket struct {
-		Name         string `json:"Name"`
-		CreationDate string `json:"CreationDate"`
-	}
-
-	payload := struct {
-		Buckets []respBucket `json:"Buckets"`
-	}{}
-
+	result := listAllMyBucketsResult{Buckets: make([]listBucket, 0, len(buckets))}
 	for _, b := range buckets {
-		payload.Buckets = append(payload.Buckets, respBucket{
+		result.Buckets = append(result.Buckets, listBucket{
 			Name:         b.Name,
 			CreationDate: b.CreatedAt.UTC().Format(time.RFC3339),
 		})
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(payload); err != nil {
-		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
-		return
+	if err := respond.Encode(w, r, http.StatusOK, result); err != nil {
+		logger.LogError(w, r, err.Error())
 	}
 }
@@ -3,6 +3,7 @@ package handlers_test
 import (
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -27,22 +28,34 @@ func TestHandlers_ListBuckets(t *testing.T) {
 		name             string
 		listResp         []gantry.Bucket
 		listErr          error
+		accept           string
 		wantStatus       int
 		wantBodySubstr   string
-		wantContentType  bool
+		wantContentType  string
 		expectBucketData bool
 	}
 
 	cases := []tc{
 		{
-			name: "success returns JSON payload",
+			name: "success returns XML payload",
 			listResp: []gantry.Bucket{
 				{Name: "first-bucket", CreatedAt: now.Add(-2 * time.Hour)},
 				{Name: "middle-bucket", CreatedAt: now},
 				{Name: "third-bucket", CreatedAt: now.Add(5 * time.Hour)},
 			},
 			wantStatus:       http.StatusOK,
-			wantContentType:  true,
+			wantContentType:  "application/xml",
+			expectBucketData: true,
+		},
+		{
+			name: "Accept application/json returns JSON payload",
+			listResp: []gantry.Bucket{
+				{Name: "first-bucket", CreatedAt: now.Add(-2 * time.Hour)},
+				{Name: "middle-bucket", CreatedAt: now},
+			},
+			accept:           "application/json",
+			wantStatus:       http.StatusOK,
+			wantContentType:  "application/json",
 			expectBucketData: true,
 		},
 		{
@@ -65,6 +78,9 @@ func TestHandlers_ListBuckets(t *testing.T) {
 			h := &handlers.Handlers{Gantry: stub, Cradle: testutil.NewCradleStub()}
 
 			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if c.accept != "" {
+				req.Header.Set("Accept", c.accept)
+			}
 			rec := httptest.NewRecorder()
 
 			h.ListBuckets(rec, req)
@@ -77,27 +93,34 @@ func TestHandlers_ListBuckets(t *testing.T) {
 				t.Fatalf("status code: got %d want %d", rec.Code, c.wantStatus)
 			}
 
-			if c.wantContentType {
-				if got := rec.Header().Get("Content-Type"); got == "" || !strings.Contains(got, "application/json") {
-					t.Fatalf("content type: got %q want application/json", got)
+			if c.wantContentType != "" {
+				if got := rec.Header().Get("Content-Type"); got != c.wantContentType {
+					t.Fatalf("content type: got %q want %q", got, c.wantContentType)
 				}
 			}
 
 			if c.expectBucketData {
 				type responseBucket struct {
-					Name         string `json:"Name"`
-					CreationDate string `json:"CreationDate"`
+					Name         string `xml:"Name" json:"Name"`
+					CreationDate string `xml:"CreationDate" json:"CreationDate"`
 				}
 				type responsePayload struct {
-					Buckets []responseBucket `json:"Buckets"`
+					XMLName xml.Name         `xml:"ListAllMyBucketsResult" json:"-"`
+					Buckets []responseBucket `xml:"Buckets>Bucket" json:"Buckets"`
 				}
 
 				var payload responsePayload
-				if err := json.NewDecoder(rec.Body).Decode(&payload); err != nil {
+				var err error
+				if c.wantContentType == "application/json" {
+					err = json.NewDecoder(rec.Body).Decode(&payload)
+				} else {
+					err = xml.NewDecoder(rec.Body).Decode(&payload)
+				}
+				if err != nil {
 					t.Fatalf("decode response: %v", err)
 				}
 
-				want := responsePayload{Buckets: make([]responseBucket, len(c.listResp))}
+				want := responsePayload{XMLName: payload.XMLName, Buckets: make([]responseBucket, len(c.listResp))}
 				for i, b := range c.listResp {
 					want.Buckets[i] = responseBucket{
 						Name:         b.Name,
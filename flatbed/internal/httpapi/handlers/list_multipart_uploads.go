@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+const defaultMaxUploads = 1000
+
+type listMultipartUploadsResult struct {
+	XMLName            xml.Name           `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListMultipartUploadsResult" json:"-"`
+	Bucket             string             `xml:"Bucket" json:"Bucket"`
+	KeyMarker          string             `xml:"KeyMarker" json:"KeyMarker"`
+	UploadIDMarker     string             `xml:"UploadIdMarker" json:"UploadIdMarker"`
+	NextKeyMarker      string             `xml:"NextKeyMarker,omitempty" json:"NextKeyMarker,omitempty"`
+	NextUploadIDMarker string             `xml:"NextUploadIdMarker,omitempty" json:"NextUploadIdMarker,omitempty"`
+	Prefix             string             `xml:"Prefix" json:"Prefix"`
+	Delimiter          string             `xml:"Delimiter,omitempty" json:"Delimiter,omitempty"`
+	MaxUploads         int32              `xml:"MaxUploads" json:"MaxUploads"`
+	IsTruncated        bool               `xml:"IsTruncated" json:"IsTruncated"`
+	EncodingType       string             `xml:"EncodingType,omitempty" json:"EncodingType,omitempty"`
+	Uploads            []listUpload       `xml:"Upload" json:"Upload"`
+	CommonPrefixes     []listCommonPrefix `xml:"CommonPrefixes" json:"CommonPrefixes"`
+}
+
+type listUpload struct {
+	Key          string `xml:"Key" json:"Key"`
+	UploadID     string `xml:"UploadId" json:"UploadId"`
+	Initiated    string `xml:"Initiated" json:"Initiated"`
+	StorageClass string `xml:"StorageClass" json:"StorageClass"`
+}
+
+// ListMultipartUploads serves GET /{bucket}?uploads. Like ListObjectsV2, the
+// paging and delimiter roll-up happen in Gantry.
+func (h *Handlers) ListMultipartUploads(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+	query := r.URL.Query()
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	maxUploads := int32(defaultMaxUploads)
+	if v := query.Get("max-uploads"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || n < 0 {
+			respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+			return
+		}
+		maxUploads = int32(min(n, defaultMaxUploads))
+	}
+
+	encode := func(s string) string { return s }
+	switch encodingType := query.Get("encoding-type"); encodingType {
+	case "":
+	case "url":
+		encode = url.QueryEscape
+	default:
+		respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+		return
+	}
+
+	params := gantry.ListMultipartUploadsParams{
+		Prefix:         query.Get("prefix"),
+		Delimiter:      query.Get("delimiter"),
+		MaxUploads:     maxUploads,
+		KeyMarker:      query.Get("key-marker"),
+		UploadIDMarker: query.Get("upload-id-marker"),
+	}
+
+	listing, err := h.Gantry.ListMultipartUploads(r.Context(), bucket, params)
+	if err != nil {
+		respondUploadError(w, r, err)
+		return
+	}
+
+	result := listMultipartUploadsResult{
+		Bucket:             bucket,
+		KeyMarker:          encode(params.KeyMarker),
+		UploadIDMarker:     params.UploadIDMarker,
+		NextKeyMarker:      encode(listing.NextKeyMarker),
+		NextUploadIDMarker: listing.NextUploadIDMarker,
+		Prefix:             encode(params.Prefix),
+		Delimiter:          encode(params.Delimiter),
+		MaxUploads:         maxUploads,
+		IsTruncated:        listing.IsTruncated,
+		EncodingType:       query.Get("encoding-type"),
+	}
+
+	for _, upload := range listing.Uploads {
+		result.Uploads = append(result.Uploads, listUpload{
+			Key:          encode(upload.Key),
+			UploadID:     upload.UploadID,
+			Initiated:    upload.Initiated.UTC().Format("2006-01-02T15:04:05.000Z"),
+			StorageClass: "STANDARD",
+		})
+	}
+
+	for _, prefix := range listing.CommonPrefixes {
+		result.CommonPrefixes = append(result.CommonPrefixes, listCommonPrefix{Prefix: encode(prefix)})
+	}
+
+	logger.LogResult(r, fmt.Sprintf("listed %d uploads in bucket <%s>", len(result.Uploads), bucket))
+
+	if err := respond.Encode(w, r, http.StatusOK, result); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
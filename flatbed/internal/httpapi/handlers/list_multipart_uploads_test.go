@@ -0,0 +1,236 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+type listMultipartUploadsResultXML struct {
+	XMLName            xml.Name `xml:"ListMultipartUploadsResult"`
+	Bucket             string   `xml:"Bucket"`
+	KeyMarker          string   `xml:"KeyMarker"`
+	UploadIDMarker     string   `xml:"UploadIdMarker"`
+	NextKeyMarker      string   `xml:"NextKeyMarker"`
+	NextUploadIDMarker string   `xml:"NextUploadIdMarker"`
+	Prefix             string   `xml:"Prefix"`
+	Delimiter          string   `xml:"Delimiter"`
+	MaxUploads         int32    `xml:"MaxUploads"`
+	IsTruncated        bool     `xml:"IsTruncated"`
+	Uploads            []struct {
+		Key          string `xml:"Key"`
+		UploadID     string `xml:"UploadId"`
+		Initiated    string `xml:"Initiated"`
+		StorageClass string `xml:"StorageClass"`
+	} `xml:"Upload"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+}
+
+func TestListMultipartUploads(t *testing.T) {
+	t.Parallel()
+
+	initiated := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	listing := gantry.MultipartUploadListing{
+		Uploads: []gantry.MultipartUpload{
+			{Key: "videos/big.mp4", UploadID: "upload-1", Initiated: initiated},
+		},
+		CommonPrefixes:     []string{"videos/2024/"},
+		IsTruncated:        true,
+		NextKeyMarker:      "videos/big.mp4",
+		NextUploadIDMarker: "upload-1",
+	}
+
+	type tc struct {
+		name           string
+		bucket         string
+		query          string
+		gantryErr      error
+		wantStatus     int
+		wantParams     *gantry.ListMultipartUploadsParams
+		wantKeys       []string
+		wantPrefixes   []string
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:         "defaults -> 200 with 1000 max uploads",
+			bucket:       "photos",
+			query:        "uploads",
+			wantStatus:   http.StatusOK,
+			wantParams:   &gantry.ListMultipartUploadsParams{MaxUploads: 1000},
+			wantKeys:     []string{"videos/big.mp4"},
+			wantPrefixes: []string{"videos/2024/"},
+		},
+		{
+			name:       "parameters pass through to gantry",
+			bucket:     "photos",
+			query:      "uploads&prefix=videos%2F&delimiter=%2F&max-uploads=5&key-marker=videos%2Fa.mp4&upload-id-marker=upload-0",
+			wantStatus: http.StatusOK,
+			wantParams: &gantry.ListMultipartUploadsParams{
+				Prefix:         "videos/",
+				Delimiter:      "/",
+				MaxUploads:     5,
+				KeyMarker:      "videos/a.mp4",
+				UploadIDMarker: "upload-0",
+			},
+			wantKeys:     []string{"videos/big.mp4"},
+			wantPrefixes: []string{"videos/2024/"},
+		},
+		{
+			name:         "max uploads above limit is capped",
+			bucket:       "photos",
+			query:        "uploads&max-uploads=5000",
+			wantStatus:   http.StatusOK,
+			wantParams:   &gantry.ListMultipartUploadsParams{MaxUploads: 1000},
+			wantKeys:     []string{"videos/big.mp4"},
+			wantPrefixes: []string{"videos/2024/"},
+		},
+		{
+			name:         "url encoding type encodes keys and prefixes",
+			bucket:       "photos",
+			query:        "uploads&encoding-type=url",
+			wantStatus:   http.StatusOK,
+			wantParams:   &gantry.ListMultipartUploadsParams{MaxUploads: 1000},
+			wantKeys:     []string{"videos%2Fbig.mp4"},
+			wantPrefixes: []string{"videos%2F2024%2F"},
+		},
+		{
+			name:           "invalid max uploads -> 400",
+			bucket:         "photos",
+			query:          "uploads&max-uploads=lots",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "invalid bucket name -> 400",
+			bucket:         "INVALID-BUCKET",
+			query:          "uploads",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidBucketName",
+		},
+		{
+			name:   "gantry bucket not found -> 404 NoSuchBucket",
+			bucket: "nonexistent-bucket",
+			query:  "uploads",
+			gantryErr: objectLookupErr(codes.NotFound, "bucket not found",
+				servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, "nonexistent-bucket", ""),
+			wantStatus:     http.StatusNotFound,
+			wantParams:     &gantry.ListMultipartUploadsParams{MaxUploads: 1000},
+			wantBodySubstr: "NoSuchBucket",
+		},
+		{
+			name:           "gantry unexpected error -> 500",
+			bucket:         "photos",
+			query:          "uploads",
+			gantryErr:      status.Error(codes.Internal, "unexpected database error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantParams:     &gantry.ListMultipartUploadsParams{MaxUploads: 1000},
+			wantBodySubstr: "InternalError",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.ListMultipartUploadsFn = func(context.Context, string, gantry.ListMultipartUploadsParams) (gantry.MultipartUploadListing, error) {
+				if c.gantryErr != nil {
+					return gantry.MultipartUploadListing{}, c.gantryErr
+				}
+				return listing, nil
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/"+c.bucket+"?"+c.query, nil)
+			req.SetPathValue("bucket", c.bucket)
+			rec := httptest.NewRecorder()
+
+			h.ListMultipartUploads(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d (body %q)", rec.Code, c.wantStatus, rec.Body.String())
+			}
+
+			if c.wantParams == nil {
+				if got := gantryStub.ListMultipartUploadsCount(); got != 0 {
+					t.Fatalf("ListMultipartUploads calls: got %d, want 0", got)
+				}
+			} else {
+				if got := gantryStub.ListMultipartUploadsCount(); got != 1 {
+					t.Fatalf("ListMultipartUploads calls: got %d, want 1", got)
+				}
+				call := gantryStub.ListMultipartUploadsCalls[0]
+				if call.Bucket != c.bucket {
+					t.Fatalf("ListMultipartUploads bucket: got %q, want %q", call.Bucket, c.bucket)
+				}
+				if diff := cmp.Diff(*c.wantParams, call.Params); diff != "" {
+					t.Fatalf("ListMultipartUploads params diff (-want +got):\n%s", diff)
+				}
+			}
+
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+
+			if c.wantStatus != http.StatusOK {
+				return
+			}
+
+			var result listMultipartUploadsResultXML
+			if err := xml.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+				t.Fatalf("decode XML: %v", err)
+			}
+
+			if result.Bucket != c.bucket || result.MaxUploads != c.wantParams.MaxUploads {
+				t.Fatalf("Bucket/MaxUploads: got %q/%d, want %q/%d", result.Bucket, result.MaxUploads, c.bucket, c.wantParams.MaxUploads)
+			}
+			if !result.IsTruncated || result.NextUploadIDMarker != "upload-1" {
+				t.Fatalf("IsTruncated/NextUploadIdMarker: got %v/%q", result.IsTruncated, result.NextUploadIDMarker)
+			}
+
+			gotKeys := make([]string, 0, len(result.Uploads))
+			for _, upload := range result.Uploads {
+				gotKeys = append(gotKeys, upload.Key)
+				if upload.UploadID != "upload-1" || upload.Initiated != "2025-01-01T12:00:00.000Z" {
+					t.Fatalf("upload %q: got UploadId %s Initiated %s", upload.Key, upload.UploadID, upload.Initiated)
+				}
+			}
+			if diff := cmp.Diff(c.wantKeys, gotKeys); diff != "" {
+				t.Fatalf("keys diff (-want +got):\n%s", diff)
+			}
+
+			gotPrefixes := make([]string, 0, len(result.CommonPrefixes))
+			for _, cp := range result.CommonPrefixes {
+				gotPrefixes = append(gotPrefixes, cp.Prefix)
+			}
+			if diff := cmp.Diff(c.wantPrefixes, gotPrefixes); diff != "" {
+				t.Fatalf("common prefixes diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+type listVersionsResult struct {
+	XMLName             xml.Name           `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListVersionsResult" json:"-"`
+	Name                string             `xml:"Name" json:"Name"`
+	Prefix              string             `xml:"Prefix" json:"Prefix"`
+	KeyMarker           string             `xml:"KeyMarker" json:"KeyMarker"`
+	VersionIDMarker     string             `xml:"VersionIdMarker" json:"VersionIdMarker"`
+	NextKeyMarker       string             `xml:"NextKeyMarker,omitempty" json:"NextKeyMarker,omitempty"`
+	NextVersionIDMarker string             `xml:"NextVersionIdMarker,omitempty" json:"NextVersionIdMarker,omitempty"`
+	Delimiter           string             `xml:"Delimiter,omitempty" json:"Delimiter,omitempty"`
+	MaxKeys             int32              `xml:"MaxKeys" json:"MaxKeys"`
+	IsTruncated         bool               `xml:"IsTruncated" json:"IsTruncated"`
+	EncodingType        string             `xml:"EncodingType,omitempty" json:"EncodingType,omitempty"`
+	Versions            []listVersion      `xml:"Version" json:"Version"`
+	DeleteMarkers       []listDeleteMarker `xml:"DeleteMarker" json:"DeleteMarker"`
+	CommonPrefixes      []listCommonPrefix `xml:"CommonPrefixes" json:"CommonPrefixes"`
+}
+
+type listVersion struct {
+	Key          string `xml:"Key" json:"Key"`
+	VersionID    string `xml:"VersionId" json:"VersionId"`
+	IsLatest     bool   `xml:"IsLatest" json:"IsLatest"`
+	LastModified string `xml:"LastModified" json:"LastModified"`
+	ETag         string `xml:"ETag" json:"ETag"`
+	Size         int64  `xml:"Size" json:"Size"`
+	StorageClass string `xml:"StorageClass" json:"StorageClass"`
+}
+
+type listDeleteMarker struct {
+	Key          string `xml:"Key" json:"Key"`
+	VersionID    string `xml:"VersionId" json:"VersionId"`
+	IsLatest     bool   `xml:"IsLatest" json:"IsLatest"`
+	LastModified string `xml:"LastModified" json:"LastModified"`
+}
+
+// ListObjectVersions serves GET /{bucket}?versions. Gantry does the paging
+// and delimiter roll-up. Versions and delete markers are rendered as
+// separate lists, which is how S3 clients read them back.
+func (h *Handlers) ListObjectVersions(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+	query := r.URL.Query()
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	maxKeys := int32(defaultMaxKeys)
+	if v := query.Get("max-keys"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || n < 0 {
+			respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+			return
+		}
+		maxKeys = int32(min(n, defaultMaxKeys))
+	}
+
+	encode := func(s string) string { return s }
+	switch encodingType := query.Get("encoding-type"); encodingType {
+	case "":
+	case "url":
+		encode = url.QueryEscape
+	default:
+		respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+		return
+	}
+
+	params := gantry.ListObjectVersionsParams{
+		Prefix:          query.Get("prefix"),
+		Delimiter:       query.Get("delimiter"),
+		MaxKeys:         maxKeys,
+		KeyMarker:       query.Get("key-marker"),
+		VersionIDMarker: query.Get("version-id-marker"),
+	}
+
+	listing, err := h.Gantry.ListObjectVersions(r.Context(), bucket, params)
+	if err != nil {
+		respondLookupError(w, r, err)
+		return
+	}
+
+	result := listVersionsResult{
+		Name:                bucket,
+		Prefix:              encode(params.Prefix),
+		KeyMarker:           encode(params.KeyMarker),
+		VersionIDMarker:     params.VersionIDMarker,
+		NextKeyMarker:       encode(listing.NextKeyMarker),
+		NextVersionIDMarker: listing.NextVersionIDMarker,
+		Delimiter:           encode(params.Delimiter),
+		MaxKeys:             maxKeys,
+		IsTruncated:         listing.IsTruncated,
+		EncodingType:        query.Get("encoding-type"),
+	}
+
+	for _, v := range listing.Versions {
+		// Objects written before the bucket was ever versioned have the
+		// "null" version.
+		versionID := v.VersionID
+		if versionID == "" {
+			versionID = "null"
+		}
+		lastModified := v.LastModified.UTC().Format("2006-01-02T15:04:05.000Z")
+
+		if v.DeleteMarker {
+			result.DeleteMarkers = append(result.DeleteMarkers, listDeleteMarker{
+				Key:          encode(v.Key),
+				VersionID:    versionID,
+				IsLatest:     v.IsLatest,
+				LastModified: lastModified,
+			})
+			continue
+		}
+		result.Versions = append(result.Versions, listVersion{
+			Key:          encode(v.Key),
+			VersionID:    versionID,
+			IsLatest:     v.IsLatest,
+			LastModified: lastModified,
+			ETag:         objectETag(v),
+			Size:         v.Size,
+			StorageClass: "STANDARD",
+		})
+	}
+
+	for _, prefix := range listing.CommonPrefixes {
+		result.CommonPrefixes = append(result.CommonPrefixes, listCommonPrefix{Prefix: encode(prefix)})
+	}
+
+	logger.LogResult(r, fmt.Sprintf("listed %d versions in bucket <%s>", len(listing.Versions), bucket))
+
+	if err := respond.Encode(w, r, http.StatusOK, result); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
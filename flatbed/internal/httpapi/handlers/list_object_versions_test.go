@@ -0,0 +1,228 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+type listVersionsResultXML struct {
+	XMLName             xml.Name `xml:"ListVersionsResult"`
+	Name                string   `xml:"Name"`
+	KeyMarker           string   `xml:"KeyMarker"`
+	VersionIDMarker     string   `xml:"VersionIdMarker"`
+	NextKeyMarker       string   `xml:"NextKeyMarker"`
+	NextVersionIDMarker string   `xml:"NextVersionIdMarker"`
+	MaxKeys             int32    `xml:"MaxKeys"`
+	IsTruncated         bool     `xml:"IsTruncated"`
+	Versions            []struct {
+		Key          string `xml:"Key"`
+		VersionID    string `xml:"VersionId"`
+		IsLatest     bool   `xml:"IsLatest"`
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+		Size         int64  `xml:"Size"`
+	} `xml:"Version"`
+	DeleteMarkers []struct {
+		Key          string `xml:"Key"`
+		VersionID    string `xml:"VersionId"`
+		IsLatest     bool   `xml:"IsLatest"`
+		LastModified string `xml:"LastModified"`
+	} `xml:"DeleteMarker"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+}
+
+func TestListObjectVersions(t *testing.T) {
+	t.Parallel()
+
+	modified := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	listing := gantry.ObjectVersionListing{
+		Versions: []gantry.Object{
+			{ID: "v3", Key: "docs/report.docx", LastModified: modified, VersionID: "v3", IsLatest: true, DeleteMarker: true},
+			{ID: "v2", Key: "docs/report.docx", Size: 2048, LastModified: modified, ETag: "etag-2", VersionID: "v2"},
+			{ID: "v1", Key: "docs/report.docx", Size: 1024, LastModified: modified, ETag: "etag-1"},
+		},
+		CommonPrefixes:      []string{"docs/2024/"},
+		IsTruncated:         true,
+		NextKeyMarker:       "docs/report.docx",
+		NextVersionIDMarker: "v1",
+	}
+
+	type tc struct {
+		name           string
+		bucket         string
+		query          string
+		gantryErr      error
+		wantStatus     int
+		wantParams     *gantry.ListObjectVersionsParams
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:       "defaults -> 200 with 1000 max keys",
+			bucket:     "photos",
+			query:      "versions",
+			wantStatus: http.StatusOK,
+			wantParams: &gantry.ListObjectVersionsParams{MaxKeys: 1000},
+		},
+		{
+			name:       "parameters pass through to gantry",
+			bucket:     "photos",
+			query:      "versions&prefix=docs%2F&delimiter=%2F&max-keys=5&key-marker=docs%2Fa.txt&version-id-marker=v0",
+			wantStatus: http.StatusOK,
+			wantParams: &gantry.ListObjectVersionsParams{
+				Prefix:          "docs/",
+				Delimiter:       "/",
+				MaxKeys:         5,
+				KeyMarker:       "docs/a.txt",
+				VersionIDMarker: "v0",
+			},
+		},
+		{
+			name:           "invalid max keys -> 400",
+			bucket:         "photos",
+			query:          "versions&max-keys=-1",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "invalid encoding type -> 400",
+			bucket:         "photos",
+			query:          "versions&encoding-type=base64",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "version marker without key marker -> 400",
+			bucket:         "photos",
+			query:          "versions&version-id-marker=v0",
+			gantryErr:      status.Error(codes.InvalidArgument, "InvalidArgument"),
+			wantStatus:     http.StatusBadRequest,
+			wantParams:     &gantry.ListObjectVersionsParams{MaxKeys: 1000, VersionIDMarker: "v0"},
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:   "gantry bucket not found -> 404 NoSuchBucket",
+			bucket: "nonexistent-bucket",
+			query:  "versions",
+			gantryErr: objectLookupErr(codes.NotFound, "bucket not found",
+				servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, "nonexistent-bucket", ""),
+			wantStatus:     http.StatusNotFound,
+			wantParams:     &gantry.ListObjectVersionsParams{MaxKeys: 1000},
+			wantBodySubstr: "NoSuchBucket",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.ListObjectVersionsFn = func(context.Context, string, gantry.ListObjectVersionsParams) (gantry.ObjectVersionListing, error) {
+				if c.gantryErr != nil {
+					return gantry.ObjectVersionListing{}, c.gantryErr
+				}
+				return listing, nil
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/"+c.bucket+"?"+c.query, nil)
+			req.SetPathValue("bucket", c.bucket)
+			rec := httptest.NewRecorder()
+
+			h.ListObjectVersions(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d (body %q)", rec.Code, c.wantStatus, rec.Body.String())
+			}
+
+			if c.wantParams == nil {
+				if got := gantryStub.ListObjectVersionsCount(); got != 0 {
+					t.Fatalf("ListObjectVersions calls: got %d, want 0", got)
+				}
+			} else {
+				if got := gantryStub.ListObjectVersionsCount(); got != 1 {
+					t.Fatalf("ListObjectVersions calls: got %d, want 1", got)
+				}
+				call := gantryStub.ListObjectVersionsCalls[0]
+				if call.Bucket != c.bucket {
+					t.Fatalf("ListObjectVersions bucket: got %q, want %q", call.Bucket, c.bucket)
+				}
+				if diff := cmp.Diff(*c.wantParams, call.Params); diff != "" {
+					t.Fatalf("ListObjectVersions params diff (-want +got):\n%s", diff)
+				}
+			}
+
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+
+			if c.wantStatus != http.StatusOK {
+				return
+			}
+
+			var result listVersionsResultXML
+			if err := xml.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+				t.Fatalf("decode XML: %v", err)
+			}
+
+			if result.Name != c.bucket || result.MaxKeys != c.wantParams.MaxKeys {
+				t.Fatalf("Name/MaxKeys: got %q/%d, want %q/%d", result.Name, result.MaxKeys, c.bucket, c.wantParams.MaxKeys)
+			}
+			if result.KeyMarker != c.wantParams.KeyMarker || result.VersionIDMarker != c.wantParams.VersionIDMarker {
+				t.Fatalf("KeyMarker/VersionIdMarker: got %q/%q", result.KeyMarker, result.VersionIDMarker)
+			}
+			if !result.IsTruncated || result.NextKeyMarker != "docs/report.docx" || result.NextVersionIDMarker != "v1" {
+				t.Fatalf("IsTruncated/NextKeyMarker/NextVersionIdMarker: got %v/%q/%q",
+					result.IsTruncated, result.NextKeyMarker, result.NextVersionIDMarker)
+			}
+
+			if len(result.DeleteMarkers) != 1 {
+				t.Fatalf("delete markers: got %+v, want 1", result.DeleteMarkers)
+			}
+			marker := result.DeleteMarkers[0]
+			if marker.Key != "docs/report.docx" || marker.VersionID != "v3" || !marker.IsLatest || marker.LastModified != "2025-01-01T12:00:00.000Z" {
+				t.Fatalf("delete marker: got %+v", marker)
+			}
+
+			if len(result.Versions) != 2 {
+				t.Fatalf("versions: got %+v, want 2", result.Versions)
+			}
+			if v := result.Versions[0]; v.VersionID != "v2" || v.IsLatest || v.ETag != `"etag-2"` || v.Size != 2048 {
+				t.Fatalf("version 0: got %+v", v)
+			}
+			// A version with no ID predates versioning and is listed as null.
+			if v := result.Versions[1]; v.VersionID != "null" || v.ETag != `"etag-1"` || v.Size != 1024 {
+				t.Fatalf("version 1: got %+v", v)
+			}
+
+			if len(result.CommonPrefixes) != 1 || result.CommonPrefixes[0].Prefix != "docs/2024/" {
+				t.Fatalf("common prefixes: got %+v", result.CommonPrefixes)
+			}
+		})
+	}
+}
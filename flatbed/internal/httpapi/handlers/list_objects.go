@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+const defaultMaxKeys = 1000
+
+type listBucketResult struct {
+	XMLName               xml.Name           `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult" json:"-"`
+	Name                  string             `xml:"Name" json:"Name"`
+	Prefix                string             `xml:"Prefix" json:"Prefix"`
+	Delimiter             string             `xml:"Delimiter,omitempty" json:"Delimiter,omitempty"`
+	MaxKeys               int32              `xml:"MaxKeys" json:"MaxKeys"`
+	KeyCount              int                `xml:"KeyCount" json:"KeyCount"`
+	IsTruncated           bool               `xml:"IsTruncated" json:"IsTruncated"`
+	ContinuationToken     string             `xml:"ContinuationToken,omitempty" json:"ContinuationToken,omitempty"`
+	NextContinuationToken string             `xml:"NextContinuationToken,omitempty" json:"NextContinuationToken,omitempty"`
+	StartAfter            string             `xml:"StartAfter,omitempty" json:"StartAfter,omitempty"`
+	EncodingType          string             `xml:"EncodingType,omitempty" json:"EncodingType,omitempty"`
+	Contents              []listContents     `xml:"Contents" json:"Contents"`
+	CommonPrefixes        []listCommonPrefix `xml:"CommonPrefixes" json:"CommonPrefixes"`
+}
+
+type listContents struct {
+	Key          string `xml:"Key" json:"Key"`
+	LastModified string `xml:"LastModified" json:"LastModified"`
+	ETag         string `xml:"ETag" json:"ETag"`
+	Size         int64  `xml:"Size" json:"Size"`
+	StorageClass string `xml:"StorageClass" json:"StorageClass"`
+}
+
+type listCommonPrefix struct {
+	Prefix string `xml:"Prefix" json:"Prefix"`
+}
+
+// ListObjectsV2 serves GET /{bucket}?list-type=2. Gantry does the paging and
+// delimiter roll-up; this handler only parses parameters and renders XML.
+func (h *Handlers) ListObjectsV2(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+	query := r.URL.Query()
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	maxKeys := int32(defaultMaxKeys)
+	if v := query.Get("max-keys"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || n < 0 {
+			respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+			return
+		}
+		maxKeys = int32(min(n, defaultMaxKeys))
+	}
+
+	// encoding-type=url asks for keys and prefixes to be URL-encoded so
+	// clients can round-trip characters XML 1.0 can't carry.
+	encode := func(s string) string { return s }
+	switch encodingType := query.Get("encoding-type"); encodingType {
+	case "":
+	case "url":
+		encode = url.QueryEscape
+	default:
+		respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+		return
+	}
+
+	params := gantry.ListObjectsParams{
+		Prefix:            query.Get("prefix"),
+		Delimiter:         query.Get("delimiter"),
+		MaxKeys:           maxKeys,
+		StartAfter:        query.Get("start-after"),
+		ContinuationToken: query.Get("continuation-token"),
+	}
+
+	listing, err := h.Gantry.ListObjects(r.Context(), bucket, params)
+	if err != nil {
+		respondLookupError(w, r, err)
+		return
+	}
+
+	result := listBucketResult{
+		Name:                  bucket,
+		Prefix:                encode(params.Prefix),
+		Delimiter:             encode(params.Delimiter),
+		MaxKeys:               maxKeys,
+		KeyCount:              len(listing.Objects) + len(listing.CommonPrefixes),
+		IsTruncated:           listing.IsTruncated,
+		ContinuationToken:     params.ContinuationToken,
+		NextContinuationToken: listing.NextContinuationToken,
+		StartAfter:            encode(params.StartAfter),
+		EncodingType:          query.Get("encoding-type"),
+	}
+
+	for _, obj := range listing.Objects {
+		result.Contents = append(result.Contents, listContents{
+			Key:          encode(obj.Key),
+			LastModified: obj.LastModified.UTC().Format("2006-01-02T15:04:05.000Z"),
+			ETag:         objectETag(obj),
+			Size:         obj.Size,
+			StorageClass: "STANDARD",
+		})
+	}
+
+	for _, prefix := range listing.CommonPrefixes {
+		result.CommonPrefixes = append(result.CommonPrefixes, listCommonPrefix{Prefix: encode(prefix)})
+	}
+
+	logger.LogResult(r, fmt.Sprintf("listed %d keys in bucket <%s>", result.KeyCount, bucket))
+
+	if err := respond.Encode(w, r, http.StatusOK, result); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
@@ -0,0 +1,272 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+type listBucketResultXML struct {
+	XMLName               xml.Name `xml:"ListBucketResult"`
+	Name                  string   `xml:"Name"`
+	Prefix                string   `xml:"Prefix"`
+	Delimiter             string   `xml:"Delimiter"`
+	MaxKeys               int32    `xml:"MaxKeys"`
+	KeyCount              int      `xml:"KeyCount"`
+	IsTruncated           bool     `xml:"IsTruncated"`
+	ContinuationToken     string   `xml:"ContinuationToken"`
+	NextContinuationToken string   `xml:"NextContinuationToken"`
+	StartAfter            string   `xml:"StartAfter"`
+	EncodingType          string   `xml:"EncodingType"`
+	Contents              []struct {
+		Key          string `xml:"Key"`
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+		Size         int64  `xml:"Size"`
+		StorageClass string `xml:"StorageClass"`
+	} `xml:"Contents"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+}
+
+func TestListObjectsV2(t *testing.T) {
+	t.Parallel()
+
+	lastModified := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	listing := gantry.ObjectListing{
+		Objects: []gantry.Object{
+			{ID: "obj-1", Key: "photos/cover image.jpg", Size: 2048, LastModified: lastModified},
+		},
+		CommonPrefixes:        []string{"photos/2024/"},
+		IsTruncated:           true,
+		NextContinuationToken: "next-token",
+	}
+
+	type tc struct {
+		name           string
+		bucket         string
+		query          string
+		gantryErr      error
+		wantStatus     int
+		wantParams     *gantry.ListObjectsParams
+		wantKeys       []string
+		wantPrefixes   []string
+		wantEncoding   string
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:         "defaults -> 200 with 1000 max keys",
+			bucket:       "photos",
+			query:        "list-type=2",
+			wantStatus:   http.StatusOK,
+			wantParams:   &gantry.ListObjectsParams{MaxKeys: 1000},
+			wantKeys:     []string{"photos/cover image.jpg"},
+			wantPrefixes: []string{"photos/2024/"},
+		},
+		{
+			name:       "parameters pass through to gantry",
+			bucket:     "photos",
+			query:      "list-type=2&prefix=photos%2F&delimiter=%2F&max-keys=5&start-after=photos%2Fa.jpg&continuation-token=prev-token",
+			wantStatus: http.StatusOK,
+			wantParams: &gantry.ListObjectsParams{
+				Prefix:            "photos/",
+				Delimiter:         "/",
+				MaxKeys:           5,
+				StartAfter:        "photos/a.jpg",
+				ContinuationToken: "prev-token",
+			},
+			wantKeys:     []string{"photos/cover image.jpg"},
+			wantPrefixes: []string{"photos/2024/"},
+		},
+		{
+			name:         "max keys above limit is capped",
+			bucket:       "photos",
+			query:        "list-type=2&max-keys=5000",
+			wantStatus:   http.StatusOK,
+			wantParams:   &gantry.ListObjectsParams{MaxKeys: 1000},
+			wantKeys:     []string{"photos/cover image.jpg"},
+			wantPrefixes: []string{"photos/2024/"},
+		},
+		{
+			name:         "url encoding type encodes keys and prefixes",
+			bucket:       "photos",
+			query:        "list-type=2&encoding-type=url&prefix=photos%2F",
+			wantStatus:   http.StatusOK,
+			wantParams:   &gantry.ListObjectsParams{Prefix: "photos/", MaxKeys: 1000},
+			wantKeys:     []string{"photos%2Fcover+image.jpg"},
+			wantPrefixes: []string{"photos%2F2024%2F"},
+			wantEncoding: "url",
+		},
+		{
+			name:           "invalid max keys -> 400",
+			bucket:         "photos",
+			query:          "list-type=2&max-keys=lots",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "negative max keys -> 400",
+			bucket:         "photos",
+			query:          "list-type=2&max-keys=-1",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "unknown encoding type -> 400",
+			bucket:         "photos",
+			query:          "list-type=2&encoding-type=base64",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "invalid bucket name -> 400",
+			bucket:         "INVALID-BUCKET",
+			query:          "list-type=2",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidBucketName",
+		},
+		{
+			name:   "gantry bucket not found -> 404 NoSuchBucket",
+			bucket: "nonexistent-bucket",
+			query:  "list-type=2",
+			gantryErr: objectLookupErr(codes.NotFound, "bucket not found",
+				servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, "nonexistent-bucket", ""),
+			wantStatus:     http.StatusNotFound,
+			wantParams:     &gantry.ListObjectsParams{MaxKeys: 1000},
+			wantBodySubstr: "NoSuchBucket",
+		},
+		{
+			name:           "gantry rejects continuation token -> 400",
+			bucket:         "photos",
+			query:          "list-type=2&continuation-token=garbage",
+			gantryErr:      status.Error(codes.InvalidArgument, "InvalidArgument"),
+			wantStatus:     http.StatusBadRequest,
+			wantParams:     &gantry.ListObjectsParams{MaxKeys: 1000, ContinuationToken: "garbage"},
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "gantry unexpected error -> 500",
+			bucket:         "photos",
+			query:          "list-type=2",
+			gantryErr:      status.Error(codes.Internal, "unexpected database error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantParams:     &gantry.ListObjectsParams{MaxKeys: 1000},
+			wantBodySubstr: "InternalError",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.ListObjectsFn = func(context.Context, string, gantry.ListObjectsParams) (gantry.ObjectListing, error) {
+				if c.gantryErr != nil {
+					return gantry.ObjectListing{}, c.gantryErr
+				}
+				return listing, nil
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/"+c.bucket+"?"+c.query, nil)
+			req.SetPathValue("bucket", c.bucket)
+			rec := httptest.NewRecorder()
+
+			h.ListObjectsV2(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d (body %q)", rec.Code, c.wantStatus, rec.Body.String())
+			}
+
+			if c.wantParams == nil {
+				if got := gantryStub.ListObjectsCount(); got != 0 {
+					t.Fatalf("ListObjects calls: got %d, want 0", got)
+				}
+			} else {
+				if got := gantryStub.ListObjectsCount(); got != 1 {
+					t.Fatalf("ListObjects calls: got %d, want 1", got)
+				}
+				call := gantryStub.ListObjectsCalls[0]
+				if call.Bucket != c.bucket {
+					t.Fatalf("ListObjects bucket: got %q, want %q", call.Bucket, c.bucket)
+				}
+				if diff := cmp.Diff(*c.wantParams, call.Params); diff != "" {
+					t.Fatalf("ListObjects params diff (-want +got):\n%s", diff)
+				}
+			}
+
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+
+			if c.wantStatus != http.StatusOK {
+				return
+			}
+
+			if got := rec.Header().Get("Content-Type"); got != "application/xml" {
+				t.Fatalf("Content-Type: got %q, want application/xml", got)
+			}
+
+			var result listBucketResultXML
+			if err := xml.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+				t.Fatalf("decode XML: %v", err)
+			}
+
+			if result.Name != c.bucket || result.MaxKeys != c.wantParams.MaxKeys {
+				t.Fatalf("Name/MaxKeys: got %q/%d, want %q/%d", result.Name, result.MaxKeys, c.bucket, c.wantParams.MaxKeys)
+			}
+			if result.KeyCount != 2 || !result.IsTruncated || result.NextContinuationToken != "next-token" {
+				t.Fatalf("KeyCount/IsTruncated/NextContinuationToken: got %d/%v/%q", result.KeyCount, result.IsTruncated, result.NextContinuationToken)
+			}
+			if result.ContinuationToken != c.wantParams.ContinuationToken {
+				t.Fatalf("ContinuationToken: got %q, want %q", result.ContinuationToken, c.wantParams.ContinuationToken)
+			}
+			if result.EncodingType != c.wantEncoding {
+				t.Fatalf("EncodingType: got %q, want %q", result.EncodingType, c.wantEncoding)
+			}
+
+			gotKeys := make([]string, 0, len(result.Contents))
+			for _, obj := range result.Contents {
+				gotKeys = append(gotKeys, obj.Key)
+				if obj.ETag != `"obj-1"` || obj.Size != 2048 || obj.LastModified != "2025-01-01T12:00:00.000Z" {
+					t.Fatalf("contents %q: got ETag %s Size %d LastModified %s", obj.Key, obj.ETag, obj.Size, obj.LastModified)
+				}
+			}
+			if diff := cmp.Diff(c.wantKeys, gotKeys); diff != "" {
+				t.Fatalf("keys diff (-want +got):\n%s", diff)
+			}
+
+			gotPrefixes := make([]string, 0, len(result.CommonPrefixes))
+			for _, cp := range result.CommonPrefixes {
+				gotPrefixes = append(gotPrefixes, cp.Prefix)
+			}
+			if diff := cmp.Diff(c.wantPrefixes, gotPrefixes); diff != "" {
+				t.Fatalf("common prefixes diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
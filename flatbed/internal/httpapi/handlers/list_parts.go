@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+const defaultMaxParts = 1000
+
+type listPartsResult struct {
+	XMLName              xml.Name   `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListPartsResult" json:"-"`
+	Bucket               string     `xml:"Bucket" json:"Bucket"`
+	Key                  string     `xml:"Key" json:"Key"`
+	UploadID             string     `xml:"UploadId" json:"UploadId"`
+	PartNumberMarker     int32      `xml:"PartNumberMarker" json:"PartNumberMarker"`
+	NextPartNumberMarker int32      `xml:"NextPartNumberMarker,omitempty" json:"NextPartNumberMarker,omitempty"`
+	MaxParts             int32      `xml:"MaxParts" json:"MaxParts"`
+	IsTruncated          bool       `xml:"IsTruncated" json:"IsTruncated"`
+	StorageClass         string     `xml:"StorageClass" json:"StorageClass"`
+	Parts                []listPart `xml:"Part" json:"Part"`
+}
+
+type listPart struct {
+	PartNumber   int32  `xml:"PartNumber" json:"PartNumber"`
+	LastModified string `xml:"LastModified" json:"LastModified"`
+	ETag         string `xml:"ETag" json:"ETag"`
+	Size         int64  `xml:"Size" json:"Size"`
+}
+
+// ListParts serves GET /{bucket}/{key}?uploadId=ID, listing the parts
+// uploaded so far in part number order.
+func (h *Handlers) ListParts(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+	key := r.PathValue("key")
+	query := r.URL.Query()
+	uploadID := query.Get("uploadId")
+
+	// Validate bucket name
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	// Validate key
+	if err := h.KeyValidator.ValidateKey(key); err != nil {
+		respond.Error(w, r, "InvalidKeyName", http.StatusBadRequest)
+		return
+	}
+
+	maxParts := int32(defaultMaxParts)
+	if v := query.Get("max-parts"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || n < 0 {
+			respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+			return
+		}
+		maxParts = int32(min(n, defaultMaxParts))
+	}
+
+	var marker int32
+	if v := query.Get("part-number-marker"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || n < 0 {
+			respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+			return
+		}
+		marker = int32(n)
+	}
+
+	params := gantry.ListPartsParams{
+		PartNumberMarker: marker,
+		MaxParts:         maxParts,
+	}
+
+	listing, err := h.Gantry.ListParts(r.Context(), bucket, key, uploadID, params)
+	if err != nil {
+		respondUploadError(w, r, err)
+		return
+	}
+
+	result := listPartsResult{
+		Bucket:               bucket,
+		Key:                  key,
+		UploadID:             uploadID,
+		PartNumberMarker:     marker,
+		NextPartNumberMarker: listing.NextPartNumberMarker,
+		MaxParts:             maxParts,
+		IsTruncated:          listing.IsTruncated,
+		StorageClass:         "STANDARD",
+	}
+
+	for _, part := range listing.Parts {
+		result.Parts = append(result.Parts, listPart{
+			PartNumber:   part.PartNumber,
+			LastModified: part.LastModified.UTC().Format("2006-01-02T15:04:05.000Z"),
+			ETag:         `"` + part.ETag + `"`,
+			Size:         part.Size,
+		})
+	}
+
+	logger.LogResult(r, fmt.Sprintf("listed %d parts of upload <%s>", len(result.Parts), uploadID))
+
+	if err := respond.Encode(w, r, http.StatusOK, result); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
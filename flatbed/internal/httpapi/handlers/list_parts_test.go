@@ -0,0 +1,182 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestListParts(t *testing.T) {
+	t.Parallel()
+
+	listing := gantry.PartListing{
+		Parts: []gantry.Part{
+			{PartNumber: 3, ETag: "blob-3", Size: 5242880, LastModified: time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)},
+		},
+		IsTruncated:          true,
+		NextPartNumberMarker: 3,
+	}
+
+	type tc struct {
+		name           string
+		bucket         string
+		key            string
+		query          string
+		gantryErr      error
+		wantStatus     int
+		wantParams     *gantry.ListPartsParams
+		wantBodySubstr []string
+	}
+
+	cases := []tc{
+		{
+			name:       "defaults -> 200 with parts",
+			bucket:     "photos",
+			key:        "videos/big.mp4",
+			query:      "uploadId=upload-1",
+			wantStatus: http.StatusOK,
+			wantParams: &gantry.ListPartsParams{MaxParts: 1000},
+			wantBodySubstr: []string{
+				"<ListPartsResult",
+				"<UploadId>upload-1</UploadId>",
+				"<NextPartNumberMarker>3</NextPartNumberMarker>",
+				"<IsTruncated>true</IsTruncated>",
+				"<PartNumber>3</PartNumber>",
+				"<ETag>&#34;blob-3&#34;</ETag>",
+				"<LastModified>2025-01-01T12:00:00.000Z</LastModified>",
+			},
+		},
+		{
+			name:           "paging parameters pass through to gantry",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			query:          "uploadId=upload-1&part-number-marker=2&max-parts=1",
+			wantStatus:     http.StatusOK,
+			wantParams:     &gantry.ListPartsParams{PartNumberMarker: 2, MaxParts: 1},
+			wantBodySubstr: []string{"<PartNumberMarker>2</PartNumberMarker>", "<MaxParts>1</MaxParts>"},
+		},
+		{
+			name:           "invalid part number marker -> 400",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			query:          "uploadId=upload-1&part-number-marker=-1",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: []string{"InvalidArgument"},
+		},
+		{
+			name:           "invalid max parts -> 400",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			query:          "uploadId=upload-1&max-parts=lots",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: []string{"InvalidArgument"},
+		},
+		{
+			name:           "invalid key -> 400",
+			bucket:         "photos",
+			key:            "file\x00name",
+			query:          "uploadId=upload-1",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: []string{"InvalidKeyName"},
+		},
+		{
+			name:   "gantry bucket not found -> 404 NoSuchBucket",
+			bucket: "nonexistent-bucket",
+			key:    "videos/big.mp4",
+			query:  "uploadId=upload-1",
+			gantryErr: objectLookupErr(codes.NotFound, "bucket not found",
+				servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, "nonexistent-bucket", "videos/big.mp4"),
+			wantStatus:     http.StatusNotFound,
+			wantParams:     &gantry.ListPartsParams{MaxParts: 1000},
+			wantBodySubstr: []string{"NoSuchBucket"},
+		},
+		{
+			name:           "gantry unknown upload -> 404 NoSuchUpload",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			query:          "uploadId=upload-1",
+			gantryErr:      status.Error(codes.NotFound, "NoSuchUpload"),
+			wantStatus:     http.StatusNotFound,
+			wantParams:     &gantry.ListPartsParams{MaxParts: 1000},
+			wantBodySubstr: []string{"NoSuchUpload"},
+		},
+		{
+			name:           "gantry unexpected error -> 500",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			query:          "uploadId=upload-1",
+			gantryErr:      status.Error(codes.Internal, "unexpected database error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantParams:     &gantry.ListPartsParams{MaxParts: 1000},
+			wantBodySubstr: []string{"InternalError"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.ListPartsFn = func(context.Context, string, string, string, gantry.ListPartsParams) (gantry.PartListing, error) {
+				if c.gantryErr != nil {
+					return gantry.PartListing{}, c.gantryErr
+				}
+				return listing, nil
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/?"+c.query, nil)
+			req.SetPathValue("bucket", c.bucket)
+			req.SetPathValue("key", c.key)
+			rec := httptest.NewRecorder()
+
+			h.ListParts(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d (body %q)", rec.Code, c.wantStatus, rec.Body.String())
+			}
+
+			if c.wantParams == nil {
+				if got := gantryStub.ListPartsCount(); got != 0 {
+					t.Fatalf("ListParts calls: got %d, want 0", got)
+				}
+			} else {
+				if got := gantryStub.ListPartsCount(); got != 1 {
+					t.Fatalf("ListParts calls: got %d, want 1", got)
+				}
+				call := gantryStub.ListPartsCalls[0]
+				if call.Bucket != c.bucket || call.Key != c.key || call.UploadID != "upload-1" {
+					t.Fatalf("ListParts call: got %+v, want %s/%s upload-1", call, c.bucket, c.key)
+				}
+				if diff := cmp.Diff(*c.wantParams, call.Params); diff != "" {
+					t.Fatalf("ListParts params diff (-want +got):\n%s", diff)
+				}
+			}
+
+			for _, want := range c.wantBodySubstr {
+				if !strings.Contains(rec.Body.String(), want) {
+					t.Fatalf("body: expected substring %q, got %q", want, rec.Body.String())
+				}
+			}
+		})
+	}
+}
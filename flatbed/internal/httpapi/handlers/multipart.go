@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// maxCompleteBodyBytes bounds the CompleteMultipartUpload body; a full
+// 10,000-part list with quoted ETags fits comfortably.
+const maxCompleteBodyBytes = 2 * 1024 * 1024
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ InitiateMultipartUploadResult" json:"-"`
+	Bucket   string   `xml:"Bucket" json:"Bucket"`
+	Key      string   `xml:"Key" json:"Key"`
+	UploadID string   `xml:"UploadId" json:"UploadId"`
+}
+
+// completeMultipartUpload is the request body of CompleteMultipartUpload.
+// Clients usually send it in the S3 namespace, so the element name is
+// matched without one.
+type completeMultipartUpload struct {
+	XMLName xml.Name       `xml:"CompleteMultipartUpload"`
+	Parts   []completePart `xml:"Part"`
+}
+
+type completePart struct {
+	PartNumber int32  `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CompleteMultipartUploadResult" json:"-"`
+	Location string   `xml:"Location" json:"Location"`
+	Bucket   string   `xml:"Bucket" json:"Bucket"`
+	Key      string   `xml:"Key" json:"Key"`
+	ETag     string   `xml:"ETag" json:"ETag"`
+}
+
+// respondUploadError maps a multipart upload failure from Gantry to the
+// matching S3 error response. Gantry reports a missing bucket with an
+// ObjectLookupError detail; any other NotFound is an unknown upload.
+func respondUploadError(w http.ResponseWriter, r *http.Request, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+		return
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		if lookupReason(st) == servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND {
+			respond.Error(w, r, "NoSuchBucket", http.StatusNotFound)
+			return
+		}
+		respond.Error(w, r, "NoSuchUpload", http.StatusNotFound)
+	case codes.InvalidArgument:
+		respond.Error(w, r, st.Message(), http.StatusBadRequest)
+	case codes.PermissionDenied:
+		respond.Error(w, r, "AccessDenied", http.StatusForbidden)
+	case codes.FailedPrecondition:
+		logger.LogGantryError(r, err)
+		respond.Error(w, r, "ServiceUnavailable", http.StatusServiceUnavailable)
+	default:
+		logger.LogGantryError(r, err)
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+	}
+}
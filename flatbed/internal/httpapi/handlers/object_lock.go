@@ -0,0 +1,331 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+// maxObjectLockBodyBytes bounds the object lock, retention and legal hold
+// request bodies, which only ever hold a few short elements.
+const maxObjectLockBodyBytes = 4 * 1024
+
+const (
+	legalHoldOn  = "ON"
+	legalHoldOff = "OFF"
+)
+
+// objectLockConfigurationRequest is the request body of
+// PutObjectLockConfiguration, matched without a namespace like
+// completeMultipartUpload.
+type objectLockConfigurationRequest struct {
+	XMLName           xml.Name          `xml:"ObjectLockConfiguration"`
+	ObjectLockEnabled string            `xml:"ObjectLockEnabled"`
+	Rule              *objectLockRuleIn `xml:"Rule"`
+}
+
+type objectLockRuleIn struct {
+	DefaultRetention defaultRetention `xml:"DefaultRetention"`
+}
+
+type objectLockConfiguration struct {
+	XMLName           xml.Name        `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ObjectLockConfiguration" json:"-"`
+	ObjectLockEnabled string          `xml:"ObjectLockEnabled" json:"ObjectLockEnabled"`
+	Rule              *objectLockRule `xml:"Rule,omitempty" json:"Rule,omitempty"`
+}
+
+type objectLockRule struct {
+	DefaultRetention defaultRetention `xml:"DefaultRetention" json:"DefaultRetention"`
+}
+
+type defaultRetention struct {
+	Mode  string `xml:"Mode" json:"Mode"`
+	Days  int32  `xml:"Days,omitempty" json:"Days,omitempty"`
+	Years int32  `xml:"Years,omitempty" json:"Years,omitempty"`
+}
+
+type retentionRequest struct {
+	XMLName         xml.Name `xml:"Retention"`
+	Mode            string   `xml:"Mode"`
+	RetainUntilDate string   `xml:"RetainUntilDate"`
+}
+
+type retention struct {
+	XMLName         xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ Retention" json:"-"`
+	Mode            string   `xml:"Mode" json:"Mode"`
+	RetainUntilDate string   `xml:"RetainUntilDate" json:"RetainUntilDate"`
+}
+
+type legalHoldRequest struct {
+	XMLName xml.Name `xml:"LegalHold"`
+	Status  string   `xml:"Status"`
+}
+
+type legalHold struct {
+	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LegalHold" json:"-"`
+	Status  string   `xml:"Status" json:"Status"`
+}
+
+// PutObjectLockConfiguration serves PUT /{bucket}?object-lock, enabling
+// object lock on a versioned bucket and setting its default retention. A
+// configuration without a Rule removes the default retention; object lock
+// itself can't be turned off.
+func (h *Handlers) PutObjectLockConfiguration(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	var body objectLockConfigurationRequest
+	if err := xml.NewDecoder(http.MaxBytesReader(w, r.Body, maxObjectLockBodyBytes)).Decode(&body); err != nil || body.ObjectLockEnabled != "Enabled" {
+		respond.Error(w, r, "MalformedXML", http.StatusBadRequest)
+		return
+	}
+
+	var cfg gantry.ObjectLockConfiguration
+	if body.Rule != nil {
+		cfg = gantry.ObjectLockConfiguration{
+			DefaultMode:  body.Rule.DefaultRetention.Mode,
+			DefaultDays:  body.Rule.DefaultRetention.Days,
+			DefaultYears: body.Rule.DefaultRetention.Years,
+		}
+	}
+
+	if err := h.Gantry.PutObjectLockConfiguration(r.Context(), bucket, cfg); err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	logger.LogResult(r, fmt.Sprintf("bucket <%s> object lock configured", bucket))
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetObjectLockConfiguration serves GET /{bucket}?object-lock. Gantry
+// answers ObjectLockConfigurationNotFoundError for a bucket without object
+// lock.
+func (h *Handlers) GetObjectLockConfiguration(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	cfg, err := h.Gantry.GetObjectLockConfiguration(r.Context(), bucket)
+	if err != nil {
+		respondBucketConfigError(w, r, err)
+		return
+	}
+
+	out := objectLockConfiguration{ObjectLockEnabled: "Enabled"}
+	if cfg.DefaultMode != "" {
+		out.Rule = &objectLockRule{DefaultRetention: defaultRetention{
+			Mode:  cfg.DefaultMode,
+			Days:  cfg.DefaultDays,
+			Years: cfg.DefaultYears,
+		}}
+	}
+
+	if err := respond.Encode(w, r, http.StatusOK, out); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
+
+// PutObjectRetention serves PUT /{bucket}/{key}?retention, setting the
+// retention of the current version or of the one ?versionId= names. An
+// empty Retention removes it. Shortening or removing a GOVERNANCE
+// retention needs x-amz-bypass-governance-retention: true; Gantry checks
+// the date and the caller's permissions.
+func (h *Handlers) PutObjectRetention(w http.ResponseWriter, r *http.Request) {
+	bucket, key, ok := h.subresourceObject(w, r)
+	if !ok {
+		return
+	}
+
+	var body retentionRequest
+	if err := xml.NewDecoder(http.MaxBytesReader(w, r.Body, maxObjectLockBodyBytes)).Decode(&body); err != nil {
+		respond.Error(w, r, "MalformedXML", http.StatusBadRequest)
+		return
+	}
+
+	retainUntil, ok := retentionDate(w, r, body.Mode, body.RetainUntilDate)
+	if !ok {
+		return
+	}
+
+	versionID, err := h.Gantry.PutObjectRetention(r.Context(), bucket, key, r.URL.Query().Get("versionId"), body.Mode, retainUntil, bypassGovernance(r))
+	if err != nil {
+		respondSubresourceError(w, r, err)
+		return
+	}
+
+	setVersionHeader(w, versionID)
+	if body.Mode == "" {
+		logger.LogResult(r, fmt.Sprintf("object <%s/%s> retention removed", bucket, key))
+	} else {
+		logger.LogResult(r, fmt.Sprintf("object <%s/%s> retained in %s mode until %s", bucket, key, body.Mode, body.RetainUntilDate))
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetObjectRetention serves GET /{bucket}/{key}?retention. Gantry answers
+// NoSuchObjectLockConfiguration for a version that isn't retained.
+func (h *Handlers) GetObjectRetention(w http.ResponseWriter, r *http.Request) {
+	bucket, key, ok := h.subresourceObject(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.Gantry.GetObjectRetention(r.Context(), bucket, key, r.URL.Query().Get("versionId"))
+	if err != nil {
+		respondSubresourceError(w, r, err)
+		return
+	}
+
+	setVersionHeader(w, result.VersionID)
+	out := retention{Mode: result.Mode, RetainUntilDate: formatRetainUntil(result.RetainUntil)}
+	if err := respond.Encode(w, r, http.StatusOK, out); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
+
+// PutObjectLegalHold serves PUT /{bucket}/{key}?legal-hold, placing or
+// lifting a legal hold on the current version or the one ?versionId= names.
+func (h *Handlers) PutObjectLegalHold(w http.ResponseWriter, r *http.Request) {
+	bucket, key, ok := h.subresourceObject(w, r)
+	if !ok {
+		return
+	}
+
+	var body legalHoldRequest
+	if err := xml.NewDecoder(http.MaxBytesReader(w, r.Body, maxObjectLockBodyBytes)).Decode(&body); err != nil {
+		respond.Error(w, r, "MalformedXML", http.StatusBadRequest)
+		return
+	}
+
+	on, ok := legalHoldStatus(body.Status)
+	if !ok {
+		respond.Error(w, r, "MalformedXML", http.StatusBadRequest)
+		return
+	}
+
+	versionID, err := h.Gantry.PutObjectLegalHold(r.Context(), bucket, key, r.URL.Query().Get("versionId"), on)
+	if err != nil {
+		respondSubresourceError(w, r, err)
+		return
+	}
+
+	setVersionHeader(w, versionID)
+	logger.LogResult(r, fmt.Sprintf("object <%s/%s> legal hold %s", bucket, key, body.Status))
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetObjectLegalHold serves GET /{bucket}/{key}?legal-hold.
+func (h *Handlers) GetObjectLegalHold(w http.ResponseWriter, r *http.Request) {
+	bucket, key, ok := h.subresourceObject(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.Gantry.GetObjectLegalHold(r.Context(), bucket, key, r.URL.Query().Get("versionId"))
+	if err != nil {
+		respondSubresourceError(w, r, err)
+		return
+	}
+
+	out := legalHold{Status: legalHoldOff}
+	if result.On {
+		out.Status = legalHoldOn
+	}
+
+	setVersionHeader(w, result.VersionID)
+	if err := respond.Encode(w, r, http.StatusOK, out); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
+
+// uploadObjectLock parses the object lock headers of an upload: a retention
+// mode with the date it lasts until, and a legal hold. It writes the S3
+// error and returns false when a header is malformed or a mode comes
+// without a date, or a date without a mode.
+func uploadObjectLock(w http.ResponseWriter, r *http.Request) (gantry.ObjectLock, bool) {
+	mode := r.Header.Get("x-amz-object-lock-mode")
+	retainUntil, ok := retentionDate(w, r, mode, r.Header.Get("x-amz-object-lock-retain-until-date"))
+	if !ok {
+		return gantry.ObjectLock{}, false
+	}
+
+	lock := gantry.ObjectLock{Mode: mode, RetainUntil: retainUntil}
+	if header := r.Header.Get("x-amz-object-lock-legal-hold"); header != "" {
+		if lock.LegalHold, ok = legalHoldStatus(header); !ok {
+			respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+			return gantry.ObjectLock{}, false
+		}
+	}
+	return lock, true
+}
+
+// retentionDate parses the date a retention in mode lasts until. Gantry
+// checks the mode and that the date is in the future. It writes the S3
+// error and returns false when the date is malformed, or only one of mode
+// and date is given.
+func retentionDate(w http.ResponseWriter, r *http.Request, mode, date string) (time.Time, bool) {
+	if (mode == "") != (date == "") {
+		respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+		return time.Time{}, false
+	}
+	if date == "" {
+		return time.Time{}, true
+	}
+
+	retainUntil, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+		return time.Time{}, false
+	}
+	return retainUntil.UTC(), true
+}
+
+// legalHoldStatus reports whether status places a legal hold, and false
+// for its second result when status is neither ON nor OFF.
+func legalHoldStatus(status string) (bool, bool) {
+	switch status {
+	case legalHoldOn:
+		return true, true
+	case legalHoldOff:
+		return false, true
+	}
+	return false, false
+}
+
+// bypassGovernance reports whether the request asks to override GOVERNANCE
+// retention with x-amz-bypass-governance-retention: true. Gantry ignores it
+// for callers without s3:BypassGovernanceRetention.
+func bypassGovernance(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("x-amz-bypass-governance-retention"), "true")
+}
+
+// formatRetainUntil formats a retain-until date the way S3 sends it.
+func formatRetainUntil(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// setObjectLockHeaders reports the retention and legal hold of a version
+// read with GET or HEAD.
+func setObjectLockHeaders(w http.ResponseWriter, lock gantry.ObjectLock) {
+	if lock.Mode != "" {
+		w.Header().Set("x-amz-object-lock-mode", lock.Mode)
+		w.Header().Set("x-amz-object-lock-retain-until-date", formatRetainUntil(lock.RetainUntil))
+	}
+	if lock.LegalHold {
+		w.Header().Set("x-amz-object-lock-legal-hold", legalHoldOn)
+	}
+}
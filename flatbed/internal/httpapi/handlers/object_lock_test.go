@@ -0,0 +1,411 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+)
+
+func TestPutObjectLockConfiguration(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		body           string
+		gantryErr      error
+		wantStatus     int
+		wantCall       *gantry.ObjectLockConfiguration
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:       "default retention -> 200",
+			body:       `<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>COMPLIANCE</Mode><Days>90</Days></DefaultRetention></Rule></ObjectLockConfiguration>`,
+			wantStatus: http.StatusOK,
+			wantCall:   &gantry.ObjectLockConfiguration{DefaultMode: "COMPLIANCE", DefaultDays: 90},
+		},
+		{
+			name:       "no rule -> 200",
+			body:       `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`,
+			wantStatus: http.StatusOK,
+			wantCall:   &gantry.ObjectLockConfiguration{},
+		},
+		{name: "not enabled -> 400", body: `<ObjectLockConfiguration></ObjectLockConfiguration>`, wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{name: "malformed body -> 400", body: `<ObjectLockConfiguration>`, wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{
+			name:           "versioning not enabled -> 409",
+			body:           `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`,
+			gantryErr:      status.Error(codes.FailedPrecondition, "InvalidBucketState"),
+			wantStatus:     http.StatusConflict,
+			wantCall:       &gantry.ObjectLockConfiguration{},
+			wantBodySubstr: "InvalidBucketState",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.PutObjectLockConfigurationFn = func(context.Context, string, gantry.ObjectLockConfiguration) error {
+				return c.gantryErr
+			}
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, "/backups?object-lock", strings.NewReader(c.body))
+			req.SetPathValue("bucket", "backups")
+			subresourceHandlers(gantryStub).PutObjectLockConfiguration(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d; body: %s", rec.Code, c.wantStatus, rec.Body.String())
+			}
+			calls := gantryStub.PutObjectLockConfigurationCalls
+			if c.wantCall == nil {
+				if len(calls) != 0 {
+					t.Fatalf("PutObjectLockConfiguration calls: got %+v, want none", calls)
+				}
+			} else if want := (testutil.PutObjectLockConfigurationCall{Bucket: "backups", Configuration: *c.wantCall}); len(calls) != 1 || calls[0] != want {
+				t.Fatalf("PutObjectLockConfiguration calls: got %+v, want %+v", calls, want)
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetObjectLockConfiguration(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name       string
+		cfg        gantry.ObjectLockConfiguration
+		gantryErr  error
+		wantStatus int
+		wantBody   string
+	}
+
+	cases := []tc{
+		{
+			name:       "default retention",
+			cfg:        gantry.ObjectLockConfiguration{DefaultMode: "GOVERNANCE", DefaultYears: 1},
+			wantStatus: http.StatusOK,
+			wantBody:   `<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Years>1</Years></DefaultRetention></Rule></ObjectLockConfiguration>`,
+		},
+		{
+			name:       "no default retention",
+			wantStatus: http.StatusOK,
+			wantBody:   `<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`,
+		},
+		{
+			name:       "object lock not enabled -> 404",
+			gantryErr:  status.Error(codes.NotFound, "ObjectLockConfigurationNotFoundError"),
+			wantStatus: http.StatusNotFound,
+			wantBody:   "ObjectLockConfigurationNotFoundError",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.GetObjectLockConfigurationFn = func(context.Context, string) (gantry.ObjectLockConfiguration, error) {
+				return c.cfg, c.gantryErr
+			}
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/backups?object-lock", nil)
+			req.SetPathValue("bucket", "backups")
+			subresourceHandlers(gantryStub).GetObjectLockConfiguration(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			if !strings.Contains(rec.Body.String(), c.wantBody) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBody, rec.Body.String())
+			}
+			if calls := gantryStub.GetObjectLockConfigurationCalls; len(calls) != 1 || calls[0] != "backups" {
+				t.Fatalf("GetObjectLockConfiguration calls: got %v, want [backups]", calls)
+			}
+		})
+	}
+}
+
+func TestPutObjectRetention(t *testing.T) {
+	t.Parallel()
+
+	const governanceBody = `<Retention xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Mode>GOVERNANCE</Mode><RetainUntilDate>2025-04-01T00:00:00Z</RetainUntilDate></Retention>`
+	retainUntil := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	type tc struct {
+		name           string
+		target         string
+		body           string
+		bypass         string
+		gantryErr      error
+		wantStatus     int
+		wantCall       *testutil.ObjectRetentionCall
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:       "retention -> 200",
+			target:     "/backups/db.tar?retention",
+			body:       governanceBody,
+			wantStatus: http.StatusOK,
+			wantCall:   &testutil.ObjectRetentionCall{Bucket: "backups", Key: "db.tar", Mode: "GOVERNANCE", RetainUntil: retainUntil},
+		},
+		{
+			name:       "bypass governance -> 200",
+			target:     "/backups/db.tar?retention&versionId=01JVERSION",
+			body:       `<Retention></Retention>`,
+			bypass:     "true",
+			wantStatus: http.StatusOK,
+			wantCall:   &testutil.ObjectRetentionCall{Bucket: "backups", Key: "db.tar", VersionID: "01JVERSION", BypassGovernance: true},
+		},
+		{
+			name:           "mode without a date -> 400",
+			target:         "/backups/db.tar?retention",
+			body:           `<Retention><Mode>COMPLIANCE</Mode></Retention>`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "malformed date -> 400",
+			target:         "/backups/db.tar?retention",
+			body:           `<Retention><Mode>COMPLIANCE</Mode><RetainUntilDate>next year</RetainUntilDate></Retention>`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{name: "malformed body -> 400", target: "/backups/db.tar?retention", body: "<Retention>", wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{
+			name:           "locked version -> 403",
+			target:         "/backups/db.tar?retention",
+			body:           governanceBody,
+			gantryErr:      status.Error(codes.PermissionDenied, "AccessDenied"),
+			wantStatus:     http.StatusForbidden,
+			wantCall:       &testutil.ObjectRetentionCall{Bucket: "backups", Key: "db.tar", Mode: "GOVERNANCE", RetainUntil: retainUntil},
+			wantBodySubstr: "AccessDenied",
+		},
+		{
+			name:           "bucket without object lock -> 400",
+			target:         "/backups/db.tar?retention",
+			body:           governanceBody,
+			gantryErr:      status.Error(codes.InvalidArgument, "InvalidRequest"),
+			wantStatus:     http.StatusBadRequest,
+			wantCall:       &testutil.ObjectRetentionCall{Bucket: "backups", Key: "db.tar", Mode: "GOVERNANCE", RetainUntil: retainUntil},
+			wantBodySubstr: "InvalidRequest",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.PutObjectRetentionFn = func(_ context.Context, _, _, versionID, _ string, _ time.Time, _ bool) (string, error) {
+				return versionID, c.gantryErr
+			}
+
+			rec := httptest.NewRecorder()
+			req := subresourceRequest(t, http.MethodPut, c.target, c.body)
+			if c.bypass != "" {
+				req.Header.Set("x-amz-bypass-governance-retention", c.bypass)
+			}
+			subresourceHandlers(gantryStub).PutObjectRetention(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d; body: %s", rec.Code, c.wantStatus, rec.Body.String())
+			}
+			calls := gantryStub.PutObjectRetentionCalls
+			if c.wantCall == nil {
+				if len(calls) != 0 {
+					t.Fatalf("PutObjectRetention calls: got %+v, want none", calls)
+				}
+			} else if len(calls) != 1 || calls[0] != *c.wantCall {
+				t.Fatalf("PutObjectRetention calls: got %+v, want %+v", calls, *c.wantCall)
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetObjectRetention(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		result      gantry.ObjectRetention
+		gantryErr   error
+		wantStatus  int
+		wantVersion string
+		wantBody    string
+	}
+
+	cases := []tc{
+		{
+			name:        "retained version",
+			result:      gantry.ObjectRetention{VersionID: "01JVERSION", Mode: "COMPLIANCE", RetainUntil: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)},
+			wantStatus:  http.StatusOK,
+			wantVersion: "01JVERSION",
+			wantBody:    `<Retention xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Mode>COMPLIANCE</Mode><RetainUntilDate>2025-04-01T00:00:00.000Z</RetainUntilDate></Retention>`,
+		},
+		{
+			name:       "version without retention -> 404",
+			gantryErr:  status.Error(codes.NotFound, "NoSuchObjectLockConfiguration"),
+			wantStatus: http.StatusNotFound,
+			wantBody:   "NoSuchObjectLockConfiguration",
+		},
+		{
+			name:       "delete marker -> 405",
+			gantryErr:  status.Error(codes.FailedPrecondition, "MethodNotAllowed"),
+			wantStatus: http.StatusMethodNotAllowed,
+			wantBody:   "MethodNotAllowed",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.GetObjectRetentionFn = func(context.Context, string, string, string) (gantry.ObjectRetention, error) {
+				return c.result, c.gantryErr
+			}
+
+			rec := httptest.NewRecorder()
+			subresourceHandlers(gantryStub).GetObjectRetention(rec, subresourceRequest(t, http.MethodGet, "/backups/db.tar?retention", ""))
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			if !strings.Contains(rec.Body.String(), c.wantBody) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBody, rec.Body.String())
+			}
+			if got := rec.Header().Get("x-amz-version-id"); got != c.wantVersion {
+				t.Fatalf("x-amz-version-id: got %q, want %q", got, c.wantVersion)
+			}
+		})
+	}
+}
+
+func TestPutObjectLegalHold(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		body           string
+		gantryErr      error
+		wantStatus     int
+		wantCall       *testutil.ObjectLegalHoldCall
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:       "hold -> 200",
+			body:       `<LegalHold xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>ON</Status></LegalHold>`,
+			wantStatus: http.StatusOK,
+			wantCall:   &testutil.ObjectLegalHoldCall{Bucket: "backups", Key: "db.tar", On: true},
+		},
+		{
+			name:       "lift -> 200",
+			body:       `<LegalHold><Status>OFF</Status></LegalHold>`,
+			wantStatus: http.StatusOK,
+			wantCall:   &testutil.ObjectLegalHoldCall{Bucket: "backups", Key: "db.tar"},
+		},
+		{name: "unknown status -> 400", body: `<LegalHold><Status>MAYBE</Status></LegalHold>`, wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{
+			name:           "missing key -> 404",
+			body:           `<LegalHold><Status>ON</Status></LegalHold>`,
+			gantryErr:      status.Error(codes.NotFound, "NoSuchKey"),
+			wantStatus:     http.StatusNotFound,
+			wantCall:       &testutil.ObjectLegalHoldCall{Bucket: "backups", Key: "db.tar", On: true},
+			wantBodySubstr: "NoSuchKey",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.PutObjectLegalHoldFn = func(context.Context, string, string, string, bool) (string, error) {
+				return "", c.gantryErr
+			}
+
+			rec := httptest.NewRecorder()
+			subresourceHandlers(gantryStub).PutObjectLegalHold(rec, subresourceRequest(t, http.MethodPut, "/backups/db.tar?legal-hold", c.body))
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d; body: %s", rec.Code, c.wantStatus, rec.Body.String())
+			}
+			calls := gantryStub.PutObjectLegalHoldCalls
+			if c.wantCall == nil {
+				if len(calls) != 0 {
+					t.Fatalf("PutObjectLegalHold calls: got %+v, want none", calls)
+				}
+			} else if len(calls) != 1 || calls[0] != *c.wantCall {
+				t.Fatalf("PutObjectLegalHold calls: got %+v, want %+v", calls, *c.wantCall)
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetObjectLegalHold(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name     string
+		on       bool
+		wantBody string
+	}
+
+	cases := []tc{
+		{name: "held", on: true, wantBody: `<LegalHold xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>ON</Status></LegalHold>`},
+		{name: "not held", wantBody: `<LegalHold xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>OFF</Status></LegalHold>`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.GetObjectLegalHoldFn = func(context.Context, string, string, string) (gantry.ObjectLegalHold, error) {
+				return gantry.ObjectLegalHold{On: c.on}, nil
+			}
+
+			rec := httptest.NewRecorder()
+			req := subresourceRequest(t, http.MethodGet, "/backups/db.tar?legal-hold&versionId=01JVERSION", "")
+			subresourceHandlers(gantryStub).GetObjectLegalHold(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status: got %d, want 200", rec.Code)
+			}
+			if !strings.Contains(rec.Body.String(), c.wantBody) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBody, rec.Body.String())
+			}
+			want := testutil.ObjectLegalHoldCall{Bucket: "backups", Key: "db.tar", VersionID: "01JVERSION"}
+			if calls := gantryStub.GetObjectLegalHoldCalls; len(calls) != 1 || calls[0] != want {
+				t.Fatalf("GetObjectLegalHold calls: got %+v, want [%+v]", calls, want)
+			}
+		})
+	}
+}
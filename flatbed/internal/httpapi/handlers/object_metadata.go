@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+)
+
+const userMetadataPrefix = "x-amz-meta-"
+
+// uploadMetadata captures the headers S3 stores with an uploaded object.
+// aws-chunked only frames the request body, so it is dropped from the stored
+// Content-Encoding. Repeated x-amz-meta-* headers are joined with commas.
+func uploadMetadata(r *http.Request) gantry.ObjectMetadata {
+	md := gantry.ObjectMetadata{
+		ContentEncoding:    storedContentEncoding(r.Header.Values("Content-Encoding")),
+		ContentDisposition: r.Header.Get("Content-Disposition"),
+		CacheControl:       r.Header.Get("Cache-Control"),
+		Expires:            r.Header.Get("Expires"),
+	}
+
+	for name, values := range r.Header {
+		key, ok := strings.CutPrefix(strings.ToLower(name), userMetadataPrefix)
+		if !ok || key == "" {
+			continue
+		}
+		if md.User == nil {
+			md.User = make(map[string]string)
+		}
+		md.User[key] = strings.Join(values, ",")
+	}
+
+	return md
+}
+
+// storedContentEncoding returns the Content-Encoding to keep for an object,
+// without aws-chunked.
+func storedContentEncoding(values []string) string {
+	var encodings []string
+	for _, v := range values {
+		for _, enc := range strings.Split(v, ",") {
+			enc = strings.TrimSpace(enc)
+			if enc != "" && !strings.EqualFold(enc, "aws-chunked") {
+				encodings = append(encodings, enc)
+			}
+		}
+	}
+	return strings.Join(encodings, ",")
+}
+
+// setMetadataHeaders replays the headers stored with an object.
+func setMetadataHeaders(w http.ResponseWriter, md gantry.ObjectMetadata) {
+	for name, value := range map[string]string{
+		"Content-Encoding":    md.ContentEncoding,
+		"Content-Disposition": md.ContentDisposition,
+		"Cache-Control":       md.CacheControl,
+		"Expires":             md.Expires,
+	} {
+		if value != "" {
+			w.Header().Set(name, value)
+		}
+	}
+
+	for key, value := range md.User {
+		w.Header().Set(userMetadataPrefix+key, value)
+	}
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+)
+
+// subresourceObject validates the bucket and key of a request for one of an
+// object's subresources, such as ?tagging or ?retention. It writes the S3
+// error and returns false when either is invalid.
+func (h *Handlers) subresourceObject(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	bucket := r.PathValue("bucket")
+	key := r.PathValue("key")
+
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return "", "", false
+	}
+
+	if err := h.KeyValidator.ValidateKey(key); err != nil {
+		respond.Error(w, r, "InvalidKeyName", http.StatusBadRequest)
+		return "", "", false
+	}
+
+	return bucket, key, true
+}
+
+// respondSubresourceError maps a failed subresource call to its S3 error.
+// Gantry answers FailedPrecondition when the version named is a delete
+// marker.
+func respondSubresourceError(w http.ResponseWriter, r *http.Request, err error) {
+	if status.Code(err) == codes.FailedPrecondition {
+		respond.Error(w, r, "MethodNotAllowed", http.StatusMethodNotAllowed)
+		return
+	}
+	respondBucketConfigError(w, r, err)
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+// maxTaggingBodyBytes bounds the PutObjectTagging body, well above what ten
+// tags of the longest keys and values take.
+const maxTaggingBodyBytes = 64 * 1024
+
+// taggingRequest is the request body of PutObjectTagging, matched without a
+// namespace like completeMultipartUpload.
+type taggingRequest struct {
+	XMLName xml.Name    `xml:"Tagging"`
+	TagSet  []objectTag `xml:"TagSet>Tag"`
+}
+
+type tagging struct {
+	XMLName xml.Name    `xml:"http://s3.amazonaws.com/doc/2006-03-01/ Tagging" json:"-"`
+	TagSet  []objectTag `xml:"TagSet>Tag" json:"TagSet"`
+}
+
+type objectTag struct {
+	Key   string `xml:"Key" json:"Key"`
+	Value string `xml:"Value" json:"Value"`
+}
+
+// PutObjectTagging serves PUT /{bucket}/{key}?tagging, replacing the tags of
+// the current version or of the one ?versionId= names. Gantry enforces S3's
+// limits on the tags.
+func (h *Handlers) PutObjectTagging(w http.ResponseWriter, r *http.Request) {
+	bucket, key, ok := h.subresourceObject(w, r)
+	if !ok {
+		return
+	}
+
+	var body taggingRequest
+	if err := xml.NewDecoder(http.MaxBytesReader(w, r.Body, maxTaggingBodyBytes)).Decode(&body); err != nil {
+		respond.Error(w, r, "MalformedXML", http.StatusBadRequest)
+		return
+	}
+
+	tags := make(map[string]string, len(body.TagSet))
+	for _, t := range body.TagSet {
+		if _, dup := tags[t.Key]; dup {
+			respond.Error(w, r, "InvalidTag", http.StatusBadRequest)
+			return
+		}
+		tags[t.Key] = t.Value
+	}
+
+	versionID, err := h.Gantry.PutObjectTagging(r.Context(), bucket, key, r.URL.Query().Get("versionId"), tags)
+	if err != nil {
+		respondSubresourceError(w, r, err)
+		return
+	}
+
+	setVersionHeader(w, versionID)
+	logger.LogResult(r, fmt.Sprintf("object <%s/%s> tagged with %d tags", bucket, key, len(tags)))
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetObjectTagging serves GET /{bucket}/{key}?tagging. Tags are returned in
+// key order.
+func (h *Handlers) GetObjectTagging(w http.ResponseWriter, r *http.Request) {
+	bucket, key, ok := h.subresourceObject(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.Gantry.GetObjectTagging(r.Context(), bucket, key, r.URL.Query().Get("versionId"))
+	if err != nil {
+		respondSubresourceError(w, r, err)
+		return
+	}
+
+	out := tagging{TagSet: make([]objectTag, 0, len(result.Tags))}
+	for k, v := range result.Tags {
+		out.TagSet = append(out.TagSet, objectTag{Key: k, Value: v})
+	}
+	sort.Slice(out.TagSet, func(i, j int) bool { return out.TagSet[i].Key < out.TagSet[j].Key })
+
+	setVersionHeader(w, result.VersionID)
+	if err := respond.Encode(w, r, http.StatusOK, out); err != nil {
+		logger.LogError(w, r, err.Error())
+	}
+}
+
+// DeleteObjectTagging serves DELETE /{bucket}/{key}?tagging, removing every
+// tag from the current version or the one ?versionId= names.
+func (h *Handlers) DeleteObjectTagging(w http.ResponseWriter, r *http.Request) {
+	bucket, key, ok := h.subresourceObject(w, r)
+	if !ok {
+		return
+	}
+
+	versionID, err := h.Gantry.DeleteObjectTagging(r.Context(), bucket, key, r.URL.Query().Get("versionId"))
+	if err != nil {
+		respondSubresourceError(w, r, err)
+		return
+	}
+
+	setVersionHeader(w, versionID)
+	logger.LogResult(r, fmt.Sprintf("object <%s/%s> tags deleted", bucket, key))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// uploadTags parses the x-amz-tagging header of an upload, which holds tags
+// encoded as URL query parameters. A key given twice is rejected with
+// InvalidTag, as in S3. It writes the S3 error and returns false when the
+// header is malformed.
+func uploadTags(w http.ResponseWriter, r *http.Request) (map[string]string, bool) {
+	header := r.Header.Get("x-amz-tagging")
+	if header == "" {
+		return nil, true
+	}
+
+	values, err := url.ParseQuery(header)
+	if err != nil {
+		respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+		return nil, false
+	}
+
+	tags := make(map[string]string, len(values))
+	for k, v := range values {
+		if len(v) > 1 {
+			respond.Error(w, r, "InvalidTag", http.StatusBadRequest)
+			return nil, false
+		}
+		tags[k] = v[0]
+	}
+	return tags, true
+}
@@ -0,0 +1,250 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+)
+
+func subresourceRequest(t *testing.T, method, target, body string) *http.Request {
+	t.Helper()
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	r.SetPathValue("bucket", "backups")
+	r.SetPathValue("key", "db.tar")
+	return r
+}
+
+func subresourceHandlers(stub *testutil.GantryStub) *handlers.Handlers {
+	return &handlers.Handlers{
+		BucketValidator: validation.DefaultBucketNameValidator{},
+		KeyValidator:    validation.DefaultKeyValidator{},
+		Gantry:          stub,
+	}
+}
+
+func TestPutObjectTagging(t *testing.T) {
+	t.Parallel()
+
+	const hostBody = `<Tagging xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><TagSet><Tag><Key>host</Key><Value>web-1</Value></Tag><Tag><Key>tier</Key><Value>cold</Value></Tag></TagSet></Tagging>`
+
+	type tc struct {
+		name           string
+		target         string
+		body           string
+		versionID      string
+		gantryErr      error
+		wantStatus     int
+		wantCall       *testutil.ObjectTaggingCall
+		wantVersion    string
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:       "tags -> 200",
+			target:     "/backups/db.tar?tagging",
+			body:       hostBody,
+			wantStatus: http.StatusOK,
+			wantCall:   &testutil.ObjectTaggingCall{Bucket: "backups", Key: "db.tar", Tags: map[string]string{"host": "web-1", "tier": "cold"}},
+		},
+		{
+			name:        "named version -> 200 with version header",
+			target:      "/backups/db.tar?tagging&versionId=01JVERSION",
+			body:        `<Tagging><TagSet><Tag><Key>host</Key><Value>web-1</Value></Tag></TagSet></Tagging>`,
+			versionID:   "01JVERSION",
+			wantStatus:  http.StatusOK,
+			wantCall:    &testutil.ObjectTaggingCall{Bucket: "backups", Key: "db.tar", VersionID: "01JVERSION", Tags: map[string]string{"host": "web-1"}},
+			wantVersion: "01JVERSION",
+		},
+		{
+			name:       "empty tag set -> 200",
+			target:     "/backups/db.tar?tagging",
+			body:       `<Tagging><TagSet></TagSet></Tagging>`,
+			wantStatus: http.StatusOK,
+			wantCall:   &testutil.ObjectTaggingCall{Bucket: "backups", Key: "db.tar", Tags: map[string]string{}},
+		},
+		{name: "malformed body -> 400", target: "/backups/db.tar?tagging", body: "<Tagging>", wantStatus: http.StatusBadRequest, wantBodySubstr: "MalformedXML"},
+		{
+			name:           "repeated key -> 400",
+			target:         "/backups/db.tar?tagging",
+			body:           `<Tagging><TagSet><Tag><Key>host</Key><Value>a</Value></Tag><Tag><Key>host</Key><Value>b</Value></Tag></TagSet></Tagging>`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidTag",
+		},
+		{
+			name:           "rejected by gantry -> 400",
+			target:         "/backups/db.tar?tagging",
+			body:           hostBody,
+			gantryErr:      status.Error(codes.InvalidArgument, "InvalidTag"),
+			wantStatus:     http.StatusBadRequest,
+			wantCall:       &testutil.ObjectTaggingCall{Bucket: "backups", Key: "db.tar", Tags: map[string]string{"host": "web-1", "tier": "cold"}},
+			wantBodySubstr: "InvalidTag",
+		},
+		{
+			name:           "missing key -> 404",
+			target:         "/backups/db.tar?tagging",
+			body:           hostBody,
+			gantryErr:      status.Error(codes.NotFound, "NoSuchKey"),
+			wantStatus:     http.StatusNotFound,
+			wantCall:       &testutil.ObjectTaggingCall{Bucket: "backups", Key: "db.tar", Tags: map[string]string{"host": "web-1", "tier": "cold"}},
+			wantBodySubstr: "NoSuchKey",
+		},
+		{
+			name:           "delete marker -> 405",
+			target:         "/backups/db.tar?tagging&versionId=01JMARKER",
+			body:           hostBody,
+			gantryErr:      status.Error(codes.FailedPrecondition, "MethodNotAllowed"),
+			wantStatus:     http.StatusMethodNotAllowed,
+			wantCall:       &testutil.ObjectTaggingCall{Bucket: "backups", Key: "db.tar", VersionID: "01JMARKER", Tags: map[string]string{"host": "web-1", "tier": "cold"}},
+			wantBodySubstr: "MethodNotAllowed",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.PutObjectTaggingFn = func(context.Context, string, string, string, map[string]string) (string, error) {
+				return c.versionID, c.gantryErr
+			}
+
+			rec := httptest.NewRecorder()
+			subresourceHandlers(gantryStub).PutObjectTagging(rec, subresourceRequest(t, http.MethodPut, c.target, c.body))
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d; body: %s", rec.Code, c.wantStatus, rec.Body.String())
+			}
+			calls := gantryStub.PutObjectTaggingCalls
+			if c.wantCall == nil {
+				if len(calls) != 0 {
+					t.Fatalf("PutObjectTagging calls: got %+v, want none", calls)
+				}
+			} else if len(calls) != 1 || !reflect.DeepEqual(calls[0], *c.wantCall) {
+				t.Fatalf("PutObjectTagging calls: got %+v, want %+v", calls, *c.wantCall)
+			}
+			if got := rec.Header().Get("x-amz-version-id"); got != c.wantVersion {
+				t.Fatalf("x-amz-version-id: got %q, want %q", got, c.wantVersion)
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetObjectTagging(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		target      string
+		result      gantry.ObjectTagging
+		gantryErr   error
+		wantStatus  int
+		wantVersion string
+		wantBody    string
+	}
+
+	cases := []tc{
+		{
+			name:       "tags in key order",
+			target:     "/backups/db.tar?tagging",
+			result:     gantry.ObjectTagging{Tags: map[string]string{"tier": "cold", "host": "web-1"}},
+			wantStatus: http.StatusOK,
+			wantBody:   `<Tagging xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><TagSet><Tag><Key>host</Key><Value>web-1</Value></Tag><Tag><Key>tier</Key><Value>cold</Value></Tag></TagSet></Tagging>`,
+		},
+		{
+			name:        "untagged version",
+			target:      "/backups/db.tar?tagging&versionId=null",
+			result:      gantry.ObjectTagging{VersionID: "null"},
+			wantStatus:  http.StatusOK,
+			wantVersion: "null",
+			wantBody:    `<Tagging xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><TagSet></TagSet></Tagging>`,
+		},
+		{name: "missing version -> 404", target: "/backups/db.tar?tagging&versionId=01JGONE", gantryErr: status.Error(codes.NotFound, "NoSuchVersion"), wantStatus: http.StatusNotFound, wantBody: "NoSuchVersion"},
+		{name: "not allowed -> 403", target: "/backups/db.tar?tagging", gantryErr: status.Error(codes.PermissionDenied, "AccessDenied"), wantStatus: http.StatusForbidden, wantBody: "AccessDenied"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.GetObjectTaggingFn = func(context.Context, string, string, string) (gantry.ObjectTagging, error) {
+				return c.result, c.gantryErr
+			}
+
+			rec := httptest.NewRecorder()
+			req := subresourceRequest(t, http.MethodGet, c.target, "")
+			subresourceHandlers(gantryStub).GetObjectTagging(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			if !strings.Contains(rec.Body.String(), c.wantBody) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBody, rec.Body.String())
+			}
+			if got := rec.Header().Get("x-amz-version-id"); got != c.wantVersion {
+				t.Fatalf("x-amz-version-id: got %q, want %q", got, c.wantVersion)
+			}
+			want := testutil.ObjectTaggingCall{Bucket: "backups", Key: "db.tar", VersionID: req.URL.Query().Get("versionId")}
+			if calls := gantryStub.GetObjectTaggingCalls; len(calls) != 1 || !reflect.DeepEqual(calls[0], want) {
+				t.Fatalf("GetObjectTagging calls: got %+v, want [%+v]", calls, want)
+			}
+		})
+	}
+}
+
+func TestDeleteObjectTagging(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name       string
+		versionID  string
+		gantryErr  error
+		wantStatus int
+	}
+
+	cases := []tc{
+		{name: "deleted -> 204", wantStatus: http.StatusNoContent},
+		{name: "versioned -> 204", versionID: "01JVERSION", wantStatus: http.StatusNoContent},
+		{name: "missing bucket -> 404", gantryErr: status.Error(codes.NotFound, "NoSuchBucket"), wantStatus: http.StatusNotFound},
+		{name: "gantry failure -> 500", gantryErr: status.Error(codes.Internal, "database error"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			gantryStub.DeleteObjectTaggingFn = func(context.Context, string, string, string) (string, error) {
+				return c.versionID, c.gantryErr
+			}
+
+			rec := httptest.NewRecorder()
+			subresourceHandlers(gantryStub).DeleteObjectTagging(rec, subresourceRequest(t, http.MethodDelete, "/backups/db.tar?tagging", ""))
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			if got := rec.Header().Get("x-amz-version-id"); got != c.versionID {
+				t.Fatalf("x-amz-version-id: got %q, want %q", got, c.versionID)
+			}
+			if calls := gantryStub.DeleteObjectTaggingCalls; len(calls) != 1 || calls[0].Bucket != "backups" || calls[0].Key != "db.tar" {
+				t.Fatalf("DeleteObjectTagging calls: got %+v, want backups/db.tar", calls)
+			}
+		})
+	}
+}
@@ -1,15 +1,26 @@
 package handlers
 
 import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/ratdaddy/blockcloset/flatbed/internal/awschunked"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/checksum"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/cradle"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/sigv4"
 )
 
 const maxPutBytes = 5 * 1024 * 1024 * 1024 // 5 GiB
@@ -18,65 +29,52 @@ func (h *Handlers) PutObject(w http.ResponseWriter, r *http.Request) {
 	bucket := r.PathValue("bucket")
 	key := r.PathValue("key")
 
-	// Reject chunked transfer encoding (check first, before Content-Length)
-	// Go processes Transfer-Encoding and populates r.TransferEncoding slice
-	if len(r.TransferEncoding) > 0 {
-		respond.Error(w, r, "InvalidRequest", http.StatusBadRequest)
+	body, contentLength, ok := uploadBody(w, r)
+	if !ok {
 		return
 	}
 
-	// Validate Content-Length is present
-	contentLengthStr := r.Header.Get("Content-Length")
-	if contentLengthStr == "" {
-		respond.Error(w, r, "MissingContentLength", http.StatusLengthRequired)
+	// Validate bucket name
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
 		return
 	}
 
-	// Validate Content-Length is greater than zero
-	contentLength, err := strconv.ParseInt(contentLengthStr, 10, 64)
-	if err != nil || contentLength <= 0 {
-		respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+	// Validate key
+	if err := h.KeyValidator.ValidateKey(key); err != nil {
+		respond.Error(w, r, "InvalidKeyName", http.StatusBadRequest)
 		return
 	}
 
-	// Validate Content-Length does not exceed maximum
-	if contentLength > maxPutBytes {
-		respond.Error(w, r, "EntityTooLarge", http.StatusBadRequest)
+	digests, ok := uploadDigests(w, r)
+	if !ok {
 		return
 	}
 
-	// Validate bucket name
-	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
-		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+	// Like S3, only If-None-Match: * is supported on PUT. Gantry checks it
+	// when committing, since another upload may commit the key meanwhile.
+	ifNoneMatch := false
+	if v := r.Header.Get("If-None-Match"); v != "" {
+		if strings.TrimSpace(v) != "*" {
+			respond.Error(w, r, "NotImplemented", http.StatusNotImplemented)
+			return
+		}
+		ifNoneMatch = true
+	}
+
+	tags, ok := uploadTags(w, r)
+	if !ok {
 		return
 	}
 
-	// Validate key
-	if err := h.KeyValidator.ValidateKey(key); err != nil {
-		respond.Error(w, r, "InvalidKeyName", http.StatusBadRequest)
+	lock, ok := uploadObjectLock(w, r)
+	if !ok {
 		return
 	}
 
-	writePlan, err := h.Gantry.PlanWrite(r.Context(), bucket, key, contentLength)
+	writePlan, err := h.Gantry.PlanWrite(r.Context(), bucket, key, contentLength, r.Header.Get("Content-Type"), uploadMetadata(r), tags, lock)
 	if err != nil {
-		st, ok := status.FromError(err)
-		if !ok {
-			respond.Error(w, r, "InternalError", http.StatusInternalServerError)
-			return
-		}
-
-		switch st.Code() {
-		case codes.NotFound:
-			respond.Error(w, r, "NoSuchBucket", http.StatusNotFound)
-		case codes.PermissionDenied:
-			respond.Error(w, r, "AccessDenied", http.StatusForbidden)
-		case codes.FailedPrecondition:
-			logger.LogGantryError(r, err)
-			respond.Error(w, r, "ServiceUnavailable", http.StatusServiceUnavailable)
-		default:
-			logger.LogGantryError(r, err)
-			respond.Error(w, r, "InternalError", http.StatusInternalServerError)
-		}
+		respondPlanError(w, r, err)
 		return
 	}
 
@@ -86,20 +84,226 @@ func (h *Handlers) PutObject(w http.ResponseWriter, r *http.Request) {
 	logger.LogWritePlan(r, objectID, cradleAddress, contentLength)
 
 	// Stream request body to Cradle
-	bytesWritten, lastModifiedMs, err := h.Cradle.WriteObject(r.Context(), cradleAddress, objectID, bucket, contentLength, r.Body)
+	written, err := h.Cradle.WriteObject(r.Context(), cradleAddress, objectID, bucket, contentLength, body, digests)
+	if err != nil {
+		respondWriteError(w, r, err)
+		return
+	}
 
 	// Validate bytes written matches expected size
-	if err != nil || bytesWritten != contentLength {
+	if written.BytesWritten != contentLength {
 		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
 		return
 	}
 
-	if err := h.Gantry.CommitObject(r.Context(), objectID, bytesWritten, lastModifiedMs); err != nil {
+	etag := hex.EncodeToString(written.MD5)
+	commit := gantry.ObjectCommit{
+		Size:              written.BytesWritten,
+		LastModifiedMs:    written.LastModifiedMs,
+		ETag:              etag,
+		ChecksumAlgorithm: digests.ChecksumAlgorithm,
+		Checksum:          written.Checksum,
+		IfNoneMatch:       ifNoneMatch,
+	}
+	versionID, err := h.Gantry.CommitObject(r.Context(), objectID, commit)
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition && st.Message() == "PreconditionFailed" {
+			respond.Error(w, r, "PreconditionFailed", http.StatusPreconditionFailed)
+			return
+		}
+		// Gantry refuses to replace a version under object lock.
+		if status.Code(err) == codes.PermissionDenied {
+			respond.Error(w, r, "AccessDenied", http.StatusForbidden)
+			return
+		}
 		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
 		return
 	}
 
-	w.Header().Set("ETag", `"`+objectID+`"`)
-	w.Header().Set("Last-Modified", time.UnixMilli(lastModifiedMs).UTC().Format(time.RFC1123))
+	w.Header().Set("ETag", `"`+etag+`"`)
+	setChecksumHeader(w, digests.ChecksumAlgorithm, written.Checksum)
+	w.Header().Set("Last-Modified", formatLastModified(time.UnixMilli(written.LastModifiedMs)))
+	setVersionHeader(w, versionID)
 	w.WriteHeader(http.StatusOK)
 }
+
+// uploadBody validates the upload body framing shared by PutObject and
+// UploadPart and returns the payload with its size. aws-chunked bodies are
+// decoded on the way through and sized by x-amz-decoded-content-length.
+// It writes the S3 error and returns false when validation fails.
+func uploadBody(w http.ResponseWriter, r *http.Request) (io.Reader, int64, bool) {
+	if awschunked.IsChunked(r) {
+		return awsChunkedBody(w, r)
+	}
+
+	// Reject chunked transfer encoding (check first, before Content-Length)
+	// Go processes Transfer-Encoding and populates r.TransferEncoding slice
+	if len(r.TransferEncoding) > 0 {
+		respond.Error(w, r, "InvalidRequest", http.StatusBadRequest)
+		return nil, 0, false
+	}
+
+	contentLength, ok := requireLength(w, r, r.Header.Get("Content-Length"))
+	if !ok {
+		return nil, 0, false
+	}
+	return r.Body, contentLength, true
+}
+
+// awsChunkedBody sizes an aws-chunked upload by its decoded length, which
+// lets it arrive with Transfer-Encoding: chunked. Chunk signatures are
+// checked when the request was authenticated.
+func awsChunkedBody(w http.ResponseWriter, r *http.Request) (io.Reader, int64, bool) {
+	decodedLength, ok := requireLength(w, r, r.Header.Get("x-amz-decoded-content-length"))
+	if !ok {
+		return nil, 0, false
+	}
+
+	opts := awschunked.Options{
+		DecodedLength: decodedLength,
+		Trailer:       r.Header.Get("x-amz-trailer"),
+	}
+	if r.Header.Get("x-amz-content-sha256") != awschunked.StreamingUnsignedTrailer {
+		opts.Verifier = awschunked.VerifierFromContext(r.Context())
+	}
+
+	body, err := awschunked.NewReader(r.Body, opts)
+	if err != nil {
+		respond.Error(w, r, "InvalidRequest", http.StatusBadRequest)
+		return nil, 0, false
+	}
+	return body, decodedLength, true
+}
+
+// requireLength validates a declared upload size header.
+func requireLength(w http.ResponseWriter, r *http.Request, header string) (int64, bool) {
+	// Validate the length is present
+	if header == "" {
+		respond.Error(w, r, "MissingContentLength", http.StatusLengthRequired)
+		return 0, false
+	}
+
+	// Validate the length is greater than zero
+	length, err := strconv.ParseInt(header, 10, 64)
+	if err != nil || length <= 0 {
+		respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+		return 0, false
+	}
+
+	// Validate the length does not exceed maximum
+	if length > maxPutBytes {
+		respond.Error(w, r, "EntityTooLarge", http.StatusBadRequest)
+		return 0, false
+	}
+
+	return length, true
+}
+
+// uploadDigests collects the integrity checks sent with an upload: a
+// Content-MD5 header and at most one additional checksum. The checksum's
+// value comes from its x-amz-checksum-* header or, for aws-chunked bodies,
+// from the trailer named by x-amz-trailer, which the chunk decoder checks.
+// x-amz-sdk-checksum-algorithm alone asks for the checksum to be computed.
+// It writes the S3 error and returns false when a value is malformed.
+func uploadDigests(w http.ResponseWriter, r *http.Request) (cradle.Digests, bool) {
+	var digests cradle.Digests
+
+	if header := r.Header.Get("Content-MD5"); header != "" {
+		digest, err := base64.StdEncoding.DecodeString(header)
+		if err != nil || len(digest) != md5.Size {
+			respond.Error(w, r, "InvalidDigest", http.StatusBadRequest)
+			return cradle.Digests{}, false
+		}
+		digests.MD5 = digest
+	}
+
+	for _, algorithm := range checksum.Algorithms {
+		value := r.Header.Get(checksum.Header(algorithm))
+		if value == "" {
+			continue
+		}
+		if digests.ChecksumAlgorithm != "" {
+			respond.Error(w, r, "InvalidRequest", http.StatusBadRequest)
+			return cradle.Digests{}, false
+		}
+		if !checksum.Valid(algorithm, value) {
+			respond.Error(w, r, "InvalidDigest", http.StatusBadRequest)
+			return cradle.Digests{}, false
+		}
+		digests.ChecksumAlgorithm = algorithm
+		digests.Checksum = value
+	}
+	if digests.ChecksumAlgorithm != "" {
+		return digests, true
+	}
+
+	if awschunked.IsChunked(r) {
+		if algorithm, ok := checksum.FromHeader(r.Header.Get("x-amz-trailer")); ok {
+			digests.ChecksumAlgorithm = algorithm
+			return digests, true
+		}
+	}
+
+	if name := r.Header.Get("x-amz-sdk-checksum-algorithm"); name != "" {
+		if _, ok := checksum.New(name); !ok {
+			respond.Error(w, r, "InvalidRequest", http.StatusBadRequest)
+			return cradle.Digests{}, false
+		}
+		digests.ChecksumAlgorithm = strings.ToUpper(name)
+	}
+	return digests, true
+}
+
+// setChecksumHeader echoes an additional checksum in its x-amz-checksum-*
+// header, if the upload had one.
+func setChecksumHeader(w http.ResponseWriter, algorithm, value string) {
+	if algorithm == "" || value == "" {
+		return
+	}
+	w.Header().Set(checksum.Header(algorithm), value)
+}
+
+// respondPlanError maps a failed PlanWrite to its S3 error.
+func respondPlanError(w http.ResponseWriter, r *http.Request, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+		return
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		respond.Error(w, r, "NoSuchBucket", http.StatusNotFound)
+	case codes.InvalidArgument:
+		respond.Error(w, r, st.Message(), http.StatusBadRequest)
+	case codes.PermissionDenied:
+		respond.Error(w, r, "AccessDenied", http.StatusForbidden)
+	case codes.FailedPrecondition:
+		logger.LogGantryError(r, err)
+		respond.Error(w, r, "ServiceUnavailable", http.StatusServiceUnavailable)
+	default:
+		logger.LogGantryError(r, err)
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+	}
+}
+
+// respondWriteError maps a failed Cradle write to its S3 error. Errors from
+// checking or decoding the request body are the client's; anything else is
+// ours.
+func respondWriteError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, sigv4.ErrContentSHA256Mismatch):
+		respond.Error(w, r, "XAmzContentSHA256Mismatch", http.StatusBadRequest)
+	case errors.Is(err, awschunked.ErrSignatureMismatch):
+		respond.Error(w, r, "SignatureDoesNotMatch", http.StatusForbidden)
+	case errors.Is(err, awschunked.ErrChecksumMismatch), errors.Is(err, cradle.ErrBadDigest):
+		respond.Error(w, r, "BadDigest", http.StatusBadRequest)
+	case errors.Is(err, awschunked.ErrIncompleteBody), errors.Is(err, cradle.ErrIncompleteBody):
+		respond.Error(w, r, "IncompleteBody", http.StatusBadRequest)
+	case errors.Is(err, awschunked.ErrMalformed):
+		respond.Error(w, r, "InvalidRequest", http.StatusBadRequest)
+	default:
+		logger.LogCradleError(r, err)
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+	}
+}
@@ -3,6 +3,7 @@ package handlers_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -10,9 +11,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/ratdaddy/blockcloset/flatbed/internal/awschunked"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/cradle"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
 	"github.com/ratdaddy/blockcloset/pkg/validation"
@@ -42,12 +47,14 @@ func TestPutObject_ValidationGantryAndResponse(t *testing.T) {
 		key              string
 		contentLength    string // empty string means omit header
 		transferEncoding string // if set, adds Transfer-Encoding header
+		contentType      string
 		gantryErr        error
 		wantStatus       int
 		wantResolves     int
 		wantBucket       string
 		wantKey          string
 		wantSize         int64
+		wantContentType  string
 		wantBodySubstr   string
 	}
 
@@ -63,6 +70,19 @@ func TestPutObject_ValidationGantryAndResponse(t *testing.T) {
 			wantKey:       "my-key",
 			wantSize:      1024,
 		},
+		{
+			name:            "content type is passed to PlanWrite",
+			bucket:          "my-bucket",
+			key:             "photo.jpg",
+			contentLength:   "1024",
+			contentType:     "image/jpeg",
+			wantStatus:      http.StatusOK,
+			wantResolves:    1,
+			wantBucket:      "my-bucket",
+			wantKey:         "photo.jpg",
+			wantSize:        1024,
+			wantContentType: "image/jpeg",
+		},
 		{
 			name:           "missing Content-Length -> 411",
 			bucket:         "my-bucket",
@@ -191,7 +211,7 @@ func TestPutObject_ValidationGantryAndResponse(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			stub := testutil.NewGantryStub()
 			if c.gantryErr != nil {
-				stub.PlanWriteFn = func(context.Context, string, string, int64) (*writeplanv1.WritePlan, error) {
+				stub.PlanWriteFn = func(context.Context, string, string, int64, string, gantry.ObjectMetadata, map[string]string, gantry.ObjectLock) (*writeplanv1.WritePlan, error) {
 					return nil, c.gantryErr
 				}
 			}
@@ -208,6 +228,9 @@ func TestPutObject_ValidationGantryAndResponse(t *testing.T) {
 			if c.contentLength != "" {
 				req.Header.Set("Content-Length", c.contentLength)
 			}
+			if c.contentType != "" {
+				req.Header.Set("Content-Type", c.contentType)
+			}
 			if c.transferEncoding != "" {
 				// Set TransferEncoding field directly (httptest doesn't process headers like real server)
 				req.TransferEncoding = []string{c.transferEncoding}
@@ -238,6 +261,9 @@ func TestPutObject_ValidationGantryAndResponse(t *testing.T) {
 				if call.Size != c.wantSize {
 					t.Fatalf("PlanWrite size: got %d, want %d", call.Size, c.wantSize)
 				}
+				if call.ContentType != c.wantContentType {
+					t.Fatalf("PlanWrite content type: got %q, want %q", call.ContentType, c.wantContentType)
+				}
 			}
 
 			if c.wantBodySubstr != "" {
@@ -314,6 +340,27 @@ func TestPutObject_CradleIntegration(t *testing.T) {
 			wantBodySubstr:    "InternalError",
 			wantCommitCalls:   0,
 		},
+		{
+			name:          "body shorter than Content-Length returns 400 IncompleteBody",
+			bucket:        "photos",
+			key:           "vacation.jpg",
+			contentLength: "17",
+			body:          "test file content",
+			planWriteResp: &writeplanv1.WritePlan{
+				ObjectId:      "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+				CradleAddress: "localhost:9444",
+			},
+			cradleErr:         fmt.Errorf("%w: read 10 bytes, expected 17", cradle.ErrIncompleteBody),
+			wantStatus:        http.StatusBadRequest,
+			wantCradleCalls:   1,
+			wantCradleAddress: "localhost:9444",
+			wantCradleObjID:   "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			wantCradleBucket:  "photos",
+			wantCradleSize:    17,
+			wantCradleBody:    "test file content",
+			wantBodySubstr:    "IncompleteBody",
+			wantCommitCalls:   0,
+		},
 		{
 			name:               "size mismatch returns 500",
 			bucket:             "photos",
@@ -342,19 +389,19 @@ func TestPutObject_CradleIntegration(t *testing.T) {
 			t.Parallel()
 
 			gantryStub := testutil.NewGantryStub()
-			gantryStub.PlanWriteFn = func(ctx context.Context, bucket, key string, size int64) (*writeplanv1.WritePlan, error) {
+			gantryStub.PlanWriteFn = func(ctx context.Context, bucket, key string, size int64, contentType string, metadata gantry.ObjectMetadata, tags map[string]string, lock gantry.ObjectLock) (*writeplanv1.WritePlan, error) {
 				return c.planWriteResp, nil
 			}
 
 			cradleStub := testutil.NewCradleStub()
 			if c.cradleErr != nil {
-				cradleStub.WriteObjectFn = func(ctx context.Context, address, objectID, bucket string, size int64, body io.Reader) (int64, int64, error) {
-					return 0, 0, c.cradleErr
+				cradleStub.WriteObjectFn = func(ctx context.Context, address, objectID, bucket string, size int64, body io.Reader, digests cradle.Digests) (cradle.WriteResult, error) {
+					return cradle.WriteResult{}, c.cradleErr
 				}
 			}
 			if c.cradleBytesWritten != 0 {
-				cradleStub.WriteObjectFn = func(ctx context.Context, address, objectID, bucket string, size int64, body io.Reader) (int64, int64, error) {
-					return c.cradleBytesWritten, 0, nil
+				cradleStub.WriteObjectFn = func(ctx context.Context, address, objectID, bucket string, size int64, body io.Reader, digests cradle.Digests) (cradle.WriteResult, error) {
+					return cradle.WriteResult{BytesWritten: c.cradleBytesWritten}, nil
 				}
 			}
 
@@ -419,23 +466,64 @@ func TestPutObject_CommitObject(t *testing.T) {
 	t.Parallel()
 
 	type tc struct {
-		name           string
-		commitErr      error
-		wantStatus     int
-		wantBodySubstr string
+		name            string
+		ifNoneMatch     string
+		versionID       string
+		commitErr       error
+		wantStatus      int
+		wantCommits     int
+		wantIfNoneMatch bool
+		wantBodySubstr  string
 	}
 
 	cases := []tc{
 		{
-			name:       "successful commit returns 200",
-			wantStatus: http.StatusOK,
+			name:        "successful commit returns 200",
+			wantStatus:  http.StatusOK,
+			wantCommits: 1,
+		},
+		{
+			name:        "versioned bucket returns x-amz-version-id",
+			versionID:   "01JVERSION",
+			wantStatus:  http.StatusOK,
+			wantCommits: 1,
 		},
 		{
 			name:           "commit failure returns 500",
 			commitErr:      errors.New("gantry unavailable"),
 			wantStatus:     http.StatusInternalServerError,
+			wantCommits:    1,
 			wantBodySubstr: "InternalError",
 		},
+		{
+			name:            "If-None-Match * is passed to commit",
+			ifNoneMatch:     "*",
+			wantStatus:      http.StatusOK,
+			wantCommits:     1,
+			wantIfNoneMatch: true,
+		},
+		{
+			name:            "If-None-Match * on existing key returns 412",
+			ifNoneMatch:     "*",
+			commitErr:       status.Error(codes.FailedPrecondition, "PreconditionFailed"),
+			wantStatus:      http.StatusPreconditionFailed,
+			wantCommits:     1,
+			wantIfNoneMatch: true,
+			wantBodySubstr:  "PreconditionFailed",
+		},
+		{
+			name:           "replacing a locked version returns 403",
+			commitErr:      status.Error(codes.PermissionDenied, "AccessDenied"),
+			wantStatus:     http.StatusForbidden,
+			wantCommits:    1,
+			wantBodySubstr: "AccessDenied",
+		},
+		{
+			name:           "If-None-Match with an ETag returns 501",
+			ifNoneMatch:    `"some-etag"`,
+			wantStatus:     http.StatusNotImplemented,
+			wantBodySubstr: "NotImplemented",
+		},
 	}
 
 	for _, c := range cases {
@@ -445,10 +533,8 @@ func TestPutObject_CommitObject(t *testing.T) {
 			gantryStub := testutil.NewGantryStub()
 			cradleStub := testutil.NewCradleStub()
 
-			if c.commitErr != nil {
-				gantryStub.CommitObjectFn = func(context.Context, string, int64, int64) error {
-					return c.commitErr
-				}
+			gantryStub.CommitObjectFn = func(context.Context, string, gantry.ObjectCommit) (string, error) {
+				return c.versionID, c.commitErr
 			}
 
 			h := &handlers.Handlers{
@@ -462,6 +548,9 @@ func TestPutObject_CommitObject(t *testing.T) {
 			req.SetPathValue("bucket", "photos")
 			req.SetPathValue("key", "vacation.jpg")
 			req.Header.Set("Content-Length", "17")
+			if c.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", c.ifNoneMatch)
+			}
 			rec := httptest.NewRecorder()
 
 			h.PutObject(rec, req)
@@ -470,10 +559,16 @@ func TestPutObject_CommitObject(t *testing.T) {
 				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
 			}
 
-			if c.wantStatus == http.StatusOK {
-				if got := gantryStub.CommitObjectCount(); got != 1 {
-					t.Fatalf("CommitObject call count: got %d, want 1", got)
+			if got := gantryStub.CommitObjectCount(); got != c.wantCommits {
+				t.Fatalf("CommitObject call count: got %d, want %d", got, c.wantCommits)
+			}
+			if c.wantCommits > 0 {
+				if got := gantryStub.CommitObjectCalls[0].IfNoneMatch; got != c.wantIfNoneMatch {
+					t.Fatalf("CommitObject IfNoneMatch: got %v, want %v", got, c.wantIfNoneMatch)
 				}
+			}
+
+			if c.wantStatus == http.StatusOK {
 				call := gantryStub.CommitObjectCalls[0]
 				if call.ObjectID != "stub-object-id" {
 					t.Fatalf("CommitObject ObjectID: got %q, want %q", call.ObjectID, "stub-object-id")
@@ -484,13 +579,19 @@ func TestPutObject_CommitObject(t *testing.T) {
 				if call.LastModifiedMs != 1234567890 {
 					t.Fatalf("CommitObject LastModifiedMs: got %d, want 1234567890", call.LastModifiedMs)
 				}
-				if got := rec.Header().Get("ETag"); got != `"stub-object-id"` {
-					t.Fatalf("ETag: got %q, want %q", got, `"stub-object-id"`)
+				if call.ETag != testBodyMD5 {
+					t.Fatalf("CommitObject ETag: got %q, want %q", call.ETag, testBodyMD5)
+				}
+				if got := rec.Header().Get("ETag"); got != `"`+testBodyMD5+`"` {
+					t.Fatalf("ETag: got %q, want %q", got, `"`+testBodyMD5+`"`)
 				}
 				wantLastModified := time.UnixMilli(1234567890).UTC().Format(time.RFC1123)
 				if got := rec.Header().Get("Last-Modified"); got != wantLastModified {
 					t.Fatalf("Last-Modified: got %q, want %q", got, wantLastModified)
 				}
+				if got := rec.Header().Get("x-amz-version-id"); got != c.versionID {
+					t.Fatalf("x-amz-version-id: got %q, want %q", got, c.versionID)
+				}
 			}
 
 			if c.wantBodySubstr != "" {
@@ -502,3 +603,691 @@ func TestPutObject_CommitObject(t *testing.T) {
 		})
 	}
 }
+
+// testBodyMD5 is the hex MD5 of "test file content".
+const testBodyMD5 = "c785060c866796cc2a1708c997154c8e"
+
+func TestPutObject_ContentMD5(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name            string
+		contentMD5      string
+		wantStatus      int
+		wantCradleCalls int
+		wantCommits     int
+		wantBodySubstr  string
+	}
+
+	cases := []tc{
+		{
+			name:            "matching Content-MD5 commits the object",
+			contentMD5:      "x4UGDIZnlswqFwjJlxVMjg==",
+			wantStatus:      http.StatusOK,
+			wantCradleCalls: 1,
+			wantCommits:     1,
+		},
+		{
+			name:            "mismatched Content-MD5 returns BadDigest without committing",
+			contentMD5:      "eV8yArF8trw9S3cdjGyerw==",
+			wantStatus:      http.StatusBadRequest,
+			wantCradleCalls: 1,
+			wantBodySubstr:  "BadDigest",
+		},
+		{
+			name:           "Content-MD5 that isn't base64 returns InvalidDigest",
+			contentMD5:     "not-base64!",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidDigest",
+		},
+		{
+			name:           "Content-MD5 of the wrong length returns InvalidDigest",
+			contentMD5:     "dGVzdA==",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidDigest",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			cradleStub := testutil.NewCradleStub()
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          cradleStub,
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader("test file content"))
+			req.SetPathValue("bucket", "photos")
+			req.SetPathValue("key", "vacation.jpg")
+			req.Header.Set("Content-Length", "17")
+			req.Header.Set("Content-MD5", c.contentMD5)
+			rec := httptest.NewRecorder()
+
+			h.PutObject(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+			if got := cradleStub.WriteObjectCount(); got != c.wantCradleCalls {
+				t.Fatalf("WriteObject call count: got %d, want %d", got, c.wantCradleCalls)
+			}
+			if c.wantCradleCalls > 0 && len(cradleStub.WriteObjectCalls[0].Digests.MD5) != 16 {
+				t.Fatalf("WriteObject Digests.MD5: got %x, want the decoded header", cradleStub.WriteObjectCalls[0].Digests.MD5)
+			}
+			if got := gantryStub.CommitObjectCount(); got != c.wantCommits {
+				t.Fatalf("CommitObject call count: got %d, want %d", got, c.wantCommits)
+			}
+			if c.wantStatus == http.StatusOK {
+				if got := rec.Header().Get("ETag"); got != `"`+testBodyMD5+`"` {
+					t.Fatalf("ETag: got %q, want %q", got, `"`+testBodyMD5+`"`)
+				}
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPutObject_Checksum(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		body           string
+		headers        map[string]string
+		wantStatus     int
+		wantAlgorithm  string
+		wantChecksum   string
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name:          "matching checksum header is stored and echoed",
+			body:          "test file content",
+			headers:       map[string]string{"Content-Length": "17", "X-Amz-Checksum-Crc32": "YdFDLw=="},
+			wantStatus:    http.StatusOK,
+			wantAlgorithm: "CRC32",
+			wantChecksum:  "YdFDLw==",
+		},
+		{
+			name:          "sdk algorithm alone computes the checksum",
+			body:          "test file content",
+			headers:       map[string]string{"Content-Length": "17", "X-Amz-Sdk-Checksum-Algorithm": "sha256"},
+			wantStatus:    http.StatusOK,
+			wantAlgorithm: "SHA256",
+			wantChecksum:  "YPUjftQEnwOCZh7wCdK8QuSMPOs+22YA9wJOerO4OPM=",
+		},
+		{
+			name: "aws-chunked trailer checksum is stored",
+			body: "b\r\nhello world\r\n0\r\nx-amz-checksum-crc32:DUoRhQ==\r\n\r\n",
+			headers: map[string]string{
+				"X-Amz-Content-Sha256":         awschunked.StreamingUnsignedTrailer,
+				"X-Amz-Decoded-Content-Length": "11",
+				"X-Amz-Trailer":                "x-amz-checksum-crc32",
+			},
+			wantStatus:    http.StatusOK,
+			wantAlgorithm: "CRC32",
+			wantChecksum:  "DUoRhQ==",
+		},
+		{
+			name:           "mismatched checksum returns BadDigest",
+			body:           "test file content",
+			headers:        map[string]string{"Content-Length": "17", "X-Amz-Checksum-Sha1": "Kq5sNclPz7QV2+lfQIuc6R7oRu0="},
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "BadDigest",
+		},
+		{
+			name:           "checksum of the wrong length returns InvalidDigest",
+			body:           "test file content",
+			headers:        map[string]string{"Content-Length": "17", "X-Amz-Checksum-Sha256": "YdFDLw=="},
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidDigest",
+		},
+		{
+			name: "two checksum headers return InvalidRequest",
+			body: "test file content",
+			headers: map[string]string{
+				"Content-Length":        "17",
+				"X-Amz-Checksum-Crc32":  "YdFDLw==",
+				"X-Amz-Checksum-Crc32c": "yZRlqg==",
+			},
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidRequest",
+		},
+		{
+			name:           "unknown sdk algorithm returns InvalidRequest",
+			body:           "test file content",
+			headers:        map[string]string{"Content-Length": "17", "X-Amz-Sdk-Checksum-Algorithm": "MD5"},
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidRequest",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			cradleStub := testutil.NewCradleStub()
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          cradleStub,
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(c.body))
+			req.SetPathValue("bucket", "photos")
+			req.SetPathValue("key", "vacation.jpg")
+			for k, v := range c.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+
+			h.PutObject(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d (body %q)", rec.Code, c.wantStatus, rec.Body.String())
+			}
+
+			if c.wantStatus != http.StatusOK {
+				if got := gantryStub.CommitObjectCount(); got != 0 {
+					t.Fatalf("CommitObject call count: got %d, want 0", got)
+				}
+				if !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+					t.Fatalf("body: expected %q, got %q", c.wantBodySubstr, rec.Body.String())
+				}
+				return
+			}
+
+			if got := gantryStub.CommitObjectCount(); got != 1 {
+				t.Fatalf("CommitObject call count: got %d, want 1", got)
+			}
+			call := gantryStub.CommitObjectCalls[0]
+			if call.ChecksumAlgorithm != c.wantAlgorithm || call.Checksum != c.wantChecksum {
+				t.Fatalf("CommitObject checksum: got %s %q, want %s %q", call.ChecksumAlgorithm, call.Checksum, c.wantAlgorithm, c.wantChecksum)
+			}
+
+			header := "x-amz-checksum-" + strings.ToLower(c.wantAlgorithm)
+			if got := rec.Header().Get(header); got != c.wantChecksum {
+				t.Fatalf("%s: got %q, want %q", header, got, c.wantChecksum)
+			}
+		})
+	}
+}
+
+func TestPutObject_Metadata(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		body           string
+		headers        map[string][]string
+		planErr        error
+		wantStatus     int
+		wantMetadata   gantry.ObjectMetadata
+		wantTags       map[string]string
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name: "standard headers and user metadata are planned with the object",
+			headers: map[string][]string{
+				"Content-Encoding":    {"gzip"},
+				"Content-Disposition": {`attachment; filename="notes.txt"`},
+				"Cache-Control":       {"max-age=3600"},
+				"Expires":             {"Thu, 01 Dec 2094 16:00:00 GMT"},
+				"X-Amz-Meta-Color":    {"blue"},
+				"X-Amz-Meta-Tags":     {"a", "b"},
+			},
+			wantStatus: http.StatusOK,
+			wantMetadata: gantry.ObjectMetadata{
+				ContentEncoding:    "gzip",
+				ContentDisposition: `attachment; filename="notes.txt"`,
+				CacheControl:       "max-age=3600",
+				Expires:            "Thu, 01 Dec 2094 16:00:00 GMT",
+				User:               map[string]string{"color": "blue", "tags": "a,b"},
+			},
+		},
+		{
+			name: "aws-chunked is dropped from Content-Encoding",
+			body: "11\r\ntest file content\r\n0\r\n\r\n",
+			headers: map[string][]string{
+				"Content-Encoding":             {"aws-chunked,gzip"},
+				"X-Amz-Decoded-Content-Length": {"17"},
+			},
+			wantStatus:   http.StatusOK,
+			wantMetadata: gantry.ObjectMetadata{ContentEncoding: "gzip"},
+		},
+		{
+			name:           "metadata over the limit -> 400 MetadataTooLarge",
+			headers:        map[string][]string{"X-Amz-Meta-Notes": {strings.Repeat("a", 2048)}},
+			planErr:        status.Error(codes.InvalidArgument, "MetadataTooLarge"),
+			wantStatus:     http.StatusBadRequest,
+			wantMetadata:   gantry.ObjectMetadata{User: map[string]string{"notes": strings.Repeat("a", 2048)}},
+			wantBodySubstr: "MetadataTooLarge",
+		},
+		{
+			name:       "x-amz-tagging is planned with the object",
+			headers:    map[string][]string{"X-Amz-Tagging": {"host=web-1&purpose=nightly%20backup"}},
+			wantStatus: http.StatusOK,
+			wantTags:   map[string]string{"host": "web-1", "purpose": "nightly backup"},
+		},
+		{
+			name:           "tags rejected by gantry -> 400 InvalidTag",
+			headers:        map[string][]string{"X-Amz-Tagging": {"aws:host=web-1"}},
+			planErr:        status.Error(codes.InvalidArgument, "InvalidTag"),
+			wantStatus:     http.StatusBadRequest,
+			wantTags:       map[string]string{"aws:host": "web-1"},
+			wantBodySubstr: "InvalidTag",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			if c.planErr != nil {
+				gantryStub.PlanWriteFn = func(context.Context, string, string, int64, string, gantry.ObjectMetadata, map[string]string, gantry.ObjectLock) (*writeplanv1.WritePlan, error) {
+					return nil, c.planErr
+				}
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			body := c.body
+			if body == "" {
+				body = "test file content"
+			}
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+			req.SetPathValue("bucket", "photos")
+			req.SetPathValue("key", "notes.txt")
+			req.Header.Set("Content-Length", "17")
+			for k, vs := range c.headers {
+				for _, v := range vs {
+					req.Header.Add(k, v)
+				}
+			}
+			rec := httptest.NewRecorder()
+
+			h.PutObject(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d (body %q)", rec.Code, c.wantStatus, rec.Body.String())
+			}
+			if got := gantryStub.PlanWriteCount(); got != 1 {
+				t.Fatalf("PlanWrite call count: got %d, want 1", got)
+			}
+			if diff := cmp.Diff(c.wantMetadata, gantryStub.PlanWriteCalls[0].Metadata); diff != "" {
+				t.Fatalf("PlanWrite metadata diff (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(c.wantTags, gantryStub.PlanWriteCalls[0].Tags); diff != "" {
+				t.Fatalf("PlanWrite tags diff (-want +got):\n%s", diff)
+			}
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
+
+// rejectingVerifier fails every chunk signature, standing in for an
+// authenticated request whose chunks were tampered with.
+type rejectingVerifier struct{}
+
+func (rejectingVerifier) VerifyChunk(string, []byte) error   { return awschunked.ErrSignatureMismatch }
+func (rejectingVerifier) VerifyTrailer(string, []byte) error { return awschunked.ErrSignatureMismatch }
+
+func TestPutObject_AWSChunked(t *testing.T) {
+	t.Parallel()
+
+	const (
+		unsignedBody = "6\r\nhello \r\n5\r\nworld\r\n0\r\nx-amz-checksum-crc32:DUoRhQ==\r\n\r\n"
+		signedBody   = "b;chunk-signature=abc\r\nhello world\r\n0;chunk-signature=def\r\n\r\n"
+	)
+
+	type tc struct {
+		name           string
+		body           string
+		headers        map[string]string
+		verifier       awschunked.Verifier
+		wantStatus     int
+		wantPlanSize   int64
+		wantCradleBody string
+		wantCommits    int
+		wantBodySubstr string
+	}
+
+	cases := []tc{
+		{
+			name: "unsigned chunks with trailing checksum -> 200",
+			body: unsignedBody,
+			headers: map[string]string{
+				"Content-Encoding":             "aws-chunked",
+				"X-Amz-Content-Sha256":         awschunked.StreamingUnsignedTrailer,
+				"X-Amz-Decoded-Content-Length": "11",
+				"X-Amz-Trailer":                "x-amz-checksum-crc32",
+			},
+			wantStatus:     http.StatusOK,
+			wantPlanSize:   11,
+			wantCradleBody: "hello world",
+			wantCommits:    1,
+		},
+		{
+			name: "signed chunks without auth -> 200",
+			body: signedBody,
+			headers: map[string]string{
+				"X-Amz-Content-Sha256":         awschunked.StreamingSigned,
+				"X-Amz-Decoded-Content-Length": "11",
+			},
+			wantStatus:     http.StatusOK,
+			wantPlanSize:   11,
+			wantCradleBody: "hello world",
+			wantCommits:    1,
+		},
+		{
+			name: "signed chunks failing verification -> 403",
+			body: signedBody,
+			headers: map[string]string{
+				"X-Amz-Content-Sha256":         awschunked.StreamingSigned,
+				"X-Amz-Decoded-Content-Length": "11",
+			},
+			verifier:       rejectingVerifier{},
+			wantStatus:     http.StatusForbidden,
+			wantPlanSize:   11,
+			wantBodySubstr: "SignatureDoesNotMatch",
+		},
+		{
+			name: "unsigned trailer skips verification -> 200",
+			body: unsignedBody,
+			headers: map[string]string{
+				"X-Amz-Content-Sha256":         awschunked.StreamingUnsignedTrailer,
+				"X-Amz-Decoded-Content-Length": "11",
+				"X-Amz-Trailer":                "x-amz-checksum-crc32",
+			},
+			verifier:       rejectingVerifier{},
+			wantStatus:     http.StatusOK,
+			wantPlanSize:   11,
+			wantCradleBody: "hello world",
+			wantCommits:    1,
+		},
+		{
+			name: "trailing checksum mismatch -> 400 BadDigest",
+			body: "b\r\nhello world\r\n0\r\nx-amz-checksum-crc32:AAAAAA==\r\n\r\n",
+			headers: map[string]string{
+				"X-Amz-Content-Sha256":         awschunked.StreamingUnsignedTrailer,
+				"X-Amz-Decoded-Content-Length": "11",
+				"X-Amz-Trailer":                "x-amz-checksum-crc32",
+			},
+			wantStatus:     http.StatusBadRequest,
+			wantPlanSize:   11,
+			wantBodySubstr: "BadDigest",
+		},
+		{
+			name: "decoded length disagrees with body -> 400 IncompleteBody",
+			body: signedBody,
+			headers: map[string]string{
+				"Content-Encoding":             "aws-chunked",
+				"X-Amz-Decoded-Content-Length": "20",
+			},
+			wantStatus:     http.StatusBadRequest,
+			wantPlanSize:   20,
+			wantBodySubstr: "IncompleteBody",
+		},
+		{
+			name: "malformed framing -> 400 InvalidRequest",
+			body: "hello world",
+			headers: map[string]string{
+				"Content-Encoding":             "aws-chunked",
+				"X-Amz-Decoded-Content-Length": "11",
+			},
+			wantStatus:     http.StatusBadRequest,
+			wantPlanSize:   11,
+			wantBodySubstr: "InvalidRequest",
+		},
+		{
+			name: "missing decoded length -> 411",
+			body: signedBody,
+			headers: map[string]string{
+				"Content-Encoding": "aws-chunked",
+			},
+			wantStatus:     http.StatusLengthRequired,
+			wantBodySubstr: "MissingContentLength",
+		},
+		{
+			name: "unsupported trailer -> 400",
+			body: unsignedBody,
+			headers: map[string]string{
+				"X-Amz-Content-Sha256":         awschunked.StreamingUnsignedTrailer,
+				"X-Amz-Decoded-Content-Length": "11",
+				"X-Amz-Trailer":                "x-amz-checksum-md5",
+			},
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidRequest",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			cradleStub := testutil.NewCradleStub()
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          cradleStub,
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(c.body))
+			req.SetPathValue("bucket", "photos")
+			req.SetPathValue("key", "vacation.jpg")
+			req.TransferEncoding = []string{"chunked"}
+			for k, v := range c.headers {
+				req.Header.Set(k, v)
+			}
+			if c.verifier != nil {
+				req = req.WithContext(awschunked.WithVerifier(req.Context(), c.verifier))
+			}
+			rec := httptest.NewRecorder()
+
+			h.PutObject(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d (body %q)", rec.Code, c.wantStatus, rec.Body.String())
+			}
+
+			if c.wantPlanSize != 0 {
+				if got := gantryStub.PlanWriteCount(); got != 1 {
+					t.Fatalf("PlanWrite call count: got %d, want 1", got)
+				}
+				if got := gantryStub.PlanWriteCalls[0].Size; got != c.wantPlanSize {
+					t.Fatalf("PlanWrite size: got %d, want %d", got, c.wantPlanSize)
+				}
+			}
+
+			if c.wantCradleBody != "" {
+				if got := string(cradleStub.WriteObjectCalls[0].BodyBytes); got != c.wantCradleBody {
+					t.Fatalf("WriteObject body: got %q, want %q", got, c.wantCradleBody)
+				}
+			}
+
+			if got := gantryStub.CommitObjectCount(); got != c.wantCommits {
+				t.Fatalf("CommitObject call count: got %d, want %d", got, c.wantCommits)
+			}
+
+			if c.wantBodySubstr != "" {
+				if body := rec.Body.String(); !strings.Contains(body, c.wantBodySubstr) {
+					t.Fatalf("body: expected %q, got %q", c.wantBodySubstr, body)
+				}
+			}
+		})
+	}
+}
+
+func TestPutObject_MalformedTagging(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		header   string
+		wantCode string
+	}{
+		{name: "repeated key -> 400 InvalidTag", header: "host=web-1&host=web-2", wantCode: "InvalidTag"},
+		{name: "bad escape -> 400 InvalidArgument", header: "host=web%zz", wantCode: "InvalidArgument"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader("test file content"))
+			req.SetPathValue("bucket", "photos")
+			req.SetPathValue("key", "notes.txt")
+			req.Header.Set("Content-Length", "17")
+			req.Header.Set("x-amz-tagging", c.header)
+			rec := httptest.NewRecorder()
+
+			h.PutObject(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("status: got %d, want 400 (body %q)", rec.Code, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), c.wantCode) {
+				t.Fatalf("body: expected %q, got %q", c.wantCode, rec.Body.String())
+			}
+			if got := gantryStub.PlanWriteCount(); got != 0 {
+				t.Fatalf("PlanWrite call count: got %d, want 0", got)
+			}
+		})
+	}
+}
+
+func TestPutObject_ObjectLock(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		headers    map[string]string
+		planErr    error
+		wantStatus int
+		wantPlan   bool
+		wantLock   gantry.ObjectLock
+		wantCode   string
+	}{
+		{
+			name: "retention and legal hold are planned with the object",
+			headers: map[string]string{
+				"x-amz-object-lock-mode":              "COMPLIANCE",
+				"x-amz-object-lock-retain-until-date": "2025-04-01T00:00:00.000Z",
+				"x-amz-object-lock-legal-hold":        "ON",
+			},
+			wantStatus: http.StatusOK,
+			wantPlan:   true,
+			wantLock:   gantry.ObjectLock{Mode: "COMPLIANCE", RetainUntil: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC), LegalHold: true},
+		},
+		{
+			name:       "legal hold off",
+			headers:    map[string]string{"x-amz-object-lock-legal-hold": "OFF"},
+			wantStatus: http.StatusOK,
+			wantPlan:   true,
+		},
+		{
+			name:       "mode without a date -> 400 InvalidArgument",
+			headers:    map[string]string{"x-amz-object-lock-mode": "GOVERNANCE"},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "InvalidArgument",
+		},
+		{
+			name:       "malformed date -> 400 InvalidArgument",
+			headers:    map[string]string{"x-amz-object-lock-mode": "GOVERNANCE", "x-amz-object-lock-retain-until-date": "April 1"},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "InvalidArgument",
+		},
+		{
+			name:       "unknown legal hold status -> 400 InvalidArgument",
+			headers:    map[string]string{"x-amz-object-lock-legal-hold": "YES"},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "InvalidArgument",
+		},
+		{
+			name:       "bucket without object lock -> 400 InvalidRequest",
+			headers:    map[string]string{"x-amz-object-lock-legal-hold": "ON"},
+			planErr:    status.Error(codes.InvalidArgument, "InvalidRequest"),
+			wantStatus: http.StatusBadRequest,
+			wantPlan:   true,
+			wantLock:   gantry.ObjectLock{LegalHold: true},
+			wantCode:   "InvalidRequest",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			if c.planErr != nil {
+				gantryStub.PlanWriteFn = func(context.Context, string, string, int64, string, gantry.ObjectMetadata, map[string]string, gantry.ObjectLock) (*writeplanv1.WritePlan, error) {
+					return nil, c.planErr
+				}
+			}
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          testutil.NewCradleStub(),
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader("test file content"))
+			req.SetPathValue("bucket", "backups")
+			req.SetPathValue("key", "db.tar")
+			req.Header.Set("Content-Length", "17")
+			for k, v := range c.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+
+			h.PutObject(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d (body %q)", rec.Code, c.wantStatus, rec.Body.String())
+			}
+			if !c.wantPlan {
+				if got := gantryStub.PlanWriteCount(); got != 0 {
+					t.Fatalf("PlanWrite call count: got %d, want 0", got)
+				}
+			} else if got := gantryStub.PlanWriteCalls; len(got) != 1 || got[0].Lock != c.wantLock {
+				t.Fatalf("PlanWrite calls: got %+v, want lock %+v", got, c.wantLock)
+			}
+			if c.wantCode != "" && !strings.Contains(rec.Body.String(), c.wantCode) {
+				t.Fatalf("body: expected %q, got %q", c.wantCode, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+)
+
+var errUnsatisfiableRange = errors.New("unsatisfiable range")
+
+// byteRange is an inclusive byte range within an object.
+type byteRange struct {
+	start int64
+	end   int64
+}
+
+func (br byteRange) length() int64 {
+	return br.end - br.start + 1
+}
+
+func (br byteRange) contentRange(size int64) string {
+	return fmt.Sprintf("bytes %d-%d/%d", br.start, br.end, size)
+}
+
+// parseRange resolves a Range header against an object of the given size.
+// Only a single byte range is supported; like S3, anything else (multiple
+// ranges, other units, bad syntax) is ignored and ok is false so the whole
+// object is served. A well-formed range that starts past the end returns
+// errUnsatisfiableRange.
+func parseRange(header string, size int64) (br byteRange, ok bool, err error) {
+	spec, found := strings.CutPrefix(strings.TrimSpace(header), "bytes=")
+	if !found || strings.Contains(spec, ",") {
+		return byteRange{}, false, nil
+	}
+
+	first, last, found := strings.Cut(strings.TrimSpace(spec), "-")
+	if !found {
+		return byteRange{}, false, nil
+	}
+
+	// bytes=-N asks for the last N bytes.
+	if first == "" {
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n < 0 {
+			return byteRange{}, false, nil
+		}
+		if n == 0 || size == 0 {
+			return byteRange{}, false, errUnsatisfiableRange
+		}
+		return byteRange{start: max(size-n, 0), end: size - 1}, true, nil
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return byteRange{}, false, nil
+	}
+	end := size - 1
+	if last != "" {
+		end, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || end < start {
+			return byteRange{}, false, nil
+		}
+	}
+	if start >= size {
+		return byteRange{}, false, errUnsatisfiableRange
+	}
+
+	return byteRange{start: start, end: min(end, size-1)}, true, nil
+}
+
+// blobRead is one ReadObject call needed to serve a GET.
+type blobRead struct {
+	part   gantry.ObjectPart
+	offset int64
+	length int64 // 0 reads to the end of the blob
+}
+
+// wholeReads reads every part from start to end.
+func wholeReads(parts []gantry.ObjectPart) []blobRead {
+	reads := make([]blobRead, 0, len(parts))
+	for _, part := range parts {
+		reads = append(reads, blobRead{part: part})
+	}
+	return reads
+}
+
+// rangeReads maps br onto the parts it overlaps, so a range inside one part
+// of a multipart object only touches that part's cradle.
+func rangeReads(parts []gantry.ObjectPart, br byteRange) []blobRead {
+	var reads []blobRead
+	var partStart int64
+	for _, part := range parts {
+		partEnd := partStart + part.Size - 1
+		if part.Size > 0 && partEnd >= br.start && partStart <= br.end {
+			start := max(br.start, partStart)
+			end := min(br.end, partEnd)
+			reads = append(reads, blobRead{
+				part:   part,
+				offset: start - partStart,
+				length: end - start + 1,
+			})
+		}
+		partStart += part.Size
+	}
+	return reads
+}
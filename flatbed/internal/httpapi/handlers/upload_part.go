@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strconv"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+const maxPartNumber = 10000
+
+// UploadPart serves PUT /{bucket}/{key}?partNumber=N&uploadId=ID. Each part
+// is written to Cradle as its own blob; Gantry checks the part size limits
+// when it plans the write.
+func (h *Handlers) UploadPart(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+	key := r.PathValue("key")
+	uploadID := r.URL.Query().Get("uploadId")
+
+	body, contentLength, ok := uploadBody(w, r)
+	if !ok {
+		return
+	}
+
+	partNumber, err := strconv.ParseInt(r.URL.Query().Get("partNumber"), 10, 32)
+	if err != nil || partNumber < 1 || partNumber > maxPartNumber {
+		respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+		return
+	}
+
+	// Validate bucket name
+	if err := h.BucketValidator.ValidateBucketName(bucket); err != nil {
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	}
+
+	// Validate key
+	if err := h.KeyValidator.ValidateKey(key); err != nil {
+		respond.Error(w, r, "InvalidKeyName", http.StatusBadRequest)
+		return
+	}
+
+	digests, ok := uploadDigests(w, r)
+	if !ok {
+		return
+	}
+
+	writePlan, err := h.Gantry.PlanPart(r.Context(), bucket, key, uploadID, int32(partNumber), contentLength)
+	if err != nil {
+		respondUploadError(w, r, err)
+		return
+	}
+
+	blobID := writePlan.GetObjectId()
+	cradleAddress := writePlan.GetCradleAddress()
+
+	logger.LogWritePlan(r, blobID, cradleAddress, contentLength)
+
+	// Stream request body to Cradle
+	written, err := h.Cradle.WriteObject(r.Context(), cradleAddress, blobID, bucket, contentLength, body, digests)
+	if err != nil {
+		respondWriteError(w, r, err)
+		return
+	}
+
+	// Validate bytes written matches expected size
+	if written.BytesWritten != contentLength {
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+		return
+	}
+
+	etag, err := h.Gantry.CommitPart(r.Context(), blobID, written.BytesWritten, written.LastModifiedMs, hex.EncodeToString(written.MD5))
+	if err != nil {
+		respondUploadError(w, r, err)
+		return
+	}
+
+	w.Header().Set("ETag", `"`+etag+`"`)
+	setChecksumHeader(w, digests.ChecksumAlgorithm, written.Checksum)
+	w.WriteHeader(http.StatusOK)
+}
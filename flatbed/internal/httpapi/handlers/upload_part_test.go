@@ -0,0 +1,349 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/awschunked"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/cradle"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	writeplanv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/write_plan/v1"
+)
+
+func TestUploadPart(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name            string
+		bucket          string
+		key             string
+		query           string
+		body            string
+		contentMD5      string
+		checksumCRC32   string
+		planErr         error
+		cradleErr       error
+		commitErr       error
+		wantStatus      int
+		wantPlans       int
+		wantCradleCalls int
+		wantCommits     int
+		wantETag        string
+		wantChecksum    string
+		wantBodySubstr  string
+	}
+
+	cases := []tc{
+		{
+			name:            "part written and committed -> 200 with ETag",
+			bucket:          "photos",
+			key:             "videos/big.mp4",
+			query:           "?partNumber=3&uploadId=upload-1",
+			body:            "part content",
+			wantStatus:      http.StatusOK,
+			wantPlans:       1,
+			wantCradleCalls: 1,
+			wantCommits:     1,
+			wantETag:        `"5d9e2866a2d0cc0249dad69c33eb7e4a"`,
+		},
+		{
+			name:            "matching Content-MD5 -> 200",
+			bucket:          "photos",
+			key:             "videos/big.mp4",
+			query:           "?partNumber=3&uploadId=upload-1",
+			body:            "part content",
+			contentMD5:      "XZ4oZqLQzAJJ2tacM+t+Sg==",
+			wantStatus:      http.StatusOK,
+			wantPlans:       1,
+			wantCradleCalls: 1,
+			wantCommits:     1,
+			wantETag:        `"5d9e2866a2d0cc0249dad69c33eb7e4a"`,
+		},
+		{
+			name:            "matching x-amz-checksum-crc32 -> 200 echoing it",
+			bucket:          "photos",
+			key:             "videos/big.mp4",
+			query:           "?partNumber=3&uploadId=upload-1",
+			body:            "part content",
+			checksumCRC32:   "C+lEng==",
+			wantStatus:      http.StatusOK,
+			wantPlans:       1,
+			wantCradleCalls: 1,
+			wantCommits:     1,
+			wantETag:        `"5d9e2866a2d0cc0249dad69c33eb7e4a"`,
+			wantChecksum:    "C+lEng==",
+		},
+		{
+			name:            "x-amz-checksum-crc32 mismatch -> 400 BadDigest",
+			bucket:          "photos",
+			key:             "videos/big.mp4",
+			query:           "?partNumber=3&uploadId=upload-1",
+			body:            "part content",
+			checksumCRC32:   "DUoRhQ==",
+			wantStatus:      http.StatusBadRequest,
+			wantPlans:       1,
+			wantCradleCalls: 1,
+			wantBodySubstr:  "BadDigest",
+		},
+		{
+			name:            "Content-MD5 mismatch -> 400 BadDigest",
+			bucket:          "photos",
+			key:             "videos/big.mp4",
+			query:           "?partNumber=3&uploadId=upload-1",
+			body:            "part content",
+			contentMD5:      "eV8yArF8trw9S3cdjGyerw==",
+			wantStatus:      http.StatusBadRequest,
+			wantPlans:       1,
+			wantCradleCalls: 1,
+			wantBodySubstr:  "BadDigest",
+		},
+		{
+			name:           "malformed Content-MD5 -> 400 InvalidDigest",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			query:          "?partNumber=3&uploadId=upload-1",
+			body:           "part content",
+			contentMD5:     "not-base64",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidDigest",
+		},
+		{
+			name:           "missing part number -> 400",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			query:          "?uploadId=upload-1",
+			body:           "part content",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "part number out of range -> 400",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			query:          "?partNumber=10001&uploadId=upload-1",
+			body:           "part content",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "empty body -> 400",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			query:          "?partNumber=1&uploadId=upload-1",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidArgument",
+		},
+		{
+			name:           "invalid bucket name -> 400",
+			bucket:         "INVALID-BUCKET",
+			key:            "videos/big.mp4",
+			query:          "?partNumber=1&uploadId=upload-1",
+			body:           "part content",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "InvalidBucketName",
+		},
+		{
+			name:           "gantry unknown upload -> 404 NoSuchUpload",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			query:          "?partNumber=1&uploadId=missing",
+			body:           "part content",
+			planErr:        status.Error(codes.NotFound, "NoSuchUpload"),
+			wantStatus:     http.StatusNotFound,
+			wantPlans:      1,
+			wantBodySubstr: "NoSuchUpload",
+		},
+		{
+			name:           "gantry part too large -> 400 EntityTooLarge",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			query:          "?partNumber=1&uploadId=upload-1",
+			body:           "part content",
+			planErr:        status.Error(codes.InvalidArgument, "EntityTooLarge"),
+			wantStatus:     http.StatusBadRequest,
+			wantPlans:      1,
+			wantBodySubstr: "EntityTooLarge",
+		},
+		{
+			name:           "gantry no cradle servers -> 503",
+			bucket:         "photos",
+			key:            "videos/big.mp4",
+			query:          "?partNumber=1&uploadId=upload-1",
+			body:           "part content",
+			planErr:        status.Error(codes.FailedPrecondition, "no cradle servers available"),
+			wantStatus:     http.StatusServiceUnavailable,
+			wantPlans:      1,
+			wantBodySubstr: "ServiceUnavailable",
+		},
+		{
+			name:            "cradle write failure -> 500",
+			bucket:          "photos",
+			key:             "videos/big.mp4",
+			query:           "?partNumber=1&uploadId=upload-1",
+			body:            "part content",
+			cradleErr:       errors.New("disk full"),
+			wantStatus:      http.StatusInternalServerError,
+			wantPlans:       1,
+			wantCradleCalls: 1,
+			wantBodySubstr:  "InternalError",
+		},
+		{
+			name:            "upload aborted during write -> 404 NoSuchUpload",
+			bucket:          "photos",
+			key:             "videos/big.mp4",
+			query:           "?partNumber=1&uploadId=upload-1",
+			body:            "part content",
+			commitErr:       status.Error(codes.NotFound, "NoSuchUpload"),
+			wantStatus:      http.StatusNotFound,
+			wantPlans:       1,
+			wantCradleCalls: 1,
+			wantCommits:     1,
+			wantBodySubstr:  "NoSuchUpload",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			gantryStub := testutil.NewGantryStub()
+			if c.planErr != nil {
+				gantryStub.PlanPartFn = func(context.Context, string, string, string, int32, int64) (*writeplanv1.WritePlan, error) {
+					return nil, c.planErr
+				}
+			}
+			if c.commitErr != nil {
+				gantryStub.CommitPartFn = func(context.Context, string, int64, int64, string) (string, error) {
+					return "", c.commitErr
+				}
+			}
+
+			cradleStub := testutil.NewCradleStub()
+			if c.cradleErr != nil {
+				cradleStub.WriteObjectFn = func(context.Context, string, string, string, int64, io.Reader, cradle.Digests) (cradle.WriteResult, error) {
+					return cradle.WriteResult{}, c.cradleErr
+				}
+			}
+
+			h := &handlers.Handlers{
+				BucketValidator: validation.DefaultBucketNameValidator{},
+				KeyValidator:    validation.DefaultKeyValidator{},
+				Gantry:          gantryStub,
+				Cradle:          cradleStub,
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/"+c.query, strings.NewReader(c.body))
+			req.SetPathValue("bucket", c.bucket)
+			req.SetPathValue("key", c.key)
+			req.Header.Set("Content-Length", strconv.Itoa(len(c.body)))
+			if c.contentMD5 != "" {
+				req.Header.Set("Content-MD5", c.contentMD5)
+			}
+			if c.checksumCRC32 != "" {
+				req.Header.Set("x-amz-checksum-crc32", c.checksumCRC32)
+			}
+			rec := httptest.NewRecorder()
+
+			h.UploadPart(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.wantStatus)
+			}
+
+			if got := gantryStub.PlanPartCount(); got != c.wantPlans {
+				t.Fatalf("PlanPart calls: got %d, want %d", got, c.wantPlans)
+			}
+			if c.wantPlans > 0 {
+				call := gantryStub.PlanPartCalls[0]
+				if call.Bucket != c.bucket || call.Key != c.key || call.Size != int64(len(c.body)) {
+					t.Fatalf("PlanPart call: got %+v, want %s/%s size %d", call, c.bucket, c.key, len(c.body))
+				}
+			}
+
+			if got := cradleStub.WriteObjectCount(); got != c.wantCradleCalls {
+				t.Fatalf("WriteObject calls: got %d, want %d", got, c.wantCradleCalls)
+			}
+			if c.wantCradleCalls > 0 {
+				call := cradleStub.WriteObjectCalls[0]
+				if call.ObjectID != "stub-blob-id" || call.Bucket != c.bucket || string(call.BodyBytes) != c.body {
+					t.Fatalf("WriteObject call: got %+v", call)
+				}
+			}
+
+			if got := gantryStub.CommitPartCount(); got != c.wantCommits {
+				t.Fatalf("CommitPart calls: got %d, want %d", got, c.wantCommits)
+			}
+
+			if c.wantETag != "" {
+				call := gantryStub.PlanPartCalls[0]
+				if call.UploadID != "upload-1" || call.PartNumber != 3 {
+					t.Fatalf("PlanPart call: got %+v, want upload-1 part 3", call)
+				}
+				if got := rec.Header().Get("ETag"); got != c.wantETag {
+					t.Fatalf("ETag: got %q, want %q", got, c.wantETag)
+				}
+				if got := `"` + gantryStub.CommitPartCalls[0].ETag + `"`; got != c.wantETag {
+					t.Fatalf("CommitPart ETag: got %q, want %q", got, c.wantETag)
+				}
+				if got := rec.Header().Get("x-amz-checksum-crc32"); got != c.wantChecksum {
+					t.Fatalf("x-amz-checksum-crc32: got %q, want %q", got, c.wantChecksum)
+				}
+			}
+
+			if c.wantBodySubstr != "" && !strings.Contains(rec.Body.String(), c.wantBodySubstr) {
+				t.Fatalf("body: expected substring %q, got %q", c.wantBodySubstr, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestUploadPart_AWSChunked(t *testing.T) {
+	t.Parallel()
+
+	gantryStub := testutil.NewGantryStub()
+	cradleStub := testutil.NewCradleStub()
+
+	h := &handlers.Handlers{
+		BucketValidator: validation.DefaultBucketNameValidator{},
+		KeyValidator:    validation.DefaultKeyValidator{},
+		Gantry:          gantryStub,
+		Cradle:          cradleStub,
+	}
+
+	body := "c\r\npart content\r\n0\r\nx-amz-checksum-sha256:DN7bDFlo3ryRmyib2AD2iUDAyUTYWuhOQOhI1fuj1mI=\r\n\r\n"
+	req := httptest.NewRequest(http.MethodPut, "/?partNumber=1&uploadId=upload-1", strings.NewReader(body))
+	req.SetPathValue("bucket", "photos")
+	req.SetPathValue("key", "videos/big.mp4")
+	req.TransferEncoding = []string{"chunked"}
+	req.Header.Set("Content-Encoding", "aws-chunked")
+	req.Header.Set("X-Amz-Content-Sha256", awschunked.StreamingUnsignedTrailer)
+	req.Header.Set("X-Amz-Decoded-Content-Length", "12")
+	req.Header.Set("X-Amz-Trailer", "x-amz-checksum-sha256")
+	rec := httptest.NewRecorder()
+
+	h.UploadPart(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d, want %d (body %q)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if got := gantryStub.PlanPartCalls[0].Size; got != 12 {
+		t.Fatalf("PlanPart size: got %d, want 12", got)
+	}
+	if got := string(cradleStub.WriteObjectCalls[0].BodyBytes); got != "part content" {
+		t.Fatalf("WriteObject body: got %q, want %q", got, "part content")
+	}
+	if got := gantryStub.CommitPartCount(); got != 1 {
+		t.Fatalf("CommitPart calls: got %d, want 1", got)
+	}
+}
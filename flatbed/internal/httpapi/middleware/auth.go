@@ -0,0 +1,240 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/awschunked"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/identity"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/sigv4"
+)
+
+// maxClockSkew is how far a request's X-Amz-Date may be from our clock, as
+// in S3.
+const maxClockSkew = 15 * time.Minute
+
+// AccessKeyLookup returns an access key with its secret and owner. Unknown
+// and inactive keys are reported as a NotFound gRPC status, as Gantry does.
+type AccessKeyLookup interface {
+	GetAccessKey(ctx context.Context, accessKeyID string) (gantry.AccessKey, error)
+}
+
+// SigV4Auth checks requests signed with an AWS4-HMAC-SHA256 Authorization
+// header or, for GET, HEAD and PUT, a presigned URL, rejecting any whose
+// signature isn't from a known access key. A body covered by a hex
+// x-amz-content-sha256 is checked as the handler reads it; a signed
+// aws-chunked body gets its chunk signature chain attached to the context.
+// The key's owner is attached to the context with identity.WithUserID.
+// Unsigned requests pass through anonymously, leaving Gantry to decide from
+// the bucket's policy and ACL whether anyone may make them.
+func SigV4Auth(keys AccessKeyLookup) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var (
+				req signedRequest
+				ok  bool
+			)
+			header := r.Header.Get("Authorization")
+			switch {
+			case sigv4.IsPresigned(r.URL) && header != "":
+				respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+				return
+			case sigv4.IsPresigned(r.URL):
+				req, ok = fromQuery(w, r)
+			case header == "":
+				next.ServeHTTP(w, r)
+				return
+			default:
+				req, ok = fromHeader(w, r, header)
+			}
+			if !ok || !checkSignedHeaders(w, r, req) {
+				return
+			}
+
+			if !strings.HasPrefix(req.datetime, req.auth.Credential.Date) {
+				respond.Error(w, r, req.malformedCode, http.StatusBadRequest)
+				return
+			}
+
+			key, err := keys.GetAccessKey(r.Context(), req.auth.Credential.AccessKeyID)
+			if err != nil {
+				if status.Code(err) == codes.NotFound {
+					respond.Error(w, r, "InvalidAccessKeyId", http.StatusForbidden)
+					return
+				}
+				logger.LogGantryError(r, err)
+				respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+				return
+			}
+
+			scope := req.auth.Credential.Scope()
+			signingKey := sigv4.SigningKey(key.SecretAccessKey, req.auth.Credential)
+			canonical := sigv4.CanonicalRequest(r, req.auth.SignedHeaders, req.payloadHash)
+			want := sigv4.Sign(signingKey, sigv4.StringToSign(req.datetime, scope, canonical))
+			if !hmac.Equal([]byte(want), []byte(req.auth.Signature)) {
+				respond.Error(w, r, "SignatureDoesNotMatch", http.StatusForbidden)
+				return
+			}
+
+			ctx := identity.WithUserID(r.Context(), key.UserID)
+			switch {
+			case req.payloadHash == awschunked.StreamingSigned || req.payloadHash == awschunked.StreamingSignedTrailer:
+				ctx = awschunked.WithVerifier(ctx, awschunked.NewSigV4Verifier(signingKey, req.datetime, scope, req.auth.Signature))
+			case sigv4.IsPayloadHash(req.payloadHash) && req.payloadHash != sigv4.UnsignedPayload:
+				r.Body = sigv4.NewPayloadReader(r.Body, req.payloadHash)
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireSignature rejects requests SigV4Auth let through unsigned, for the
+// service operations S3 never allows anonymously: listing and creating
+// buckets.
+func RequireSignature(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if identity.UserIDFromContext(r.Context()) == "" {
+			respond.Error(w, r, "AccessDenied", http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// signedRequest is what a request's authorization claims, before the
+// signature has been checked.
+type signedRequest struct {
+	auth        sigv4.Authorization
+	datetime    string
+	payloadHash string
+
+	// malformedCode is the error for authorization that parses but does
+	// not hang together.
+	malformedCode string
+}
+
+// fromHeader reads the Authorization header form, writing the S3 error and
+// returning false if it can't be used.
+func fromHeader(w http.ResponseWriter, r *http.Request, header string) (signedRequest, bool) {
+	auth, err := sigv4.ParseAuthorization(header)
+	if err != nil {
+		respond.Error(w, r, "AuthorizationHeaderMalformed", http.StatusBadRequest)
+		return signedRequest{}, false
+	}
+
+	payloadHash := r.Header.Get("x-amz-content-sha256")
+	if payloadHash == "" {
+		respond.Error(w, r, "InvalidRequest", http.StatusBadRequest)
+		return signedRequest{}, false
+	}
+	if !strings.HasPrefix(payloadHash, "STREAMING-") && !sigv4.IsPayloadHash(payloadHash) {
+		respond.Error(w, r, "InvalidArgument", http.StatusBadRequest)
+		return signedRequest{}, false
+	}
+
+	signedAt, ok := requestTime(r)
+	if !ok {
+		respond.Error(w, r, "AccessDenied", http.StatusForbidden)
+		return signedRequest{}, false
+	}
+	if skew := time.Since(signedAt); skew > maxClockSkew || skew < -maxClockSkew {
+		respond.Error(w, r, "RequestTimeTooSkewed", http.StatusForbidden)
+		return signedRequest{}, false
+	}
+
+	return signedRequest{
+		auth:          auth,
+		datetime:      signedAt.Format(sigv4.TimeFormat),
+		payloadHash:   payloadHash,
+		malformedCode: "AuthorizationHeaderMalformed",
+	}, true
+}
+
+// fromQuery reads the presigned URL form, writing the S3 error and
+// returning false if it can't be used. Presigned requests never sign their
+// payload.
+func fromQuery(w http.ResponseWriter, r *http.Request) (signedRequest, bool) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut:
+	default:
+		respond.Error(w, r, "AccessDenied", http.StatusForbidden)
+		return signedRequest{}, false
+	}
+
+	presigned, err := sigv4.ParsePresigned(r.URL.Query())
+	if err != nil {
+		respond.Error(w, r, "AuthorizationQueryParametersError", http.StatusBadRequest)
+		return signedRequest{}, false
+	}
+
+	now := time.Now()
+	if presigned.SignedAt.After(now.Add(maxClockSkew)) {
+		respond.Error(w, r, "RequestTimeTooSkewed", http.StatusForbidden)
+		return signedRequest{}, false
+	}
+	if now.After(presigned.SignedAt.Add(presigned.Expires)) {
+		respond.Error(w, r, "AccessDenied", http.StatusForbidden)
+		return signedRequest{}, false
+	}
+
+	return signedRequest{
+		auth:          presigned.Authorization,
+		datetime:      presigned.SignedAt.Format(sigv4.TimeFormat),
+		payloadHash:   sigv4.UnsignedPayload,
+		malformedCode: "AuthorizationQueryParametersError",
+	}, true
+}
+
+// optionallySigned are the x-amz-* headers a request may send without
+// signing, as S3 allows.
+var optionallySigned = map[string]bool{
+	"x-amz-content-sha256": true,
+	"x-amz-date":           true,
+}
+
+// checkSignedHeaders makes sure the signature covers the host and every
+// x-amz-* header sent, so none can be changed or added after signing,
+// writing the S3 error and returning false if it doesn't.
+func checkSignedHeaders(w http.ResponseWriter, r *http.Request, req signedRequest) bool {
+	signed := make(map[string]bool, len(req.auth.SignedHeaders))
+	for _, name := range req.auth.SignedHeaders {
+		signed[strings.ToLower(name)] = true
+	}
+
+	if !signed["host"] {
+		respond.Error(w, r, req.malformedCode, http.StatusBadRequest)
+		return false
+	}
+	for name := range r.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-amz-") && !signed[name] && !optionallySigned[name] {
+			respond.Error(w, r, "AccessDenied", http.StatusForbidden)
+			return false
+		}
+	}
+	return true
+}
+
+// requestTime returns when the request was signed, from X-Amz-Date or else
+// the Date header.
+func requestTime(r *http.Request) (time.Time, bool) {
+	if v := r.Header.Get("X-Amz-Date"); v != "" {
+		t, err := time.Parse(sigv4.TimeFormat, v)
+		return t, err == nil
+	}
+	if v := r.Header.Get("Date"); v != "" {
+		t, err := http.ParseTime(v)
+		return t.UTC(), err == nil
+	}
+	return time.Time{}, false
+}
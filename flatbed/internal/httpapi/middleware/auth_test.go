@@ -0,0 +1,466 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/awschunked"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/identity"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/sigv4"
+)
+
+const (
+	testAccessKeyID = "AKIDTEST"
+	testSecret      = "test-secret"
+	emptySHA256Hex  = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	helloSHA256Hex  = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+)
+
+type stubKeys map[string]string
+
+func (k stubKeys) GetAccessKey(_ context.Context, accessKeyID string) (gantry.AccessKey, error) {
+	if accessKeyID == "boom" {
+		return gantry.AccessKey{}, status.Error(codes.Unavailable, "gantry down")
+	}
+	secret, ok := k[accessKeyID]
+	if !ok {
+		return gantry.AccessKey{}, status.Error(codes.NotFound, "access key not found")
+	}
+	return gantry.AccessKey{AccessKeyID: accessKeyID, SecretAccessKey: secret, UserID: "user-" + accessKeyID}, nil
+}
+
+// signRequest signs req at signedAt with the given credentials, as an S3
+// client would.
+func signRequest(req *http.Request, accessKeyID, secret string, signedAt time.Time, payloadHash string) {
+	signRequestHeaders(req, accessKeyID, secret, signedAt, payloadHash, []string{"host", "x-amz-content-sha256", "x-amz-date"})
+}
+
+// signRequestHeaders is signRequest covering only signedHeaders.
+func signRequestHeaders(req *http.Request, accessKeyID, secret string, signedAt time.Time, payloadHash string, signedHeaders []string) {
+	datetime := signedAt.UTC().Format(sigv4.TimeFormat)
+	req.Header.Set("X-Amz-Date", datetime)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	cred := sigv4.Credential{
+		AccessKeyID: accessKeyID,
+		Date:        datetime[:8],
+		Region:      "us-east-1",
+		Service:     "s3",
+	}
+	canonical := sigv4.CanonicalRequest(req, signedHeaders, payloadHash)
+	sig := sigv4.Sign(sigv4.SigningKey(secret, cred), sigv4.StringToSign(datetime, cred.Scope(), canonical))
+
+	req.Header.Set("Authorization", sigv4.Algorithm+" Credential="+accessKeyID+"/"+cred.Scope()+
+		", SignedHeaders="+strings.Join(signedHeaders, ";")+", Signature="+sig)
+}
+
+func TestSigV4Auth(t *testing.T) {
+	t.Parallel()
+
+	keys := stubKeys{testAccessKeyID: testSecret}
+
+	tests := []struct {
+		name       string
+		prepare    func(req *http.Request)
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name: "valid signature",
+			prepare: func(req *http.Request) {
+				signRequest(req, testAccessKeyID, testSecret, time.Now(), emptySHA256Hex)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "malformed authorization",
+			prepare: func(req *http.Request) {
+				req.Header.Set("Authorization", "AWS AKIDTEST:signature")
+			},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "AuthorizationHeaderMalformed",
+		},
+		{
+			name: "missing content sha256",
+			prepare: func(req *http.Request) {
+				signRequest(req, testAccessKeyID, testSecret, time.Now(), emptySHA256Hex)
+				req.Header.Del("X-Amz-Content-Sha256")
+			},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "InvalidRequest",
+		},
+		{
+			name: "invalid content sha256",
+			prepare: func(req *http.Request) {
+				signRequest(req, testAccessKeyID, testSecret, time.Now(), "not-a-hash")
+			},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "InvalidArgument",
+		},
+		{
+			name: "skewed request time",
+			prepare: func(req *http.Request) {
+				signRequest(req, testAccessKeyID, testSecret, time.Now().Add(-time.Hour), emptySHA256Hex)
+			},
+			wantStatus: http.StatusForbidden,
+			wantCode:   "RequestTimeTooSkewed",
+		},
+		{
+			name: "unknown access key",
+			prepare: func(req *http.Request) {
+				signRequest(req, "AKIDUNKNOWN", testSecret, time.Now(), emptySHA256Hex)
+			},
+			wantStatus: http.StatusForbidden,
+			wantCode:   "InvalidAccessKeyId",
+		},
+		{
+			name: "access key lookup fails",
+			prepare: func(req *http.Request) {
+				signRequest(req, "boom", testSecret, time.Now(), emptySHA256Hex)
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   "InternalError",
+		},
+		{
+			name: "wrong secret",
+			prepare: func(req *http.Request) {
+				signRequest(req, testAccessKeyID, "other-secret", time.Now(), emptySHA256Hex)
+			},
+			wantStatus: http.StatusForbidden,
+			wantCode:   "SignatureDoesNotMatch",
+		},
+		{
+			name: "tampered after signing",
+			prepare: func(req *http.Request) {
+				signRequest(req, testAccessKeyID, testSecret, time.Now(), emptySHA256Hex)
+				req.URL.Path = "/bucket/other"
+			},
+			wantStatus: http.StatusForbidden,
+			wantCode:   "SignatureDoesNotMatch",
+		},
+		{
+			name: "host not signed",
+			prepare: func(req *http.Request) {
+				signRequestHeaders(req, testAccessKeyID, testSecret, time.Now(), emptySHA256Hex, []string{"x-amz-content-sha256", "x-amz-date"})
+			},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "AuthorizationHeaderMalformed",
+		},
+		{
+			name: "x-amz header added after signing",
+			prepare: func(req *http.Request) {
+				signRequest(req, testAccessKeyID, testSecret, time.Now(), emptySHA256Hex)
+				req.Header.Set("X-Amz-Meta-Color", "blue")
+			},
+			wantStatus: http.StatusForbidden,
+			wantCode:   "AccessDenied",
+		},
+		{
+			name: "signed x-amz header",
+			prepare: func(req *http.Request) {
+				req.Header.Set("X-Amz-Meta-Color", "blue")
+				signRequestHeaders(req, testAccessKeyID, testSecret, time.Now(), emptySHA256Hex, []string{"host", "x-amz-content-sha256", "x-amz-date", "x-amz-meta-color"})
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "payload hash and date may go unsigned",
+			prepare: func(req *http.Request) {
+				signRequestHeaders(req, testAccessKeyID, testSecret, time.Now(), emptySHA256Hex, []string{"host"})
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			var userID string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				userID = identity.UserIDFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/bucket/key", nil)
+			tt.prepare(req)
+			rr := httptest.NewRecorder()
+
+			SigV4Auth(keys)(next).ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rr.Code, tt.wantStatus, rr.Body.String())
+			}
+			if called != (tt.wantStatus == http.StatusOK) {
+				t.Fatalf("next called = %v, want %v", called, tt.wantStatus == http.StatusOK)
+			}
+			if called && userID != "user-"+testAccessKeyID {
+				t.Fatalf("user ID = %q, want %q", userID, "user-"+testAccessKeyID)
+			}
+			if tt.wantCode != "" && !strings.Contains(rr.Body.String(), "<Code>"+tt.wantCode+"</Code>") {
+				t.Fatalf("body = %s, want code %s", rr.Body.String(), tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestSigV4Auth_UnsignedPassesThroughAnonymously(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	var userID string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		userID = identity.UserIDFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/bucket/key", nil)
+	rr := httptest.NewRecorder()
+
+	SigV4Auth(stubKeys{testAccessKeyID: testSecret})(next).ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+	if !called {
+		t.Fatalf("next not called for an unsigned request")
+	}
+	if userID != "" {
+		t.Fatalf("user ID = %q, want none", userID)
+	}
+}
+
+func TestSigV4Auth_PayloadHashChecked(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		body    string
+		wantErr error
+	}{
+		{name: "matching body", body: "hello world"},
+		{name: "tampered body", body: "hello there", wantErr: sigv4.ErrContentSHA256Mismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var readErr error
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, readErr = io.ReadAll(r.Body)
+			})
+
+			req := httptest.NewRequest(http.MethodPut, "/bucket/key", strings.NewReader(tt.body))
+			signRequest(req, testAccessKeyID, testSecret, time.Now(), helloSHA256Hex)
+
+			SigV4Auth(stubKeys{testAccessKeyID: testSecret})(next).ServeHTTP(httptest.NewRecorder(), req)
+
+			if !errors.Is(readErr, tt.wantErr) {
+				t.Fatalf("body read error = %v, want %v", readErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSigV4Auth_StreamingAttachesVerifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		payloadHash  string
+		wantVerifier bool
+	}{
+		{name: "signed streaming", payloadHash: awschunked.StreamingSigned, wantVerifier: true},
+		{name: "signed streaming with trailer", payloadHash: awschunked.StreamingSignedTrailer, wantVerifier: true},
+		{name: "unsigned streaming with trailer", payloadHash: awschunked.StreamingUnsignedTrailer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotVerifier awschunked.Verifier
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotVerifier = awschunked.VerifierFromContext(r.Context())
+			})
+
+			req := httptest.NewRequest(http.MethodPut, "/bucket/key", nil)
+			signRequest(req, testAccessKeyID, testSecret, time.Now(), tt.payloadHash)
+
+			SigV4Auth(stubKeys{testAccessKeyID: testSecret})(next).ServeHTTP(httptest.NewRecorder(), req)
+
+			if (gotVerifier != nil) != tt.wantVerifier {
+				t.Fatalf("verifier attached = %v, want %v", gotVerifier != nil, tt.wantVerifier)
+			}
+		})
+	}
+}
+
+func TestSigV4Auth_Presigned(t *testing.T) {
+	t.Parallel()
+
+	keys := stubKeys{testAccessKeyID: testSecret}
+	cred := sigv4.Credential{AccessKeyID: testAccessKeyID, Region: "us-east-1", Service: "s3"}
+
+	tests := []struct {
+		name       string
+		method     string
+		prepare    func(req *http.Request)
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:   "valid GET",
+			method: http.MethodGet,
+			prepare: func(req *http.Request) {
+				sigv4.Presign(req, testSecret, cred, time.Now(), time.Hour)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "valid PUT",
+			method: http.MethodPut,
+			prepare: func(req *http.Request) {
+				sigv4.Presign(req, testSecret, cred, time.Now(), time.Hour)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "valid HEAD",
+			method: http.MethodHead,
+			prepare: func(req *http.Request) {
+				sigv4.Presign(req, testSecret, cred, time.Now(), time.Hour)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "DELETE not presignable",
+			method: http.MethodDelete,
+			prepare: func(req *http.Request) {
+				sigv4.Presign(req, testSecret, cred, time.Now(), time.Hour)
+			},
+			wantStatus: http.StatusForbidden,
+			wantCode:   "AccessDenied",
+		},
+		{
+			name:   "expired",
+			method: http.MethodGet,
+			prepare: func(req *http.Request) {
+				sigv4.Presign(req, testSecret, cred, time.Now().Add(-2*time.Hour), time.Hour)
+			},
+			wantStatus: http.StatusForbidden,
+			wantCode:   "AccessDenied",
+		},
+		{
+			name:   "signed in the future",
+			method: http.MethodGet,
+			prepare: func(req *http.Request) {
+				sigv4.Presign(req, testSecret, cred, time.Now().Add(time.Hour), time.Hour)
+			},
+			wantStatus: http.StatusForbidden,
+			wantCode:   "RequestTimeTooSkewed",
+		},
+		{
+			name:   "malformed expires",
+			method: http.MethodGet,
+			prepare: func(req *http.Request) {
+				sigv4.Presign(req, testSecret, cred, time.Now(), 8*24*time.Hour)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "AuthorizationQueryParametersError",
+		},
+		{
+			name:   "query tampered after signing",
+			method: http.MethodGet,
+			prepare: func(req *http.Request) {
+				sigv4.Presign(req, testSecret, cred, time.Now(), time.Hour)
+				req.URL.RawQuery += "&versionId=other"
+			},
+			wantStatus: http.StatusForbidden,
+			wantCode:   "SignatureDoesNotMatch",
+		},
+		{
+			name:   "wrong secret",
+			method: http.MethodGet,
+			prepare: func(req *http.Request) {
+				sigv4.Presign(req, "other-secret", cred, time.Now(), time.Hour)
+			},
+			wantStatus: http.StatusForbidden,
+			wantCode:   "SignatureDoesNotMatch",
+		},
+		{
+			name:   "host not signed",
+			method: http.MethodGet,
+			prepare: func(req *http.Request) {
+				sigv4.Presign(req, testSecret, cred, time.Now(), time.Hour)
+				q := req.URL.Query()
+				q.Set("X-Amz-SignedHeaders", "x-amz-meta-color")
+				req.URL.RawQuery = q.Encode()
+			},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "AuthorizationQueryParametersError",
+		},
+		{
+			name:   "unsigned x-amz header",
+			method: http.MethodPut,
+			prepare: func(req *http.Request) {
+				sigv4.Presign(req, testSecret, cred, time.Now(), time.Hour)
+				req.Header.Set("X-Amz-Meta-Color", "blue")
+			},
+			wantStatus: http.StatusForbidden,
+			wantCode:   "AccessDenied",
+		},
+		{
+			name:   "also has authorization header",
+			method: http.MethodGet,
+			prepare: func(req *http.Request) {
+				sigv4.Presign(req, testSecret, cred, time.Now(), time.Hour)
+				req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=x")
+			},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "InvalidArgument",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(tt.method, "http://localhost:8080/bucket/key", nil)
+			tt.prepare(req)
+			rr := httptest.NewRecorder()
+
+			SigV4Auth(keys)(next).ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rr.Code, tt.wantStatus, rr.Body.String())
+			}
+			if called != (tt.wantStatus == http.StatusOK) {
+				t.Fatalf("next called = %v, want %v", called, tt.wantStatus == http.StatusOK)
+			}
+			if tt.wantCode != "" && !strings.Contains(rr.Body.String(), "<Code>"+tt.wantCode+"</Code>") {
+				t.Fatalf("body = %s, want code %s", rr.Body.String(), tt.wantCode)
+			}
+		})
+	}
+}
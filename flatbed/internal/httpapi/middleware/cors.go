@@ -0,0 +1,176 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+)
+
+// corsVary lists the request headers a CORS response depends on.
+const corsVary = "Origin, Access-Control-Request-Headers, Access-Control-Request-Method"
+
+// CORSLookup returns a bucket's CORS rules, with no rules for a bucket
+// without a CORS configuration or a missing bucket, as Gantry does.
+type CORSLookup interface {
+	LookupBucketCors(ctx context.Context, bucket string) ([]gantry.CORSRule, error)
+}
+
+// CORS applies the bucket's CORS rules to browser requests. It answers
+// OPTIONS preflights itself, since browsers never sign them, and adds
+// Access-Control-* headers to any other request with an Origin that a rule
+// allows, whether or not the request then succeeds. Requests without an
+// Origin pass straight through.
+func CORS(lookup CORSLookup) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			bucket, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+			if r.Method == http.MethodOptions && bucket != "" {
+				preflight(w, r, lookup, bucket)
+				return
+			}
+
+			origin := r.Header.Get("Origin")
+			if origin == "" || bucket == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// A failed lookup leaves the response undecorated; the
+			// handler reports a missing bucket itself.
+			rules, err := lookup.LookupBucketCors(r.Context(), bucket)
+			if err == nil {
+				if rule, ok := matchCORSRule(rules, origin, r.Method, nil); ok {
+					setCORSHeaders(w.Header(), rule, origin)
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// preflight answers an OPTIONS request for bucket with the first rule that
+// allows its origin, requested method and requested headers.
+func preflight(w http.ResponseWriter, r *http.Request, lookup CORSLookup, bucket string) {
+	origin := r.Header.Get("Origin")
+	method := r.Header.Get("Access-Control-Request-Method")
+	if origin == "" || method == "" {
+		respond.Error(w, r, "BadRequest", http.StatusBadRequest)
+		return
+	}
+
+	var headers []string
+	for _, h := range strings.Split(r.Header.Get("Access-Control-Request-Headers"), ",") {
+		if h = strings.TrimSpace(h); h != "" {
+			headers = append(headers, h)
+		}
+	}
+
+	// A missing bucket is refused like one whose rules don't match, so
+	// unsigned preflights can't tell which buckets exist.
+	rules, err := lookup.LookupBucketCors(r.Context(), bucket)
+	switch status.Code(err) {
+	case codes.OK, codes.NotFound:
+	case codes.InvalidArgument:
+		respond.Error(w, r, "InvalidBucketName", http.StatusBadRequest)
+		return
+	default:
+		logger.LogGantryError(r, err)
+		respond.Error(w, r, "InternalError", http.StatusInternalServerError)
+		return
+	}
+
+	rule, ok := matchCORSRule(rules, origin, method, headers)
+	if !ok {
+		respond.Error(w, r, "AccessForbidden", http.StatusForbidden)
+		return
+	}
+
+	setCORSHeaders(w.Header(), rule, origin)
+	if len(headers) > 0 {
+		w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ", "))
+	}
+	if rule.MaxAgeSeconds > 0 {
+		w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(rule.MaxAgeSeconds)))
+	}
+
+	logger.LogResult(r, fmt.Sprintf("preflight from <%s> for %s allowed", origin, method))
+	w.WriteHeader(http.StatusOK)
+}
+
+// matchCORSRule returns the first rule allowing origin to make a method
+// request sending headers. Origins match case-sensitively and headers
+// case-insensitively, as in S3.
+func matchCORSRule(rules []gantry.CORSRule, origin, method string, headers []string) (gantry.CORSRule, bool) {
+	for _, rule := range rules {
+		if !matchesAny(rule.AllowedOrigins, origin, false) {
+			continue
+		}
+		if !matchesAny(rule.AllowedMethods, method, false) {
+			continue
+		}
+		allowed := true
+		for _, h := range headers {
+			if !matchesAny(rule.AllowedHeaders, h, true) {
+				allowed = false
+				break
+			}
+		}
+		if allowed {
+			return rule, true
+		}
+	}
+	return gantry.CORSRule{}, false
+}
+
+// matchesAny reports whether s matches one of patterns, each of which holds
+// at most one * wildcard standing for any run of characters.
+func matchesAny(patterns []string, s string, foldCase bool) bool {
+	if foldCase {
+		s = strings.ToLower(s)
+	}
+	for _, p := range patterns {
+		if foldCase {
+			p = strings.ToLower(p)
+		}
+		before, after, wildcard := strings.Cut(p, "*")
+		if !wildcard {
+			if p == s {
+				return true
+			}
+			continue
+		}
+		if len(s) >= len(before)+len(after) && strings.HasPrefix(s, before) && strings.HasSuffix(s, after) {
+			return true
+		}
+	}
+	return false
+}
+
+// setCORSHeaders sets the headers S3 sends with every request rule allows.
+// A rule allowing any origin answers with *, which browsers accept only for
+// requests without credentials; any other rule echoes the origin.
+func setCORSHeaders(h http.Header, rule gantry.CORSRule, origin string) {
+	if slices.Contains(rule.AllowedOrigins, "*") {
+		h.Set("Access-Control-Allow-Origin", "*")
+	} else {
+		h.Set("Access-Control-Allow-Origin", origin)
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+	h.Set("Access-Control-Allow-Methods", strings.Join(rule.AllowedMethods, ", "))
+	if len(rule.ExposeHeaders) > 0 {
+		h.Set("Access-Control-Expose-Headers", strings.Join(rule.ExposeHeaders, ", "))
+	}
+	h.Set("Vary", corsVary)
+}
@@ -0,0 +1,185 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+)
+
+type stubCORS map[string][]gantry.CORSRule
+
+func (c stubCORS) LookupBucketCors(_ context.Context, bucket string) ([]gantry.CORSRule, error) {
+	if bucket == "boom" {
+		return nil, status.Error(codes.Unavailable, "gantry down")
+	}
+	rules, ok := c[bucket]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "NoSuchBucket")
+	}
+	return rules, nil
+}
+
+func TestCORS_Preflight(t *testing.T) {
+	t.Parallel()
+
+	cors := stubCORS{
+		"gallery": {
+			{AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET", "HEAD"}, AllowedHeaders: []string{"x-amz-*", "Range"}, ExposeHeaders: []string{"ETag", "x-amz-version-id"}, MaxAgeSeconds: 3000},
+			{AllowedOrigins: []string{"https://*.example.com"}, AllowedMethods: []string{"PUT"}},
+			{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}},
+		},
+		"plain": nil,
+	}
+
+	tests := []struct {
+		name        string
+		target      string
+		origin      string
+		method      string
+		headers     string
+		wantStatus  int
+		wantCode    string
+		wantHeaders map[string]string
+	}{
+		{
+			name:       "allowed origin, method and headers",
+			target:     "/gallery/photo.jpg",
+			origin:     "https://gallery.example.com",
+			method:     "GET",
+			headers:    "X-Amz-Date, range",
+			wantStatus: http.StatusOK,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "https://gallery.example.com",
+				"Access-Control-Allow-Credentials": "true",
+				"Access-Control-Allow-Methods":     "GET, HEAD",
+				"Access-Control-Allow-Headers":     "X-Amz-Date, range",
+				"Access-Control-Expose-Headers":    "ETag, x-amz-version-id",
+				"Access-Control-Max-Age":           "3000",
+				"Vary":                             "Origin, Access-Control-Request-Headers, Access-Control-Request-Method",
+			},
+		},
+		{
+			name:        "wildcard subdomain origin",
+			target:      "/gallery/upload.jpg",
+			origin:      "https://upload.example.com",
+			method:      "PUT",
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": "https://upload.example.com", "Access-Control-Allow-Methods": "PUT"},
+		},
+		{
+			name:        "any origin answers with a wildcard",
+			target:      "/gallery",
+			origin:      "https://elsewhere.test",
+			method:      "GET",
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": "*", "Access-Control-Allow-Credentials": ""},
+		},
+		{name: "method no rule allows", target: "/gallery/photo.jpg", origin: "https://elsewhere.test", method: "DELETE", wantStatus: http.StatusForbidden, wantCode: "AccessForbidden"},
+		{name: "header no rule allows", target: "/gallery/photo.jpg", origin: "https://gallery.example.com", method: "GET", headers: "Authorization", wantStatus: http.StatusForbidden, wantCode: "AccessForbidden"},
+		{name: "bucket without cors", target: "/plain/photo.jpg", origin: "https://gallery.example.com", method: "GET", wantStatus: http.StatusForbidden, wantCode: "AccessForbidden"},
+		{name: "missing origin", target: "/gallery/photo.jpg", method: "GET", wantStatus: http.StatusBadRequest, wantCode: "BadRequest"},
+		{name: "missing request method", target: "/gallery/photo.jpg", origin: "https://gallery.example.com", wantStatus: http.StatusBadRequest, wantCode: "BadRequest"},
+		{name: "missing bucket refused like a non-matching rule", target: "/missing/photo.jpg", origin: "https://gallery.example.com", method: "GET", wantStatus: http.StatusForbidden, wantCode: "AccessForbidden"},
+		{name: "lookup fails", target: "/boom/photo.jpg", origin: "https://gallery.example.com", method: "GET", wantStatus: http.StatusInternalServerError, wantCode: "InternalError"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			handler := CORS(cors)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+			}))
+
+			req := httptest.NewRequest(http.MethodOptions, tt.target, nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if tt.method != "" {
+				req.Header.Set("Access-Control-Request-Method", tt.method)
+			}
+			if tt.headers != "" {
+				req.Header.Set("Access-Control-Request-Headers", tt.headers)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if called {
+				t.Fatal("preflight reached the next handler")
+			}
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status: got %d, want %d; body: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantCode != "" && !strings.Contains(rec.Body.String(), "<Code>"+tt.wantCode+"</Code>") {
+				t.Fatalf("body: want code %s, got %s", tt.wantCode, rec.Body.String())
+			}
+			for name, want := range tt.wantHeaders {
+				if got := rec.Header().Get(name); got != want {
+					t.Fatalf("%s: got %q, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCORS_DecoratesRequests(t *testing.T) {
+	t.Parallel()
+
+	cors := stubCORS{
+		"gallery": {{AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET"}, ExposeHeaders: []string{"ETag"}}},
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		origin     string
+		wantOrigin string
+	}{
+		{name: "allowed origin", method: http.MethodGet, target: "/gallery/photo.jpg", origin: "https://gallery.example.com", wantOrigin: "https://gallery.example.com"},
+		{name: "method not allowed", method: http.MethodPut, target: "/gallery/photo.jpg", origin: "https://gallery.example.com"},
+		{name: "origin not allowed", method: http.MethodGet, target: "/gallery/photo.jpg", origin: "https://evil.test"},
+		{name: "same-origin request", method: http.MethodGet, target: "/gallery/photo.jpg"},
+		{name: "missing bucket left to the handler", method: http.MethodGet, target: "/missing/photo.jpg", origin: "https://gallery.example.com"},
+		{name: "list buckets", method: http.MethodGet, target: "/", origin: "https://gallery.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			handler := CORS(cors)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusTeapot)
+			}))
+
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if !called || rec.Code != http.StatusTeapot {
+				t.Fatalf("next handler: called %v, status %d", called, rec.Code)
+			}
+			if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Fatalf("Access-Control-Allow-Origin: got %q, want %q", got, tt.wantOrigin)
+			}
+			if tt.wantOrigin != "" {
+				if got := rec.Header().Get("Access-Control-Expose-Headers"); got != "ETag" {
+					t.Fatalf("Access-Control-Expose-Headers: got %q, want ETag", got)
+				}
+			}
+		})
+	}
+}
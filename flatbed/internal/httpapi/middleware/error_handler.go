@@ -6,8 +6,9 @@ import (
 	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/respond"
 )
 
-// ErrorHandler captures 404 and 405 responses and overrides them with
-// custom error messages.
+// ErrorHandler captures 404 and 405 responses and overrides them with an
+// S3 NotFound error body. Any other status is written straight through so
+// large object bodies stream to the client instead of being buffered.
 func ErrorHandler(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		buf := &responseBuffer{
@@ -20,14 +21,14 @@ func ErrorHandler(next http.Handler) http.Handler {
 
 		// Always convert 405 to 404 for S3 compatibility
 		if buf.statusCode == http.StatusMethodNotAllowed {
-			respond.Error(w, r, "page not found", http.StatusNotFound)
+			respond.Error(w, r, "NotFound", http.StatusNotFound)
 			return
 		}
 
 		// For 404: only override if handler didn't write a body (router-level 404)
 		// If handler wrote a body (e.g., "NoSuchBucket"), let it pass through
 		if buf.statusCode == http.StatusNotFound && len(buf.body) == 0 {
-			respond.Error(w, r, "page not found", http.StatusNotFound)
+			respond.Error(w, r, "NotFound", http.StatusNotFound)
 			return
 		}
 
@@ -42,13 +43,21 @@ type responseBuffer struct {
 	headers    http.Header
 	body       []byte
 	wroteOnce  bool
+	passThru   bool
 }
 
 func (rb *responseBuffer) WriteHeader(code int) {
 	if !rb.wroteOnce {
 		rb.statusCode = code
 		rb.wroteOnce = true
-		// Don't write to underlying writer yet - buffer it
+
+		// Only 404 and 405 may be rewritten, so everything else can go
+		// straight to the underlying writer
+		if code != http.StatusNotFound && code != http.StatusMethodNotAllowed {
+			rb.passThru = true
+			rb.copyHeaders()
+			rb.ResponseWriter.WriteHeader(code)
+		}
 	}
 }
 
@@ -56,6 +65,9 @@ func (rb *responseBuffer) Write(b []byte) (int, error) {
 	if !rb.wroteOnce {
 		rb.WriteHeader(http.StatusOK)
 	}
+	if rb.passThru {
+		return rb.ResponseWriter.Write(b)
+	}
 	// Buffer the response body instead of writing directly
 	rb.body = append(rb.body, b...)
 	return len(b), nil
@@ -66,13 +78,20 @@ func (rb *responseBuffer) Header() http.Header {
 }
 
 func (rb *responseBuffer) flush() {
-	// Copy buffered headers to actual response
+	if rb.passThru {
+		return
+	}
+	rb.copyHeaders()
+	// Write status and body to actual response
+	rb.ResponseWriter.WriteHeader(rb.statusCode)
+	rb.ResponseWriter.Write(rb.body)
+}
+
+// copyHeaders copies buffered headers to the actual response.
+func (rb *responseBuffer) copyHeaders() {
 	for k, v := range rb.headers {
 		for _, val := range v {
 			rb.ResponseWriter.Header().Add(k, val)
 		}
 	}
-	// Write status and body to actual response
-	rb.ResponseWriter.WriteHeader(rb.statusCode)
-	rb.ResponseWriter.Write(rb.body)
 }
@@ -20,14 +20,14 @@ func TestErrorHandler(t *testing.T) {
 			handlerStatus:  http.StatusNotFound,
 			handlerBody:    "",
 			wantStatus:     http.StatusNotFound,
-			wantBodyPrefix: "page not found",
+			wantBodyPrefix: "<Code>NotFound</Code>",
 		},
 		{
 			name:           "405 without body gets custom error as 404",
 			handlerStatus:  http.StatusMethodNotAllowed,
 			handlerBody:    "",
 			wantStatus:     http.StatusNotFound,
-			wantBodyPrefix: "page not found",
+			wantBodyPrefix: "<Code>NotFound</Code>",
 		},
 		{
 			name:           "404 with body passes through unchanged",
@@ -41,7 +41,7 @@ func TestErrorHandler(t *testing.T) {
 			handlerStatus:  http.StatusMethodNotAllowed,
 			handlerBody:    "MethodNotAllowed",
 			wantStatus:     http.StatusNotFound,
-			wantBodyPrefix: "page not found",
+			wantBodyPrefix: "<Code>NotFound</Code>",
 		},
 		{
 			name:           "400 passes through unchanged",
@@ -81,3 +81,30 @@ func TestErrorHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorHandler_StreamsSuccessfulResponses(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "11")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("hello "))
+
+		// The first write must already have reached the client
+		if rec.Code != http.StatusOK || rec.Body.String() != "hello " {
+			t.Errorf("mid-stream: status %d body %q, want 200 %q", rec.Code, rec.Body.String(), "hello ")
+		}
+		if got := rec.Header().Get("Content-Length"); got != "11" {
+			t.Errorf("mid-stream Content-Length = %q, want %q", got, "11")
+		}
+
+		w.Write([]byte("world"))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	ErrorHandler(next).ServeHTTP(rec, req)
+
+	if rec.Body.String() != "hello world" {
+		t.Errorf("body = %q, want %q", rec.Body.String(), "hello world")
+	}
+}
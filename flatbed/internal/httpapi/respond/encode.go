@@ -0,0 +1,55 @@
+package respond
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// S3Namespace is the xmlns S3 puts on the root element of its responses.
+const S3Namespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+// Encode writes v with the given status as XML, the S3 wire format, or as
+// JSON when the client asks for it via Accept. Response types carry both xml
+// and json struct tags so either encoding has the same shape.
+func Encode(w http.ResponseWriter, r *http.Request, status int, v any) error {
+	// Headers a handler set for a different body (an object's length, say)
+	// no longer describe this one.
+	w.Header().Del("Content-Length")
+
+	if WantsJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		return json.NewEncoder(w).Encode(v)
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	return xml.NewEncoder(w).Encode(v)
+}
+
+// WantsJSON reports whether the Accept header prefers JSON over XML. Media
+// types are taken in the order listed, ignoring q-values; S3 SDKs don't ask
+// for JSON, so anything else gets XML.
+func WantsJSON(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		switch mediaType {
+		case "application/json":
+			return true
+		case "application/xml", "text/xml":
+			return false
+		}
+	}
+	return false
+}
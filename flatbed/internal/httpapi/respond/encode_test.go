@@ -0,0 +1,41 @@
+package respond
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWantsJSON(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		accept string
+		want   bool
+	}{
+		{name: "no Accept header", accept: "", want: false},
+		{name: "wildcard", accept: "*/*", want: false},
+		{name: "application/json", accept: "application/json", want: true},
+		{name: "json with parameters", accept: "application/json; charset=utf-8", want: true},
+		{name: "xml listed first", accept: "application/xml, application/json", want: false},
+		{name: "json listed first", accept: "application/json, application/xml", want: true},
+		{name: "text/xml", accept: "text/xml", want: false},
+		{name: "malformed entries are skipped", accept: ";;, application/json", want: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if c.accept != "" {
+				req.Header.Set("Accept", c.accept)
+			}
+
+			if got := WantsJSON(req); got != c.want {
+				t.Fatalf("WantsJSON(%q): got %v, want %v", c.accept, got, c.want)
+			}
+		})
+	}
+}
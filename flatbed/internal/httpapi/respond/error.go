@@ -1,12 +1,90 @@
 package respond
 
 import (
+	"encoding/xml"
 	"net/http"
 
 	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
 )
 
-func Error(w http.ResponseWriter, r *http.Request, msg string, status int) {
-	logger.LogError(w, r, msg)
-	http.Error(w, msg, status)
+type errorResponse struct {
+	XMLName   xml.Name `xml:"Error" json:"-"`
+	Code      string   `xml:"Code" json:"Code"`
+	Message   string   `xml:"Message" json:"Message"`
+	Resource  string   `xml:"Resource" json:"Resource"`
+	RequestID string   `xml:"RequestId" json:"RequestId"`
+}
+
+// errorMessages holds the human-readable text S3 sends with each error code.
+var errorMessages = map[string]string{
+	"AccessDenied":                            "Access Denied",
+	"AccessForbidden":                         "CORSResponse: This CORS request is not allowed. This is usually because the evalution of Origin, request method / Access-Control-Request-Method or Access-Control-Request-Headers are not whitelisted by the resource's CORS spec.",
+	"AuthorizationHeaderMalformed":            "The authorization header that you provided is not valid.",
+	"AuthorizationQueryParametersError":       "The query parameters that provide authentication information are not valid.",
+	"BadDigest":                               "The Content-MD5 or checksum value that you specified did not match what the server received.",
+	"BadRequest":                              "Insufficient information. Origin request header needed.",
+	"BucketAlreadyExists":                     "The requested bucket name is not available. The bucket namespace is shared by all users of the system. Please select a different name and try again.",
+	"BucketAlreadyOwnedByYou":                 "Your previous request to create the named bucket succeeded and you already own it.",
+	"BucketNotEmpty":                          "The bucket you tried to delete is not empty",
+	"EntityTooLarge":                          "Your proposed upload exceeds the maximum allowed size",
+	"EntityTooSmall":                          "Your proposed upload is smaller than the minimum allowed object size.",
+	"IllegalVersioningConfigurationException": "The versioning configuration specified in the request is invalid.",
+	"IncompleteBody":                          "You did not provide the number of bytes specified by the Content-Length HTTP header.",
+	"InternalError":                           "We encountered an internal error. Please try again.",
+	"InvalidAccessKeyId":                      "The AWS access key ID that you provided does not exist in our records.",
+	"InvalidTag":                              "The tag provided was not a valid tag. This error can occur if the tag did not pass input validation.",
+	"InvalidArgument":                         "Invalid Argument",
+	"InvalidBucketState":                      "The request is not valid with the current state of the bucket.",
+	"InvalidBucketName":                       "The specified bucket is not valid.",
+	"InvalidDigest":                           "The Content-MD5 or checksum value that you specified is not valid.",
+	"InvalidKeyName":                          "The specified key is not valid.",
+	"InvalidPart":                             "One or more of the specified parts could not be found. The part may not have been uploaded, or the specified entity tag may not match the part's entity tag.",
+	"InvalidPartOrder":                        "The list of parts was not in ascending order. The parts list must be specified in order by part number.",
+	"InvalidRange":                            "The requested range is not satisfiable",
+	"InvalidRequest":                          "Invalid Request",
+	"InvalidStorageClass":                     "The storage class you specified is not valid",
+	"MalformedXML":                            "The XML you provided was not well-formed or did not validate against our published schema.",
+	"MalformedPolicy":                         "Policies must be valid JSON and the first byte must be '{'",
+	"MetadataTooLarge":                        "Your metadata headers exceed the maximum allowed metadata size.",
+	"MethodNotAllowed":                        "The specified method is not allowed against this resource.",
+	"MissingContentLength":                    "You must provide the Content-Length HTTP header.",
+	"MissingSecurityHeader":                   "Your request was missing a required header",
+	"NoSuchBucket":                            "The specified bucket does not exist",
+	"NoSuchBucketPolicy":                      "The bucket policy does not exist",
+	"NoSuchCORSConfiguration":                 "The CORS configuration does not exist",
+	"NoSuchKey":                               "The specified key does not exist.",
+	"NoSuchLifecycleConfiguration":            "The lifecycle configuration does not exist",
+	"NoSuchObjectLockConfiguration":           "The specified object does not have a ObjectLock configuration",
+	"NoSuchUpload":                            "The specified upload does not exist. The upload ID may be invalid, or the upload may have been aborted or completed.",
+	"NoSuchVersion":                           "The specified version does not exist.",
+	"NotFound":                                "The requested resource was not found",
+	"NotImplemented":                          "A header you provided implies functionality that is not implemented",
+	"ObjectLockConfigurationNotFoundError":    "Object Lock configuration does not exist for this bucket",
+	"PreconditionFailed":                      "At least one of the pre-conditions you specified did not hold",
+	"RequestTimeTooSkewed":                    "The difference between the request time and the server's time is too large.",
+	"ServiceUnavailable":                      "Service is unable to handle request.",
+	"SignatureDoesNotMatch":                   "The request signature we calculated does not match the signature you provided. Check your key and signing method.",
+	"XAmzContentSHA256Mismatch":               "The provided 'x-amz-content-sha256' header does not match what was computed.",
+}
+
+// Message returns S3's standard text for an error code, falling back to the
+// code itself.
+func Message(code string) string {
+	if message, ok := errorMessages[code]; ok {
+		return message
+	}
+	return code
+}
+
+// Error writes an S3 error body for code, with the Message for it.
+func Error(w http.ResponseWriter, r *http.Request, code string, status int) {
+	logger.LogError(w, r, code)
+
+	Encode(w, r, status, errorResponse{
+		Code:      code,
+		Message:   Message(code),
+		Resource:  r.URL.Path,
+		RequestID: requestid.RequestIDFromContext(r.Context()),
+	})
 }
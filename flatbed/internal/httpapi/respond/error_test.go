@@ -0,0 +1,102 @@
+package respond
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
+)
+
+func TestError(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name            string
+		code            string
+		status          int
+		accept          string
+		wantContentType string
+		wantMessage     string
+	}
+
+	cases := []tc{
+		{
+			name:            "known code gets S3 message as XML",
+			code:            "NoSuchBucket",
+			status:          http.StatusNotFound,
+			wantContentType: "application/xml",
+			wantMessage:     "The specified bucket does not exist",
+		},
+		{
+			name:            "unknown code falls back to the code",
+			code:            "SomethingOdd",
+			status:          http.StatusBadRequest,
+			wantContentType: "application/xml",
+			wantMessage:     "SomethingOdd",
+		},
+		{
+			name:            "Accept application/json returns JSON",
+			code:            "AccessDenied",
+			status:          http.StatusForbidden,
+			accept:          "application/json",
+			wantContentType: "application/json",
+			wantMessage:     "Access Denied",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/photos/sunset.jpg", nil)
+			req = req.WithContext(requestid.WithRequestID(req.Context(), "req-abc"))
+			if c.accept != "" {
+				req.Header.Set("Accept", c.accept)
+			}
+			rec := httptest.NewRecorder()
+			rec.Header().Set("Content-Length", "204800")
+
+			Error(rec, req, c.code, c.status)
+
+			if rec.Code != c.status {
+				t.Fatalf("status: got %d, want %d", rec.Code, c.status)
+			}
+			if got := rec.Header().Get("Content-Type"); got != c.wantContentType {
+				t.Fatalf("Content-Type: got %q, want %q", got, c.wantContentType)
+			}
+			if got := rec.Header().Get("Content-Length"); got != "" {
+				t.Fatalf("Content-Length: got %q, want it cleared", got)
+			}
+
+			var got errorResponse
+			var err error
+			if c.wantContentType == "application/json" {
+				err = json.Unmarshal(rec.Body.Bytes(), &got)
+			} else {
+				err = xml.Unmarshal(rec.Body.Bytes(), &got)
+			}
+			if err != nil {
+				t.Fatalf("decode body %q: %v", rec.Body.String(), err)
+			}
+
+			want := errorResponse{
+				XMLName:   got.XMLName,
+				Code:      c.code,
+				Message:   c.wantMessage,
+				Resource:  "/photos/sunset.jpg",
+				RequestID: "req-abc",
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Fatalf("error body mismatch (-want +got):\n%s", diff)
+			}
+			if c.wantContentType == "application/xml" && got.XMLName.Local != "Error" {
+				t.Fatalf("root element: got %q, want Error", got.XMLName.Local)
+			}
+		})
+	}
+}
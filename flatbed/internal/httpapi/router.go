@@ -3,6 +3,7 @@ package httpapi
 import (
 	"net/http"
 
+	"github.com/ratdaddy/blockcloset/flatbed/internal/config"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/middleware"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/logger"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/requestid"
@@ -13,17 +14,187 @@ type Handler interface {
 	CreateBucket(http.ResponseWriter, *http.Request)
 	ListBuckets(http.ResponseWriter, *http.Request)
 	PutObject(http.ResponseWriter, *http.Request)
+	CopyObject(http.ResponseWriter, *http.Request)
+	GetObject(http.ResponseWriter, *http.Request)
+	HeadBucket(http.ResponseWriter, *http.Request)
+	HeadObject(http.ResponseWriter, *http.Request)
+	DeleteObject(http.ResponseWriter, *http.Request)
+	DeleteBucket(http.ResponseWriter, *http.Request)
+	ListObjectsV2(http.ResponseWriter, *http.Request)
+	CreateMultipartUpload(http.ResponseWriter, *http.Request)
+	UploadPart(http.ResponseWriter, *http.Request)
+	CompleteMultipartUpload(http.ResponseWriter, *http.Request)
+	AbortMultipartUpload(http.ResponseWriter, *http.Request)
+	ListMultipartUploads(http.ResponseWriter, *http.Request)
+	ListParts(http.ResponseWriter, *http.Request)
+	PutBucketPolicy(http.ResponseWriter, *http.Request)
+	GetBucketPolicy(http.ResponseWriter, *http.Request)
+	DeleteBucketPolicy(http.ResponseWriter, *http.Request)
+	PutBucketAcl(http.ResponseWriter, *http.Request)
+	GetBucketAcl(http.ResponseWriter, *http.Request)
+	PutBucketVersioning(http.ResponseWriter, *http.Request)
+	GetBucketVersioning(http.ResponseWriter, *http.Request)
+	ListObjectVersions(http.ResponseWriter, *http.Request)
+	PutBucketLifecycleConfiguration(http.ResponseWriter, *http.Request)
+	GetBucketLifecycleConfiguration(http.ResponseWriter, *http.Request)
+	DeleteBucketLifecycle(http.ResponseWriter, *http.Request)
+	PutBucketCors(http.ResponseWriter, *http.Request)
+	GetBucketCors(http.ResponseWriter, *http.Request)
+	DeleteBucketCors(http.ResponseWriter, *http.Request)
+	PutObjectTagging(http.ResponseWriter, *http.Request)
+	GetObjectTagging(http.ResponseWriter, *http.Request)
+	DeleteObjectTagging(http.ResponseWriter, *http.Request)
+	DeleteObjects(http.ResponseWriter, *http.Request)
+	PutObjectLockConfiguration(http.ResponseWriter, *http.Request)
+	GetObjectLockConfiguration(http.ResponseWriter, *http.Request)
+	PutObjectRetention(http.ResponseWriter, *http.Request)
+	GetObjectRetention(http.ResponseWriter, *http.Request)
+	PutObjectLegalHold(http.ResponseWriter, *http.Request)
+	GetObjectLegalHold(http.ResponseWriter, *http.Request)
 }
 
-// NewRouter creates an HTTP router
-func NewRouter(h Handler) http.Handler {
+// NewRouter creates an HTTP router. When config.AuthMode is sigv4, signed
+// requests must be SigV4 signed with an access key found in keys; unsigned
+// ones are made anonymously, except that listing and creating buckets always
+// need a signature. Browser requests are checked against the bucket CORS
+// rules found in cors before they are authenticated.
+func NewRouter(h Handler, keys middleware.AccessKeyLookup, cors middleware.CORSLookup) http.Handler {
 	mux := http.NewServeMux()
 
+	signed := func(next http.HandlerFunc) http.HandlerFunc {
+		if config.AuthMode != config.AuthSigV4 {
+			return next
+		}
+		return middleware.RequireSignature(next)
+	}
+
 	// Register routes
 	// Use /{$} to match exactly "/" and not act as a prefix matcher
-	mux.HandleFunc("GET /{$}", h.ListBuckets)
-	mux.HandleFunc("PUT /{bucket}/{key...}", h.PutObject)
-	mux.HandleFunc("PUT /{bucket}", h.CreateBucket)
+	mux.HandleFunc("GET /{$}", signed(h.ListBuckets))
+	mux.HandleFunc("HEAD /{bucket}/{key...}", h.HeadObject)
+
+	// Multipart uploads share the object paths and are told apart by their
+	// query parameters, as in S3.
+	mux.HandleFunc("GET /{bucket}/{key...}", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Has("uploadId"):
+			h.ListParts(w, r)
+		case r.URL.Query().Has("tagging"):
+			h.GetObjectTagging(w, r)
+		case r.URL.Query().Has("retention"):
+			h.GetObjectRetention(w, r)
+		case r.URL.Query().Has("legal-hold"):
+			h.GetObjectLegalHold(w, r)
+		default:
+			h.GetObject(w, r)
+		}
+	})
+	mux.HandleFunc("PUT /{bucket}/{key...}", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Has("uploadId"):
+			h.UploadPart(w, r)
+		case r.URL.Query().Has("tagging"):
+			h.PutObjectTagging(w, r)
+		case r.URL.Query().Has("retention"):
+			h.PutObjectRetention(w, r)
+		case r.URL.Query().Has("legal-hold"):
+			h.PutObjectLegalHold(w, r)
+		case r.Header.Get("x-amz-copy-source") != "":
+			h.CopyObject(w, r)
+		default:
+			h.PutObject(w, r)
+		}
+	})
+	mux.HandleFunc("DELETE /{bucket}/{key...}", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Has("uploadId"):
+			h.AbortMultipartUpload(w, r)
+		case r.URL.Query().Has("tagging"):
+			h.DeleteObjectTagging(w, r)
+		default:
+			h.DeleteObject(w, r)
+		}
+	})
+	mux.HandleFunc("POST /{bucket}/{key...}", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch {
+		case query.Has("uploads"):
+			h.CreateMultipartUpload(w, r)
+		case query.Has("uploadId"):
+			h.CompleteMultipartUpload(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	// Without an exact GET /{bucket} route, ServeMux redirects /bucket to the
+	// /bucket/ object subtree, which the trailing slash middleware strips again.
+	// HEAD is dispatched here too because a separate HEAD /{bucket} pattern
+	// conflicts with GET /panic.
+	mux.HandleFunc("GET /{bucket}", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodHead:
+			h.HeadBucket(w, r)
+		case r.URL.Query().Get("list-type") == "2":
+			h.ListObjectsV2(w, r)
+		case r.URL.Query().Has("uploads"):
+			h.ListMultipartUploads(w, r)
+		case r.URL.Query().Has("policy"):
+			h.GetBucketPolicy(w, r)
+		case r.URL.Query().Has("acl"):
+			h.GetBucketAcl(w, r)
+		case r.URL.Query().Has("versioning"):
+			h.GetBucketVersioning(w, r)
+		case r.URL.Query().Has("versions"):
+			h.ListObjectVersions(w, r)
+		case r.URL.Query().Has("lifecycle"):
+			h.GetBucketLifecycleConfiguration(w, r)
+		case r.URL.Query().Has("cors"):
+			h.GetBucketCors(w, r)
+		case r.URL.Query().Has("object-lock"):
+			h.GetObjectLockConfiguration(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	mux.HandleFunc("PUT /{bucket}", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Has("policy"):
+			h.PutBucketPolicy(w, r)
+		case r.URL.Query().Has("acl"):
+			h.PutBucketAcl(w, r)
+		case r.URL.Query().Has("versioning"):
+			h.PutBucketVersioning(w, r)
+		case r.URL.Query().Has("lifecycle"):
+			h.PutBucketLifecycleConfiguration(w, r)
+		case r.URL.Query().Has("cors"):
+			h.PutBucketCors(w, r)
+		case r.URL.Query().Has("object-lock"):
+			h.PutObjectLockConfiguration(w, r)
+		default:
+			signed(h.CreateBucket)(w, r)
+		}
+	})
+	mux.HandleFunc("DELETE /{bucket}", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Has("policy"):
+			h.DeleteBucketPolicy(w, r)
+		case r.URL.Query().Has("lifecycle"):
+			h.DeleteBucketLifecycle(w, r)
+		case r.URL.Query().Has("cors"):
+			h.DeleteBucketCors(w, r)
+		default:
+			h.DeleteBucket(w, r)
+		}
+	})
+	mux.HandleFunc("POST /{bucket}", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Has("delete"):
+			h.DeleteObjects(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
 
 	mux.HandleFunc("GET /panic", func(w http.ResponseWriter, r *http.Request) {
 		panic("intentional test panic")
@@ -33,6 +204,10 @@ func NewRouter(h Handler) http.Handler {
 	var handler http.Handler = mux
 	handler = middleware.StripTrailingSlashForBuckets(handler)
 	handler = middleware.ErrorHandler(handler)
+	if config.AuthMode == config.AuthSigV4 {
+		handler = middleware.SigV4Auth(keys)(handler)
+	}
+	handler = middleware.CORS(cors)(handler)
 	handler = requestid.RequestID()(handler)
 	handler = logger.RequestLogger(handler)
 
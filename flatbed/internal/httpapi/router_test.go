@@ -1,11 +1,21 @@
 package httpapi_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/config"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
 	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/httpapi/handlers"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/identity"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
 )
 
 // Table entries validate routing-only behavior. To cover a new endpoint,
@@ -14,13 +24,51 @@ import (
 // router triggers the correct handler without involving Gantry stubs.
 
 type stubBucketHandlers struct {
-	createStatus    int
-	listStatus      int
-	putObjectStatus int
-	createCalls     int
-	listCalls       int
-	putObjectCalls  int
-	lastPutKey      string
+	createStatus         int
+	listStatus           int
+	putObjectStatus      int
+	getObjectStatus      int
+	createCalls          int
+	listCalls            int
+	putObjectCalls       int
+	copyObjectCalls      int
+	getObjectCalls       int
+	headBucketCalls      int
+	headObjectCalls      int
+	deleteObjectCalls    int
+	deleteBucketCalls    int
+	listObjectsCalls     int
+	createUploadCalls    int
+	uploadPartCalls      int
+	completeCalls        int
+	abortCalls           int
+	listUploadsCalls     int
+	listPartsCalls       int
+	putPolicyCalls       int
+	getPolicyCalls       int
+	deletePolicyCalls    int
+	putACLCalls          int
+	getACLCalls          int
+	putVersioningCalls   int
+	getVersioningCalls   int
+	listVersionsCalls    int
+	putLifecycleCalls    int
+	getLifecycleCalls    int
+	deleteLifecycleCalls int
+	putCORSCalls         int
+	getCORSCalls         int
+	deleteCORSCalls      int
+	putTaggingCalls      int
+	getTaggingCalls      int
+	deleteTaggingCalls   int
+	deleteObjectsCalls   int
+	putObjectLockCalls   int
+	getObjectLockCalls   int
+	putRetentionCalls    int
+	getRetentionCalls    int
+	putLegalHoldCalls    int
+	getLegalHoldCalls    int
+	lastKey              string
 }
 
 func newStubBucketHandlers() *stubBucketHandlers {
@@ -28,6 +76,7 @@ func newStubBucketHandlers() *stubBucketHandlers {
 		createStatus:    http.StatusCreated,
 		listStatus:      http.StatusOK,
 		putObjectStatus: http.StatusOK,
+		getObjectStatus: http.StatusOK,
 	}
 }
 
@@ -43,10 +92,211 @@ func (s *stubBucketHandlers) ListBuckets(w http.ResponseWriter, r *http.Request)
 
 func (s *stubBucketHandlers) PutObject(w http.ResponseWriter, r *http.Request) {
 	s.putObjectCalls++
-	s.lastPutKey = r.PathValue("key")
+	s.lastKey = r.PathValue("key")
 	w.WriteHeader(s.putObjectStatus)
 }
 
+func (s *stubBucketHandlers) CopyObject(w http.ResponseWriter, r *http.Request) {
+	s.copyObjectCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) GetObject(w http.ResponseWriter, r *http.Request) {
+	s.getObjectCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(s.getObjectStatus)
+}
+
+func (s *stubBucketHandlers) HeadBucket(w http.ResponseWriter, r *http.Request) {
+	s.headBucketCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) HeadObject(w http.ResponseWriter, r *http.Request) {
+	s.headObjectCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) DeleteObject(w http.ResponseWriter, r *http.Request) {
+	s.deleteObjectCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *stubBucketHandlers) DeleteBucket(w http.ResponseWriter, r *http.Request) {
+	s.deleteBucketCalls++
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *stubBucketHandlers) ListObjectsV2(w http.ResponseWriter, r *http.Request) {
+	s.listObjectsCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) CreateMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	s.createUploadCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) UploadPart(w http.ResponseWriter, r *http.Request) {
+	s.uploadPartCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) CompleteMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	s.completeCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) AbortMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	s.abortCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *stubBucketHandlers) ListMultipartUploads(w http.ResponseWriter, r *http.Request) {
+	s.listUploadsCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) ListParts(w http.ResponseWriter, r *http.Request) {
+	s.listPartsCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) PutBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	s.putPolicyCalls++
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *stubBucketHandlers) GetBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	s.getPolicyCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) DeleteBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	s.deletePolicyCalls++
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *stubBucketHandlers) PutBucketAcl(w http.ResponseWriter, r *http.Request) {
+	s.putACLCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) GetBucketAcl(w http.ResponseWriter, r *http.Request) {
+	s.getACLCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) PutBucketVersioning(w http.ResponseWriter, r *http.Request) {
+	s.putVersioningCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) GetBucketVersioning(w http.ResponseWriter, r *http.Request) {
+	s.getVersioningCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) ListObjectVersions(w http.ResponseWriter, r *http.Request) {
+	s.listVersionsCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) PutBucketLifecycleConfiguration(w http.ResponseWriter, r *http.Request) {
+	s.putLifecycleCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) GetBucketLifecycleConfiguration(w http.ResponseWriter, r *http.Request) {
+	s.getLifecycleCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) DeleteBucketLifecycle(w http.ResponseWriter, r *http.Request) {
+	s.deleteLifecycleCalls++
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *stubBucketHandlers) PutBucketCors(w http.ResponseWriter, r *http.Request) {
+	s.putCORSCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) GetBucketCors(w http.ResponseWriter, r *http.Request) {
+	s.getCORSCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) DeleteBucketCors(w http.ResponseWriter, r *http.Request) {
+	s.deleteCORSCalls++
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *stubBucketHandlers) PutObjectTagging(w http.ResponseWriter, r *http.Request) {
+	s.putTaggingCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) GetObjectTagging(w http.ResponseWriter, r *http.Request) {
+	s.getTaggingCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) DeleteObjectTagging(w http.ResponseWriter, r *http.Request) {
+	s.deleteTaggingCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *stubBucketHandlers) DeleteObjects(w http.ResponseWriter, r *http.Request) {
+	s.deleteObjectsCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) PutObjectLockConfiguration(w http.ResponseWriter, r *http.Request) {
+	s.putObjectLockCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) GetObjectLockConfiguration(w http.ResponseWriter, r *http.Request) {
+	s.getObjectLockCalls++
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) PutObjectRetention(w http.ResponseWriter, r *http.Request) {
+	s.putRetentionCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) GetObjectRetention(w http.ResponseWriter, r *http.Request) {
+	s.getRetentionCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) PutObjectLegalHold(w http.ResponseWriter, r *http.Request) {
+	s.putLegalHoldCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *stubBucketHandlers) GetObjectLegalHold(w http.ResponseWriter, r *http.Request) {
+	s.getLegalHoldCalls++
+	s.lastKey = r.PathValue("key")
+	w.WriteHeader(http.StatusOK)
+}
+
 func (s *stubBucketHandlers) CreateCount() int {
 	return s.createCalls
 }
@@ -59,6 +309,154 @@ func (s *stubBucketHandlers) PutObjectCount() int {
 	return s.putObjectCalls
 }
 
+func (s *stubBucketHandlers) CopyObjectCount() int {
+	return s.copyObjectCalls
+}
+
+func (s *stubBucketHandlers) GetObjectCount() int {
+	return s.getObjectCalls
+}
+
+func (s *stubBucketHandlers) HeadBucketCount() int {
+	return s.headBucketCalls
+}
+
+func (s *stubBucketHandlers) HeadObjectCount() int {
+	return s.headObjectCalls
+}
+
+func (s *stubBucketHandlers) DeleteObjectCount() int {
+	return s.deleteObjectCalls
+}
+
+func (s *stubBucketHandlers) DeleteBucketCount() int {
+	return s.deleteBucketCalls
+}
+
+func (s *stubBucketHandlers) ListObjectsCount() int {
+	return s.listObjectsCalls
+}
+
+func (s *stubBucketHandlers) CreateUploadCount() int {
+	return s.createUploadCalls
+}
+
+func (s *stubBucketHandlers) UploadPartCount() int {
+	return s.uploadPartCalls
+}
+
+func (s *stubBucketHandlers) CompleteCount() int {
+	return s.completeCalls
+}
+
+func (s *stubBucketHandlers) AbortCount() int {
+	return s.abortCalls
+}
+
+func (s *stubBucketHandlers) ListUploadsCount() int {
+	return s.listUploadsCalls
+}
+
+func (s *stubBucketHandlers) ListPartsCount() int {
+	return s.listPartsCalls
+}
+
+func (s *stubBucketHandlers) PutPolicyCount() int {
+	return s.putPolicyCalls
+}
+
+func (s *stubBucketHandlers) GetPolicyCount() int {
+	return s.getPolicyCalls
+}
+
+func (s *stubBucketHandlers) DeletePolicyCount() int {
+	return s.deletePolicyCalls
+}
+
+func (s *stubBucketHandlers) PutACLCount() int {
+	return s.putACLCalls
+}
+
+func (s *stubBucketHandlers) GetACLCount() int {
+	return s.getACLCalls
+}
+
+func (s *stubBucketHandlers) PutVersioningCount() int {
+	return s.putVersioningCalls
+}
+
+func (s *stubBucketHandlers) GetVersioningCount() int {
+	return s.getVersioningCalls
+}
+
+func (s *stubBucketHandlers) ListVersionsCount() int {
+	return s.listVersionsCalls
+}
+
+func (s *stubBucketHandlers) PutLifecycleCount() int {
+	return s.putLifecycleCalls
+}
+
+func (s *stubBucketHandlers) GetLifecycleCount() int {
+	return s.getLifecycleCalls
+}
+
+func (s *stubBucketHandlers) DeleteLifecycleCount() int {
+	return s.deleteLifecycleCalls
+}
+
+func (s *stubBucketHandlers) PutCORSCount() int {
+	return s.putCORSCalls
+}
+
+func (s *stubBucketHandlers) GetCORSCount() int {
+	return s.getCORSCalls
+}
+
+func (s *stubBucketHandlers) DeleteCORSCount() int {
+	return s.deleteCORSCalls
+}
+
+func (s *stubBucketHandlers) PutTaggingCount() int {
+	return s.putTaggingCalls
+}
+
+func (s *stubBucketHandlers) GetTaggingCount() int {
+	return s.getTaggingCalls
+}
+
+func (s *stubBucketHandlers) DeleteTaggingCount() int {
+	return s.deleteTaggingCalls
+}
+
+func (s *stubBucketHandlers) DeleteObjectsCount() int {
+	return s.deleteObjectsCalls
+}
+
+func (s *stubBucketHandlers) PutObjectLockCount() int {
+	return s.putObjectLockCalls
+}
+
+func (s *stubBucketHandlers) GetObjectLockCount() int {
+	return s.getObjectLockCalls
+}
+
+func (s *stubBucketHandlers) PutRetentionCount() int {
+	return s.putRetentionCalls
+}
+
+func (s *stubBucketHandlers) GetRetentionCount() int {
+	return s.getRetentionCalls
+}
+
+func (s *stubBucketHandlers) PutLegalHoldCount() int {
+	return s.putLegalHoldCalls
+}
+
+func (s *stubBucketHandlers) GetLegalHoldCount() int {
+	return s.getLegalHoldCalls
+}
+
 func TestRouterChi_Routing(t *testing.T) {
 	t.Parallel()
 
@@ -66,6 +464,7 @@ func TestRouterChi_Routing(t *testing.T) {
 		name       string
 		method     string
 		target     string
+		header     http.Header
 		wantStatus int
 		callName   string
 		callCount  func(*stubBucketHandlers) int
@@ -106,6 +505,324 @@ func TestRouterChi_Routing(t *testing.T) {
 			callCount:  (*stubBucketHandlers).PutObjectCount,
 			wantKey:    "path/to/key",
 		},
+		{
+			name:       "PUT /{bucket}/{key} with x-amz-copy-source routes to CopyObject",
+			method:     http.MethodPut,
+			target:     "/bucket/path/to/copy",
+			header:     http.Header{"X-Amz-Copy-Source": {"source/key"}},
+			wantStatus: http.StatusOK,
+			callName:   "copy object handler",
+			callCount:  (*stubBucketHandlers).CopyObjectCount,
+			wantKey:    "path/to/copy",
+		},
+		{
+			name:       "GET /{bucket}/{key} routes to GetObject",
+			method:     http.MethodGet,
+			target:     "/bucket/path/to/key",
+			wantStatus: http.StatusOK,
+			callName:   "get object handler",
+			callCount:  (*stubBucketHandlers).GetObjectCount,
+			wantKey:    "path/to/key",
+		},
+		{
+			name:       "HEAD /{bucket} routes to HeadBucket",
+			method:     http.MethodHead,
+			target:     "/alpha-bucket",
+			wantStatus: http.StatusOK,
+			callName:   "head bucket handler",
+			callCount:  (*stubBucketHandlers).HeadBucketCount,
+		},
+		{
+			name:       "HEAD /{bucket}/{key} routes to HeadObject",
+			method:     http.MethodHead,
+			target:     "/bucket/path/to/key",
+			wantStatus: http.StatusOK,
+			callName:   "head object handler",
+			callCount:  (*stubBucketHandlers).HeadObjectCount,
+			wantKey:    "path/to/key",
+		},
+		{
+			name:       "DELETE /{bucket}/{key} routes to DeleteObject",
+			method:     http.MethodDelete,
+			target:     "/bucket/path/to/key",
+			wantStatus: http.StatusNoContent,
+			callName:   "delete object handler",
+			callCount:  (*stubBucketHandlers).DeleteObjectCount,
+			wantKey:    "path/to/key",
+		},
+		{
+			name:       "DELETE /{bucket} routes to DeleteBucket",
+			method:     http.MethodDelete,
+			target:     "/alpha-bucket",
+			wantStatus: http.StatusNoContent,
+			callName:   "delete bucket handler",
+			callCount:  (*stubBucketHandlers).DeleteBucketCount,
+		},
+		{
+			name:       "GET /{bucket}?list-type=2 routes to ListObjectsV2",
+			method:     http.MethodGet,
+			target:     "/alpha-bucket?list-type=2&prefix=photos%2F",
+			wantStatus: http.StatusOK,
+			callName:   "list objects handler",
+			callCount:  (*stubBucketHandlers).ListObjectsCount,
+		},
+		{
+			name:       "POST /{bucket}/{key}?uploads routes to CreateMultipartUpload",
+			method:     http.MethodPost,
+			target:     "/bucket/videos/big.mp4?uploads",
+			wantStatus: http.StatusOK,
+			callName:   "create multipart upload handler",
+			callCount:  (*stubBucketHandlers).CreateUploadCount,
+			wantKey:    "videos/big.mp4",
+		},
+		{
+			name:       "PUT /{bucket}/{key}?uploadId routes to UploadPart",
+			method:     http.MethodPut,
+			target:     "/bucket/videos/big.mp4?partNumber=1&uploadId=abc",
+			wantStatus: http.StatusOK,
+			callName:   "upload part handler",
+			callCount:  (*stubBucketHandlers).UploadPartCount,
+			wantKey:    "videos/big.mp4",
+		},
+		{
+			name:       "POST /{bucket}/{key}?uploadId routes to CompleteMultipartUpload",
+			method:     http.MethodPost,
+			target:     "/bucket/videos/big.mp4?uploadId=abc",
+			wantStatus: http.StatusOK,
+			callName:   "complete multipart upload handler",
+			callCount:  (*stubBucketHandlers).CompleteCount,
+			wantKey:    "videos/big.mp4",
+		},
+		{
+			name:       "DELETE /{bucket}/{key}?uploadId routes to AbortMultipartUpload",
+			method:     http.MethodDelete,
+			target:     "/bucket/videos/big.mp4?uploadId=abc",
+			wantStatus: http.StatusNoContent,
+			callName:   "abort multipart upload handler",
+			callCount:  (*stubBucketHandlers).AbortCount,
+			wantKey:    "videos/big.mp4",
+		},
+		{
+			name:       "GET /{bucket}?uploads routes to ListMultipartUploads",
+			method:     http.MethodGet,
+			target:     "/alpha-bucket?uploads&prefix=videos%2F",
+			wantStatus: http.StatusOK,
+			callName:   "list multipart uploads handler",
+			callCount:  (*stubBucketHandlers).ListUploadsCount,
+		},
+		{
+			name:       "GET /{bucket}/{key}?uploadId routes to ListParts",
+			method:     http.MethodGet,
+			target:     "/bucket/videos/big.mp4?uploadId=abc",
+			wantStatus: http.StatusOK,
+			callName:   "list parts handler",
+			callCount:  (*stubBucketHandlers).ListPartsCount,
+			wantKey:    "videos/big.mp4",
+		},
+		{
+			name:       "PUT /{bucket}?policy routes to PutBucketPolicy",
+			method:     http.MethodPut,
+			target:     "/alpha-bucket?policy",
+			wantStatus: http.StatusNoContent,
+			callName:   "put bucket policy handler",
+			callCount:  (*stubBucketHandlers).PutPolicyCount,
+		},
+		{
+			name:       "GET /{bucket}?policy routes to GetBucketPolicy",
+			method:     http.MethodGet,
+			target:     "/alpha-bucket?policy",
+			wantStatus: http.StatusOK,
+			callName:   "get bucket policy handler",
+			callCount:  (*stubBucketHandlers).GetPolicyCount,
+		},
+		{
+			name:       "DELETE /{bucket}?policy routes to DeleteBucketPolicy",
+			method:     http.MethodDelete,
+			target:     "/alpha-bucket?policy",
+			wantStatus: http.StatusNoContent,
+			callName:   "delete bucket policy handler",
+			callCount:  (*stubBucketHandlers).DeletePolicyCount,
+		},
+		{
+			name:       "PUT /{bucket}?acl routes to PutBucketAcl",
+			method:     http.MethodPut,
+			target:     "/alpha-bucket?acl",
+			wantStatus: http.StatusOK,
+			callName:   "put bucket acl handler",
+			callCount:  (*stubBucketHandlers).PutACLCount,
+		},
+		{
+			name:       "GET /{bucket}?acl routes to GetBucketAcl",
+			method:     http.MethodGet,
+			target:     "/alpha-bucket?acl",
+			wantStatus: http.StatusOK,
+			callName:   "get bucket acl handler",
+			callCount:  (*stubBucketHandlers).GetACLCount,
+		},
+		{
+			name:       "PUT /{bucket}?versioning routes to PutBucketVersioning",
+			method:     http.MethodPut,
+			target:     "/alpha-bucket?versioning",
+			wantStatus: http.StatusOK,
+			callName:   "put bucket versioning handler",
+			callCount:  (*stubBucketHandlers).PutVersioningCount,
+		},
+		{
+			name:       "GET /{bucket}?versioning routes to GetBucketVersioning",
+			method:     http.MethodGet,
+			target:     "/alpha-bucket?versioning",
+			wantStatus: http.StatusOK,
+			callName:   "get bucket versioning handler",
+			callCount:  (*stubBucketHandlers).GetVersioningCount,
+		},
+		{
+			name:       "GET /{bucket}?versions routes to ListObjectVersions",
+			method:     http.MethodGet,
+			target:     "/alpha-bucket?versions",
+			wantStatus: http.StatusOK,
+			callName:   "list object versions handler",
+			callCount:  (*stubBucketHandlers).ListVersionsCount,
+		},
+		{
+			name:       "PUT /{bucket}?lifecycle routes to PutBucketLifecycleConfiguration",
+			method:     http.MethodPut,
+			target:     "/alpha-bucket?lifecycle",
+			wantStatus: http.StatusOK,
+			callName:   "put bucket lifecycle handler",
+			callCount:  (*stubBucketHandlers).PutLifecycleCount,
+		},
+		{
+			name:       "GET /{bucket}?lifecycle routes to GetBucketLifecycleConfiguration",
+			method:     http.MethodGet,
+			target:     "/alpha-bucket?lifecycle",
+			wantStatus: http.StatusOK,
+			callName:   "get bucket lifecycle handler",
+			callCount:  (*stubBucketHandlers).GetLifecycleCount,
+		},
+		{
+			name:       "DELETE /{bucket}?lifecycle routes to DeleteBucketLifecycle",
+			method:     http.MethodDelete,
+			target:     "/alpha-bucket?lifecycle",
+			wantStatus: http.StatusNoContent,
+			callName:   "delete bucket lifecycle handler",
+			callCount:  (*stubBucketHandlers).DeleteLifecycleCount,
+		},
+		{
+			name:       "PUT /{bucket}?cors routes to PutBucketCors",
+			method:     http.MethodPut,
+			target:     "/alpha-bucket?cors",
+			wantStatus: http.StatusOK,
+			callName:   "put bucket cors handler",
+			callCount:  (*stubBucketHandlers).PutCORSCount,
+		},
+		{
+			name:       "GET /{bucket}?cors routes to GetBucketCors",
+			method:     http.MethodGet,
+			target:     "/alpha-bucket?cors",
+			wantStatus: http.StatusOK,
+			callName:   "get bucket cors handler",
+			callCount:  (*stubBucketHandlers).GetCORSCount,
+		},
+		{
+			name:       "DELETE /{bucket}?cors routes to DeleteBucketCors",
+			method:     http.MethodDelete,
+			target:     "/alpha-bucket?cors",
+			wantStatus: http.StatusNoContent,
+			callName:   "delete bucket cors handler",
+			callCount:  (*stubBucketHandlers).DeleteCORSCount,
+		},
+		{
+			name:       "PUT /{bucket}/{key}?tagging routes to PutObjectTagging",
+			method:     http.MethodPut,
+			target:     "/bucket/backups/db.tar?tagging",
+			wantStatus: http.StatusOK,
+			callName:   "put object tagging handler",
+			callCount:  (*stubBucketHandlers).PutTaggingCount,
+		},
+		{
+			name:       "GET /{bucket}/{key}?tagging routes to GetObjectTagging",
+			method:     http.MethodGet,
+			target:     "/bucket/backups/db.tar?tagging",
+			wantStatus: http.StatusOK,
+			callName:   "get object tagging handler",
+			callCount:  (*stubBucketHandlers).GetTaggingCount,
+		},
+		{
+			name:       "DELETE /{bucket}/{key}?tagging routes to DeleteObjectTagging",
+			method:     http.MethodDelete,
+			target:     "/bucket/backups/db.tar?tagging",
+			wantStatus: http.StatusNoContent,
+			callName:   "delete object tagging handler",
+			callCount:  (*stubBucketHandlers).DeleteTaggingCount,
+		},
+		{
+			name:       "POST /{bucket}?delete routes to DeleteObjects",
+			method:     http.MethodPost,
+			target:     "/alpha-bucket?delete",
+			wantStatus: http.StatusOK,
+			callName:   "delete objects handler",
+			callCount:  (*stubBucketHandlers).DeleteObjectsCount,
+		},
+		{
+			name:       "PUT /{bucket}?object-lock routes to PutObjectLockConfiguration",
+			method:     http.MethodPut,
+			target:     "/alpha-bucket?object-lock",
+			wantStatus: http.StatusOK,
+			callName:   "put object lock configuration handler",
+			callCount:  (*stubBucketHandlers).PutObjectLockCount,
+		},
+		{
+			name:       "GET /{bucket}?object-lock routes to GetObjectLockConfiguration",
+			method:     http.MethodGet,
+			target:     "/alpha-bucket?object-lock",
+			wantStatus: http.StatusOK,
+			callName:   "get object lock configuration handler",
+			callCount:  (*stubBucketHandlers).GetObjectLockCount,
+		},
+		{
+			name:       "PUT /{bucket}/{key}?retention routes to PutObjectRetention",
+			method:     http.MethodPut,
+			target:     "/bucket/backups/db.tar?retention",
+			wantStatus: http.StatusOK,
+			callName:   "put object retention handler",
+			callCount:  (*stubBucketHandlers).PutRetentionCount,
+		},
+		{
+			name:       "GET /{bucket}/{key}?retention routes to GetObjectRetention",
+			method:     http.MethodGet,
+			target:     "/bucket/backups/db.tar?retention",
+			wantStatus: http.StatusOK,
+			callName:   "get object retention handler",
+			callCount:  (*stubBucketHandlers).GetRetentionCount,
+		},
+		{
+			name:       "PUT /{bucket}/{key}?legal-hold routes to PutObjectLegalHold",
+			method:     http.MethodPut,
+			target:     "/bucket/backups/db.tar?legal-hold",
+			wantStatus: http.StatusOK,
+			callName:   "put object legal hold handler",
+			callCount:  (*stubBucketHandlers).PutLegalHoldCount,
+		},
+		{
+			name:       "GET /{bucket}/{key}?legal-hold routes to GetObjectLegalHold",
+			method:     http.MethodGet,
+			target:     "/bucket/backups/db.tar?legal-hold",
+			wantStatus: http.StatusOK,
+			callName:   "get object legal hold handler",
+			callCount:  (*stubBucketHandlers).GetLegalHoldCount,
+		},
+		{
+			name:       "POST /{bucket} without delete => 404",
+			method:     http.MethodPost,
+			target:     "/alpha-bucket",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "POST /{bucket}/{key} without upload params => 404",
+			method:     http.MethodPost,
+			target:     "/bucket/videos/big.mp4",
+			wantStatus: http.StatusNotFound,
+		},
 		{
 			name:       "GET list buckets",
 			method:     http.MethodGet,
@@ -125,10 +842,13 @@ func TestRouterChi_Routing(t *testing.T) {
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
 			h := newStubBucketHandlers()
-			r := httpapi.NewRouter(h)
+			r := httpapi.NewRouter(h, nil, nil)
 
 			rec := httptest.NewRecorder()
 			req := httptest.NewRequest(c.method, c.target, nil)
+			for name, values := range c.header {
+				req.Header[name] = values
+			}
 			r.ServeHTTP(rec, req)
 
 			if rec.Code != c.wantStatus {
@@ -142,10 +862,106 @@ func TestRouterChi_Routing(t *testing.T) {
 			}
 
 			if c.wantKey != "" {
-				if h.lastPutKey != c.wantKey {
-					t.Fatalf("%s: key got %q, want %q", c.name, h.lastPutKey, c.wantKey)
+				if h.lastKey != c.wantKey {
+					t.Fatalf("%s: key got %q, want %q", c.name, h.lastKey, c.wantKey)
 				}
 			}
 		})
 	}
 }
+
+type stubCORSLookup []gantry.CORSRule
+
+func (s stubCORSLookup) LookupBucketCors(context.Context, string) ([]gantry.CORSRule, error) {
+	return s, nil
+}
+
+func TestRouter_CORS(t *testing.T) {
+	cors := stubCORSLookup{{AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET"}}}
+
+	t.Run("OPTIONS preflight is answered without a handler", func(t *testing.T) {
+		h := newStubBucketHandlers()
+		r := httpapi.NewRouter(h, nil, cors)
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodOptions, "/alpha-bucket/photo.jpg", nil)
+		req.Header.Set("Origin", "https://gallery.example.com")
+		req.Header.Set("Access-Control-Request-Method", "GET")
+		r.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status: got %d, want %d", rec.Code, http.StatusOK)
+		}
+		if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://gallery.example.com" {
+			t.Fatalf("Access-Control-Allow-Origin: got %q", got)
+		}
+		if h.GetObjectCount() != 0 {
+			t.Fatalf("get object handler called for a preflight")
+		}
+	})
+
+	t.Run("cross-origin GET reaches its handler with CORS headers", func(t *testing.T) {
+		h := newStubBucketHandlers()
+		r := httpapi.NewRouter(h, nil, cors)
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/alpha-bucket/photo.jpg", nil)
+		req.Header.Set("Origin", "https://gallery.example.com")
+		r.ServeHTTP(rec, req)
+
+		if h.GetObjectCount() != 1 {
+			t.Fatalf("get object handler count: got %d, want 1", h.GetObjectCount())
+		}
+		if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://gallery.example.com" {
+			t.Fatalf("Access-Control-Allow-Origin: got %q", got)
+		}
+	})
+}
+
+func TestRouter_AnonymousAccess(t *testing.T) {
+	prev := config.AuthMode
+	config.AuthMode = config.AuthSigV4
+	t.Cleanup(func() { config.AuthMode = prev })
+
+	// The stub stands in for gantry's authorization: anyone may read the
+	// public-read bucket, only a signed caller the private one.
+	gantryStub := testutil.NewGantryStub()
+	gantryStub.LookupObjectFn = func(ctx context.Context, bucket, key, _ string) (gantry.Object, error) {
+		if bucket != "public-bucket" && identity.UserIDFromContext(ctx) == "" {
+			return gantry.Object{}, status.Error(codes.PermissionDenied, "AccessDenied")
+		}
+		return gantry.Object{ID: "obj-1", Key: key, Size: int64(len(testutil.StubObjectBody)), CradleAddress: "localhost:9001"}, nil
+	}
+	r := httpapi.NewRouter(handlers.NewHandlers(gantryStub, testutil.NewCradleStub()), gantryStub, nil)
+
+	cases := []struct {
+		name       string
+		method     string
+		target     string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "GET on a public-read bucket", method: http.MethodGet, target: "/public-bucket/photo.jpg", wantStatus: http.StatusOK, wantBody: testutil.StubObjectBody},
+		{name: "GET on a private bucket", method: http.MethodGet, target: "/private-bucket/photo.jpg", wantStatus: http.StatusForbidden, wantBody: "<Code>AccessDenied</Code>"},
+		{name: "create bucket", method: http.MethodPut, target: "/new-bucket", wantStatus: http.StatusForbidden, wantBody: "<Code>AccessDenied</Code>"},
+		{name: "list buckets", method: http.MethodGet, target: "/", wantStatus: http.StatusForbidden, wantBody: "<Code>AccessDenied</Code>"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, httptest.NewRequest(c.method, c.target, nil))
+
+			if rec.Code != c.wantStatus {
+				t.Fatalf("status: got %d, want %d; body %s", rec.Code, c.wantStatus, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), c.wantBody) {
+				t.Fatalf("body: got %q, want it to contain %q", rec.Body.String(), c.wantBody)
+			}
+		})
+	}
+
+	if got := gantryStub.CreateCount(); got != 0 {
+		t.Fatalf("gantry create calls: got %d, want 0", got)
+	}
+}
@@ -0,0 +1,22 @@
+// Package identity carries the user a request was authenticated as.
+package identity
+
+import "context"
+
+type ctxKey int
+
+const ctxKeyUserID ctxKey = iota
+
+// UserIDFromContext returns the authenticated user's ID, or "" for an
+// anonymous request.
+func UserIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKeyUserID).(string)
+	return id
+}
+
+func WithUserID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxKeyUserID, id)
+}
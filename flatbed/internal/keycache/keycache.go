@@ -0,0 +1,75 @@
+// Package keycache keeps recently used access keys so flatbed doesn't ask
+// gantry for the secret on every signed request.
+package keycache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+)
+
+// Lookup fetches an access key from gantry.
+type Lookup interface {
+	GetAccessKey(ctx context.Context, accessKeyID string) (gantry.AccessKey, error)
+}
+
+type entry struct {
+	key     gantry.AccessKey
+	expires time.Time
+}
+
+// Cache remembers keys found by the underlying Lookup for a fixed TTL.
+// Failed lookups are not cached, so a new key works at once; a disabled or
+// rotated-out key keeps working until its entry expires.
+type Cache struct {
+	next Lookup
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+// New returns a Cache in front of next. A ttl of zero or less disables
+// caching.
+func New(next Lookup, ttl time.Duration) *Cache {
+	return &Cache{
+		next:    next,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]entry),
+	}
+}
+
+func (c *Cache) GetAccessKey(ctx context.Context, accessKeyID string) (gantry.AccessKey, error) {
+	if c.ttl <= 0 {
+		return c.next.GetAccessKey(ctx, accessKeyID)
+	}
+
+	now := c.now()
+
+	c.mu.Lock()
+	e, ok := c.entries[accessKeyID]
+	c.mu.Unlock()
+	if ok && now.Before(e.expires) {
+		return e.key, nil
+	}
+
+	key, err := c.next.GetAccessKey(ctx, accessKeyID)
+	if err != nil {
+		return gantry.AccessKey{}, err
+	}
+
+	c.mu.Lock()
+	for id, old := range c.entries {
+		if !now.Before(old.expires) {
+			delete(c.entries, id)
+		}
+	}
+	c.entries[accessKeyID] = entry{key: key, expires: now.Add(c.ttl)}
+	c.mu.Unlock()
+
+	return key, nil
+}
@@ -0,0 +1,85 @@
+package keycache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/testutil"
+)
+
+func TestCache_GetAccessKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		ttl       time.Duration
+		elapsed   time.Duration
+		err       error
+		wantCalls int
+	}{
+		{name: "second lookup is cached", ttl: time.Minute, elapsed: 30 * time.Second, wantCalls: 1},
+		{name: "expired entry is fetched again", ttl: time.Minute, elapsed: time.Minute, wantCalls: 2},
+		{name: "zero ttl disables caching", ttl: 0, wantCalls: 2},
+		{name: "not found is not cached", ttl: time.Minute, err: status.Error(codes.NotFound, "access key not found"), wantCalls: 2},
+		{name: "gantry error is not cached", ttl: time.Minute, err: errors.New("gantry down"), wantCalls: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fg := testutil.NewGantryStub()
+			if tt.err != nil {
+				fg.GetAccessKeyFn = func(context.Context, string) (gantry.AccessKey, error) {
+					return gantry.AccessKey{}, tt.err
+				}
+			}
+
+			clock := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+			c := New(fg, tt.ttl)
+			c.now = func() time.Time { return clock }
+
+			for i := 0; i < 2; i++ {
+				key, err := c.GetAccessKey(context.Background(), "AKIDTEST")
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("lookup %d: err = %v, want %v", i, err, tt.err)
+				}
+				if tt.err == nil && key.SecretAccessKey != testutil.StubSecretAccessKey {
+					t.Fatalf("lookup %d: secret = %q, want %q", i, key.SecretAccessKey, testutil.StubSecretAccessKey)
+				}
+				clock = clock.Add(tt.elapsed)
+			}
+
+			if fg.GetAccessKeyCount() != tt.wantCalls {
+				t.Fatalf("gantry lookups = %d, want %d", fg.GetAccessKeyCount(), tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCache_KeysCachedSeparately(t *testing.T) {
+	t.Parallel()
+
+	fg := testutil.NewGantryStub()
+	c := New(fg, time.Minute)
+
+	for _, id := range []string{"AKIDONE", "AKIDTWO", "AKIDONE"} {
+		key, err := c.GetAccessKey(context.Background(), id)
+		if err != nil {
+			t.Fatalf("GetAccessKey(%s): %v", id, err)
+		}
+		if key.AccessKeyID != id {
+			t.Fatalf("GetAccessKey(%s) returned %s", id, key.AccessKeyID)
+		}
+	}
+
+	if fg.GetAccessKeyCount() != 2 {
+		t.Fatalf("gantry lookups = %d, want 2", fg.GetAccessKeyCount())
+	}
+}
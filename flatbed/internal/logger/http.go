@@ -23,6 +23,13 @@ func LogGantryError(r *http.Request, err error) {
 	)
 }
 
+func LogCradleError(r *http.Request, err error) {
+	httplog.SetAttrs(r.Context(),
+		slog.String("error.type", "cradle"),
+		slog.String("error.detail", err.Error()),
+	)
+}
+
 // LogWritePlan logs the write plan information returned from Gantry.
 func LogWritePlan(r *http.Request, objectID, cradleAddress string, size int64) {
 	httplog.SetAttrs(r.Context(),
@@ -31,3 +38,12 @@ func LogWritePlan(r *http.Request, objectID, cradleAddress string, size int64) {
 		slog.Int64("write_plan.size", size),
 	)
 }
+
+// LogObjectLocation logs where Gantry located a committed object.
+func LogObjectLocation(r *http.Request, objectID, cradleAddress string, size int64) {
+	httplog.SetAttrs(r.Context(),
+		slog.String("object.id", objectID),
+		slog.String("object.cradle_address", cradleAddress),
+		slog.Int64("object.size", size),
+	)
+}
@@ -0,0 +1,39 @@
+package sigv4
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+)
+
+var ErrContentSHA256Mismatch = errors.New("sigv4: payload does not match x-amz-content-sha256")
+
+// payloadReader hashes a request body as it is read and fails at EOF if the
+// body does not match the x-amz-content-sha256 the client signed.
+type payloadReader struct {
+	body io.ReadCloser
+	want string
+	hash hash.Hash
+}
+
+// NewPayloadReader wraps body so that reading it to EOF checks it against
+// the hex SHA-256 in want, returning ErrContentSHA256Mismatch instead of
+// io.EOF when they differ.
+func NewPayloadReader(body io.ReadCloser, want string) io.ReadCloser {
+	return &payloadReader{body: body, want: want, hash: sha256.New()}
+}
+
+func (p *payloadReader) Read(b []byte) (int, error) {
+	n, err := p.body.Read(b)
+	p.hash.Write(b[:n])
+	if err == io.EOF && hex.EncodeToString(p.hash.Sum(nil)) != p.want {
+		return n, ErrContentSHA256Mismatch
+	}
+	return n, err
+}
+
+func (p *payloadReader) Close() error {
+	return p.body.Close()
+}
@@ -0,0 +1,88 @@
+package sigv4
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxExpires is the longest lifetime a presigned URL may ask for.
+const MaxExpires = 7 * 24 * time.Hour
+
+// Presigned is the authorization carried in the query string of a
+// presigned URL.
+type Presigned struct {
+	Authorization
+	SignedAt time.Time
+	Expires  time.Duration
+}
+
+// IsPresigned reports whether u carries query-string authorization.
+func IsPresigned(u *url.URL) bool {
+	return u.Query().Has("X-Amz-Algorithm")
+}
+
+// ParsePresigned reads the X-Amz-* authorization parameters of a presigned
+// URL.
+func ParsePresigned(query url.Values) (Presigned, error) {
+	if query.Get("X-Amz-Algorithm") != Algorithm {
+		return Presigned{}, fmt.Errorf("%w: unsupported algorithm", ErrMalformed)
+	}
+
+	cred, err := ParseCredential(query.Get("X-Amz-Credential"))
+	if err != nil {
+		return Presigned{}, err
+	}
+
+	signedAt, err := time.Parse(TimeFormat, query.Get("X-Amz-Date"))
+	if err != nil {
+		return Presigned{}, fmt.Errorf("%w: X-Amz-Date %q", ErrMalformed, query.Get("X-Amz-Date"))
+	}
+
+	seconds, err := strconv.Atoi(query.Get("X-Amz-Expires"))
+	expires := time.Duration(seconds) * time.Second
+	if err != nil || seconds < 1 || expires > MaxExpires {
+		return Presigned{}, fmt.Errorf("%w: X-Amz-Expires %q", ErrMalformed, query.Get("X-Amz-Expires"))
+	}
+
+	signedHeaders := query.Get("X-Amz-SignedHeaders")
+	signature := query.Get("X-Amz-Signature")
+	if signedHeaders == "" || signature == "" {
+		return Presigned{}, fmt.Errorf("%w: missing X-Amz-SignedHeaders or X-Amz-Signature", ErrMalformed)
+	}
+
+	return Presigned{
+		Authorization: Authorization{
+			Credential:    cred,
+			SignedHeaders: strings.Split(signedHeaders, ";"),
+			Signature:     signature,
+		},
+		SignedAt: signedAt,
+		Expires:  expires,
+	}, nil
+}
+
+// Presign adds query-string authorization to r's URL so it can be sent by
+// anyone until expires has passed. Only the host header is signed and the
+// payload is left unsigned, so the URL works from a plain browser or curl.
+// cred's Date is taken from signedAt.
+func Presign(r *http.Request, secret string, cred Credential, signedAt time.Time, expires time.Duration) {
+	datetime := signedAt.UTC().Format(TimeFormat)
+	cred.Date = datetime[:len(dateFormat)]
+
+	query := r.URL.Query()
+	query.Set("X-Amz-Algorithm", Algorithm)
+	query.Set("X-Amz-Credential", cred.AccessKeyID+"/"+cred.Scope())
+	query.Set("X-Amz-Date", datetime)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expires/time.Second)))
+	query.Set("X-Amz-SignedHeaders", "host")
+	query.Del("X-Amz-Signature")
+	r.URL.RawQuery = query.Encode()
+
+	canonical := CanonicalRequest(r, []string{"host"}, UnsignedPayload)
+	signature := Sign(SigningKey(secret, cred), StringToSign(datetime, cred.Scope(), canonical))
+	r.URL.RawQuery += "&X-Amz-Signature=" + signature
+}
@@ -0,0 +1,259 @@
+// Package sigv4 implements the parts of AWS Signature Version 4 that flatbed
+// needs to authenticate S3 requests: parsing the Authorization header,
+// building the canonical request and deriving the signature.
+package sigv4
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	Algorithm = "AWS4-HMAC-SHA256"
+
+	// TimeFormat is the ISO 8601 basic format of X-Amz-Date.
+	TimeFormat = "20060102T150405Z"
+
+	// UnsignedPayload is the x-amz-content-sha256 value of a request whose
+	// body is not covered by the signature.
+	UnsignedPayload = "UNSIGNED-PAYLOAD"
+
+	dateFormat = "20060102"
+	terminator = "aws4_request"
+)
+
+var ErrMalformed = errors.New("sigv4: malformed authorization")
+
+// Credential is the Credential component of a SigV4 authorization.
+type Credential struct {
+	AccessKeyID string
+	Date        string
+	Region      string
+	Service     string
+}
+
+// Scope returns the credential scope, date/region/service/aws4_request.
+func (c Credential) Scope() string {
+	return strings.Join([]string{c.Date, c.Region, c.Service, terminator}, "/")
+}
+
+// ParseCredential parses AKID/date/region/service/aws4_request.
+func ParseCredential(v string) (Credential, error) {
+	parts := strings.Split(v, "/")
+	if len(parts) != 5 || parts[4] != terminator {
+		return Credential{}, fmt.Errorf("%w: credential %q", ErrMalformed, v)
+	}
+	for _, p := range parts {
+		if p == "" {
+			return Credential{}, fmt.Errorf("%w: credential %q", ErrMalformed, v)
+		}
+	}
+	if _, err := time.Parse(dateFormat, parts[1]); err != nil {
+		return Credential{}, fmt.Errorf("%w: credential date %q", ErrMalformed, parts[1])
+	}
+	return Credential{
+		AccessKeyID: parts[0],
+		Date:        parts[1],
+		Region:      parts[2],
+		Service:     parts[3],
+	}, nil
+}
+
+// Authorization is a parsed AWS4-HMAC-SHA256 Authorization header.
+type Authorization struct {
+	Credential    Credential
+	SignedHeaders []string
+	Signature     string
+}
+
+// ParseAuthorization parses an Authorization header of the form
+//
+//	AWS4-HMAC-SHA256 Credential=..., SignedHeaders=a;b, Signature=hex
+func ParseAuthorization(header string) (Authorization, error) {
+	rest, ok := strings.CutPrefix(header, Algorithm+" ")
+	if !ok {
+		return Authorization{}, fmt.Errorf("%w: unsupported algorithm", ErrMalformed)
+	}
+
+	fields := make(map[string]string, 3)
+	for _, field := range strings.Split(rest, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(field), "=")
+		if !ok {
+			return Authorization{}, fmt.Errorf("%w: field %q", ErrMalformed, field)
+		}
+		fields[name] = value
+	}
+
+	cred, err := ParseCredential(fields["Credential"])
+	if err != nil {
+		return Authorization{}, err
+	}
+	signedHeaders := fields["SignedHeaders"]
+	signature := fields["Signature"]
+	if signedHeaders == "" || signature == "" {
+		return Authorization{}, fmt.Errorf("%w: missing SignedHeaders or Signature", ErrMalformed)
+	}
+
+	return Authorization{
+		Credential:    cred,
+		SignedHeaders: strings.Split(signedHeaders, ";"),
+		Signature:     signature,
+	}, nil
+}
+
+// IsPayloadHash reports whether v is a valid x-amz-content-sha256 value
+// other than the STREAMING- forms: a hex SHA-256 or UNSIGNED-PAYLOAD.
+func IsPayloadHash(v string) bool {
+	if v == UnsignedPayload {
+		return true
+	}
+	if len(v) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(v)
+	return err == nil
+}
+
+// SigningKey derives the key that signs requests within cred's scope.
+func SigningKey(secret string, cred Credential) []byte {
+	key := []byte("AWS4" + secret)
+	for _, part := range []string{cred.Date, cred.Region, cred.Service, terminator} {
+		key = hmacSHA256(key, part)
+	}
+	return key
+}
+
+// CanonicalRequest builds the canonical form of r that SigV4 signs.
+// signedHeaders must be lowercase and sorted, as they appear in the
+// Authorization header. The X-Amz-Signature query parameter is left out so
+// presigned URLs canonicalize the same way.
+func CanonicalRequest(r *http.Request, signedHeaders []string, payloadHash string) string {
+	var b strings.Builder
+
+	b.WriteString(r.Method)
+	b.WriteByte('\n')
+	b.WriteString(canonicalURI(r.URL))
+	b.WriteByte('\n')
+	b.WriteString(canonicalQuery(r.URL))
+	b.WriteByte('\n')
+	for _, name := range signedHeaders {
+		b.WriteString(name)
+		b.WriteByte(':')
+		b.WriteString(headerValue(r, name))
+		b.WriteByte('\n')
+	}
+	b.WriteByte('\n')
+	b.WriteString(strings.Join(signedHeaders, ";"))
+	b.WriteByte('\n')
+	b.WriteString(payloadHash)
+
+	return b.String()
+}
+
+// StringToSign builds the string signed for a request made at datetime
+// (in TimeFormat) within scope.
+func StringToSign(datetime, scope, canonicalRequest string) string {
+	sum := sha256.Sum256([]byte(canonicalRequest))
+	return strings.Join([]string{Algorithm, datetime, scope, hex.EncodeToString(sum[:])}, "\n")
+}
+
+// Sign returns the hex signature of stringToSign.
+func Sign(signingKey []byte, stringToSign string) string {
+	return hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// canonicalURI encodes each path segment as S3 expects, keeping any
+// encoded slashes the client sent inside a segment.
+func canonicalURI(u *url.URL) string {
+	path := u.EscapedPath()
+	if path == "" {
+		return "/"
+	}
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		if decoded, err := url.PathUnescape(seg); err == nil {
+			seg = decoded
+		}
+		segments[i] = uriEncode(seg)
+	}
+	return strings.Join(segments, "/")
+}
+
+// canonicalQuery sorts the query by name and then value, encoding both.
+func canonicalQuery(u *url.URL) string {
+	query := u.Query()
+	pairs := make([]string, 0, len(query))
+	for name, values := range query {
+		if name == "X-Amz-Signature" {
+			continue
+		}
+		for _, v := range values {
+			pairs = append(pairs, uriEncode(name)+"="+uriEncode(v))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+// headerValue returns the canonical value of a signed header: every value
+// trimmed, inner runs of spaces collapsed, and joined with commas.
+func headerValue(r *http.Request, name string) string {
+	var values []string
+	switch name {
+	case "host":
+		values = []string{r.Host}
+	case "content-length":
+		values = r.Header.Values(name)
+		if len(values) == 0 && r.ContentLength >= 0 {
+			values = []string{strconv.FormatInt(r.ContentLength, 10)}
+		}
+	case "transfer-encoding":
+		values = r.Header.Values(name)
+		if len(values) == 0 {
+			values = r.TransferEncoding
+		}
+	default:
+		values = r.Header.Values(name)
+	}
+
+	canonical := make([]string, len(values))
+	for i, v := range values {
+		canonical[i] = strings.Join(strings.Fields(v), " ")
+	}
+	return strings.Join(canonical, ",")
+}
+
+// uriEncode percent-encodes everything but the RFC 3986 unreserved
+// characters, as SigV4 requires.
+func uriEncode(s string) string {
+	const hexDigits = "0123456789ABCDEF"
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(hexDigits[c>>4])
+		b.WriteByte(hexDigits[c&0xf])
+	}
+	return b.String()
+}
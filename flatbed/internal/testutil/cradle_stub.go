@@ -1,8 +1,14 @@
 package testutil
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
 	"io"
+	"strings"
+
+	"github.com/ratdaddy/blockcloset/flatbed/internal/checksum"
+	"github.com/ratdaddy/blockcloset/flatbed/internal/cradle"
 )
 
 type WriteObjectCall struct {
@@ -11,11 +17,35 @@ type WriteObjectCall struct {
 	Bucket    string
 	Size      int64
 	BodyBytes []byte
+	Digests   cradle.Digests
+}
+
+type ReadObjectCall struct {
+	Address  string
+	ObjectID string
+	Bucket   string
+	Offset   int64
+	Length   int64
 }
 
+type CopyObjectCall struct {
+	Address  string
+	ObjectID string
+	Bucket   string
+	Size     int64
+	Sources  []cradle.CopySource
+}
+
+// StubObjectBody is the object content returned by the default ReadObject stub.
+const StubObjectBody = "stub object body"
+
 type CradleStub struct {
-	WriteObjectFn    func(context.Context, string, string, string, int64, io.Reader) (int64, int64, error)
+	WriteObjectFn    func(context.Context, string, string, string, int64, io.Reader, cradle.Digests) (cradle.WriteResult, error)
 	WriteObjectCalls []WriteObjectCall
+	ReadObjectFn     func(context.Context, string, string, string, int64, int64) (io.ReadCloser, error)
+	ReadObjectCalls  []ReadObjectCall
+	CopyObjectFn     func(context.Context, string, string, string, int64, []cradle.CopySource) (cradle.WriteResult, error)
+	CopyObjectCalls  []CopyObjectCall
 }
 
 func NewCradleStub() *CradleStub {
@@ -26,10 +56,18 @@ func (c *CradleStub) WriteObjectCount() int {
 	return len(c.WriteObjectCalls)
 }
 
-func (c *CradleStub) WriteObject(ctx context.Context, address, objectID, bucket string, size int64, body io.Reader) (int64, int64, error) {
+func (c *CradleStub) ReadObjectCount() int {
+	return len(c.ReadObjectCalls)
+}
+
+func (c *CradleStub) CopyObjectCount() int {
+	return len(c.CopyObjectCalls)
+}
+
+func (c *CradleStub) WriteObject(ctx context.Context, address, objectID, bucket string, size int64, body io.Reader, digests cradle.Digests) (cradle.WriteResult, error) {
 	bodyBytes, err := io.ReadAll(body)
 	if err != nil {
-		return 0, 0, err
+		return cradle.WriteResult{}, err
 	}
 
 	c.WriteObjectCalls = append(c.WriteObjectCalls, WriteObjectCall{
@@ -38,13 +76,65 @@ func (c *CradleStub) WriteObject(ctx context.Context, address, objectID, bucket
 		Bucket:    bucket,
 		Size:      size,
 		BodyBytes: bodyBytes,
+		Digests:   digests,
 	})
 
 	if c.WriteObjectFn != nil {
 		// Re-create reader with the bytes we just read
-		return c.WriteObjectFn(ctx, address, objectID, bucket, size, io.NopCloser(io.Reader(nil)))
+		return c.WriteObjectFn(ctx, address, objectID, bucket, size, io.NopCloser(io.Reader(nil)), digests)
+	}
+
+	// Default: return successful write, checking digests like Cradle's client
+	sum := md5.Sum(bodyBytes)
+	if digests.MD5 != nil && !bytes.Equal(sum[:], digests.MD5) {
+		return cradle.WriteResult{}, cradle.ErrBadDigest
+	}
+	var extra string
+	if h, ok := checksum.New(digests.ChecksumAlgorithm); ok {
+		h.Write(bodyBytes)
+		extra = checksum.Sum(h)
+		if digests.Checksum != "" && extra != digests.Checksum {
+			return cradle.WriteResult{}, cradle.ErrBadDigest
+		}
+	}
+	return cradle.WriteResult{BytesWritten: size, LastModifiedMs: 1234567890, MD5: sum[:], Checksum: extra}, nil
+}
+
+func (c *CradleStub) ReadObject(ctx context.Context, address, objectID, bucket string, offset, length int64) (io.ReadCloser, error) {
+	c.ReadObjectCalls = append(c.ReadObjectCalls, ReadObjectCall{
+		Address:  address,
+		ObjectID: objectID,
+		Bucket:   bucket,
+		Offset:   offset,
+		Length:   length,
+	})
+
+	if c.ReadObjectFn != nil {
+		return c.ReadObjectFn(ctx, address, objectID, bucket, offset, length)
+	}
+
+	// Default: serve the requested range of StubObjectBody, as a cradle would.
+	body := StubObjectBody[min(offset, int64(len(StubObjectBody))):]
+	if length > 0 && length < int64(len(body)) {
+		body = body[:length]
+	}
+	return io.NopCloser(strings.NewReader(body)), nil
+}
+
+func (c *CradleStub) CopyObject(ctx context.Context, address, objectID, bucket string, size int64, sources []cradle.CopySource) (cradle.WriteResult, error) {
+	c.CopyObjectCalls = append(c.CopyObjectCalls, CopyObjectCall{
+		Address:  address,
+		ObjectID: objectID,
+		Bucket:   bucket,
+		Size:     size,
+		Sources:  sources,
+	})
+
+	if c.CopyObjectFn != nil {
+		return c.CopyObjectFn(ctx, address, objectID, bucket, size, sources)
 	}
 
-	// Default: return successful write
-	return size, 1234567890, nil
+	// Default: report a successful copy of StubObjectBody.
+	sum := md5.Sum([]byte(StubObjectBody))
+	return cradle.WriteResult{BytesWritten: size, LastModifiedMs: 1234567890, MD5: sum[:]}, nil
 }
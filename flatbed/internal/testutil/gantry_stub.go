@@ -2,32 +2,263 @@ package testutil
 
 import (
 	"context"
+	"time"
 
 	"github.com/ratdaddy/blockcloset/flatbed/internal/gantry"
 	writeplanv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/write_plan/v1"
 )
 
+// StubSecretAccessKey is the secret returned by the default GetAccessKey stub.
+const StubSecretAccessKey = "stub-secret-access-key"
+
+// StubUserID owns the access key returned by the default GetAccessKey stub.
+const StubUserID = "stub-user-id"
+
 type PlanWriteCall struct {
-	Bucket string
-	Key    string
-	Size   int64
+	Bucket      string
+	Key         string
+	Size        int64
+	ContentType string
+	Metadata    gantry.ObjectMetadata
+	Tags        map[string]string
+	Lock        gantry.ObjectLock
 }
 
 type CommitObjectCall struct {
-	ObjectID       string
+	ObjectID          string
+	Size              int64
+	LastModifiedMs    int64
+	ETag              string
+	ChecksumAlgorithm string
+	Checksum          string
+	IfNoneMatch       bool
+}
+
+type LookupObjectCall struct {
+	Bucket    string
+	Key       string
+	VersionID string
+}
+
+type DeleteObjectCall struct {
+	Bucket           string
+	Key              string
+	VersionID        string
+	BypassGovernance bool
+}
+
+type ListObjectsCall struct {
+	Bucket string
+	Params gantry.ListObjectsParams
+}
+
+type ListObjectVersionsCall struct {
+	Bucket string
+	Params gantry.ListObjectVersionsParams
+}
+
+type CreateMultipartUploadCall struct {
+	Bucket      string
+	Key         string
+	ContentType string
+	Metadata    gantry.ObjectMetadata
+}
+
+type PlanPartCall struct {
+	Bucket     string
+	Key        string
+	UploadID   string
+	PartNumber int32
+	Size       int64
+}
+
+type CommitPartCall struct {
+	BlobID         string
 	Size           int64
 	LastModifiedMs int64
+	ETag           string
+}
+
+type CompleteMultipartUploadCall struct {
+	Bucket   string
+	Key      string
+	UploadID string
+	Parts    []gantry.CompletedPart
+}
+
+type ListMultipartUploadsCall struct {
+	Bucket string
+	Params gantry.ListMultipartUploadsParams
+}
+
+type ListPartsCall struct {
+	Bucket   string
+	Key      string
+	UploadID string
+	Params   gantry.ListPartsParams
+}
+
+type AbortMultipartUploadCall struct {
+	Bucket   string
+	Key      string
+	UploadID string
+}
+
+// BucketConfigCall records a PutBucketPolicy, PutBucketACL or
+// PutBucketVersioning call. Value is the policy document, the canned ACL or
+// the versioning status.
+type BucketConfigCall struct {
+	Bucket string
+	Value  string
+}
+
+type PutBucketLifecycleCall struct {
+	Bucket string
+	Rules  []gantry.LifecycleRule
+}
+
+type PutBucketCorsCall struct {
+	Bucket string
+	Rules  []gantry.CORSRule
+}
+
+type DeleteObjectsCall struct {
+	Bucket           string
+	Objects          []gantry.ObjectIdentifier
+	BypassGovernance bool
+}
+
+// ObjectTaggingCall records a PutObjectTagging, GetObjectTagging or
+// DeleteObjectTagging call. Tags is only set for PutObjectTagging.
+type ObjectTaggingCall struct {
+	Bucket    string
+	Key       string
+	VersionID string
+	Tags      map[string]string
+}
+
+type PutObjectLockConfigurationCall struct {
+	Bucket        string
+	Configuration gantry.ObjectLockConfiguration
+}
+
+// ObjectRetentionCall records a PutObjectRetention or GetObjectRetention
+// call. Mode, RetainUntil and BypassGovernance are only set for
+// PutObjectRetention.
+type ObjectRetentionCall struct {
+	Bucket           string
+	Key              string
+	VersionID        string
+	Mode             string
+	RetainUntil      time.Time
+	BypassGovernance bool
+}
+
+// ObjectLegalHoldCall records a PutObjectLegalHold or GetObjectLegalHold
+// call. On is only set for PutObjectLegalHold.
+type ObjectLegalHoldCall struct {
+	Bucket    string
+	Key       string
+	VersionID string
+	On        bool
 }
 
 type GantryStub struct {
-	CreateFn          func(context.Context, string) (string, error)
+	CreateFn          func(context.Context, string, string, bool) (string, error)
 	ListFn            func(context.Context) ([]gantry.Bucket, error)
-	PlanWriteFn       func(context.Context, string, string, int64) (*writeplanv1.WritePlan, error)
-	CommitObjectFn    func(context.Context, string, int64, int64) error
+	GetBucketFn       func(context.Context, string) (gantry.Bucket, error)
+	PlanWriteFn       func(context.Context, string, string, int64, string, gantry.ObjectMetadata, map[string]string, gantry.ObjectLock) (*writeplanv1.WritePlan, error)
+	CommitObjectFn    func(context.Context, string, gantry.ObjectCommit) (string, error)
 	CreateCalls       []string
+	CreateACLs        []string
+	CreateObjectLocks []bool
 	ListCalls         int
+	GetBucketCalls    []string
 	PlanWriteCalls    []PlanWriteCall
 	CommitObjectCalls []CommitObjectCall
+	LookupObjectFn    func(context.Context, string, string, string) (gantry.Object, error)
+	LookupObjectCalls []LookupObjectCall
+	DeleteObjectFn    func(context.Context, string, string, string, bool) (gantry.ObjectDeletion, error)
+	DeleteObjectCalls []DeleteObjectCall
+	DeleteBucketFn    func(context.Context, string) error
+	DeleteBucketCalls []string
+	ListObjectsFn     func(context.Context, string, gantry.ListObjectsParams) (gantry.ObjectListing, error)
+	ListObjectsCalls  []ListObjectsCall
+
+	ListObjectVersionsFn    func(context.Context, string, gantry.ListObjectVersionsParams) (gantry.ObjectVersionListing, error)
+	ListObjectVersionsCalls []ListObjectVersionsCall
+
+	CreateMultipartUploadFn    func(context.Context, string, string, string, gantry.ObjectMetadata) (string, error)
+	CreateMultipartUploadCalls []CreateMultipartUploadCall
+	PlanPartFn                 func(context.Context, string, string, string, int32, int64) (*writeplanv1.WritePlan, error)
+	PlanPartCalls              []PlanPartCall
+	CommitPartFn               func(context.Context, string, int64, int64, string) (string, error)
+	CommitPartCalls            []CommitPartCall
+	CompleteMultipartUploadFn  func(context.Context, string, string, string, []gantry.CompletedPart) (string, string, error)
+	CompleteMultipartCalls     []CompleteMultipartUploadCall
+	AbortMultipartUploadFn     func(context.Context, string, string, string) error
+	AbortMultipartUploadCalls  []AbortMultipartUploadCall
+	ListMultipartUploadsFn     func(context.Context, string, gantry.ListMultipartUploadsParams) (gantry.MultipartUploadListing, error)
+	ListMultipartUploadsCalls  []ListMultipartUploadsCall
+	ListPartsFn                func(context.Context, string, string, string, gantry.ListPartsParams) (gantry.PartListing, error)
+	ListPartsCalls             []ListPartsCall
+	GetAccessKeyFn             func(context.Context, string) (gantry.AccessKey, error)
+	GetAccessKeyCalls          []string
+
+	PutBucketPolicyFn       func(context.Context, string, string) error
+	PutBucketPolicyCalls    []BucketConfigCall
+	GetBucketPolicyFn       func(context.Context, string) (string, error)
+	GetBucketPolicyCalls    []string
+	DeleteBucketPolicyFn    func(context.Context, string) error
+	DeleteBucketPolicyCalls []string
+	PutBucketACLFn          func(context.Context, string, string) error
+	PutBucketACLCalls       []BucketConfigCall
+	GetBucketACLFn          func(context.Context, string) (gantry.BucketACL, error)
+	GetBucketACLCalls       []string
+
+	PutBucketVersioningFn    func(context.Context, string, string) error
+	PutBucketVersioningCalls []BucketConfigCall
+	GetBucketVersioningFn    func(context.Context, string) (string, error)
+	GetBucketVersioningCalls []string
+
+	PutBucketLifecycleFn       func(context.Context, string, []gantry.LifecycleRule) error
+	PutBucketLifecycleCalls    []PutBucketLifecycleCall
+	GetBucketLifecycleFn       func(context.Context, string) ([]gantry.LifecycleRule, error)
+	GetBucketLifecycleCalls    []string
+	DeleteBucketLifecycleFn    func(context.Context, string) error
+	DeleteBucketLifecycleCalls []string
+
+	PutBucketCorsFn       func(context.Context, string, []gantry.CORSRule) error
+	PutBucketCorsCalls    []PutBucketCorsCall
+	GetBucketCorsFn       func(context.Context, string) ([]gantry.CORSRule, error)
+	GetBucketCorsCalls    []string
+	DeleteBucketCorsFn    func(context.Context, string) error
+	DeleteBucketCorsCalls []string
+	LookupBucketCorsFn    func(context.Context, string) ([]gantry.CORSRule, error)
+	LookupBucketCorsCalls []string
+
+	PutObjectTaggingFn       func(context.Context, string, string, string, map[string]string) (string, error)
+	PutObjectTaggingCalls    []ObjectTaggingCall
+	GetObjectTaggingFn       func(context.Context, string, string, string) (gantry.ObjectTagging, error)
+	GetObjectTaggingCalls    []ObjectTaggingCall
+	DeleteObjectTaggingFn    func(context.Context, string, string, string) (string, error)
+	DeleteObjectTaggingCalls []ObjectTaggingCall
+
+	DeleteObjectsFn    func(context.Context, string, []gantry.ObjectIdentifier, bool) (gantry.ObjectDeletions, error)
+	DeleteObjectsCalls []DeleteObjectsCall
+
+	PutObjectLockConfigurationFn    func(context.Context, string, gantry.ObjectLockConfiguration) error
+	PutObjectLockConfigurationCalls []PutObjectLockConfigurationCall
+	GetObjectLockConfigurationFn    func(context.Context, string) (gantry.ObjectLockConfiguration, error)
+	GetObjectLockConfigurationCalls []string
+	PutObjectRetentionFn            func(context.Context, string, string, string, string, time.Time, bool) (string, error)
+	PutObjectRetentionCalls         []ObjectRetentionCall
+	GetObjectRetentionFn            func(context.Context, string, string, string) (gantry.ObjectRetention, error)
+	GetObjectRetentionCalls         []ObjectRetentionCall
+	PutObjectLegalHoldFn            func(context.Context, string, string, string, bool) (string, error)
+	PutObjectLegalHoldCalls         []ObjectLegalHoldCall
+	GetObjectLegalHoldFn            func(context.Context, string, string, string) (gantry.ObjectLegalHold, error)
+	GetObjectLegalHoldCalls         []ObjectLegalHoldCall
 }
 
 func NewGantryStub() *GantryStub {
@@ -42,6 +273,10 @@ func (g *GantryStub) ListCount() int {
 	return g.ListCalls
 }
 
+func (g *GantryStub) GetBucketCount() int {
+	return len(g.GetBucketCalls)
+}
+
 func (g *GantryStub) PlanWriteCount() int {
 	return len(g.PlanWriteCalls)
 }
@@ -50,10 +285,16 @@ func (g *GantryStub) CommitObjectCount() int {
 	return len(g.CommitObjectCalls)
 }
 
-func (g *GantryStub) CreateBucket(ctx context.Context, name string) (string, error) {
+func (g *GantryStub) LookupObjectCount() int {
+	return len(g.LookupObjectCalls)
+}
+
+func (g *GantryStub) CreateBucket(ctx context.Context, name, acl string, objectLock bool) (string, error) {
 	g.CreateCalls = append(g.CreateCalls, name)
+	g.CreateACLs = append(g.CreateACLs, acl)
+	g.CreateObjectLocks = append(g.CreateObjectLocks, objectLock)
 	if g.CreateFn != nil {
-		return g.CreateFn(ctx, name)
+		return g.CreateFn(ctx, name, acl, objectLock)
 	}
 	return "", nil
 }
@@ -66,29 +307,463 @@ func (g *GantryStub) ListBuckets(ctx context.Context) ([]gantry.Bucket, error) {
 	return nil, nil
 }
 
-func (g *GantryStub) CommitObject(ctx context.Context, objectID string, size int64, lastModifiedMs int64) error {
+func (g *GantryStub) GetBucket(ctx context.Context, name string) (gantry.Bucket, error) {
+	g.GetBucketCalls = append(g.GetBucketCalls, name)
+	if g.GetBucketFn != nil {
+		return g.GetBucketFn(ctx, name)
+	}
+	return gantry.Bucket{Name: name, CreatedAt: time.UnixMilli(1234567890).UTC()}, nil
+}
+
+func (g *GantryStub) CommitObject(ctx context.Context, objectID string, commit gantry.ObjectCommit) (string, error) {
 	g.CommitObjectCalls = append(g.CommitObjectCalls, CommitObjectCall{
-		ObjectID:       objectID,
-		Size:           size,
-		LastModifiedMs: lastModifiedMs,
+		ObjectID:          objectID,
+		Size:              commit.Size,
+		LastModifiedMs:    commit.LastModifiedMs,
+		ETag:              commit.ETag,
+		ChecksumAlgorithm: commit.ChecksumAlgorithm,
+		Checksum:          commit.Checksum,
+		IfNoneMatch:       commit.IfNoneMatch,
 	})
 	if g.CommitObjectFn != nil {
-		return g.CommitObjectFn(ctx, objectID, size, lastModifiedMs)
+		return g.CommitObjectFn(ctx, objectID, commit)
 	}
-	return nil
+	return "", nil
 }
 
-func (g *GantryStub) PlanWrite(ctx context.Context, bucket, key string, size int64) (*writeplanv1.WritePlan, error) {
+func (g *GantryStub) PlanWrite(ctx context.Context, bucket, key string, size int64, contentType string, metadata gantry.ObjectMetadata, tags map[string]string, lock gantry.ObjectLock) (*writeplanv1.WritePlan, error) {
 	g.PlanWriteCalls = append(g.PlanWriteCalls, PlanWriteCall{
-		Bucket: bucket,
-		Key:    key,
-		Size:   size,
+		Bucket:      bucket,
+		Key:         key,
+		Size:        size,
+		ContentType: contentType,
+		Metadata:    metadata,
+		Tags:        tags,
+		Lock:        lock,
 	})
 	if g.PlanWriteFn != nil {
-		return g.PlanWriteFn(ctx, bucket, key, size)
+		return g.PlanWriteFn(ctx, bucket, key, size, contentType, metadata, tags, lock)
 	}
 	return &writeplanv1.WritePlan{
 		ObjectId:      "stub-object-id",
 		CradleAddress: "localhost:9002",
 	}, nil
 }
+
+func (g *GantryStub) LookupObject(ctx context.Context, bucket, key, versionID string) (gantry.Object, error) {
+	g.LookupObjectCalls = append(g.LookupObjectCalls, LookupObjectCall{
+		Bucket:    bucket,
+		Key:       key,
+		VersionID: versionID,
+	})
+	if g.LookupObjectFn != nil {
+		return g.LookupObjectFn(ctx, bucket, key, versionID)
+	}
+	return gantry.Object{
+		ID:            "stub-object-id",
+		Key:           key,
+		Size:          int64(len(StubObjectBody)),
+		LastModified:  time.UnixMilli(1234567890).UTC(),
+		CradleAddress: "localhost:9002",
+		ContentType:   "text/plain",
+	}, nil
+}
+
+func (g *GantryStub) DeleteObjectCount() int {
+	return len(g.DeleteObjectCalls)
+}
+
+func (g *GantryStub) DeleteObject(ctx context.Context, bucket, key, versionID string, bypassGovernance bool) (gantry.ObjectDeletion, error) {
+	g.DeleteObjectCalls = append(g.DeleteObjectCalls, DeleteObjectCall{
+		Bucket:           bucket,
+		Key:              key,
+		VersionID:        versionID,
+		BypassGovernance: bypassGovernance,
+	})
+	if g.DeleteObjectFn != nil {
+		return g.DeleteObjectFn(ctx, bucket, key, versionID, bypassGovernance)
+	}
+	return gantry.ObjectDeletion{}, nil
+}
+
+func (g *GantryStub) DeleteBucketCount() int {
+	return len(g.DeleteBucketCalls)
+}
+
+func (g *GantryStub) DeleteBucket(ctx context.Context, name string) error {
+	g.DeleteBucketCalls = append(g.DeleteBucketCalls, name)
+	if g.DeleteBucketFn != nil {
+		return g.DeleteBucketFn(ctx, name)
+	}
+	return nil
+}
+
+func (g *GantryStub) ListObjectsCount() int {
+	return len(g.ListObjectsCalls)
+}
+
+func (g *GantryStub) ListObjects(ctx context.Context, bucket string, params gantry.ListObjectsParams) (gantry.ObjectListing, error) {
+	g.ListObjectsCalls = append(g.ListObjectsCalls, ListObjectsCall{
+		Bucket: bucket,
+		Params: params,
+	})
+	if g.ListObjectsFn != nil {
+		return g.ListObjectsFn(ctx, bucket, params)
+	}
+	return gantry.ObjectListing{}, nil
+}
+
+func (g *GantryStub) ListObjectVersionsCount() int {
+	return len(g.ListObjectVersionsCalls)
+}
+
+func (g *GantryStub) ListObjectVersions(ctx context.Context, bucket string, params gantry.ListObjectVersionsParams) (gantry.ObjectVersionListing, error) {
+	g.ListObjectVersionsCalls = append(g.ListObjectVersionsCalls, ListObjectVersionsCall{
+		Bucket: bucket,
+		Params: params,
+	})
+	if g.ListObjectVersionsFn != nil {
+		return g.ListObjectVersionsFn(ctx, bucket, params)
+	}
+	return gantry.ObjectVersionListing{}, nil
+}
+
+func (g *GantryStub) CreateMultipartUploadCount() int {
+	return len(g.CreateMultipartUploadCalls)
+}
+
+func (g *GantryStub) CreateMultipartUpload(ctx context.Context, bucket, key, contentType string, metadata gantry.ObjectMetadata) (string, error) {
+	g.CreateMultipartUploadCalls = append(g.CreateMultipartUploadCalls, CreateMultipartUploadCall{
+		Bucket:      bucket,
+		Key:         key,
+		ContentType: contentType,
+		Metadata:    metadata,
+	})
+	if g.CreateMultipartUploadFn != nil {
+		return g.CreateMultipartUploadFn(ctx, bucket, key, contentType, metadata)
+	}
+	return "stub-upload-id", nil
+}
+
+func (g *GantryStub) PlanPartCount() int {
+	return len(g.PlanPartCalls)
+}
+
+func (g *GantryStub) PlanPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, size int64) (*writeplanv1.WritePlan, error) {
+	g.PlanPartCalls = append(g.PlanPartCalls, PlanPartCall{
+		Bucket:     bucket,
+		Key:        key,
+		UploadID:   uploadID,
+		PartNumber: partNumber,
+		Size:       size,
+	})
+	if g.PlanPartFn != nil {
+		return g.PlanPartFn(ctx, bucket, key, uploadID, partNumber, size)
+	}
+	return &writeplanv1.WritePlan{
+		ObjectId:      "stub-blob-id",
+		CradleAddress: "localhost:9002",
+	}, nil
+}
+
+func (g *GantryStub) CommitPartCount() int {
+	return len(g.CommitPartCalls)
+}
+
+func (g *GantryStub) CommitPart(ctx context.Context, blobID string, size int64, lastModifiedMs int64, etag string) (string, error) {
+	g.CommitPartCalls = append(g.CommitPartCalls, CommitPartCall{
+		BlobID:         blobID,
+		Size:           size,
+		LastModifiedMs: lastModifiedMs,
+		ETag:           etag,
+	})
+	if g.CommitPartFn != nil {
+		return g.CommitPartFn(ctx, blobID, size, lastModifiedMs, etag)
+	}
+	return etag, nil
+}
+
+func (g *GantryStub) CompleteMultipartUploadCount() int {
+	return len(g.CompleteMultipartCalls)
+}
+
+func (g *GantryStub) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string, parts []gantry.CompletedPart) (string, string, error) {
+	g.CompleteMultipartCalls = append(g.CompleteMultipartCalls, CompleteMultipartUploadCall{
+		Bucket:   bucket,
+		Key:      key,
+		UploadID: uploadID,
+		Parts:    parts,
+	})
+	if g.CompleteMultipartUploadFn != nil {
+		return g.CompleteMultipartUploadFn(ctx, bucket, key, uploadID, parts)
+	}
+	return "stub-etag-1", "", nil
+}
+
+func (g *GantryStub) AbortMultipartUploadCount() int {
+	return len(g.AbortMultipartUploadCalls)
+}
+
+func (g *GantryStub) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	g.AbortMultipartUploadCalls = append(g.AbortMultipartUploadCalls, AbortMultipartUploadCall{
+		Bucket:   bucket,
+		Key:      key,
+		UploadID: uploadID,
+	})
+	if g.AbortMultipartUploadFn != nil {
+		return g.AbortMultipartUploadFn(ctx, bucket, key, uploadID)
+	}
+	return nil
+}
+
+func (g *GantryStub) ListMultipartUploadsCount() int {
+	return len(g.ListMultipartUploadsCalls)
+}
+
+func (g *GantryStub) ListMultipartUploads(ctx context.Context, bucket string, params gantry.ListMultipartUploadsParams) (gantry.MultipartUploadListing, error) {
+	g.ListMultipartUploadsCalls = append(g.ListMultipartUploadsCalls, ListMultipartUploadsCall{
+		Bucket: bucket,
+		Params: params,
+	})
+	if g.ListMultipartUploadsFn != nil {
+		return g.ListMultipartUploadsFn(ctx, bucket, params)
+	}
+	return gantry.MultipartUploadListing{}, nil
+}
+
+func (g *GantryStub) ListPartsCount() int {
+	return len(g.ListPartsCalls)
+}
+
+func (g *GantryStub) ListParts(ctx context.Context, bucket, key, uploadID string, params gantry.ListPartsParams) (gantry.PartListing, error) {
+	g.ListPartsCalls = append(g.ListPartsCalls, ListPartsCall{
+		Bucket:   bucket,
+		Key:      key,
+		UploadID: uploadID,
+		Params:   params,
+	})
+	if g.ListPartsFn != nil {
+		return g.ListPartsFn(ctx, bucket, key, uploadID, params)
+	}
+	return gantry.PartListing{}, nil
+}
+
+func (g *GantryStub) GetAccessKeyCount() int {
+	return len(g.GetAccessKeyCalls)
+}
+
+func (g *GantryStub) GetAccessKey(ctx context.Context, accessKeyID string) (gantry.AccessKey, error) {
+	g.GetAccessKeyCalls = append(g.GetAccessKeyCalls, accessKeyID)
+	if g.GetAccessKeyFn != nil {
+		return g.GetAccessKeyFn(ctx, accessKeyID)
+	}
+	return gantry.AccessKey{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: StubSecretAccessKey,
+		UserID:          StubUserID,
+	}, nil
+}
+
+func (g *GantryStub) PutBucketPolicy(ctx context.Context, bucket, policy string) error {
+	g.PutBucketPolicyCalls = append(g.PutBucketPolicyCalls, BucketConfigCall{Bucket: bucket, Value: policy})
+	if g.PutBucketPolicyFn != nil {
+		return g.PutBucketPolicyFn(ctx, bucket, policy)
+	}
+	return nil
+}
+
+func (g *GantryStub) GetBucketPolicy(ctx context.Context, bucket string) (string, error) {
+	g.GetBucketPolicyCalls = append(g.GetBucketPolicyCalls, bucket)
+	if g.GetBucketPolicyFn != nil {
+		return g.GetBucketPolicyFn(ctx, bucket)
+	}
+	return "", nil
+}
+
+func (g *GantryStub) DeleteBucketPolicy(ctx context.Context, bucket string) error {
+	g.DeleteBucketPolicyCalls = append(g.DeleteBucketPolicyCalls, bucket)
+	if g.DeleteBucketPolicyFn != nil {
+		return g.DeleteBucketPolicyFn(ctx, bucket)
+	}
+	return nil
+}
+
+func (g *GantryStub) PutBucketACL(ctx context.Context, bucket, acl string) error {
+	g.PutBucketACLCalls = append(g.PutBucketACLCalls, BucketConfigCall{Bucket: bucket, Value: acl})
+	if g.PutBucketACLFn != nil {
+		return g.PutBucketACLFn(ctx, bucket, acl)
+	}
+	return nil
+}
+
+func (g *GantryStub) GetBucketACL(ctx context.Context, bucket string) (gantry.BucketACL, error) {
+	g.GetBucketACLCalls = append(g.GetBucketACLCalls, bucket)
+	if g.GetBucketACLFn != nil {
+		return g.GetBucketACLFn(ctx, bucket)
+	}
+	return gantry.BucketACL{OwnerID: StubUserID, ACL: "private"}, nil
+}
+
+func (g *GantryStub) PutBucketVersioning(ctx context.Context, bucket, status string) error {
+	g.PutBucketVersioningCalls = append(g.PutBucketVersioningCalls, BucketConfigCall{Bucket: bucket, Value: status})
+	if g.PutBucketVersioningFn != nil {
+		return g.PutBucketVersioningFn(ctx, bucket, status)
+	}
+	return nil
+}
+
+func (g *GantryStub) GetBucketVersioning(ctx context.Context, bucket string) (string, error) {
+	g.GetBucketVersioningCalls = append(g.GetBucketVersioningCalls, bucket)
+	if g.GetBucketVersioningFn != nil {
+		return g.GetBucketVersioningFn(ctx, bucket)
+	}
+	return "", nil
+}
+
+func (g *GantryStub) PutBucketLifecycleConfiguration(ctx context.Context, bucket string, rules []gantry.LifecycleRule) error {
+	g.PutBucketLifecycleCalls = append(g.PutBucketLifecycleCalls, PutBucketLifecycleCall{Bucket: bucket, Rules: rules})
+	if g.PutBucketLifecycleFn != nil {
+		return g.PutBucketLifecycleFn(ctx, bucket, rules)
+	}
+	return nil
+}
+
+func (g *GantryStub) GetBucketLifecycleConfiguration(ctx context.Context, bucket string) ([]gantry.LifecycleRule, error) {
+	g.GetBucketLifecycleCalls = append(g.GetBucketLifecycleCalls, bucket)
+	if g.GetBucketLifecycleFn != nil {
+		return g.GetBucketLifecycleFn(ctx, bucket)
+	}
+	return nil, nil
+}
+
+func (g *GantryStub) DeleteBucketLifecycle(ctx context.Context, bucket string) error {
+	g.DeleteBucketLifecycleCalls = append(g.DeleteBucketLifecycleCalls, bucket)
+	if g.DeleteBucketLifecycleFn != nil {
+		return g.DeleteBucketLifecycleFn(ctx, bucket)
+	}
+	return nil
+}
+
+func (g *GantryStub) PutBucketCors(ctx context.Context, bucket string, rules []gantry.CORSRule) error {
+	g.PutBucketCorsCalls = append(g.PutBucketCorsCalls, PutBucketCorsCall{Bucket: bucket, Rules: rules})
+	if g.PutBucketCorsFn != nil {
+		return g.PutBucketCorsFn(ctx, bucket, rules)
+	}
+	return nil
+}
+
+func (g *GantryStub) GetBucketCors(ctx context.Context, bucket string) ([]gantry.CORSRule, error) {
+	g.GetBucketCorsCalls = append(g.GetBucketCorsCalls, bucket)
+	if g.GetBucketCorsFn != nil {
+		return g.GetBucketCorsFn(ctx, bucket)
+	}
+	return nil, nil
+}
+
+func (g *GantryStub) DeleteBucketCors(ctx context.Context, bucket string) error {
+	g.DeleteBucketCorsCalls = append(g.DeleteBucketCorsCalls, bucket)
+	if g.DeleteBucketCorsFn != nil {
+		return g.DeleteBucketCorsFn(ctx, bucket)
+	}
+	return nil
+}
+
+func (g *GantryStub) LookupBucketCors(ctx context.Context, bucket string) ([]gantry.CORSRule, error) {
+	g.LookupBucketCorsCalls = append(g.LookupBucketCorsCalls, bucket)
+	if g.LookupBucketCorsFn != nil {
+		return g.LookupBucketCorsFn(ctx, bucket)
+	}
+	return nil, nil
+}
+
+func (g *GantryStub) PutObjectTagging(ctx context.Context, bucket, key, versionID string, tags map[string]string) (string, error) {
+	g.PutObjectTaggingCalls = append(g.PutObjectTaggingCalls, ObjectTaggingCall{Bucket: bucket, Key: key, VersionID: versionID, Tags: tags})
+	if g.PutObjectTaggingFn != nil {
+		return g.PutObjectTaggingFn(ctx, bucket, key, versionID, tags)
+	}
+	return "", nil
+}
+
+func (g *GantryStub) GetObjectTagging(ctx context.Context, bucket, key, versionID string) (gantry.ObjectTagging, error) {
+	g.GetObjectTaggingCalls = append(g.GetObjectTaggingCalls, ObjectTaggingCall{Bucket: bucket, Key: key, VersionID: versionID})
+	if g.GetObjectTaggingFn != nil {
+		return g.GetObjectTaggingFn(ctx, bucket, key, versionID)
+	}
+	return gantry.ObjectTagging{}, nil
+}
+
+func (g *GantryStub) DeleteObjectTagging(ctx context.Context, bucket, key, versionID string) (string, error) {
+	g.DeleteObjectTaggingCalls = append(g.DeleteObjectTaggingCalls, ObjectTaggingCall{Bucket: bucket, Key: key, VersionID: versionID})
+	if g.DeleteObjectTaggingFn != nil {
+		return g.DeleteObjectTaggingFn(ctx, bucket, key, versionID)
+	}
+	return "", nil
+}
+
+// DeleteObjects reports every object deleted unless DeleteObjectsFn is set.
+func (g *GantryStub) DeleteObjects(ctx context.Context, bucket string, objects []gantry.ObjectIdentifier, bypassGovernance bool) (gantry.ObjectDeletions, error) {
+	g.DeleteObjectsCalls = append(g.DeleteObjectsCalls, DeleteObjectsCall{Bucket: bucket, Objects: objects, BypassGovernance: bypassGovernance})
+	if g.DeleteObjectsFn != nil {
+		return g.DeleteObjectsFn(ctx, bucket, objects, bypassGovernance)
+	}
+	var deletions gantry.ObjectDeletions
+	for _, obj := range objects {
+		deletions.Deleted = append(deletions.Deleted, gantry.DeletedObject{Key: obj.Key, VersionID: obj.VersionID})
+	}
+	return deletions, nil
+}
+
+func (g *GantryStub) PutObjectLockConfiguration(ctx context.Context, bucket string, cfg gantry.ObjectLockConfiguration) error {
+	g.PutObjectLockConfigurationCalls = append(g.PutObjectLockConfigurationCalls, PutObjectLockConfigurationCall{Bucket: bucket, Configuration: cfg})
+	if g.PutObjectLockConfigurationFn != nil {
+		return g.PutObjectLockConfigurationFn(ctx, bucket, cfg)
+	}
+	return nil
+}
+
+func (g *GantryStub) GetObjectLockConfiguration(ctx context.Context, bucket string) (gantry.ObjectLockConfiguration, error) {
+	g.GetObjectLockConfigurationCalls = append(g.GetObjectLockConfigurationCalls, bucket)
+	if g.GetObjectLockConfigurationFn != nil {
+		return g.GetObjectLockConfigurationFn(ctx, bucket)
+	}
+	return gantry.ObjectLockConfiguration{}, nil
+}
+
+func (g *GantryStub) PutObjectRetention(ctx context.Context, bucket, key, versionID, mode string, retainUntil time.Time, bypassGovernance bool) (string, error) {
+	g.PutObjectRetentionCalls = append(g.PutObjectRetentionCalls, ObjectRetentionCall{
+		Bucket:           bucket,
+		Key:              key,
+		VersionID:        versionID,
+		Mode:             mode,
+		RetainUntil:      retainUntil,
+		BypassGovernance: bypassGovernance,
+	})
+	if g.PutObjectRetentionFn != nil {
+		return g.PutObjectRetentionFn(ctx, bucket, key, versionID, mode, retainUntil, bypassGovernance)
+	}
+	return "", nil
+}
+
+func (g *GantryStub) GetObjectRetention(ctx context.Context, bucket, key, versionID string) (gantry.ObjectRetention, error) {
+	g.GetObjectRetentionCalls = append(g.GetObjectRetentionCalls, ObjectRetentionCall{Bucket: bucket, Key: key, VersionID: versionID})
+	if g.GetObjectRetentionFn != nil {
+		return g.GetObjectRetentionFn(ctx, bucket, key, versionID)
+	}
+	return gantry.ObjectRetention{}, nil
+}
+
+func (g *GantryStub) PutObjectLegalHold(ctx context.Context, bucket, key, versionID string, on bool) (string, error) {
+	g.PutObjectLegalHoldCalls = append(g.PutObjectLegalHoldCalls, ObjectLegalHoldCall{Bucket: bucket, Key: key, VersionID: versionID, On: on})
+	if g.PutObjectLegalHoldFn != nil {
+		return g.PutObjectLegalHoldFn(ctx, bucket, key, versionID, on)
+	}
+	return "", nil
+}
+
+func (g *GantryStub) GetObjectLegalHold(ctx context.Context, bucket, key, versionID string) (gantry.ObjectLegalHold, error) {
+	g.GetObjectLegalHoldCalls = append(g.GetObjectLegalHoldCalls, ObjectLegalHoldCall{Bucket: bucket, Key: key, VersionID: versionID})
+	if g.GetObjectLegalHoldFn != nil {
+		return g.GetObjectLegalHoldFn(ctx, bucket, key, versionID)
+	}
+	return gantry.ObjectLegalHold{}, nil
+}
@@ -15,13 +15,17 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"github.com/ratdaddy/blockcloset/gantry/internal/bootstrap"
+	"github.com/ratdaddy/blockcloset/gantry/internal/cleanup"
 	"github.com/ratdaddy/blockcloset/gantry/internal/config"
 	"github.com/ratdaddy/blockcloset/gantry/internal/cradle"
 	"github.com/ratdaddy/blockcloset/gantry/internal/database"
 	"github.com/ratdaddy/blockcloset/gantry/internal/grpcsvc"
 	"github.com/ratdaddy/blockcloset/gantry/internal/heartbeat"
+	"github.com/ratdaddy/blockcloset/gantry/internal/lifecycle"
 	"github.com/ratdaddy/blockcloset/gantry/internal/logger"
+	"github.com/ratdaddy/blockcloset/gantry/internal/secretbox"
 	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/uploadsweep"
 	"github.com/ratdaddy/blockcloset/loggrpc"
 )
 
@@ -32,19 +36,26 @@ func main() {
 	config.Init()
 	logger.Init()
 
-	db, cleanup, err := database.Init(ctx)
+	db, closeDB, err := database.Init(ctx)
 	if err != nil {
 		slog.Error("database init failed", "err", err)
 		os.Exit(1)
 	}
-	defer cleanup()
+	defer closeDB()
 
-	if err := bootstrap.Init(ctx, store.New(db)); err != nil {
+	secrets, err := secretbox.New(config.SecretKey)
+	if err != nil {
+		slog.Error("GANTRY_SECRET_KEY must be 32 base64-encoded bytes", "err", err)
+		os.Exit(1)
+	}
+	st := store.New(db, secrets)
+
+	if err := bootstrap.Init(ctx, st); err != nil {
 		slog.Error("bootstrap init failed", "err", err)
 		os.Exit(1)
 	}
 
-	servers, err := store.New(db).CradleServers().All(ctx)
+	servers, err := st.CradleServers().All(ctx)
 	if err != nil {
 		slog.Error("load cradle servers", "err", err)
 		os.Exit(1)
@@ -54,6 +65,7 @@ func main() {
 	}
 
 	var cradleClients []heartbeat.CradleClient
+	cleanupClients := make(map[string]cleanup.CradleClient, len(servers))
 	for _, srv := range servers {
 		c, err := cradle.New(ctx, srv.Address)
 		if err != nil {
@@ -62,11 +74,23 @@ func main() {
 		}
 		defer c.Close()
 		cradleClients = append(cradleClients, c)
+		cleanupClients[srv.Address] = c
 	}
 
 	worker := heartbeat.New(cradleClients, config.HeartbeatInterval)
 	go worker.Run(ctx)
 
+	cleanupWorker := cleanup.New(st.Objects(), st.BlobDeletions(), cleanupClients, config.CleanupInterval)
+	go cleanupWorker.Run(ctx)
+
+	if config.UploadMaxAge > 0 {
+		sweepWorker := uploadsweep.New(st.Multipart(), config.UploadMaxAge, config.UploadSweepInterval)
+		go sweepWorker.Run(ctx)
+	}
+
+	lifecycleWorker := lifecycle.New(st.Buckets(), st.Objects(), config.LifecycleInterval)
+	go lifecycleWorker.Run(ctx)
+
 	addr := fmt.Sprintf(":%d", config.GantryPort)
 
 	slog.Info("starting gantry", "addr", addr)
@@ -89,7 +113,7 @@ func main() {
 		),
 	)
 
-	grpcsvc.Register(s, grpcsvc.New(slogger, db))
+	grpcsvc.Register(s, grpcsvc.New(slogger, db, secrets))
 	if config.EnableReflection {
 		slog.Info("grpc reflection enabled")
 		reflection.Register(s)
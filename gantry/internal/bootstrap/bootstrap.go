@@ -3,6 +3,7 @@ package bootstrap
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/ratdaddy/blockcloset/gantry/internal/config"
@@ -18,5 +19,27 @@ func Init(ctx context.Context, st store.Store) error {
 	}
 
 	config.CradleServerID = cradleId
+
+	if config.AccessKeyID != "" && config.SecretAccessKey != "" {
+		user, err := st.Users().Ensure(ctx, store.NewID(), config.AccessKeyUser, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to bootstrap access key user: %w", err)
+		}
+		if _, err := st.AccessKeys().Upsert(ctx, store.NewID(), user.ID, config.AccessKeyID, config.SecretAccessKey, time.Now()); err != nil {
+			return fmt.Errorf("failed to bootstrap access key: %w", err)
+		}
+
+		// Buckets from before users existed, or created while flatbed ran
+		// without authentication, have no owner; the access key user takes
+		// them over so they are listed and managed by someone.
+		adopted, err := st.Buckets().AdoptUnowned(ctx, user.ID, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to bootstrap bucket owners: %w", err)
+		}
+		if adopted > 0 {
+			slog.Info("buckets without an owner given to access key user", "user", user.Name, "buckets", adopted)
+		}
+	}
+
 	return nil
 }
@@ -49,3 +49,75 @@ func TestInitSeedsCradleServer(t *testing.T) {
 		t.Fatalf("Upsert timestamp not UTC: %s", call.Stamp)
 	}
 }
+
+func TestInitSeedsAccessKey(t *testing.T) {
+	type tc struct {
+		name        string
+		accessKeyID string
+		secret      string
+		wantUpserts int
+	}
+
+	cases := []tc{
+		{
+			name:        "seeds configured access key",
+			accessKeyID: "AKIDEXAMPLE",
+			secret:      "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY",
+			wantUpserts: 1,
+		},
+		{
+			name:        "skips seeding without a secret",
+			accessKeyID: "AKIDEXAMPLE",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Setenv("GANTRY_ACCESS_KEY_ID", c.accessKeyID)
+			t.Setenv("GANTRY_SECRET_ACCESS_KEY", c.secret)
+			t.Setenv("GANTRY_ACCESS_KEY_USER", "")
+			config.Init()
+
+			users := testutil.NewFakeUserStore()
+			keys := testutil.NewFakeAccessKeyStore()
+			buckets := testutil.NewFakeBucketStore()
+			st := testutil.NewFakeStore(testutil.WithUsers(users), testutil.WithAccessKeys(keys), testutil.WithBuckets(buckets))
+
+			if err := Init(context.Background(), st); err != nil {
+				t.Fatalf("Init: unexpected error: %v", err)
+			}
+
+			calls := keys.UpsertCalls()
+			if len(calls) != c.wantUpserts {
+				t.Fatalf("Upsert calls: got %d want %d", len(calls), c.wantUpserts)
+			}
+			adopted := buckets.AdoptUnownedCalls()
+			if len(adopted) != c.wantUpserts {
+				t.Fatalf("AdoptUnowned calls: got %d want %d", len(adopted), c.wantUpserts)
+			}
+			if c.wantUpserts == 0 {
+				return
+			}
+
+			ensured := users.EnsureCalls()
+			if len(ensured) != 1 || ensured[0].Name != "admin" {
+				t.Fatalf("Ensure calls: got %+v, want one for admin", ensured)
+			}
+
+			if adopted[0].OwnerID != ensured[0].ID {
+				t.Fatalf("AdoptUnowned owner: got %q want %q", adopted[0].OwnerID, ensured[0].ID)
+			}
+
+			call := calls[0]
+			if call.UserID != ensured[0].ID {
+				t.Fatalf("Upsert user: got %q want %q", call.UserID, ensured[0].ID)
+			}
+			if call.AccessKeyID != c.accessKeyID || call.SecretAccessKey != c.secret {
+				t.Fatalf("Upsert key: got %s/%s want %s/%s", call.AccessKeyID, call.SecretAccessKey, c.accessKeyID, c.secret)
+			}
+			if _, err := ulid.Parse(call.ID); err != nil {
+				t.Fatalf("Upsert id not ULID: %v", err)
+			}
+		})
+	}
+}
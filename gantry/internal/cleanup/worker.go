@@ -0,0 +1,109 @@
+package cleanup
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+)
+
+// batchSize bounds how many blobs one sweep asks cradles to delete.
+const batchSize = 100
+
+type ObjectStore interface {
+	ListReclaimable(ctx context.Context, now time.Time, limit int) ([]store.ReclaimableObject, error)
+	MarkDeleted(ctx context.Context, objectID string, updatedAt time.Time) error
+}
+
+type DeletionQueue interface {
+	List(ctx context.Context, limit int) ([]store.ReclaimableObject, error)
+	Remove(ctx context.Context, objectID string) error
+}
+
+type CradleClient interface {
+	DeleteObject(ctx context.Context, objectID, bucket string) error
+}
+
+// Worker deletes REPLACED and FAILED blobs, plus blobs queued by bucket
+// deletion, from their cradles and records the confirmation. Failed deletes
+// are left in place and retried next tick. Versions still under an object
+// lock are never listed as reclaimable.
+type Worker struct {
+	objects  ObjectStore
+	queue    DeletionQueue
+	clients  map[string]CradleClient
+	interval time.Duration
+}
+
+// New creates a Worker. clients is keyed by cradle address.
+func New(objects ObjectStore, queue DeletionQueue, clients map[string]CradleClient, interval time.Duration) *Worker {
+	return &Worker{
+		objects,
+		queue,
+		clients,
+		interval,
+	}
+}
+
+func (w *Worker) Run(ctx context.Context) {
+	slog.Debug("starting cleanup worker")
+	w.sweep(ctx)
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			w.sweep(ctx)
+		}
+	}
+}
+
+func (w *Worker) sweep(ctx context.Context) {
+	objs, err := w.objects.ListReclaimable(ctx, time.Now().UTC(), batchSize)
+	if err != nil {
+		slog.Error("cleanup list reclaimable", "err", err)
+	} else {
+		w.reclaim(ctx, objs, func(ctx context.Context, objectID string) error {
+			return w.objects.MarkDeleted(ctx, objectID, time.Now().UTC())
+		})
+	}
+
+	queued, err := w.queue.List(ctx, batchSize)
+	if err != nil {
+		slog.Error("cleanup list blob deletions", "err", err)
+		return
+	}
+	w.reclaim(ctx, queued, w.queue.Remove)
+}
+
+// reclaim asks each blob's cradle to delete it and calls confirm for every
+// delete the cradle acknowledges.
+func (w *Worker) reclaim(ctx context.Context, objs []store.ReclaimableObject, confirm func(context.Context, string) error) {
+	slog.Debug("cleanup tick", "objects", len(objs))
+	for _, obj := range objs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		client, ok := w.clients[obj.CradleAddress]
+		if !ok {
+			slog.Warn("cleanup skipped, no client for cradle", "object_id", obj.ID, "addr", obj.CradleAddress)
+			continue
+		}
+
+		if err := client.DeleteObject(ctx, obj.ID, obj.Bucket); err != nil {
+			slog.Warn("cleanup cradle delete failed", "object_id", obj.ID, "addr", obj.CradleAddress, "err", err)
+			continue
+		}
+
+		if err := confirm(ctx, obj.ID); err != nil {
+			slog.Error("cleanup confirm deleted", "object_id", obj.ID, "err", err)
+			continue
+		}
+
+		slog.Debug("cleanup deleted object", "object_id", obj.ID, "bucket", obj.Bucket, "addr", obj.CradleAddress)
+	}
+}
@@ -0,0 +1,140 @@
+package cleanup_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/cleanup"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+)
+
+type fakeClient struct {
+	mu    sync.Mutex
+	err   error
+	calls []string
+}
+
+func (f *fakeClient) DeleteObject(_ context.Context, objectID, bucket string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, bucket+"/"+objectID)
+	return f.err
+}
+
+func (f *fakeClient) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.calls)
+}
+
+func TestWorker_DeletesReclaimableObjects(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name            string
+		objects         []store.ReclaimableObject
+		queued          []store.ReclaimableObject
+		clientErr       error
+		wantDeletes     []string
+		wantMarkDeleted []string
+		wantRemoved     []string
+	}
+
+	cases := []tc{
+		{
+			name: "deletes from cradle then marks deleted",
+			objects: []store.ReclaimableObject{
+				{ID: "obj-1", Bucket: "photos", CradleAddress: "cradle-a:9444"},
+				{ID: "obj-2", Bucket: "docs", CradleAddress: "cradle-a:9444"},
+			},
+			wantDeletes:     []string{"photos/obj-1", "docs/obj-2"},
+			wantMarkDeleted: []string{"obj-1", "obj-2"},
+		},
+		{
+			name: "drains blobs queued by bucket deletion",
+			objects: []store.ReclaimableObject{
+				{ID: "obj-1", Bucket: "photos", CradleAddress: "cradle-a:9444"},
+			},
+			queued: []store.ReclaimableObject{
+				{ID: "obj-9", Bucket: "old-bucket", CradleAddress: "cradle-a:9444"},
+			},
+			wantDeletes:     []string{"photos/obj-1", "old-bucket/obj-9"},
+			wantMarkDeleted: []string{"obj-1"},
+			wantRemoved:     []string{"obj-9"},
+		},
+		{
+			name: "cradle failure leaves object for retry",
+			objects: []store.ReclaimableObject{
+				{ID: "obj-1", Bucket: "photos", CradleAddress: "cradle-a:9444"},
+			},
+			queued: []store.ReclaimableObject{
+				{ID: "obj-9", Bucket: "old-bucket", CradleAddress: "cradle-a:9444"},
+			},
+			clientErr:   errors.New("connection refused"),
+			wantDeletes: []string{"photos/obj-1", "old-bucket/obj-9"},
+		},
+		{
+			name: "unknown cradle is skipped",
+			objects: []store.ReclaimableObject{
+				{ID: "obj-1", Bucket: "photos", CradleAddress: "cradle-gone:9444"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			objects := testutil.NewFakeObjectStore()
+			objects.SetListReclaimableResponse(c.objects)
+
+			queue := testutil.NewFakeBlobDeletionStore()
+			queue.SetListResponse(c.queued)
+
+			client := &fakeClient{err: c.clientErr}
+			clients := map[string]cleanup.CradleClient{"cradle-a:9444": client}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			worker := cleanup.New(objects, queue, clients, time.Hour)
+
+			done := make(chan struct{})
+			go func() {
+				worker.Run(ctx)
+				close(done)
+			}()
+
+			deadline := time.After(time.Second)
+			for queue.ListCalls() == 0 || len(client.Calls()) < len(c.wantDeletes) || len(objects.MarkDeletedCalls()) < len(c.wantMarkDeleted) {
+				select {
+				case <-deadline:
+					t.Fatal("timeout waiting for cleanup sweep")
+				case <-time.After(time.Millisecond):
+				}
+			}
+
+			cancel()
+			select {
+			case <-done:
+			case <-time.After(100 * time.Millisecond):
+				t.Fatal("timeout waiting for worker to stop")
+			}
+
+			if got := client.Calls(); !slices.Equal(got, c.wantDeletes) {
+				t.Fatalf("DeleteObject calls: got %v, want %v", got, c.wantDeletes)
+			}
+			if got := objects.MarkDeletedCalls(); !slices.Equal(got, c.wantMarkDeleted) {
+				t.Fatalf("MarkDeleted calls: got %v, want %v", got, c.wantMarkDeleted)
+			}
+			if got := queue.RemoveCalls(); !slices.Equal(got, c.wantRemoved) {
+				t.Fatalf("Remove calls: got %v, want %v", got, c.wantRemoved)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"flag"
 	"log/slog"
 	"os"
@@ -34,16 +36,25 @@ const (
 )
 
 var (
-	AppEnv            envVal
-	LogFormat         logFormatVal
-	LogVerbosity      logVerbosityVal
-	EnableReflection  bool
-	GantryPort        int
-	DatabasePath      string
-	CradleServerID    string
-	CradleAddr        string
-	HeartbeatInterval time.Duration
-	LogLevel          slog.Level
+	AppEnv              envVal
+	LogFormat           logFormatVal
+	LogVerbosity        logVerbosityVal
+	EnableReflection    bool
+	GantryPort          int
+	DatabasePath        string
+	CradleServerID      string
+	CradleAddr          string
+	HeartbeatInterval   time.Duration
+	CleanupInterval     time.Duration
+	UploadSweepInterval time.Duration
+	UploadMaxAge        time.Duration
+	LifecycleInterval   time.Duration
+	AccessKeyUser       string
+	AccessKeyID         string
+	SecretAccessKey     string
+	RequireBucketOwner  bool
+	SecretKey           []byte
+	LogLevel            slog.Level
 )
 
 func Init() {
@@ -112,6 +123,75 @@ func Init() {
 		}
 	}
 
+	CleanupInterval = time.Minute
+	if v := strings.TrimSpace(os.Getenv("GANTRY_CLEANUP_INTERVAL")); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			CleanupInterval = d
+		}
+	}
+
+	UploadSweepInterval = time.Hour
+	if v := strings.TrimSpace(os.Getenv("GANTRY_UPLOAD_SWEEP_INTERVAL")); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			UploadSweepInterval = d
+		}
+	}
+
+	// Multipart uploads still IN_PROGRESS this long after they were created
+	// are aborted. Zero disables the sweep.
+	UploadMaxAge = 7 * 24 * time.Hour
+	if v := strings.TrimSpace(os.Getenv("GANTRY_UPLOAD_MAX_AGE")); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			UploadMaxAge = d
+		}
+	}
+
+	LifecycleInterval = time.Hour
+	if v := strings.TrimSpace(os.Getenv("GANTRY_LIFECYCLE_INTERVAL")); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			LifecycleInterval = d
+		}
+	}
+
+	// An access key seeded at startup for AccessKeyUser so flatbed can
+	// authenticate requests before any other keys exist.
+	AccessKeyUser = "admin"
+	if v := strings.TrimSpace(os.Getenv("GANTRY_ACCESS_KEY_USER")); v != "" {
+		AccessKeyUser = v
+	}
+	AccessKeyID = strings.TrimSpace(os.Getenv("GANTRY_ACCESS_KEY_ID"))
+	SecretAccessKey = strings.TrimSpace(os.Getenv("GANTRY_SECRET_ACCESS_KEY"))
+
+	// Buckets created while flatbed runs without authentication have no
+	// owner. Development and test leave them open to everyone; elsewhere,
+	// where flatbed checks signatures, they are closed to anyone but what
+	// their ACL and policy allow until the access key user adopts them.
+	RequireBucketOwner = AppEnv != EnvDevelopment && AppEnv != EnvTest
+	if v := strings.ToLower(strings.TrimSpace(os.Getenv("GANTRY_REQUIRE_BUCKET_OWNER"))); v != "" {
+		switch v {
+		case "true", "1", "yes", "on":
+			RequireBucketOwner = true
+		case "false", "0", "no", "off":
+			RequireBucketOwner = false
+		}
+	}
+
+	// The key that seals access key secrets at rest: 32 bytes, base64
+	// encoded. Development and test fall back to a fixed, publicly known key
+	// so a fresh checkout runs; elsewhere gantry refuses to start without it.
+	SecretKey = nil
+	if AppEnv == EnvDevelopment || AppEnv == EnvTest {
+		sum := sha256.Sum256([]byte("blockcloset development secret key"))
+		SecretKey = sum[:]
+	}
+	if v := strings.TrimSpace(os.Getenv("GANTRY_SECRET_KEY")); v != "" {
+		key, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			key = nil
+		}
+		SecretKey = key
+	}
+
 	LogLevel = slog.LevelInfo
 	if v := strings.ToLower(strings.TrimSpace(os.Getenv("LOG_LEVEL"))); v != "" {
 		switch v {
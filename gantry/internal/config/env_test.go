@@ -11,3 +11,28 @@ func TestAppEnvDefaultsToTestInGoTest(t *testing.T) {
 		t.Fatalf("expected default AppEnv to be \"test\" when running tests, got %q", AppEnv)
 	}
 }
+
+func TestRequireBucketOwner(t *testing.T) {
+	cases := []struct {
+		name string
+		env  string
+		want bool
+	}{
+		{name: "off in test", want: false},
+		{name: "turned on", env: "true", want: true},
+		{name: "turned off", env: "off", want: false},
+		{name: "garbage keeps the default", env: "maybe", want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Setenv("GANTRY_REQUIRE_BUCKET_OWNER", c.env)
+
+			Init()
+
+			if RequireBucketOwner != c.want {
+				t.Fatalf("RequireBucketOwner = %v, want %v", RequireBucketOwner, c.want)
+			}
+		})
+	}
+}
@@ -45,3 +45,11 @@ func (c *Client) Heartbeat(ctx context.Context) error {
 	slog.Debug("heartbeat ok", "addr", c.cc.Target(), "available_bytes", resp.GetAvailableBytes())
 	return nil
 }
+
+func (c *Client) DeleteObject(ctx context.Context, objectID, bucket string) error {
+	_, err := c.svc.DeleteObject(ctx, &servicev1.DeleteObjectRequest{
+		ObjectId: objectID,
+		Bucket:   bucket,
+	})
+	return err
+}
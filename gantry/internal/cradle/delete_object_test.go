@@ -0,0 +1,25 @@
+package cradle
+
+import (
+	"context"
+	"testing"
+)
+
+func TestClientDeleteObject(t *testing.T) {
+	client, svc := newTestClient(t)
+
+	if err := client.DeleteObject(context.Background(), "obj-123", "photos"); err != nil {
+		t.Fatalf("DeleteObject: %v", err)
+	}
+
+	calls := svc.DeleteObjectCalls()
+	if len(calls) != 1 {
+		t.Fatalf("DeleteObjectCalls: got %d, want 1", len(calls))
+	}
+	if calls[0].GetObjectId() != "obj-123" {
+		t.Fatalf("object_id: got %q, want %q", calls[0].GetObjectId(), "obj-123")
+	}
+	if calls[0].GetBucket() != "photos" {
+		t.Fatalf("bucket: got %q, want %q", calls[0].GetBucket(), "photos")
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
 
 	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1"
 )
@@ -17,8 +18,9 @@ const testBufConnSize = 1024 * 1024
 type captureCradleService struct {
 	servicev1.UnimplementedCradleServiceServer
 
-	mu             sync.Mutex
-	heartbeatCalls int
+	mu                sync.Mutex
+	heartbeatCalls    int
+	deleteObjectCalls []*servicev1.DeleteObjectRequest
 }
 
 func (s *captureCradleService) Heartbeat(ctx context.Context, req *servicev1.HeartbeatRequest) (*servicev1.HeartbeatResponse, error) {
@@ -34,6 +36,21 @@ func (s *captureCradleService) HeartbeatCalls() int {
 	return s.heartbeatCalls
 }
 
+func (s *captureCradleService) DeleteObject(ctx context.Context, req *servicev1.DeleteObjectRequest) (*servicev1.DeleteObjectResponse, error) {
+	s.mu.Lock()
+	s.deleteObjectCalls = append(s.deleteObjectCalls, proto.Clone(req).(*servicev1.DeleteObjectRequest))
+	s.mu.Unlock()
+	return &servicev1.DeleteObjectResponse{}, nil
+}
+
+func (s *captureCradleService) DeleteObjectCalls() []*servicev1.DeleteObjectRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	calls := make([]*servicev1.DeleteObjectRequest, len(s.deleteObjectCalls))
+	copy(calls, s.deleteObjectCalls)
+	return calls
+}
+
 func newTestClient(t *testing.T) (*Client, *captureCradleService) {
 	t.Helper()
 
@@ -0,0 +1,216 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMigration0009_RoundTripKeepsBuckets(t *testing.T) {
+	conn := openMigrationConn(t)
+	migrateUpTo(t, conn, 9)
+
+	execAll(t, conn,
+		`INSERT INTO users (id, name, created_at, updated_at) VALUES ('user-1', 'alice', 1, 1)`,
+		`INSERT INTO buckets (id, name, created_at, updated_at, owner_id) VALUES ('bucket-1', 'photos', 1, 1, 'user-1')`,
+		`INSERT INTO buckets (id, name, created_at, updated_at) VALUES ('bucket-2', 'legacy', 1, 1)`,
+		`INSERT INTO cradle_servers (id, address, created_at, updated_at) VALUES ('cradle-1', 'localhost:9444', 1, 1)`,
+		`INSERT INTO objects (object_id, bucket_id, key, state, size_expected, cradle_server_id, created_at, updated_at)
+			VALUES ('object-1', 'bucket-1', 'cat.jpg', 'COMMITTED', 3, 'cradle-1', 1, 1)`,
+		`INSERT INTO multipart_uploads (upload_id, bucket_id, key, state, created_at, updated_at)
+			VALUES ('upload-1', 'bucket-1', 'video.mp4', 'IN_PROGRESS', 1, 1)`,
+	)
+
+	applyMigration(t, conn, "0009_create_users_table.down.sql")
+
+	if got := countRows(t, conn, "buckets"); got != 2 {
+		t.Fatalf("buckets after down = %d, want 2", got)
+	}
+	if got := countRows(t, conn, "objects"); got != 1 {
+		t.Fatalf("objects after down = %d, want 1", got)
+	}
+	if got := countRows(t, conn, "multipart_uploads"); got != 1 {
+		t.Fatalf("multipart uploads after down = %d, want 1", got)
+	}
+	assertForeignKeysIntact(t, conn)
+
+	applyMigration(t, conn, "0009_create_users_table.up.sql")
+
+	if got := countRows(t, conn, "buckets"); got != 2 {
+		t.Fatalf("buckets after up = %d, want 2", got)
+	}
+	execAll(t, conn,
+		`INSERT INTO users (id, name, created_at, updated_at) VALUES ('user-1', 'alice', 1, 1)`,
+		`UPDATE buckets SET owner_id = 'user-1' WHERE id = 'bucket-1'`,
+	)
+	assertForeignKeysIntact(t, conn)
+}
+
+func TestMigration0014_RoundTripKeepsObjectMetadata(t *testing.T) {
+	conn := openMigrationConn(t)
+	migrateUpTo(t, conn, 13)
+
+	execAll(t, conn,
+		`INSERT INTO buckets (id, name, created_at, updated_at) VALUES ('bucket-1', 'photos', 1, 1)`,
+		`INSERT INTO cradle_servers (id, address, created_at, updated_at) VALUES ('cradle-1', 'localhost:9444', 1, 1)`,
+		`INSERT INTO objects (object_id, bucket_id, key, state, size_expected, cradle_server_id, created_at, updated_at)
+			VALUES ('object-1', 'bucket-1', 'cat.jpg', 'COMMITTED', 3, 'cradle-1', 1, 1)`,
+		`INSERT INTO object_metadata (object_id, name, value) VALUES ('object-1', 'cache-control', 'no-cache')`,
+		`INSERT INTO object_metadata (object_id, name, value) VALUES ('object-1', 'x-amz-meta-camera', 'pinhole')`,
+	)
+
+	applyMigration(t, conn, "0014_add_object_versioning.up.sql")
+
+	if got := countRows(t, conn, "object_metadata"); got != 2 {
+		t.Fatalf("object metadata after up = %d, want 2", got)
+	}
+	assertForeignKeysIntact(t, conn)
+
+	// A delete marker has no metadata and doesn't survive the down
+	// migration; the version it hides does.
+	execAll(t, conn,
+		`UPDATE objects SET state = 'NONCURRENT' WHERE object_id = 'object-1'`,
+		`INSERT INTO objects (object_id, bucket_id, key, state, size_expected, created_at, updated_at, version_id)
+			VALUES ('marker-1', 'bucket-1', 'cat.jpg', 'DELETE_MARKER', 0, 2, 2, 'v2')`,
+	)
+
+	applyMigration(t, conn, "0014_add_object_versioning.down.sql")
+
+	if got := countRows(t, conn, "objects"); got != 1 {
+		t.Fatalf("objects after down = %d, want 1", got)
+	}
+	if got := countRows(t, conn, "object_metadata"); got != 2 {
+		t.Fatalf("object metadata after down = %d, want 2", got)
+	}
+	assertForeignKeysIntact(t, conn)
+
+	applyMigration(t, conn, "0014_add_object_versioning.up.sql")
+
+	if got := countRows(t, conn, "object_metadata"); got != 2 {
+		t.Fatalf("object metadata after second up = %d, want 2", got)
+	}
+	assertForeignKeysIntact(t, conn)
+}
+
+// openMigrationConn opens an empty database with foreign keys on, as gantry
+// opens its own. PRAGMA foreign_keys is per connection, so every migration
+// runs on the one returned.
+func openMigrationConn(t *testing.T) *sql.Conn {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := OpenDatabase(ctx, filepath.Join(t.TempDir(), "migrate.db"))
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatalf("get connection: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = ON"); err != nil {
+		t.Fatalf("enable foreign keys: %v", err)
+	}
+
+	return conn
+}
+
+// migrateUpTo applies every up migration through version in order.
+func migrateUpTo(t *testing.T, conn *sql.Conn, version int) {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join(locateMigrationsDir(), "*.up.sql"))
+	if err != nil {
+		t.Fatalf("list migrations: %v", err)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		name := filepath.Base(file)
+		v, err := strconv.Atoi(name[:strings.Index(name, "_")])
+		if err != nil {
+			t.Fatalf("migration version of %s: %v", name, err)
+		}
+		if v > version {
+			break
+		}
+		applyMigration(t, conn, name)
+	}
+}
+
+func applyMigration(t *testing.T, conn *sql.Conn, file string) {
+	t.Helper()
+
+	dir := locateMigrationsDir()
+	if dir == "" {
+		t.Fatalf("migrations directory not found")
+	}
+
+	script, err := os.ReadFile(filepath.Join(dir, file))
+	if err != nil {
+		t.Fatalf("read %s: %v", file, err)
+	}
+
+	if _, err := conn.ExecContext(context.Background(), string(script)); err != nil {
+		t.Fatalf("apply %s: %v", file, err)
+	}
+}
+
+func execAll(t *testing.T, conn *sql.Conn, stmts ...string) {
+	t.Helper()
+
+	for _, stmt := range stmts {
+		if _, err := conn.ExecContext(context.Background(), stmt); err != nil {
+			t.Fatalf("exec %q: %v", stmt, err)
+		}
+	}
+}
+
+func countRows(t *testing.T, conn *sql.Conn, table string) int {
+	t.Helper()
+
+	var n int
+	if err := conn.QueryRowContext(context.Background(), "SELECT COUNT(*) FROM "+table).Scan(&n); err != nil {
+		t.Fatalf("count %s: %v", table, err)
+	}
+	return n
+}
+
+func assertForeignKeysIntact(t *testing.T, conn *sql.Conn) {
+	t.Helper()
+
+	var enabled bool
+	if err := conn.QueryRowContext(context.Background(), "PRAGMA foreign_keys").Scan(&enabled); err != nil {
+		t.Fatalf("read foreign_keys: %v", err)
+	}
+	if !enabled {
+		t.Fatalf("foreign keys left disabled")
+	}
+
+	rows, err := conn.QueryContext(context.Background(), "PRAGMA foreign_key_check")
+	if err != nil {
+		t.Fatalf("foreign_key_check: %v", err)
+	}
+	defer rows.Close()
+	if rows.Next() {
+		var table string
+		var rowid sql.NullInt64
+		var parent string
+		var fkid int
+		if err := rows.Scan(&table, &rowid, &parent, &fkid); err != nil {
+			t.Fatalf("scan foreign_key_check: %v", err)
+		}
+		t.Fatalf("foreign key violation in %s referencing %s", table, parent)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("foreign_key_check rows: %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) AbortMultipartUpload(ctx context.Context, req *servicev1.AbortMultipartUploadRequest) (*servicev1.AbortMultipartUploadResponse, error) {
+	bucketName := req.GetBucket()
+	key := req.GetKey()
+
+	bucketValidator := validation.DefaultBucketNameValidator{}
+	keyValidator := validation.DefaultKeyValidator{}
+
+	if err := bucketValidator.ValidateBucketName(bucketName); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	if err := keyValidator.ValidateKey(key); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidKeyName")
+	}
+
+	if err := checkTestBucket(bucketName); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	upload, err := s.openUpload(ctx, bucketName, key, req.GetUploadId(), policy.ActionAbortMultipartUpload)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	err = s.store.Multipart().Abort(ctx, upload.ID, time.Now().UTC())
+	switch {
+	case errors.Is(err, store.ErrUploadNotFound), errors.Is(err, store.ErrUploadNotInProgress):
+		return nil, loggrpc.SetError(ctx, errNoSuchUpload)
+	case err != nil:
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	loggrpc.SetAttrs(ctx,
+		slog.String("result", fmt.Sprintf("upload %s of %s/%s aborted", upload.ID, bucketName, key)))
+
+	return &servicev1.AbortMultipartUploadResponse{}, nil
+}
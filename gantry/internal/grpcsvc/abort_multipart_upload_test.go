@@ -0,0 +1,131 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_AbortMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name            string
+		key             string
+		uploadID        string
+		getByNameErr    error
+		abortErr        error
+		wantAborts      int
+		wantErr         bool
+		wantCode        codes.Code
+		wantMessage     string
+		wantErrorDetail bool
+	}
+
+	cases := []tc{
+		{
+			name:       "aborts upload",
+			key:        "videos/big.mp4",
+			uploadID:   "upload-id-1",
+			wantAborts: 1,
+		},
+		{
+			name:        "unknown upload returns NoSuchUpload",
+			key:         "videos/big.mp4",
+			uploadID:    "upload-id-missing",
+			wantErr:     true,
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchUpload",
+		},
+		{
+			name:        "upload of another key returns NoSuchUpload",
+			key:         "videos/other.mp4",
+			uploadID:    "upload-id-1",
+			wantErr:     true,
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchUpload",
+		},
+		{
+			name:            "bucket not found returns NotFound",
+			key:             "videos/big.mp4",
+			uploadID:        "upload-id-1",
+			getByNameErr:    store.ErrBucketNotFound,
+			wantErr:         true,
+			wantCode:        codes.NotFound,
+			wantMessage:     "bucket not found",
+			wantErrorDetail: true,
+		},
+		{
+			name:        "upload finished concurrently returns NoSuchUpload",
+			key:         "videos/big.mp4",
+			uploadID:    "upload-id-1",
+			abortErr:    fmt.Errorf("abort upload: %w", store.ErrUploadNotInProgress),
+			wantAborts:  1,
+			wantErr:     true,
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchUpload",
+		},
+		{
+			name:        "store error returns Internal",
+			key:         "videos/big.mp4",
+			uploadID:    "upload-id-1",
+			abortErr:    errors.New("abort upload: disk I/O error"),
+			wantAborts:  1,
+			wantErr:     true,
+			wantCode:    codes.Internal,
+			wantMessage: "abort upload: disk I/O error",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			buckets, uploads := newUploadFakes()
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+			if c.abortErr != nil {
+				uploads.SetAbortError(c.abortErr)
+			}
+
+			svc.store = testutil.NewFakeStore(
+				testutil.WithBuckets(buckets),
+				testutil.WithMultipart(uploads),
+			)
+
+			_, err := svc.AbortMultipartUpload(context.Background(), &servicev1.AbortMultipartUploadRequest{
+				Bucket:   "my-bucket",
+				Key:      c.key,
+				UploadId: c.uploadID,
+			})
+
+			if got := len(uploads.AbortCalls()); got != c.wantAborts {
+				t.Fatalf("Abort calls: got %d, want %d", got, c.wantAborts)
+			}
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				if c.wantErrorDetail {
+					assertObjectLookupErrorDetail(t, err, servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, "my-bucket", c.key)
+				}
+				return
+			}
+
+			assertNoError(t, err)
+
+			if got := uploads.AbortCalls()[0].UploadID; got != "upload-id-1" {
+				t.Fatalf("Abort upload id: got %q, want %q", got, "upload-id-1")
+			}
+		})
+	}
+}
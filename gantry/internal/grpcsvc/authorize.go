@@ -0,0 +1,175 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+)
+
+var errAccessDenied = status.Error(codes.PermissionDenied, "AccessDenied")
+
+// validCannedACL reports whether acl is one gantry stores. An empty acl
+// means private.
+func validCannedACL(acl string) bool {
+	switch acl {
+	case "", store.BucketACLPrivate, store.BucketACLPublicRead,
+		store.BucketACLPublicReadWrite, store.BucketACLAuthenticatedRead:
+		return true
+	}
+	return false
+}
+
+// authorize decides whether the caller may perform action on bucket, or on
+// key within it when key is non-empty. An explicit Deny in the bucket policy
+// always wins; otherwise the owner is allowed, then the canned ACL, then an
+// Allow in the policy. A bucket nobody owns, created anonymously, is open to
+// everyone unless the service requires an owner, in which case only its ACL
+// and policy grant anything. It returns errAccessDenied when nothing allows
+// the request.
+func (s *Service) authorize(ctx context.Context, bucket store.BucketRecord, action, key string) error {
+	caller := callerID(ctx)
+
+	decision := policy.NoMatch
+	if bucket.Policy != "" {
+		pol, err := policy.Parse(bucket.Policy, bucket.Name)
+		if err != nil {
+			return status.Error(codes.Internal, fmt.Sprintf("stored policy for bucket %s: %v", bucket.Name, err))
+		}
+		principal, err := s.principal(ctx, caller)
+		if err != nil {
+			return err
+		}
+		resource := policy.BucketARN(bucket.Name)
+		if key != "" {
+			resource = policy.ObjectARN(bucket.Name, key)
+		}
+		decision = pol.Evaluate(policy.Request{Principal: principal, Action: action, Resource: resource})
+	}
+
+	switch {
+	case decision == policy.Denied:
+		return errAccessDenied
+	case bucket.OwnerID == "" && !s.requireOwner:
+		return nil
+	case isOwner(caller, bucket):
+		return nil
+	case aclAllows(bucket.ACL, caller, action):
+		return nil
+	case decision == policy.Allowed:
+		return nil
+	}
+	return errAccessDenied
+}
+
+// authorizeOwner allows only the bucket owner, for managing the bucket's
+// policy and ACL. Anyone may manage a bucket nobody owns, unless the service
+// requires an owner.
+func (s *Service) authorizeOwner(ctx context.Context, bucket store.BucketRecord) error {
+	if bucket.OwnerID == "" && !s.requireOwner {
+		return nil
+	}
+	if !isOwner(callerID(ctx), bucket) {
+		return errAccessDenied
+	}
+	return nil
+}
+
+// authorizeAdmin allows only the access key user gantry seeds at startup,
+// for operations that reach past bucket ownership.
+func (s *Service) authorizeAdmin(ctx context.Context) error {
+	caller := callerID(ctx)
+	if caller == "" || s.adminUser == "" {
+		return errAccessDenied
+	}
+	user, err := s.store.Users().GetByID(ctx, caller)
+	if err != nil {
+		if errors.Is(err, store.ErrUserNotFound) {
+			return errAccessDenied
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+	if user.Name != s.adminUser {
+		return errAccessDenied
+	}
+	return nil
+}
+
+// isOwner reports whether caller owns bucket. An anonymous caller owns
+// nothing, not even a bucket without an owner.
+func isOwner(caller string, bucket store.BucketRecord) bool {
+	return caller != "" && caller == bucket.OwnerID
+}
+
+// principal returns the user name policies refer to the caller by.
+func (s *Service) principal(ctx context.Context, caller string) (string, error) {
+	if caller == "" {
+		return "", nil
+	}
+	user, err := s.store.Users().GetByID(ctx, caller)
+	if err != nil {
+		if errors.Is(err, store.ErrUserNotFound) {
+			return "", errAccessDenied
+		}
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	return user.Name, nil
+}
+
+// aclAllows applies a canned ACL to a caller that doesn't own the bucket.
+func aclAllows(acl, caller, action string) bool {
+	var read bool
+	switch action {
+	case policy.ActionGetObject, policy.ActionGetObjectVersion, policy.ActionListBucket, policy.ActionListBucketVersions:
+		read = true
+	}
+
+	switch acl {
+	case store.BucketACLPublicRead:
+		return read
+	case store.BucketACLPublicReadWrite:
+		return read || action == policy.ActionPutObject
+	case store.BucketACLAuthenticatedRead:
+		return read && caller != ""
+	}
+	return false
+}
+
+var errNoSuchBucket = status.Error(codes.NotFound, "NoSuchBucket")
+
+// ownedBucket resolves the named bucket for the policy, ACL and versioning
+// RPCs, which only its owner may call.
+func (s *Service) ownedBucket(ctx context.Context, name string) (store.BucketRecord, error) {
+	validator := validation.DefaultBucketNameValidator{}
+	if err := validator.ValidateBucketName(name); err != nil {
+		return store.BucketRecord{}, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	bucket, err := s.store.Buckets().GetByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, store.ErrBucketNotFound) {
+			return store.BucketRecord{}, errNoSuchBucket
+		}
+		return store.BucketRecord{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := s.authorizeOwner(ctx, bucket); err != nil {
+		return store.BucketRecord{}, err
+	}
+	return bucket, nil
+}
+
+// setBucketError maps a failed SetACL, SetPolicy or SetVersioning to a
+// status.
+func setBucketError(err error) error {
+	if errors.Is(err, store.ErrBucketNotFound) {
+		return errNoSuchBucket
+	}
+	return status.Error(codes.Internal, err.Error())
+}
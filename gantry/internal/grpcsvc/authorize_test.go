@@ -0,0 +1,168 @@
+package grpcsvc
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+)
+
+func TestService_Authorize(t *testing.T) {
+	t.Parallel()
+
+	const (
+		allowBobReads   = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam:::user/bob"},"Action":["s3:GetObject","s3:ListBucket"],"Resource":["arn:aws:s3:::shared","arn:aws:s3:::shared/*"]}]}`
+		allowAnonPublic = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::shared/public/*"}]}`
+		denySecrets     = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::shared/secret/*"}]}`
+	)
+
+	type tc struct {
+		name         string
+		requireOwner bool
+		caller       string
+		callerName   string
+		userErr      error
+		ownerID      string
+		acl          string
+		policy       string
+		action       string
+		key          string
+		wantCode     codes.Code
+		wantMsg      string
+	}
+
+	cases := []tc{
+		{name: "owner allowed", caller: "alice-id", ownerID: "alice-id", action: policy.ActionPutObject, key: "a.txt"},
+		{name: "unowned bucket open to anonymous", action: policy.ActionPutObject, key: "a.txt"},
+		{name: "unowned bucket open to signed callers", caller: "bob-id", action: policy.ActionDeleteObject, key: "a.txt"},
+		{name: "required owner closes unowned bucket to anonymous", requireOwner: true, action: policy.ActionPutObject, key: "a.txt", wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "required owner closes unowned bucket to signed callers", requireOwner: true, caller: "bob-id", action: policy.ActionDeleteObject, key: "a.txt", wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "required owner leaves unowned bucket to its acl", requireOwner: true, acl: store.BucketACLPublicRead, action: policy.ActionGetObject, key: "a.txt"},
+		{name: "policy deny applies to unowned bucket", policy: denySecrets, action: policy.ActionGetObject, key: "secret/plans.txt", wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "stranger denied on private bucket", caller: "bob-id", ownerID: "alice-id", action: policy.ActionGetObject, key: "a.txt", wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "anonymous denied on private bucket", ownerID: "alice-id", action: policy.ActionListBucket, wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "public-read allows anonymous get", ownerID: "alice-id", acl: store.BucketACLPublicRead, action: policy.ActionGetObject, key: "a.txt"},
+		{name: "public-read denies anonymous put", ownerID: "alice-id", acl: store.BucketACLPublicRead, action: policy.ActionPutObject, key: "a.txt", wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "public-read-write allows anonymous put", ownerID: "alice-id", acl: store.BucketACLPublicReadWrite, action: policy.ActionPutObject, key: "a.txt"},
+		{name: "public-read-write denies anonymous delete", ownerID: "alice-id", acl: store.BucketACLPublicReadWrite, action: policy.ActionDeleteObject, key: "a.txt", wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "authenticated-read allows signed list", caller: "bob-id", ownerID: "alice-id", acl: store.BucketACLAuthenticatedRead, action: policy.ActionListBucket},
+		{name: "authenticated-read denies anonymous list", ownerID: "alice-id", acl: store.BucketACLAuthenticatedRead, action: policy.ActionListBucket, wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "policy allows named user", caller: "bob-id", callerName: "bob", ownerID: "alice-id", policy: allowBobReads, action: policy.ActionGetObject, key: "a.txt"},
+		{name: "policy allow does not cover other actions", caller: "bob-id", callerName: "bob", ownerID: "alice-id", policy: allowBobReads, action: policy.ActionPutObject, key: "a.txt", wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "policy allow does not cover other users", caller: "carol-id", callerName: "carol", ownerID: "alice-id", policy: allowBobReads, action: policy.ActionGetObject, key: "a.txt", wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "policy allows anonymous under prefix", ownerID: "alice-id", policy: allowAnonPublic, action: policy.ActionGetObject, key: "public/logo.png"},
+		{name: "policy anonymous allow stops at prefix", ownerID: "alice-id", policy: allowAnonPublic, action: policy.ActionGetObject, key: "private/logo.png", wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "policy deny overrides owner", caller: "alice-id", callerName: "alice", ownerID: "alice-id", policy: denySecrets, action: policy.ActionGetObject, key: "secret/plans.txt", wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "policy deny overrides acl", ownerID: "alice-id", acl: store.BucketACLPublicRead, policy: denySecrets, action: policy.ActionGetObject, key: "secret/plans.txt", wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "policy deny leaves other keys to owner", caller: "alice-id", callerName: "alice", ownerID: "alice-id", policy: denySecrets, action: policy.ActionGetObject, key: "notes.txt"},
+		{name: "unknown caller denied when policy applies", caller: "ghost-id", ownerID: "alice-id", policy: allowBobReads, userErr: store.ErrUserNotFound, action: policy.ActionGetObject, key: "a.txt", wantCode: codes.PermissionDenied, wantMsg: "AccessDenied"},
+		{name: "user lookup failure returns Internal", caller: "bob-id", ownerID: "alice-id", policy: allowBobReads, userErr: errors.New("db down"), action: policy.ActionGetObject, key: "a.txt", wantCode: codes.Internal, wantMsg: "db down"},
+		{name: "corrupt stored policy returns Internal", ownerID: "alice-id", policy: `{`, action: policy.ActionGetObject, key: "a.txt", wantCode: codes.Internal},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			svc.requireOwner = c.requireOwner
+			users := testutil.NewFakeUserStore()
+			users.SetGetByIDResponse(store.UserRecord{ID: c.caller, Name: c.callerName})
+			if c.userErr != nil {
+				users.SetGetByIDError(c.userErr)
+			}
+			svc.store = testutil.NewFakeStore(testutil.WithUsers(users))
+
+			bucket := store.BucketRecord{ID: "shared-id", Name: "shared", OwnerID: c.ownerID, ACL: c.acl, Policy: c.policy}
+			if bucket.ACL == "" {
+				bucket.ACL = store.BucketACLPrivate
+			}
+
+			err := svc.authorize(callerContext(c.caller), bucket, c.action, c.key)
+
+			if c.wantCode == codes.OK {
+				assertNoError(t, err)
+				return
+			}
+			if c.wantMsg == "" {
+				if got := status.Code(err); got != c.wantCode {
+					t.Fatalf("status code: got %v, want %v (err %v)", got, c.wantCode, err)
+				}
+				return
+			}
+			assertGRPCError(t, err, c.wantCode, c.wantMsg)
+		})
+	}
+}
+
+func TestAuthorizeOwner(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name         string
+		requireOwner bool
+		caller       string
+		ownerID      string
+		wantErr      bool
+	}{
+		{name: "owner allowed", caller: "alice-id", ownerID: "alice-id"},
+		{name: "stranger denied", caller: "bob-id", ownerID: "alice-id", wantErr: true},
+		{name: "anonymous denied on owned bucket", ownerID: "alice-id", wantErr: true},
+		{name: "anonymous allowed on unowned bucket"},
+		{name: "signed caller allowed on unowned bucket", caller: "bob-id"},
+		{name: "required owner denies anonymous on unowned bucket", requireOwner: true, wantErr: true},
+		{name: "required owner denies signed caller on unowned bucket", requireOwner: true, caller: "bob-id", wantErr: true},
+		{name: "required owner still allows owner", requireOwner: true, caller: "alice-id", ownerID: "alice-id"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			svc.requireOwner = c.requireOwner
+
+			err := svc.authorizeOwner(callerContext(c.caller), store.BucketRecord{Name: "shared", OwnerID: c.ownerID})
+
+			if !c.wantErr {
+				assertNoError(t, err)
+				return
+			}
+			assertGRPCError(t, err, codes.PermissionDenied, "AccessDenied")
+		})
+	}
+}
+
+func TestIsOwner(t *testing.T) {
+	t.Parallel()
+
+	if isOwner("", store.BucketRecord{}) {
+		t.Errorf("isOwner: anonymous caller owns an unowned bucket")
+	}
+	if !isOwner("alice-id", store.BucketRecord{OwnerID: "alice-id"}) {
+		t.Errorf("isOwner: owner not recognized")
+	}
+	if isOwner("bob-id", store.BucketRecord{OwnerID: "alice-id"}) {
+		t.Errorf("isOwner: stranger recognized as owner")
+	}
+}
+
+func TestValidCannedACL(t *testing.T) {
+	t.Parallel()
+
+	for _, acl := range []string{"", "private", "public-read", "public-read-write", "authenticated-read"} {
+		if !validCannedACL(acl) {
+			t.Errorf("validCannedACL(%q) = false, want true", acl)
+		}
+	}
+	for _, acl := range []string{"PRIVATE", "bucket-owner-full-control", "log-delivery-write", "everyone"} {
+		if validCannedACL(acl) {
+			t.Errorf("validCannedACL(%q) = true, want false", acl)
+		}
+	}
+}
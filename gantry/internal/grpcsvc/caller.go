@@ -0,0 +1,25 @@
+package grpcsvc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// CallerMetadataKey carries the ID of the user flatbed authenticated an S3
+// request as. Gantry trusts it as it trusts the rest of flatbed's requests;
+// it is absent when flatbed runs without authentication.
+const CallerMetadataKey = "x-blockcloset-user-id"
+
+// callerID returns the user the request is made on behalf of, or "" for an
+// anonymous request.
+func callerID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md.Get(CallerMetadataKey); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
@@ -2,11 +2,16 @@ package grpcsvc
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
 	"github.com/ratdaddy/blockcloset/loggrpc"
 	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
 )
@@ -15,6 +20,9 @@ func (s *Service) CommitObject(ctx context.Context, req *servicev1.CommitObjectR
 	sizeActual := req.GetSize()
 	objectID := req.GetObjectId()
 	lastModifiedMs := req.GetLastModifiedMs()
+	etag := req.GetEtag()
+	checksumAlgorithm := req.GetChecksumAlgorithm()
+	checksum := req.GetChecksum()
 
 	if sizeActual <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "InvalidSize")
@@ -28,12 +36,71 @@ func (s *Service) CommitObject(ctx context.Context, req *servicev1.CommitObjectR
 		return nil, status.Error(codes.InvalidArgument, "InvalidLastModifiedMs")
 	}
 
+	if !validETag(etag) {
+		return nil, status.Error(codes.InvalidArgument, "InvalidETag")
+	}
+
+	if !validChecksum(checksumAlgorithm, checksum) {
+		return nil, status.Error(codes.InvalidArgument, "InvalidChecksum")
+	}
+
 	now := time.Now().UTC()
 
 	objects := s.store.Objects()
-	if err := objects.CommitWithReplace(ctx, objectID, sizeActual, lastModifiedMs, now); err != nil {
+	commit := objects.CommitWithReplace
+	if req.GetIfNoneMatch() {
+		commit = objects.CommitIfAbsent
+	}
+
+	versionID, err := commit(ctx, objectID, store.ObjectCommit{
+		SizeActual:        sizeActual,
+		LastModifiedMs:    lastModifiedMs,
+		ETag:              etag,
+		ChecksumAlgorithm: checksumAlgorithm,
+		Checksum:          checksum,
+	}, now)
+	if err != nil {
+		if errors.Is(err, store.ErrObjectExists) {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.FailedPrecondition, "PreconditionFailed"))
+		}
+		if errors.Is(err, store.ErrObjectLocked) {
+			return nil, objectLocked(ctx)
+		}
 		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
 	}
 
-	return &servicev1.CommitObjectResponse{}, nil
+	return &servicev1.CommitObjectResponse{VersionId: versionID}, nil
+}
+
+// validETag reports whether etag is a hex-encoded MD5 as flatbed computes
+// it. An empty etag is accepted from callers that don't send one.
+func validETag(etag string) bool {
+	if etag == "" {
+		return true
+	}
+	digest, err := hex.DecodeString(etag)
+	return err == nil && len(digest) == md5.Size
+}
+
+// checksumSizes maps the x-amz-checksum-* algorithms S3 supports to the size
+// of their digests.
+var checksumSizes = map[string]int{
+	"CRC32":  4,
+	"CRC32C": 4,
+	"SHA1":   20,
+	"SHA256": 32,
+}
+
+// validChecksum reports whether checksum is a base64 digest of the size
+// algorithm produces. Both are empty when the client asked for no checksum.
+func validChecksum(algorithm, checksum string) bool {
+	if algorithm == "" {
+		return checksum == ""
+	}
+	size, ok := checksumSizes[algorithm]
+	if !ok {
+		return false
+	}
+	digest, err := base64.StdEncoding.DecodeString(checksum)
+	return err == nil && len(digest) == size
 }
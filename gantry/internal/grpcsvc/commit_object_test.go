@@ -3,10 +3,12 @@ package grpcsvc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"google.golang.org/grpc/codes"
 
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
 	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
 	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
 )
@@ -21,6 +23,10 @@ func TestService_CommitObject(t *testing.T) {
 		key              string
 		size             int64
 		lastModifiedMs   int64
+		etag             string
+		checksumAlg      string
+		checksum         string
+		ifNoneMatch      bool
 		commitErr        error
 		wantErr          bool
 		wantCode         codes.Code
@@ -36,8 +42,56 @@ func TestService_CommitObject(t *testing.T) {
 			key:              "photos/sunset.jpg",
 			size:             4096,
 			lastModifiedMs:   1735689600000,
+			etag:             "9e107d9d372bb6826bd81d3542a419d6",
 			expectCommitCall: true,
 		},
+		{
+			name:             "checksum is stored with the object",
+			objectID:         "01JEBF2KR8JXZB3Q4V5TW6Y7Z8",
+			bucket:           "my-bucket",
+			key:              "photos/sunset.jpg",
+			size:             4096,
+			lastModifiedMs:   1735689600000,
+			etag:             "9e107d9d372bb6826bd81d3542a419d6",
+			checksumAlg:      "CRC32C",
+			checksum:         "yZRlqg==",
+			expectCommitCall: true,
+		},
+		{
+			name:             "if_none_match commits only if absent",
+			objectID:         "01JEBF2KR8JXZB3Q4V5TW6Y7Z8",
+			bucket:           "my-bucket",
+			key:              "photos/sunset.jpg",
+			size:             4096,
+			lastModifiedMs:   1735689600000,
+			ifNoneMatch:      true,
+			expectCommitCall: true,
+		},
+		{
+			name:           "if_none_match on existing key returns FailedPrecondition",
+			objectID:       "01JEBF2KR8JXZB3Q4V5TW6Y7Z8",
+			bucket:         "my-bucket",
+			key:            "photos/sunset.jpg",
+			size:           4096,
+			lastModifiedMs: 1735689600000,
+			ifNoneMatch:    true,
+			commitErr:      fmt.Errorf("commit object: %w", store.ErrObjectExists),
+			wantErr:        true,
+			wantCode:       codes.FailedPrecondition,
+			wantMessage:    "PreconditionFailed",
+		},
+		{
+			name:           "locked version it would replace returns AccessDenied",
+			objectID:       "01JEBF2KR8JXZB3Q4V5TW6Y7Z8",
+			bucket:         "my-bucket",
+			key:            "photos/sunset.jpg",
+			size:           4096,
+			lastModifiedMs: 1735689600000,
+			commitErr:      fmt.Errorf("commit object: %w", store.ErrObjectLocked),
+			wantErr:        true,
+			wantCode:       codes.PermissionDenied,
+			wantMessage:    "AccessDenied",
+		},
 		{
 			name:           "empty object_id returns InvalidArgument",
 			objectID:       "",
@@ -71,6 +125,56 @@ func TestService_CommitObject(t *testing.T) {
 			wantCode:       codes.InvalidArgument,
 			wantMessage:    "InvalidLastModifiedMs",
 		},
+		{
+			name:           "malformed etag returns InvalidArgument",
+			objectID:       "01JEBF2KR8JXZB3Q4V5TW6Y7Z8",
+			bucket:         "my-bucket",
+			key:            "photos/sunset.jpg",
+			size:           4096,
+			lastModifiedMs: 1735689600000,
+			etag:           "not-an-md5",
+			wantErr:        true,
+			wantCode:       codes.InvalidArgument,
+			wantMessage:    "InvalidETag",
+		},
+		{
+			name:           "unknown checksum algorithm returns InvalidArgument",
+			objectID:       "01JEBF2KR8JXZB3Q4V5TW6Y7Z8",
+			bucket:         "my-bucket",
+			key:            "photos/sunset.jpg",
+			size:           4096,
+			lastModifiedMs: 1735689600000,
+			checksumAlg:    "MD5",
+			checksum:       "yZRlqg==",
+			wantErr:        true,
+			wantCode:       codes.InvalidArgument,
+			wantMessage:    "InvalidChecksum",
+		},
+		{
+			name:           "checksum of the wrong size returns InvalidArgument",
+			objectID:       "01JEBF2KR8JXZB3Q4V5TW6Y7Z8",
+			bucket:         "my-bucket",
+			key:            "photos/sunset.jpg",
+			size:           4096,
+			lastModifiedMs: 1735689600000,
+			checksumAlg:    "SHA256",
+			checksum:       "yZRlqg==",
+			wantErr:        true,
+			wantCode:       codes.InvalidArgument,
+			wantMessage:    "InvalidChecksum",
+		},
+		{
+			name:           "checksum without algorithm returns InvalidArgument",
+			objectID:       "01JEBF2KR8JXZB3Q4V5TW6Y7Z8",
+			bucket:         "my-bucket",
+			key:            "photos/sunset.jpg",
+			size:           4096,
+			lastModifiedMs: 1735689600000,
+			checksum:       "yZRlqg==",
+			wantErr:        true,
+			wantCode:       codes.InvalidArgument,
+			wantMessage:    "InvalidChecksum",
+		},
 		{
 			name:           "commit store error returns Internal",
 			objectID:       "01JEBF2KR8JXZB3Q4V5TW6Y7Z8",
@@ -90,7 +194,7 @@ func TestService_CommitObject(t *testing.T) {
 			t.Parallel()
 
 			logger := newDiscardLogger()
-			svc := New(logger, nil)
+			svc := New(logger, nil, nil)
 
 			objects := testutil.NewFakeObjectStore()
 			if c.commitErr != nil {
@@ -102,9 +206,13 @@ func TestService_CommitObject(t *testing.T) {
 			)
 
 			resp, err := svc.CommitObject(context.Background(), &servicev1.CommitObjectRequest{
-				ObjectId:       c.objectID,
-				Size:           c.size,
-				LastModifiedMs: c.lastModifiedMs,
+				ObjectId:          c.objectID,
+				Size:              c.size,
+				LastModifiedMs:    c.lastModifiedMs,
+				IfNoneMatch:       c.ifNoneMatch,
+				Etag:              c.etag,
+				ChecksumAlgorithm: c.checksumAlg,
+				Checksum:          c.checksum,
 			})
 
 			if c.wantErr {
@@ -133,10 +241,40 @@ func TestService_CommitObject(t *testing.T) {
 				if call.LastModifiedMs != c.lastModifiedMs {
 					t.Fatalf("CommitWithReplace last_modified_ms: got %d, want %d", call.LastModifiedMs, c.lastModifiedMs)
 				}
+				if call.ETag != c.etag {
+					t.Fatalf("CommitWithReplace etag: got %q, want %q", call.ETag, c.etag)
+				}
+				if call.ChecksumAlgorithm != c.checksumAlg || call.Checksum != c.checksum {
+					t.Fatalf("CommitWithReplace checksum: got %s %q, want %s %q", call.ChecksumAlgorithm, call.Checksum, c.checksumAlg, c.checksum)
+				}
 				if call.UpdatedAt.IsZero() {
 					t.Fatal("CommitWithReplace updatedAt is zero")
 				}
+				if call.IfAbsent != c.ifNoneMatch {
+					t.Fatalf("commit IfAbsent: got %v, want %v", call.IfAbsent, c.ifNoneMatch)
+				}
 			}
 		})
 	}
 }
+
+func TestService_CommitObject_VersionID(t *testing.T) {
+	t.Parallel()
+
+	svc := New(newDiscardLogger(), nil, nil)
+
+	objects := testutil.NewFakeObjectStore()
+	objects.SetCommitVersionID("01JEBF2KR8JXZB3Q4V5TW6Y7Z8")
+	svc.store = testutil.NewFakeStore(testutil.WithObjects(objects))
+
+	resp, err := svc.CommitObject(context.Background(), &servicev1.CommitObjectRequest{
+		ObjectId:       "01JEBF2KR8JXZB3Q4V5TW6Y7Z8",
+		Size:           4096,
+		LastModifiedMs: 1735689600000,
+	})
+	assertNoError(t, err)
+
+	if resp.GetVersionId() != "01JEBF2KR8JXZB3Q4V5TW6Y7Z8" {
+		t.Fatalf("version_id: got %q, want %q", resp.GetVersionId(), "01JEBF2KR8JXZB3Q4V5TW6Y7Z8")
+	}
+}
@@ -0,0 +1,50 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) CommitPart(ctx context.Context, req *servicev1.CommitPartRequest) (*servicev1.CommitPartResponse, error) {
+	sizeActual := req.GetSize()
+	blobID := req.GetBlobId()
+	lastModifiedMs := req.GetLastModifiedMs()
+	etag := req.GetEtag()
+
+	if sizeActual <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "InvalidSize")
+	}
+
+	if len(blobID) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBlobID")
+	}
+
+	if lastModifiedMs <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "InvalidLastModifiedMs")
+	}
+
+	if !validETag(etag) {
+		return nil, status.Error(codes.InvalidArgument, "InvalidETag")
+	}
+
+	err := s.store.Multipart().CommitPart(ctx, blobID, sizeActual, lastModifiedMs, etag, time.Now().UTC())
+	if errors.Is(err, store.ErrUploadNotInProgress) {
+		return nil, loggrpc.SetError(ctx, errNoSuchUpload)
+	}
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	if etag == "" {
+		etag = blobID
+	}
+	return &servicev1.CommitPartResponse{Etag: etag}, nil
+}
@@ -0,0 +1,143 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_CommitPart(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name           string
+		blobID         string
+		size           int64
+		lastModifiedMs int64
+		etag           string
+		commitErr      error
+		wantErr        bool
+		wantCode       codes.Code
+		wantMessage    string
+		wantETag       string
+	}
+
+	cases := []tc{
+		{
+			name:           "commits part and returns its ETag",
+			blobID:         "blob-id-1",
+			size:           1024,
+			lastModifiedMs: 1735689600000,
+			etag:           "e4d909c290d0fb1ca068ffaddf22cbd0",
+			wantETag:       "e4d909c290d0fb1ca068ffaddf22cbd0",
+		},
+		{
+			name:           "part without a digest uses its blob ID as ETag",
+			blobID:         "blob-id-1",
+			size:           1024,
+			lastModifiedMs: 1735689600000,
+			wantETag:       "blob-id-1",
+		},
+		{
+			name:           "malformed etag returns InvalidETag",
+			blobID:         "blob-id-1",
+			size:           1024,
+			lastModifiedMs: 1735689600000,
+			etag:           "e4d909c2",
+			wantErr:        true,
+			wantCode:       codes.InvalidArgument,
+			wantMessage:    "InvalidETag",
+		},
+		{
+			name:           "finished upload returns NoSuchUpload",
+			blobID:         "blob-id-1",
+			size:           1024,
+			lastModifiedMs: 1735689600000,
+			commitErr:      fmt.Errorf("commit part: %w", store.ErrUploadNotInProgress),
+			wantErr:        true,
+			wantCode:       codes.NotFound,
+			wantMessage:    "NoSuchUpload",
+		},
+		{
+			name:           "store error returns Internal",
+			blobID:         "blob-id-1",
+			size:           1024,
+			lastModifiedMs: 1735689600000,
+			commitErr:      errors.New("commit part: disk I/O error"),
+			wantErr:        true,
+			wantCode:       codes.Internal,
+			wantMessage:    "commit part: disk I/O error",
+		},
+		{
+			name:           "zero size returns InvalidSize",
+			blobID:         "blob-id-1",
+			lastModifiedMs: 1735689600000,
+			wantErr:        true,
+			wantCode:       codes.InvalidArgument,
+			wantMessage:    "InvalidSize",
+		},
+		{
+			name:           "empty blob id returns InvalidBlobID",
+			size:           1024,
+			lastModifiedMs: 1735689600000,
+			wantErr:        true,
+			wantCode:       codes.InvalidArgument,
+			wantMessage:    "InvalidBlobID",
+		},
+		{
+			name:        "missing last modified returns InvalidLastModifiedMs",
+			blobID:      "blob-id-1",
+			size:        1024,
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidLastModifiedMs",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			uploads := testutil.NewFakeMultipartStore()
+			if c.commitErr != nil {
+				uploads.SetCommitPartError(c.commitErr)
+			}
+			svc.store = testutil.NewFakeStore(testutil.WithMultipart(uploads))
+
+			resp, err := svc.CommitPart(context.Background(), &servicev1.CommitPartRequest{
+				BlobId:         c.blobID,
+				Size:           c.size,
+				LastModifiedMs: c.lastModifiedMs,
+				Etag:           c.etag,
+			})
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+
+			assertNoError(t, err)
+
+			if resp.GetEtag() != c.wantETag {
+				t.Fatalf("etag: got %q, want %q", resp.GetEtag(), c.wantETag)
+			}
+
+			calls := uploads.CommitPartCalls()
+			if len(calls) != 1 {
+				t.Fatalf("CommitPart calls: got %d, want 1", len(calls))
+			}
+			if calls[0].BlobID != c.blobID || calls[0].SizeActual != c.size || calls[0].LastModifiedMs != c.lastModifiedMs || calls[0].ETag != c.etag {
+				t.Fatalf("CommitPart call: got %+v", calls[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) CompleteMultipartUpload(ctx context.Context, req *servicev1.CompleteMultipartUploadRequest) (*servicev1.CompleteMultipartUploadResponse, error) {
+	bucketName := req.GetBucket()
+	key := req.GetKey()
+	requested := req.GetParts()
+
+	bucketValidator := validation.DefaultBucketNameValidator{}
+	keyValidator := validation.DefaultKeyValidator{}
+
+	if err := bucketValidator.ValidateBucketName(bucketName); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	if err := keyValidator.ValidateKey(key); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidKeyName")
+	}
+
+	if len(requested) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "MalformedXML")
+	}
+
+	if err := checkTestBucket(bucketName); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	upload, err := s.openUpload(ctx, bucketName, key, req.GetUploadId(), policy.ActionPutObject)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	uploaded, err := s.store.Multipart().ListUploadedParts(ctx, upload.ID)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	byNumber := make(map[int32]store.PartRecord, len(uploaded))
+	for _, p := range uploaded {
+		byNumber[p.PartNumber] = p
+	}
+
+	completed := store.CompletedUpload{
+		BlobIDs:        make([]string, 0, len(requested)),
+		LastModifiedMs: time.Now().UnixMilli(),
+	}
+	partETags := make([]string, 0, len(requested))
+
+	var previous int32
+	for i, want := range requested {
+		if want.GetPartNumber() <= previous {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "InvalidPartOrder"))
+		}
+		previous = want.GetPartNumber()
+
+		part, ok := byNumber[want.GetPartNumber()]
+		if !ok || strings.Trim(want.GetEtag(), `"`) != part.ETag {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "InvalidPart"))
+		}
+
+		if i < len(requested)-1 && part.SizeActual < minPartBytes {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "EntityTooSmall"))
+		}
+
+		if i == 0 {
+			completed.CradleServerID = part.CradleServerID
+		}
+		completed.BlobIDs = append(completed.BlobIDs, part.BlobID)
+		completed.Size += part.SizeActual
+		partETags = append(partETags, part.ETag)
+	}
+
+	if completed.Size > maxMultipartBytes {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "EntityTooLarge"))
+	}
+
+	completed.ETag = multipartETag(partETags)
+
+	versionID, err := s.store.Multipart().Complete(ctx, upload.ID, completed, time.Now().UTC())
+	switch {
+	case errors.Is(err, store.ErrUploadNotFound), errors.Is(err, store.ErrUploadNotInProgress):
+		return nil, loggrpc.SetError(ctx, errNoSuchUpload)
+	case errors.Is(err, store.ErrPartNotUploaded):
+		return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "InvalidPart"))
+	case errors.Is(err, store.ErrObjectLocked):
+		return nil, objectLocked(ctx)
+	case err != nil:
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	loggrpc.SetAttrs(ctx,
+		slog.String("result", fmt.Sprintf("upload %s completed as %s/%s (%d parts, %d bytes)",
+			upload.ID, bucketName, key, len(completed.BlobIDs), completed.Size)))
+
+	return &servicev1.CompleteMultipartUploadResponse{Etag: completed.ETag, VersionId: versionID}, nil
+}
@@ -0,0 +1,251 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_CompleteMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	const mib = 1024 * 1024
+
+	uploaded := []store.PartRecord{
+		{BlobID: "blob-id-1", ETag: "etag-1", PartNumber: 1, SizeActual: 5 * mib, CradleServerID: "cradle-id-a"},
+		{BlobID: "blob-id-2", ETag: "etag-2", PartNumber: 2, SizeActual: 5 * mib, CradleServerID: "cradle-id-b"},
+		{BlobID: "blob-id-3", ETag: "etag-3", PartNumber: 3, SizeActual: 1024, CradleServerID: "cradle-id-a"},
+	}
+
+	type tc struct {
+		name        string
+		uploadID    string
+		parts       []*servicev1.CompletedPart
+		uploaded    []store.PartRecord
+		completeErr error
+		wantErr     bool
+		wantCode    codes.Code
+		wantMessage string
+		wantBlobIDs []string
+		wantETags   []string
+		wantSize    int64
+	}
+
+	cases := []tc{
+		{
+			name:     "completes upload from listed parts",
+			uploadID: "upload-id-1",
+			parts: []*servicev1.CompletedPart{
+				{PartNumber: 1, Etag: `"etag-1"`},
+				{PartNumber: 3, Etag: "etag-3"},
+			},
+			uploaded:    uploaded,
+			wantBlobIDs: []string{"blob-id-1", "blob-id-3"},
+			wantETags:   []string{"etag-1", "etag-3"},
+			wantSize:    5*mib + 1024,
+		},
+		{
+			name:        "no parts returns MalformedXML",
+			uploadID:    "upload-id-1",
+			uploaded:    uploaded,
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "MalformedXML",
+		},
+		{
+			name:     "descending part numbers return InvalidPartOrder",
+			uploadID: "upload-id-1",
+			parts: []*servicev1.CompletedPart{
+				{PartNumber: 2, Etag: "etag-2"},
+				{PartNumber: 1, Etag: "etag-1"},
+			},
+			uploaded:    uploaded,
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidPartOrder",
+		},
+		{
+			name:     "unknown part returns InvalidPart",
+			uploadID: "upload-id-1",
+			parts: []*servicev1.CompletedPart{
+				{PartNumber: 4, Etag: "etag-4"},
+			},
+			uploaded:    uploaded,
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidPart",
+		},
+		{
+			name:     "ETag mismatch returns InvalidPart",
+			uploadID: "upload-id-1",
+			parts: []*servicev1.CompletedPart{
+				{PartNumber: 1, Etag: "etag-stale"},
+			},
+			uploaded:    uploaded,
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidPart",
+		},
+		{
+			name:     "small part before the last returns EntityTooSmall",
+			uploadID: "upload-id-1",
+			parts: []*servicev1.CompletedPart{
+				{PartNumber: 3, Etag: "etag-3"},
+				{PartNumber: 4, Etag: "etag-4"},
+			},
+			uploaded: append(slices.Clone(uploaded),
+				store.PartRecord{BlobID: "blob-id-4", ETag: "etag-4", PartNumber: 4, SizeActual: 5 * mib, CradleServerID: "cradle-id-a"}),
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "EntityTooSmall",
+		},
+		{
+			name:     "unknown upload returns NoSuchUpload",
+			uploadID: "upload-id-missing",
+			parts: []*servicev1.CompletedPart{
+				{PartNumber: 1, Etag: "etag-1"},
+			},
+			uploaded:    uploaded,
+			wantErr:     true,
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchUpload",
+		},
+		{
+			name:     "part replaced during completion returns InvalidPart",
+			uploadID: "upload-id-1",
+			parts: []*servicev1.CompletedPart{
+				{PartNumber: 1, Etag: "etag-1"},
+			},
+			uploaded:    uploaded,
+			completeErr: fmt.Errorf("complete upload: %w", store.ErrPartNotUploaded),
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidPart",
+		},
+		{
+			name:     "upload finished during completion returns NoSuchUpload",
+			uploadID: "upload-id-1",
+			parts: []*servicev1.CompletedPart{
+				{PartNumber: 1, Etag: "etag-1"},
+			},
+			uploaded:    uploaded,
+			completeErr: fmt.Errorf("complete upload: %w", store.ErrUploadNotInProgress),
+			wantErr:     true,
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchUpload",
+		},
+		{
+			name:     "locked version it would replace returns AccessDenied",
+			uploadID: "upload-id-1",
+			parts: []*servicev1.CompletedPart{
+				{PartNumber: 1, Etag: "etag-1"},
+			},
+			uploaded:    uploaded,
+			completeErr: fmt.Errorf("complete upload: %w", store.ErrObjectLocked),
+			wantErr:     true,
+			wantCode:    codes.PermissionDenied,
+			wantMessage: "AccessDenied",
+		},
+		{
+			name:     "store error returns Internal",
+			uploadID: "upload-id-1",
+			parts: []*servicev1.CompletedPart{
+				{PartNumber: 1, Etag: "etag-1"},
+			},
+			uploaded:    uploaded,
+			completeErr: errors.New("complete upload: disk I/O error"),
+			wantErr:     true,
+			wantCode:    codes.Internal,
+			wantMessage: "complete upload: disk I/O error",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			buckets, uploads := newUploadFakes()
+			uploads.SetParts("upload-id-1", c.uploaded)
+			if c.completeErr != nil {
+				uploads.SetCompleteError(c.completeErr)
+			}
+
+			svc.store = testutil.NewFakeStore(
+				testutil.WithBuckets(buckets),
+				testutil.WithMultipart(uploads),
+			)
+
+			resp, err := svc.CompleteMultipartUpload(context.Background(), &servicev1.CompleteMultipartUploadRequest{
+				Bucket:   "my-bucket",
+				Key:      "videos/big.mp4",
+				UploadId: c.uploadID,
+				Parts:    c.parts,
+			})
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+
+			assertNoError(t, err)
+
+			wantETag := multipartETag(c.wantETags)
+			if resp.GetEtag() != wantETag {
+				t.Fatalf("etag: got %q, want %q", resp.GetEtag(), wantETag)
+			}
+
+			calls := uploads.CompleteCalls()
+			if len(calls) != 1 {
+				t.Fatalf("Complete calls: got %d, want 1", len(calls))
+			}
+			got := calls[0]
+			if got.UploadID != "upload-id-1" {
+				t.Fatalf("Complete upload id: got %q, want %q", got.UploadID, "upload-id-1")
+			}
+			if !slices.Equal(got.Object.BlobIDs, c.wantBlobIDs) {
+				t.Fatalf("Complete blob ids: got %v, want %v", got.Object.BlobIDs, c.wantBlobIDs)
+			}
+			if got.Object.Size != c.wantSize {
+				t.Fatalf("Complete size: got %d, want %d", got.Object.Size, c.wantSize)
+			}
+			if got.Object.ETag != wantETag {
+				t.Fatalf("Complete etag: got %q, want %q", got.Object.ETag, wantETag)
+			}
+			if got.Object.CradleServerID != "cradle-id-a" {
+				t.Fatalf("Complete cradle: got %q, want %q", got.Object.CradleServerID, "cradle-id-a")
+			}
+		})
+	}
+}
+
+func TestMultipartETag(t *testing.T) {
+	t.Parallel()
+
+	// md5("blob-id-1" + "blob-id-2") followed by the part count.
+	const want = "816d195b2f5dfc10ee04d6966a3b0766-2"
+
+	if got := multipartETag([]string{"blob-id-1", "blob-id-2"}); got != want {
+		t.Fatalf("etag: got %q, want %q", got, want)
+	}
+	if got := multipartETag([]string{"blob-id-2", "blob-id-1"}); got == want {
+		t.Fatalf("etag should depend on part order, got %q for reversed parts", got)
+	}
+
+	// MD5 part ETags are hashed as binary digests, matching S3:
+	// md5(md5("a") + md5("b")) followed by the part count.
+	const wantDigest = "96e024ba2074fe77e8e965ba43a704be-2"
+	parts := []string{"0cc175b9c0f1b6a831c399e269772661", "92eb5ffee6ae2fec3ad71c777531578f"}
+	if got := multipartETag(parts); got != wantDigest {
+		t.Fatalf("etag of md5 parts: got %q, want %q", got, wantDigest)
+	}
+}
@@ -0,0 +1,47 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// CreateAccessKey issues a new key for a user. The secret is returned once
+// and never logged.
+func (s *Service) CreateAccessKey(ctx context.Context, req *servicev1.CreateAccessKeyRequest) (*servicev1.CreateAccessKeyResponse, error) {
+	userName := req.GetUserName()
+	if !userNamePattern.MatchString(userName) {
+		return nil, status.Error(codes.InvalidArgument, "ValidationError")
+	}
+
+	user, err := s.store.Users().GetByName(ctx, userName)
+	if err != nil {
+		if errors.Is(err, store.ErrUserNotFound) {
+			return nil, loggrpc.SetError(ctx, errNoSuchEntity)
+		}
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	accessKeyID, secret := newAccessKey()
+	rec, err := s.store.AccessKeys().Create(ctx, store.NewID(), user.ID, accessKeyID, secret, time.Now().UTC())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+	rec.UserName = user.Name
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("access key <%s> created for user <%s>", accessKeyID, userName)))
+
+	return &servicev1.CreateAccessKeyResponse{
+		AccessKey:       accessKeyToProto(rec),
+		SecretAccessKey: secret,
+	}, nil
+}
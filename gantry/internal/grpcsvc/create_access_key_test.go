@@ -0,0 +1,120 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_CreateAccessKey(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		userName    string
+		getUserErr  error
+		createErr   error
+		wantCode    codes.Code
+		wantMessage string
+		wantCreate  bool
+	}
+
+	cases := []tc{
+		{
+			name:       "creates key for user",
+			userName:   "alice",
+			wantCreate: true,
+		},
+		{
+			name:        "invalid user name",
+			userName:    "not a name",
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "ValidationError",
+		},
+		{
+			name:        "unknown user returns NotFound",
+			userName:    "nobody",
+			getUserErr:  store.ErrUserNotFound,
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchEntity",
+		},
+		{
+			name:        "user lookup failure returns Internal",
+			userName:    "alice",
+			getUserErr:  errors.New("disk I/O error"),
+			wantCode:    codes.Internal,
+			wantMessage: "disk I/O error",
+		},
+		{
+			name:        "store failure returns Internal",
+			userName:    "alice",
+			createErr:   errors.New("insert failed"),
+			wantCode:    codes.Internal,
+			wantMessage: "insert failed",
+			wantCreate:  true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			users := testutil.NewFakeUserStore()
+			users.SetGetByNameResponse(store.UserRecord{ID: "user-alice", Name: "alice"})
+			users.SetGetByNameError(c.getUserErr)
+			keys := testutil.NewFakeAccessKeyStore()
+			keys.SetCreateError(c.createErr)
+			svc.store = testutil.NewFakeStore(testutil.WithUsers(users), testutil.WithAccessKeys(keys))
+
+			resp, err := svc.CreateAccessKey(context.Background(), &servicev1.CreateAccessKeyRequest{UserName: c.userName})
+
+			calls := keys.CreateCalls()
+			if c.wantCreate != (len(calls) == 1) {
+				t.Fatalf("Create calls: got %+v, want called=%v", calls, c.wantCreate)
+			}
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+
+			call := calls[0]
+			if call.UserID != "user-alice" {
+				t.Fatalf("Create user: got %q, want user-alice", call.UserID)
+			}
+			if !strings.HasPrefix(call.AccessKeyID, accessKeyIDPrefix) || len(call.AccessKeyID) != 20 {
+				t.Fatalf("access key id: got %q, want 20 characters starting %s", call.AccessKeyID, accessKeyIDPrefix)
+			}
+			if len(call.SecretAccessKey) != 40 {
+				t.Fatalf("secret: got %d characters, want 40", len(call.SecretAccessKey))
+			}
+
+			key := resp.GetAccessKey()
+			if key.GetAccessKeyId() != call.AccessKeyID || key.GetUserName() != "alice" || key.GetStatus() != servicev1.AccessKeyStatus_ACCESS_KEY_STATUS_ACTIVE {
+				t.Fatalf("access key: got %+v", key)
+			}
+			if resp.GetSecretAccessKey() != call.SecretAccessKey {
+				t.Fatalf("secret: got %q, want %q", resp.GetSecretAccessKey(), call.SecretAccessKey)
+			}
+		})
+	}
+}
+
+func TestNewAccessKey_Unique(t *testing.T) {
+	t.Parallel()
+
+	id1, secret1 := newAccessKey()
+	id2, secret2 := newAccessKey()
+	if id1 == id2 || secret1 == secret2 {
+		t.Fatalf("newAccessKey repeated: %s/%s and %s/%s", id1, secret1, id2, secret2)
+	}
+}
@@ -26,18 +26,41 @@ func (s *Service) CreateBucket(ctx context.Context, req *servicev1.CreateBucketR
 		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
 	}
 
+	acl := req.GetAcl()
+	if !validCannedACL(acl) {
+		return nil, status.Error(codes.InvalidArgument, "InvalidArgument")
+	}
+
 	if err := checkTestBucket(name); err != nil {
 		return nil, loggrpc.SetError(ctx, err)
 	}
 
+	// A bucket created anonymously has no owner, which leaves it unusable
+	// when the service requires one.
+	owner := callerID(ctx)
+	if owner == "" && s.requireOwner {
+		return nil, loggrpc.SetError(ctx, errAccessDenied)
+	}
+
 	bucketID := store.NewID()
 	now := time.Now().UTC()
 	buckets := s.store.Buckets()
 
-	if _, err := buckets.Create(ctx, bucketID, name, now); err != nil {
+	var lock *store.ObjectLockConfig
+	result := fmt.Sprintf("bucket <%s> created", name)
+	if req.GetObjectLockEnabled() {
+		lock = &store.ObjectLockConfig{}
+		result = fmt.Sprintf("bucket <%s> created with object lock", name)
+	}
+
+	if _, err := buckets.Create(ctx, bucketID, name, owner, acl, lock, now); err != nil {
 		if errors.Is(err, store.ErrBucketAlreadyExists) {
+			reason := servicev1.BucketOwnershipConflict_REASON_BUCKET_ALREADY_EXISTS
+			if existing, getErr := buckets.GetByName(ctx, name); getErr == nil && isOwner(owner, existing) {
+				reason = servicev1.BucketOwnershipConflict_REASON_BUCKET_ALREADY_OWNED_BY_YOU
+			}
 			conflict := &servicev1.BucketOwnershipConflict{
-				Reason: servicev1.BucketOwnershipConflict_REASON_BUCKET_ALREADY_OWNED_BY_YOU,
+				Reason: reason,
 				Bucket: name,
 			}
 			st := status.New(codes.AlreadyExists, err.Error())
@@ -50,7 +73,7 @@ func (s *Service) CreateBucket(ctx context.Context, req *servicev1.CreateBucketR
 		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
 	}
 
-	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> created", name)))
+	loggrpc.SetAttrs(ctx, slog.String("result", result))
 
 	return &servicev1.CreateBucketResponse{
 		Bucket: &bucketv1.Bucket{
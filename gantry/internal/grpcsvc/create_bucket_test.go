@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/oklog/ulid/v2"
@@ -20,6 +21,11 @@ func TestService_CreateBucket(t *testing.T) {
 	type tc struct {
 		name             string
 		bucket           string
+		caller           string
+		requireOwner     bool
+		acl              string
+		objectLock       bool
+		existingOwner    string
 		wantErr          bool
 		wantResponse     bool
 		code             codes.Code
@@ -37,6 +43,28 @@ func TestService_CreateBucket(t *testing.T) {
 			wantResponse:    true,
 			expectStoreCall: true,
 		},
+		{
+			name:            "canned acl is stored",
+			bucket:          "public-bucket",
+			acl:             "public-read",
+			wantResponse:    true,
+			expectStoreCall: true,
+		},
+		{
+			name:            "object lock is enabled on creation",
+			bucket:          "offsite-backups",
+			objectLock:      true,
+			wantResponse:    true,
+			expectStoreCall: true,
+		},
+		{
+			name:    "unknown canned acl",
+			bucket:  "my-bucket-123",
+			acl:     "everyone",
+			wantErr: true,
+			code:    codes.InvalidArgument,
+			message: "InvalidArgument",
+		},
 		{
 			name:         "invalid bucket",
 			bucket:       "Bad!Name",
@@ -45,6 +73,29 @@ func TestService_CreateBucket(t *testing.T) {
 			message:      "InvalidBucketName",
 			wantResponse: false,
 		},
+		{
+			name:            "bucket is owned by the caller",
+			bucket:          "owned-bucket",
+			caller:          "user-alice",
+			wantResponse:    true,
+			expectStoreCall: true,
+		},
+		{
+			name:            "required owner lets a signed caller create",
+			bucket:          "owned-bucket",
+			caller:          "user-alice",
+			requireOwner:    true,
+			wantResponse:    true,
+			expectStoreCall: true,
+		},
+		{
+			name:         "required owner refuses an anonymous caller",
+			bucket:       "anonymous-bucket",
+			requireOwner: true,
+			wantErr:      true,
+			code:         codes.PermissionDenied,
+			message:      "AccessDenied",
+		},
 		{
 			name:            "bucket store error surfaces as internal",
 			bucket:          "store-error-bucket",
@@ -55,7 +106,7 @@ func TestService_CreateBucket(t *testing.T) {
 			expectStoreCall: true,
 		},
 		{
-			name:             "bucket already exists surfaces as already exists",
+			name:             "anonymous caller never owns an existing bucket",
 			bucket:           "duplicate-bucket",
 			wantErr:          true,
 			code:             codes.AlreadyExists,
@@ -63,8 +114,34 @@ func TestService_CreateBucket(t *testing.T) {
 			storeErr:         store.ErrBucketAlreadyExists,
 			expectStoreCall:  true,
 			wantConflictInfo: true,
+			conflictReason:   servicev1.BucketOwnershipConflict_REASON_BUCKET_ALREADY_EXISTS,
+		},
+		{
+			name:             "caller's own bucket surfaces as owned by you",
+			bucket:           "duplicate-bucket",
+			caller:           "user-alice",
+			existingOwner:    "user-alice",
+			wantErr:          true,
+			code:             codes.AlreadyExists,
+			message:          store.ErrBucketAlreadyExists.Error(),
+			storeErr:         store.ErrBucketAlreadyExists,
+			expectStoreCall:  true,
+			wantConflictInfo: true,
 			conflictReason:   servicev1.BucketOwnershipConflict_REASON_BUCKET_ALREADY_OWNED_BY_YOU,
 		},
+		{
+			name:             "another user's bucket surfaces as already exists",
+			bucket:           "duplicate-bucket",
+			caller:           "user-alice",
+			existingOwner:    "user-bob",
+			wantErr:          true,
+			code:             codes.AlreadyExists,
+			message:          store.ErrBucketAlreadyExists.Error(),
+			storeErr:         store.ErrBucketAlreadyExists,
+			expectStoreCall:  true,
+			wantConflictInfo: true,
+			conflictReason:   servicev1.BucketOwnershipConflict_REASON_BUCKET_ALREADY_EXISTS,
+		},
 	}
 
 	for _, c := range cases {
@@ -72,14 +149,21 @@ func TestService_CreateBucket(t *testing.T) {
 			t.Parallel()
 
 			logger := newDiscardLogger()
-			svc := New(logger, nil)
+			svc := New(logger, nil, nil)
+			svc.requireOwner = c.requireOwner
 			buckets := testutil.NewFakeBucketStore()
 			if c.storeErr != nil {
 				buckets.SetCreateError(c.storeErr)
 			}
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-existing", Name: c.bucket, OwnerID: c.existingOwner})
 			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
 
-			resp, err := svc.CreateBucket(context.Background(), &servicev1.CreateBucketRequest{Name: c.bucket})
+			ctx := context.Background()
+			if c.caller != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(CallerMetadataKey, c.caller))
+			}
+
+			resp, err := svc.CreateBucket(ctx, &servicev1.CreateBucketRequest{Name: c.bucket, Acl: c.acl, ObjectLockEnabled: c.objectLock})
 
 			if c.wantErr {
 				assertGRPCError(t, err, c.code, c.message)
@@ -98,9 +182,28 @@ func TestService_CreateBucket(t *testing.T) {
 
 			if c.expectStoreCall {
 				assertStoreCreateCalled(t, buckets, c.bucket)
+				if owner := buckets.CreateCalls()[0].OwnerID; owner != c.caller {
+					t.Fatalf("bucket store owner: got %q, want %q", owner, c.caller)
+				}
+				if acl := buckets.CreateCalls()[0].ACL; acl != c.acl {
+					t.Fatalf("bucket store acl: got %q, want %q", acl, c.acl)
+				}
 			} else {
 				assertStoreNotCalled(t, buckets)
 			}
+
+			if c.expectStoreCall {
+				lock := buckets.CreateCalls()[0].Lock
+				if c.objectLock && (lock == nil || *lock != (store.ObjectLockConfig{})) {
+					t.Fatalf("bucket store lock: got %+v, want object lock without default retention", lock)
+				}
+				if !c.objectLock && lock != nil {
+					t.Fatalf("bucket store lock: got %+v, want none", lock)
+				}
+			}
+			if locks := buckets.SetObjectLockCalls(); len(locks) != 0 {
+				t.Fatalf("SetObjectLock calls: got %+v, want none", locks)
+			}
 		})
 	}
 }
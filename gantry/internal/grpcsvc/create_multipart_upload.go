@@ -0,0 +1,61 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) CreateMultipartUpload(ctx context.Context, req *servicev1.CreateMultipartUploadRequest) (*servicev1.CreateMultipartUploadResponse, error) {
+	bucketName := req.GetBucket()
+	key := req.GetKey()
+
+	bucketValidator := validation.DefaultBucketNameValidator{}
+	keyValidator := validation.DefaultKeyValidator{}
+
+	if err := bucketValidator.ValidateBucketName(bucketName); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	if err := keyValidator.ValidateKey(key); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidKeyName")
+	}
+
+	metadata, err := metadataRows(req.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkTestBucket(bucketName); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	bucket, err := s.store.Buckets().GetByName(ctx, bucketName)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, objectLookupError(servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, bucketName, key, err))
+	}
+
+	if err := s.authorize(ctx, bucket, policy.ActionPutObject, key); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	upload, err := s.store.Multipart().Create(ctx, store.NewID(), bucket.ID, key, req.GetContentType(), metadata, time.Now().UTC())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	loggrpc.SetAttrs(ctx,
+		slog.String("result", fmt.Sprintf("upload %s started for %s/%s", upload.ID, bucketName, key)))
+
+	return &servicev1.CreateMultipartUploadResponse{UploadId: upload.ID}, nil
+}
@@ -0,0 +1,160 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	objectv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/object/v1"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_CreateMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name            string
+		bucket          string
+		key             string
+		metadata        *objectv1.ObjectMetadata
+		wantMetadata    map[string]string
+		getByNameErr    error
+		createErr       error
+		wantErr         bool
+		wantCode        codes.Code
+		wantMessage     string
+		wantErrorDetail bool
+	}
+
+	cases := []tc{
+		{
+			name:   "creates upload",
+			bucket: "my-bucket",
+			key:    "videos/big.mp4",
+		},
+		{
+			name:   "stores metadata with the upload",
+			bucket: "my-bucket",
+			key:    "videos/big.mp4",
+			metadata: &objectv1.ObjectMetadata{
+				CacheControl:       "max-age=60",
+				ContentDisposition: `attachment; filename="big.mp4"`,
+				UserMetadata:       map[string]string{"Camera": "front"},
+			},
+			wantMetadata: map[string]string{
+				"cache-control":       "max-age=60",
+				"content-disposition": `attachment; filename="big.mp4"`,
+				"x-amz-meta-camera":   "front",
+			},
+		},
+		{
+			name:        "oversized user metadata returns InvalidArgument",
+			bucket:      "my-bucket",
+			key:         "videos/big.mp4",
+			metadata:    &objectv1.ObjectMetadata{UserMetadata: map[string]string{"note": strings.Repeat("x", maxUserMetadataBytes)}},
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "MetadataTooLarge",
+		},
+		{
+			name:            "bucket not found returns NotFound",
+			bucket:          "nonexistent-bucket",
+			key:             "videos/big.mp4",
+			getByNameErr:    store.ErrBucketNotFound,
+			wantErr:         true,
+			wantCode:        codes.NotFound,
+			wantMessage:     "bucket not found",
+			wantErrorDetail: true,
+		},
+		{
+			name:        "store error returns Internal",
+			bucket:      "my-bucket",
+			key:         "videos/big.mp4",
+			createErr:   errors.New("insert multipart upload: disk I/O error"),
+			wantErr:     true,
+			wantCode:    codes.Internal,
+			wantMessage: "insert multipart upload: disk I/O error",
+		},
+		{
+			name:        "invalid bucket name returns InvalidArgument",
+			bucket:      "Bad!Name",
+			key:         "videos/big.mp4",
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidBucketName",
+		},
+		{
+			name:        "invalid key returns InvalidArgument",
+			bucket:      "my-bucket",
+			key:         "",
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidKeyName",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			buckets, uploads := newUploadFakes()
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+			if c.createErr != nil {
+				uploads.SetCreateError(c.createErr)
+			}
+
+			svc.store = testutil.NewFakeStore(
+				testutil.WithBuckets(buckets),
+				testutil.WithMultipart(uploads),
+			)
+
+			resp, err := svc.CreateMultipartUpload(context.Background(), &servicev1.CreateMultipartUploadRequest{
+				Bucket:      c.bucket,
+				Key:         c.key,
+				ContentType: "video/mp4",
+				Metadata:    c.metadata,
+			})
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				if c.wantErrorDetail {
+					assertObjectLookupErrorDetail(t, err, servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, c.bucket, c.key)
+				}
+				return
+			}
+
+			assertNoError(t, err)
+
+			if _, err := ulid.Parse(resp.GetUploadId()); err != nil {
+				t.Fatalf("upload_id %q is not a ULID: %v", resp.GetUploadId(), err)
+			}
+
+			calls := uploads.CreateCalls()
+			if len(calls) != 1 {
+				t.Fatalf("Create calls: got %d, want 1", len(calls))
+			}
+			got := calls[0]
+			if got.ID != resp.GetUploadId() || got.BucketID != "bucket-id-123" || got.Key != c.key || got.ContentType != "video/mp4" {
+				t.Fatalf("Create call: got %+v", got)
+			}
+			if len(got.Metadata) != len(c.wantMetadata) {
+				t.Fatalf("Create metadata: got %v, want %v", got.Metadata, c.wantMetadata)
+			}
+			for name, want := range c.wantMetadata {
+				if got.Metadata[name] != want {
+					t.Fatalf("Create metadata %s: got %q, want %q", name, got.Metadata[name], want)
+				}
+			}
+		})
+	}
+}
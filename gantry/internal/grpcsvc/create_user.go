@@ -0,0 +1,35 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) CreateUser(ctx context.Context, req *servicev1.CreateUserRequest) (*servicev1.CreateUserResponse, error) {
+	name := req.GetName()
+	if !userNamePattern.MatchString(name) {
+		return nil, status.Error(codes.InvalidArgument, "ValidationError")
+	}
+
+	rec, err := s.store.Users().Create(ctx, store.NewID(), name, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, store.ErrUserAlreadyExists) {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.AlreadyExists, "EntityAlreadyExists"))
+		}
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("user <%s> created", name)))
+
+	return &servicev1.CreateUserResponse{User: userToProto(rec)}, nil
+}
@@ -0,0 +1,97 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_CreateUser(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		userName    string
+		createErr   error
+		wantCode    codes.Code
+		wantMessage string
+		wantCreate  bool
+	}
+
+	cases := []tc{
+		{
+			name:       "creates user",
+			userName:   "alice@example.com",
+			wantCreate: true,
+		},
+		{
+			name:        "empty name is invalid",
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "ValidationError",
+		},
+		{
+			name:        "name with spaces is invalid",
+			userName:    "alice smith",
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "ValidationError",
+		},
+		{
+			name:        "name over 64 characters is invalid",
+			userName:    strings.Repeat("a", 65),
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "ValidationError",
+		},
+		{
+			name:        "taken name returns AlreadyExists",
+			userName:    "alice",
+			createErr:   store.ErrUserAlreadyExists,
+			wantCode:    codes.AlreadyExists,
+			wantMessage: "EntityAlreadyExists",
+			wantCreate:  true,
+		},
+		{
+			name:        "store failure returns Internal",
+			userName:    "alice",
+			createErr:   errors.New("disk I/O error"),
+			wantCode:    codes.Internal,
+			wantMessage: "disk I/O error",
+			wantCreate:  true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			users := testutil.NewFakeUserStore()
+			users.SetCreateError(c.createErr)
+			svc.store = testutil.NewFakeStore(testutil.WithUsers(users))
+
+			resp, err := svc.CreateUser(context.Background(), &servicev1.CreateUserRequest{Name: c.userName})
+
+			calls := users.CreateCalls()
+			if c.wantCreate != (len(calls) == 1) {
+				t.Fatalf("Create calls: got %+v, want called=%v", calls, c.wantCreate)
+			}
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+
+			user := resp.GetUser()
+			if user.GetName() != c.userName || user.GetId() != calls[0].ID || user.GetCreatedMs() != calls[0].CreatedAt.UnixMilli() {
+				t.Fatalf("user: got %+v, want %s created with %+v", user, c.userName, calls[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) DeleteBucket(ctx context.Context, req *servicev1.DeleteBucketRequest) (*servicev1.DeleteBucketResponse, error) {
+	name := req.GetName()
+
+	validator := validation.DefaultBucketNameValidator{}
+
+	if err := validator.ValidateBucketName(name); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	if err := checkTestBucket(name); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	// Force purges whatever the bucket holds, so only the admin may use it,
+	// on any bucket; everyone else deletes their own empty buckets.
+	if req.GetForce() {
+		if err := s.authorizeAdmin(ctx); err != nil {
+			return nil, loggrpc.SetError(ctx, err)
+		}
+	}
+
+	bucket, err := s.store.Buckets().GetByName(ctx, name)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.NotFound, err.Error()))
+	}
+
+	if !req.GetForce() {
+		if err := s.authorizeOwner(ctx, bucket); err != nil {
+			return nil, loggrpc.SetError(ctx, err)
+		}
+	}
+
+	queued, err := s.store.Buckets().Delete(ctx, bucket.ID, req.GetForce(), time.Now().UTC())
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrBucketNotEmpty):
+			return nil, loggrpc.SetError(ctx, status.Error(codes.FailedPrecondition, err.Error()))
+		case errors.Is(err, store.ErrBucketNotFound):
+			return nil, loggrpc.SetError(ctx, status.Error(codes.NotFound, err.Error()))
+		case errors.Is(err, store.ErrObjectLocked):
+			return nil, objectLocked(ctx)
+		default:
+			return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+		}
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> deleted, %d blobs queued", name, queued)))
+
+	return &servicev1.DeleteBucketResponse{ObjectsQueued: queued}, nil
+}
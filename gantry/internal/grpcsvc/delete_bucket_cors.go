@@ -0,0 +1,29 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// DeleteBucketCors removes the bucket's CORS configuration, after which
+// flatbed refuses cross-origin requests to it. Deleting a configuration that
+// isn't there succeeds, as in S3.
+func (s *Service) DeleteBucketCors(ctx context.Context, req *servicev1.DeleteBucketCorsRequest) (*servicev1.DeleteBucketCorsResponse, error) {
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if err := s.store.Buckets().SetCORS(ctx, bucket.ID, nil, time.Now().UTC()); err != nil {
+		return nil, loggrpc.SetError(ctx, setBucketError(err))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> cors deleted", bucket.Name)))
+
+	return &servicev1.DeleteBucketCorsResponse{}, nil
+}
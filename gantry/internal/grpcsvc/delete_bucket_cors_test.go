@@ -0,0 +1,62 @@
+package grpcsvc
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_DeleteBucketCors(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		caller      string
+		setErr      error
+		wantSet     bool
+		wantCode    codes.Code
+		wantMessage string
+	}
+
+	cases := []tc{
+		{name: "owner clears cors", caller: "user-alice", wantSet: true},
+		{name: "other user returns AccessDenied", caller: "user-bob", wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "bucket removed concurrently returns NoSuchBucket", caller: "user-alice", setErr: fmt.Errorf("set bucket cors: %w", store.ErrBucketNotFound), wantSet: true, wantCode: codes.NotFound, wantMessage: "NoSuchBucket"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{
+				ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice",
+				CORS: []store.CORSRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}}},
+			})
+			buckets.SetSetCORSError(c.setErr)
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			_, err := svc.DeleteBucketCors(callerContext(c.caller), &servicev1.DeleteBucketCorsRequest{Bucket: "my-bucket"})
+
+			calls := buckets.SetCORSCalls()
+			if c.wantSet != (len(calls) == 1) {
+				t.Fatalf("SetCORS calls: got %v, want set=%v", calls, c.wantSet)
+			}
+			if c.wantSet && calls[0].Rules != nil {
+				t.Fatalf("SetCORS rules: got %+v, want none", calls[0].Rules)
+			}
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+		})
+	}
+}
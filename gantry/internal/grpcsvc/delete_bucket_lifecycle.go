@@ -0,0 +1,29 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// DeleteBucketLifecycle removes the bucket's lifecycle configuration, which
+// stops the lifecycle worker expiring its objects. Deleting a configuration
+// that isn't there succeeds, as in S3.
+func (s *Service) DeleteBucketLifecycle(ctx context.Context, req *servicev1.DeleteBucketLifecycleRequest) (*servicev1.DeleteBucketLifecycleResponse, error) {
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if err := s.store.Buckets().SetLifecycle(ctx, bucket.ID, nil, time.Now().UTC()); err != nil {
+		return nil, loggrpc.SetError(ctx, setBucketError(err))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> lifecycle deleted", bucket.Name)))
+
+	return &servicev1.DeleteBucketLifecycleResponse{}, nil
+}
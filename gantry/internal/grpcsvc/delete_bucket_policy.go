@@ -0,0 +1,28 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// DeleteBucketPolicy removes the bucket policy. Deleting a policy that isn't
+// there succeeds, as in S3.
+func (s *Service) DeleteBucketPolicy(ctx context.Context, req *servicev1.DeleteBucketPolicyRequest) (*servicev1.DeleteBucketPolicyResponse, error) {
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if err := s.store.Buckets().SetPolicy(ctx, bucket.ID, "", time.Now().UTC()); err != nil {
+		return nil, loggrpc.SetError(ctx, setBucketError(err))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> policy deleted", bucket.Name)))
+
+	return &servicev1.DeleteBucketPolicyResponse{}, nil
+}
@@ -0,0 +1,211 @@
+package grpcsvc
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_DeleteBucket(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name         string
+		bucket       string
+		force        bool
+		caller       string
+		callerName   string
+		userErr      error
+		ownerID      string
+		queued       int64
+		getByNameErr error
+		deleteErr    error
+		wantDeletes  int
+		wantErr      bool
+		wantCode     codes.Code
+		wantMessage  string
+	}
+
+	cases := []tc{
+		{
+			name:        "empty bucket is deleted",
+			bucket:      "my-bucket",
+			wantDeletes: 1,
+		},
+		{
+			name:        "force delete reports queued blobs",
+			bucket:      "my-bucket",
+			force:       true,
+			caller:      "user-admin",
+			callerName:  "admin",
+			queued:      3,
+			wantDeletes: 1,
+		},
+		{
+			name:        "admin force deletes another user's bucket",
+			bucket:      "my-bucket",
+			force:       true,
+			caller:      "user-admin",
+			callerName:  "admin",
+			ownerID:     "user-alice",
+			wantDeletes: 1,
+		},
+		{
+			name:        "owner can't force delete",
+			bucket:      "my-bucket",
+			force:       true,
+			caller:      "user-alice",
+			callerName:  "alice",
+			ownerID:     "user-alice",
+			wantErr:     true,
+			wantCode:    codes.PermissionDenied,
+			wantMessage: "AccessDenied",
+		},
+		{
+			name:        "anonymous force delete of an unowned bucket returns AccessDenied",
+			bucket:      "my-bucket",
+			force:       true,
+			wantErr:     true,
+			wantCode:    codes.PermissionDenied,
+			wantMessage: "AccessDenied",
+		},
+		{
+			name:        "unknown caller can't force delete",
+			bucket:      "my-bucket",
+			force:       true,
+			caller:      "user-ghost",
+			userErr:     store.ErrUserNotFound,
+			wantErr:     true,
+			wantCode:    codes.PermissionDenied,
+			wantMessage: "AccessDenied",
+		},
+		{
+			name:        "admin lookup failure returns Internal",
+			bucket:      "my-bucket",
+			force:       true,
+			caller:      "user-admin",
+			userErr:     errors.New("db down"),
+			wantErr:     true,
+			wantCode:    codes.Internal,
+			wantMessage: "db down",
+		},
+		{
+			name:        "non-empty bucket returns FailedPrecondition",
+			bucket:      "my-bucket",
+			deleteErr:   fmt.Errorf("delete bucket: %w", store.ErrBucketNotEmpty),
+			wantDeletes: 1,
+			wantErr:     true,
+			wantCode:    codes.FailedPrecondition,
+			wantMessage: "delete bucket: bucket not empty",
+		},
+		{
+			name:        "force delete of locked objects returns AccessDenied",
+			bucket:      "my-bucket",
+			force:       true,
+			caller:      "user-admin",
+			callerName:  "admin",
+			deleteErr:   fmt.Errorf("delete bucket: %w", store.ErrObjectLocked),
+			wantDeletes: 1,
+			wantErr:     true,
+			wantCode:    codes.PermissionDenied,
+			wantMessage: "AccessDenied",
+		},
+		{
+			name:         "missing bucket returns NotFound",
+			bucket:       "nonexistent-bucket",
+			getByNameErr: store.ErrBucketNotFound,
+			wantErr:      true,
+			wantCode:     codes.NotFound,
+			wantMessage:  "bucket not found",
+		},
+		{
+			name:        "bucket removed concurrently returns NotFound",
+			bucket:      "my-bucket",
+			deleteErr:   fmt.Errorf("delete bucket: %w", store.ErrBucketNotFound),
+			wantDeletes: 1,
+			wantErr:     true,
+			wantCode:    codes.NotFound,
+			wantMessage: "delete bucket: bucket not found",
+		},
+		{
+			name:        "store error returns Internal",
+			bucket:      "my-bucket",
+			deleteErr:   errors.New("delete bucket: disk I/O error"),
+			wantDeletes: 1,
+			wantErr:     true,
+			wantCode:    codes.Internal,
+			wantMessage: "delete bucket: disk I/O error",
+		},
+		{
+			name:        "another user's bucket returns PermissionDenied",
+			bucket:      "my-bucket",
+			ownerID:     "user-alice",
+			wantErr:     true,
+			wantCode:    codes.PermissionDenied,
+			wantMessage: "AccessDenied",
+		},
+		{
+			name:        "invalid bucket name returns InvalidArgument",
+			bucket:      "Bad!Name",
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidBucketName",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			svc.adminUser = "admin"
+
+			users := testutil.NewFakeUserStore()
+			users.SetGetByIDResponse(store.UserRecord{ID: c.caller, Name: c.callerName})
+			if c.userErr != nil {
+				users.SetGetByIDError(c.userErr)
+			}
+
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: c.bucket, OwnerID: c.ownerID})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+			buckets.SetDeleteQueued(c.queued)
+			if c.deleteErr != nil {
+				buckets.SetDeleteError(c.deleteErr)
+			}
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets), testutil.WithUsers(users))
+
+			resp, err := svc.DeleteBucket(callerContext(c.caller), &servicev1.DeleteBucketRequest{
+				Name:  c.bucket,
+				Force: c.force,
+			})
+
+			calls := buckets.DeleteCalls()
+			if len(calls) != c.wantDeletes {
+				t.Fatalf("Delete calls: got %d, want %d", len(calls), c.wantDeletes)
+			}
+			if c.wantDeletes > 0 && (calls[0].ID != "bucket-id-123" || calls[0].Force != c.force) {
+				t.Fatalf("Delete call: got %+v, want bucket-id-123 force=%v", calls[0], c.force)
+			}
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+
+			assertNoError(t, err)
+
+			if got := resp.GetObjectsQueued(); got != c.queued {
+				t.Fatalf("objects queued: got %d, want %d", got, c.queued)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) DeleteObject(ctx context.Context, req *servicev1.DeleteObjectRequest) (*servicev1.DeleteObjectResponse, error) {
+	bucketName := req.GetBucket()
+	key := req.GetKey()
+	versionID := req.GetVersionId()
+
+	bucketValidator := validation.DefaultBucketNameValidator{}
+	keyValidator := validation.DefaultKeyValidator{}
+
+	if err := bucketValidator.ValidateBucketName(bucketName); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	if err := keyValidator.ValidateKey(key); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidKeyName")
+	}
+
+	if err := checkTestBucket(bucketName); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	bucket, err := s.store.Buckets().GetByName(ctx, bucketName)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, objectLookupError(servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, bucketName, key, err))
+	}
+
+	action := policy.ActionDeleteObject
+	if versionID != "" {
+		action = policy.ActionDeleteObjectVersion
+	}
+	if err := s.authorize(ctx, bucket, action, key); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	bypass, err := s.bypassGovernance(ctx, bucket, key, req.GetBypassGovernanceRetention())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	objects := s.store.Objects()
+	now := time.Now().UTC()
+
+	var deletion store.ObjectDeletion
+	if versionID == "" {
+		deletion, err = objects.DeleteCurrent(ctx, bucket.ID, key, store.NewID(), now)
+	} else {
+		deletion, err = objects.DeleteVersion(ctx, bucket.ID, key, versionID, bypass, now)
+	}
+	if errors.Is(err, store.ErrObjectLocked) {
+		return nil, objectLocked(ctx)
+	}
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	var result string
+	switch {
+	case !deletion.Deleted && versionID != "":
+		result = fmt.Sprintf("version %s of object %s/%s not found, nothing to delete", versionID, bucketName, key)
+	case !deletion.Deleted:
+		result = fmt.Sprintf("object %s/%s not found, nothing to delete", bucketName, key)
+	case versionID != "":
+		result = fmt.Sprintf("version %s of object %s/%s deleted", versionID, bucketName, key)
+	case deletion.DeleteMarker:
+		result = fmt.Sprintf("object %s/%s deleted, delete marker %s created", bucketName, key, deletion.VersionID)
+	default:
+		result = fmt.Sprintf("object %s/%s deleted", bucketName, key)
+	}
+	loggrpc.SetAttrs(ctx, slog.String("result", result))
+
+	return &servicev1.DeleteObjectResponse{
+		VersionId:    deletion.VersionID,
+		DeleteMarker: deletion.DeleteMarker,
+	}, nil
+}
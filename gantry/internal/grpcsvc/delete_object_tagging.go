@@ -0,0 +1,31 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// DeleteObjectTagging removes every tag from one version of an object.
+func (s *Service) DeleteObjectTagging(ctx context.Context, req *servicev1.DeleteObjectTaggingRequest) (*servicev1.DeleteObjectTaggingResponse, error) {
+	key := req.GetKey()
+	versionID := req.GetVersionId()
+
+	bucket, err := s.subresourceBucket(ctx, req.GetBucket(), key, versionID, policy.ActionDeleteObjectTagging, policy.ActionDeleteObjectVersionTagging)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	tagged, err := s.store.Objects().SetTags(ctx, bucket.ID, key, versionID, nil)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, subresourceError(err, versionID))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("object %s/%s tags deleted", bucket.Name, key)))
+
+	return &servicev1.DeleteObjectTaggingResponse{VersionId: subresourceVersionID(bucket, tagged)}, nil
+}
@@ -0,0 +1,63 @@
+package grpcsvc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_DeleteObjectTagging(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		caller      string
+		setErr      error
+		wantSet     bool
+		wantCode    codes.Code
+		wantMessage string
+	}
+
+	cases := []tc{
+		{name: "owner clears the tags", caller: "user-alice", wantSet: true},
+		{name: "other user returns AccessDenied", caller: "user-bob", wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "missing key", caller: "user-alice", setErr: store.ErrObjectNotFound, wantSet: true, wantCode: codes.NotFound, wantMessage: "NoSuchKey"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice"})
+			objects := testutil.NewFakeObjectStore()
+			objects.SetSetTagsError(c.setErr)
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets), testutil.WithObjects(objects))
+
+			_, err := svc.DeleteObjectTagging(callerContext(c.caller), &servicev1.DeleteObjectTaggingRequest{
+				Bucket: "my-bucket",
+				Key:    "backups/db.tar",
+			})
+
+			calls := objects.SetTagsCalls()
+			if !c.wantSet {
+				if len(calls) != 0 {
+					t.Fatalf("SetTags calls: got %+v, want none", calls)
+				}
+			} else if len(calls) != 1 || calls[0].Key != "backups/db.tar" || calls[0].Tags != nil {
+				t.Fatalf("SetTags calls: got %+v, want one clearing the tags", calls)
+			}
+
+			if c.wantMessage != "" {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_DeleteObject(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name            string
+		bucket          string
+		key             string
+		versionID       string
+		bypass          bool
+		deletion        store.ObjectDeletion
+		getByNameErr    error
+		deleteErr       error
+		wantDeletes     int
+		wantResponse    *servicev1.DeleteObjectResponse
+		wantErr         bool
+		wantCode        codes.Code
+		wantMessage     string
+		wantErrorDetail bool
+	}
+
+	cases := []tc{
+		{
+			name:         "committed object is retired",
+			bucket:       "my-bucket",
+			key:          "photos/sunset.jpg",
+			deletion:     store.ObjectDeletion{Deleted: true},
+			wantDeletes:  1,
+			wantResponse: &servicev1.DeleteObjectResponse{},
+		},
+		{
+			name:         "missing key succeeds",
+			bucket:       "my-bucket",
+			key:          "photos/missing.jpg",
+			wantDeletes:  1,
+			wantResponse: &servicev1.DeleteObjectResponse{},
+		},
+		{
+			name:         "versioned bucket reports the delete marker",
+			bucket:       "my-bucket",
+			key:          "photos/sunset.jpg",
+			deletion:     store.ObjectDeletion{VersionID: "marker-1", DeleteMarker: true, Deleted: true},
+			wantDeletes:  1,
+			wantResponse: &servicev1.DeleteObjectResponse{VersionId: "marker-1", DeleteMarker: true},
+		},
+		{
+			name:         "version id deletes that version",
+			bucket:       "my-bucket",
+			key:          "photos/sunset.jpg",
+			versionID:    "version-1",
+			deletion:     store.ObjectDeletion{VersionID: "version-1", Deleted: true},
+			wantDeletes:  1,
+			wantResponse: &servicev1.DeleteObjectResponse{VersionId: "version-1"},
+		},
+		{
+			name:         "deleting a delete marker reports it",
+			bucket:       "my-bucket",
+			key:          "photos/sunset.jpg",
+			versionID:    "marker-1",
+			deletion:     store.ObjectDeletion{VersionID: "marker-1", DeleteMarker: true, Deleted: true},
+			wantDeletes:  1,
+			wantResponse: &servicev1.DeleteObjectResponse{VersionId: "marker-1", DeleteMarker: true},
+		},
+		{
+			name:         "bypass governance is passed to the store",
+			bucket:       "my-bucket",
+			key:          "photos/sunset.jpg",
+			versionID:    "version-1",
+			bypass:       true,
+			deletion:     store.ObjectDeletion{VersionID: "version-1", Deleted: true},
+			wantDeletes:  1,
+			wantResponse: &servicev1.DeleteObjectResponse{VersionId: "version-1"},
+		},
+		{
+			name:        "locked version returns AccessDenied",
+			bucket:      "my-bucket",
+			key:         "photos/sunset.jpg",
+			versionID:   "version-1",
+			deleteErr:   store.ErrObjectLocked,
+			wantDeletes: 1,
+			wantErr:     true,
+			wantCode:    codes.PermissionDenied,
+			wantMessage: "AccessDenied",
+		},
+		{
+			name:            "bucket not found returns NotFound",
+			bucket:          "nonexistent-bucket",
+			key:             "photos/sunset.jpg",
+			getByNameErr:    store.ErrBucketNotFound,
+			wantErr:         true,
+			wantCode:        codes.NotFound,
+			wantMessage:     "bucket not found",
+			wantErrorDetail: true,
+		},
+		{
+			name:        "store error returns Internal",
+			bucket:      "my-bucket",
+			key:         "photos/sunset.jpg",
+			deleteErr:   errors.New("delete object: disk I/O error"),
+			wantDeletes: 1,
+			wantErr:     true,
+			wantCode:    codes.Internal,
+			wantMessage: "delete object: disk I/O error",
+		},
+		{
+			name:        "invalid bucket name returns InvalidArgument",
+			bucket:      "Bad!Name",
+			key:         "photos/sunset.jpg",
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidBucketName",
+		},
+		{
+			name:        "invalid key returns InvalidArgument",
+			bucket:      "my-bucket",
+			key:         "",
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidKeyName",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: c.bucket})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+
+			objects := testutil.NewFakeObjectStore()
+			objects.SetDeleteResponse(c.deletion)
+			if c.deleteErr != nil {
+				objects.SetDeleteError(c.deleteErr)
+			}
+
+			svc.store = testutil.NewFakeStore(
+				testutil.WithBuckets(buckets),
+				testutil.WithObjects(objects),
+			)
+
+			resp, err := svc.DeleteObject(context.Background(), &servicev1.DeleteObjectRequest{
+				Bucket:    c.bucket,
+				Key:       c.key,
+				VersionId: c.versionID,
+
+				BypassGovernanceRetention: c.bypass,
+			})
+
+			calls := objects.DeleteCalls()
+			if len(calls) != c.wantDeletes {
+				t.Fatalf("delete calls: got %d, want %d", len(calls), c.wantDeletes)
+			}
+			if c.wantDeletes > 0 {
+				call := calls[0]
+				if call.BucketID != "bucket-id-123" || call.Key != c.key {
+					t.Fatalf("delete call: got %+v, want bucket-id-123/%s", call, c.key)
+				}
+				if c.versionID != "" && (!call.Version || call.VersionID != c.versionID || call.BypassGovernance != c.bypass) {
+					t.Fatalf("delete call: got %+v, want DeleteVersion of %s", call, c.versionID)
+				}
+				if c.versionID == "" && (call.Version || call.VersionID == "") {
+					t.Fatalf("delete call: got %+v, want DeleteCurrent with a marker ID", call)
+				}
+			}
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				if c.wantErrorDetail {
+					assertObjectLookupErrorDetail(t, err, servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, c.bucket, c.key)
+				}
+				return
+			}
+
+			assertNoError(t, err)
+			if !proto.Equal(resp, c.wantResponse) {
+				t.Fatalf("response: got %v, want %v", resp, c.wantResponse)
+			}
+		})
+	}
+}
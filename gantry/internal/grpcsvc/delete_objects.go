@@ -0,0 +1,120 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// maxDeleteObjects is S3's limit on the keys of one DeleteObjects request.
+const maxDeleteObjects = 1000
+
+func (s *Service) DeleteObjects(ctx context.Context, req *servicev1.DeleteObjectsRequest) (*servicev1.DeleteObjectsResponse, error) {
+	bucketName := req.GetBucket()
+	objects := req.GetObjects()
+
+	bucketValidator := validation.DefaultBucketNameValidator{}
+	keyValidator := validation.DefaultKeyValidator{}
+
+	if err := bucketValidator.ValidateBucketName(bucketName); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	if len(objects) == 0 || len(objects) > maxDeleteObjects {
+		loggrpc.SetAttrs(ctx, slog.String("reason", fmt.Sprintf("%d objects, want 1 to %d", len(objects), maxDeleteObjects)))
+		return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "MalformedXML"))
+	}
+
+	if err := checkTestBucket(bucketName); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	bucket, err := s.store.Buckets().GetByName(ctx, bucketName)
+	if err != nil {
+		if errors.Is(err, store.ErrBucketNotFound) {
+			return nil, loggrpc.SetError(ctx, errNoSuchBucket)
+		}
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	// Each key is checked on its own, so a policy denying some keys only
+	// fails those. failed holds the error code of each object that fails,
+	// so results are reported in request order.
+	failed := make([]string, len(objects))
+	deletes := make([]store.ObjectDelete, 0, len(objects))
+	positions := make([]int, 0, len(objects))
+	for i, obj := range objects {
+		if err := keyValidator.ValidateKey(obj.GetKey()); err != nil {
+			failed[i] = "InvalidKeyName"
+			continue
+		}
+
+		action := policy.ActionDeleteObject
+		if obj.GetVersionId() != "" {
+			action = policy.ActionDeleteObjectVersion
+		}
+		if err := s.authorize(ctx, bucket, action, obj.GetKey()); err != nil {
+			if status.Code(err) != codes.PermissionDenied {
+				return nil, loggrpc.SetError(ctx, err)
+			}
+			failed[i] = "AccessDenied"
+			continue
+		}
+
+		d := store.ObjectDelete{Key: obj.GetKey(), VersionID: obj.GetVersionId()}
+		if d.VersionID == "" {
+			d.MarkerID = store.NewID()
+		} else if d.BypassGovernance, err = s.bypassGovernance(ctx, bucket, d.Key, req.GetBypassGovernanceRetention()); err != nil {
+			return nil, loggrpc.SetError(ctx, err)
+		}
+		deletes = append(deletes, d)
+		positions = append(positions, i)
+	}
+
+	deletions := make([]store.ObjectDeletion, len(objects))
+	if len(deletes) > 0 {
+		results, err := s.store.Objects().DeleteMany(ctx, bucket.ID, deletes, time.Now().UTC())
+		if err != nil {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+		}
+		for j, i := range positions {
+			deletions[i] = results[j]
+			if results[j].Locked {
+				failed[i] = "AccessDenied"
+			}
+		}
+	}
+
+	resp := &servicev1.DeleteObjectsResponse{}
+	for i, obj := range objects {
+		if failed[i] != "" {
+			resp.Errors = append(resp.Errors, deleteObjectError(obj, failed[i]))
+			continue
+		}
+		d := &servicev1.DeletedObject{Key: obj.GetKey(), VersionId: obj.GetVersionId()}
+		if deletions[i].DeleteMarker {
+			d.DeleteMarker = true
+			d.DeleteMarkerVersionId = deletions[i].VersionID
+		}
+		resp.Deleted = append(resp.Deleted, d)
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("%d objects deleted from bucket %s, %d failed", len(resp.Deleted), bucketName, len(resp.Errors))))
+
+	return resp, nil
+}
+
+func deleteObjectError(obj *servicev1.ObjectIdentifier, code string) *servicev1.DeleteObjectError {
+	return &servicev1.DeleteObjectError{Key: obj.GetKey(), VersionId: obj.GetVersionId(), Code: code}
+}
@@ -0,0 +1,189 @@
+package grpcsvc
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_DeleteObjects(t *testing.T) {
+	t.Parallel()
+
+	const denyPrivate = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:DeleteObject","Resource":"arn:aws:s3:::my-bucket/private/*"}]}`
+
+	tooMany := make([]*servicev1.ObjectIdentifier, 1001)
+	for i := range tooMany {
+		tooMany[i] = &servicev1.ObjectIdentifier{Key: fmt.Sprintf("key-%d", i)}
+	}
+
+	type tc struct {
+		name         string
+		caller       string
+		policy       string
+		objects      []*servicev1.ObjectIdentifier
+		bypass       bool
+		deletions    []store.ObjectDeletion
+		getByNameErr error
+		deleteErr    error
+		wantDeletes  []store.ObjectDelete
+		wantResponse *servicev1.DeleteObjectsResponse
+		wantCode     codes.Code
+		wantMessage  string
+	}
+
+	cases := []tc{
+		{
+			name:        "keys and versions are deleted together",
+			caller:      "user-alice",
+			objects:     []*servicev1.ObjectIdentifier{{Key: "a.tar"}, {Key: "b.tar", VersionId: "version-1"}},
+			deletions:   []store.ObjectDeletion{{Deleted: true}, {VersionID: "version-1", Deleted: true}},
+			wantDeletes: []store.ObjectDelete{{Key: "a.tar"}, {Key: "b.tar", VersionID: "version-1"}},
+			wantResponse: &servicev1.DeleteObjectsResponse{
+				Deleted: []*servicev1.DeletedObject{{Key: "a.tar"}, {Key: "b.tar", VersionId: "version-1"}},
+			},
+		},
+		{
+			name:        "delete markers are reported",
+			caller:      "user-alice",
+			objects:     []*servicev1.ObjectIdentifier{{Key: "a.tar"}, {Key: "b.tar", VersionId: "marker-2"}},
+			deletions:   []store.ObjectDeletion{{VersionID: "marker-1", DeleteMarker: true, Deleted: true}, {VersionID: "marker-2", DeleteMarker: true, Deleted: true}},
+			wantDeletes: []store.ObjectDelete{{Key: "a.tar"}, {Key: "b.tar", VersionID: "marker-2"}},
+			wantResponse: &servicev1.DeleteObjectsResponse{
+				Deleted: []*servicev1.DeletedObject{
+					{Key: "a.tar", DeleteMarker: true, DeleteMarkerVersionId: "marker-1"},
+					{Key: "b.tar", VersionId: "marker-2", DeleteMarker: true, DeleteMarkerVersionId: "marker-2"},
+				},
+			},
+		},
+		{
+			name:        "keys the policy denies fail on their own",
+			caller:      "user-alice",
+			policy:      denyPrivate,
+			objects:     []*servicev1.ObjectIdentifier{{Key: "private/a.tar"}, {Key: "public/b.tar"}},
+			wantDeletes: []store.ObjectDelete{{Key: "public/b.tar"}},
+			wantResponse: &servicev1.DeleteObjectsResponse{
+				Deleted: []*servicev1.DeletedObject{{Key: "public/b.tar"}},
+				Errors:  []*servicev1.DeleteObjectError{{Key: "private/a.tar", Code: "AccessDenied"}},
+			},
+		},
+		{
+			name:        "invalid keys fail on their own",
+			caller:      "user-alice",
+			objects:     []*servicev1.ObjectIdentifier{{Key: ""}, {Key: "a.tar"}},
+			wantDeletes: []store.ObjectDelete{{Key: "a.tar"}},
+			wantResponse: &servicev1.DeleteObjectsResponse{
+				Deleted: []*servicev1.DeletedObject{{Key: "a.tar"}},
+				Errors:  []*servicev1.DeleteObjectError{{Key: "", Code: "InvalidKeyName"}},
+			},
+		},
+		{
+			name:        "locked versions fail on their own",
+			caller:      "user-alice",
+			objects:     []*servicev1.ObjectIdentifier{{Key: "a.tar", VersionId: "version-1"}, {Key: "b.tar", VersionId: "version-2"}},
+			deletions:   []store.ObjectDeletion{{Locked: true}, {VersionID: "version-2", Deleted: true}},
+			wantDeletes: []store.ObjectDelete{{Key: "a.tar", VersionID: "version-1"}, {Key: "b.tar", VersionID: "version-2"}},
+			wantResponse: &servicev1.DeleteObjectsResponse{
+				Deleted: []*servicev1.DeletedObject{{Key: "b.tar", VersionId: "version-2"}},
+				Errors:  []*servicev1.DeleteObjectError{{Key: "a.tar", VersionId: "version-1", Code: "AccessDenied"}},
+			},
+		},
+		{
+			name:        "bypass governance applies to versions",
+			caller:      "user-alice",
+			objects:     []*servicev1.ObjectIdentifier{{Key: "a.tar"}, {Key: "b.tar", VersionId: "version-1"}},
+			bypass:      true,
+			deletions:   []store.ObjectDeletion{{Deleted: true}, {VersionID: "version-1", Deleted: true}},
+			wantDeletes: []store.ObjectDelete{{Key: "a.tar"}, {Key: "b.tar", VersionID: "version-1", BypassGovernance: true}},
+			wantResponse: &servicev1.DeleteObjectsResponse{
+				Deleted: []*servicev1.DeletedObject{{Key: "a.tar"}, {Key: "b.tar", VersionId: "version-1"}},
+			},
+		},
+		{
+			name:    "other user is denied every key",
+			caller:  "user-bob",
+			objects: []*servicev1.ObjectIdentifier{{Key: "a.tar"}, {Key: "b.tar", VersionId: "version-1"}},
+			wantResponse: &servicev1.DeleteObjectsResponse{
+				Errors: []*servicev1.DeleteObjectError{{Key: "a.tar", Code: "AccessDenied"}, {Key: "b.tar", VersionId: "version-1", Code: "AccessDenied"}},
+			},
+		},
+		{name: "no objects", caller: "user-alice", wantCode: codes.InvalidArgument, wantMessage: "MalformedXML"},
+		{name: "more than 1000 objects", caller: "user-alice", objects: tooMany, wantCode: codes.InvalidArgument, wantMessage: "MalformedXML"},
+		{name: "missing bucket", caller: "user-alice", objects: []*servicev1.ObjectIdentifier{{Key: "a.tar"}}, getByNameErr: store.ErrBucketNotFound, wantCode: codes.NotFound, wantMessage: "NoSuchBucket"},
+		{
+			name:        "store error fails the request",
+			caller:      "user-alice",
+			objects:     []*servicev1.ObjectIdentifier{{Key: "a.tar"}},
+			deleteErr:   errors.New("disk I/O error"),
+			wantDeletes: []store.ObjectDelete{{Key: "a.tar"}},
+			wantCode:    codes.Internal,
+			wantMessage: "disk I/O error",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", Policy: c.policy})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+			objects := testutil.NewFakeObjectStore()
+			if c.deletions != nil {
+				objects.SetDeleteManyResponse(c.deletions)
+			}
+			objects.SetDeleteManyError(c.deleteErr)
+			users := testutil.NewFakeUserStore()
+			users.SetGetByIDResponse(store.UserRecord{ID: c.caller, Name: "alice"})
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets), testutil.WithObjects(objects), testutil.WithUsers(users))
+
+			resp, err := svc.DeleteObjects(callerContext(c.caller), &servicev1.DeleteObjectsRequest{
+				Bucket:  "my-bucket",
+				Objects: c.objects,
+
+				BypassGovernanceRetention: c.bypass,
+			})
+
+			calls := objects.DeleteManyCalls()
+			if c.wantDeletes == nil {
+				if len(calls) != 0 {
+					t.Fatalf("DeleteMany calls: got %+v, want none", calls)
+				}
+			} else {
+				if len(calls) != 1 || calls[0].BucketID != "bucket-id-123" {
+					t.Fatalf("DeleteMany calls: got %+v, want one for bucket-id-123", calls)
+				}
+				got := calls[0].Deletes
+				for i := range got {
+					if (got[i].VersionID == "") == (got[i].MarkerID == "") {
+						t.Fatalf("delete %d: got %+v, want a marker ID only without a version", i, got[i])
+					}
+					got[i].MarkerID = ""
+				}
+				if !slices.Equal(got, c.wantDeletes) {
+					t.Fatalf("deletes: got %+v, want %+v", got, c.wantDeletes)
+				}
+			}
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+
+			assertNoError(t, err)
+			if !proto.Equal(resp, c.wantResponse) {
+				t.Fatalf("response: got %v, want %v", resp, c.wantResponse)
+			}
+		})
+	}
+}
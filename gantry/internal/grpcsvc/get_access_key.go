@@ -0,0 +1,45 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// GetAccessKey returns the secret and owner of an active access key. The
+// secret is never logged; only the access key ID appears in the request
+// attrs. Inactive keys are reported as not found, as S3 does.
+func (s *Service) GetAccessKey(ctx context.Context, req *servicev1.GetAccessKeyRequest) (*servicev1.GetAccessKeyResponse, error) {
+	accessKeyID := req.GetAccessKeyId()
+	if accessKeyID == "" {
+		return nil, status.Error(codes.InvalidArgument, "InvalidAccessKeyId")
+	}
+
+	rec, err := s.store.AccessKeys().Get(ctx, accessKeyID)
+	if err != nil {
+		if errors.Is(err, store.ErrAccessKeyNotFound) {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.NotFound, err.Error()))
+		}
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+	if rec.Status != store.AccessKeyActive {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.NotFound, "access key is inactive"))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("access key <%s> found", accessKeyID)))
+
+	return &servicev1.GetAccessKeyResponse{
+		AccessKeyId:     rec.AccessKeyID,
+		SecretAccessKey: rec.SecretAccessKey,
+		UserId:          rec.UserID,
+		UserName:        rec.UserName,
+	}, nil
+}
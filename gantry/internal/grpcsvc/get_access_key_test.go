@@ -0,0 +1,123 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_GetAccessKey(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		accessKeyID string
+		keyStatus   string
+		getErr      error
+		wantErr     bool
+		wantCode    codes.Code
+		wantMessage string
+		wantLookup  bool
+	}
+
+	cases := []tc{
+		{
+			name:        "known access key returns secret",
+			accessKeyID: "AKIDEXAMPLE",
+			wantLookup:  true,
+		},
+		{
+			name:        "unknown access key returns NotFound",
+			accessKeyID: "AKIDMISSING",
+			getErr:      store.ErrAccessKeyNotFound,
+			wantErr:     true,
+			wantCode:    codes.NotFound,
+			wantMessage: "access key not found",
+			wantLookup:  true,
+		},
+		{
+			name:        "inactive access key returns NotFound",
+			accessKeyID: "AKIDEXAMPLE",
+			keyStatus:   store.AccessKeyInactive,
+			wantErr:     true,
+			wantCode:    codes.NotFound,
+			wantMessage: "access key is inactive",
+			wantLookup:  true,
+		},
+		{
+			name:        "store failure returns Internal",
+			accessKeyID: "AKIDEXAMPLE",
+			getErr:      errors.New("disk I/O error"),
+			wantErr:     true,
+			wantCode:    codes.Internal,
+			wantMessage: "disk I/O error",
+			wantLookup:  true,
+		},
+		{
+			name:        "empty access key returns InvalidArgument",
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidAccessKeyId",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			keyStatus := c.keyStatus
+			if keyStatus == "" {
+				keyStatus = store.AccessKeyActive
+			}
+
+			keys := testutil.NewFakeAccessKeyStore()
+			keys.SetGetResponse(store.AccessKeyRecord{
+				ID:              "access-key-row",
+				AccessKeyID:     c.accessKeyID,
+				UserID:          "user-admin",
+				UserName:        "admin",
+				SecretAccessKey: "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY",
+				Status:          keyStatus,
+			})
+			if c.getErr != nil {
+				keys.SetGetError(c.getErr)
+			}
+			svc.store = testutil.NewFakeStore(testutil.WithAccessKeys(keys))
+
+			resp, err := svc.GetAccessKey(context.Background(), &servicev1.GetAccessKeyRequest{AccessKeyId: c.accessKeyID})
+
+			calls := keys.GetCalls()
+			if c.wantLookup && (len(calls) != 1 || calls[0] != c.accessKeyID) {
+				t.Fatalf("Get calls: got %v, want [%s]", calls, c.accessKeyID)
+			}
+			if !c.wantLookup && len(calls) != 0 {
+				t.Fatalf("Get calls: got %v, want none", calls)
+			}
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetAccessKey: unexpected error: %v", err)
+			}
+			if resp.GetAccessKeyId() != c.accessKeyID {
+				t.Fatalf("AccessKeyId: got %q, want %q", resp.GetAccessKeyId(), c.accessKeyID)
+			}
+			if resp.GetSecretAccessKey() != "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY" {
+				t.Fatalf("SecretAccessKey: got %q", resp.GetSecretAccessKey())
+			}
+			if resp.GetUserId() != "user-admin" || resp.GetUserName() != "admin" {
+				t.Fatalf("user: got %q/%q, want user-admin/admin", resp.GetUserId(), resp.GetUserName())
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	bucketv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/bucket/v1"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) GetBucket(ctx context.Context, req *servicev1.GetBucketRequest) (*servicev1.GetBucketResponse, error) {
+	name := req.GetName()
+
+	validator := validation.DefaultBucketNameValidator{}
+
+	if err := validator.ValidateBucketName(name); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	if err := checkTestBucket(name); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	rec, err := s.store.Buckets().GetByName(ctx, name)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.NotFound, err.Error()))
+	}
+
+	if err := s.authorize(ctx, rec, policy.ActionListBucket, ""); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> found", name)))
+
+	return &servicev1.GetBucketResponse{
+		Bucket: &bucketv1.Bucket{
+			Name:             rec.Name,
+			CreatedAtRfc3339: rec.CreatedAt.UTC().Format("2006-01-02T15:04:05.000000Z"),
+		},
+	}, nil
+}
@@ -0,0 +1,33 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) GetBucketAcl(ctx context.Context, req *servicev1.GetBucketAclRequest) (*servicev1.GetBucketAclResponse, error) {
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	resp := &servicev1.GetBucketAclResponse{OwnerId: bucket.OwnerID, Acl: bucket.ACL}
+	if bucket.OwnerID != "" {
+		owner, err := s.store.Users().GetByID(ctx, bucket.OwnerID)
+		if err != nil {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+		}
+		resp.OwnerName = owner.Name
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> acl is %s", bucket.Name, bucket.ACL)))
+
+	return resp, nil
+}
@@ -0,0 +1,60 @@
+package grpcsvc
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_GetBucketAcl(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name          string
+		caller        string
+		ownerID       string
+		userErr       error
+		wantOwnerName string
+		wantCode      codes.Code
+		wantMessage   string
+	}
+
+	cases := []tc{
+		{name: "owner reads acl", caller: "user-alice", ownerID: "user-alice", wantOwnerName: "alice"},
+		{name: "unowned bucket has no owner", ownerID: ""},
+		{name: "other user returns AccessDenied", caller: "user-bob", ownerID: "user-alice", wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "owner lookup failure returns Internal", caller: "user-alice", ownerID: "user-alice", userErr: errors.New("db down"), wantCode: codes.Internal, wantMessage: "db down"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: c.ownerID, ACL: store.BucketACLPublicRead})
+			users := testutil.NewFakeUserStore()
+			users.SetGetByIDResponse(store.UserRecord{ID: "user-alice", Name: "alice"})
+			if c.userErr != nil {
+				users.SetGetByIDError(c.userErr)
+			}
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets), testutil.WithUsers(users))
+
+			resp, err := svc.GetBucketAcl(callerContext(c.caller), &servicev1.GetBucketAclRequest{Bucket: "my-bucket"})
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+			if resp.GetOwnerId() != c.ownerID || resp.GetOwnerName() != c.wantOwnerName || resp.GetAcl() != store.BucketACLPublicRead {
+				t.Fatalf("response: got %+v", resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) GetBucketCors(ctx context.Context, req *servicev1.GetBucketCorsRequest) (*servicev1.GetBucketCorsResponse, error) {
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if len(bucket.CORS) == 0 {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.NotFound, "NoSuchCORSConfiguration"))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> cors has %d rules", bucket.Name, len(bucket.CORS))))
+
+	return &servicev1.GetBucketCorsResponse{Rules: corsRulesToProto(bucket.CORS)}, nil
+}
@@ -0,0 +1,75 @@
+package grpcsvc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_GetBucketCors(t *testing.T) {
+	t.Parallel()
+
+	rules := []store.CORSRule{
+		{ID: "gallery", AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET"}, AllowedHeaders: []string{"*"}, ExposeHeaders: []string{"ETag"}, MaxAgeSeconds: 3000},
+	}
+
+	type tc struct {
+		name         string
+		caller       string
+		cors         []store.CORSRule
+		getByNameErr error
+		wantRules    []*servicev1.CorsRule
+		wantCode     codes.Code
+		wantMessage  string
+	}
+
+	cases := []tc{
+		{
+			name:   "owner reads rules",
+			caller: "user-alice",
+			cors:   rules,
+			wantRules: []*servicev1.CorsRule{
+				{Id: "gallery", AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET"}, AllowedHeaders: []string{"*"}, ExposeHeaders: []string{"ETag"}, MaxAgeSeconds: 3000},
+			},
+		},
+		{name: "bucket without cors returns NoSuchCORSConfiguration", caller: "user-alice", wantCode: codes.NotFound, wantMessage: "NoSuchCORSConfiguration"},
+		{name: "other user returns AccessDenied", caller: "user-bob", cors: rules, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "missing bucket returns NoSuchBucket", caller: "user-alice", getByNameErr: store.ErrBucketNotFound, wantCode: codes.NotFound, wantMessage: "NoSuchBucket"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", CORS: c.cors})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			resp, err := svc.GetBucketCors(callerContext(c.caller), &servicev1.GetBucketCorsRequest{Bucket: "my-bucket"})
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+			got := resp.GetRules()
+			if len(got) != len(c.wantRules) {
+				t.Fatalf("rules: got %d, want %d", len(got), len(c.wantRules))
+			}
+			for i := range got {
+				if !proto.Equal(got[i], c.wantRules[i]) {
+					t.Fatalf("rule %d: got %v, want %v", i, got[i], c.wantRules[i])
+				}
+			}
+		})
+	}
+}
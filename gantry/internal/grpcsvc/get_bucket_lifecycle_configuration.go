@@ -0,0 +1,47 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) GetBucketLifecycleConfiguration(ctx context.Context, req *servicev1.GetBucketLifecycleConfigurationRequest) (*servicev1.GetBucketLifecycleConfigurationResponse, error) {
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if len(bucket.Lifecycle) == 0 {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.NotFound, "NoSuchLifecycleConfiguration"))
+	}
+
+	rules := make([]*servicev1.LifecycleRule, 0, len(bucket.Lifecycle))
+	for _, r := range bucket.Lifecycle {
+		rule := &servicev1.LifecycleRule{
+			Id:                        r.ID,
+			Enabled:                   r.Enabled,
+			Prefix:                    r.Prefix,
+			Tags:                      r.Tags,
+			ExpirationDays:            int32(r.ExpirationDays),
+			ExpiredObjectDeleteMarker: r.ExpiredObjectDeleteMarker,
+			NoncurrentDays:            int32(r.NoncurrentDays),
+			NewerNoncurrentVersions:   int32(r.NewerNoncurrentVersions),
+		}
+		if !r.ExpirationDate.IsZero() {
+			rule.ExpirationDateRfc3339 = r.ExpirationDate.UTC().Format(time.RFC3339)
+		}
+		rules = append(rules, rule)
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> lifecycle has %d rules", bucket.Name, len(rules))))
+
+	return &servicev1.GetBucketLifecycleConfigurationResponse{Rules: rules}, nil
+}
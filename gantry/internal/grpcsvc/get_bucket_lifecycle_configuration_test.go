@@ -0,0 +1,78 @@
+package grpcsvc
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_GetBucketLifecycleConfiguration(t *testing.T) {
+	t.Parallel()
+
+	rules := []store.LifecycleRule{
+		{ID: "footage", Enabled: true, Prefix: "footage/", ExpirationDays: 30},
+		{Tags: map[string]string{"retain": "short"}, ExpirationDate: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), NoncurrentDays: 7, NewerNoncurrentVersions: 2},
+	}
+
+	type tc struct {
+		name         string
+		caller       string
+		lifecycle    []store.LifecycleRule
+		getByNameErr error
+		wantRules    []*servicev1.LifecycleRule
+		wantCode     codes.Code
+		wantMessage  string
+	}
+
+	cases := []tc{
+		{
+			name:      "owner reads rules",
+			caller:    "user-alice",
+			lifecycle: rules,
+			wantRules: []*servicev1.LifecycleRule{
+				{Id: "footage", Enabled: true, Prefix: "footage/", ExpirationDays: 30},
+				{Tags: map[string]string{"retain": "short"}, ExpirationDateRfc3339: "2026-01-01T00:00:00Z", NoncurrentDays: 7, NewerNoncurrentVersions: 2},
+			},
+		},
+		{name: "bucket without lifecycle returns NoSuchLifecycleConfiguration", caller: "user-alice", wantCode: codes.NotFound, wantMessage: "NoSuchLifecycleConfiguration"},
+		{name: "other user returns AccessDenied", caller: "user-bob", lifecycle: rules, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "missing bucket returns NoSuchBucket", caller: "user-alice", getByNameErr: store.ErrBucketNotFound, wantCode: codes.NotFound, wantMessage: "NoSuchBucket"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", Lifecycle: c.lifecycle})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			resp, err := svc.GetBucketLifecycleConfiguration(callerContext(c.caller), &servicev1.GetBucketLifecycleConfigurationRequest{Bucket: "my-bucket"})
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+			got := resp.GetRules()
+			if len(got) != len(c.wantRules) {
+				t.Fatalf("rules: got %d, want %d", len(got), len(c.wantRules))
+			}
+			for i := range got {
+				if !proto.Equal(got[i], c.wantRules[i]) {
+					t.Fatalf("rule %d: got %v, want %v", i, got[i], c.wantRules[i])
+				}
+			}
+		})
+	}
+}
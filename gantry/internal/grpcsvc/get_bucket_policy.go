@@ -0,0 +1,28 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) GetBucketPolicy(ctx context.Context, req *servicev1.GetBucketPolicyRequest) (*servicev1.GetBucketPolicyResponse, error) {
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if bucket.Policy == "" {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.NotFound, "NoSuchBucketPolicy"))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> policy found", bucket.Name)))
+
+	return &servicev1.GetBucketPolicyResponse{Policy: bucket.Policy}, nil
+}
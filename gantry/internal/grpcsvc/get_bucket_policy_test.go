@@ -0,0 +1,58 @@
+package grpcsvc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_GetBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	const doc = `{"Version":"2012-10-17","Statement":[]}`
+
+	type tc struct {
+		name         string
+		caller       string
+		policy       string
+		getByNameErr error
+		wantCode     codes.Code
+		wantMessage  string
+	}
+
+	cases := []tc{
+		{name: "owner reads policy", caller: "user-alice", policy: doc},
+		{name: "bucket without policy returns NoSuchBucketPolicy", caller: "user-alice", wantCode: codes.NotFound, wantMessage: "NoSuchBucketPolicy"},
+		{name: "anonymous caller returns AccessDenied", policy: doc, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "missing bucket returns NoSuchBucket", caller: "user-alice", getByNameErr: store.ErrBucketNotFound, wantCode: codes.NotFound, wantMessage: "NoSuchBucket"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", Policy: c.policy})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			resp, err := svc.GetBucketPolicy(callerContext(c.caller), &servicev1.GetBucketPolicyRequest{Bucket: "my-bucket"})
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+			if resp.GetPolicy() != c.policy {
+				t.Fatalf("policy: got %q, want %q", resp.GetPolicy(), c.policy)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package grpcsvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_GetBucket(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	type tc struct {
+		name         string
+		bucket       string
+		getByNameErr error
+		wantErr      bool
+		wantCode     codes.Code
+		wantMessage  string
+		wantLookup   bool
+	}
+
+	cases := []tc{
+		{
+			name:       "existing bucket returns bucket",
+			bucket:     "my-bucket",
+			wantLookup: true,
+		},
+		{
+			name:         "missing bucket returns NotFound",
+			bucket:       "nonexistent-bucket",
+			getByNameErr: store.ErrBucketNotFound,
+			wantErr:      true,
+			wantCode:     codes.NotFound,
+			wantMessage:  "bucket not found",
+			wantLookup:   true,
+		},
+		{
+			name:        "invalid bucket name returns InvalidArgument",
+			bucket:      "Bad!Name",
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidBucketName",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(newBucketRecord(c.bucket, createdAt))
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			resp, err := svc.GetBucket(context.Background(), &servicev1.GetBucketRequest{Name: c.bucket})
+
+			calls := buckets.GetByNameCalls()
+			if c.wantLookup && (len(calls) != 1 || calls[0] != c.bucket) {
+				t.Fatalf("GetByName calls: got %v, want [%s]", calls, c.bucket)
+			}
+			if !c.wantLookup && len(calls) != 0 {
+				t.Fatalf("GetByName calls: got %v, want none", calls)
+			}
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+
+			assertNoError(t, err)
+
+			if got := resp.GetBucket().GetName(); got != c.bucket {
+				t.Fatalf("bucket name: got %q, want %q", got, c.bucket)
+			}
+			if got := resp.GetBucket().GetCreatedAtRfc3339(); got != formatBucketTimestamp(createdAt) {
+				t.Fatalf("bucket created_at: got %q, want %q", got, formatBucketTimestamp(createdAt))
+			}
+		})
+	}
+}
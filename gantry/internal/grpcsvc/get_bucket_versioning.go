@@ -0,0 +1,25 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) GetBucketVersioning(ctx context.Context, req *servicev1.GetBucketVersioningRequest) (*servicev1.GetBucketVersioningResponse, error) {
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	versioning := bucket.Versioning
+	if versioning == "" {
+		versioning = "never enabled"
+	}
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> versioning %s", bucket.Name, versioning)))
+
+	return &servicev1.GetBucketVersioningResponse{Status: bucket.Versioning}, nil
+}
@@ -0,0 +1,57 @@
+package grpcsvc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_GetBucketVersioning(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name         string
+		caller       string
+		versioning   string
+		getByNameErr error
+		wantCode     codes.Code
+		wantMessage  string
+	}
+
+	cases := []tc{
+		{name: "never enabled returns empty status", caller: "user-alice"},
+		{name: "enabled", caller: "user-alice", versioning: "Enabled"},
+		{name: "suspended", caller: "user-alice", versioning: "Suspended"},
+		{name: "anonymous caller returns AccessDenied", versioning: "Enabled", wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "missing bucket returns NoSuchBucket", caller: "user-alice", getByNameErr: store.ErrBucketNotFound, wantCode: codes.NotFound, wantMessage: "NoSuchBucket"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", Versioning: c.versioning})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			resp, err := svc.GetBucketVersioning(callerContext(c.caller), &servicev1.GetBucketVersioningRequest{Bucket: "my-bucket"})
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+			if resp.GetStatus() != c.versioning {
+				t.Fatalf("status: got %q, want %q", resp.GetStatus(), c.versioning)
+			}
+		})
+	}
+}
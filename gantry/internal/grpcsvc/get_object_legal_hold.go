@@ -0,0 +1,39 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// GetObjectLegalHold reports whether one version of an object is under a
+// legal hold.
+func (s *Service) GetObjectLegalHold(ctx context.Context, req *servicev1.GetObjectLegalHoldRequest) (*servicev1.GetObjectLegalHoldResponse, error) {
+	key := req.GetKey()
+	versionID := req.GetVersionId()
+
+	bucket, err := s.subresourceBucket(ctx, req.GetBucket(), key, versionID, policy.ActionGetObjectLegalHold, policy.ActionGetObjectLegalHold)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if err := requireObjectLock(ctx, bucket); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	obj, err := s.subresourceVersion(ctx, bucket, key, versionID)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, subresourceError(err, versionID))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("object %s/%s legal hold %t", bucket.Name, key, obj.Lock.LegalHold)))
+
+	return &servicev1.GetObjectLegalHoldResponse{
+		VersionId: objectVersionID(bucket, obj),
+		On:        obj.Lock.LegalHold,
+	}, nil
+}
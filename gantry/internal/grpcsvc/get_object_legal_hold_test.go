@@ -0,0 +1,86 @@
+package grpcsvc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_GetObjectLegalHold(t *testing.T) {
+	t.Parallel()
+
+	held := store.ObjectRecord{ID: "object-1", Key: "backups/db.tar", State: "COMMITTED", VersionID: "object-1", Lock: store.ObjectLock{LegalHold: true}}
+
+	type tc struct {
+		name          string
+		caller        string
+		policy        string
+		objectLock    *store.ObjectLockConfig
+		versionID     string
+		record        store.ObjectRecord
+		getErr        error
+		wantOn        bool
+		wantVersionID string
+		wantCode      codes.Code
+		wantMessage   string
+	}
+
+	lock := &store.ObjectLockConfig{}
+	cases := []tc{
+		{name: "held version", caller: "user-alice", objectLock: lock, record: held, wantOn: true, wantVersionID: "object-1"},
+		{name: "version without a hold", caller: "user-alice", objectLock: lock, record: store.ObjectRecord{ID: "object-2", State: "COMMITTED", VersionID: "object-2"}, wantVersionID: "object-2"},
+		{name: "named version", caller: "user-alice", objectLock: lock, versionID: "object-1", record: held, wantOn: true, wantVersionID: "object-1"},
+		{
+			name:       "policy grants s3:GetObjectLegalHold",
+			caller:     "user-bob",
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam:::user/bob"},"Action":"s3:GetObjectLegalHold","Resource":"arn:aws:s3:::my-bucket/*"}]}`,
+			objectLock: lock, record: held, wantOn: true, wantVersionID: "object-1",
+		},
+		{name: "bucket without object lock returns InvalidRequest", caller: "user-alice", record: held, wantCode: codes.InvalidArgument, wantMessage: "InvalidRequest"},
+		{name: "other user returns AccessDenied", caller: "user-bob", objectLock: lock, record: held, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "missing key", caller: "user-alice", objectLock: lock, getErr: store.ErrObjectNotFound, wantCode: codes.NotFound, wantMessage: "NoSuchKey"},
+		{name: "delete marker", caller: "user-alice", objectLock: lock, versionID: "marker-1", record: store.ObjectRecord{ID: "marker-1", State: "DELETE_MARKER"}, wantCode: codes.FailedPrecondition, wantMessage: "MethodNotAllowed"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{
+				ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", Policy: c.policy,
+				Versioning: store.VersioningEnabled, ObjectLock: c.objectLock,
+			})
+			objects := testutil.NewFakeObjectStore()
+			objects.SetGetCommittedResponse(c.record)
+			objects.SetGetVersionResponse(c.record)
+			if c.getErr != nil {
+				objects.SetGetCommittedError(c.getErr)
+				objects.SetGetVersionError(c.getErr)
+			}
+			users := testutil.NewFakeUserStore()
+			users.SetGetByIDResponse(store.UserRecord{ID: "user-bob", Name: "bob"})
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets), testutil.WithObjects(objects), testutil.WithUsers(users))
+
+			resp, err := svc.GetObjectLegalHold(callerContext(c.caller), &servicev1.GetObjectLegalHoldRequest{
+				Bucket:    "my-bucket",
+				Key:       "backups/db.tar",
+				VersionId: c.versionID,
+			})
+
+			if c.wantMessage != "" {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+			if resp.GetOn() != c.wantOn || resp.GetVersionId() != c.wantVersionID {
+				t.Fatalf("legal hold: got %v, want on %t in version %q", resp, c.wantOn, c.wantVersionID)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) GetObjectLockConfiguration(ctx context.Context, req *servicev1.GetObjectLockConfigurationRequest) (*servicev1.GetObjectLockConfigurationResponse, error) {
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if bucket.ObjectLock == nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.NotFound, "ObjectLockConfigurationNotFoundError"))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> has object lock enabled", bucket.Name)))
+
+	return &servicev1.GetObjectLockConfigurationResponse{Configuration: objectLockConfig(*bucket.ObjectLock)}, nil
+}
@@ -0,0 +1,64 @@
+package grpcsvc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_GetObjectLockConfiguration(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name         string
+		caller       string
+		objectLock   *store.ObjectLockConfig
+		getByNameErr error
+		wantConfig   *servicev1.ObjectLockConfiguration
+		wantCode     codes.Code
+		wantMessage  string
+	}
+
+	cases := []tc{
+		{
+			name:       "owner reads the default retention",
+			caller:     "user-alice",
+			objectLock: &store.ObjectLockConfig{DefaultMode: store.RetentionCompliance, DefaultDays: 90},
+			wantConfig: &servicev1.ObjectLockConfiguration{DefaultMode: store.RetentionCompliance, DefaultDays: 90},
+		},
+		{name: "object lock without a default", caller: "user-alice", objectLock: &store.ObjectLockConfig{}, wantConfig: &servicev1.ObjectLockConfiguration{}},
+		{name: "bucket without object lock returns ObjectLockConfigurationNotFoundError", caller: "user-alice", wantCode: codes.NotFound, wantMessage: "ObjectLockConfigurationNotFoundError"},
+		{name: "other user returns AccessDenied", caller: "user-bob", objectLock: &store.ObjectLockConfig{}, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "missing bucket returns NoSuchBucket", caller: "user-alice", getByNameErr: store.ErrBucketNotFound, wantCode: codes.NotFound, wantMessage: "NoSuchBucket"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", ObjectLock: c.objectLock})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			resp, err := svc.GetObjectLockConfiguration(callerContext(c.caller), &servicev1.GetObjectLockConfigurationRequest{Bucket: "my-bucket"})
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+			if !proto.Equal(resp.GetConfiguration(), c.wantConfig) {
+				t.Fatalf("configuration: got %v, want %v", resp.GetConfiguration(), c.wantConfig)
+			}
+		})
+	}
+}
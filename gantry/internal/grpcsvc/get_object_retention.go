@@ -0,0 +1,48 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// GetObjectRetention returns the retention of one version of an object.
+func (s *Service) GetObjectRetention(ctx context.Context, req *servicev1.GetObjectRetentionRequest) (*servicev1.GetObjectRetentionResponse, error) {
+	key := req.GetKey()
+	versionID := req.GetVersionId()
+
+	bucket, err := s.subresourceBucket(ctx, req.GetBucket(), key, versionID, policy.ActionGetObjectRetention, policy.ActionGetObjectRetention)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if err := requireObjectLock(ctx, bucket); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	obj, err := s.subresourceVersion(ctx, bucket, key, versionID)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, subresourceError(err, versionID))
+	}
+
+	if obj.Lock.Mode == "" || !obj.Lock.RetainUntil.After(time.Now()) {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.NotFound, "NoSuchObjectLockConfiguration"))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("object %s/%s retained in %s mode until %s",
+		bucket.Name, key, obj.Lock.Mode, obj.Lock.RetainUntil.Format(time.RFC3339))))
+
+	return &servicev1.GetObjectRetentionResponse{
+		VersionId:     objectVersionID(bucket, obj),
+		Mode:          obj.Lock.Mode,
+		RetainUntilMs: obj.Lock.RetainUntil.UnixMilli(),
+	}, nil
+}
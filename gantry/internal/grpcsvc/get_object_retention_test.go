@@ -0,0 +1,102 @@
+package grpcsvc
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_GetObjectRetention(t *testing.T) {
+	t.Parallel()
+
+	future := time.Now().UTC().AddDate(0, 0, 90).Truncate(time.Millisecond)
+	retained := store.ObjectRecord{
+		ID: "object-1", Key: "backups/db.tar", State: "COMMITTED", VersionID: "object-1",
+		Lock: store.ObjectLock{Mode: store.RetentionCompliance, RetainUntil: future},
+	}
+
+	type tc struct {
+		name          string
+		caller        string
+		policy        string
+		objectLock    *store.ObjectLockConfig
+		versionID     string
+		record        store.ObjectRecord
+		getErr        error
+		wantMode      string
+		wantVersionID string
+		wantCode      codes.Code
+		wantMessage   string
+	}
+
+	lock := &store.ObjectLockConfig{}
+	cases := []tc{
+		{name: "current version", caller: "user-alice", objectLock: lock, record: retained, wantMode: store.RetentionCompliance, wantVersionID: "object-1"},
+		{name: "named version", caller: "user-alice", objectLock: lock, versionID: "object-1", record: retained, wantMode: store.RetentionCompliance, wantVersionID: "object-1"},
+		{
+			name:       "policy grants s3:GetObjectRetention",
+			caller:     "user-bob",
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam:::user/bob"},"Action":"s3:GetObjectRetention","Resource":"arn:aws:s3:::my-bucket/*"}]}`,
+			objectLock: lock, record: retained, wantMode: store.RetentionCompliance, wantVersionID: "object-1",
+		},
+		{name: "version without retention returns NoSuchObjectLockConfiguration", caller: "user-alice", objectLock: lock, record: store.ObjectRecord{ID: "object-2", State: "COMMITTED"}, wantCode: codes.NotFound, wantMessage: "NoSuchObjectLockConfiguration"},
+		{
+			name:        "expired retention returns NoSuchObjectLockConfiguration",
+			caller:      "user-alice",
+			objectLock:  lock,
+			record:      store.ObjectRecord{ID: "object-2", State: "COMMITTED", Lock: store.ObjectLock{Mode: store.RetentionGovernance, RetainUntil: time.Now().Add(-time.Hour)}},
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchObjectLockConfiguration",
+		},
+		{name: "bucket without object lock returns InvalidRequest", caller: "user-alice", record: retained, wantCode: codes.InvalidArgument, wantMessage: "InvalidRequest"},
+		{name: "other user returns AccessDenied", caller: "user-bob", objectLock: lock, record: retained, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "missing key", caller: "user-alice", objectLock: lock, getErr: store.ErrObjectNotFound, wantCode: codes.NotFound, wantMessage: "NoSuchKey"},
+		{name: "missing version", caller: "user-alice", objectLock: lock, versionID: "object-9", getErr: store.ErrObjectNotFound, wantCode: codes.NotFound, wantMessage: "NoSuchVersion"},
+		{name: "delete marker", caller: "user-alice", objectLock: lock, versionID: "marker-1", record: store.ObjectRecord{ID: "marker-1", State: "DELETE_MARKER"}, wantCode: codes.FailedPrecondition, wantMessage: "MethodNotAllowed"},
+		{name: "store error returns Internal", caller: "user-alice", objectLock: lock, getErr: errors.New("disk I/O error"), wantCode: codes.Internal, wantMessage: "disk I/O error"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{
+				ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", Policy: c.policy,
+				Versioning: store.VersioningEnabled, ObjectLock: c.objectLock,
+			})
+			objects := testutil.NewFakeObjectStore()
+			objects.SetGetCommittedResponse(c.record)
+			objects.SetGetVersionResponse(c.record)
+			if c.getErr != nil {
+				objects.SetGetCommittedError(c.getErr)
+				objects.SetGetVersionError(c.getErr)
+			}
+			users := testutil.NewFakeUserStore()
+			users.SetGetByIDResponse(store.UserRecord{ID: "user-bob", Name: "bob"})
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets), testutil.WithObjects(objects), testutil.WithUsers(users))
+
+			resp, err := svc.GetObjectRetention(callerContext(c.caller), &servicev1.GetObjectRetentionRequest{
+				Bucket:    "my-bucket",
+				Key:       "backups/db.tar",
+				VersionId: c.versionID,
+			})
+
+			if c.wantMessage != "" {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+			if resp.GetMode() != c.wantMode || resp.GetRetainUntilMs() != future.UnixMilli() || resp.GetVersionId() != c.wantVersionID {
+				t.Fatalf("retention: got %v, want %s until %d in version %q", resp, c.wantMode, future.UnixMilli(), c.wantVersionID)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// GetObjectTagging returns the tags of one version of an object.
+func (s *Service) GetObjectTagging(ctx context.Context, req *servicev1.GetObjectTaggingRequest) (*servicev1.GetObjectTaggingResponse, error) {
+	key := req.GetKey()
+	versionID := req.GetVersionId()
+
+	bucket, err := s.subresourceBucket(ctx, req.GetBucket(), key, versionID, policy.ActionGetObjectTagging, policy.ActionGetObjectVersionTagging)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	obj, err := s.subresourceVersion(ctx, bucket, key, versionID)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, subresourceError(err, versionID))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("object %s/%s has %d tags", bucket.Name, key, len(obj.Tags))))
+
+	return &servicev1.GetObjectTaggingResponse{
+		VersionId: objectVersionID(bucket, obj),
+		Tags:      obj.Tags,
+	}, nil
+}
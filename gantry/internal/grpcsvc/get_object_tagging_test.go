@@ -0,0 +1,92 @@
+package grpcsvc
+
+import (
+	"errors"
+	"maps"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_GetObjectTagging(t *testing.T) {
+	t.Parallel()
+
+	tagged := store.ObjectRecord{ID: "object-1", Key: "backups/db.tar", State: "COMMITTED", VersionID: "object-1", Tags: map[string]string{"host": "web-1"}}
+
+	type tc struct {
+		name          string
+		caller        string
+		policy        string
+		versioning    string
+		versionID     string
+		record        store.ObjectRecord
+		getErr        error
+		wantTags      map[string]string
+		wantVersionID string
+		wantCode      codes.Code
+		wantMessage   string
+	}
+
+	cases := []tc{
+		{name: "current version", caller: "user-alice", record: tagged, wantTags: map[string]string{"host": "web-1"}},
+		{name: "named version", caller: "user-alice", versioning: store.VersioningEnabled, versionID: "object-1", record: tagged, wantTags: map[string]string{"host": "web-1"}, wantVersionID: "object-1"},
+		{name: "untagged object", caller: "user-alice", record: store.ObjectRecord{ID: "object-2", State: "COMMITTED"}},
+		{name: "other user returns AccessDenied", caller: "user-bob", record: tagged, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{
+			name:     "policy grants s3:GetObjectTagging",
+			caller:   "user-bob",
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam:::user/bob"},"Action":"s3:GetObjectTagging","Resource":"arn:aws:s3:::my-bucket/*"}]}`,
+			record:   tagged,
+			wantTags: map[string]string{"host": "web-1"},
+		},
+		{name: "missing key", caller: "user-alice", getErr: store.ErrObjectNotFound, wantCode: codes.NotFound, wantMessage: "NoSuchKey"},
+		{name: "missing version", caller: "user-alice", versionID: "object-9", getErr: store.ErrObjectNotFound, wantCode: codes.NotFound, wantMessage: "NoSuchVersion"},
+		{name: "delete marker", caller: "user-alice", versionID: "marker-1", record: store.ObjectRecord{ID: "marker-1", State: "DELETE_MARKER"}, wantCode: codes.FailedPrecondition, wantMessage: "MethodNotAllowed"},
+		{name: "store error returns Internal", caller: "user-alice", getErr: errors.New("disk I/O error"), wantCode: codes.Internal, wantMessage: "disk I/O error"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", Policy: c.policy, Versioning: c.versioning})
+			objects := testutil.NewFakeObjectStore()
+			objects.SetGetCommittedResponse(c.record)
+			objects.SetGetVersionResponse(c.record)
+			if c.getErr != nil {
+				objects.SetGetCommittedError(c.getErr)
+				objects.SetGetVersionError(c.getErr)
+			}
+			users := testutil.NewFakeUserStore()
+			users.SetGetByIDResponse(store.UserRecord{ID: "user-bob", Name: "bob"})
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets), testutil.WithObjects(objects), testutil.WithUsers(users))
+
+			resp, err := svc.GetObjectTagging(callerContext(c.caller), &servicev1.GetObjectTaggingRequest{
+				Bucket:    "my-bucket",
+				Key:       "backups/db.tar",
+				VersionId: c.versionID,
+			})
+
+			if c.wantMessage != "" {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+			if !maps.Equal(resp.GetTags(), c.wantTags) {
+				t.Fatalf("tags: got %v, want %v", resp.GetTags(), c.wantTags)
+			}
+			if resp.GetVersionId() != c.wantVersionID {
+				t.Fatalf("version ID: got %q, want %q", resp.GetVersionId(), c.wantVersionID)
+			}
+			if c.versionID != "" && len(objects.GetVersionCalls()) != 1 {
+				t.Fatalf("GetVersion calls: got %d, want 1", len(objects.GetVersionCalls()))
+			}
+		})
+	}
+}
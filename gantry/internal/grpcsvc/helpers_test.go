@@ -1,18 +1,33 @@
 package grpcsvc
 
 import (
+	"context"
 	"io"
 	"log/slog"
 	"testing"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
 )
 
 func newDiscardLogger() *slog.Logger {
 	return slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
 }
 
+// callerContext returns a context carrying the caller flatbed authenticated,
+// or no caller at all when userID is empty.
+func callerContext(userID string) context.Context {
+	ctx := context.Background()
+	if userID == "" {
+		return ctx
+	}
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(CallerMetadataKey, userID))
+}
+
 func assertNoError(t *testing.T, err error) {
 	t.Helper()
 
@@ -41,3 +56,21 @@ func assertGRPCError(t *testing.T, err error, code codes.Code, message string) {
 		t.Fatalf("status message: got %q, want %q", st.Message(), message)
 	}
 }
+
+// newUploadFakes returns bucket and multipart fakes holding an IN_PROGRESS
+// upload "upload-id-1" of my-bucket/videos/big.mp4.
+func newUploadFakes() (*testutil.BucketStoreFake, *testutil.MultipartStoreFake) {
+	buckets := testutil.NewFakeBucketStore()
+	buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket"})
+
+	uploads := testutil.NewFakeMultipartStore()
+	uploads.SetUpload(store.MultipartUploadRecord{
+		ID:          "upload-id-1",
+		BucketID:    "bucket-id-123",
+		Key:         "videos/big.mp4",
+		State:       "IN_PROGRESS",
+		ContentType: "video/mp4",
+	})
+
+	return buckets, uploads
+}
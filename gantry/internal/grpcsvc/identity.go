@@ -0,0 +1,67 @@
+package grpcsvc
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"regexp"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// accessKeyIDPrefix marks Block Closet keys the way AWS keys start with
+// AKIA. With 18 random base32 characters the ID is 20 long, like AWS's.
+const accessKeyIDPrefix = "BC"
+
+// userNamePattern is the IAM user name syntax.
+var userNamePattern = regexp.MustCompile(`^[\w+=,.@-]{1,64}$`)
+
+var errNoSuchEntity = status.Error(codes.NotFound, "NoSuchEntity")
+
+// newAccessKey generates an access key ID and a 40-character secret.
+func newAccessKey() (accessKeyID, secret string) {
+	id := make([]byte, 15)
+	key := make([]byte, 30)
+	if _, err := rand.Read(id); err != nil {
+		panic(err)
+	}
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+
+	encoded := base32.StdEncoding.EncodeToString(id)
+	return accessKeyIDPrefix + encoded[:18], base64.StdEncoding.EncodeToString(key)
+}
+
+func userToProto(rec store.UserRecord) *servicev1.User {
+	return &servicev1.User{
+		Id:        rec.ID,
+		Name:      rec.Name,
+		CreatedMs: rec.CreatedAt.UnixMilli(),
+	}
+}
+
+func accessKeyToProto(rec store.AccessKeyRecord) *servicev1.AccessKey {
+	return &servicev1.AccessKey{
+		AccessKeyId: rec.AccessKeyID,
+		UserId:      rec.UserID,
+		UserName:    rec.UserName,
+		Status:      accessKeyStatusToProto(rec.Status),
+		CreatedMs:   rec.CreatedAt.UnixMilli(),
+	}
+}
+
+func accessKeyStatusToProto(s string) servicev1.AccessKeyStatus {
+	switch s {
+	case store.AccessKeyActive:
+		return servicev1.AccessKeyStatus_ACCESS_KEY_STATUS_ACTIVE
+	case store.AccessKeyInactive:
+		return servicev1.AccessKeyStatus_ACCESS_KEY_STATUS_INACTIVE
+	default:
+		return servicev1.AccessKeyStatus_ACCESS_KEY_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,39 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) ListAccessKeys(ctx context.Context, req *servicev1.ListAccessKeysRequest) (*servicev1.ListAccessKeysResponse, error) {
+	var userID string
+	if userName := req.GetUserName(); userName != "" {
+		user, err := s.store.Users().GetByName(ctx, userName)
+		if err != nil {
+			if errors.Is(err, store.ErrUserNotFound) {
+				return nil, loggrpc.SetError(ctx, errNoSuchEntity)
+			}
+			return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+		}
+		userID = user.ID
+	}
+
+	records, err := s.store.AccessKeys().List(ctx, userID)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	resp := &servicev1.ListAccessKeysResponse{}
+	for _, rec := range records {
+		resp.AccessKeys = append(resp.AccessKeys, accessKeyToProto(rec))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,105 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_ListAccessKeys(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	type tc struct {
+		name        string
+		userName    string
+		getUserErr  error
+		listErr     error
+		wantCode    codes.Code
+		wantMessage string
+		wantUserID  string
+		wantList    bool
+	}
+
+	cases := []tc{
+		{
+			name:     "lists every user's keys",
+			wantList: true,
+		},
+		{
+			name:       "lists one user's keys",
+			userName:   "alice",
+			wantUserID: "user-alice",
+			wantList:   true,
+		},
+		{
+			name:        "unknown user returns NotFound",
+			userName:    "nobody",
+			getUserErr:  store.ErrUserNotFound,
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchEntity",
+		},
+		{
+			name:        "store failure returns Internal",
+			listErr:     errors.New("list failed"),
+			wantCode:    codes.Internal,
+			wantMessage: "list failed",
+			wantList:    true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			users := testutil.NewFakeUserStore()
+			users.SetGetByNameResponse(store.UserRecord{ID: "user-alice", Name: "alice"})
+			users.SetGetByNameError(c.getUserErr)
+			keys := testutil.NewFakeAccessKeyStore()
+			keys.SetListRecords([]store.AccessKeyRecord{
+				{AccessKeyID: "AKIDONE", UserID: "user-alice", UserName: "alice", Status: store.AccessKeyActive, CreatedAt: base},
+				{AccessKeyID: "AKIDTWO", UserID: "user-alice", UserName: "alice", Status: store.AccessKeyInactive, CreatedAt: base},
+			})
+			keys.SetListError(c.listErr)
+			svc.store = testutil.NewFakeStore(testutil.WithUsers(users), testutil.WithAccessKeys(keys))
+
+			resp, err := svc.ListAccessKeys(context.Background(), &servicev1.ListAccessKeysRequest{UserName: c.userName})
+
+			calls := keys.ListCalls()
+			if c.wantList != (len(calls) == 1) {
+				t.Fatalf("List calls: got %v, want called=%v", calls, c.wantList)
+			}
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+
+			if calls[0] != c.wantUserID {
+				t.Fatalf("List user: got %q, want %q", calls[0], c.wantUserID)
+			}
+
+			got := resp.GetAccessKeys()
+			if len(got) != 2 {
+				t.Fatalf("access keys: got %d, want 2", len(got))
+			}
+			if got[0].GetStatus() != servicev1.AccessKeyStatus_ACCESS_KEY_STATUS_ACTIVE ||
+				got[1].GetStatus() != servicev1.AccessKeyStatus_ACCESS_KEY_STATUS_INACTIVE {
+				t.Fatalf("statuses: got %v, %v", got[0].GetStatus(), got[1].GetStatus())
+			}
+			if got[0].GetCreatedMs() != base.UnixMilli() {
+				t.Fatalf("created_ms: got %d, want %d", got[0].GetCreatedMs(), base.UnixMilli())
+			}
+		})
+	}
+}
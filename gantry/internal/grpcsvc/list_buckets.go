@@ -19,8 +19,15 @@ func (s *Service) ListBuckets(ctx context.Context, _ *servicev1.ListBucketsReque
 		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
 	}
 
+	// Like S3, a caller only sees the buckets it owns. An anonymous caller
+	// sees the buckets nobody owns, unless the service requires an owner.
+	caller := callerID(ctx)
+
 	resp := &servicev1.ListBucketsResponse{}
 	for _, rec := range records {
+		if rec.OwnerID != caller || (caller == "" && s.requireOwner) {
+			continue
+		}
 		resp.Buckets = append(resp.Buckets, &bucketv1.Bucket{
 			Name:             rec.Name,
 			CreatedAtRfc3339: rec.CreatedAt.UTC().Format("2006-01-02T15:04:05.000000Z"),
@@ -1,7 +1,6 @@
 package grpcsvc
 
 import (
-	"context"
 	"errors"
 	"testing"
 	"time"
@@ -20,6 +19,8 @@ func TestService_ListBuckets(t *testing.T) {
 
 	type tc struct {
 		name            string
+		caller          string
+		requireOwner    bool
 		records         []store.BucketRecord
 		listErr         error
 		wantErr         bool
@@ -53,6 +54,38 @@ func TestService_ListBuckets(t *testing.T) {
 			},
 			expectStoreCall: true,
 		},
+		{
+			name:   "returns only the caller's buckets",
+			caller: "user-alice",
+			records: []store.BucketRecord{
+				ownedBucketRecord("alice-bucket", "user-alice", base),
+				ownedBucketRecord("bob-bucket", "user-bob", base),
+				newBucketRecord("anonymous-bucket", base),
+			},
+			wantNames:       []string{"alice-bucket"},
+			wantTimestamps:  []string{formatBucketTimestamp(base)},
+			expectStoreCall: true,
+		},
+		{
+			name: "anonymous caller sees unowned buckets",
+			records: []store.BucketRecord{
+				ownedBucketRecord("alice-bucket", "user-alice", base),
+				newBucketRecord("anonymous-bucket", base),
+			},
+			wantNames:       []string{"anonymous-bucket"},
+			wantTimestamps:  []string{formatBucketTimestamp(base)},
+			expectStoreCall: true,
+		},
+		{
+			name:         "required owner hides unowned buckets from anonymous callers",
+			requireOwner: true,
+			records: []store.BucketRecord{
+				newBucketRecord("anonymous-bucket", base),
+			},
+			wantNames:       nil,
+			wantTimestamps:  nil,
+			expectStoreCall: true,
+		},
 		{
 			name:            "store error surfaces as internal",
 			records:         nil,
@@ -69,7 +102,8 @@ func TestService_ListBuckets(t *testing.T) {
 			t.Parallel()
 
 			logger := newDiscardLogger()
-			svc := New(logger, nil)
+			svc := New(logger, nil, nil)
+			svc.requireOwner = c.requireOwner
 
 			buckets := testutil.NewFakeBucketStore()
 			buckets.SetListRecords(c.records)
@@ -77,7 +111,7 @@ func TestService_ListBuckets(t *testing.T) {
 
 			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
 
-			resp, err := svc.ListBuckets(context.Background(), &servicev1.ListBucketsRequest{})
+			resp, err := svc.ListBuckets(callerContext(c.caller), &servicev1.ListBucketsRequest{})
 
 			assertListInvocation(t, buckets, c.expectStoreCall)
 
@@ -95,6 +129,12 @@ func TestService_ListBuckets(t *testing.T) {
 	}
 }
 
+func ownedBucketRecord(name, ownerID string, createdAt time.Time) store.BucketRecord {
+	rec := newBucketRecord(name, createdAt)
+	rec.OwnerID = ownerID
+	return rec
+}
+
 func newBucketRecord(name string, createdAt time.Time) store.BucketRecord {
 	return store.BucketRecord{
 		ID:        name + "-id",
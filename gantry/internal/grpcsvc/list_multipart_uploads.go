@@ -0,0 +1,115 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+const maxListUploads = 1000
+
+func (s *Service) ListMultipartUploads(ctx context.Context, req *servicev1.ListMultipartUploadsRequest) (*servicev1.ListMultipartUploadsResponse, error) {
+	bucketName := req.GetBucket()
+	prefix := req.GetPrefix()
+	delimiter := req.GetDelimiter()
+
+	validator := validation.DefaultBucketNameValidator{}
+
+	if err := validator.ValidateBucketName(bucketName); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	maxUploads := maxListUploads
+	if req.MaxUploads != nil {
+		if req.GetMaxUploads() < 0 {
+			return nil, status.Error(codes.InvalidArgument, "InvalidArgument")
+		}
+		maxUploads = min(int(req.GetMaxUploads()), maxListUploads)
+	}
+
+	// A key marker that is itself a common prefix, as returned for a page
+	// ending in a roll-up, resumes past every key under it.
+	afterKey := req.GetKeyMarker()
+	afterUploadID := req.GetUploadIdMarker()
+	if afterUploadID == "" && strings.HasPrefix(afterKey, prefix) {
+		if cp, ok := commonPrefix(afterKey, prefix, delimiter); ok && cp == afterKey {
+			afterKey = cp + store.KeysetCeiling
+		}
+	}
+
+	if err := checkTestBucket(bucketName); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	bucket, err := s.store.Buckets().GetByName(ctx, bucketName)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, objectLookupError(servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, bucketName, "", err))
+	}
+
+	if err := s.authorize(ctx, bucket, policy.ActionListBucketMultipartUploads, ""); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	resp := &servicev1.ListMultipartUploadsResponse{}
+	entries := 0
+	var nextKeyMarker, nextUploadIDMarker string
+
+	// Paging works as in ListObjects, with (key, upload ID) as the cursor.
+	for maxUploads > 0 {
+		limit := maxUploads - entries + 1
+		uploads, err := s.store.Multipart().ListInProgress(ctx, bucket.ID, prefix, afterKey, afterUploadID, limit)
+		if err != nil {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+		}
+
+		rolledUp := false
+		for _, upload := range uploads {
+			if entries == maxUploads {
+				resp.IsTruncated = true
+				break
+			}
+
+			entries++
+
+			if cp, ok := commonPrefix(upload.Key, prefix, delimiter); ok {
+				resp.CommonPrefixes = append(resp.CommonPrefixes, cp)
+				nextKeyMarker, nextUploadIDMarker = cp, ""
+				afterKey, afterUploadID = cp+store.KeysetCeiling, ""
+				rolledUp = true
+				break
+			}
+
+			resp.Uploads = append(resp.Uploads, &servicev1.MultipartUpload{
+				Key:         upload.Key,
+				UploadId:    upload.ID,
+				InitiatedMs: upload.CreatedAt.UnixMilli(),
+			})
+			nextKeyMarker, nextUploadIDMarker = upload.Key, upload.ID
+			afterKey, afterUploadID = upload.Key, upload.ID
+		}
+
+		if resp.IsTruncated || !rolledUp {
+			break
+		}
+	}
+
+	if resp.IsTruncated {
+		resp.NextKeyMarker = nextKeyMarker
+		resp.NextUploadIdMarker = nextUploadIDMarker
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("listed %d uploads and %d common prefixes in bucket <%s>",
+		len(resp.Uploads), len(resp.CommonPrefixes), bucketName)))
+
+	return resp, nil
+}
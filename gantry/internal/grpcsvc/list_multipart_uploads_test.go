@@ -0,0 +1,188 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_ListMultipartUploads(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	// Seeded in key then upload ID order, as the store returns them.
+	uploads := []store.MultipartUploadRecord{
+		{ID: "upload-1", Key: "a.img"},
+		{ID: "upload-2", Key: "backups/2024/jan.img"},
+		{ID: "upload-3", Key: "backups/2024/jan.img"},
+		{ID: "upload-4", Key: "backups/2025/jan.img"},
+		{ID: "upload-5", Key: "backups/full.img"},
+	}
+	for i := range uploads {
+		uploads[i].BucketID = "bucket-id-123"
+		uploads[i].State = "IN_PROGRESS"
+		uploads[i].CreatedAt = created
+	}
+
+	type tc struct {
+		name             string
+		req              *servicev1.ListMultipartUploadsRequest
+		getByNameErr     error
+		listErr          error
+		wantUploads      []string
+		wantPrefixes     []string
+		wantTrunc        bool
+		wantNextKey      string
+		wantNextUploadID string
+		wantErr          bool
+		wantCode         codes.Code
+		wantMessage      string
+	}
+
+	cases := []tc{
+		{
+			name:        "lists every upload",
+			req:         &servicev1.ListMultipartUploadsRequest{},
+			wantUploads: []string{"upload-1", "upload-2", "upload-3", "upload-4", "upload-5"},
+		},
+		{
+			name:         "delimiter rolls up common prefixes",
+			req:          &servicev1.ListMultipartUploadsRequest{Prefix: "backups/", Delimiter: "/"},
+			wantUploads:  []string{"upload-5"},
+			wantPrefixes: []string{"backups/2024/", "backups/2025/"},
+		},
+		{
+			name:             "max uploads truncates with markers",
+			req:              &servicev1.ListMultipartUploadsRequest{MaxUploads: proto.Int32(2)},
+			wantUploads:      []string{"upload-1", "upload-2"},
+			wantTrunc:        true,
+			wantNextKey:      "backups/2024/jan.img",
+			wantNextUploadID: "upload-2",
+		},
+		{
+			name: "markers resume within a key",
+			req: &servicev1.ListMultipartUploadsRequest{
+				KeyMarker:      "backups/2024/jan.img",
+				UploadIdMarker: "upload-2",
+			},
+			wantUploads: []string{"upload-3", "upload-4", "upload-5"},
+		},
+		{
+			name:        "key marker alone skips the whole key",
+			req:         &servicev1.ListMultipartUploadsRequest{KeyMarker: "backups/2024/jan.img"},
+			wantUploads: []string{"upload-4", "upload-5"},
+		},
+		{
+			name:         "page ending in a common prefix returns it as the key marker",
+			req:          &servicev1.ListMultipartUploadsRequest{Prefix: "backups/", Delimiter: "/", MaxUploads: proto.Int32(1)},
+			wantPrefixes: []string{"backups/2024/"},
+			wantTrunc:    true,
+			wantNextKey:  "backups/2024/",
+		},
+		{
+			name:         "common prefix key marker skips the keys under it",
+			req:          &servicev1.ListMultipartUploadsRequest{Prefix: "backups/", Delimiter: "/", KeyMarker: "backups/2024/"},
+			wantUploads:  []string{"upload-5"},
+			wantPrefixes: []string{"backups/2025/"},
+		},
+		{
+			name:        "negative max uploads returns InvalidArgument",
+			req:         &servicev1.ListMultipartUploadsRequest{MaxUploads: proto.Int32(-1)},
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidArgument",
+		},
+		{
+			name:        "invalid bucket name returns InvalidArgument",
+			req:         &servicev1.ListMultipartUploadsRequest{Bucket: "Bad!Name"},
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidBucketName",
+		},
+		{
+			name:         "bucket not found returns NotFound",
+			req:          &servicev1.ListMultipartUploadsRequest{},
+			getByNameErr: store.ErrBucketNotFound,
+			wantErr:      true,
+			wantCode:     codes.NotFound,
+			wantMessage:  "bucket not found",
+		},
+		{
+			name:        "store error returns Internal",
+			req:         &servicev1.ListMultipartUploadsRequest{},
+			listErr:     errors.New("list multipart uploads: disk I/O error"),
+			wantErr:     true,
+			wantCode:    codes.Internal,
+			wantMessage: "list multipart uploads: disk I/O error",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket"})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+
+			multipart := testutil.NewFakeMultipartStore()
+			multipart.SetListInProgressResponse(uploads)
+			if c.listErr != nil {
+				multipart.SetListInProgressError(c.listErr)
+			}
+
+			svc.store = testutil.NewFakeStore(
+				testutil.WithBuckets(buckets),
+				testutil.WithMultipart(multipart),
+			)
+
+			if c.req.Bucket == "" {
+				c.req.Bucket = "my-bucket"
+			}
+
+			resp, err := svc.ListMultipartUploads(context.Background(), c.req)
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+
+			assertNoError(t, err)
+
+			gotUploads := make([]string, 0, len(resp.GetUploads()))
+			for _, u := range resp.GetUploads() {
+				gotUploads = append(gotUploads, u.GetUploadId())
+				if u.GetInitiatedMs() != created.UnixMilli() {
+					t.Fatalf("initiated_ms: got %d, want %d", u.GetInitiatedMs(), created.UnixMilli())
+				}
+			}
+			if !slices.Equal(gotUploads, c.wantUploads) {
+				t.Fatalf("uploads: got %v, want %v", gotUploads, c.wantUploads)
+			}
+			if !slices.Equal(resp.GetCommonPrefixes(), c.wantPrefixes) {
+				t.Fatalf("common prefixes: got %v, want %v", resp.GetCommonPrefixes(), c.wantPrefixes)
+			}
+			if resp.GetIsTruncated() != c.wantTrunc {
+				t.Fatalf("is_truncated: got %v, want %v", resp.GetIsTruncated(), c.wantTrunc)
+			}
+			if resp.GetNextKeyMarker() != c.wantNextKey || resp.GetNextUploadIdMarker() != c.wantNextUploadID {
+				t.Fatalf("next markers: got %q/%q, want %q/%q",
+					resp.GetNextKeyMarker(), resp.GetNextUploadIdMarker(), c.wantNextKey, c.wantNextUploadID)
+			}
+		})
+	}
+}
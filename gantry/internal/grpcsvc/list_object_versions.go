@@ -0,0 +1,137 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	objectv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/object/v1"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) ListObjectVersions(ctx context.Context, req *servicev1.ListObjectVersionsRequest) (*servicev1.ListObjectVersionsResponse, error) {
+	bucketName := req.GetBucket()
+	prefix := req.GetPrefix()
+	delimiter := req.GetDelimiter()
+
+	validator := validation.DefaultBucketNameValidator{}
+
+	if err := validator.ValidateBucketName(bucketName); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	maxKeys := maxListKeys
+	if req.MaxKeys != nil {
+		if req.GetMaxKeys() < 0 {
+			return nil, status.Error(codes.InvalidArgument, "InvalidArgument")
+		}
+		maxKeys = min(int(req.GetMaxKeys()), maxListKeys)
+	}
+
+	// As in S3, a version ID marker only means something with a key marker.
+	afterKey := req.GetKeyMarker()
+	afterVersionID := req.GetVersionIdMarker()
+	if afterVersionID != "" && afterKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "InvalidArgument")
+	}
+	if afterVersionID == "" && strings.HasPrefix(afterKey, prefix) {
+		if cp, ok := commonPrefix(afterKey, prefix, delimiter); ok && cp == afterKey {
+			afterKey = cp + store.KeysetCeiling
+		}
+	}
+
+	if err := checkTestBucket(bucketName); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	bucket, err := s.store.Buckets().GetByName(ctx, bucketName)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, objectLookupError(servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, bucketName, "", err))
+	}
+
+	if err := s.authorize(ctx, bucket, policy.ActionListBucketVersions, ""); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	resp := &servicev1.ListObjectVersionsResponse{}
+	entries := 0
+	var nextKeyMarker, nextVersionIDMarker string
+
+	// Paging works as in ListObjects, with (key, version ID) as the cursor.
+	for maxKeys > 0 {
+		limit := maxKeys - entries + 1
+		versions, err := s.store.Objects().ListVersions(ctx, bucket.ID, prefix, afterKey, afterVersionID, limit)
+		if err != nil {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+		}
+
+		rolledUp := false
+		for _, v := range versions {
+			if entries == maxKeys {
+				resp.IsTruncated = true
+				break
+			}
+
+			entries++
+
+			if cp, ok := commonPrefix(v.Key, prefix, delimiter); ok {
+				resp.CommonPrefixes = append(resp.CommonPrefixes, cp)
+				nextKeyMarker, nextVersionIDMarker = cp, ""
+				afterKey, afterVersionID = cp+store.KeysetCeiling, ""
+				rolledUp = true
+				break
+			}
+
+			resp.Versions = append(resp.Versions, objectVersion(v))
+			nextKeyMarker, nextVersionIDMarker = v.Key, v.VersionID
+			afterKey, afterVersionID = v.Key, v.VersionID
+		}
+
+		if resp.IsTruncated || !rolledUp {
+			break
+		}
+	}
+
+	if resp.IsTruncated {
+		resp.NextKeyMarker = nextKeyMarker
+		resp.NextVersionIdMarker = nextVersionIDMarker
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("listed %d versions and %d common prefixes in bucket <%s>",
+		len(resp.Versions), len(resp.CommonPrefixes), bucketName)))
+
+	return resp, nil
+}
+
+// objectVersion is a ListObjectVersions entry for v. A delete marker has no
+// data, so only its identity and age are reported.
+func objectVersion(v store.ObjectRecord) *objectv1.Object {
+	if v.State == "DELETE_MARKER" {
+		return &objectv1.Object{
+			ObjectId:       v.ID,
+			Key:            v.Key,
+			LastModifiedMs: v.LastModifiedMs,
+			VersionId:      v.VersionID,
+			IsLatest:       v.IsLatest,
+			DeleteMarker:   true,
+		}
+	}
+	return &objectv1.Object{
+		ObjectId:       v.ID,
+		Key:            v.Key,
+		Size:           v.SizeActual,
+		LastModifiedMs: v.LastModifiedMs,
+		ContentType:    v.ContentType,
+		Etag:           v.ETag,
+		VersionId:      v.VersionID,
+		IsLatest:       v.IsLatest,
+	}
+}
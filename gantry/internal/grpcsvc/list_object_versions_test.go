@@ -0,0 +1,205 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_ListObjectVersions(t *testing.T) {
+	t.Parallel()
+
+	// Seeded by key and then newest first, as the store returns them.
+	versions := []store.ObjectRecord{
+		{ID: "obj-1", Key: "a.txt", State: "COMMITTED", VersionID: "v3", IsLatest: true, SizeActual: 30, ETag: "etag-3"},
+		{ID: "obj-2", Key: "a.txt", State: "NONCURRENT", VersionID: "v2", SizeActual: 20, ETag: "etag-2"},
+		{ID: "obj-3", Key: "a.txt", State: "NONCURRENT", VersionID: "null", SizeActual: 10, ETag: "etag-1"},
+		{ID: "obj-4", Key: "docs/2024/report.pdf", State: "COMMITTED", VersionID: "v4", IsLatest: true},
+		{ID: "obj-5", Key: "docs/2025/report.pdf", State: "COMMITTED", VersionID: "v5", IsLatest: true},
+		{ID: "obj-6", Key: "docs/notes.txt", State: "DELETE_MARKER", VersionID: "v7", IsLatest: true, LastModifiedMs: 1700000000000},
+		{ID: "obj-7", Key: "docs/notes.txt", State: "NONCURRENT", VersionID: "v6"},
+	}
+	for i := range versions {
+		versions[i].BucketID = "bucket-id-123"
+	}
+
+	type tc struct {
+		name              string
+		req               *servicev1.ListObjectVersionsRequest
+		getByNameErr      error
+		listErr           error
+		wantVersions      []string
+		wantPrefixes      []string
+		wantTrunc         bool
+		wantNextKey       string
+		wantNextVersionID string
+		wantErr           bool
+		wantCode          codes.Code
+		wantMessage       string
+	}
+
+	cases := []tc{
+		{
+			name:         "lists every version",
+			req:          &servicev1.ListObjectVersionsRequest{},
+			wantVersions: []string{"v3", "v2", "null", "v4", "v5", "v7", "v6"},
+		},
+		{
+			name:         "delimiter rolls up common prefixes",
+			req:          &servicev1.ListObjectVersionsRequest{Prefix: "docs/", Delimiter: "/"},
+			wantVersions: []string{"v7", "v6"},
+			wantPrefixes: []string{"docs/2024/", "docs/2025/"},
+		},
+		{
+			name:              "max keys truncates with markers",
+			req:               &servicev1.ListObjectVersionsRequest{MaxKeys: proto.Int32(2)},
+			wantVersions:      []string{"v3", "v2"},
+			wantTrunc:         true,
+			wantNextKey:       "a.txt",
+			wantNextVersionID: "v2",
+		},
+		{
+			name:         "markers resume within a key",
+			req:          &servicev1.ListObjectVersionsRequest{KeyMarker: "a.txt", VersionIdMarker: "v2", MaxKeys: proto.Int32(2)},
+			wantVersions: []string{"null", "v4"},
+			wantTrunc:    true,
+			wantNextKey:  "docs/2024/report.pdf", wantNextVersionID: "v4",
+		},
+		{
+			name:         "key marker alone skips the whole key",
+			req:          &servicev1.ListObjectVersionsRequest{KeyMarker: "a.txt", Prefix: "a"},
+			wantVersions: []string{},
+		},
+		{
+			name:         "common prefix key marker skips the keys under it",
+			req:          &servicev1.ListObjectVersionsRequest{Prefix: "docs/", Delimiter: "/", KeyMarker: "docs/2024/"},
+			wantVersions: []string{"v7", "v6"},
+			wantPrefixes: []string{"docs/2025/"},
+		},
+		{
+			name:        "version id marker without key marker returns InvalidArgument",
+			req:         &servicev1.ListObjectVersionsRequest{VersionIdMarker: "v2"},
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidArgument",
+		},
+		{
+			name:        "negative max keys returns InvalidArgument",
+			req:         &servicev1.ListObjectVersionsRequest{MaxKeys: proto.Int32(-1)},
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidArgument",
+		},
+		{
+			name:         "bucket not found returns NotFound",
+			req:          &servicev1.ListObjectVersionsRequest{},
+			getByNameErr: store.ErrBucketNotFound,
+			wantErr:      true,
+			wantCode:     codes.NotFound,
+			wantMessage:  "bucket not found",
+		},
+		{
+			name:        "store error returns Internal",
+			req:         &servicev1.ListObjectVersionsRequest{},
+			listErr:     errors.New("list object versions: disk I/O error"),
+			wantErr:     true,
+			wantCode:    codes.Internal,
+			wantMessage: "list object versions: disk I/O error",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", Versioning: store.VersioningEnabled})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+
+			objects := testutil.NewFakeObjectStore()
+			objects.SetListVersionsRecords(versions)
+			if c.listErr != nil {
+				objects.SetListVersionsError(c.listErr)
+			}
+
+			svc.store = testutil.NewFakeStore(
+				testutil.WithBuckets(buckets),
+				testutil.WithObjects(objects),
+			)
+
+			if c.req.Bucket == "" {
+				c.req.Bucket = "my-bucket"
+			}
+
+			resp, err := svc.ListObjectVersions(context.Background(), c.req)
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+
+			assertNoError(t, err)
+
+			gotVersions := make([]string, 0, len(resp.GetVersions()))
+			for _, v := range resp.GetVersions() {
+				gotVersions = append(gotVersions, v.GetVersionId())
+			}
+			if !slices.Equal(gotVersions, c.wantVersions) {
+				t.Fatalf("versions: got %v, want %v", gotVersions, c.wantVersions)
+			}
+			if !slices.Equal(resp.GetCommonPrefixes(), c.wantPrefixes) {
+				t.Fatalf("common prefixes: got %v, want %v", resp.GetCommonPrefixes(), c.wantPrefixes)
+			}
+			if resp.GetIsTruncated() != c.wantTrunc {
+				t.Fatalf("is_truncated: got %v, want %v", resp.GetIsTruncated(), c.wantTrunc)
+			}
+			if resp.GetNextKeyMarker() != c.wantNextKey || resp.GetNextVersionIdMarker() != c.wantNextVersionID {
+				t.Fatalf("next markers: got %q/%q, want %q/%q",
+					resp.GetNextKeyMarker(), resp.GetNextVersionIdMarker(), c.wantNextKey, c.wantNextVersionID)
+			}
+		})
+	}
+}
+
+func TestService_ListObjectVersions_DeleteMarkerEntry(t *testing.T) {
+	t.Parallel()
+
+	svc := New(newDiscardLogger(), nil, nil)
+
+	buckets := testutil.NewFakeBucketStore()
+	buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", Versioning: store.VersioningEnabled})
+
+	objects := testutil.NewFakeObjectStore()
+	objects.SetListVersionsRecords([]store.ObjectRecord{
+		{ID: "marker-1", Key: "notes.txt", State: "DELETE_MARKER", VersionID: "marker-1", IsLatest: true, LastModifiedMs: 1700000000000},
+		{ID: "obj-1", Key: "notes.txt", State: "NONCURRENT", VersionID: "obj-1", SizeActual: 42, ETag: "abc", LastModifiedMs: 1600000000000},
+	})
+
+	svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets), testutil.WithObjects(objects))
+
+	resp, err := svc.ListObjectVersions(context.Background(), &servicev1.ListObjectVersionsRequest{Bucket: "my-bucket"})
+	assertNoError(t, err)
+
+	got := resp.GetVersions()
+	if len(got) != 2 {
+		t.Fatalf("versions: got %d, want 2", len(got))
+	}
+	if !got[0].GetDeleteMarker() || !got[0].GetIsLatest() || got[0].GetEtag() != "" || got[0].GetLastModifiedMs() != 1700000000000 {
+		t.Fatalf("delete marker entry: got %+v", got[0])
+	}
+	if got[1].GetDeleteMarker() || got[1].GetIsLatest() || got[1].GetSize() != 42 || got[1].GetEtag() != "abc" {
+		t.Fatalf("noncurrent entry: got %+v", got[1])
+	}
+}
@@ -0,0 +1,135 @@
+package grpcsvc
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	objectv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/object/v1"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+const maxListKeys = 1000
+
+func (s *Service) ListObjects(ctx context.Context, req *servicev1.ListObjectsRequest) (*servicev1.ListObjectsResponse, error) {
+	bucketName := req.GetBucket()
+	prefix := req.GetPrefix()
+	delimiter := req.GetDelimiter()
+
+	validator := validation.DefaultBucketNameValidator{}
+
+	if err := validator.ValidateBucketName(bucketName); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	maxKeys := maxListKeys
+	if req.MaxKeys != nil {
+		if req.GetMaxKeys() < 0 {
+			return nil, status.Error(codes.InvalidArgument, "InvalidArgument")
+		}
+		maxKeys = min(int(req.GetMaxKeys()), maxListKeys)
+	}
+
+	// The cursor is the last key (or common prefix ceiling) already returned;
+	// the continuation token is just that cursor, encoded.
+	cursor := req.GetStartAfter()
+	if token := req.GetContinuationToken(); token != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "InvalidArgument")
+		}
+		cursor = string(decoded)
+	}
+
+	if err := checkTestBucket(bucketName); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	bucket, err := s.store.Buckets().GetByName(ctx, bucketName)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, objectLookupError(servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, bucketName, "", err))
+	}
+
+	if err := s.authorize(ctx, bucket, policy.ActionListBucket, ""); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	resp := &servicev1.ListObjectsResponse{}
+	entries := 0
+
+	// Each page asks for one more row than still fits so a full page proves
+	// the listing is truncated. Rolling keys up into a common prefix jumps the
+	// cursor past the whole prefix and starts a fresh page from there. A
+	// max_keys of zero lists nothing, as in S3.
+	for maxKeys > 0 {
+		limit := maxKeys - entries + 1
+		objects, err := s.store.Objects().ListCommitted(ctx, bucket.ID, prefix, cursor, limit)
+		if err != nil {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+		}
+
+		rolledUp := false
+		for _, obj := range objects {
+			if entries == maxKeys {
+				resp.IsTruncated = true
+				break
+			}
+
+			entries++
+
+			if cp, ok := commonPrefix(obj.Key, prefix, delimiter); ok {
+				resp.CommonPrefixes = append(resp.CommonPrefixes, cp)
+				cursor = cp + store.KeysetCeiling
+				rolledUp = true
+				break
+			}
+
+			resp.Objects = append(resp.Objects, &objectv1.Object{
+				ObjectId:       obj.ID,
+				Key:            obj.Key,
+				Size:           obj.SizeActual,
+				LastModifiedMs: obj.LastModifiedMs,
+				ContentType:    obj.ContentType,
+				Etag:           obj.ETag,
+			})
+			cursor = obj.Key
+		}
+
+		if resp.IsTruncated || !rolledUp {
+			break
+		}
+	}
+
+	if resp.IsTruncated {
+		resp.NextContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(cursor))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("listed %d objects and %d common prefixes in bucket <%s>",
+		len(resp.Objects), len(resp.CommonPrefixes), bucketName)))
+
+	return resp, nil
+}
+
+// commonPrefix reports the prefix a key rolls up into: everything through the
+// first delimiter that follows the requested prefix.
+func commonPrefix(key, prefix, delimiter string) (string, bool) {
+	if delimiter == "" {
+		return "", false
+	}
+
+	i := strings.Index(key[len(prefix):], delimiter)
+	if i < 0 {
+		return "", false
+	}
+
+	return key[:len(prefix)+i+len(delimiter)], true
+}
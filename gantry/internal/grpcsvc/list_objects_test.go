@@ -0,0 +1,227 @@
+package grpcsvc
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_ListObjects(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{
+		"a.txt",
+		"photos/2024/beach.jpg",
+		"photos/2024/city.jpg",
+		"photos/2025/snow.jpg",
+		"photos/cover.jpg",
+		"z.txt",
+	}
+
+	token := func(cursor string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+	}
+
+	type tc struct {
+		name         string
+		bucket       string
+		req          *servicev1.ListObjectsRequest
+		getByNameErr error
+		listErr      error
+		wantKeys     []string
+		wantPrefixes []string
+		wantTrunc    bool
+		wantToken    string
+		wantErr      bool
+		wantCode     codes.Code
+		wantMessage  string
+	}
+
+	cases := []tc{
+		{
+			name:     "lists every key",
+			req:      &servicev1.ListObjectsRequest{},
+			wantKeys: keys,
+		},
+		{
+			name:     "prefix filters keys",
+			req:      &servicev1.ListObjectsRequest{Prefix: "photos/2024/"},
+			wantKeys: []string{"photos/2024/beach.jpg", "photos/2024/city.jpg"},
+		},
+		{
+			name:         "delimiter rolls up common prefixes",
+			req:          &servicev1.ListObjectsRequest{Delimiter: "/"},
+			wantKeys:     []string{"a.txt", "z.txt"},
+			wantPrefixes: []string{"photos/"},
+		},
+		{
+			name:         "delimiter after prefix",
+			req:          &servicev1.ListObjectsRequest{Prefix: "photos/", Delimiter: "/"},
+			wantKeys:     []string{"photos/cover.jpg"},
+			wantPrefixes: []string{"photos/2024/", "photos/2025/"},
+		},
+		{
+			name:      "max keys truncates with continuation token",
+			req:       &servicev1.ListObjectsRequest{MaxKeys: proto.Int32(2)},
+			wantKeys:  []string{"a.txt", "photos/2024/beach.jpg"},
+			wantTrunc: true,
+			wantToken: token("photos/2024/beach.jpg"),
+		},
+		{
+			name:      "continuation token resumes listing",
+			req:       &servicev1.ListObjectsRequest{MaxKeys: proto.Int32(2), ContinuationToken: token("photos/2024/beach.jpg")},
+			wantKeys:  []string{"photos/2024/city.jpg", "photos/2025/snow.jpg"},
+			wantTrunc: true,
+			wantToken: token("photos/2025/snow.jpg"),
+		},
+		{
+			name:     "continuation token wins over start after",
+			req:      &servicev1.ListObjectsRequest{StartAfter: "a.txt", ContinuationToken: token("photos/cover.jpg")},
+			wantKeys: []string{"z.txt"},
+		},
+		{
+			name:     "start after skips keys",
+			req:      &servicev1.ListObjectsRequest{StartAfter: "photos/2025/snow.jpg"},
+			wantKeys: []string{"photos/cover.jpg", "z.txt"},
+		},
+		{
+			name:         "common prefixes count toward max keys",
+			req:          &servicev1.ListObjectsRequest{Delimiter: "/", MaxKeys: proto.Int32(2)},
+			wantKeys:     []string{"a.txt"},
+			wantPrefixes: []string{"photos/"},
+			wantTrunc:    true,
+			wantToken:    token("photos/" + store.KeysetCeiling),
+		},
+		{
+			name:     "token after common prefix skips its keys",
+			req:      &servicev1.ListObjectsRequest{Delimiter: "/", ContinuationToken: token("photos/" + store.KeysetCeiling)},
+			wantKeys: []string{"z.txt"},
+		},
+		{
+			name: "zero max keys returns nothing",
+			req:  &servicev1.ListObjectsRequest{MaxKeys: proto.Int32(0)},
+		},
+		{
+			name:        "negative max keys returns InvalidArgument",
+			req:         &servicev1.ListObjectsRequest{MaxKeys: proto.Int32(-1)},
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidArgument",
+		},
+		{
+			name:        "malformed continuation token returns InvalidArgument",
+			req:         &servicev1.ListObjectsRequest{ContinuationToken: "not a token!"},
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidArgument",
+		},
+		{
+			name:         "missing bucket returns NotFound",
+			bucket:       "nonexistent-bucket",
+			req:          &servicev1.ListObjectsRequest{},
+			getByNameErr: store.ErrBucketNotFound,
+			wantErr:      true,
+			wantCode:     codes.NotFound,
+			wantMessage:  "bucket not found",
+		},
+		{
+			name:        "store error returns Internal",
+			req:         &servicev1.ListObjectsRequest{},
+			listErr:     errors.New("list committed objects: disk I/O error"),
+			wantErr:     true,
+			wantCode:    codes.Internal,
+			wantMessage: "list committed objects: disk I/O error",
+		},
+		{
+			name:        "invalid bucket name returns InvalidArgument",
+			bucket:      "Bad!Name",
+			req:         &servicev1.ListObjectsRequest{},
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidBucketName",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			bucket := c.bucket
+			if bucket == "" {
+				bucket = "my-bucket"
+			}
+			c.req.Bucket = bucket
+
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: bucket})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+
+			records := make([]store.ObjectRecord, 0, len(keys))
+			for _, key := range keys {
+				records = append(records, store.ObjectRecord{ID: "id-" + key, BucketID: "bucket-id-123", Key: key, State: "COMMITTED", SizeActual: 5})
+			}
+
+			objects := testutil.NewFakeObjectStore()
+			objects.SetListCommittedRecords(records)
+			if c.listErr != nil {
+				objects.SetListCommittedError(c.listErr)
+			}
+
+			svc.store = testutil.NewFakeStore(
+				testutil.WithBuckets(buckets),
+				testutil.WithObjects(objects),
+			)
+
+			resp, err := svc.ListObjects(context.Background(), c.req)
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				if c.wantCode == codes.NotFound {
+					assertObjectLookupErrorDetail(t, err, servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, bucket, "")
+				}
+				return
+			}
+
+			assertNoError(t, err)
+
+			gotKeys := make([]string, 0, len(resp.GetObjects()))
+			for _, obj := range resp.GetObjects() {
+				gotKeys = append(gotKeys, obj.GetKey())
+				if obj.GetObjectId() != "id-"+obj.GetKey() || obj.GetSize() != 5 {
+					t.Fatalf("object %q: got id %q size %d", obj.GetKey(), obj.GetObjectId(), obj.GetSize())
+				}
+			}
+			if !slices.Equal(gotKeys, c.wantKeys) && (len(gotKeys) != 0 || len(c.wantKeys) != 0) {
+				t.Fatalf("keys: got %v, want %v", gotKeys, c.wantKeys)
+			}
+			if got := resp.GetCommonPrefixes(); !slices.Equal(got, c.wantPrefixes) && (len(got) != 0 || len(c.wantPrefixes) != 0) {
+				t.Fatalf("common prefixes: got %v, want %v", got, c.wantPrefixes)
+			}
+			if resp.GetIsTruncated() != c.wantTrunc {
+				t.Fatalf("is truncated: got %v, want %v", resp.GetIsTruncated(), c.wantTrunc)
+			}
+			if resp.GetNextContinuationToken() != c.wantToken {
+				t.Fatalf("next token: got %q, want %q", resp.GetNextContinuationToken(), c.wantToken)
+			}
+
+			for _, call := range objects.ListCommittedCalls() {
+				if call.BucketID != "bucket-id-123" || call.Prefix != c.req.GetPrefix() {
+					t.Fatalf("ListCommitted call: got %+v, want bucket-id-123 prefix %q", call, c.req.GetPrefix())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+const maxListParts = 1000
+
+func (s *Service) ListParts(ctx context.Context, req *servicev1.ListPartsRequest) (*servicev1.ListPartsResponse, error) {
+	bucketName := req.GetBucket()
+	key := req.GetKey()
+	marker := req.GetPartNumberMarker()
+
+	bucketValidator := validation.DefaultBucketNameValidator{}
+	keyValidator := validation.DefaultKeyValidator{}
+
+	if err := bucketValidator.ValidateBucketName(bucketName); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	if err := keyValidator.ValidateKey(key); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidKeyName")
+	}
+
+	if marker < 0 {
+		return nil, status.Error(codes.InvalidArgument, "InvalidArgument")
+	}
+
+	maxParts := maxListParts
+	if req.MaxParts != nil {
+		if req.GetMaxParts() < 0 {
+			return nil, status.Error(codes.InvalidArgument, "InvalidArgument")
+		}
+		maxParts = min(int(req.GetMaxParts()), maxListParts)
+	}
+
+	if err := checkTestBucket(bucketName); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	upload, err := s.openUpload(ctx, bucketName, key, req.GetUploadId(), policy.ActionListMultipartUploadParts)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	// An upload never has more than maxPartNumber parts, so the page is cut
+	// from the full list rather than in the store.
+	parts, err := s.store.Multipart().ListUploadedParts(ctx, upload.ID)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	resp := &servicev1.ListPartsResponse{}
+	for _, part := range parts {
+		if part.PartNumber <= marker {
+			continue
+		}
+		if len(resp.Parts) == maxParts {
+			resp.IsTruncated = maxParts > 0
+			break
+		}
+		resp.Parts = append(resp.Parts, &servicev1.UploadedPart{
+			PartNumber:     part.PartNumber,
+			Etag:           part.ETag,
+			Size:           part.SizeActual,
+			LastModifiedMs: part.LastModifiedMs,
+		})
+	}
+
+	if resp.IsTruncated {
+		resp.NextPartNumberMarker = resp.Parts[len(resp.Parts)-1].GetPartNumber()
+	}
+
+	loggrpc.SetAttrs(ctx,
+		slog.String("result", fmt.Sprintf("listed %d parts of upload %s", len(resp.Parts), upload.ID)))
+
+	return resp, nil
+}
@@ -0,0 +1,159 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_ListParts(t *testing.T) {
+	t.Parallel()
+
+	parts := []store.PartRecord{
+		{BlobID: "blob-id-1", ETag: "etag-1", PartNumber: 1, SizeActual: 5242880, LastModifiedMs: 1735689600000},
+		{BlobID: "blob-id-2", ETag: "etag-2", PartNumber: 2, SizeActual: 5242880, LastModifiedMs: 1735689601000},
+		{BlobID: "blob-id-4", ETag: "etag-4", PartNumber: 4, SizeActual: 1024, LastModifiedMs: 1735689602000},
+	}
+
+	type tc struct {
+		name         string
+		key          string
+		uploadID     string
+		marker       int32
+		maxParts     *int32
+		getByNameErr error
+		listErr      error
+		wantParts    []int32
+		wantTrunc    bool
+		wantNext     int32
+		wantErr      bool
+		wantCode     codes.Code
+		wantMessage  string
+	}
+
+	cases := []tc{
+		{
+			name:      "lists uploaded parts",
+			key:       "videos/big.mp4",
+			uploadID:  "upload-id-1",
+			wantParts: []int32{1, 2, 4},
+		},
+		{
+			name:      "max parts truncates with next marker",
+			key:       "videos/big.mp4",
+			uploadID:  "upload-id-1",
+			maxParts:  proto.Int32(2),
+			wantParts: []int32{1, 2},
+			wantTrunc: true,
+			wantNext:  2,
+		},
+		{
+			name:      "marker resumes after part number",
+			key:       "videos/big.mp4",
+			uploadID:  "upload-id-1",
+			marker:    2,
+			wantParts: []int32{4},
+		},
+		{
+			name:        "unknown upload returns NoSuchUpload",
+			key:         "videos/big.mp4",
+			uploadID:    "upload-id-missing",
+			wantErr:     true,
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchUpload",
+		},
+		{
+			name:        "negative marker returns InvalidArgument",
+			key:         "videos/big.mp4",
+			uploadID:    "upload-id-1",
+			marker:      -1,
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidArgument",
+		},
+		{
+			name:         "bucket not found returns NotFound",
+			key:          "videos/big.mp4",
+			uploadID:     "upload-id-1",
+			getByNameErr: store.ErrBucketNotFound,
+			wantErr:      true,
+			wantCode:     codes.NotFound,
+			wantMessage:  "bucket not found",
+		},
+		{
+			name:        "store error returns Internal",
+			key:         "videos/big.mp4",
+			uploadID:    "upload-id-1",
+			listErr:     errors.New("list parts: disk I/O error"),
+			wantErr:     true,
+			wantCode:    codes.Internal,
+			wantMessage: "list parts: disk I/O error",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			buckets, uploads := newUploadFakes()
+			uploads.SetParts("upload-id-1", parts)
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+			if c.listErr != nil {
+				uploads.SetListPartsError(c.listErr)
+			}
+
+			svc.store = testutil.NewFakeStore(
+				testutil.WithBuckets(buckets),
+				testutil.WithMultipart(uploads),
+			)
+
+			resp, err := svc.ListParts(context.Background(), &servicev1.ListPartsRequest{
+				Bucket:           "my-bucket",
+				Key:              c.key,
+				UploadId:         c.uploadID,
+				PartNumberMarker: c.marker,
+				MaxParts:         c.maxParts,
+			})
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+
+			assertNoError(t, err)
+
+			gotParts := make([]int32, 0, len(resp.GetParts()))
+			for _, p := range resp.GetParts() {
+				gotParts = append(gotParts, p.GetPartNumber())
+			}
+			if !slices.Equal(gotParts, c.wantParts) {
+				t.Fatalf("parts: got %v, want %v", gotParts, c.wantParts)
+			}
+
+			first := resp.GetParts()[0]
+			want := parts[slices.IndexFunc(parts, func(p store.PartRecord) bool { return p.PartNumber == first.GetPartNumber() })]
+			if first.GetEtag() != want.ETag || first.GetSize() != want.SizeActual || first.GetLastModifiedMs() != want.LastModifiedMs {
+				t.Fatalf("part %d: got %v, want %+v", first.GetPartNumber(), first, want)
+			}
+
+			if resp.GetIsTruncated() != c.wantTrunc {
+				t.Fatalf("is_truncated: got %v, want %v", resp.GetIsTruncated(), c.wantTrunc)
+			}
+			if resp.GetNextPartNumberMarker() != c.wantNext {
+				t.Fatalf("next_part_number_marker: got %d, want %d", resp.GetNextPartNumberMarker(), c.wantNext)
+			}
+		})
+	}
+}
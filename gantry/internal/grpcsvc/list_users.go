@@ -0,0 +1,25 @@
+package grpcsvc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) ListUsers(ctx context.Context, _ *servicev1.ListUsersRequest) (*servicev1.ListUsersResponse, error) {
+	records, err := s.store.Users().List(ctx)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	resp := &servicev1.ListUsersResponse{}
+	for _, rec := range records {
+		resp.Users = append(resp.Users, userToProto(rec))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,58 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_ListUsers(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("returns users in store order", func(t *testing.T) {
+		t.Parallel()
+
+		svc := New(newDiscardLogger(), nil, nil)
+		users := testutil.NewFakeUserStore()
+		users.SetListRecords([]store.UserRecord{
+			{ID: "user-1", Name: "admin", CreatedAt: base},
+			{ID: "user-2", Name: "alice", CreatedAt: base.Add(time.Hour)},
+		})
+		svc.store = testutil.NewFakeStore(testutil.WithUsers(users))
+
+		resp, err := svc.ListUsers(context.Background(), &servicev1.ListUsersRequest{})
+		assertNoError(t, err)
+
+		got := resp.GetUsers()
+		if len(got) != 2 {
+			t.Fatalf("users: got %d, want 2", len(got))
+		}
+		if got[0].GetName() != "admin" || got[1].GetName() != "alice" || got[1].GetId() != "user-2" {
+			t.Fatalf("users: got %+v", got)
+		}
+		if got[1].GetCreatedMs() != base.Add(time.Hour).UnixMilli() {
+			t.Fatalf("created_ms: got %d, want %d", got[1].GetCreatedMs(), base.Add(time.Hour).UnixMilli())
+		}
+	})
+
+	t.Run("store failure returns Internal", func(t *testing.T) {
+		t.Parallel()
+
+		svc := New(newDiscardLogger(), nil, nil)
+		users := testutil.NewFakeUserStore()
+		users.SetListError(errors.New("list users failed"))
+		svc.store = testutil.NewFakeStore(testutil.WithUsers(users))
+
+		_, err := svc.ListUsers(context.Background(), &servicev1.ListUsersRequest{})
+		assertGRPCError(t, err, codes.Internal, "list users failed")
+	})
+}
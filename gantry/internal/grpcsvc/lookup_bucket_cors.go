@@ -0,0 +1,44 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// LookupBucketCors returns a bucket's CORS rules without checking the
+// caller, since flatbed needs them before it has authenticated a request.
+// The rules only say which browsers may make requests; gantry still
+// authorizes the requests themselves. A missing bucket has no rules, as a
+// bucket without a CORS configuration does, so anonymous callers can't use
+// the lookup to find out which buckets exist.
+func (s *Service) LookupBucketCors(ctx context.Context, req *servicev1.LookupBucketCorsRequest) (*servicev1.LookupBucketCorsResponse, error) {
+	name := req.GetBucket()
+
+	validator := validation.DefaultBucketNameValidator{}
+	if err := validator.ValidateBucketName(name); err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "InvalidBucketName"))
+	}
+
+	bucket, err := s.store.Buckets().GetByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, store.ErrBucketNotFound) {
+			loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> not found, no cors rules", name)))
+			return &servicev1.LookupBucketCorsResponse{}, nil
+		}
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> cors has %d rules", bucket.Name, len(bucket.CORS))))
+
+	return &servicev1.LookupBucketCorsResponse{Rules: corsRulesToProto(bucket.CORS)}, nil
+}
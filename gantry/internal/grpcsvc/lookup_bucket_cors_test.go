@@ -0,0 +1,74 @@
+package grpcsvc
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_LookupBucketCors(t *testing.T) {
+	t.Parallel()
+
+	rules := []store.CORSRule{{AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET"}}}
+
+	type tc struct {
+		name         string
+		caller       string
+		bucket       string
+		cors         []store.CORSRule
+		getByNameErr error
+		wantRules    []*servicev1.CorsRule
+		wantCode     codes.Code
+		wantMessage  string
+	}
+
+	cases := []tc{
+		{
+			name:      "anonymous caller reads rules of an owned bucket",
+			bucket:    "my-bucket",
+			cors:      rules,
+			wantRules: []*servicev1.CorsRule{{AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET"}}},
+		},
+		{name: "bucket without cors returns no rules", caller: "user-bob", bucket: "my-bucket"},
+		{name: "invalid bucket name", bucket: "b", wantCode: codes.InvalidArgument, wantMessage: "InvalidBucketName"},
+		{name: "missing bucket returns no rules", bucket: "my-bucket", getByNameErr: store.ErrBucketNotFound},
+		{name: "store failure returns Internal", bucket: "my-bucket", getByNameErr: errors.New("database is locked"), wantCode: codes.Internal, wantMessage: "database is locked"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", CORS: c.cors})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			resp, err := svc.LookupBucketCors(callerContext(c.caller), &servicev1.LookupBucketCorsRequest{Bucket: c.bucket})
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+			got := resp.GetRules()
+			if len(got) != len(c.wantRules) {
+				t.Fatalf("rules: got %d, want %d", len(got), len(c.wantRules))
+			}
+			for i := range got {
+				if !proto.Equal(got[i], c.wantRules[i]) {
+					t.Fatalf("rule %d: got %v, want %v", i, got[i], c.wantRules[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	objectv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/object/v1"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) LookupObject(ctx context.Context, req *servicev1.LookupObjectRequest) (*servicev1.LookupObjectResponse, error) {
+	bucketName := req.GetBucket()
+	key := req.GetKey()
+	versionID := req.GetVersionId()
+
+	bucketValidator := validation.DefaultBucketNameValidator{}
+	keyValidator := validation.DefaultKeyValidator{}
+
+	if err := bucketValidator.ValidateBucketName(bucketName); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	if err := keyValidator.ValidateKey(key); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidKeyName")
+	}
+
+	if err := checkTestBucket(bucketName); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	bucket, err := s.store.Buckets().GetByName(ctx, bucketName)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, objectLookupError(servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, bucketName, key, err))
+	}
+
+	action := policy.ActionGetObject
+	if versionID != "" {
+		action = policy.ActionGetObjectVersion
+	}
+	if err := s.authorize(ctx, bucket, action, key); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	var obj store.ObjectRecord
+	if versionID == "" {
+		obj, err = s.store.Objects().GetCommitted(ctx, bucket.ID, key)
+		if err != nil {
+			return nil, loggrpc.SetError(ctx, objectLookupError(servicev1.ObjectLookupError_REASON_OBJECT_NOT_FOUND, bucketName, key, err))
+		}
+	} else {
+		obj, err = s.store.Objects().GetVersion(ctx, bucket.ID, key, versionID)
+		if err != nil {
+			return nil, loggrpc.SetError(ctx, versionLookupError(servicev1.ObjectLookupError_REASON_VERSION_NOT_FOUND, bucketName, key, versionID, err))
+		}
+		if obj.State == "DELETE_MARKER" {
+			return nil, loggrpc.SetError(ctx, versionLookupError(servicev1.ObjectLookupError_REASON_DELETE_MARKER, bucketName, key, versionID, errDeleteMarker))
+		}
+	}
+
+	object := &objectv1.Object{
+		ObjectId:       obj.ID,
+		Key:            obj.Key,
+		Size:           obj.SizeActual,
+		LastModifiedMs: obj.LastModifiedMs,
+		ContentType:    obj.ContentType,
+		Etag:           obj.ETag,
+
+		ChecksumAlgorithm: obj.ChecksumAlgorithm,
+		Checksum:          obj.Checksum,
+		Metadata:          objectMetadata(obj.Metadata),
+		VersionId:         objectVersionID(bucket, obj),
+		Tags:              obj.Tags,
+
+		ObjectLockLegalHold: obj.Lock.LegalHold,
+	}
+	if obj.Lock.Mode != "" {
+		object.ObjectLockMode = obj.Lock.Mode
+		object.ObjectLockRetainUntilMs = obj.Lock.RetainUntil.UnixMilli()
+	}
+
+	if obj.PartCount > 0 {
+		parts, err := s.store.Multipart().ListUploadedParts(ctx, obj.ID)
+		if err != nil {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+		}
+		if len(parts) != obj.PartCount {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.Internal,
+				fmt.Sprintf("object %s has %d of %d parts", obj.ID, len(parts), obj.PartCount)))
+		}
+		for _, p := range parts {
+			object.Parts = append(object.Parts, &objectv1.ObjectPart{
+				BlobId:        p.BlobID,
+				Size:          p.SizeActual,
+				CradleAddress: p.CradleAddress,
+			})
+		}
+		object.CradleAddress = parts[0].CradleAddress
+	} else {
+		server, err := s.store.CradleServers().GetByID(ctx, obj.CradleServerID)
+		if err != nil {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+		}
+		object.CradleAddress = server.Address
+	}
+
+	loggrpc.SetAttrs(ctx,
+		slog.String("result", fmt.Sprintf("object %s/%s (%d bytes) found on %s",
+			bucketName, key, obj.SizeActual, object.CradleAddress)))
+
+	return &servicev1.LookupObjectResponse{Object: object}, nil
+}
+
+var errDeleteMarker = errors.New("object version is a delete marker")
+
+// objectVersionID is the version ID reported for obj, which is empty in a
+// bucket that was never versioned.
+func objectVersionID(bucket store.BucketRecord, obj store.ObjectRecord) string {
+	if bucket.Versioning == "" {
+		return ""
+	}
+	return obj.VersionID
+}
+
+// objectLookupError builds a NotFound status carrying an ObjectLookupError
+// detail so callers can tell a missing bucket from a missing key.
+func objectLookupError(reason servicev1.ObjectLookupError_Reason, bucket, key string, cause error) error {
+	return lookupError(&servicev1.ObjectLookupError{
+		Reason: reason,
+		Bucket: bucket,
+		Key:    key,
+	}, cause)
+}
+
+// versionLookupError is objectLookupError for a request naming a version.
+func versionLookupError(reason servicev1.ObjectLookupError_Reason, bucket, key, versionID string, cause error) error {
+	return lookupError(&servicev1.ObjectLookupError{
+		Reason:    reason,
+		Bucket:    bucket,
+		Key:       key,
+		VersionId: versionID,
+	}, cause)
+}
+
+func lookupError(detail *servicev1.ObjectLookupError, cause error) error {
+	st := status.New(codes.NotFound, cause.Error())
+	withDetail, err := st.WithDetails(detail)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return withDetail.Err()
+}
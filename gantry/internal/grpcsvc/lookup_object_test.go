@@ -0,0 +1,510 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_LookupObject(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name            string
+		bucket          string
+		key             string
+		ownerID         string
+		acl             string
+		getByNameErr    error
+		getCommittedErr error
+		getByIDErr      error
+		wantErr         bool
+		wantCode        codes.Code
+		wantMessage     string
+		wantErrorDetail bool
+		wantErrorReason servicev1.ObjectLookupError_Reason
+	}
+
+	cases := []tc{
+		{
+			name:   "committed object returns location",
+			bucket: "my-bucket",
+			key:    "photos/sunset.jpg",
+		},
+		{
+			name:    "public-read bucket allows anonymous lookup",
+			bucket:  "my-bucket",
+			key:     "photos/sunset.jpg",
+			ownerID: "user-alice",
+			acl:     store.BucketACLPublicRead,
+		},
+		{
+			name:        "private bucket of another user returns PermissionDenied",
+			bucket:      "my-bucket",
+			key:         "photos/sunset.jpg",
+			ownerID:     "user-alice",
+			acl:         store.BucketACLPrivate,
+			wantErr:     true,
+			wantCode:    codes.PermissionDenied,
+			wantMessage: "AccessDenied",
+		},
+		{
+			name:            "bucket not found returns NotFound",
+			bucket:          "nonexistent-bucket",
+			key:             "photos/sunset.jpg",
+			getByNameErr:    store.ErrBucketNotFound,
+			wantErr:         true,
+			wantCode:        codes.NotFound,
+			wantMessage:     "bucket not found",
+			wantErrorDetail: true,
+			wantErrorReason: servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND,
+		},
+		{
+			name:            "object not found returns NotFound",
+			bucket:          "my-bucket",
+			key:             "photos/missing.jpg",
+			getCommittedErr: store.ErrObjectNotFound,
+			wantErr:         true,
+			wantCode:        codes.NotFound,
+			wantMessage:     "object not found",
+			wantErrorDetail: true,
+			wantErrorReason: servicev1.ObjectLookupError_REASON_OBJECT_NOT_FOUND,
+		},
+		{
+			name:        "cradle server lookup error returns Internal",
+			bucket:      "my-bucket",
+			key:         "photos/sunset.jpg",
+			getByIDErr:  errors.New("cradle store error"),
+			wantErr:     true,
+			wantCode:    codes.Internal,
+			wantMessage: "cradle store error",
+		},
+		{
+			name:        "invalid bucket name returns InvalidArgument",
+			bucket:      "Bad!Name",
+			key:         "photos/sunset.jpg",
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidBucketName",
+		},
+		{
+			name:        "invalid key returns InvalidArgument",
+			bucket:      "my-bucket",
+			key:         "",
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidKeyName",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: c.bucket, OwnerID: c.ownerID, ACL: c.acl})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+
+			objects := testutil.NewFakeObjectStore()
+			objects.SetGetCommittedResponse(store.ObjectRecord{
+				ID:             "object-id-789",
+				BucketID:       "bucket-id-123",
+				Key:            c.key,
+				State:          "COMMITTED",
+				SizeActual:     2048,
+				LastModifiedMs: 1735689600000,
+				ContentType:    "image/jpeg",
+				CradleServerID: "cradle-id-456",
+
+				ChecksumAlgorithm: "CRC32",
+				Checksum:          "DUoRhQ==",
+				Metadata: map[string]string{
+					"content-disposition": "attachment",
+					"x-amz-meta-color":    "blue",
+				},
+			})
+			if c.getCommittedErr != nil {
+				objects.SetGetCommittedError(c.getCommittedErr)
+			}
+
+			cradles := testutil.NewFakeCradleStore()
+			cradles.SetGetByIDResponse(store.CradleServerRecord{ID: "cradle-id-456", Address: "127.0.0.1:9444"})
+			if c.getByIDErr != nil {
+				cradles.SetGetByIDError(c.getByIDErr)
+			}
+
+			svc.store = testutil.NewFakeStore(
+				testutil.WithBuckets(buckets),
+				testutil.WithCradles(cradles),
+				testutil.WithObjects(objects),
+			)
+
+			resp, err := svc.LookupObject(context.Background(), &servicev1.LookupObjectRequest{
+				Bucket: c.bucket,
+				Key:    c.key,
+			})
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				if c.wantErrorDetail {
+					assertObjectLookupErrorDetail(t, err, c.wantErrorReason, c.bucket, c.key)
+				}
+				return
+			}
+
+			assertNoError(t, err)
+
+			calls := objects.GetCommittedCalls()
+			if len(calls) != 1 {
+				t.Fatalf("GetCommitted calls: got %d, want 1", len(calls))
+			}
+			if calls[0].BucketID != "bucket-id-123" || calls[0].Key != c.key {
+				t.Fatalf("GetCommitted call: got %+v, want bucket-id-123/%s", calls[0], c.key)
+			}
+
+			if got := cradles.GetByIDCalls(); len(got) != 1 || got[0] != "cradle-id-456" {
+				t.Fatalf("GetByID calls: got %v, want [cradle-id-456]", got)
+			}
+
+			obj := resp.GetObject()
+			if obj.GetObjectId() != "object-id-789" {
+				t.Fatalf("object_id: got %q, want %q", obj.GetObjectId(), "object-id-789")
+			}
+			if obj.GetKey() != c.key {
+				t.Fatalf("key: got %q, want %q", obj.GetKey(), c.key)
+			}
+			if obj.GetSize() != 2048 {
+				t.Fatalf("size: got %d, want %d", obj.GetSize(), 2048)
+			}
+			if obj.GetLastModifiedMs() != 1735689600000 {
+				t.Fatalf("last_modified_ms: got %d, want %d", obj.GetLastModifiedMs(), int64(1735689600000))
+			}
+			if obj.GetContentType() != "image/jpeg" {
+				t.Fatalf("content_type: got %q, want %q", obj.GetContentType(), "image/jpeg")
+			}
+			if obj.GetCradleAddress() != "127.0.0.1:9444" {
+				t.Fatalf("cradle_address: got %q, want %q", obj.GetCradleAddress(), "127.0.0.1:9444")
+			}
+			if obj.GetChecksumAlgorithm() != "CRC32" || obj.GetChecksum() != "DUoRhQ==" {
+				t.Fatalf("checksum: got %s %q, want CRC32 %q", obj.GetChecksumAlgorithm(), obj.GetChecksum(), "DUoRhQ==")
+			}
+			md := obj.GetMetadata()
+			if md.GetContentDisposition() != "attachment" || md.GetUserMetadata()["color"] != "blue" || len(md.GetUserMetadata()) != 1 {
+				t.Fatalf("metadata: got %v, want content_disposition attachment and color blue", md)
+			}
+		})
+	}
+}
+
+func assertObjectLookupErrorDetail(t *testing.T, err error, wantReason servicev1.ObjectLookupError_Reason, wantBucket, wantKey string) {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("expected gRPC status error, got %v", err)
+	}
+
+	for _, detail := range st.Details() {
+		lookupErr, ok := detail.(*servicev1.ObjectLookupError)
+		if !ok {
+			continue
+		}
+
+		if lookupErr.GetReason() != wantReason {
+			t.Fatalf("ObjectLookupError reason: got %v, want %v", lookupErr.GetReason(), wantReason)
+		}
+		if lookupErr.GetBucket() != wantBucket {
+			t.Fatalf("ObjectLookupError bucket: got %q, want %q", lookupErr.GetBucket(), wantBucket)
+		}
+		if lookupErr.GetKey() != wantKey {
+			t.Fatalf("ObjectLookupError key: got %q, want %q", lookupErr.GetKey(), wantKey)
+		}
+		return
+	}
+
+	t.Fatalf("status missing ObjectLookupError detail: %v", err)
+}
+
+func TestService_LookupObject_Version(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name            string
+		versioning      string
+		versionID       string
+		record          store.ObjectRecord
+		getVersionErr   error
+		wantVersionID   string
+		wantCode        codes.Code
+		wantMessage     string
+		wantErrorReason servicev1.ObjectLookupError_Reason
+	}
+
+	committed := store.ObjectRecord{ID: "object-id-789", State: "COMMITTED", VersionID: "object-id-789", IsLatest: true, CradleServerID: "cradle-id-456"}
+	noncurrent := store.ObjectRecord{ID: "object-id-456", State: "NONCURRENT", VersionID: "object-id-456", CradleServerID: "cradle-id-456"}
+	nullVersion := store.ObjectRecord{ID: "object-id-123", State: "COMMITTED", VersionID: store.NullVersionID, IsLatest: true, CradleServerID: "cradle-id-456"}
+
+	cases := []tc{
+		{
+			name:          "current version in a versioned bucket reports its version",
+			versioning:    store.VersioningEnabled,
+			record:        committed,
+			wantVersionID: "object-id-789",
+		},
+		{
+			name:   "unversioned bucket hides the null version",
+			record: nullVersion,
+		},
+		{
+			name:          "suspended bucket reports the null version",
+			versioning:    store.VersioningSuspended,
+			record:        nullVersion,
+			wantVersionID: "null",
+		},
+		{
+			name:          "version id resolves a noncurrent version",
+			versioning:    store.VersioningEnabled,
+			versionID:     "object-id-456",
+			record:        noncurrent,
+			wantVersionID: "object-id-456",
+		},
+		{
+			name:            "missing version returns NotFound",
+			versioning:      store.VersioningEnabled,
+			versionID:       "object-id-000",
+			getVersionErr:   store.ErrObjectNotFound,
+			wantCode:        codes.NotFound,
+			wantMessage:     "object not found",
+			wantErrorReason: servicev1.ObjectLookupError_REASON_VERSION_NOT_FOUND,
+		},
+		{
+			name:            "delete marker returns NotFound",
+			versioning:      store.VersioningEnabled,
+			versionID:       "marker-1",
+			record:          store.ObjectRecord{ID: "marker-1", State: "DELETE_MARKER", VersionID: "marker-1", IsLatest: true},
+			wantCode:        codes.NotFound,
+			wantMessage:     "object version is a delete marker",
+			wantErrorReason: servicev1.ObjectLookupError_REASON_DELETE_MARKER,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", Versioning: c.versioning})
+
+			objects := testutil.NewFakeObjectStore()
+			objects.SetGetCommittedResponse(c.record)
+			objects.SetGetVersionResponse(c.record)
+			if c.getVersionErr != nil {
+				objects.SetGetVersionError(c.getVersionErr)
+			}
+
+			cradles := testutil.NewFakeCradleStore()
+			cradles.SetGetByIDResponse(store.CradleServerRecord{ID: "cradle-id-456", Address: "127.0.0.1:9444"})
+
+			svc.store = testutil.NewFakeStore(
+				testutil.WithBuckets(buckets),
+				testutil.WithCradles(cradles),
+				testutil.WithObjects(objects),
+			)
+
+			resp, err := svc.LookupObject(context.Background(), &servicev1.LookupObjectRequest{
+				Bucket:    "my-bucket",
+				Key:       "report.txt",
+				VersionId: c.versionID,
+			})
+
+			versionCalls := objects.GetVersionCalls()
+			if c.versionID != "" {
+				if len(versionCalls) != 1 || versionCalls[0].VersionID != c.versionID || len(objects.GetCommittedCalls()) != 0 {
+					t.Fatalf("GetVersion calls: got %+v, want one for %s and no GetCommitted", versionCalls, c.versionID)
+				}
+			} else if len(versionCalls) != 0 {
+				t.Fatalf("GetVersion calls: got %+v, want none", versionCalls)
+			}
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				assertObjectLookupErrorDetail(t, err, c.wantErrorReason, "my-bucket", "report.txt")
+				return
+			}
+
+			assertNoError(t, err)
+			if got := resp.GetObject().GetVersionId(); got != c.wantVersionID {
+				t.Fatalf("version_id: got %q, want %q", got, c.wantVersionID)
+			}
+		})
+	}
+}
+
+func TestService_LookupObject_ObjectLock(t *testing.T) {
+	t.Parallel()
+
+	retainUntil := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	type tc struct {
+		name              string
+		lock              store.ObjectLock
+		wantMode          string
+		wantRetainUntilMs int64
+		wantLegalHold     bool
+	}
+
+	cases := []tc{
+		{
+			name:              "retained and held version reports its lock",
+			lock:              store.ObjectLock{Mode: store.RetentionCompliance, RetainUntil: retainUntil, LegalHold: true},
+			wantMode:          store.RetentionCompliance,
+			wantRetainUntilMs: retainUntil.UnixMilli(),
+			wantLegalHold:     true,
+		},
+		{name: "legal hold alone", lock: store.ObjectLock{LegalHold: true}, wantLegalHold: true},
+		{name: "unlocked version reports no lock"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", Versioning: store.VersioningEnabled, ObjectLock: &store.ObjectLockConfig{}})
+
+			objects := testutil.NewFakeObjectStore()
+			objects.SetGetCommittedResponse(store.ObjectRecord{ID: "object-id-789", State: "COMMITTED", VersionID: "object-id-789", CradleServerID: "cradle-id-456", Lock: c.lock})
+
+			cradles := testutil.NewFakeCradleStore()
+			cradles.SetGetByIDResponse(store.CradleServerRecord{ID: "cradle-id-456", Address: "127.0.0.1:9444"})
+
+			svc.store = testutil.NewFakeStore(
+				testutil.WithBuckets(buckets),
+				testutil.WithCradles(cradles),
+				testutil.WithObjects(objects),
+			)
+
+			resp, err := svc.LookupObject(context.Background(), &servicev1.LookupObjectRequest{Bucket: "my-bucket", Key: "backups/db.tar"})
+
+			assertNoError(t, err)
+			obj := resp.GetObject()
+			if obj.GetObjectLockMode() != c.wantMode || obj.GetObjectLockRetainUntilMs() != c.wantRetainUntilMs || obj.GetObjectLockLegalHold() != c.wantLegalHold {
+				t.Fatalf("object lock: got mode %q until %d hold %t, want mode %q until %d hold %t",
+					obj.GetObjectLockMode(), obj.GetObjectLockRetainUntilMs(), obj.GetObjectLockLegalHold(),
+					c.wantMode, c.wantRetainUntilMs, c.wantLegalHold)
+			}
+		})
+	}
+}
+
+func TestService_LookupObject_Multipart(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		parts       []store.PartRecord
+		wantErr     bool
+		wantMessage string
+	}
+
+	cases := []tc{
+		{
+			name: "returns parts in order",
+			parts: []store.PartRecord{
+				{BlobID: "blob-id-1", PartNumber: 1, SizeActual: 5242880, CradleAddress: "127.0.0.1:9444"},
+				{BlobID: "blob-id-2", PartNumber: 2, SizeActual: 1024, CradleAddress: "127.0.0.1:9555"},
+			},
+		},
+		{
+			name: "missing part returns Internal",
+			parts: []store.PartRecord{
+				{BlobID: "blob-id-1", PartNumber: 1, SizeActual: 5242880, CradleAddress: "127.0.0.1:9444"},
+			},
+			wantErr:     true,
+			wantMessage: "object upload-id-1 has 1 of 2 parts",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			buckets, uploads := newUploadFakes()
+			uploads.SetParts("upload-id-1", c.parts)
+
+			objects := testutil.NewFakeObjectStore()
+			objects.SetGetCommittedResponse(store.ObjectRecord{
+				ID:             "upload-id-1",
+				BucketID:       "bucket-id-123",
+				Key:            "videos/big.mp4",
+				State:          "COMMITTED",
+				SizeActual:     5243904,
+				LastModifiedMs: 1735689600000,
+				ContentType:    "video/mp4",
+				CradleServerID: "cradle-id-456",
+				ETag:           "816d195b2f5dfc10ee04d6966a3b0766-2",
+				PartCount:      2,
+			})
+
+			cradles := testutil.NewFakeCradleStore()
+
+			svc.store = testutil.NewFakeStore(
+				testutil.WithBuckets(buckets),
+				testutil.WithCradles(cradles),
+				testutil.WithObjects(objects),
+				testutil.WithMultipart(uploads),
+			)
+
+			resp, err := svc.LookupObject(context.Background(), &servicev1.LookupObjectRequest{
+				Bucket: "my-bucket",
+				Key:    "videos/big.mp4",
+			})
+
+			if c.wantErr {
+				assertGRPCError(t, err, codes.Internal, c.wantMessage)
+				return
+			}
+
+			assertNoError(t, err)
+
+			if got := cradles.GetByIDCalls(); len(got) != 0 {
+				t.Fatalf("GetByID calls: got %v, want none", got)
+			}
+
+			obj := resp.GetObject()
+			if obj.GetEtag() != "816d195b2f5dfc10ee04d6966a3b0766-2" {
+				t.Fatalf("etag: got %q", obj.GetEtag())
+			}
+			if obj.GetCradleAddress() != "127.0.0.1:9444" {
+				t.Fatalf("cradle_address: got %q, want first part's %q", obj.GetCradleAddress(), "127.0.0.1:9444")
+			}
+			if len(obj.GetParts()) != len(c.parts) {
+				t.Fatalf("parts: got %d, want %d", len(obj.GetParts()), len(c.parts))
+			}
+			for i, p := range obj.GetParts() {
+				want := c.parts[i]
+				if p.GetBlobId() != want.BlobID || p.GetSize() != want.SizeActual || p.GetCradleAddress() != want.CradleAddress {
+					t.Fatalf("part %d: got %v, want %+v", i, p, want)
+				}
+			}
+		})
+	}
+}
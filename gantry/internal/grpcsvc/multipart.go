@@ -0,0 +1,66 @@
+package grpcsvc
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+const (
+	maxPartNumber     = 10000
+	minPartBytes      = 5 * 1024 * 1024               // 5 MiB, except for the last part
+	maxMultipartBytes = 5 * 1024 * 1024 * 1024 * 1024 // 5 TiB
+)
+
+var errNoSuchUpload = status.Error(codes.NotFound, "NoSuchUpload")
+
+// openUpload resolves an IN_PROGRESS upload of key in the named bucket once
+// the caller is authorized for action. An upload that is missing, finished,
+// or belongs to another key is reported as NoSuchUpload, the same as S3.
+func (s *Service) openUpload(ctx context.Context, bucketName, key, uploadID, action string) (store.MultipartUploadRecord, error) {
+	bucket, err := s.store.Buckets().GetByName(ctx, bucketName)
+	if err != nil {
+		return store.MultipartUploadRecord{}, objectLookupError(servicev1.ObjectLookupError_REASON_BUCKET_NOT_FOUND, bucketName, key, err)
+	}
+
+	if err := s.authorize(ctx, bucket, action, key); err != nil {
+		return store.MultipartUploadRecord{}, err
+	}
+
+	upload, err := s.store.Multipart().Get(ctx, uploadID)
+	if errors.Is(err, store.ErrUploadNotFound) {
+		return store.MultipartUploadRecord{}, errNoSuchUpload
+	}
+	if err != nil {
+		return store.MultipartUploadRecord{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if upload.BucketID != bucket.ID || upload.Key != key || upload.State != "IN_PROGRESS" {
+		return store.MultipartUploadRecord{}, errNoSuchUpload
+	}
+
+	return upload, nil
+}
+
+// multipartETag follows the S3 convention for completed uploads: the MD5 of
+// the concatenated binary part digests followed by "-" and the number of
+// parts. A part with no stored digest contributes its ETag string instead.
+func multipartETag(partETags []string) string {
+	h := md5.New()
+	for _, etag := range partETags {
+		if digest, err := hex.DecodeString(etag); err == nil && len(digest) == md5.Size {
+			h.Write(digest)
+			continue
+		}
+		h.Write([]byte(etag))
+	}
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(h.Sum(nil)), len(partETags))
+}
@@ -0,0 +1,101 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// validateRetention checks a retention a request asks for: a mode and an end
+// after now, set together.
+func validateRetention(mode string, retainUntilMs int64, now time.Time) error {
+	switch {
+	case mode != store.RetentionGovernance && mode != store.RetentionCompliance:
+		return fmt.Errorf("unknown retention mode %q", mode)
+	case retainUntilMs <= now.UnixMilli():
+		return fmt.Errorf("retain until %d is not in the future", retainUntilMs)
+	}
+	return nil
+}
+
+// invalidRetention logs why validateRetention rejected a retention and
+// returns InvalidArgument.
+func invalidRetention(ctx context.Context, err error) error {
+	loggrpc.SetAttrs(ctx, slog.String("reason", err.Error()))
+	return loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "InvalidArgument"))
+}
+
+// requireObjectLock rejects setting an object lock in a bucket without
+// object lock enabled.
+func requireObjectLock(ctx context.Context, bucket store.BucketRecord) error {
+	if bucket.ObjectLock != nil {
+		return nil
+	}
+	loggrpc.SetAttrs(ctx, slog.String("reason", fmt.Sprintf("bucket %s does not have object lock enabled", bucket.Name)))
+	return status.Error(codes.InvalidArgument, "InvalidRequest")
+}
+
+// objectLocked logs that an object lock kept a version from being removed
+// or replaced and returns AccessDenied, as S3 does.
+func objectLocked(ctx context.Context) error {
+	loggrpc.SetAttrs(ctx, slog.String("reason", store.ErrObjectLocked.Error()))
+	return loggrpc.SetError(ctx, errAccessDenied)
+}
+
+// bypassGovernance reports whether a request that asked to bypass GOVERNANCE
+// retentions on key may. A caller without s3:BypassGovernanceRetention is
+// held to them as though it hadn't asked, so only a version actually under
+// such a retention fails.
+func (s *Service) bypassGovernance(ctx context.Context, bucket store.BucketRecord, key string, requested bool) (bool, error) {
+	if !requested {
+		return false, nil
+	}
+	err := s.authorize(ctx, bucket, policy.ActionBypassGovernanceRetention, key)
+	if status.Code(err) == codes.PermissionDenied {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// objectLockConfig converts a bucket's object lock configuration for the
+// wire.
+func objectLockConfig(lock store.ObjectLockConfig) *servicev1.ObjectLockConfiguration {
+	return &servicev1.ObjectLockConfiguration{
+		DefaultMode:  lock.DefaultMode,
+		DefaultDays:  int32(lock.DefaultDays),
+		DefaultYears: int32(lock.DefaultYears),
+	}
+}
+
+var errInvalidDefaultRetention = errors.New("invalid default retention")
+
+// defaultRetentionOf validates the default retention of an object lock
+// configuration: none, or a mode for exactly one positive period.
+func defaultRetentionOf(cfg *servicev1.ObjectLockConfiguration) (store.ObjectLockConfig, error) {
+	lock := store.ObjectLockConfig{
+		DefaultMode:  cfg.GetDefaultMode(),
+		DefaultDays:  int(cfg.GetDefaultDays()),
+		DefaultYears: int(cfg.GetDefaultYears()),
+	}
+
+	switch {
+	case lock.DefaultMode == "" && lock.DefaultDays == 0 && lock.DefaultYears == 0:
+		return lock, nil
+	case lock.DefaultMode != store.RetentionGovernance && lock.DefaultMode != store.RetentionCompliance:
+		return store.ObjectLockConfig{}, fmt.Errorf("%w: unknown mode %q", errInvalidDefaultRetention, lock.DefaultMode)
+	case (lock.DefaultDays > 0) == (lock.DefaultYears > 0) || lock.DefaultDays < 0 || lock.DefaultYears < 0:
+		return store.ObjectLockConfig{}, fmt.Errorf("%w: want one positive period, got %d days and %d years",
+			errInvalidDefaultRetention, lock.DefaultDays, lock.DefaultYears)
+	}
+	return lock, nil
+}
@@ -0,0 +1,71 @@
+package grpcsvc
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	objectv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/object/v1"
+)
+
+// maxUserMetadataBytes is S3's limit on user metadata: the UTF-8 bytes of
+// every x-amz-meta-* name (without the prefix) and value added together.
+const maxUserMetadataBytes = 2 * 1024
+
+const userMetadataPrefix = "x-amz-meta-"
+
+// Names of the standard headers in object_metadata.
+const (
+	metadataContentEncoding    = "content-encoding"
+	metadataContentDisposition = "content-disposition"
+	metadataCacheControl       = "cache-control"
+	metadataExpires            = "expires"
+)
+
+// metadataRows flattens md into the header names and values stored in
+// object_metadata. It fails with MetadataTooLarge when the user metadata is
+// over S3's limit.
+func metadataRows(md *objectv1.ObjectMetadata) (map[string]string, error) {
+	rows := make(map[string]string)
+	for name, value := range map[string]string{
+		metadataContentEncoding:    md.GetContentEncoding(),
+		metadataContentDisposition: md.GetContentDisposition(),
+		metadataCacheControl:       md.GetCacheControl(),
+		metadataExpires:            md.GetExpires(),
+	} {
+		if value != "" {
+			rows[name] = value
+		}
+	}
+
+	size := 0
+	for name, value := range md.GetUserMetadata() {
+		size += len(name) + len(value)
+		rows[userMetadataPrefix+strings.ToLower(name)] = value
+	}
+	if size > maxUserMetadataBytes {
+		return nil, status.Error(codes.InvalidArgument, "MetadataTooLarge")
+	}
+
+	return rows, nil
+}
+
+// objectMetadata rebuilds the ObjectMetadata stored as rows.
+func objectMetadata(rows map[string]string) *objectv1.ObjectMetadata {
+	md := &objectv1.ObjectMetadata{
+		ContentEncoding:    rows[metadataContentEncoding],
+		ContentDisposition: rows[metadataContentDisposition],
+		CacheControl:       rows[metadataCacheControl],
+		Expires:            rows[metadataExpires],
+	}
+	for name, value := range rows {
+		if key, ok := strings.CutPrefix(name, userMetadataPrefix); ok {
+			if md.UserMetadata == nil {
+				md.UserMetadata = make(map[string]string)
+			}
+			md.UserMetadata[key] = value
+		}
+	}
+	return md
+}
@@ -0,0 +1,81 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+)
+
+// subresourceBucket resolves the bucket holding the object whose tags,
+// retention or legal hold an object subresource RPC reads or changes,
+// checking the caller may perform action, or versionAction when the request
+// names a version.
+func (s *Service) subresourceBucket(ctx context.Context, bucketName, key, versionID, action, versionAction string) (store.BucketRecord, error) {
+	bucketValidator := validation.DefaultBucketNameValidator{}
+	keyValidator := validation.DefaultKeyValidator{}
+
+	if err := bucketValidator.ValidateBucketName(bucketName); err != nil {
+		return store.BucketRecord{}, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	if err := keyValidator.ValidateKey(key); err != nil {
+		return store.BucketRecord{}, status.Error(codes.InvalidArgument, "InvalidKeyName")
+	}
+
+	bucket, err := s.store.Buckets().GetByName(ctx, bucketName)
+	if err != nil {
+		if errors.Is(err, store.ErrBucketNotFound) {
+			return store.BucketRecord{}, errNoSuchBucket
+		}
+		return store.BucketRecord{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if versionID != "" {
+		action = versionAction
+	}
+	if err := s.authorize(ctx, bucket, action, key); err != nil {
+		return store.BucketRecord{}, err
+	}
+
+	return bucket, nil
+}
+
+// subresourceVersion reads the version an object subresource RPC names: the
+// current version without a versionID. A delete marker returns
+// ErrObjectIsDeleteMarker.
+func (s *Service) subresourceVersion(ctx context.Context, bucket store.BucketRecord, key, versionID string) (store.ObjectRecord, error) {
+	if versionID == "" {
+		return s.store.Objects().GetCommitted(ctx, bucket.ID, key)
+	}
+
+	obj, err := s.store.Objects().GetVersion(ctx, bucket.ID, key, versionID)
+	if err == nil && obj.State == "DELETE_MARKER" {
+		return store.ObjectRecord{}, store.ErrObjectIsDeleteMarker
+	}
+	return obj, err
+}
+
+// subresourceError maps a failed lookup or change of the version an object
+// subresource RPC names to a status.
+func subresourceError(err error, versionID string) error {
+	switch {
+	case errors.Is(err, store.ErrObjectNotFound) && versionID != "":
+		return status.Error(codes.NotFound, "NoSuchVersion")
+	case errors.Is(err, store.ErrObjectNotFound):
+		return status.Error(codes.NotFound, "NoSuchKey")
+	case errors.Is(err, store.ErrObjectIsDeleteMarker):
+		return status.Error(codes.FailedPrecondition, "MethodNotAllowed")
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// subresourceVersionID is the version ID an object subresource RPC reports,
+// which is empty in a bucket that was never versioned.
+func subresourceVersionID(bucket store.BucketRecord, versionID string) string {
+	return objectVersionID(bucket, store.ObjectRecord{VersionID: versionID})
+}
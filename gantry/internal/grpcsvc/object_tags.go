@@ -0,0 +1,45 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/loggrpc"
+)
+
+// S3's limits on the tags of an object.
+const (
+	maxObjectTags     = 10
+	maxTagKeyLength   = 128
+	maxTagValueLength = 256
+)
+
+// validateTags checks tags against S3's limits.
+func validateTags(tags map[string]string) error {
+	if len(tags) > maxObjectTags {
+		return fmt.Errorf("%d tags, more than %d", len(tags), maxObjectTags)
+	}
+	for key, value := range tags {
+		switch {
+		case key == "" || utf8.RuneCountInString(key) > maxTagKeyLength:
+			return fmt.Errorf("tag key %q is not 1 to %d characters", key, maxTagKeyLength)
+		case strings.HasPrefix(strings.ToLower(key), "aws:"):
+			return fmt.Errorf("tag key %q uses the reserved aws: prefix", key)
+		case utf8.RuneCountInString(value) > maxTagValueLength:
+			return fmt.Errorf("tag %q value is over %d characters", key, maxTagValueLength)
+		}
+	}
+	return nil
+}
+
+// invalidTags logs why validateTags rejected tags and returns InvalidTag.
+func invalidTags(ctx context.Context, err error) error {
+	loggrpc.SetAttrs(ctx, slog.String("reason", err.Error()))
+	return loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "InvalidTag"))
+}
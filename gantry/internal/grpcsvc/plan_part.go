@@ -0,0 +1,87 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	"github.com/ratdaddy/blockcloset/pkg/validation"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+	writeplanv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/write_plan/v1"
+)
+
+func (s *Service) PlanPart(ctx context.Context, req *servicev1.PlanPartRequest) (*servicev1.PlanPartResponse, error) {
+	bucketName := req.GetBucket()
+	key := req.GetKey()
+	partNumber := req.GetPartNumber()
+	size := req.GetSize()
+
+	bucketValidator := validation.DefaultBucketNameValidator{}
+	keyValidator := validation.DefaultKeyValidator{}
+
+	if size <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "InvalidSize")
+	}
+
+	if size > maxPutBytes {
+		return nil, status.Error(codes.InvalidArgument, "EntityTooLarge")
+	}
+
+	if partNumber < 1 || partNumber > maxPartNumber {
+		return nil, status.Error(codes.InvalidArgument, "InvalidArgument")
+	}
+
+	if err := bucketValidator.ValidateBucketName(bucketName); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidBucketName")
+	}
+
+	if err := keyValidator.ValidateKey(key); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "InvalidKeyName")
+	}
+
+	if err := checkTestBucket(bucketName); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	upload, err := s.openUpload(ctx, bucketName, key, req.GetUploadId(), policy.ActionPutObject)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	server, err := s.store.CradleServers().SelectForUpload(ctx)
+	if err != nil {
+		detail := &servicev1.PlanWriteError{
+			Reason: servicev1.PlanWriteError_REASON_NO_CRADLE_SERVERS,
+			Bucket: bucketName,
+		}
+		st := status.New(codes.FailedPrecondition, err.Error())
+		withDetail, err := st.WithDetails(detail)
+		if err != nil {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+		}
+		return nil, loggrpc.SetError(ctx, withDetail.Err())
+	}
+
+	blobID := store.NewID()
+	if _, err := s.store.Multipart().CreatePendingPart(ctx, blobID, upload.ID, partNumber, size, server.ID, time.Now().UTC()); err != nil {
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	loggrpc.SetAttrs(ctx,
+		slog.String("result", fmt.Sprintf("part %d of upload %s (%d bytes) created, write to %s",
+			partNumber, upload.ID, size, server.Address)))
+
+	return &servicev1.PlanPartResponse{
+		WritePlan: &writeplanv1.WritePlan{
+			ObjectId:      blobID,
+			CradleAddress: server.Address,
+		},
+	}, nil
+}
@@ -0,0 +1,171 @@
+package grpcsvc
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_PlanPart(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name               string
+		key                string
+		uploadID           string
+		partNumber         int32
+		size               int64
+		selectForUploadErr error
+		wantErr            bool
+		wantCode           codes.Code
+		wantMessage        string
+		wantNoCradleDetail bool
+	}
+
+	cases := []tc{
+		{
+			name:       "plans part on a cradle",
+			key:        "videos/big.mp4",
+			uploadID:   "upload-id-1",
+			partNumber: 1,
+			size:       5 * 1024 * 1024,
+		},
+		{
+			name:        "unknown upload returns NoSuchUpload",
+			key:         "videos/big.mp4",
+			uploadID:    "upload-id-missing",
+			partNumber:  1,
+			size:        1024,
+			wantErr:     true,
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchUpload",
+		},
+		{
+			name:        "upload of another key returns NoSuchUpload",
+			key:         "videos/other.mp4",
+			uploadID:    "upload-id-1",
+			partNumber:  1,
+			size:        1024,
+			wantErr:     true,
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchUpload",
+		},
+		{
+			name:        "part number zero returns InvalidArgument",
+			key:         "videos/big.mp4",
+			uploadID:    "upload-id-1",
+			partNumber:  0,
+			size:        1024,
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidArgument",
+		},
+		{
+			name:        "part number above 10000 returns InvalidArgument",
+			key:         "videos/big.mp4",
+			uploadID:    "upload-id-1",
+			partNumber:  10001,
+			size:        1024,
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidArgument",
+		},
+		{
+			name:        "part larger than 5 GiB returns EntityTooLarge",
+			key:         "videos/big.mp4",
+			uploadID:    "upload-id-1",
+			partNumber:  1,
+			size:        maxPutBytes + 1,
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "EntityTooLarge",
+		},
+		{
+			name:        "zero size returns InvalidSize",
+			key:         "videos/big.mp4",
+			uploadID:    "upload-id-1",
+			partNumber:  1,
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidSize",
+		},
+		{
+			name:               "no cradle servers returns FailedPrecondition",
+			key:                "videos/big.mp4",
+			uploadID:           "upload-id-1",
+			partNumber:         1,
+			size:               1024,
+			selectForUploadErr: store.ErrNoCradleServersAvailable,
+			wantErr:            true,
+			wantCode:           codes.FailedPrecondition,
+			wantMessage:        store.ErrNoCradleServersAvailable.Error(),
+			wantNoCradleDetail: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+
+			buckets, uploads := newUploadFakes()
+
+			cradles := testutil.NewFakeCradleStore()
+			cradles.SetSelectForUploadResponse(store.CradleServerRecord{ID: "cradle-id-456", Address: "127.0.0.1:9444"})
+			if c.selectForUploadErr != nil {
+				cradles.SetSelectForUploadError(c.selectForUploadErr)
+			}
+
+			svc.store = testutil.NewFakeStore(
+				testutil.WithBuckets(buckets),
+				testutil.WithCradles(cradles),
+				testutil.WithMultipart(uploads),
+			)
+
+			resp, err := svc.PlanPart(context.Background(), &servicev1.PlanPartRequest{
+				Bucket:     "my-bucket",
+				Key:        c.key,
+				UploadId:   c.uploadID,
+				PartNumber: c.partNumber,
+				Size:       c.size,
+			})
+
+			if c.wantErr {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				if c.wantNoCradleDetail {
+					st, _ := status.FromError(err)
+					detail, ok := st.Details()[0].(*servicev1.PlanWriteError)
+					if !ok || detail.GetReason() != servicev1.PlanWriteError_REASON_NO_CRADLE_SERVERS {
+						t.Fatalf("PlanWriteError detail: got %v", st.Details())
+					}
+				}
+				if got := uploads.CreatePartCalls(); len(got) != 0 {
+					t.Fatalf("CreatePendingPart calls: got %d, want 0", len(got))
+				}
+				return
+			}
+
+			assertNoError(t, err)
+
+			calls := uploads.CreatePartCalls()
+			if len(calls) != 1 {
+				t.Fatalf("CreatePendingPart calls: got %d, want 1", len(calls))
+			}
+			got := calls[0]
+			if got.BlobID != resp.GetWritePlan().GetObjectId() || got.UploadID != "upload-id-1" ||
+				got.PartNumber != c.partNumber || got.SizeExpected != c.size || got.CradleServerID != "cradle-id-456" {
+				t.Fatalf("CreatePendingPart call: got %+v", got)
+			}
+			if resp.GetWritePlan().GetCradleAddress() != "127.0.0.1:9444" {
+				t.Fatalf("cradle_address: got %q, want %q", resp.GetWritePlan().GetCradleAddress(), "127.0.0.1:9444")
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
 	"github.com/ratdaddy/blockcloset/gantry/internal/store"
 	"github.com/ratdaddy/blockcloset/loggrpc"
 	"github.com/ratdaddy/blockcloset/pkg/validation"
@@ -22,6 +23,7 @@ func (s *Service) PlanWrite(ctx context.Context, req *servicev1.PlanWriteRequest
 	bucketName := req.GetBucket()
 	key := req.GetKey()
 	size := req.GetSize()
+	contentType := req.GetContentType()
 
 	bucketValidator := validation.DefaultBucketNameValidator{}
 	keyValidator := validation.DefaultKeyValidator{}
@@ -45,6 +47,25 @@ func (s *Service) PlanWrite(ctx context.Context, req *servicev1.PlanWriteRequest
 		return nil, status.Error(codes.InvalidArgument, "InvalidKeyName")
 	}
 
+	metadata, err := metadataRows(req.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	tags := req.GetTags()
+	if err := validateTags(tags); err != nil {
+		return nil, invalidTags(ctx, err)
+	}
+
+	now := time.Now().UTC()
+	lock := store.ObjectLock{Mode: req.GetObjectLockMode(), LegalHold: req.GetObjectLockLegalHold()}
+	if retainUntilMs := req.GetObjectLockRetainUntilMs(); lock.Mode != "" || retainUntilMs != 0 {
+		if err := validateRetention(lock.Mode, retainUntilMs, now); err != nil {
+			return nil, invalidRetention(ctx, err)
+		}
+		lock.RetainUntil = time.UnixMilli(retainUntilMs).UTC()
+	}
+
 	if err := checkTestBucket(bucketName); err != nil {
 		return nil, loggrpc.SetError(ctx, err)
 	}
@@ -65,6 +86,43 @@ func (s *Service) PlanWrite(ctx context.Context, req *servicev1.PlanWriteRequest
 		return nil, loggrpc.SetError(ctx, withDetail.Err())
 	}
 
+	if err := s.authorize(ctx, bucket, policy.ActionPutObject, key); err != nil {
+		if status.Code(err) != codes.PermissionDenied {
+			return nil, loggrpc.SetError(ctx, err)
+		}
+		detail := &servicev1.PlanWriteError{
+			Reason: servicev1.PlanWriteError_REASON_BUCKET_ACCESS_DENIED,
+			Bucket: bucketName,
+		}
+		withDetail, err := status.Convert(err).WithDetails(detail)
+		if err != nil {
+			return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+		}
+		return nil, loggrpc.SetError(ctx, withDetail.Err())
+	}
+
+	if len(tags) > 0 {
+		if err := s.authorize(ctx, bucket, policy.ActionPutObjectTagging, key); err != nil {
+			return nil, loggrpc.SetError(ctx, err)
+		}
+	}
+
+	if lock != (store.ObjectLock{}) {
+		if err := requireObjectLock(ctx, bucket); err != nil {
+			return nil, loggrpc.SetError(ctx, err)
+		}
+	}
+	if lock.Mode != "" {
+		if err := s.authorize(ctx, bucket, policy.ActionPutObjectRetention, key); err != nil {
+			return nil, loggrpc.SetError(ctx, err)
+		}
+	}
+	if lock.LegalHold {
+		if err := s.authorize(ctx, bucket, policy.ActionPutObjectLegalHold, key); err != nil {
+			return nil, loggrpc.SetError(ctx, err)
+		}
+	}
+
 	cradle_servers := s.store.CradleServers()
 	server, err := cradle_servers.SelectForUpload(ctx)
 	if err != nil {
@@ -81,9 +139,8 @@ func (s *Service) PlanWrite(ctx context.Context, req *servicev1.PlanWriteRequest
 	}
 
 	objectID := store.NewID()
-	now := time.Now().UTC()
 	objects := s.store.Objects()
-	if _, err = objects.CreatePending(ctx, objectID, bucket.ID, key, size, server.ID, now); err != nil {
+	if _, err = objects.CreatePending(ctx, objectID, bucket.ID, key, size, contentType, metadata, tags, lock, server.ID, now); err != nil {
 		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
 	}
 
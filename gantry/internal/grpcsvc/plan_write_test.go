@@ -3,7 +3,10 @@ package grpcsvc
 import (
 	"context"
 	"errors"
+	"maps"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/oklog/ulid/v2"
 	"google.golang.org/grpc/codes"
@@ -11,18 +14,29 @@ import (
 
 	"github.com/ratdaddy/blockcloset/gantry/internal/store"
 	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	objectv1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/object/v1"
 	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
 )
 
 func TestService_PlanWrite(t *testing.T) {
 	t.Parallel()
 
+	retainUntil := time.Now().UTC().AddDate(0, 0, 90).Truncate(time.Millisecond)
+
 	type tc struct {
 		name                  string
 		bucket                string
 		key                   string
 		size                  int64
+		contentType           string
+		metadata              *objectv1.ObjectMetadata
+		tags                  map[string]string
+		lockMode              string
+		lockRetainUntilMs     int64
+		legalHold             bool
+		objectLock            *store.ObjectLockConfig
 		bucketID              string
+		ownerID               string
 		getByNameErr          error
 		cradleID              string
 		cradleAddress         string
@@ -35,6 +49,8 @@ func TestService_PlanWrite(t *testing.T) {
 		wantErrorReason       servicev1.PlanWriteError_Reason
 		wantObjectID          bool
 		wantCradleAddress     string
+		wantMetadata          map[string]string
+		wantLock              store.ObjectLock
 		expectGetByNameCall   bool
 		expectSelectForUpload bool
 		expectObjectCreate    bool
@@ -46,15 +62,131 @@ func TestService_PlanWrite(t *testing.T) {
 			bucket:                "my-bucket",
 			key:                   "my-key.txt",
 			size:                  1024,
+			contentType:           "text/plain",
+			bucketID:              "bucket-id-123",
+			cradleID:              "cradle-id-456",
+			cradleAddress:         "127.0.0.1:9444",
+			wantObjectID:          true,
+			wantCradleAddress:     "127.0.0.1:9444",
+			expectGetByNameCall:   true,
+			expectSelectForUpload: true,
+			expectObjectCreate:    true,
+		},
+		{
+			name:        "metadata is stored with the pending object",
+			bucket:      "my-bucket",
+			key:         "my-key.txt",
+			size:        1024,
+			contentType: "text/plain",
+			metadata: &objectv1.ObjectMetadata{
+				ContentEncoding: "gzip",
+				CacheControl:    "max-age=60",
+				UserMetadata:    map[string]string{"Color": "blue"},
+			},
+			bucketID:          "bucket-id-123",
+			cradleID:          "cradle-id-456",
+			cradleAddress:     "127.0.0.1:9444",
+			wantObjectID:      true,
+			wantCradleAddress: "127.0.0.1:9444",
+			wantMetadata: map[string]string{
+				"content-encoding": "gzip",
+				"cache-control":    "max-age=60",
+				"x-amz-meta-color": "blue",
+			},
+			expectGetByNameCall:   true,
+			expectSelectForUpload: true,
+			expectObjectCreate:    true,
+		},
+		{
+			name:   "user metadata over 2 KB returns InvalidArgument",
+			bucket: "my-bucket",
+			key:    "my-key.txt",
+			size:   1024,
+			metadata: &objectv1.ObjectMetadata{
+				UserMetadata: map[string]string{"notes": strings.Repeat("a", 2044)},
+			},
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "MetadataTooLarge",
+		},
+		{
+			name:                  "tags are stored with the pending object",
+			bucket:                "my-bucket",
+			key:                   "backups/db.tar",
+			size:                  1024,
+			tags:                  map[string]string{"host": "web-1"},
+			bucketID:              "bucket-id-123",
+			cradleID:              "cradle-id-456",
+			cradleAddress:         "127.0.0.1:9444",
+			wantObjectID:          true,
+			wantCradleAddress:     "127.0.0.1:9444",
+			expectGetByNameCall:   true,
+			expectSelectForUpload: true,
+			expectObjectCreate:    true,
+		},
+		{
+			name:        "invalid tag returns InvalidArgument",
+			bucket:      "my-bucket",
+			key:         "backups/db.tar",
+			size:        1024,
+			tags:        map[string]string{"aws:host": "web-1"},
+			wantErr:     true,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "InvalidTag",
+		},
+		{
+			name:                  "object lock is stored with the pending object",
+			bucket:                "my-bucket",
+			key:                   "backups/db.tar",
+			size:                  1024,
+			lockMode:              store.RetentionCompliance,
+			lockRetainUntilMs:     retainUntil.UnixMilli(),
+			legalHold:             true,
+			objectLock:            &store.ObjectLockConfig{},
 			bucketID:              "bucket-id-123",
 			cradleID:              "cradle-id-456",
 			cradleAddress:         "127.0.0.1:9444",
 			wantObjectID:          true,
 			wantCradleAddress:     "127.0.0.1:9444",
+			wantLock:              store.ObjectLock{Mode: store.RetentionCompliance, RetainUntil: retainUntil, LegalHold: true},
 			expectGetByNameCall:   true,
 			expectSelectForUpload: true,
 			expectObjectCreate:    true,
 		},
+		{
+			name:              "invalid retention mode returns InvalidArgument",
+			bucket:            "my-bucket",
+			key:               "backups/db.tar",
+			size:              1024,
+			lockMode:          "FOREVER",
+			lockRetainUntilMs: retainUntil.UnixMilli(),
+			wantErr:           true,
+			wantCode:          codes.InvalidArgument,
+			wantMessage:       "InvalidArgument",
+		},
+		{
+			name:              "retention in the past returns InvalidArgument",
+			bucket:            "my-bucket",
+			key:               "backups/db.tar",
+			size:              1024,
+			lockMode:          store.RetentionGovernance,
+			lockRetainUntilMs: time.Now().Add(-time.Hour).UnixMilli(),
+			wantErr:           true,
+			wantCode:          codes.InvalidArgument,
+			wantMessage:       "InvalidArgument",
+		},
+		{
+			name:                "legal hold without bucket object lock returns InvalidRequest",
+			bucket:              "my-bucket",
+			key:                 "backups/db.tar",
+			size:                1024,
+			legalHold:           true,
+			bucketID:            "bucket-id-123",
+			wantErr:             true,
+			wantCode:            codes.InvalidArgument,
+			wantMessage:         "InvalidRequest",
+			expectGetByNameCall: true,
+		},
 		{
 			name:                "bucket not found returns NotFound",
 			bucket:              "nonexistent-bucket",
@@ -68,6 +200,20 @@ func TestService_PlanWrite(t *testing.T) {
 			wantErrorReason:     servicev1.PlanWriteError_REASON_BUCKET_NOT_FOUND,
 			expectGetByNameCall: true,
 		},
+		{
+			name:                "bucket owned by someone else returns PermissionDenied",
+			bucket:              "my-bucket",
+			key:                 "my-key.txt",
+			size:                1024,
+			bucketID:            "bucket-id-123",
+			ownerID:             "user-alice",
+			wantErr:             true,
+			wantCode:            codes.PermissionDenied,
+			wantMessage:         "AccessDenied",
+			wantErrorDetail:     true,
+			wantErrorReason:     servicev1.PlanWriteError_REASON_BUCKET_ACCESS_DENIED,
+			expectGetByNameCall: true,
+		},
 		{
 			name:                  "no cradle servers returns FailedPrecondition",
 			bucket:                "my-bucket",
@@ -141,15 +287,17 @@ func TestService_PlanWrite(t *testing.T) {
 			t.Parallel()
 
 			logger := newDiscardLogger()
-			svc := New(logger, nil)
+			svc := New(logger, nil, nil)
 
 			buckets := testutil.NewFakeBucketStore()
 			if c.getByNameErr != nil {
 				buckets.SetGetByNameError(c.getByNameErr)
 			} else if c.bucketID != "" {
 				buckets.SetGetByNameResponse(store.BucketRecord{
-					ID:   c.bucketID,
-					Name: c.bucket,
+					ID:         c.bucketID,
+					Name:       c.bucket,
+					OwnerID:    c.ownerID,
+					ObjectLock: c.objectLock,
 				})
 			}
 
@@ -176,9 +324,16 @@ func TestService_PlanWrite(t *testing.T) {
 			)
 
 			resp, err := svc.PlanWrite(context.Background(), &servicev1.PlanWriteRequest{
-				Bucket: c.bucket,
-				Key:    c.key,
-				Size:   c.size,
+				Bucket:      c.bucket,
+				Key:         c.key,
+				Size:        c.size,
+				ContentType: c.contentType,
+				Metadata:    c.metadata,
+				Tags:        c.tags,
+
+				ObjectLockMode:          c.lockMode,
+				ObjectLockRetainUntilMs: c.lockRetainUntilMs,
+				ObjectLockLegalHold:     c.legalHold,
 			})
 
 			if c.expectGetByNameCall {
@@ -196,6 +351,9 @@ func TestService_PlanWrite(t *testing.T) {
 				if c.wantErrorDetail {
 					assertPlanWriteErrorDetail(t, err, c.wantErrorReason, c.bucket)
 				}
+				if !c.expectSelectForUpload && cradles.SelectForUploadCallCount() != 0 {
+					t.Fatalf("SelectForUpload calls: got %d, want 0", cradles.SelectForUploadCallCount())
+				}
 				return
 			}
 
@@ -244,6 +402,18 @@ func TestService_PlanWrite(t *testing.T) {
 				if call.SizeExpected != c.size {
 					t.Fatalf("CreatePending size: got %d, want %d", call.SizeExpected, c.size)
 				}
+				if call.ContentType != c.contentType {
+					t.Fatalf("CreatePending content_type: got %q, want %q", call.ContentType, c.contentType)
+				}
+				if !maps.Equal(call.Metadata, c.wantMetadata) {
+					t.Fatalf("CreatePending metadata: got %v, want %v", call.Metadata, c.wantMetadata)
+				}
+				if !maps.Equal(call.Tags, c.tags) {
+					t.Fatalf("CreatePending tags: got %v, want %v", call.Tags, c.tags)
+				}
+				if call.Lock.Mode != c.wantLock.Mode || !call.Lock.RetainUntil.Equal(c.wantLock.RetainUntil) || call.Lock.LegalHold != c.wantLock.LegalHold {
+					t.Fatalf("CreatePending lock: got %+v, want %+v", call.Lock, c.wantLock)
+				}
 				if call.CradleServerID != c.cradleID {
 					t.Fatalf("CreatePending cradle_server_id: got %q, want %q", call.CradleServerID, c.cradleID)
 				}
@@ -0,0 +1,38 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func (s *Service) PutBucketAcl(ctx context.Context, req *servicev1.PutBucketAclRequest) (*servicev1.PutBucketAclResponse, error) {
+	acl := req.GetAcl()
+	if !validCannedACL(acl) {
+		return nil, status.Error(codes.InvalidArgument, "InvalidArgument")
+	}
+	if acl == "" {
+		acl = store.BucketACLPrivate
+	}
+
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if err := s.store.Buckets().SetACL(ctx, bucket.ID, acl, time.Now().UTC()); err != nil {
+		return nil, loggrpc.SetError(ctx, setBucketError(err))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> acl set to %s", bucket.Name, acl)))
+
+	return &servicev1.PutBucketAclResponse{}, nil
+}
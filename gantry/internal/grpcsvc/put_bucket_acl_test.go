@@ -0,0 +1,57 @@
+package grpcsvc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_PutBucketAcl(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		caller      string
+		acl         string
+		wantACL     string
+		wantCode    codes.Code
+		wantMessage string
+	}
+
+	cases := []tc{
+		{name: "owner sets canned acl", caller: "user-alice", acl: "public-read", wantACL: "public-read"},
+		{name: "empty acl resets to private", caller: "user-alice", wantACL: "private"},
+		{name: "unknown acl returns InvalidArgument", caller: "user-alice", acl: "everyone", wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "other user returns AccessDenied", caller: "user-bob", acl: "private", wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice"})
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			_, err := svc.PutBucketAcl(callerContext(c.caller), &servicev1.PutBucketAclRequest{Bucket: "my-bucket", Acl: c.acl})
+
+			calls := buckets.SetACLCalls()
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				if len(calls) != 0 {
+					t.Fatalf("SetACL calls: got %v, want none", calls)
+				}
+				return
+			}
+			assertNoError(t, err)
+			if len(calls) != 1 || calls[0].ID != "bucket-id-123" || calls[0].Value != c.wantACL {
+				t.Fatalf("SetACL calls: got %+v, want one with %q", calls, c.wantACL)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// S3's limits on a CORS configuration.
+const (
+	maxCORSRules  = 100
+	maxCORSRuleID = 255
+)
+
+// corsMethods are the methods a CORS rule may allow.
+var corsMethods = map[string]bool{
+	"GET":    true,
+	"PUT":    true,
+	"HEAD":   true,
+	"POST":   true,
+	"DELETE": true,
+}
+
+var errInvalidCORSRule = errors.New("invalid CORS rule")
+
+// PutBucketCors validates and stores a bucket's CORS rules, replacing any
+// existing ones.
+func (s *Service) PutBucketCors(ctx context.Context, req *servicev1.PutBucketCorsRequest) (*servicev1.PutBucketCorsResponse, error) {
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	rules, err := corsRules(req.GetRules())
+	if err != nil {
+		loggrpc.SetAttrs(ctx, slog.String("reason", err.Error()))
+		code := "InvalidArgument"
+		if errors.Is(err, errInvalidCORSRule) {
+			code = "InvalidRequest"
+		}
+		return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, code))
+	}
+
+	if err := s.store.Buckets().SetCORS(ctx, bucket.ID, rules, time.Now().UTC()); err != nil {
+		return nil, loggrpc.SetError(ctx, setBucketError(err))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> cors set with %d rules", bucket.Name, len(rules))))
+
+	return &servicev1.PutBucketCorsResponse{}, nil
+}
+
+// corsRules validates the rules of a CORS configuration and converts them
+// for the store.
+func corsRules(rules []*servicev1.CorsRule) ([]store.CORSRule, error) {
+	if len(rules) == 0 || len(rules) > maxCORSRules {
+		return nil, fmt.Errorf("configuration has %d rules, want 1 to %d", len(rules), maxCORSRules)
+	}
+
+	out := make([]store.CORSRule, 0, len(rules))
+	for i, r := range rules {
+		rule, err := corsRule(r)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		out = append(out, rule)
+	}
+
+	return out, nil
+}
+
+func corsRule(r *servicev1.CorsRule) (store.CORSRule, error) {
+	rule := store.CORSRule{
+		ID:             r.GetId(),
+		AllowedOrigins: r.GetAllowedOrigins(),
+		AllowedMethods: r.GetAllowedMethods(),
+		AllowedHeaders: r.GetAllowedHeaders(),
+		ExposeHeaders:  r.GetExposeHeaders(),
+		MaxAgeSeconds:  int(r.GetMaxAgeSeconds()),
+	}
+
+	switch {
+	case len(rule.ID) > maxCORSRuleID:
+		return store.CORSRule{}, fmt.Errorf("ID longer than %d characters", maxCORSRuleID)
+	case rule.MaxAgeSeconds < 0:
+		return store.CORSRule{}, errors.New("negative max age")
+	case len(rule.AllowedOrigins) == 0 || len(rule.AllowedMethods) == 0:
+		return store.CORSRule{}, fmt.Errorf("%w: no allowed origin or method", errInvalidCORSRule)
+	}
+
+	for _, m := range rule.AllowedMethods {
+		if !corsMethods[m] {
+			return store.CORSRule{}, fmt.Errorf("%w: unsupported method %q", errInvalidCORSRule, m)
+		}
+	}
+	for _, origin := range rule.AllowedOrigins {
+		if origin == "" || strings.Count(origin, "*") > 1 {
+			return store.CORSRule{}, fmt.Errorf("%w: allowed origin %q", errInvalidCORSRule, origin)
+		}
+	}
+	for _, header := range rule.AllowedHeaders {
+		if header == "" || strings.Count(header, "*") > 1 {
+			return store.CORSRule{}, fmt.Errorf("%w: allowed header %q", errInvalidCORSRule, header)
+		}
+	}
+
+	return rule, nil
+}
+
+// corsRulesToProto converts a bucket's stored CORS rules for GetBucketCors
+// and LookupBucketCors.
+func corsRulesToProto(rules []store.CORSRule) []*servicev1.CorsRule {
+	out := make([]*servicev1.CorsRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, &servicev1.CorsRule{
+			Id:             r.ID,
+			AllowedOrigins: r.AllowedOrigins,
+			AllowedMethods: r.AllowedMethods,
+			AllowedHeaders: r.AllowedHeaders,
+			ExposeHeaders:  r.ExposeHeaders,
+			MaxAgeSeconds:  int32(r.MaxAgeSeconds),
+		})
+	}
+	return out
+}
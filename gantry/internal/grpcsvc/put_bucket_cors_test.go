@@ -0,0 +1,102 @@
+package grpcsvc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_PutBucketCors(t *testing.T) {
+	t.Parallel()
+
+	gallery := &servicev1.CorsRule{
+		Id:             "gallery",
+		AllowedOrigins: []string{"https://gallery.example.com"},
+		AllowedMethods: []string{"GET", "HEAD"},
+		AllowedHeaders: []string{"*"},
+		ExposeHeaders:  []string{"ETag"},
+		MaxAgeSeconds:  3000,
+	}
+	galleryRule := store.CORSRule{
+		ID:             "gallery",
+		AllowedOrigins: []string{"https://gallery.example.com"},
+		AllowedMethods: []string{"GET", "HEAD"},
+		AllowedHeaders: []string{"*"},
+		ExposeHeaders:  []string{"ETag"},
+		MaxAgeSeconds:  3000,
+	}
+
+	type tc struct {
+		name        string
+		caller      string
+		rules       []*servicev1.CorsRule
+		setErr      error
+		wantRules   []store.CORSRule
+		wantCode    codes.Code
+		wantMessage string
+	}
+
+	cases := []tc{
+		{name: "owner sets rules", caller: "user-alice", rules: []*servicev1.CorsRule{gallery}, wantRules: []store.CORSRule{galleryRule}},
+		{
+			name:      "wildcard origin and subdomain",
+			caller:    "user-alice",
+			rules:     []*servicev1.CorsRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}}, {AllowedOrigins: []string{"https://*.example.com"}, AllowedMethods: []string{"PUT", "POST", "DELETE"}}},
+			wantRules: []store.CORSRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}}, {AllowedOrigins: []string{"https://*.example.com"}, AllowedMethods: []string{"PUT", "POST", "DELETE"}}},
+		},
+		{name: "no rules", caller: "user-alice", wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "too many rules", caller: "user-alice", rules: make([]*servicev1.CorsRule, 101), wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "rule ID too long", caller: "user-alice", rules: []*servicev1.CorsRule{{Id: strings.Repeat("r", 256), AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "negative max age", caller: "user-alice", rules: []*servicev1.CorsRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, MaxAgeSeconds: -1}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "no origin", caller: "user-alice", rules: []*servicev1.CorsRule{{AllowedMethods: []string{"GET"}}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidRequest"},
+		{name: "no method", caller: "user-alice", rules: []*servicev1.CorsRule{{AllowedOrigins: []string{"*"}}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidRequest"},
+		{name: "unsupported method", caller: "user-alice", rules: []*servicev1.CorsRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"PATCH"}}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidRequest"},
+		{name: "two wildcards in an origin", caller: "user-alice", rules: []*servicev1.CorsRule{{AllowedOrigins: []string{"https://*.*.example.com"}, AllowedMethods: []string{"GET"}}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidRequest"},
+		{name: "two wildcards in a header", caller: "user-alice", rules: []*servicev1.CorsRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, AllowedHeaders: []string{"x-*-*"}}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidRequest"},
+		{name: "other user returns AccessDenied", caller: "user-bob", rules: []*servicev1.CorsRule{gallery}, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{
+			name:        "bucket deleted meanwhile returns NoSuchBucket",
+			caller:      "user-alice",
+			rules:       []*servicev1.CorsRule{gallery},
+			setErr:      store.ErrBucketNotFound,
+			wantRules:   []store.CORSRule{galleryRule},
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchBucket",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice"})
+			buckets.SetSetCORSError(c.setErr)
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			_, err := svc.PutBucketCors(callerContext(c.caller), &servicev1.PutBucketCorsRequest{Bucket: "my-bucket", Rules: c.rules})
+
+			calls := buckets.SetCORSCalls()
+			if c.wantRules != nil {
+				if len(calls) != 1 || calls[0].ID != "bucket-id-123" || !reflect.DeepEqual(calls[0].Rules, c.wantRules) {
+					t.Fatalf("SetCORS calls: got %+v, want one with %+v", calls, c.wantRules)
+				}
+			} else if len(calls) != 0 {
+				t.Fatalf("SetCORS calls: got %+v, want none", calls)
+			}
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+		})
+	}
+}
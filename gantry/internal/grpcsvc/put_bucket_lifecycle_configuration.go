@@ -0,0 +1,134 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// S3's limits on a lifecycle configuration.
+const (
+	maxLifecycleRules  = 1000
+	maxLifecycleRuleID = 255
+)
+
+var errNoLifecycleAction = errors.New("rule has no action")
+
+// PutBucketLifecycleConfiguration validates and stores a bucket's lifecycle
+// rules, replacing any existing ones. The lifecycle worker picks them up on
+// its next sweep.
+func (s *Service) PutBucketLifecycleConfiguration(ctx context.Context, req *servicev1.PutBucketLifecycleConfigurationRequest) (*servicev1.PutBucketLifecycleConfigurationResponse, error) {
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	rules, err := lifecycleRules(req.GetRules())
+	if err != nil {
+		loggrpc.SetAttrs(ctx, slog.String("reason", err.Error()))
+		code := "InvalidArgument"
+		if errors.Is(err, errNoLifecycleAction) {
+			code = "InvalidRequest"
+		}
+		return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, code))
+	}
+
+	if err := s.store.Buckets().SetLifecycle(ctx, bucket.ID, rules, time.Now().UTC()); err != nil {
+		return nil, loggrpc.SetError(ctx, setBucketError(err))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> lifecycle set with %d rules", bucket.Name, len(rules))))
+
+	return &servicev1.PutBucketLifecycleConfigurationResponse{}, nil
+}
+
+// lifecycleRules validates the rules of a lifecycle configuration and
+// converts them for the store.
+func lifecycleRules(rules []*servicev1.LifecycleRule) ([]store.LifecycleRule, error) {
+	if len(rules) == 0 || len(rules) > maxLifecycleRules {
+		return nil, fmt.Errorf("configuration has %d rules, want 1 to %d", len(rules), maxLifecycleRules)
+	}
+
+	out := make([]store.LifecycleRule, 0, len(rules))
+	ids := make(map[string]bool, len(rules))
+	for i, r := range rules {
+		rule, err := lifecycleRule(r)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		if rule.ID != "" {
+			if ids[rule.ID] {
+				return nil, fmt.Errorf("rule %d: ID %q is not unique", i+1, rule.ID)
+			}
+			ids[rule.ID] = true
+		}
+		out = append(out, rule)
+	}
+
+	return out, nil
+}
+
+func lifecycleRule(r *servicev1.LifecycleRule) (store.LifecycleRule, error) {
+	rule := store.LifecycleRule{
+		ID:                        r.GetId(),
+		Enabled:                   r.GetEnabled(),
+		Prefix:                    r.GetPrefix(),
+		ExpirationDays:            int(r.GetExpirationDays()),
+		ExpiredObjectDeleteMarker: r.GetExpiredObjectDeleteMarker(),
+		NoncurrentDays:            int(r.GetNoncurrentDays()),
+		NewerNoncurrentVersions:   int(r.GetNewerNoncurrentVersions()),
+	}
+
+	if len(rule.ID) > maxLifecycleRuleID {
+		return store.LifecycleRule{}, fmt.Errorf("ID longer than %d characters", maxLifecycleRuleID)
+	}
+
+	if len(r.GetTags()) > 0 {
+		rule.Tags = make(map[string]string, len(r.GetTags()))
+		for k, v := range r.GetTags() {
+			if k == "" {
+				return store.LifecycleRule{}, errors.New("tag filter with an empty key")
+			}
+			rule.Tags[k] = v
+		}
+	}
+
+	if rule.ExpirationDays < 0 || rule.NoncurrentDays < 0 || rule.NewerNoncurrentVersions < 0 {
+		return store.LifecycleRule{}, errors.New("negative days or version count")
+	}
+
+	if date := r.GetExpirationDateRfc3339(); date != "" {
+		t, err := time.Parse(time.RFC3339, date)
+		if err != nil {
+			return store.LifecycleRule{}, fmt.Errorf("expiration date: %w", err)
+		}
+		t = t.UTC()
+		if !t.Equal(t.Truncate(24 * time.Hour)) {
+			return store.LifecycleRule{}, errors.New("expiration date is not midnight UTC")
+		}
+		rule.ExpirationDate = t
+	}
+
+	expires := rule.ExpirationDays > 0 || !rule.ExpirationDate.IsZero()
+	switch {
+	case rule.ExpirationDays > 0 && !rule.ExpirationDate.IsZero():
+		return store.LifecycleRule{}, errors.New("expiration has both days and a date")
+	case expires && rule.ExpiredObjectDeleteMarker:
+		return store.LifecycleRule{}, errors.New("expired object delete marker with expiration days or date")
+	case rule.NewerNoncurrentVersions > 0 && rule.NoncurrentDays == 0:
+		return store.LifecycleRule{}, errors.New("newer noncurrent versions without noncurrent days")
+	case !expires && !rule.ExpiredObjectDeleteMarker && rule.NoncurrentDays == 0:
+		return store.LifecycleRule{}, errNoLifecycleAction
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,101 @@
+package grpcsvc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_PutBucketLifecycleConfiguration(t *testing.T) {
+	t.Parallel()
+
+	footage := &servicev1.LifecycleRule{Id: "footage", Enabled: true, Prefix: "footage/", ExpirationDays: 30}
+
+	type tc struct {
+		name        string
+		caller      string
+		rules       []*servicev1.LifecycleRule
+		setErr      error
+		wantRules   []store.LifecycleRule
+		wantCode    codes.Code
+		wantMessage string
+	}
+
+	cases := []tc{
+		{
+			name:      "owner sets expiration by days",
+			caller:    "user-alice",
+			rules:     []*servicev1.LifecycleRule{footage},
+			wantRules: []store.LifecycleRule{{ID: "footage", Enabled: true, Prefix: "footage/", ExpirationDays: 30}},
+		},
+		{
+			name:   "expiration date, tag filter and noncurrent expiry",
+			caller: "user-alice",
+			rules: []*servicev1.LifecycleRule{
+				{Enabled: true, Tags: map[string]string{"retain": "short"}, ExpirationDateRfc3339: "2026-01-01T00:00:00Z"},
+				{NoncurrentDays: 7, NewerNoncurrentVersions: 2, ExpiredObjectDeleteMarker: true},
+			},
+			wantRules: []store.LifecycleRule{
+				{Enabled: true, Tags: map[string]string{"retain": "short"}, ExpirationDate: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)},
+				{NoncurrentDays: 7, NewerNoncurrentVersions: 2, ExpiredObjectDeleteMarker: true},
+			},
+		},
+		{name: "no rules", caller: "user-alice", wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "rule without action", caller: "user-alice", rules: []*servicev1.LifecycleRule{{Enabled: true, Prefix: "logs/"}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidRequest"},
+		{name: "duplicate rule IDs", caller: "user-alice", rules: []*servicev1.LifecycleRule{footage, footage}, wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "rule ID too long", caller: "user-alice", rules: []*servicev1.LifecycleRule{{Id: strings.Repeat("r", 256), ExpirationDays: 1}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "negative days", caller: "user-alice", rules: []*servicev1.LifecycleRule{{ExpirationDays: -1}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "days and date", caller: "user-alice", rules: []*servicev1.LifecycleRule{{ExpirationDays: 1, ExpirationDateRfc3339: "2026-01-01T00:00:00Z"}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "date not at midnight", caller: "user-alice", rules: []*servicev1.LifecycleRule{{ExpirationDateRfc3339: "2026-01-01T12:00:00Z"}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "unparseable date", caller: "user-alice", rules: []*servicev1.LifecycleRule{{ExpirationDateRfc3339: "next tuesday"}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "delete marker cleanup with expiration", caller: "user-alice", rules: []*servicev1.LifecycleRule{{ExpirationDays: 1, ExpiredObjectDeleteMarker: true}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "newer noncurrent versions without days", caller: "user-alice", rules: []*servicev1.LifecycleRule{{ExpirationDays: 1, NewerNoncurrentVersions: 1}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "empty tag key", caller: "user-alice", rules: []*servicev1.LifecycleRule{{ExpirationDays: 1, Tags: map[string]string{"": "x"}}}, wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "other user returns AccessDenied", caller: "user-bob", rules: []*servicev1.LifecycleRule{footage}, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{
+			name:        "bucket deleted meanwhile returns NoSuchBucket",
+			caller:      "user-alice",
+			rules:       []*servicev1.LifecycleRule{footage},
+			setErr:      store.ErrBucketNotFound,
+			wantRules:   []store.LifecycleRule{{ID: "footage", Enabled: true, Prefix: "footage/", ExpirationDays: 30}},
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchBucket",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice"})
+			buckets.SetSetLifecycleError(c.setErr)
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			_, err := svc.PutBucketLifecycleConfiguration(callerContext(c.caller), &servicev1.PutBucketLifecycleConfigurationRequest{Bucket: "my-bucket", Rules: c.rules})
+
+			calls := buckets.SetLifecycleCalls()
+			if c.wantRules != nil {
+				if len(calls) != 1 || calls[0].ID != "bucket-id-123" || !reflect.DeepEqual(calls[0].Rules, c.wantRules) {
+					t.Fatalf("SetLifecycle calls: got %+v, want one with %+v", calls, c.wantRules)
+				}
+			} else if len(calls) != 0 {
+				t.Fatalf("SetLifecycle calls: got %+v, want none", calls)
+			}
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+		})
+	}
+}
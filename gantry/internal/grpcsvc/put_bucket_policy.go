@@ -0,0 +1,41 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// PutBucketPolicy validates and stores a bucket policy, replacing any
+// existing one.
+func (s *Service) PutBucketPolicy(ctx context.Context, req *servicev1.PutBucketPolicyRequest) (*servicev1.PutBucketPolicyResponse, error) {
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if _, err := policy.Parse(req.GetPolicy(), bucket.Name); err != nil {
+		if errors.Is(err, policy.ErrMalformed) {
+			loggrpc.SetAttrs(ctx, slog.String("reason", err.Error()))
+			return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "MalformedPolicy"))
+		}
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	if err := s.store.Buckets().SetPolicy(ctx, bucket.ID, req.GetPolicy(), time.Now().UTC()); err != nil {
+		return nil, loggrpc.SetError(ctx, setBucketError(err))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> policy set", bucket.Name)))
+
+	return &servicev1.PutBucketPolicyResponse{}, nil
+}
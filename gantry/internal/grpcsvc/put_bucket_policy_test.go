@@ -0,0 +1,71 @@
+package grpcsvc
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_PutBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	const doc = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::my-bucket/*"}]}`
+
+	type tc struct {
+		name         string
+		bucket       string
+		caller       string
+		policy       string
+		getByNameErr error
+		setErr       error
+		wantSet      bool
+		wantCode     codes.Code
+		wantMessage  string
+	}
+
+	cases := []tc{
+		{name: "owner stores policy", bucket: "my-bucket", caller: "user-alice", policy: doc, wantSet: true},
+		{name: "other user returns AccessDenied", bucket: "my-bucket", caller: "user-bob", policy: doc, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "policy for another bucket is malformed", bucket: "other-bucket", caller: "user-alice", policy: doc, wantCode: codes.InvalidArgument, wantMessage: "MalformedPolicy"},
+		{name: "invalid JSON is malformed", bucket: "my-bucket", caller: "user-alice", policy: "{", wantCode: codes.InvalidArgument, wantMessage: "MalformedPolicy"},
+		{name: "missing bucket returns NoSuchBucket", bucket: "my-bucket", caller: "user-alice", policy: doc, getByNameErr: store.ErrBucketNotFound, wantCode: codes.NotFound, wantMessage: "NoSuchBucket"},
+		{name: "invalid bucket name returns InvalidArgument", bucket: "Bad!Name", policy: doc, wantCode: codes.InvalidArgument, wantMessage: "InvalidBucketName"},
+		{name: "store error returns Internal", bucket: "my-bucket", caller: "user-alice", policy: doc, setErr: errors.New("disk I/O error"), wantSet: true, wantCode: codes.Internal, wantMessage: "disk I/O error"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: c.bucket, OwnerID: "user-alice"})
+			if c.getByNameErr != nil {
+				buckets.SetGetByNameError(c.getByNameErr)
+			}
+			buckets.SetSetPolicyError(c.setErr)
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			_, err := svc.PutBucketPolicy(callerContext(c.caller), &servicev1.PutBucketPolicyRequest{Bucket: c.bucket, Policy: c.policy})
+
+			calls := buckets.SetPolicyCalls()
+			if c.wantSet != (len(calls) == 1) {
+				t.Fatalf("SetPolicy calls: got %v, want set=%v", calls, c.wantSet)
+			}
+			if c.wantSet && (calls[0].ID != "bucket-id-123" || calls[0].Value != c.policy) {
+				t.Fatalf("SetPolicy call: got %+v", calls[0])
+			}
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// PutBucketVersioning enables or suspends versioning on a bucket. Objects
+// already in the bucket keep the null version they were written with. A
+// bucket with object lock enabled can't be suspended.
+func (s *Service) PutBucketVersioning(ctx context.Context, req *servicev1.PutBucketVersioningRequest) (*servicev1.PutBucketVersioningResponse, error) {
+	versioning := req.GetStatus()
+	if versioning != store.VersioningEnabled && versioning != store.VersioningSuspended {
+		return nil, status.Error(codes.InvalidArgument, "IllegalVersioningConfigurationException")
+	}
+
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if versioning == store.VersioningSuspended && bucket.ObjectLock != nil {
+		loggrpc.SetAttrs(ctx, slog.String("reason", "object lock is enabled"))
+		return nil, loggrpc.SetError(ctx, status.Error(codes.FailedPrecondition, "InvalidBucketState"))
+	}
+
+	if err := s.store.Buckets().SetVersioning(ctx, bucket.ID, versioning, time.Now().UTC()); err != nil {
+		return nil, loggrpc.SetError(ctx, setBucketError(err))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("bucket <%s> versioning %s", bucket.Name, versioning)))
+
+	return &servicev1.PutBucketVersioningResponse{}, nil
+}
@@ -0,0 +1,66 @@
+package grpcsvc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_PutBucketVersioning(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name          string
+		caller        string
+		status        string
+		objectLock    *store.ObjectLockConfig
+		setErr        error
+		wantCode      codes.Code
+		wantMessage   string
+		wantSetCalled bool
+	}
+
+	cases := []tc{
+		{name: "owner enables versioning", caller: "user-alice", status: "Enabled", wantSetCalled: true},
+		{name: "owner suspends versioning", caller: "user-alice", status: "Suspended", wantSetCalled: true},
+		{name: "empty status is rejected", caller: "user-alice", wantCode: codes.InvalidArgument, wantMessage: "IllegalVersioningConfigurationException"},
+		{name: "disabling is rejected", caller: "user-alice", status: "Disabled", wantCode: codes.InvalidArgument, wantMessage: "IllegalVersioningConfigurationException"},
+		{name: "object lock keeps versioning enabled", caller: "user-alice", status: "Enabled", objectLock: &store.ObjectLockConfig{}, wantSetCalled: true},
+		{name: "object lock rejects suspending", caller: "user-alice", status: "Suspended", objectLock: &store.ObjectLockConfig{}, wantCode: codes.FailedPrecondition, wantMessage: "InvalidBucketState"},
+		{name: "other user returns AccessDenied", caller: "user-bob", status: "Enabled", wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "bucket deleted meanwhile returns NoSuchBucket", caller: "user-alice", status: "Enabled", setErr: store.ErrBucketNotFound, wantCode: codes.NotFound, wantMessage: "NoSuchBucket", wantSetCalled: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", ObjectLock: c.objectLock})
+			buckets.SetSetVersioningError(c.setErr)
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			_, err := svc.PutBucketVersioning(callerContext(c.caller), &servicev1.PutBucketVersioningRequest{Bucket: "my-bucket", Status: c.status})
+
+			calls := buckets.SetVersioningCalls()
+			if c.wantSetCalled {
+				if len(calls) != 1 || calls[0].ID != "bucket-id-123" || calls[0].Value != c.status {
+					t.Fatalf("SetVersioning calls: got %+v, want one with %q", calls, c.status)
+				}
+			} else if len(calls) != 0 {
+				t.Fatalf("SetVersioning calls: got %v, want none", calls)
+			}
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+		})
+	}
+}
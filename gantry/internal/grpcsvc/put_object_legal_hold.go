@@ -0,0 +1,40 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// PutObjectLegalHold places or lifts a legal hold on one version of an
+// object.
+func (s *Service) PutObjectLegalHold(ctx context.Context, req *servicev1.PutObjectLegalHoldRequest) (*servicev1.PutObjectLegalHoldResponse, error) {
+	key := req.GetKey()
+	versionID := req.GetVersionId()
+
+	bucket, err := s.subresourceBucket(ctx, req.GetBucket(), key, versionID, policy.ActionPutObjectLegalHold, policy.ActionPutObjectLegalHold)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if err := requireObjectLock(ctx, bucket); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	held, err := s.store.Objects().SetLegalHold(ctx, bucket.ID, key, versionID, req.GetOn())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, subresourceError(err, versionID))
+	}
+
+	result := fmt.Sprintf("object %s/%s legal hold lifted", bucket.Name, key)
+	if req.GetOn() {
+		result = fmt.Sprintf("object %s/%s legal hold placed", bucket.Name, key)
+	}
+	loggrpc.SetAttrs(ctx, slog.String("result", result))
+
+	return &servicev1.PutObjectLegalHoldResponse{VersionId: subresourceVersionID(bucket, held)}, nil
+}
@@ -0,0 +1,97 @@
+package grpcsvc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_PutObjectLegalHold(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name          string
+		caller        string
+		policy        string
+		objectLock    *store.ObjectLockConfig
+		versioning    string
+		versionID     string
+		on            bool
+		setErr        error
+		wantSet       bool
+		wantVersionID string
+		wantCode      codes.Code
+		wantMessage   string
+	}
+
+	lock := &store.ObjectLockConfig{}
+	cases := []tc{
+		{name: "owner places a hold", caller: "user-alice", objectLock: lock, versioning: store.VersioningEnabled, on: true, wantSet: true},
+		{name: "owner lifts a hold", caller: "user-alice", objectLock: lock, versioning: store.VersioningEnabled, wantSet: true},
+		{name: "named version", caller: "user-alice", objectLock: lock, versioning: store.VersioningEnabled, versionID: "version-1", on: true, wantSet: true, wantVersionID: "version-1"},
+		{
+			name:       "policy grants s3:PutObjectLegalHold",
+			caller:     "user-bob",
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam:::user/bob"},"Action":"s3:PutObjectLegalHold","Resource":"arn:aws:s3:::my-bucket/*"}]}`,
+			objectLock: lock,
+			versioning: store.VersioningEnabled,
+			on:         true,
+			wantSet:    true,
+		},
+		{name: "bucket without object lock returns InvalidRequest", caller: "user-alice", on: true, wantCode: codes.InvalidArgument, wantMessage: "InvalidRequest"},
+		{name: "other user returns AccessDenied", caller: "user-bob", objectLock: lock, versioning: store.VersioningEnabled, on: true, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "missing key", caller: "user-alice", objectLock: lock, versioning: store.VersioningEnabled, on: true, setErr: store.ErrObjectNotFound, wantSet: true, wantCode: codes.NotFound, wantMessage: "NoSuchKey"},
+		{name: "missing version", caller: "user-alice", objectLock: lock, versioning: store.VersioningEnabled, versionID: "version-9", on: true, setErr: store.ErrObjectNotFound, wantSet: true, wantCode: codes.NotFound, wantMessage: "NoSuchVersion"},
+		{name: "delete marker", caller: "user-alice", objectLock: lock, versioning: store.VersioningEnabled, versionID: "marker-1", on: true, setErr: store.ErrObjectIsDeleteMarker, wantSet: true, wantCode: codes.FailedPrecondition, wantMessage: "MethodNotAllowed"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{
+				ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", Policy: c.policy,
+				Versioning: c.versioning, ObjectLock: c.objectLock,
+			})
+			objects := testutil.NewFakeObjectStore()
+			objects.SetSetLegalHoldError(c.setErr)
+			users := testutil.NewFakeUserStore()
+			users.SetGetByIDResponse(store.UserRecord{ID: "user-bob", Name: "bob"})
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets), testutil.WithObjects(objects), testutil.WithUsers(users))
+
+			resp, err := svc.PutObjectLegalHold(callerContext(c.caller), &servicev1.PutObjectLegalHoldRequest{
+				Bucket:    "my-bucket",
+				Key:       "backups/db.tar",
+				VersionId: c.versionID,
+				On:        c.on,
+			})
+
+			calls := objects.SetLegalHoldCalls()
+			if !c.wantSet {
+				if len(calls) != 0 {
+					t.Fatalf("SetLegalHold calls: got %+v, want none", calls)
+				}
+			} else {
+				want := testutil.ObjectSetLegalHoldCall{BucketID: "bucket-id-123", Key: "backups/db.tar", VersionID: c.versionID, On: c.on}
+				if len(calls) != 1 || calls[0] != want {
+					t.Fatalf("SetLegalHold calls: got %+v, want %+v", calls, want)
+				}
+			}
+
+			if c.wantMessage != "" {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+			if resp.GetVersionId() != c.wantVersionID {
+				t.Fatalf("version ID: got %q, want %q", resp.GetVersionId(), c.wantVersionID)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// PutObjectLockConfiguration enables object lock on a versioned bucket, or
+// replaces the default retention of one that has it.
+func (s *Service) PutObjectLockConfiguration(ctx context.Context, req *servicev1.PutObjectLockConfigurationRequest) (*servicev1.PutObjectLockConfigurationResponse, error) {
+	bucket, err := s.ownedBucket(ctx, req.GetBucket())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	lock, err := defaultRetentionOf(req.GetConfiguration())
+	if err != nil {
+		loggrpc.SetAttrs(ctx, slog.String("reason", err.Error()))
+		return nil, loggrpc.SetError(ctx, status.Error(codes.InvalidArgument, "MalformedXML"))
+	}
+
+	if bucket.ObjectLock == nil && bucket.Versioning != store.VersioningEnabled {
+		loggrpc.SetAttrs(ctx, slog.String("reason", "versioning is not enabled"))
+		return nil, loggrpc.SetError(ctx, status.Error(codes.FailedPrecondition, "InvalidBucketState"))
+	}
+
+	if err := s.store.Buckets().SetObjectLock(ctx, bucket.ID, lock, time.Now().UTC()); err != nil {
+		return nil, loggrpc.SetError(ctx, setBucketError(err))
+	}
+
+	result := fmt.Sprintf("bucket <%s> object lock enabled without default retention", bucket.Name)
+	if lock.DefaultMode != "" {
+		result = fmt.Sprintf("bucket <%s> object lock enabled, default %s retention for %d days %d years",
+			bucket.Name, lock.DefaultMode, lock.DefaultDays, lock.DefaultYears)
+	}
+	loggrpc.SetAttrs(ctx, slog.String("result", result))
+
+	return &servicev1.PutObjectLockConfigurationResponse{}, nil
+}
@@ -0,0 +1,100 @@
+package grpcsvc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_PutObjectLockConfiguration(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		caller      string
+		versioning  string
+		objectLock  *store.ObjectLockConfig
+		config      *servicev1.ObjectLockConfiguration
+		setErr      error
+		wantLock    *store.ObjectLockConfig
+		wantCode    codes.Code
+		wantMessage string
+	}
+
+	cases := []tc{
+		{
+			name:       "owner enables object lock with a default retention",
+			caller:     "user-alice",
+			versioning: store.VersioningEnabled,
+			config:     &servicev1.ObjectLockConfiguration{DefaultMode: store.RetentionCompliance, DefaultDays: 90},
+			wantLock:   &store.ObjectLockConfig{DefaultMode: store.RetentionCompliance, DefaultDays: 90},
+		},
+		{
+			name:       "no default retention",
+			caller:     "user-alice",
+			versioning: store.VersioningEnabled,
+			config:     &servicev1.ObjectLockConfiguration{},
+			wantLock:   &store.ObjectLockConfig{},
+		},
+		{
+			name:       "default retention in years",
+			caller:     "user-alice",
+			versioning: store.VersioningEnabled,
+			config:     &servicev1.ObjectLockConfiguration{DefaultMode: store.RetentionGovernance, DefaultYears: 1},
+			wantLock:   &store.ObjectLockConfig{DefaultMode: store.RetentionGovernance, DefaultYears: 1},
+		},
+		{
+			name:       "bucket with object lock changes its default",
+			caller:     "user-alice",
+			versioning: store.VersioningEnabled,
+			objectLock: &store.ObjectLockConfig{DefaultMode: store.RetentionGovernance, DefaultDays: 1},
+			config:     &servicev1.ObjectLockConfiguration{DefaultMode: store.RetentionCompliance, DefaultDays: 90},
+			wantLock:   &store.ObjectLockConfig{DefaultMode: store.RetentionCompliance, DefaultDays: 90},
+		},
+		{name: "unversioned bucket returns InvalidBucketState", caller: "user-alice", config: &servicev1.ObjectLockConfiguration{}, wantCode: codes.FailedPrecondition, wantMessage: "InvalidBucketState"},
+		{name: "suspended bucket returns InvalidBucketState", caller: "user-alice", versioning: store.VersioningSuspended, config: &servicev1.ObjectLockConfiguration{}, wantCode: codes.FailedPrecondition, wantMessage: "InvalidBucketState"},
+		{name: "unknown mode", caller: "user-alice", versioning: store.VersioningEnabled, config: &servicev1.ObjectLockConfiguration{DefaultMode: "FOREVER", DefaultDays: 1}, wantCode: codes.InvalidArgument, wantMessage: "MalformedXML"},
+		{name: "mode without a period", caller: "user-alice", versioning: store.VersioningEnabled, config: &servicev1.ObjectLockConfiguration{DefaultMode: store.RetentionGovernance}, wantCode: codes.InvalidArgument, wantMessage: "MalformedXML"},
+		{name: "days and years", caller: "user-alice", versioning: store.VersioningEnabled, config: &servicev1.ObjectLockConfiguration{DefaultMode: store.RetentionGovernance, DefaultDays: 1, DefaultYears: 1}, wantCode: codes.InvalidArgument, wantMessage: "MalformedXML"},
+		{name: "negative days", caller: "user-alice", versioning: store.VersioningEnabled, config: &servicev1.ObjectLockConfiguration{DefaultMode: store.RetentionGovernance, DefaultDays: -1}, wantCode: codes.InvalidArgument, wantMessage: "MalformedXML"},
+		{name: "period without a mode", caller: "user-alice", versioning: store.VersioningEnabled, config: &servicev1.ObjectLockConfiguration{DefaultDays: 1}, wantCode: codes.InvalidArgument, wantMessage: "MalformedXML"},
+		{name: "other user returns AccessDenied", caller: "user-bob", versioning: store.VersioningEnabled, config: &servicev1.ObjectLockConfiguration{}, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "bucket deleted meanwhile returns NoSuchBucket", caller: "user-alice", versioning: store.VersioningEnabled, config: &servicev1.ObjectLockConfiguration{}, setErr: store.ErrBucketNotFound, wantLock: &store.ObjectLockConfig{}, wantCode: codes.NotFound, wantMessage: "NoSuchBucket"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", Versioning: c.versioning, ObjectLock: c.objectLock})
+			buckets.SetSetObjectLockError(c.setErr)
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets))
+
+			_, err := svc.PutObjectLockConfiguration(callerContext(c.caller), &servicev1.PutObjectLockConfigurationRequest{
+				Bucket:        "my-bucket",
+				Configuration: c.config,
+			})
+
+			calls := buckets.SetObjectLockCalls()
+			if c.wantLock == nil {
+				if len(calls) != 0 {
+					t.Fatalf("SetObjectLock calls: got %+v, want none", calls)
+				}
+			} else if len(calls) != 1 || calls[0].ID != "bucket-id-123" || calls[0].Lock != *c.wantLock {
+				t.Fatalf("SetObjectLock calls: got %+v, want one with %+v", calls, *c.wantLock)
+			}
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+		})
+	}
+}
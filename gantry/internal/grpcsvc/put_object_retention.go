@@ -0,0 +1,65 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// PutObjectRetention sets or removes the retention of one version of an
+// object.
+func (s *Service) PutObjectRetention(ctx context.Context, req *servicev1.PutObjectRetentionRequest) (*servicev1.PutObjectRetentionResponse, error) {
+	key := req.GetKey()
+	versionID := req.GetVersionId()
+	mode := req.GetMode()
+	retainUntilMs := req.GetRetainUntilMs()
+	now := time.Now().UTC()
+
+	if mode != "" || retainUntilMs != 0 {
+		if err := validateRetention(mode, retainUntilMs, now); err != nil {
+			return nil, invalidRetention(ctx, err)
+		}
+	}
+
+	bucket, err := s.subresourceBucket(ctx, req.GetBucket(), key, versionID, policy.ActionPutObjectRetention, policy.ActionPutObjectRetention)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	if err := requireObjectLock(ctx, bucket); err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	bypass, err := s.bypassGovernance(ctx, bucket, key, req.GetBypassGovernanceRetention())
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	var retainUntil time.Time
+	if mode != "" {
+		retainUntil = time.UnixMilli(retainUntilMs).UTC()
+	}
+
+	retained, err := s.store.Objects().SetRetention(ctx, bucket.ID, key, versionID, mode, retainUntil, bypass, now)
+	if errors.Is(err, store.ErrObjectLocked) {
+		return nil, objectLocked(ctx)
+	}
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, subresourceError(err, versionID))
+	}
+
+	result := fmt.Sprintf("object %s/%s retention removed", bucket.Name, key)
+	if mode != "" {
+		result = fmt.Sprintf("object %s/%s retained in %s mode until %s", bucket.Name, key, mode, retainUntil.Format(time.RFC3339))
+	}
+	loggrpc.SetAttrs(ctx, slog.String("result", result))
+
+	return &servicev1.PutObjectRetentionResponse{VersionId: subresourceVersionID(bucket, retained)}, nil
+}
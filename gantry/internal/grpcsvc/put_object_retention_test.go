@@ -0,0 +1,109 @@
+package grpcsvc
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_PutObjectRetention(t *testing.T) {
+	t.Parallel()
+
+	future := time.Now().UTC().AddDate(0, 0, 90).Truncate(time.Millisecond)
+	retentionOnly := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam:::user/bob"},"Action":"s3:PutObjectRetention","Resource":"arn:aws:s3:::my-bucket/*"}]}`
+	withBypass := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam:::user/bob"},"Action":["s3:PutObjectRetention","s3:BypassGovernanceRetention"],"Resource":"arn:aws:s3:::my-bucket/*"}]}`
+
+	type tc struct {
+		name          string
+		caller        string
+		policy        string
+		objectLock    *store.ObjectLockConfig
+		versionID     string
+		mode          string
+		retainUntilMs int64
+		bypass        bool
+		setErr        error
+		wantSet       bool
+		wantBypass    bool
+		wantVersionID string
+		wantCode      codes.Code
+		wantMessage   string
+	}
+
+	lock := &store.ObjectLockConfig{}
+	cases := []tc{
+		{name: "owner retains the current version", caller: "user-alice", objectLock: lock, mode: store.RetentionCompliance, retainUntilMs: future.UnixMilli(), wantSet: true},
+		{name: "named version", caller: "user-alice", objectLock: lock, versionID: "version-1", mode: store.RetentionGovernance, retainUntilMs: future.UnixMilli(), wantSet: true, wantVersionID: "version-1"},
+		{name: "no mode removes the retention", caller: "user-alice", objectLock: lock, wantSet: true},
+		{name: "owner bypasses governance", caller: "user-alice", objectLock: lock, bypass: true, wantSet: true, wantBypass: true},
+		{name: "policy grants the bypass", caller: "user-bob", policy: withBypass, objectLock: lock, bypass: true, wantSet: true, wantBypass: true},
+		{name: "bypass without permission is not applied", caller: "user-bob", policy: retentionOnly, objectLock: lock, bypass: true, wantSet: true},
+		{name: "unknown mode", caller: "user-alice", objectLock: lock, mode: "FOREVER", retainUntilMs: future.UnixMilli(), wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "mode without a date", caller: "user-alice", objectLock: lock, mode: store.RetentionGovernance, wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "date without a mode", caller: "user-alice", objectLock: lock, retainUntilMs: future.UnixMilli(), wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "date in the past", caller: "user-alice", objectLock: lock, mode: store.RetentionGovernance, retainUntilMs: time.Now().Add(-time.Hour).UnixMilli(), wantCode: codes.InvalidArgument, wantMessage: "InvalidArgument"},
+		{name: "bucket without object lock returns InvalidRequest", caller: "user-alice", mode: store.RetentionGovernance, retainUntilMs: future.UnixMilli(), wantCode: codes.InvalidArgument, wantMessage: "InvalidRequest"},
+		{name: "other user returns AccessDenied", caller: "user-bob", objectLock: lock, mode: store.RetentionGovernance, retainUntilMs: future.UnixMilli(), wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "locked version returns AccessDenied", caller: "user-alice", objectLock: lock, setErr: store.ErrObjectLocked, wantSet: true, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "missing version", caller: "user-alice", objectLock: lock, versionID: "version-9", setErr: store.ErrObjectNotFound, wantSet: true, wantCode: codes.NotFound, wantMessage: "NoSuchVersion"},
+		{name: "delete marker", caller: "user-alice", objectLock: lock, versionID: "marker-1", setErr: store.ErrObjectIsDeleteMarker, wantSet: true, wantCode: codes.FailedPrecondition, wantMessage: "MethodNotAllowed"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{
+				ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", Policy: c.policy,
+				Versioning: store.VersioningEnabled, ObjectLock: c.objectLock,
+			})
+			objects := testutil.NewFakeObjectStore()
+			objects.SetSetRetentionError(c.setErr)
+			users := testutil.NewFakeUserStore()
+			users.SetGetByIDResponse(store.UserRecord{ID: "user-bob", Name: "bob"})
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets), testutil.WithObjects(objects), testutil.WithUsers(users))
+
+			resp, err := svc.PutObjectRetention(callerContext(c.caller), &servicev1.PutObjectRetentionRequest{
+				Bucket:                    "my-bucket",
+				Key:                       "backups/db.tar",
+				VersionId:                 c.versionID,
+				Mode:                      c.mode,
+				RetainUntilMs:             c.retainUntilMs,
+				BypassGovernanceRetention: c.bypass,
+			})
+
+			calls := objects.SetRetentionCalls()
+			if !c.wantSet {
+				if len(calls) != 0 {
+					t.Fatalf("SetRetention calls: got %+v, want none", calls)
+				}
+			} else {
+				var wantUntil time.Time
+				if c.mode != "" {
+					wantUntil = time.UnixMilli(c.retainUntilMs).UTC()
+				}
+				if len(calls) != 1 || calls[0].BucketID != "bucket-id-123" || calls[0].Key != "backups/db.tar" ||
+					calls[0].VersionID != c.versionID || calls[0].Mode != c.mode || !calls[0].RetainUntil.Equal(wantUntil) ||
+					calls[0].BypassGovernance != c.wantBypass || calls[0].Now.IsZero() {
+					t.Fatalf("SetRetention calls: got %+v, want mode %q until %v bypass %t", calls, c.mode, wantUntil, c.wantBypass)
+				}
+			}
+
+			if c.wantMessage != "" {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+			if resp.GetVersionId() != c.wantVersionID {
+				t.Fatalf("version ID: got %q, want %q", resp.GetVersionId(), c.wantVersionID)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package grpcsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/policy"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// PutObjectTagging replaces the tags of one version of an object.
+func (s *Service) PutObjectTagging(ctx context.Context, req *servicev1.PutObjectTaggingRequest) (*servicev1.PutObjectTaggingResponse, error) {
+	key := req.GetKey()
+	versionID := req.GetVersionId()
+
+	bucket, err := s.subresourceBucket(ctx, req.GetBucket(), key, versionID, policy.ActionPutObjectTagging, policy.ActionPutObjectVersionTagging)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, err)
+	}
+
+	tags := req.GetTags()
+	if err := validateTags(tags); err != nil {
+		return nil, invalidTags(ctx, err)
+	}
+
+	tagged, err := s.store.Objects().SetTags(ctx, bucket.ID, key, versionID, tags)
+	if err != nil {
+		return nil, loggrpc.SetError(ctx, subresourceError(err, versionID))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("object %s/%s tagged with %d tags", bucket.Name, key, len(tags))))
+
+	return &servicev1.PutObjectTaggingResponse{VersionId: subresourceVersionID(bucket, tagged)}, nil
+}
@@ -0,0 +1,92 @@
+package grpcsvc
+
+import (
+	"maps"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_PutObjectTagging(t *testing.T) {
+	t.Parallel()
+
+	tooMany := make(map[string]string)
+	for i := range 11 {
+		tooMany[strings.Repeat("k", i+1)] = "v"
+	}
+
+	type tc struct {
+		name          string
+		caller        string
+		versioning    string
+		versionID     string
+		tags          map[string]string
+		setErr        error
+		wantSet       bool
+		wantVersionID string
+		wantCode      codes.Code
+		wantMessage   string
+	}
+
+	cases := []tc{
+		{name: "owner tags the current version", caller: "user-alice", tags: map[string]string{"host": "web-1"}, wantSet: true},
+		{name: "versioned bucket reports the version", caller: "user-alice", versioning: store.VersioningEnabled, tags: map[string]string{"host": "web-1"}, wantSet: true, wantVersionID: "version-1"},
+		{name: "named version", caller: "user-alice", versioning: store.VersioningEnabled, versionID: "version-1", tags: map[string]string{"host": "web-1"}, wantSet: true, wantVersionID: "version-1"},
+		{name: "no tags clears them", caller: "user-alice", wantSet: true},
+		{name: "longest key and value", caller: "user-alice", tags: map[string]string{strings.Repeat("k", 128): strings.Repeat("v", 256)}, wantSet: true},
+		{name: "more than ten tags", caller: "user-alice", tags: tooMany, wantCode: codes.InvalidArgument, wantMessage: "InvalidTag"},
+		{name: "empty key", caller: "user-alice", tags: map[string]string{"": "v"}, wantCode: codes.InvalidArgument, wantMessage: "InvalidTag"},
+		{name: "key too long", caller: "user-alice", tags: map[string]string{strings.Repeat("k", 129): "v"}, wantCode: codes.InvalidArgument, wantMessage: "InvalidTag"},
+		{name: "value too long", caller: "user-alice", tags: map[string]string{"host": strings.Repeat("v", 257)}, wantCode: codes.InvalidArgument, wantMessage: "InvalidTag"},
+		{name: "reserved prefix", caller: "user-alice", tags: map[string]string{"aws:created": "v"}, wantCode: codes.InvalidArgument, wantMessage: "InvalidTag"},
+		{name: "other user returns AccessDenied", caller: "user-bob", tags: map[string]string{"host": "web-1"}, wantCode: codes.PermissionDenied, wantMessage: "AccessDenied"},
+		{name: "missing key", caller: "user-alice", setErr: store.ErrObjectNotFound, wantSet: true, wantCode: codes.NotFound, wantMessage: "NoSuchKey"},
+		{name: "missing version", caller: "user-alice", versionID: "version-9", setErr: store.ErrObjectNotFound, wantSet: true, wantCode: codes.NotFound, wantMessage: "NoSuchVersion"},
+		{name: "delete marker", caller: "user-alice", versionID: "marker-1", setErr: store.ErrObjectIsDeleteMarker, wantSet: true, wantCode: codes.FailedPrecondition, wantMessage: "MethodNotAllowed"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetGetByNameResponse(store.BucketRecord{ID: "bucket-id-123", Name: "my-bucket", OwnerID: "user-alice", Versioning: c.versioning})
+			objects := testutil.NewFakeObjectStore()
+			objects.SetSetTagsVersionID("version-1")
+			objects.SetSetTagsError(c.setErr)
+			svc.store = testutil.NewFakeStore(testutil.WithBuckets(buckets), testutil.WithObjects(objects))
+
+			resp, err := svc.PutObjectTagging(callerContext(c.caller), &servicev1.PutObjectTaggingRequest{
+				Bucket:    "my-bucket",
+				Key:       "backups/db.tar",
+				VersionId: c.versionID,
+				Tags:      c.tags,
+			})
+
+			calls := objects.SetTagsCalls()
+			if !c.wantSet {
+				if len(calls) != 0 {
+					t.Fatalf("SetTags calls: got %+v, want none", calls)
+				}
+			} else if len(calls) != 1 || calls[0].BucketID != "bucket-id-123" || calls[0].Key != "backups/db.tar" ||
+				calls[0].VersionID != c.versionID || !maps.Equal(calls[0].Tags, c.tags) {
+				t.Fatalf("SetTags calls: got %+v, want tags %v", calls, c.tags)
+			}
+
+			if c.wantMessage != "" {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+			if resp.GetVersionId() != c.wantVersionID {
+				t.Fatalf("version ID: got %q, want %q", resp.GetVersionId(), c.wantVersionID)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// RotateAccessKey replaces a key with a new one for the same user and
+// disables the old key. The new secret is returned once and never logged.
+func (s *Service) RotateAccessKey(ctx context.Context, req *servicev1.RotateAccessKeyRequest) (*servicev1.RotateAccessKeyResponse, error) {
+	accessKeyID := req.GetAccessKeyId()
+	if accessKeyID == "" {
+		return nil, status.Error(codes.InvalidArgument, "ValidationError")
+	}
+
+	newAccessKeyID, secret := newAccessKey()
+	rec, err := s.store.AccessKeys().Rotate(ctx, accessKeyID, store.NewID(), newAccessKeyID, secret, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, store.ErrAccessKeyNotFound) {
+			return nil, loggrpc.SetError(ctx, errNoSuchEntity)
+		}
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("access key <%s> rotated to <%s>", accessKeyID, newAccessKeyID)))
+
+	return &servicev1.RotateAccessKeyResponse{
+		AccessKey:       accessKeyToProto(rec),
+		SecretAccessKey: secret,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_RotateAccessKey(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		accessKeyID string
+		rotateErr   error
+		wantCode    codes.Code
+		wantMessage string
+		wantRotate  bool
+	}
+
+	cases := []tc{
+		{
+			name:        "rotates key",
+			accessKeyID: "AKIDOLD",
+			wantRotate:  true,
+		},
+		{
+			name:        "missing access key id",
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "ValidationError",
+		},
+		{
+			name:        "unknown key returns NotFound",
+			accessKeyID: "AKIDMISSING",
+			rotateErr:   store.ErrAccessKeyNotFound,
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchEntity",
+			wantRotate:  true,
+		},
+		{
+			name:        "store failure returns Internal",
+			accessKeyID: "AKIDOLD",
+			rotateErr:   errors.New("rotate failed"),
+			wantCode:    codes.Internal,
+			wantMessage: "rotate failed",
+			wantRotate:  true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			keys := testutil.NewFakeAccessKeyStore()
+			keys.SetRotateError(c.rotateErr)
+			svc.store = testutil.NewFakeStore(testutil.WithAccessKeys(keys))
+
+			resp, err := svc.RotateAccessKey(context.Background(), &servicev1.RotateAccessKeyRequest{AccessKeyId: c.accessKeyID})
+
+			calls := keys.RotateCalls()
+			if c.wantRotate != (len(calls) == 1) {
+				t.Fatalf("Rotate calls: got %+v, want called=%v", calls, c.wantRotate)
+			}
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+
+			call := calls[0]
+			if call.AccessKeyID != c.accessKeyID || call.NewAccessKeyID == c.accessKeyID {
+				t.Fatalf("Rotate call: got %+v", call)
+			}
+			if resp.GetAccessKey().GetAccessKeyId() != call.NewAccessKeyID {
+				t.Fatalf("access key id: got %q, want %q", resp.GetAccessKey().GetAccessKeyId(), call.NewAccessKeyID)
+			}
+			if resp.GetSecretAccessKey() != call.NewSecretAccessKey {
+				t.Fatalf("secret: got %q, want %q", resp.GetSecretAccessKey(), call.NewSecretAccessKey)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"google.golang.org/grpc"
 
+	"github.com/ratdaddy/blockcloset/gantry/internal/config"
+	"github.com/ratdaddy/blockcloset/gantry/internal/secretbox"
 	"github.com/ratdaddy/blockcloset/gantry/internal/store"
 	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
 )
@@ -15,16 +17,27 @@ type Service struct {
 	log   *slog.Logger
 	db    *sql.DB
 	store store.Store
+
+	// requireOwner closes buckets without an owner; see
+	// config.RequireBucketOwner.
+	requireOwner bool
+	// adminUser names the access key user gantry seeds at startup, the
+	// only one who may force-delete a bucket.
+	adminUser string
 }
 
-func New(log *slog.Logger, db *sql.DB) *Service {
+// New returns the gantry service. secrets seals access key secrets at rest
+// and is only used when db is set.
+func New(log *slog.Logger, db *sql.DB, secrets *secretbox.Box) *Service {
 	svc := &Service{
-		log: log,
-		db:  db,
+		log:          log,
+		db:           db,
+		requireOwner: config.RequireBucketOwner,
+		adminUser:    config.AccessKeyUser,
 	}
 
 	if db != nil {
-		svc.store = store.New(db)
+		svc.store = store.New(db, secrets)
 	}
 
 	return svc
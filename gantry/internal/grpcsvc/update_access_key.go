@@ -0,0 +1,47 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/loggrpc"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+// UpdateAccessKey disables or re-enables a key. Flatbed stops accepting a
+// disabled key once its cached copy expires.
+func (s *Service) UpdateAccessKey(ctx context.Context, req *servicev1.UpdateAccessKeyRequest) (*servicev1.UpdateAccessKeyResponse, error) {
+	accessKeyID := req.GetAccessKeyId()
+	if accessKeyID == "" {
+		return nil, status.Error(codes.InvalidArgument, "ValidationError")
+	}
+
+	var keyStatus string
+	switch req.GetStatus() {
+	case servicev1.AccessKeyStatus_ACCESS_KEY_STATUS_ACTIVE:
+		keyStatus = store.AccessKeyActive
+	case servicev1.AccessKeyStatus_ACCESS_KEY_STATUS_INACTIVE:
+		keyStatus = store.AccessKeyInactive
+	default:
+		return nil, status.Error(codes.InvalidArgument, "ValidationError")
+	}
+
+	rec, err := s.store.AccessKeys().SetStatus(ctx, accessKeyID, keyStatus, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, store.ErrAccessKeyNotFound) {
+			return nil, loggrpc.SetError(ctx, errNoSuchEntity)
+		}
+		return nil, loggrpc.SetError(ctx, status.Error(codes.Internal, err.Error()))
+	}
+
+	loggrpc.SetAttrs(ctx, slog.String("result", fmt.Sprintf("access key <%s> %s", accessKeyID, keyStatus)))
+
+	return &servicev1.UpdateAccessKeyResponse{AccessKey: accessKeyToProto(rec)}, nil
+}
@@ -0,0 +1,107 @@
+package grpcsvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	servicev1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1"
+)
+
+func TestService_UpdateAccessKey(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		accessKeyID string
+		status      servicev1.AccessKeyStatus
+		setErr      error
+		wantCode    codes.Code
+		wantMessage string
+		wantStatus  string
+	}
+
+	cases := []tc{
+		{
+			name:        "deactivates key",
+			accessKeyID: "AKIDEXAMPLE",
+			status:      servicev1.AccessKeyStatus_ACCESS_KEY_STATUS_INACTIVE,
+			wantStatus:  store.AccessKeyInactive,
+		},
+		{
+			name:        "activates key",
+			accessKeyID: "AKIDEXAMPLE",
+			status:      servicev1.AccessKeyStatus_ACCESS_KEY_STATUS_ACTIVE,
+			wantStatus:  store.AccessKeyActive,
+		},
+		{
+			name:        "missing access key id",
+			status:      servicev1.AccessKeyStatus_ACCESS_KEY_STATUS_ACTIVE,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "ValidationError",
+		},
+		{
+			name:        "unspecified status",
+			accessKeyID: "AKIDEXAMPLE",
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "ValidationError",
+		},
+		{
+			name:        "unknown key returns NotFound",
+			accessKeyID: "AKIDMISSING",
+			status:      servicev1.AccessKeyStatus_ACCESS_KEY_STATUS_INACTIVE,
+			setErr:      store.ErrAccessKeyNotFound,
+			wantCode:    codes.NotFound,
+			wantMessage: "NoSuchEntity",
+			wantStatus:  store.AccessKeyInactive,
+		},
+		{
+			name:        "store failure returns Internal",
+			accessKeyID: "AKIDEXAMPLE",
+			status:      servicev1.AccessKeyStatus_ACCESS_KEY_STATUS_INACTIVE,
+			setErr:      errors.New("update failed"),
+			wantCode:    codes.Internal,
+			wantMessage: "update failed",
+			wantStatus:  store.AccessKeyInactive,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := New(newDiscardLogger(), nil, nil)
+			keys := testutil.NewFakeAccessKeyStore()
+			keys.SetSetStatusError(c.setErr)
+			svc.store = testutil.NewFakeStore(testutil.WithAccessKeys(keys))
+
+			resp, err := svc.UpdateAccessKey(context.Background(), &servicev1.UpdateAccessKeyRequest{
+				AccessKeyId: c.accessKeyID,
+				Status:      c.status,
+			})
+
+			calls := keys.SetStatusCalls()
+			if c.wantStatus == "" {
+				if len(calls) != 0 {
+					t.Fatalf("SetStatus calls: got %+v, want none", calls)
+				}
+			} else if len(calls) != 1 || calls[0].AccessKeyID != c.accessKeyID || calls[0].Status != c.wantStatus {
+				t.Fatalf("SetStatus calls: got %+v, want %s %s", calls, c.accessKeyID, c.wantStatus)
+			}
+
+			if c.wantCode != codes.OK {
+				assertGRPCError(t, err, c.wantCode, c.wantMessage)
+				return
+			}
+			assertNoError(t, err)
+
+			if resp.GetAccessKey().GetStatus() != c.status {
+				t.Fatalf("status: got %v, want %v", resp.GetAccessKey().GetStatus(), c.status)
+			}
+		})
+	}
+}
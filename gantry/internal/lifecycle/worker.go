@@ -0,0 +1,150 @@
+package lifecycle
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+)
+
+// batchSize bounds how many expired versions are fetched at a time.
+const batchSize = 100
+
+type BucketStore interface {
+	List(ctx context.Context) ([]store.BucketRecord, error)
+}
+
+type ObjectStore interface {
+	ListExpired(ctx context.Context, bucketID string, expiry store.LifecycleExpiry, limit int) ([]store.ObjectRecord, error)
+	ExpireCurrent(ctx context.Context, objectID, markerID string, updatedAt time.Time) (store.ObjectDeletion, error)
+	DeleteVersion(ctx context.Context, bucketID, key, versionID string, bypassGovernance bool, updatedAt time.Time) (store.ObjectDeletion, error)
+}
+
+// Worker applies bucket lifecycle rules. Each sweep finds the versions every
+// enabled rule has expired and deletes them the way S3 requests would: an
+// expired current version is deleted, leaving a delete marker in a versioned
+// bucket, and expired noncurrent versions and delete markers are removed,
+// unless an object lock still protects them. The cleanup worker then
+// reclaims their blobs.
+type Worker struct {
+	buckets  BucketStore
+	objects  ObjectStore
+	interval time.Duration
+}
+
+func New(buckets BucketStore, objects ObjectStore, interval time.Duration) *Worker {
+	return &Worker{
+		buckets,
+		objects,
+		interval,
+	}
+}
+
+func (w *Worker) Run(ctx context.Context) {
+	slog.Debug("starting lifecycle worker")
+	w.sweep(ctx)
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			w.sweep(ctx)
+		}
+	}
+}
+
+func (w *Worker) sweep(ctx context.Context) {
+	now := time.Now().UTC()
+	buckets, err := w.buckets.List(ctx)
+	if err != nil {
+		slog.Error("lifecycle list buckets", "err", err)
+		return
+	}
+
+	expired := 0
+	for _, bucket := range buckets {
+		for _, rule := range bucket.Lifecycle {
+			expiry, ok := expiryOf(rule, now)
+			if !ok {
+				continue
+			}
+			expired += w.expire(ctx, bucket, rule.ID, expiry, now)
+		}
+	}
+
+	slog.Debug("lifecycle sweep tick", "expired", expired)
+}
+
+// expiryOf is what rule expires as of now. ok is false when it expires
+// nothing. As in S3, days are counted to the midnight UTC after a version was
+// written or superseded.
+func expiryOf(rule store.LifecycleRule, now time.Time) (store.LifecycleExpiry, bool) {
+	if !rule.Enabled {
+		return store.LifecycleExpiry{}, false
+	}
+
+	midnight := now.Truncate(24 * time.Hour)
+	expiry := store.LifecycleExpiry{
+		Prefix:          rule.Prefix,
+		Tags:            rule.Tags,
+		NewerNoncurrent: rule.NewerNoncurrentVersions,
+		DeleteMarkers:   rule.ExpiredObjectDeleteMarker,
+		Now:             now,
+	}
+
+	switch {
+	case rule.ExpirationDays > 0:
+		expiry.CurrentBefore = midnight.AddDate(0, 0, -rule.ExpirationDays)
+	case !rule.ExpirationDate.IsZero() && !now.Before(rule.ExpirationDate):
+		expiry.CurrentBefore = now
+	}
+
+	if rule.NoncurrentDays > 0 {
+		expiry.NoncurrentBefore = midnight.AddDate(0, 0, -rule.NoncurrentDays)
+	}
+
+	ok := !expiry.CurrentBefore.IsZero() || !expiry.NoncurrentBefore.IsZero() || expiry.DeleteMarkers
+	return expiry, ok
+}
+
+// expire deletes the versions of bucket that expiry selects, batchSize at a
+// time, and returns how many it deleted. It stops at a batch that deletes
+// nothing, so failures are logged and retried next tick.
+func (w *Worker) expire(ctx context.Context, bucket store.BucketRecord, ruleID string, expiry store.LifecycleExpiry, now time.Time) int {
+	expired := 0
+	for {
+		versions, err := w.objects.ListExpired(ctx, bucket.ID, expiry, batchSize)
+		if err != nil {
+			slog.Error("lifecycle list expired", "bucket", bucket.Name, "rule", ruleID, "err", err)
+			return expired
+		}
+
+		n := 0
+		for _, v := range versions {
+			var deletion store.ObjectDeletion
+			if v.State == "COMMITTED" {
+				deletion, err = w.objects.ExpireCurrent(ctx, v.ID, store.NewID(), now)
+			} else {
+				deletion, err = w.objects.DeleteVersion(ctx, bucket.ID, v.Key, v.VersionID, false, now)
+			}
+			if err != nil {
+				slog.Error("lifecycle expire", "bucket", bucket.Name, "key", v.Key, "version_id", v.VersionID, "err", err)
+				continue
+			}
+			if !deletion.Deleted {
+				continue
+			}
+
+			n++
+			slog.Info("lifecycle expired object", "bucket", bucket.Name, "key", v.Key, "version_id", v.VersionID, "rule", ruleID)
+		}
+
+		expired += n
+		if len(versions) < batchSize || n == 0 || ctx.Err() != nil {
+			return expired
+		}
+	}
+}
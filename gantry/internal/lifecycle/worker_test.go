@@ -0,0 +1,183 @@
+package lifecycle_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/lifecycle"
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+)
+
+func TestWorker_AppliesLifecycleRules(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		rules       []store.LifecycleRule
+		expired     []store.ObjectRecord
+		listErr     error
+		wantExpiry  func(midnight time.Time) store.LifecycleExpiry
+		wantExpires []string
+		wantDeletes []string
+
+		// wantCurrentNow expects current versions written before the sweep
+		// to expire, in addition to wantExpiry.
+		wantCurrentNow bool
+	}
+
+	cases := []tc{
+		{
+			name:  "expires current versions past expiration days",
+			rules: []store.LifecycleRule{{ID: "footage", Enabled: true, Prefix: "footage/", ExpirationDays: 30}},
+			expired: []store.ObjectRecord{
+				{ID: "object-1", Key: "footage/a.mp4", State: "COMMITTED", VersionID: store.NullVersionID},
+			},
+			wantExpiry: func(midnight time.Time) store.LifecycleExpiry {
+				return store.LifecycleExpiry{Prefix: "footage/", CurrentBefore: midnight.AddDate(0, 0, -30)}
+			},
+			wantExpires: []string{"object-1"},
+		},
+		{
+			name:  "passes tag filters to the store",
+			rules: []store.LifecycleRule{{ID: "backups", Enabled: true, Prefix: "backups/", Tags: map[string]string{"host": "web-1"}, ExpirationDays: 7}},
+			expired: []store.ObjectRecord{
+				{ID: "object-1", Key: "backups/web.tar", State: "COMMITTED", VersionID: store.NullVersionID},
+			},
+			wantExpiry: func(midnight time.Time) store.LifecycleExpiry {
+				return store.LifecycleExpiry{Prefix: "backups/", Tags: map[string]string{"host": "web-1"}, CurrentBefore: midnight.AddDate(0, 0, -7)}
+			},
+			wantExpires: []string{"object-1"},
+		},
+		{
+			name:           "expires everything once the expiration date passes",
+			rules:          []store.LifecycleRule{{Enabled: true, ExpirationDate: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)}},
+			wantExpiry:     func(time.Time) store.LifecycleExpiry { return store.LifecycleExpiry{} },
+			wantCurrentNow: true,
+		},
+		{
+			name:  "removes noncurrent versions and expired delete markers",
+			rules: []store.LifecycleRule{{Enabled: true, NoncurrentDays: 7, NewerNoncurrentVersions: 2, ExpiredObjectDeleteMarker: true}},
+			expired: []store.ObjectRecord{
+				{ID: "object-1", Key: "a.txt", State: "NONCURRENT", VersionID: "object-1"},
+				{ID: "marker-1", Key: "b.txt", State: "DELETE_MARKER", VersionID: "marker-1"},
+			},
+			wantExpiry: func(midnight time.Time) store.LifecycleExpiry {
+				return store.LifecycleExpiry{NoncurrentBefore: midnight.AddDate(0, 0, -7), NewerNoncurrent: 2, DeleteMarkers: true}
+			},
+			wantDeletes: []string{"a.txt@object-1", "b.txt@marker-1"},
+		},
+		{
+			name: "skips disabled and not yet due rules",
+			rules: []store.LifecycleRule{
+				{ExpirationDays: 1},
+				{Enabled: true, ExpirationDate: time.Date(2999, time.January, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:    "store failure is retried next tick",
+			rules:   []store.LifecycleRule{{Enabled: true, ExpirationDays: 30}},
+			listErr: errors.New("database is locked"),
+			wantExpiry: func(midnight time.Time) store.LifecycleExpiry {
+				return store.LifecycleExpiry{CurrentBefore: midnight.AddDate(0, 0, -30)}
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			buckets := testutil.NewFakeBucketStore()
+			buckets.SetListRecords([]store.BucketRecord{
+				{ID: "bucket-id-plain", Name: "plain"},
+				{ID: "bucket-id-cameras", Name: "cameras", Lifecycle: c.rules},
+			})
+			objects := testutil.NewFakeObjectStore()
+			objects.SetListExpiredRecords("bucket-id-cameras", c.expired)
+			objects.SetListExpiredError(c.listErr)
+			objects.SetExpireResponse(store.ObjectDeletion{Deleted: true})
+			objects.SetDeleteResponse(store.ObjectDeletion{Deleted: true})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			worker := lifecycle.New(buckets, objects, time.Hour)
+
+			before := time.Now().UTC()
+			done := make(chan struct{})
+			go func() {
+				worker.Run(ctx)
+				close(done)
+			}()
+
+			deadline := time.After(time.Second)
+			for buckets.ListCallCount() == 0 {
+				select {
+				case <-deadline:
+					t.Fatal("timeout waiting for lifecycle sweep")
+				case <-time.After(time.Millisecond):
+				}
+			}
+
+			cancel()
+			<-done
+			after := time.Now().UTC()
+
+			calls := objects.ListExpiredCalls()
+			if c.wantExpiry == nil {
+				if len(calls) != 0 {
+					t.Fatalf("ListExpired calls: got %+v, want none", calls)
+				}
+			} else {
+				if len(calls) != 1 {
+					t.Fatalf("ListExpired calls: got %d, want 1", len(calls))
+				}
+				call := calls[0]
+				if call.BucketID != "bucket-id-cameras" || call.Limit <= 0 {
+					t.Fatalf("ListExpired call: got %+v", call)
+				}
+				expiry := call.Expiry
+				if expiry.Now.Before(before) || expiry.Now.After(after) {
+					t.Fatalf("ListExpired now: got %v, want the sweep time", expiry.Now)
+				}
+				expiry.Now = time.Time{}
+				if c.wantCurrentNow {
+					if expiry.CurrentBefore.Before(before) || expiry.CurrentBefore.After(after) {
+						t.Fatalf("ListExpired current cutoff: got %v, want the sweep time", expiry.CurrentBefore)
+					}
+					expiry.CurrentBefore = time.Time{}
+				}
+				if want := c.wantExpiry(before.Truncate(24 * time.Hour)); !reflect.DeepEqual(expiry, want) {
+					t.Fatalf("ListExpired expiry: got %+v, want %+v", expiry, want)
+				}
+			}
+
+			var expires []string
+			for _, call := range objects.ExpireCalls() {
+				if call.MarkerID == "" {
+					t.Fatalf("ExpireCurrent %s without a marker ID", call.ObjectID)
+				}
+				expires = append(expires, call.ObjectID)
+			}
+			if !slices.Equal(expires, c.wantExpires) {
+				t.Fatalf("ExpireCurrent calls: got %v, want %v", expires, c.wantExpires)
+			}
+
+			var deletes []string
+			for _, call := range objects.DeleteCalls() {
+				if !call.Version || call.BucketID != "bucket-id-cameras" {
+					t.Fatalf("delete call: got %+v, want DeleteVersion in bucket-id-cameras", call)
+				}
+				deletes = append(deletes, call.Key+"@"+call.VersionID)
+			}
+			if !slices.Equal(deletes, c.wantDeletes) {
+				t.Fatalf("DeleteVersion calls: got %v, want %v", deletes, c.wantDeletes)
+			}
+		})
+	}
+}
@@ -0,0 +1,404 @@
+// Package policy parses and evaluates bucket policies: the subset of the IAM
+// policy language that S3 bucket policies use, without conditions.
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxSize is the largest policy document S3 accepts.
+const MaxSize = 20 * 1024
+
+// Actions a statement may name. Patterns such as "s3:Get*" and "s3:*" match
+// the actions they cover.
+const (
+	ActionGetObject                  = "s3:GetObject"
+	ActionPutObject                  = "s3:PutObject"
+	ActionDeleteObject               = "s3:DeleteObject"
+	ActionListBucket                 = "s3:ListBucket"
+	ActionAbortMultipartUpload       = "s3:AbortMultipartUpload"
+	ActionListBucketMultipartUploads = "s3:ListBucketMultipartUploads"
+	ActionListMultipartUploadParts   = "s3:ListMultipartUploadParts"
+	ActionGetObjectVersion           = "s3:GetObjectVersion"
+	ActionDeleteObjectVersion        = "s3:DeleteObjectVersion"
+	ActionListBucketVersions         = "s3:ListBucketVersions"
+	ActionGetObjectTagging           = "s3:GetObjectTagging"
+	ActionPutObjectTagging           = "s3:PutObjectTagging"
+	ActionDeleteObjectTagging        = "s3:DeleteObjectTagging"
+	ActionGetObjectVersionTagging    = "s3:GetObjectVersionTagging"
+	ActionPutObjectVersionTagging    = "s3:PutObjectVersionTagging"
+	ActionDeleteObjectVersionTagging = "s3:DeleteObjectVersionTagging"
+	ActionGetObjectRetention         = "s3:GetObjectRetention"
+	ActionPutObjectRetention         = "s3:PutObjectRetention"
+	ActionGetObjectLegalHold         = "s3:GetObjectLegalHold"
+	ActionPutObjectLegalHold         = "s3:PutObjectLegalHold"
+	ActionBypassGovernanceRetention  = "s3:BypassGovernanceRetention"
+)
+
+var knownActions = []string{
+	ActionGetObject,
+	ActionPutObject,
+	ActionDeleteObject,
+	ActionListBucket,
+	ActionAbortMultipartUpload,
+	ActionListBucketMultipartUploads,
+	ActionListMultipartUploadParts,
+	ActionGetObjectVersion,
+	ActionDeleteObjectVersion,
+	ActionListBucketVersions,
+	ActionGetObjectTagging,
+	ActionPutObjectTagging,
+	ActionDeleteObjectTagging,
+	ActionGetObjectVersionTagging,
+	ActionPutObjectVersionTagging,
+	ActionDeleteObjectVersionTagging,
+	ActionGetObjectRetention,
+	ActionPutObjectRetention,
+	ActionGetObjectLegalHold,
+	ActionPutObjectLegalHold,
+	ActionBypassGovernanceRetention,
+}
+
+const (
+	bucketARNPrefix = "arn:aws:s3:::"
+	userARNPrefix   = "arn:aws:iam:::user/"
+)
+
+// ErrMalformed wraps every reason a document is rejected.
+var ErrMalformed = errors.New("malformed policy")
+
+// Decision is the outcome of evaluating a policy for one request.
+type Decision int
+
+const (
+	// NoMatch means no statement applies; access falls back to ownership
+	// and the ACL.
+	NoMatch Decision = iota
+	Allowed
+	Denied
+)
+
+// Policy is a parsed bucket policy.
+type Policy struct {
+	Statements []Statement
+}
+
+// Statement allows or denies Actions on Resources to Principals. A
+// principal is "*" for anyone, including anonymous callers, or a user name.
+type Statement struct {
+	Sid        string
+	Deny       bool
+	Principals []string
+	Actions    []string
+	Resources  []string
+}
+
+// Request is one access to evaluate. Principal is the caller's user name,
+// or "" when anonymous.
+type Request struct {
+	Principal string
+	Action    string
+	Resource  string
+}
+
+// BucketARN returns the resource name of a bucket.
+func BucketARN(bucket string) string {
+	return bucketARNPrefix + bucket
+}
+
+// ObjectARN returns the resource name of an object.
+func ObjectARN(bucket, key string) string {
+	return bucketARNPrefix + bucket + "/" + key
+}
+
+type document struct {
+	Version   string          `json:"Version"`
+	ID        string          `json:"Id"`
+	Statement json.RawMessage `json:"Statement"`
+}
+
+type statement struct {
+	Sid          string          `json:"Sid"`
+	Effect       string          `json:"Effect"`
+	Principal    json.RawMessage `json:"Principal"`
+	Action       stringList      `json:"Action"`
+	Resource     stringList      `json:"Resource"`
+	NotPrincipal json.RawMessage `json:"NotPrincipal"`
+	NotAction    json.RawMessage `json:"NotAction"`
+	NotResource  json.RawMessage `json:"NotResource"`
+	Condition    json.RawMessage `json:"Condition"`
+}
+
+// stringList is a JSON string or array of strings, as IAM allows for most
+// fields.
+type stringList []string
+
+func (l *stringList) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*l = stringList{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*l = many
+	return nil
+}
+
+// Parse validates a policy document for bucket.
+func Parse(doc, bucket string) (*Policy, error) {
+	if len(doc) > MaxSize {
+		return nil, malformed("policy exceeds the maximum allowed document size")
+	}
+
+	dec := json.NewDecoder(strings.NewReader(doc))
+	dec.DisallowUnknownFields()
+
+	var d document
+	if err := dec.Decode(&d); err != nil {
+		return nil, malformed("policy is not valid JSON: %v", err)
+	}
+	if dec.More() {
+		return nil, malformed("policy has trailing data")
+	}
+
+	switch d.Version {
+	case "2012-10-17", "2008-10-17":
+	default:
+		return nil, malformed("policy has an invalid version %q", d.Version)
+	}
+
+	var raw []json.RawMessage
+	if trimmed := bytes.TrimSpace(d.Statement); len(trimmed) > 0 && trimmed[0] == '{' {
+		raw = []json.RawMessage{d.Statement}
+	} else if err := json.Unmarshal(d.Statement, &raw); err != nil || len(raw) == 0 {
+		return nil, malformed("policy has no statements")
+	}
+
+	p := &Policy{}
+	for i, r := range raw {
+		st, err := parseStatement(r, bucket)
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %w", i+1, err)
+		}
+		p.Statements = append(p.Statements, st)
+	}
+
+	return p, nil
+}
+
+func parseStatement(raw json.RawMessage, bucket string) (Statement, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+
+	var s statement
+	if err := dec.Decode(&s); err != nil {
+		return Statement{}, malformed("statement is not valid: %v", err)
+	}
+
+	switch {
+	case s.NotPrincipal != nil, s.NotAction != nil, s.NotResource != nil:
+		return Statement{}, malformed("NotPrincipal, NotAction and NotResource are not supported")
+	case s.Condition != nil:
+		return Statement{}, malformed("conditions are not supported")
+	}
+
+	st := Statement{Sid: s.Sid}
+
+	switch s.Effect {
+	case "Allow":
+	case "Deny":
+		st.Deny = true
+	default:
+		return Statement{}, malformed("statement has an invalid effect %q", s.Effect)
+	}
+
+	principals, err := parsePrincipal(s.Principal)
+	if err != nil {
+		return Statement{}, err
+	}
+	st.Principals = principals
+
+	if len(s.Action) == 0 {
+		return Statement{}, malformed("statement has no actions")
+	}
+	for _, a := range s.Action {
+		if !validAction(a) {
+			return Statement{}, malformed("policy has invalid action %q", a)
+		}
+	}
+	st.Actions = s.Action
+
+	if len(s.Resource) == 0 {
+		return Statement{}, malformed("statement has no resources")
+	}
+	for _, r := range s.Resource {
+		if !validResource(r, bucket) {
+			return Statement{}, malformed("policy has invalid resource %q", r)
+		}
+	}
+	st.Resources = s.Resource
+
+	return st, nil
+}
+
+// parsePrincipal accepts "*", {"AWS": "*"} and {"AWS": [user ARNs]}.
+func parsePrincipal(raw json.RawMessage) ([]string, error) {
+	if raw == nil {
+		return nil, malformed("statement has no principal")
+	}
+
+	var wildcard string
+	if err := json.Unmarshal(raw, &wildcard); err == nil {
+		if wildcard != "*" {
+			return nil, malformed("policy has invalid principal %q", wildcard)
+		}
+		return []string{"*"}, nil
+	}
+
+	var byType map[string]stringList
+	if err := json.Unmarshal(raw, &byType); err != nil {
+		return nil, malformed("policy has invalid principal")
+	}
+
+	var principals []string
+	for typ, values := range byType {
+		if typ != "AWS" {
+			return nil, malformed("policy has invalid principal type %q", typ)
+		}
+		for _, v := range values {
+			if v == "*" {
+				principals = append(principals, "*")
+				continue
+			}
+			name, ok := strings.CutPrefix(v, userARNPrefix)
+			if !ok || name == "" {
+				return nil, malformed("policy has invalid principal %q", v)
+			}
+			principals = append(principals, name)
+		}
+	}
+	if len(principals) == 0 {
+		return nil, malformed("statement has no principal")
+	}
+
+	return principals, nil
+}
+
+func validAction(action string) bool {
+	if action == "*" {
+		return true
+	}
+	if !strings.HasPrefix(strings.ToLower(action), "s3:") {
+		return false
+	}
+	for _, known := range knownActions {
+		if match(strings.ToLower(action), strings.ToLower(known)) {
+			return true
+		}
+	}
+	return false
+}
+
+// validResource requires a resource inside the policy's own bucket, as S3
+// does.
+func validResource(resource, bucket string) bool {
+	rest, ok := strings.CutPrefix(resource, bucketARNPrefix)
+	if !ok {
+		return false
+	}
+	name, _, _ := strings.Cut(rest, "/")
+	return name == bucket
+}
+
+// Evaluate applies the policy to req. An explicit Deny in any matching
+// statement wins over every Allow. A nil policy matches nothing.
+func (p *Policy) Evaluate(req Request) Decision {
+	if p == nil {
+		return NoMatch
+	}
+
+	decision := NoMatch
+	for _, st := range p.Statements {
+		if !st.matches(req) {
+			continue
+		}
+		if st.Deny {
+			return Denied
+		}
+		decision = Allowed
+	}
+	return decision
+}
+
+func (st Statement) matches(req Request) bool {
+	return st.matchesPrincipal(req.Principal) && st.matchesAction(req.Action) && st.matchesResource(req.Resource)
+}
+
+func (st Statement) matchesPrincipal(principal string) bool {
+	for _, p := range st.Principals {
+		if p == "*" || (principal != "" && p == principal) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAction compares case-insensitively, as IAM does.
+func (st Statement) matchesAction(action string) bool {
+	action = strings.ToLower(action)
+	for _, a := range st.Actions {
+		if a == "*" || match(strings.ToLower(a), action) {
+			return true
+		}
+	}
+	return false
+}
+
+func (st Statement) matchesResource(resource string) bool {
+	for _, r := range st.Resources {
+		if match(r, resource) {
+			return true
+		}
+	}
+	return false
+}
+
+// match reports whether s matches pattern, where * matches any run of
+// characters, including "/", and ? matches any single character.
+func match(pattern, s string) bool {
+	// Backtrack to just after the last * on a mismatch; this is linear
+	// enough for the short patterns policies hold.
+	p, i := 0, 0
+	star, mark := -1, 0
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == s[i]):
+			p++
+			i++
+		case p < len(pattern) && pattern[p] == '*':
+			star, mark = p, i
+			p++
+		case star >= 0:
+			p = star + 1
+			mark++
+			i = mark
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+func malformed(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrMalformed, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,182 @@
+package policy
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const publicRead = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "PublicRead",
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": ["s3:GetObject"],
+    "Resource": "arn:aws:s3:::photos/*"
+  }]
+}`
+
+func TestParse_Valid(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		doc  string
+		want Statement
+	}{
+		{
+			name: "public read",
+			doc:  publicRead,
+			want: Statement{Sid: "PublicRead", Principals: []string{"*"}, Actions: []string{"s3:GetObject"}, Resources: []string{"arn:aws:s3:::photos/*"}},
+		},
+		{
+			name: "single statement object and user principals",
+			doc: `{"Version":"2012-10-17","Statement":{"Effect":"Deny","Principal":{"AWS":["arn:aws:iam:::user/alice","arn:aws:iam:::user/bob"]},
+				"Action":"s3:*","Resource":["arn:aws:s3:::photos","arn:aws:s3:::photos/private/*"]}}`,
+			want: Statement{Deny: true, Principals: []string{"alice", "bob"}, Actions: []string{"s3:*"},
+				Resources: []string{"arn:aws:s3:::photos", "arn:aws:s3:::photos/private/*"}},
+		},
+		{
+			name: "wildcard AWS principal and action pattern",
+			doc:  `{"Version":"2008-10-17","Id":"p1","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:List*","Resource":"arn:aws:s3:::photos"}]}`,
+			want: Statement{Principals: []string{"*"}, Actions: []string{"s3:List*"}, Resources: []string{"arn:aws:s3:::photos"}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := Parse(c.doc, "photos")
+			if err != nil {
+				t.Fatalf("Parse: unexpected error: %v", err)
+			}
+			if len(p.Statements) != 1 {
+				t.Fatalf("statements: got %d, want 1", len(p.Statements))
+			}
+			got := p.Statements[0]
+			if got.Sid != c.want.Sid || got.Deny != c.want.Deny ||
+				strings.Join(got.Principals, ",") != strings.Join(c.want.Principals, ",") ||
+				strings.Join(got.Actions, ",") != strings.Join(c.want.Actions, ",") ||
+				strings.Join(got.Resources, ",") != strings.Join(c.want.Resources, ",") {
+				t.Fatalf("statement: got %+v, want %+v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestParse_Malformed(t *testing.T) {
+	t.Parallel()
+
+	statement := func(body string) string {
+		return `{"Version":"2012-10-17","Statement":[{` + body + `}]}`
+	}
+
+	cases := []struct {
+		name string
+		doc  string
+	}{
+		{name: "not JSON", doc: "Allow everyone"},
+		{name: "trailing data", doc: publicRead + " {}"},
+		{name: "missing version", doc: `{"Statement":[]}`},
+		{name: "no statements", doc: `{"Version":"2012-10-17","Statement":[]}`},
+		{name: "unknown top-level field", doc: `{"Version":"2012-10-17","Statment":[]}`},
+		{name: "bad effect", doc: statement(`"Effect":"Maybe","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::photos/*"`)},
+		{name: "no principal", doc: statement(`"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::photos/*"`)},
+		{name: "bad principal", doc: statement(`"Effect":"Allow","Principal":"alice","Action":"s3:GetObject","Resource":"arn:aws:s3:::photos/*"`)},
+		{name: "service principal", doc: statement(`"Effect":"Allow","Principal":{"Service":"logs.amazonaws.com"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::photos/*"`)},
+		{name: "unsupported action", doc: statement(`"Effect":"Allow","Principal":"*","Action":"s3:PutBucketPolicy","Resource":"arn:aws:s3:::photos"`)},
+		{name: "non-S3 action", doc: statement(`"Effect":"Allow","Principal":"*","Action":"iam:*","Resource":"arn:aws:s3:::photos"`)},
+		{name: "no actions", doc: statement(`"Effect":"Allow","Principal":"*","Action":[],"Resource":"arn:aws:s3:::photos"`)},
+		{name: "other bucket", doc: statement(`"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::videos/*"`)},
+		{name: "bucket prefix", doc: statement(`"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::photos-old/*"`)},
+		{name: "no resources", doc: statement(`"Effect":"Allow","Principal":"*","Action":"s3:GetObject"`)},
+		{name: "condition", doc: statement(`"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::photos/*","Condition":{"Bool":{"aws:SecureTransport":"true"}}`)},
+		{name: "NotAction", doc: statement(`"Effect":"Deny","Principal":"*","NotAction":"s3:GetObject","Resource":"arn:aws:s3:::photos/*"`)},
+		{name: "too large", doc: `{"Version":"2012-10-17","Id":"` + strings.Repeat("x", MaxSize) + `"}`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := Parse(c.doc, "photos"); !errors.Is(err, ErrMalformed) {
+				t.Fatalf("Parse: got err %v, want %v", err, ErrMalformed)
+			}
+		})
+	}
+}
+
+func TestPolicy_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	p, err := Parse(`{"Version":"2012-10-17","Statement":[
+		{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::photos/public/*"},
+		{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam:::user/alice"},"Action":["s3:Get*","s3:ListBucket"],"Resource":["arn:aws:s3:::photos","arn:aws:s3:::photos/*"]},
+		{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::photos/secret/*"},
+		{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam:::user/bob"},"Action":"S3:PUTOBJECT","Resource":"arn:aws:s3:::photos/inbox/????.jpg"}
+	]}`, "photos")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	cases := []struct {
+		name string
+		req  Request
+		want Decision
+	}{
+		{name: "anonymous reads public", req: Request{Action: ActionGetObject, Resource: ObjectARN("photos", "public/cat.jpg")}, want: Allowed},
+		{name: "wildcard spans slashes", req: Request{Action: ActionGetObject, Resource: ObjectARN("photos", "public/2024/cat.jpg")}, want: Allowed},
+		{name: "anonymous cannot read elsewhere", req: Request{Action: ActionGetObject, Resource: ObjectARN("photos", "private/cat.jpg")}, want: NoMatch},
+		{name: "anonymous cannot list", req: Request{Action: ActionListBucket, Resource: BucketARN("photos")}, want: NoMatch},
+		{name: "named user lists", req: Request{Principal: "alice", Action: ActionListBucket, Resource: BucketARN("photos")}, want: Allowed},
+		{name: "named user reads by action pattern", req: Request{Principal: "alice", Action: ActionGetObject, Resource: ObjectARN("photos", "private/cat.jpg")}, want: Allowed},
+		{name: "named user cannot write", req: Request{Principal: "alice", Action: ActionPutObject, Resource: ObjectARN("photos", "private/cat.jpg")}, want: NoMatch},
+		{name: "deny wins over allow", req: Request{Principal: "alice", Action: ActionGetObject, Resource: ObjectARN("photos", "secret/plans.txt")}, want: Denied},
+		{name: "action matched case-insensitively", req: Request{Principal: "bob", Action: ActionPutObject, Resource: ObjectARN("photos", "inbox/0001.jpg")}, want: Allowed},
+		{name: "question mark matches one character", req: Request{Principal: "bob", Action: ActionPutObject, Resource: ObjectARN("photos", "inbox/01.jpg")}, want: NoMatch},
+		{name: "resource matched case-sensitively", req: Request{Action: ActionGetObject, Resource: ObjectARN("photos", "Public/cat.jpg")}, want: NoMatch},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := p.Evaluate(c.req); got != c.want {
+				t.Fatalf("Evaluate(%+v) = %v, want %v", c.req, got, c.want)
+			}
+		})
+	}
+
+	var none *Policy
+	if got := none.Evaluate(Request{Action: ActionGetObject, Resource: ObjectARN("photos", "a")}); got != NoMatch {
+		t.Fatalf("nil policy Evaluate = %v, want NoMatch", got)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		pattern, s string
+		want       bool
+	}{
+		{"abc", "abc", true},
+		{"abc", "abcd", false},
+		{"*", "", true},
+		{"a*c", "abbbc", true},
+		{"a*c", "abbbd", false},
+		{"a*b*c", "axbyc", true},
+		{"a*b*c", "axbycb", false},
+		{"a?c", "abc", true},
+		{"a?c", "ac", false},
+		{"**", "anything", true},
+	}
+
+	for _, c := range cases {
+		if got := match(c.pattern, c.s); got != c.want {
+			t.Errorf("match(%q, %q) = %v, want %v", c.pattern, c.s, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Package secretbox seals secrets that gantry must be able to read back,
+// such as SigV4 secret access keys, so they are never stored in plaintext.
+package secretbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+// KeySize is the length of the key Box requires: AES-256.
+const KeySize = 32
+
+var ErrOpen = errors.New("secretbox: message authentication failed")
+
+// Box seals and opens secrets with AES-256-GCM. Each sealed value carries
+// its own random nonce.
+type Box struct {
+	aead cipher.AEAD
+}
+
+func New(key []byte) (*Box, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("secretbox: key is %d bytes, want %d", len(key), KeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("secretbox: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("secretbox: %w", err)
+	}
+
+	return &Box{aead: aead}, nil
+}
+
+// Seal encrypts plaintext bound to context, which must be given again to
+// Open. Binding to the owning row's identifier stops a sealed value from
+// being copied onto another row.
+func (b *Box) Seal(plaintext, context string) []byte {
+	nonce := make([]byte, b.aead.NonceSize(), b.aead.NonceSize()+len(plaintext)+b.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		panic(fmt.Sprintf("secretbox: read nonce: %v", err))
+	}
+	return b.aead.Seal(nonce, nonce, []byte(plaintext), []byte(context))
+}
+
+// Open decrypts a value from Seal, failing with ErrOpen if it was sealed
+// under another key or context or has been altered.
+func (b *Box) Open(sealed []byte, context string) (string, error) {
+	if len(sealed) < b.aead.NonceSize() {
+		return "", ErrOpen
+	}
+	nonce, ciphertext := sealed[:b.aead.NonceSize()], sealed[b.aead.NonceSize():]
+
+	plaintext, err := b.aead.Open(nil, nonce, ciphertext, []byte(context))
+	if err != nil {
+		return "", ErrOpen
+	}
+	return string(plaintext), nil
+}
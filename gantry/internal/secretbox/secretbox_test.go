@@ -0,0 +1,83 @@
+package secretbox_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/secretbox"
+)
+
+func newBox(t *testing.T, fill byte) *secretbox.Box {
+	t.Helper()
+
+	box, err := secretbox.New(bytes.Repeat([]byte{fill}, secretbox.KeySize))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return box
+}
+
+func TestBox_SealOpen(t *testing.T) {
+	t.Parallel()
+
+	box := newBox(t, 1)
+	sealed := box.Seal("wJalrXUtnFEMI/K7MDENG", "AKIDEXAMPLE")
+
+	if bytes.Contains(sealed, []byte("wJalrXUtnFEMI")) {
+		t.Fatalf("sealed value contains the plaintext")
+	}
+	if again := box.Seal("wJalrXUtnFEMI/K7MDENG", "AKIDEXAMPLE"); bytes.Equal(again, sealed) {
+		t.Fatalf("sealing twice gave the same bytes; nonce not random")
+	}
+
+	got, err := box.Open(sealed, "AKIDEXAMPLE")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if got != "wJalrXUtnFEMI/K7MDENG" {
+		t.Fatalf("Open = %q, want %q", got, "wJalrXUtnFEMI/K7MDENG")
+	}
+}
+
+func TestBox_OpenRejects(t *testing.T) {
+	t.Parallel()
+
+	box := newBox(t, 1)
+	sealed := box.Seal("secret", "AKIDEXAMPLE")
+
+	tampered := bytes.Clone(sealed)
+	tampered[len(tampered)-1] ^= 0xff
+
+	cases := []struct {
+		name    string
+		box     *secretbox.Box
+		sealed  []byte
+		context string
+	}{
+		{name: "other key", box: newBox(t, 2), sealed: sealed, context: "AKIDEXAMPLE"},
+		{name: "other context", box: box, sealed: sealed, context: "AKIDOTHER"},
+		{name: "tampered", box: box, sealed: tampered, context: "AKIDEXAMPLE"},
+		{name: "truncated", box: box, sealed: sealed[:4], context: "AKIDEXAMPLE"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := c.box.Open(c.sealed, c.context); !errors.Is(err, secretbox.ErrOpen) {
+				t.Fatalf("Open error = %v, want %v", err, secretbox.ErrOpen)
+			}
+		})
+	}
+}
+
+func TestNew_RejectsWrongKeySize(t *testing.T) {
+	t.Parallel()
+
+	_, err := secretbox.New([]byte("short"))
+	if err == nil || !strings.Contains(err.Error(), "want 32") {
+		t.Fatalf("New error = %v, want key size error", err)
+	}
+}
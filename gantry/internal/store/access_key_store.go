@@ -0,0 +1,245 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/secretbox"
+)
+
+var ErrAccessKeyNotFound = errors.New("access key not found")
+var ErrAccessKeyAlreadyExists = errors.New("access key already exists")
+
+// Access key statuses, named as in IAM. Only Active keys may sign requests.
+const (
+	AccessKeyActive   = "Active"
+	AccessKeyInactive = "Inactive"
+)
+
+// accessKeyStore seals each secret with box before it reaches the database,
+// bound to its access key ID.
+type accessKeyStore struct {
+	db  *sql.DB
+	box *secretbox.Box
+}
+
+func NewAccessKeyStore(db *sql.DB, box *secretbox.Box) AccessKeyStore {
+	return &accessKeyStore{db: db, box: box}
+}
+
+// AccessKeyRecord is an access key and the user it belongs to.
+// SecretAccessKey is only filled in by Get, Create, Upsert and Rotate.
+type AccessKeyRecord struct {
+	ID              string
+	AccessKeyID     string
+	UserID          string
+	UserName        string
+	SecretAccessKey string
+	Status          string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+const selectAccessKeyColumns = `
+SELECT k.id, k.access_key_id, k.user_id, u.name, k.secret_ciphertext, k.status, k.created_at, k.updated_at
+FROM access_keys k
+JOIN users u ON u.id = k.user_id`
+
+// Create stores a new Active access key for userID.
+func (s *accessKeyStore) Create(ctx context.Context, id, userID, accessKeyID, secretAccessKey string, createdAt time.Time) (AccessKeyRecord, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return AccessKeyRecord{}, fmt.Errorf("create access key, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.insert(ctx, tx, id, userID, accessKeyID, secretAccessKey, createdAt); err != nil {
+		return AccessKeyRecord{}, fmt.Errorf("create access key: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return AccessKeyRecord{}, fmt.Errorf("create access key, commit: %w", err)
+	}
+
+	return s.Get(ctx, accessKeyID)
+}
+
+// Upsert stores an Active access key for userID, replacing the owner and
+// secret and reactivating it if the access key ID already exists.
+func (s *accessKeyStore) Upsert(ctx context.Context, id, userID, accessKeyID, secretAccessKey string, updatedAt time.Time) (AccessKeyRecord, error) {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	const upsertAccessKey = `
+INSERT INTO access_keys (id, access_key_id, user_id, secret_ciphertext, status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $6)
+ON CONFLICT (access_key_id)
+DO UPDATE SET
+	user_id = EXCLUDED.user_id,
+	secret_ciphertext = EXCLUDED.secret_ciphertext,
+	status = EXCLUDED.status,
+	updated_at = EXCLUDED.updated_at;
+`
+
+	sealed := s.box.Seal(secretAccessKey, accessKeyID)
+	if _, err := s.db.ExecContext(ctx, upsertAccessKey, id, accessKeyID, userID, sealed, AccessKeyActive, micros); err != nil {
+		return AccessKeyRecord{}, fmt.Errorf("upsert access key: %w", err)
+	}
+
+	return s.Get(ctx, accessKeyID)
+}
+
+// Get returns an access key with its secret unsealed.
+func (s *accessKeyStore) Get(ctx context.Context, accessKeyID string) (AccessKeyRecord, error) {
+	row := s.db.QueryRowContext(ctx, selectAccessKeyColumns+` WHERE k.access_key_id = ?`, accessKeyID)
+
+	rec, sealed, err := scanAccessKey(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return AccessKeyRecord{}, ErrAccessKeyNotFound
+		}
+		return AccessKeyRecord{}, fmt.Errorf("get access key: %w", err)
+	}
+
+	rec.SecretAccessKey, err = s.box.Open(sealed, rec.AccessKeyID)
+	if err != nil {
+		return AccessKeyRecord{}, fmt.Errorf("get access key %s: %w", accessKeyID, err)
+	}
+
+	return rec, nil
+}
+
+// List returns the access keys of userID, or of every user when userID is
+// empty, ordered by user name and creation time. Secrets are left sealed.
+func (s *accessKeyStore) List(ctx context.Context, userID string) ([]AccessKeyRecord, error) {
+	query := selectAccessKeyColumns + ` WHERE ($1 = '' OR k.user_id = $1) ORDER BY u.name ASC, k.created_at ASC, k.access_key_id ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list access keys: %w", err)
+	}
+	defer rows.Close()
+
+	var records []AccessKeyRecord
+
+	for rows.Next() {
+		rec, _, err := scanAccessKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan access key: %w", err)
+		}
+		records = append(records, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate access keys: %w", err)
+	}
+
+	return records, nil
+}
+
+// SetStatus activates or deactivates an access key. Its secret is not
+// returned.
+func (s *accessKeyStore) SetStatus(ctx context.Context, accessKeyID, status string, updatedAt time.Time) (AccessKeyRecord, error) {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	const updateStatus = `UPDATE access_keys SET status = ?, updated_at = ? WHERE access_key_id = ?`
+
+	result, err := s.db.ExecContext(ctx, updateStatus, status, micros, accessKeyID)
+	if err != nil {
+		return AccessKeyRecord{}, fmt.Errorf("set access key status: %w", err)
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return AccessKeyRecord{}, fmt.Errorf("set access key status: %w", err)
+	} else if n == 0 {
+		return AccessKeyRecord{}, ErrAccessKeyNotFound
+	}
+
+	rec, err := s.Get(ctx, accessKeyID)
+	rec.SecretAccessKey = ""
+	return rec, err
+}
+
+// Rotate replaces an access key: in one transaction it creates a new Active
+// key for the same user and deactivates the old one, which can be deleted
+// once clients have switched over.
+func (s *accessKeyStore) Rotate(ctx context.Context, accessKeyID, newID, newAccessKeyID, newSecretAccessKey string, rotatedAt time.Time) (AccessKeyRecord, error) {
+	micros := rotatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return AccessKeyRecord{}, fmt.Errorf("rotate access key, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	var userID string
+	if err := tx.QueryRowContext(ctx, `SELECT user_id FROM access_keys WHERE access_key_id = ?`, accessKeyID).Scan(&userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return AccessKeyRecord{}, ErrAccessKeyNotFound
+		}
+		return AccessKeyRecord{}, fmt.Errorf("rotate access key: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE access_keys SET status = ?, updated_at = ? WHERE access_key_id = ?`,
+		AccessKeyInactive, micros, accessKeyID); err != nil {
+		return AccessKeyRecord{}, fmt.Errorf("rotate access key, deactivate: %w", err)
+	}
+
+	if err := s.insert(ctx, tx, newID, userID, newAccessKeyID, newSecretAccessKey, rotatedAt); err != nil {
+		return AccessKeyRecord{}, fmt.Errorf("rotate access key: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return AccessKeyRecord{}, fmt.Errorf("rotate access key, commit: %w", err)
+	}
+
+	return s.Get(ctx, newAccessKeyID)
+}
+
+func (s *accessKeyStore) insert(ctx context.Context, tx *sql.Tx, id, userID, accessKeyID, secretAccessKey string, createdAt time.Time) error {
+	micros := createdAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	const insertAccessKey = `
+INSERT INTO access_keys (id, access_key_id, user_id, secret_ciphertext, status, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	sealed := s.box.Seal(secretAccessKey, accessKeyID)
+	if _, err := tx.ExecContext(ctx, insertAccessKey, id, accessKeyID, userID, sealed, AccessKeyActive, micros, micros); err != nil {
+		var sqliteErr *sqlite.Error
+		if errors.As(err, &sqliteErr) {
+			switch sqliteErr.Code() {
+			case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+				return ErrAccessKeyAlreadyExists
+			case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+				return ErrUserNotFound
+			}
+		}
+		return fmt.Errorf("insert access key: %w", err)
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAccessKey(row rowScanner) (AccessKeyRecord, []byte, error) {
+	var (
+		rec       AccessKeyRecord
+		sealed    []byte
+		createdAt int64
+		updatedAt int64
+	)
+
+	if err := row.Scan(&rec.ID, &rec.AccessKeyID, &rec.UserID, &rec.UserName, &sealed, &rec.Status, &createdAt, &updatedAt); err != nil {
+		return AccessKeyRecord{}, nil, err
+	}
+
+	rec.CreatedAt = time.UnixMicro(createdAt).UTC()
+	rec.UpdatedAt = time.UnixMicro(updatedAt).UTC()
+
+	return rec, sealed, nil
+}
@@ -0,0 +1,372 @@
+package store_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/secretbox"
+	store "github.com/ratdaddy/blockcloset/gantry/internal/store"
+)
+
+func newTestBox(t *testing.T) *secretbox.Box {
+	t.Helper()
+
+	box, err := secretbox.New(bytes.Repeat([]byte{7}, secretbox.KeySize))
+	if err != nil {
+		t.Fatalf("secretbox.New: %v", err)
+	}
+	return box
+}
+
+func createTestUser(t *testing.T, db *sql.DB, id, name string, createdAt time.Time) store.UserRecord {
+	t.Helper()
+
+	rec, err := store.NewUserStore(db).Create(context.Background(), id, name, createdAt)
+	if err != nil {
+		t.Fatalf("create user %s: %v", name, err)
+	}
+	return rec
+}
+
+func TestAccessKeyStore_UpsertAndGet(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	type tc struct {
+		name        string
+		secondID    string
+		secondUser  string
+		secondStamp time.Time
+		deactivate  bool
+		wantSecret  string
+		wantUser    string
+	}
+
+	cases := []tc{
+		{
+			name:       "creates access key",
+			wantSecret: "first-secret",
+			wantUser:   "admin",
+		},
+		{
+			name:        "existing access key gets the new secret and owner",
+			secondID:    "access-key-row-replaced",
+			secondUser:  "user-bob",
+			secondStamp: base.Add(10 * time.Minute),
+			wantSecret:  "second-secret",
+			wantUser:    "bob",
+		},
+		{
+			name:        "inactive access key is reactivated",
+			secondID:    "access-key-row-replaced",
+			secondUser:  "user-admin",
+			secondStamp: base.Add(10 * time.Minute),
+			deactivate:  true,
+			wantSecret:  "second-secret",
+			wantUser:    "admin",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			createTestUser(t, db, "user-admin", "admin", base)
+			createTestUser(t, db, "user-bob", "bob", base)
+			s := store.NewAccessKeyStore(db, newTestBox(t))
+
+			if _, err := s.Upsert(ctx, "access-key-row-initial", "user-admin", "AKIDEXAMPLE", "first-secret", base); err != nil {
+				t.Fatalf("Upsert initial: unexpected error: %v", err)
+			}
+			if c.deactivate {
+				if _, err := s.SetStatus(ctx, "AKIDEXAMPLE", store.AccessKeyInactive, base); err != nil {
+					t.Fatalf("SetStatus: unexpected error: %v", err)
+				}
+			}
+
+			wantUpdated := base
+			wantUserID := "user-admin"
+			if c.secondID != "" {
+				rec, err := s.Upsert(ctx, c.secondID, c.secondUser, "AKIDEXAMPLE", "second-secret", c.secondStamp)
+				if err != nil {
+					t.Fatalf("Upsert second: unexpected error: %v", err)
+				}
+				if rec.ID != "access-key-row-initial" {
+					t.Fatalf("Upsert second ID: got %q, want %q", rec.ID, "access-key-row-initial")
+				}
+				wantUpdated = c.secondStamp
+				wantUserID = c.secondUser
+			}
+
+			got, err := s.Get(ctx, "AKIDEXAMPLE")
+			if err != nil {
+				t.Fatalf("Get: unexpected error: %v", err)
+			}
+
+			want := store.AccessKeyRecord{
+				ID:              "access-key-row-initial",
+				AccessKeyID:     "AKIDEXAMPLE",
+				UserID:          wantUserID,
+				UserName:        c.wantUser,
+				SecretAccessKey: c.wantSecret,
+				Status:          store.AccessKeyActive,
+				CreatedAt:       base,
+				UpdatedAt:       wantUpdated,
+			}
+			if got != want {
+				t.Fatalf("Get: got %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestAccessKeyStore_SecretSealedAtRest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	createTestUser(t, db, "user-admin", "admin", now)
+
+	if _, err := store.NewAccessKeyStore(db, newTestBox(t)).Create(ctx, "row-1", "user-admin", "AKIDEXAMPLE", "plain-secret", now); err != nil {
+		t.Fatalf("Create: unexpected error: %v", err)
+	}
+
+	var sealed []byte
+	if err := db.QueryRowContext(ctx, `SELECT secret_ciphertext FROM access_keys WHERE access_key_id = ?`, "AKIDEXAMPLE").Scan(&sealed); err != nil {
+		t.Fatalf("select ciphertext: %v", err)
+	}
+	if bytes.Contains(sealed, []byte("plain-secret")) {
+		t.Fatalf("secret stored in plaintext: %q", sealed)
+	}
+
+	otherBox, err := secretbox.New(bytes.Repeat([]byte{8}, secretbox.KeySize))
+	if err != nil {
+		t.Fatalf("secretbox.New: %v", err)
+	}
+	if _, err := store.NewAccessKeyStore(db, otherBox).Get(ctx, "AKIDEXAMPLE"); !errors.Is(err, secretbox.ErrOpen) {
+		t.Fatalf("Get with wrong key: got err %v, want %v", err, secretbox.ErrOpen)
+	}
+}
+
+func TestAccessKeyStore_Create(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name        string
+		userID      string
+		accessKeyID string
+		wantErr     error
+	}{
+		{
+			name:        "creates active key",
+			userID:      "user-admin",
+			accessKeyID: "AKIDNEW",
+		},
+		{
+			name:        "duplicate access key id",
+			userID:      "user-admin",
+			accessKeyID: "AKIDEXISTING",
+			wantErr:     store.ErrAccessKeyAlreadyExists,
+		},
+		{
+			name:        "unknown user",
+			userID:      "user-missing",
+			accessKeyID: "AKIDNEW",
+			wantErr:     store.ErrUserNotFound,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			createTestUser(t, db, "user-admin", "admin", now)
+			s := store.NewAccessKeyStore(db, newTestBox(t))
+
+			if _, err := s.Create(ctx, "row-existing", "user-admin", "AKIDEXISTING", "existing-secret", now); err != nil {
+				t.Fatalf("Create existing: unexpected error: %v", err)
+			}
+
+			got, err := s.Create(ctx, "row-new", c.userID, c.accessKeyID, "new-secret", now)
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("Create: got err %v, want %v", err, c.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Create: unexpected error: %v", err)
+			}
+
+			want := store.AccessKeyRecord{
+				ID:              "row-new",
+				AccessKeyID:     c.accessKeyID,
+				UserID:          "user-admin",
+				UserName:        "admin",
+				SecretAccessKey: "new-secret",
+				Status:          store.AccessKeyActive,
+				CreatedAt:       now,
+				UpdatedAt:       now,
+			}
+			if got != want {
+				t.Fatalf("Create: got %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestAccessKeyStore_List(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	base := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	createTestUser(t, db, "user-bob", "bob", base)
+	createTestUser(t, db, "user-alice", "alice", base)
+	s := store.NewAccessKeyStore(db, newTestBox(t))
+
+	for _, k := range []struct{ id, user, key string }{
+		{"row-1", "user-bob", "AKIDBOB2"},
+		{"row-2", "user-alice", "AKIDALICE"},
+		{"row-3", "user-bob", "AKIDBOB1"},
+	} {
+		if _, err := s.Create(ctx, k.id, k.user, k.key, "secret", base); err != nil {
+			t.Fatalf("Create %s: unexpected error: %v", k.key, err)
+		}
+		base = base.Add(time.Minute)
+	}
+
+	cases := []struct {
+		name   string
+		userID string
+		want   []string
+	}{
+		{name: "all users", want: []string{"AKIDALICE", "AKIDBOB2", "AKIDBOB1"}},
+		{name: "one user", userID: "user-bob", want: []string{"AKIDBOB2", "AKIDBOB1"}},
+		{name: "user without keys", userID: "user-nobody"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			records, err := s.List(ctx, c.userID)
+			if err != nil {
+				t.Fatalf("List: unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, rec := range records {
+				if rec.SecretAccessKey != "" {
+					t.Fatalf("List returned secret for %s", rec.AccessKeyID)
+				}
+				got = append(got, rec.AccessKeyID)
+			}
+			if len(got) != len(c.want) {
+				t.Fatalf("List: got %v, want %v", got, c.want)
+			}
+			for i := range got {
+				if got[i] != c.want[i] {
+					t.Fatalf("List: got %v, want %v", got, c.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAccessKeyStore_SetStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	base := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	createTestUser(t, db, "user-admin", "admin", base)
+	s := store.NewAccessKeyStore(db, newTestBox(t))
+
+	if _, err := s.Create(ctx, "row-1", "user-admin", "AKIDEXAMPLE", "secret", base); err != nil {
+		t.Fatalf("Create: unexpected error: %v", err)
+	}
+
+	later := base.Add(time.Hour)
+	rec, err := s.SetStatus(ctx, "AKIDEXAMPLE", store.AccessKeyInactive, later)
+	if err != nil {
+		t.Fatalf("SetStatus: unexpected error: %v", err)
+	}
+	if rec.Status != store.AccessKeyInactive || !rec.UpdatedAt.Equal(later) || rec.SecretAccessKey != "" {
+		t.Fatalf("SetStatus: got %+v", rec)
+	}
+
+	if _, err := s.SetStatus(ctx, "AKIDMISSING", store.AccessKeyInactive, later); !errors.Is(err, store.ErrAccessKeyNotFound) {
+		t.Fatalf("SetStatus missing: got err %v, want %v", err, store.ErrAccessKeyNotFound)
+	}
+}
+
+func TestAccessKeyStore_Rotate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	base := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	createTestUser(t, db, "user-admin", "admin", base)
+	s := store.NewAccessKeyStore(db, newTestBox(t))
+
+	if _, err := s.Create(ctx, "row-old", "user-admin", "AKIDOLD", "old-secret", base); err != nil {
+		t.Fatalf("Create: unexpected error: %v", err)
+	}
+
+	later := base.Add(time.Hour)
+	got, err := s.Rotate(ctx, "AKIDOLD", "row-new", "AKIDNEW", "new-secret", later)
+	if err != nil {
+		t.Fatalf("Rotate: unexpected error: %v", err)
+	}
+
+	want := store.AccessKeyRecord{
+		ID:              "row-new",
+		AccessKeyID:     "AKIDNEW",
+		UserID:          "user-admin",
+		UserName:        "admin",
+		SecretAccessKey: "new-secret",
+		Status:          store.AccessKeyActive,
+		CreatedAt:       later,
+		UpdatedAt:       later,
+	}
+	if got != want {
+		t.Fatalf("Rotate: got %+v, want %+v", got, want)
+	}
+
+	old, err := s.Get(ctx, "AKIDOLD")
+	if err != nil {
+		t.Fatalf("Get old: unexpected error: %v", err)
+	}
+	if old.Status != store.AccessKeyInactive {
+		t.Fatalf("old key status: got %q, want %q", old.Status, store.AccessKeyInactive)
+	}
+
+	if _, err := s.Rotate(ctx, "AKIDMISSING", "row-x", "AKIDX", "x", later); !errors.Is(err, store.ErrAccessKeyNotFound) {
+		t.Fatalf("Rotate missing: got err %v, want %v", err, store.ErrAccessKeyNotFound)
+	}
+	if _, err := s.Rotate(ctx, "AKIDOLD", "row-dup", "AKIDNEW", "x", later); !errors.Is(err, store.ErrAccessKeyAlreadyExists) {
+		t.Fatalf("Rotate duplicate: got err %v, want %v", err, store.ErrAccessKeyAlreadyExists)
+	}
+}
+
+func TestAccessKeyStore_GetMissing(t *testing.T) {
+	t.Parallel()
+
+	db := openIsolatedDB(t)
+	s := store.NewAccessKeyStore(db, newTestBox(t))
+
+	if _, err := s.Get(context.Background(), "AKIDMISSING"); !errors.Is(err, store.ErrAccessKeyNotFound) {
+		t.Fatalf("Get: got err %v, want %v", err, store.ErrAccessKeyNotFound)
+	}
+}
@@ -0,0 +1,56 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type blobDeletionStore struct {
+	db *sql.DB
+}
+
+func NewBlobDeletionStore(db *sql.DB) BlobDeletionStore {
+	return &blobDeletionStore{db: db}
+}
+
+// List returns queued blobs oldest first, in the same shape the cleanup
+// worker uses for REPLACED and FAILED objects.
+func (s *blobDeletionStore) List(ctx context.Context, limit int) ([]ReclaimableObject, error) {
+	const selectQueued = `
+SELECT d.object_id, d.bucket_name, c.address
+FROM blob_deletions d
+JOIN cradle_servers c ON c.id = d.cradle_server_id
+ORDER BY d.created_at
+LIMIT ?
+`
+
+	rows, err := s.db.QueryContext(ctx, selectQueued, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list blob deletions: %w", err)
+	}
+	defer rows.Close()
+
+	objects := make([]ReclaimableObject, 0)
+	for rows.Next() {
+		var obj ReclaimableObject
+		if err := rows.Scan(&obj.ID, &obj.Bucket, &obj.CradleAddress); err != nil {
+			return nil, fmt.Errorf("scan blob deletion: %w", err)
+		}
+		objects = append(objects, obj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate blob deletions: %w", err)
+	}
+
+	return objects, nil
+}
+
+// Remove drops a blob from the queue once its cradle confirms the delete.
+func (s *blobDeletionStore) Remove(ctx context.Context, objectID string) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM blob_deletions WHERE object_id = ?`, objectID); err != nil {
+		return fmt.Errorf("remove blob deletion: %w", err)
+	}
+	return nil
+}
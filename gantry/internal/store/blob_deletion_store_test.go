@@ -0,0 +1,58 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	store "github.com/ratdaddy/blockcloset/gantry/internal/store"
+)
+
+func TestBlobDeletionStore_ListAndRemove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	s := store.NewBlobDeletionStore(db)
+	createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	setupPrerequisites(ctx, t, db, "bucket-id-queue", "cradle-id-queue", createdAt, false, false)
+	insertObjectWithState(ctx, t, db, "object-id-1", "bucket-id-queue", "a", "REPLACED", "cradle-id-queue", createdAt)
+	insertObjectWithState(ctx, t, db, "object-id-2", "bucket-id-queue", "b", "FAILED", "cradle-id-queue", createdAt)
+
+	if _, err := store.NewBucketStore(db).Delete(ctx, "bucket-id-queue", false, createdAt.Add(time.Minute)); err != nil {
+		t.Fatalf("setup Delete: %v", err)
+	}
+
+	got, err := s.List(ctx, 10)
+	if err != nil {
+		t.Fatalf("List: unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("List: got %d blobs, want 2: %+v", len(got), got)
+	}
+	for _, obj := range got {
+		if obj.Bucket != "test-bucket" {
+			t.Errorf("Bucket: got %q, want %q", obj.Bucket, "test-bucket")
+		}
+		if obj.CradleAddress != "127.0.0.1:9444" {
+			t.Errorf("CradleAddress: got %q, want %q", obj.CradleAddress, "127.0.0.1:9444")
+		}
+	}
+
+	if err := s.Remove(ctx, "object-id-1"); err != nil {
+		t.Fatalf("Remove: unexpected error: %v", err)
+	}
+	// Removing again is a no-op so retried confirmations don't fail.
+	if err := s.Remove(ctx, "object-id-1"); err != nil {
+		t.Fatalf("Remove again: unexpected error: %v", err)
+	}
+
+	got, err = s.List(ctx, 10)
+	if err != nil {
+		t.Fatalf("List after remove: unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "object-id-2" {
+		t.Fatalf("List after remove: got %+v, want only object-id-2", got)
+	}
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -13,6 +14,22 @@ import (
 
 var ErrBucketAlreadyExists = errors.New("bucket already exists")
 var ErrBucketNotFound = errors.New("bucket not found")
+var ErrBucketNotEmpty = errors.New("bucket not empty")
+
+// Canned ACLs a bucket may have, named as in S3.
+const (
+	BucketACLPrivate           = "private"
+	BucketACLPublicRead        = "public-read"
+	BucketACLPublicReadWrite   = "public-read-write"
+	BucketACLAuthenticatedRead = "authenticated-read"
+)
+
+// Versioning statuses a bucket may have, named as in S3. A bucket whose
+// versioning was never enabled has neither.
+const (
+	VersioningEnabled   = "Enabled"
+	VersioningSuspended = "Suspended"
+)
 
 type bucketStore struct {
 	db *sql.DB
@@ -22,20 +39,99 @@ func NewBucketStore(db *sql.DB) BucketStore {
 	return &bucketStore{db: db}
 }
 
+// BucketRecord is a bucket, the user that owns it and who else may use it.
+// OwnerID is empty for buckets created anonymously; Policy is empty when the
+// bucket has none, Versioning when versioning was never enabled, and
+// Lifecycle and CORS when no such configuration was put. ObjectLock is nil
+// unless object lock is enabled.
 type BucketRecord struct {
-	ID        string
-	Name      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID         string
+	Name       string
+	OwnerID    string
+	ACL        string
+	Policy     string
+	Versioning string
+	Lifecycle  []LifecycleRule
+	CORS       []CORSRule
+	ObjectLock *ObjectLockConfig
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// LifecycleRule is one rule of a bucket's lifecycle configuration. It applies
+// to the versions of keys starting with Prefix whose objects carry every tag
+// in Tags. Current versions expire ExpirationDays days after they became
+// current, or once ExpirationDate has passed. Noncurrent versions are removed
+// NoncurrentDays days after they became noncurrent, except for the
+// NewerNoncurrentVersions newest of each key. With ExpiredObjectDeleteMarker a
+// delete marker that is the only version left of its key is removed.
+type LifecycleRule struct {
+	ID                        string            `json:"id,omitempty"`
+	Enabled                   bool              `json:"enabled"`
+	Prefix                    string            `json:"prefix,omitempty"`
+	Tags                      map[string]string `json:"tags,omitempty"`
+	ExpirationDays            int               `json:"expiration_days,omitempty"`
+	ExpirationDate            time.Time         `json:"expiration_date,omitzero"`
+	ExpiredObjectDeleteMarker bool              `json:"expired_object_delete_marker,omitempty"`
+	NoncurrentDays            int               `json:"noncurrent_days,omitempty"`
+	NewerNoncurrentVersions   int               `json:"newer_noncurrent_versions,omitempty"`
+}
+
+// CORSRule is one rule of a bucket's CORS configuration. It allows requests
+// from an origin matching one of AllowedOrigins with one of AllowedMethods,
+// sending only headers matching AllowedHeaders. Origins and headers may hold
+// one * wildcard. ExposeHeaders are the response headers the browser may read
+// and MaxAgeSeconds how long it may cache a preflight response.
+type CORSRule struct {
+	ID             string   `json:"id,omitempty"`
+	AllowedOrigins []string `json:"allowed_origins"`
+	AllowedMethods []string `json:"allowed_methods"`
+	AllowedHeaders []string `json:"allowed_headers,omitempty"`
+	ExposeHeaders  []string `json:"expose_headers,omitempty"`
+	MaxAgeSeconds  int      `json:"max_age_seconds,omitempty"`
+}
+
+// ObjectLockConfig is the object lock configuration of a bucket. Versions
+// written without a retention of their own are retained in DefaultMode for
+// DefaultDays days or DefaultYears years from when they became current; an
+// empty DefaultMode means there is no default retention.
+type ObjectLockConfig struct {
+	DefaultMode  string `json:"default_mode,omitempty"`
+	DefaultDays  int    `json:"default_days,omitempty"`
+	DefaultYears int    `json:"default_years,omitempty"`
 }
 
-func (s *bucketStore) Create(ctx context.Context, id string, name string, createdAt time.Time) (BucketRecord, error) {
+// DefaultRetainUntil is when the default retention of a version that became
+// current at from ends.
+func (c ObjectLockConfig) DefaultRetainUntil(from time.Time) time.Time {
+	return from.AddDate(c.DefaultYears, 0, c.DefaultDays)
+}
+
+const selectBucketColumns = `SELECT id, name, COALESCE(owner_id, ''), acl, COALESCE(policy, ''), COALESCE(versioning, ''), COALESCE(lifecycle, ''), COALESCE(cors, ''), COALESCE(object_lock, ''), created_at, updated_at FROM buckets`
+
+// Create inserts a bucket. An empty acl means BucketACLPrivate. A non-nil
+// lock enables object lock from the start, and with it versioning, as
+// SetObjectLock does.
+func (s *bucketStore) Create(ctx context.Context, id string, name string, ownerID string, acl string, lock *ObjectLockConfig, createdAt time.Time) (BucketRecord, error) {
 	stamp := createdAt.UTC().Truncate(time.Microsecond)
 	micros := stamp.UnixMicro()
 
-	const insertBucket = `INSERT INTO buckets (id, name, created_at, updated_at) VALUES (?, ?, ?, ?)`
+	if acl == "" {
+		acl = BucketACLPrivate
+	}
+
+	var objectLock, versioning any
+	if lock != nil {
+		doc, err := json.Marshal(lock)
+		if err != nil {
+			return BucketRecord{}, fmt.Errorf("insert bucket: %w", err)
+		}
+		objectLock, versioning = string(doc), VersioningEnabled
+	}
+
+	const insertBucket = `INSERT INTO buckets (id, name, owner_id, acl, object_lock, versioning, created_at, updated_at) VALUES (?, ?, NULLIF(?, ''), ?, ?, ?, ?, ?)`
 
-	if _, err := s.db.ExecContext(ctx, insertBucket, id, name, micros, micros); err != nil {
+	if _, err := s.db.ExecContext(ctx, insertBucket, id, name, ownerID, acl, objectLock, versioning, micros, micros); err != nil {
 		var sqliteErr *sqlite.Error
 		if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
 			return BucketRecord{}, fmt.Errorf("insert bucket: %w", ErrBucketAlreadyExists)
@@ -43,11 +139,16 @@ func (s *bucketStore) Create(ctx context.Context, id string, name string, create
 		return BucketRecord{}, fmt.Errorf("insert bucket: %w", err)
 	}
 
-	return BucketRecord{ID: id, Name: name, CreatedAt: stamp, UpdatedAt: stamp}, nil
+	rec := BucketRecord{ID: id, Name: name, OwnerID: ownerID, ACL: acl, CreatedAt: stamp, UpdatedAt: stamp}
+	if lock != nil {
+		l := *lock
+		rec.ObjectLock, rec.Versioning = &l, VersioningEnabled
+	}
+	return rec, nil
 }
 
 func (s *bucketStore) List(ctx context.Context) ([]BucketRecord, error) {
-	const selectBuckets = `SELECT id, name, created_at, updated_at FROM buckets ORDER BY created_at ASC`
+	const selectBuckets = selectBucketColumns + ` ORDER BY created_at ASC`
 
 	rows, err := s.db.QueryContext(ctx, selectBuckets)
 	if err != nil {
@@ -58,19 +159,10 @@ func (s *bucketStore) List(ctx context.Context) ([]BucketRecord, error) {
 	var records []BucketRecord
 
 	for rows.Next() {
-		var (
-			rec       BucketRecord
-			createdAt int64
-			updatedAt int64
-		)
-
-		if err = rows.Scan(&rec.ID, &rec.Name, &createdAt, &updatedAt); err != nil {
+		rec, err := scanBucket(rows)
+		if err != nil {
 			return nil, fmt.Errorf("scan bucket: %w", err)
 		}
-
-		rec.CreatedAt = time.UnixMicro(createdAt).UTC()
-		rec.UpdatedAt = time.UnixMicro(updatedAt).UTC()
-
 		records = append(records, rec)
 	}
 	if err := rows.Err(); err != nil {
@@ -81,16 +173,146 @@ func (s *bucketStore) List(ctx context.Context) ([]BucketRecord, error) {
 }
 
 func (s *bucketStore) GetByName(ctx context.Context, name string) (BucketRecord, error) {
-	const selectBucket = `SELECT id, name, created_at, updated_at FROM buckets WHERE name = ?`
+	rec, err := scanBucket(s.db.QueryRowContext(ctx, selectBucketColumns+` WHERE name = ?`, name))
+	if err != nil {
+		return BucketRecord{}, fmt.Errorf("get bucket by name: %w", ErrBucketNotFound)
+	}
+
+	return rec, nil
+}
+
+// SetACL replaces the bucket's canned ACL.
+func (s *bucketStore) SetACL(ctx context.Context, id string, acl string, updatedAt time.Time) error {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	return s.update(ctx, "set bucket acl", `UPDATE buckets SET acl = ?, updated_at = ? WHERE id = ?`, acl, micros, id)
+}
+
+// SetPolicy replaces the bucket policy; an empty policy removes it.
+func (s *bucketStore) SetPolicy(ctx context.Context, id string, policy string, updatedAt time.Time) error {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	return s.update(ctx, "set bucket policy", `UPDATE buckets SET policy = NULLIF(?, ''), updated_at = ? WHERE id = ?`, policy, micros, id)
+}
+
+// SetVersioning sets the bucket's versioning status to VersioningEnabled or
+// VersioningSuspended. Once enabled, versioning can be suspended but not
+// turned off.
+func (s *bucketStore) SetVersioning(ctx context.Context, id string, versioning string, updatedAt time.Time) error {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	return s.update(ctx, "set bucket versioning", `UPDATE buckets SET versioning = ?, updated_at = ? WHERE id = ?`, versioning, micros, id)
+}
+
+// SetLifecycle replaces the bucket's lifecycle rules; no rules removes its
+// lifecycle configuration.
+func (s *bucketStore) SetLifecycle(ctx context.Context, id string, rules []LifecycleRule, updatedAt time.Time) error {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	var lifecycle any
+	if len(rules) > 0 {
+		doc, err := json.Marshal(rules)
+		if err != nil {
+			return fmt.Errorf("set bucket lifecycle: %w", err)
+		}
+		lifecycle = string(doc)
+	}
+
+	return s.update(ctx, "set bucket lifecycle", `UPDATE buckets SET lifecycle = ?, updated_at = ? WHERE id = ?`, lifecycle, micros, id)
+}
+
+// SetCORS replaces the bucket's CORS rules; no rules removes its CORS
+// configuration.
+func (s *bucketStore) SetCORS(ctx context.Context, id string, rules []CORSRule, updatedAt time.Time) error {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	var cors any
+	if len(rules) > 0 {
+		doc, err := json.Marshal(rules)
+		if err != nil {
+			return fmt.Errorf("set bucket cors: %w", err)
+		}
+		cors = string(doc)
+	}
+
+	return s.update(ctx, "set bucket cors", `UPDATE buckets SET cors = ?, updated_at = ? WHERE id = ?`, cors, micros, id)
+}
+
+// SetObjectLock enables object lock on the bucket with lock's default
+// retention, replacing any it had. Object lock needs versioning, so that is
+// enabled too.
+func (s *bucketStore) SetObjectLock(ctx context.Context, id string, lock ObjectLockConfig, updatedAt time.Time) error {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	doc, err := json.Marshal(lock)
+	if err != nil {
+		return fmt.Errorf("set bucket object lock: %w", err)
+	}
+
+	return s.update(ctx, "set bucket object lock", `UPDATE buckets SET object_lock = ?, versioning = ?, updated_at = ? WHERE id = ?`,
+		string(doc), VersioningEnabled, micros, id)
+}
+
+// AdoptUnowned gives every bucket without an owner to ownerID, returning how
+// many it changed.
+func (s *bucketStore) AdoptUnowned(ctx context.Context, ownerID string, updatedAt time.Time) (int64, error) {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	result, err := s.db.ExecContext(ctx, `UPDATE buckets SET owner_id = ?, updated_at = ? WHERE owner_id IS NULL`, ownerID, micros)
+	if err != nil {
+		return 0, fmt.Errorf("adopt unowned buckets: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("adopt unowned buckets: %w", err)
+	}
+	return n, nil
+}
+
+func (s *bucketStore) update(ctx context.Context, op, query string, args ...any) error {
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%s: %w", op, ErrBucketNotFound)
+	}
+	return nil
+}
 
+func scanBucket(row rowScanner) (BucketRecord, error) {
 	var (
-		rec       BucketRecord
-		createdAt int64
-		updatedAt int64
+		rec        BucketRecord
+		lifecycle  string
+		cors       string
+		objectLock string
+		createdAt  int64
+		updatedAt  int64
 	)
 
-	if err := s.db.QueryRowContext(ctx, selectBucket, name).Scan(&rec.ID, &rec.Name, &createdAt, &updatedAt); err != nil {
-		return BucketRecord{}, fmt.Errorf("get bucket by name: %w", ErrBucketNotFound)
+	if err := row.Scan(&rec.ID, &rec.Name, &rec.OwnerID, &rec.ACL, &rec.Policy, &rec.Versioning, &lifecycle, &cors, &objectLock, &createdAt, &updatedAt); err != nil {
+		return BucketRecord{}, err
+	}
+
+	if lifecycle != "" {
+		if err := json.Unmarshal([]byte(lifecycle), &rec.Lifecycle); err != nil {
+			return BucketRecord{}, fmt.Errorf("decode lifecycle: %w", err)
+		}
+	}
+	if cors != "" {
+		if err := json.Unmarshal([]byte(cors), &rec.CORS); err != nil {
+			return BucketRecord{}, fmt.Errorf("decode cors: %w", err)
+		}
+	}
+	if objectLock != "" {
+		rec.ObjectLock = &ObjectLockConfig{}
+		if err := json.Unmarshal([]byte(objectLock), rec.ObjectLock); err != nil {
+			return BucketRecord{}, fmt.Errorf("decode object lock: %w", err)
+		}
 	}
 
 	rec.CreatedAt = time.UnixMicro(createdAt).UTC()
@@ -98,3 +320,100 @@ func (s *bucketStore) GetByName(ctx context.Context, name string) (BucketRecord,
 
 	return rec, nil
 }
+
+// Delete removes a bucket with its object and multipart upload rows in one
+// transaction, queueing every blob still on a cradle, including part blobs,
+// into blob_deletions for the cleanup worker. Without force, a bucket holding
+// COMMITTED objects, noncurrent versions or delete markers, in-flight PENDING
+// uploads or IN_PROGRESS multipart uploads is rejected with
+// ErrBucketNotEmpty. With force, a bucket holding a version under an object
+// lock is rejected with ErrObjectLocked instead. It returns the number of
+// blobs queued.
+func (s *bucketStore) Delete(ctx context.Context, id string, force bool, deletedAt time.Time) (int64, error) {
+	micros := deletedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("delete bucket, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	var name string
+	if err := tx.QueryRowContext(ctx, `SELECT name FROM buckets WHERE id = ?`, id).Scan(&name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("delete bucket: %w", ErrBucketNotFound)
+		}
+		return 0, fmt.Errorf("delete bucket: %w", err)
+	}
+
+	if !force {
+		var live bool
+		err := tx.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM objects WHERE bucket_id = ? AND state IN ('COMMITTED','PENDING','NONCURRENT','DELETE_MARKER')
+				UNION ALL
+				SELECT 1 FROM multipart_uploads WHERE bucket_id = ? AND state = 'IN_PROGRESS'
+			)
+		`, id, id).Scan(&live)
+		if err != nil {
+			return 0, fmt.Errorf("delete bucket, check empty: %w", err)
+		}
+		if live {
+			return 0, fmt.Errorf("delete bucket: %w", ErrBucketNotEmpty)
+		}
+	} else {
+		var locked bool
+		err := tx.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM objects o WHERE o.bucket_id = ? AND o.state IN `+versionStates+` AND `+lockedVersion(false)+`
+			)
+		`, id, micros).Scan(&locked)
+		if err != nil {
+			return 0, fmt.Errorf("delete bucket, check locks: %w", err)
+		}
+		if locked {
+			return 0, fmt.Errorf("delete bucket: %w", ErrObjectLocked)
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO blob_deletions (object_id, bucket_name, cradle_server_id, created_at, updated_at)
+		SELECT object_id, ?, cradle_server_id, ?, ?
+		FROM objects
+		WHERE bucket_id = ?
+		  AND state NOT IN ('DELETED','DELETE_MARKER')
+		  AND part_count = 0
+	`, name, micros, micros, id)
+	if err != nil {
+		return 0, fmt.Errorf("delete bucket, queue blobs: %w", err)
+	}
+
+	queued, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("delete bucket, rows affected: %w", err)
+	}
+
+	partsQueued, err := queuePartBlobs(ctx, tx, `u.bucket_id = ?`, micros, id)
+	if err != nil {
+		return 0, fmt.Errorf("delete bucket, queue parts: %w", err)
+	}
+	queued += partsQueued
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM multipart_uploads WHERE bucket_id = ?`, id); err != nil {
+		return 0, fmt.Errorf("delete bucket, delete uploads: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM objects WHERE bucket_id = ?`, id); err != nil {
+		return 0, fmt.Errorf("delete bucket, delete objects: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM buckets WHERE id = ?`, id); err != nil {
+		return 0, fmt.Errorf("delete bucket: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("delete bucket, commit: %w", err)
+	}
+
+	return queued, nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"reflect"
+	"slices"
 	"testing"
 	"time"
 
@@ -33,7 +36,7 @@ func TestBucketStore_Create(t *testing.T) {
 			bucket: "existing-bucket",
 			setup: func(ctx context.Context, t *testing.T, s store.BucketStore, createdAt time.Time) {
 				t.Helper()
-				if _, err := s.Create(ctx, "seed-bucket-id", "existing-bucket", createdAt); err != nil {
+				if _, err := s.Create(ctx, "seed-bucket-id", "existing-bucket", "", "", nil, createdAt); err != nil {
 					t.Fatalf("seed create: %v", err)
 				}
 			},
@@ -54,7 +57,7 @@ func TestBucketStore_Create(t *testing.T) {
 				c.setup(ctx, t, s, createdAt)
 			}
 
-			rec, err := s.Create(ctx, c.id, c.bucket, createdAt)
+			rec, err := s.Create(ctx, c.id, c.bucket, "", "", nil, createdAt)
 
 			if c.wantErr != nil {
 				if err == nil {
@@ -135,7 +138,7 @@ func TestBucketStore_List(t *testing.T) {
 			} else {
 				for _, seed := range c.seeds {
 					ts := seed.at.UTC().Truncate(time.Microsecond)
-					if _, err := s.Create(ctx, seed.id, seed.name, ts); err != nil {
+					if _, err := s.Create(ctx, seed.id, seed.name, "", "", nil, ts); err != nil {
 						t.Fatalf("seed create %q: %v", seed.name, err)
 					}
 				}
@@ -196,7 +199,7 @@ func TestBucketStore_GetByName(t *testing.T) {
 			bucket: "existing-bucket",
 			setup: func(ctx context.Context, t *testing.T, s store.BucketStore, createdAt time.Time) {
 				t.Helper()
-				if _, err := s.Create(ctx, "bucket-id-123", "existing-bucket", createdAt); err != nil {
+				if _, err := s.Create(ctx, "bucket-id-123", "existing-bucket", "", "", nil, createdAt); err != nil {
 					t.Fatalf("seed create: %v", err)
 				}
 			},
@@ -249,6 +252,291 @@ func TestBucketStore_GetByName(t *testing.T) {
 	}
 }
 
+func TestBucketStore_Owner(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	createTestUser(t, db, "user-alice", "alice", now)
+	s := store.NewBucketStore(db)
+
+	if _, err := s.Create(ctx, "bucket-owned", "owned-bucket", "user-alice", "", nil, now); err != nil {
+		t.Fatalf("Create owned: unexpected error: %v", err)
+	}
+	if _, err := s.Create(ctx, "bucket-anon", "anonymous-bucket", "", "", nil, now.Add(time.Minute)); err != nil {
+		t.Fatalf("Create anonymous: unexpected error: %v", err)
+	}
+	if _, err := s.Create(ctx, "bucket-bad", "orphan-bucket", "user-missing", "", nil, now); err == nil {
+		t.Fatal("Create with unknown owner: expected error")
+	}
+
+	for name, want := range map[string]string{"owned-bucket": "user-alice", "anonymous-bucket": ""} {
+		rec, err := s.GetByName(ctx, name)
+		if err != nil {
+			t.Fatalf("GetByName %s: unexpected error: %v", name, err)
+		}
+		if rec.OwnerID != want {
+			t.Fatalf("GetByName %s owner: got %q, want %q", name, rec.OwnerID, want)
+		}
+	}
+
+	// An anonymous bucket has no owner at all rather than an empty one, so
+	// nothing can match it as owner.
+	var ownerless bool
+	if err := db.QueryRowContext(ctx, `SELECT owner_id IS NULL FROM buckets WHERE id = 'bucket-anon'`).Scan(&ownerless); err != nil {
+		t.Fatalf("read anonymous owner: %v", err)
+	}
+	if !ownerless {
+		t.Fatal("anonymous bucket owner_id: got non-NULL, want NULL")
+	}
+
+	records, err := s.List(ctx)
+	if err != nil {
+		t.Fatalf("List: unexpected error: %v", err)
+	}
+	if len(records) != 2 || records[0].OwnerID != "user-alice" || records[1].OwnerID != "" {
+		t.Fatalf("List owners: got %+v", records)
+	}
+}
+
+func TestBucketStore_AdoptUnowned(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	createTestUser(t, db, "user-alice", "alice", now)
+	createTestUser(t, db, "user-admin", "admin", now)
+	s := store.NewBucketStore(db)
+
+	if _, err := s.Create(ctx, "bucket-owned", "owned-bucket", "user-alice", "", nil, now); err != nil {
+		t.Fatalf("Create owned: unexpected error: %v", err)
+	}
+	for _, name := range []string{"legacy-one", "legacy-two"} {
+		if _, err := s.Create(ctx, "bucket-"+name, name, "", "", nil, now); err != nil {
+			t.Fatalf("Create %s: unexpected error: %v", name, err)
+		}
+	}
+
+	later := now.Add(time.Hour)
+	adopted, err := s.AdoptUnowned(ctx, "user-admin", later)
+	if err != nil {
+		t.Fatalf("AdoptUnowned: unexpected error: %v", err)
+	}
+	if adopted != 2 {
+		t.Fatalf("AdoptUnowned: got %d, want 2", adopted)
+	}
+
+	for name, want := range map[string]string{"owned-bucket": "user-alice", "legacy-one": "user-admin", "legacy-two": "user-admin"} {
+		rec, err := s.GetByName(ctx, name)
+		if err != nil {
+			t.Fatalf("GetByName %s: unexpected error: %v", name, err)
+		}
+		if rec.OwnerID != want {
+			t.Fatalf("GetByName %s owner: got %q, want %q", name, rec.OwnerID, want)
+		}
+	}
+
+	adopted, err = s.AdoptUnowned(ctx, "user-admin", later)
+	if err != nil {
+		t.Fatalf("second AdoptUnowned: unexpected error: %v", err)
+	}
+	if adopted != 0 {
+		t.Fatalf("second AdoptUnowned: got %d, want 0", adopted)
+	}
+}
+
+func TestBucketStore_Delete(t *testing.T) {
+	t.Parallel()
+
+	type object struct {
+		id    string
+		state string
+	}
+
+	type upload struct {
+		id    string
+		state string
+		parts []string
+	}
+
+	type tc struct {
+		name       string
+		bucketID   string
+		force      bool
+		objects    []object
+		uploads    []upload
+		wantQueued []string
+		wantErr    error
+	}
+
+	cases := []tc{
+		{
+			name:     "empty bucket is removed",
+			bucketID: "bucket-id-delete",
+		},
+		{
+			name:     "retired blobs are queued and rows removed",
+			bucketID: "bucket-id-delete",
+			objects: []object{
+				{id: "object-id-replaced", state: "REPLACED"},
+				{id: "object-id-failed", state: "FAILED"},
+				{id: "object-id-deleted", state: "DELETED"},
+			},
+			wantQueued: []string{"object-id-failed", "object-id-replaced"},
+		},
+		{
+			name:     "COMMITTED object returns ErrBucketNotEmpty",
+			bucketID: "bucket-id-delete",
+			objects: []object{
+				{id: "object-id-committed", state: "COMMITTED"},
+			},
+			wantErr: store.ErrBucketNotEmpty,
+		},
+		{
+			name:     "PENDING upload returns ErrBucketNotEmpty",
+			bucketID: "bucket-id-delete",
+			objects: []object{
+				{id: "object-id-pending", state: "PENDING"},
+			},
+			wantErr: store.ErrBucketNotEmpty,
+		},
+		{
+			name:     "IN_PROGRESS multipart upload returns ErrBucketNotEmpty",
+			bucketID: "bucket-id-delete",
+			uploads: []upload{
+				{id: "upload-id-open", state: "IN_PROGRESS", parts: []string{"blob-id-part"}},
+			},
+			wantErr: store.ErrBucketNotEmpty,
+		},
+		{
+			name:     "finished uploads are removed",
+			bucketID: "bucket-id-delete",
+			uploads: []upload{
+				{id: "upload-id-aborted", state: "ABORTED"},
+			},
+		},
+		{
+			name:     "force queues part blobs of open uploads",
+			bucketID: "bucket-id-delete",
+			force:    true,
+			uploads: []upload{
+				{id: "upload-id-open", state: "IN_PROGRESS", parts: []string{"blob-id-part-1", "blob-id-part-2"}},
+			},
+			wantQueued: []string{"blob-id-part-1", "blob-id-part-2"},
+		},
+		{
+			name:     "force queues live objects",
+			bucketID: "bucket-id-delete",
+			force:    true,
+			objects: []object{
+				{id: "object-id-committed", state: "COMMITTED"},
+				{id: "object-id-pending", state: "PENDING"},
+				{id: "object-id-replaced", state: "REPLACED"},
+			},
+			wantQueued: []string{"object-id-committed", "object-id-pending", "object-id-replaced"},
+		},
+		{
+			name:     "missing bucket returns ErrBucketNotFound",
+			bucketID: "bucket-id-missing",
+			wantErr:  store.ErrBucketNotFound,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			s := store.NewBucketStore(db)
+			createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+			setupPrerequisites(ctx, t, db, "bucket-id-delete", "cradle-id-delete", createdAt, false, false)
+			for i, obj := range c.objects {
+				insertObjectWithState(ctx, t, db, obj.id, "bucket-id-delete", fmt.Sprintf("key-%d", i), obj.state, "cradle-id-delete", createdAt)
+				if _, err := db.ExecContext(ctx, `INSERT INTO object_metadata (object_id, name, value) VALUES (?, 'x-amz-meta-color', 'blue')`, obj.id); err != nil {
+					t.Fatalf("insert object metadata: %v", err)
+				}
+			}
+			for i, up := range c.uploads {
+				insertUpload(ctx, t, db, up.id, "bucket-id-delete", fmt.Sprintf("upload-key-%d", i), up.state, createdAt)
+				for n, blobID := range up.parts {
+					insertUploadedPart(ctx, t, db, blobID, up.id, int32(n+1), "cradle-id-delete", createdAt)
+				}
+			}
+
+			queued, err := s.Delete(ctx, c.bucketID, c.force, createdAt.Add(time.Minute))
+
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("Delete error: got %v want %v", err, c.wantErr)
+				}
+				if _, err := s.GetByName(ctx, "test-bucket"); err != nil {
+					t.Fatalf("bucket should remain after failed delete: %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Delete: unexpected error: %v", err)
+			}
+
+			if queued != int64(len(c.wantQueued)) {
+				t.Fatalf("queued: got %d want %d", queued, len(c.wantQueued))
+			}
+
+			if _, err := s.GetByName(ctx, "test-bucket"); !errors.Is(err, store.ErrBucketNotFound) {
+				t.Fatalf("GetByName after delete: got %v want %v", err, store.ErrBucketNotFound)
+			}
+
+			var remaining int
+			if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM objects WHERE bucket_id = ?`, c.bucketID).Scan(&remaining); err != nil {
+				t.Fatalf("count objects: %v", err)
+			}
+			if remaining != 0 {
+				t.Fatalf("objects remaining: got %d want 0", remaining)
+			}
+
+			if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM object_metadata`).Scan(&remaining); err != nil {
+				t.Fatalf("count object metadata: %v", err)
+			}
+			if remaining != 0 {
+				t.Fatalf("object metadata remaining: got %d want 0", remaining)
+			}
+
+			if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM multipart_uploads WHERE bucket_id = ?`, c.bucketID).Scan(&remaining); err != nil {
+				t.Fatalf("count uploads: %v", err)
+			}
+			if remaining != 0 {
+				t.Fatalf("uploads remaining: got %d want 0", remaining)
+			}
+
+			rows, err := db.QueryContext(ctx, `SELECT object_id, bucket_name FROM blob_deletions ORDER BY object_id`)
+			if err != nil {
+				t.Fatalf("query blob_deletions: %v", err)
+			}
+			defer rows.Close()
+
+			var gotQueued []string
+			for rows.Next() {
+				var id, bucketName string
+				if err := rows.Scan(&id, &bucketName); err != nil {
+					t.Fatalf("scan blob_deletions: %v", err)
+				}
+				if bucketName != "test-bucket" {
+					t.Fatalf("queued bucket_name: got %q want %q", bucketName, "test-bucket")
+				}
+				gotQueued = append(gotQueued, id)
+			}
+			if !slices.Equal(gotQueued, c.wantQueued) {
+				t.Fatalf("queued blobs: got %v want %v", gotQueued, c.wantQueued)
+			}
+		})
+	}
+}
+
 func assertBucketRecord(t *testing.T, ctx context.Context, db *sql.DB, rec store.BucketRecord, wantID string, expectedStamp time.Time) {
 	t.Helper()
 
@@ -309,3 +597,240 @@ func assertBucketRecord(t *testing.T, ctx context.Context, db *sql.DB, rec store
 		t.Fatalf("stored timestamps mismatch: got created=%s updated=%s want %s", createdAt, updatedAt, wantStamp)
 	}
 }
+
+func TestBucketStore_AccessControl(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	s := store.NewBucketStore(db)
+
+	rec, err := s.Create(ctx, "bucket-default", "default-bucket", "", "", nil, now)
+	if err != nil {
+		t.Fatalf("Create default: unexpected error: %v", err)
+	}
+	if rec.ACL != store.BucketACLPrivate {
+		t.Fatalf("Create default acl: got %q, want %q", rec.ACL, store.BucketACLPrivate)
+	}
+
+	if _, err := s.Create(ctx, "bucket-public", "public-bucket", "", store.BucketACLPublicRead, nil, now); err != nil {
+		t.Fatalf("Create public: unexpected error: %v", err)
+	}
+	if _, err := s.Create(ctx, "bucket-bad", "bad-acl-bucket", "", "everyone", nil, now); err == nil {
+		t.Fatal("Create with unknown acl: expected error")
+	}
+
+	const doc = `{"Version":"2012-10-17","Statement":[]}`
+	later := now.Add(time.Minute)
+
+	if err := s.SetACL(ctx, "bucket-default", store.BucketACLAuthenticatedRead, later); err != nil {
+		t.Fatalf("SetACL: unexpected error: %v", err)
+	}
+	if err := s.SetPolicy(ctx, "bucket-default", doc, later); err != nil {
+		t.Fatalf("SetPolicy: unexpected error: %v", err)
+	}
+
+	got, err := s.GetByName(ctx, "default-bucket")
+	if err != nil {
+		t.Fatalf("GetByName: unexpected error: %v", err)
+	}
+	if got.ACL != store.BucketACLAuthenticatedRead || got.Policy != doc || !got.UpdatedAt.Equal(later) {
+		t.Fatalf("GetByName after set: got acl %q policy %q updated %v", got.ACL, got.Policy, got.UpdatedAt)
+	}
+
+	if err := s.SetPolicy(ctx, "bucket-default", "", later); err != nil {
+		t.Fatalf("SetPolicy clear: unexpected error: %v", err)
+	}
+	got, err = s.GetByName(ctx, "default-bucket")
+	if err != nil {
+		t.Fatalf("GetByName: unexpected error: %v", err)
+	}
+	if got.Policy != "" {
+		t.Fatalf("Policy after clear: got %q, want empty", got.Policy)
+	}
+
+	public, err := s.GetByName(ctx, "public-bucket")
+	if err != nil {
+		t.Fatalf("GetByName public: unexpected error: %v", err)
+	}
+	if public.ACL != store.BucketACLPublicRead {
+		t.Fatalf("public acl: got %q, want %q", public.ACL, store.BucketACLPublicRead)
+	}
+
+	if err := s.SetACL(ctx, "missing", store.BucketACLPrivate, later); !errors.Is(err, store.ErrBucketNotFound) {
+		t.Fatalf("SetACL missing: got %v, want %v", err, store.ErrBucketNotFound)
+	}
+	if err := s.SetPolicy(ctx, "missing", doc, later); !errors.Is(err, store.ErrBucketNotFound) {
+		t.Fatalf("SetPolicy missing: got %v, want %v", err, store.ErrBucketNotFound)
+	}
+}
+
+func TestBucketStore_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	s := store.NewBucketStore(db)
+
+	if _, err := s.Create(ctx, "bucket-lifecycle", "lifecycle-bucket", "", "", nil, now); err != nil {
+		t.Fatalf("Create: unexpected error: %v", err)
+	}
+
+	rules := []store.LifecycleRule{
+		{ID: "footage", Enabled: true, Prefix: "footage/", ExpirationDays: 30},
+		{
+			ID:                      "tagged",
+			Tags:                    map[string]string{"retain": "short"},
+			ExpirationDate:          time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+			NoncurrentDays:          7,
+			NewerNoncurrentVersions: 2,
+		},
+	}
+	if err := s.SetLifecycle(ctx, "bucket-lifecycle", rules, now); err != nil {
+		t.Fatalf("SetLifecycle: unexpected error: %v", err)
+	}
+
+	got, err := s.GetByName(ctx, "lifecycle-bucket")
+	if err != nil {
+		t.Fatalf("GetByName: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got.Lifecycle, rules) {
+		t.Fatalf("Lifecycle: got %+v, want %+v", got.Lifecycle, rules)
+	}
+
+	if err := s.SetLifecycle(ctx, "bucket-lifecycle", nil, now); err != nil {
+		t.Fatalf("SetLifecycle clear: unexpected error: %v", err)
+	}
+	got, err = s.GetByName(ctx, "lifecycle-bucket")
+	if err != nil {
+		t.Fatalf("GetByName: unexpected error: %v", err)
+	}
+	if got.Lifecycle != nil {
+		t.Fatalf("Lifecycle after clear: got %+v, want none", got.Lifecycle)
+	}
+
+	if err := s.SetLifecycle(ctx, "missing", rules, now); !errors.Is(err, store.ErrBucketNotFound) {
+		t.Fatalf("SetLifecycle missing: got %v, want %v", err, store.ErrBucketNotFound)
+	}
+}
+
+func TestBucketStore_CORS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	s := store.NewBucketStore(db)
+
+	if _, err := s.Create(ctx, "bucket-cors", "cors-bucket", "", "", nil, now); err != nil {
+		t.Fatalf("Create: unexpected error: %v", err)
+	}
+
+	rules := []store.CORSRule{
+		{ID: "gallery", AllowedOrigins: []string{"https://gallery.example.com"}, AllowedMethods: []string{"GET", "HEAD"}, AllowedHeaders: []string{"*"}, ExposeHeaders: []string{"ETag"}, MaxAgeSeconds: 3000},
+		{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}},
+	}
+	if err := s.SetCORS(ctx, "bucket-cors", rules, now); err != nil {
+		t.Fatalf("SetCORS: unexpected error: %v", err)
+	}
+
+	got, err := s.GetByName(ctx, "cors-bucket")
+	if err != nil {
+		t.Fatalf("GetByName: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got.CORS, rules) {
+		t.Fatalf("CORS: got %+v, want %+v", got.CORS, rules)
+	}
+
+	if err := s.SetCORS(ctx, "bucket-cors", nil, now); err != nil {
+		t.Fatalf("SetCORS clear: unexpected error: %v", err)
+	}
+	got, err = s.GetByName(ctx, "cors-bucket")
+	if err != nil {
+		t.Fatalf("GetByName: unexpected error: %v", err)
+	}
+	if got.CORS != nil {
+		t.Fatalf("CORS after clear: got %+v, want none", got.CORS)
+	}
+
+	if err := s.SetCORS(ctx, "missing", rules, now); !errors.Is(err, store.ErrBucketNotFound) {
+		t.Fatalf("SetCORS missing: got %v, want %v", err, store.ErrBucketNotFound)
+	}
+}
+
+func TestBucketStore_ObjectLock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	s := store.NewBucketStore(db)
+
+	if _, err := s.Create(ctx, "bucket-lock", "lock-bucket", "", "", nil, now); err != nil {
+		t.Fatalf("Create: unexpected error: %v", err)
+	}
+	got, err := s.GetByName(ctx, "lock-bucket")
+	if err != nil {
+		t.Fatalf("GetByName: unexpected error: %v", err)
+	}
+	if got.ObjectLock != nil {
+		t.Fatalf("ObjectLock before enabling: got %+v, want nil", got.ObjectLock)
+	}
+
+	lock := store.ObjectLockConfig{DefaultMode: store.RetentionCompliance, DefaultYears: 1}
+	if err := s.SetObjectLock(ctx, "bucket-lock", lock, now); err != nil {
+		t.Fatalf("SetObjectLock: unexpected error: %v", err)
+	}
+	got, err = s.GetByName(ctx, "lock-bucket")
+	if err != nil {
+		t.Fatalf("GetByName: unexpected error: %v", err)
+	}
+	if got.ObjectLock == nil || *got.ObjectLock != lock {
+		t.Fatalf("ObjectLock: got %+v, want %+v", got.ObjectLock, lock)
+	}
+	if got.Versioning != store.VersioningEnabled {
+		t.Fatalf("Versioning: got %q, want %q", got.Versioning, store.VersioningEnabled)
+	}
+	if want := now.AddDate(1, 0, 0); !lock.DefaultRetainUntil(now).Equal(want) {
+		t.Fatalf("DefaultRetainUntil: got %s, want %s", lock.DefaultRetainUntil(now), want)
+	}
+
+	if err := s.SetObjectLock(ctx, "missing", lock, now); !errors.Is(err, store.ErrBucketNotFound) {
+		t.Fatalf("SetObjectLock missing: got %v, want %v", err, store.ErrBucketNotFound)
+	}
+
+	created, err := s.Create(ctx, "bucket-locked", "locked-bucket", "", "", &store.ObjectLockConfig{}, now)
+	if err != nil {
+		t.Fatalf("Create with lock: unexpected error: %v", err)
+	}
+	got, err = s.GetByName(ctx, "locked-bucket")
+	if err != nil {
+		t.Fatalf("GetByName: unexpected error: %v", err)
+	}
+	if got.ObjectLock == nil || *got.ObjectLock != (store.ObjectLockConfig{}) || got.Versioning != store.VersioningEnabled {
+		t.Fatalf("Create with lock: got lock %+v versioning %q, want lock enabled and versioning %q", got.ObjectLock, got.Versioning, store.VersioningEnabled)
+	}
+	if created.ObjectLock == nil || created.Versioning != store.VersioningEnabled {
+		t.Fatalf("Create with lock returned %+v", created)
+	}
+
+	if _, err := store.NewCradleServerStore(db).Upsert(ctx, "cradle-lock", "127.0.0.1:9444", now); err != nil {
+		t.Fatalf("Upsert cradle server: %v", err)
+	}
+	objects := store.NewObjectStore(db)
+	if _, err := objects.CreatePending(ctx, "object-lock", "bucket-lock", "backups/db.tar", 1024, "", nil, nil, store.ObjectLock{}, "cradle-lock", now); err != nil {
+		t.Fatalf("CreatePending: %v", err)
+	}
+	if _, err := objects.CommitWithReplace(ctx, "object-lock", store.ObjectCommit{SizeActual: 1024, LastModifiedMs: now.UnixMilli()}, now); err != nil {
+		t.Fatalf("CommitWithReplace: %v", err)
+	}
+
+	if _, err := s.Delete(ctx, "bucket-lock", true, now.AddDate(0, 6, 0)); !errors.Is(err, store.ErrObjectLocked) {
+		t.Fatalf("force Delete while retained: got %v, want %v", err, store.ErrObjectLocked)
+	}
+	if _, err := s.Delete(ctx, "bucket-lock", true, now.AddDate(2, 0, 0)); err != nil {
+		t.Fatalf("force Delete after retention: unexpected error: %v", err)
+	}
+}
@@ -8,7 +8,10 @@ import (
 	"time"
 )
 
-var ErrNoCradleServersAvailable = errors.New("no cradle servers available")
+var (
+	ErrNoCradleServersAvailable = errors.New("no cradle servers available")
+	ErrCradleServerNotFound     = errors.New("cradle server not found")
+)
 
 type cradleServerStore struct {
 	db *sql.DB
@@ -106,3 +109,27 @@ func (s *cradleServerStore) SelectForUpload(ctx context.Context) (CradleServerRe
 
 	return rec, nil
 }
+
+func (s *cradleServerStore) GetByID(ctx context.Context, id string) (CradleServerRecord, error) {
+	const selectCradleServer = `SELECT id, address, created_at, updated_at FROM cradle_servers WHERE id = ?`
+
+	row := s.db.QueryRowContext(ctx, selectCradleServer, id)
+
+	var (
+		rec       CradleServerRecord
+		createdAt int64
+		updatedAt int64
+	)
+
+	if err := row.Scan(&rec.ID, &rec.Address, &createdAt, &updatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return CradleServerRecord{}, ErrCradleServerNotFound
+		}
+		return CradleServerRecord{}, fmt.Errorf("get cradle server: %w", err)
+	}
+
+	rec.CreatedAt = time.UnixMicro(createdAt).UTC()
+	rec.UpdatedAt = time.UnixMicro(updatedAt).UTC()
+
+	return rec, nil
+}
@@ -237,6 +237,62 @@ func TestCradleServerStore_All(t *testing.T) {
 	}
 }
 
+func TestCradleServerStore_GetByID(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name    string
+		id      string
+		wantErr error
+	}
+
+	cases := []tc{
+		{
+			name: "returns registered server",
+			id:   "cradle-1",
+		},
+		{
+			name:    "unknown id returns ErrCradleServerNotFound",
+			id:      "cradle-missing",
+			wantErr: store.ErrCradleServerNotFound,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			s := store.NewCradleServerStore(db)
+			now := time.Now().UTC()
+
+			if _, err := s.Upsert(ctx, "cradle-1", "127.0.0.1:9001", now); err != nil {
+				t.Fatalf("seed upsert: %v", err)
+			}
+
+			rec, err := s.GetByID(ctx, c.id)
+
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("GetByID error: got %v, want %v", err, c.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("GetByID: unexpected error: %v", err)
+			}
+			if rec.ID != c.id {
+				t.Fatalf("ID: got %q, want %q", rec.ID, c.id)
+			}
+			if rec.Address != "127.0.0.1:9001" {
+				t.Fatalf("Address: got %q, want %q", rec.Address, "127.0.0.1:9001")
+			}
+		})
+	}
+}
+
 func assertCradleServerRow(t *testing.T, ctx context.Context, db *sql.DB, address string, wantID string, wantCreated time.Time, wantUpdated time.Time) {
 	t.Helper()
 
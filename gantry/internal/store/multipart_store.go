@@ -0,0 +1,581 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrUploadNotFound      = errors.New("multipart upload not found")
+	ErrUploadNotInProgress = errors.New("multipart upload not in IN_PROGRESS state")
+	ErrPartNotPending      = errors.New("part not found or not in PENDING state")
+	ErrPartNotUploaded     = errors.New("part not found or not in UPLOADED state")
+)
+
+type multipartStore struct {
+	db *sql.DB
+}
+
+func NewMultipartStore(db *sql.DB) MultipartStore {
+	return &multipartStore{db: db}
+}
+
+type MultipartUploadRecord struct {
+	ID          string
+	BucketID    string
+	Key         string
+	State       string
+	ContentType string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type PartRecord struct {
+	BlobID         string
+	UploadID       string
+	PartNumber     int32
+	State          string
+	SizeExpected   int64
+	SizeActual     int64
+	LastModifiedMs int64
+	CradleServerID string
+	CradleAddress  string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	// ETag is the hex MD5 of the part, or its blob ID for parts uploaded
+	// before gantry stored digests.
+	ETag string
+}
+
+// CompletedUpload is the object a multipart upload becomes: the parts it is
+// assembled from, in order, and the totals gantry computed for them.
+type CompletedUpload struct {
+	BlobIDs        []string
+	Size           int64
+	ETag           string
+	LastModifiedMs int64
+	CradleServerID string
+}
+
+// Create records an IN_PROGRESS upload with the Content-Type and the other
+// object_metadata rows its object is given on completion.
+func (s *multipartStore) Create(ctx context.Context, id, bucketID, key, contentType string, metadata map[string]string, createdAt time.Time) (MultipartUploadRecord, error) {
+	stamp := createdAt.UTC().Truncate(time.Microsecond)
+	micros := stamp.UnixMicro()
+
+	const insertUpload = `
+INSERT INTO multipart_uploads (upload_id, bucket_id, key, state, content_type, created_at, updated_at)
+VALUES (?, ?, ?, ?, NULLIF(?, ''), ?, ?)
+`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return MultipartUploadRecord{}, fmt.Errorf("insert multipart upload, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, insertUpload, id, bucketID, key, "IN_PROGRESS", contentType, micros, micros); err != nil {
+		return MultipartUploadRecord{}, fmt.Errorf("insert multipart upload: %w", err)
+	}
+
+	for name, value := range metadata {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO upload_metadata (upload_id, name, value) VALUES (?, ?, ?)`, id, name, value); err != nil {
+			return MultipartUploadRecord{}, fmt.Errorf("insert upload metadata: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return MultipartUploadRecord{}, fmt.Errorf("insert multipart upload, commit: %w", err)
+	}
+
+	return MultipartUploadRecord{
+		ID:          id,
+		BucketID:    bucketID,
+		Key:         key,
+		State:       "IN_PROGRESS",
+		ContentType: contentType,
+		CreatedAt:   stamp,
+		UpdatedAt:   stamp,
+	}, nil
+}
+
+func (s *multipartStore) Get(ctx context.Context, id string) (MultipartUploadRecord, error) {
+	const selectUpload = `
+SELECT upload_id, bucket_id, key, state, COALESCE(content_type, ''), created_at, updated_at
+FROM multipart_uploads
+WHERE upload_id = ?
+`
+
+	var (
+		rec       MultipartUploadRecord
+		createdAt int64
+		updatedAt int64
+	)
+
+	err := s.db.QueryRowContext(ctx, selectUpload, id).Scan(&rec.ID, &rec.BucketID, &rec.Key, &rec.State,
+		&rec.ContentType, &createdAt, &updatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return MultipartUploadRecord{}, ErrUploadNotFound
+		}
+		return MultipartUploadRecord{}, fmt.Errorf("get multipart upload: %w", err)
+	}
+
+	rec.CreatedAt = time.UnixMicro(createdAt).UTC()
+	rec.UpdatedAt = time.UnixMicro(updatedAt).UTC()
+
+	return rec, nil
+}
+
+func (s *multipartStore) CreatePendingPart(ctx context.Context, blobID, uploadID string, partNumber int32, sizeExpected int64, cradleServerID string, createdAt time.Time) (PartRecord, error) {
+	stamp := createdAt.UTC().Truncate(time.Microsecond)
+	micros := stamp.UnixMicro()
+
+	const insertPart = `
+INSERT INTO parts (blob_id, upload_id, part_number, state, size_expected, cradle_server_id, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+	_, err := s.db.ExecContext(ctx, insertPart, blobID, uploadID, partNumber, "PENDING", sizeExpected, cradleServerID, micros, micros)
+	if err != nil {
+		return PartRecord{}, fmt.Errorf("insert part: %w", err)
+	}
+
+	return PartRecord{
+		BlobID:         blobID,
+		UploadID:       uploadID,
+		PartNumber:     partNumber,
+		State:          "PENDING",
+		SizeExpected:   sizeExpected,
+		CradleServerID: cradleServerID,
+		CreatedAt:      stamp,
+		UpdatedAt:      stamp,
+	}, nil
+}
+
+// CommitPart moves a PENDING part to UPLOADED, queueing the blob of any part
+// it replaces for deletion. If the upload completed or was aborted while the
+// part was being written, the new blob is queued instead and
+// ErrUploadNotInProgress is returned.
+func (s *multipartStore) CommitPart(ctx context.Context, blobID string, sizeActual int64, lastModifiedMs int64, etag string, updatedAt time.Time) error {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("commit part, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	var (
+		uploadID    string
+		partNumber  int32
+		uploadState string
+	)
+	err = tx.QueryRowContext(ctx, `
+		SELECT p.upload_id, p.part_number, u.state
+		FROM parts p
+		JOIN multipart_uploads u ON u.upload_id = p.upload_id
+		WHERE p.blob_id = ? AND p.state = 'PENDING'
+	`, blobID).Scan(&uploadID, &partNumber, &uploadState)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("commit part: %w", ErrPartNotPending)
+		}
+		return fmt.Errorf("commit part: %w", err)
+	}
+
+	if uploadState != "IN_PROGRESS" {
+		if _, err := queuePartBlobs(ctx, tx, `p.blob_id = ?`, micros, blobID); err != nil {
+			return fmt.Errorf("commit part, queue orphan: %w", err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("commit part, commit: %w", err)
+		}
+		return fmt.Errorf("commit part: %w", ErrUploadNotInProgress)
+	}
+
+	if _, err := queuePartBlobs(ctx, tx, `p.upload_id = ? AND p.part_number = ? AND p.state = 'UPLOADED'`,
+		micros, uploadID, partNumber); err != nil {
+		return fmt.Errorf("commit part, replace previous: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE parts
+		SET state = 'UPLOADED',
+		    size_actual = ?,
+		    last_modified = ?,
+		    etag = NULLIF(?, ''),
+		    updated_at = ?
+		WHERE blob_id = ?
+		  AND state = 'PENDING'
+	`, sizeActual, lastModifiedMs, etag, micros, blobID)
+	if err != nil {
+		return fmt.Errorf("commit part: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("commit part, rows affected: %w", err)
+	}
+
+	if rows != 1 {
+		return fmt.Errorf("commit part: %w", ErrPartNotPending)
+	}
+
+	return tx.Commit()
+}
+
+// ListUploadedParts returns the UPLOADED parts of an upload in part-number
+// order along with the address of the cradle holding each one. A completed
+// upload's parts are the parts of the object sharing its ID.
+func (s *multipartStore) ListUploadedParts(ctx context.Context, uploadID string) ([]PartRecord, error) {
+	const selectParts = `
+SELECT p.blob_id, p.upload_id, p.part_number, p.state, p.size_expected, p.size_actual, p.last_modified,
+       p.cradle_server_id, c.address, COALESCE(p.etag, p.blob_id), p.created_at, p.updated_at
+FROM parts p
+JOIN cradle_servers c ON c.id = p.cradle_server_id
+WHERE p.upload_id = ? AND p.state = 'UPLOADED'
+ORDER BY p.part_number
+`
+
+	rows, err := s.db.QueryContext(ctx, selectParts, uploadID)
+	if err != nil {
+		return nil, fmt.Errorf("list parts: %w", err)
+	}
+	defer rows.Close()
+
+	parts := make([]PartRecord, 0)
+	for rows.Next() {
+		var (
+			rec       PartRecord
+			createdAt int64
+			updatedAt int64
+		)
+		if err := rows.Scan(&rec.BlobID, &rec.UploadID, &rec.PartNumber, &rec.State, &rec.SizeExpected, &rec.SizeActual,
+			&rec.LastModifiedMs, &rec.CradleServerID, &rec.CradleAddress, &rec.ETag, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("scan part: %w", err)
+		}
+		rec.CreatedAt = time.UnixMicro(createdAt).UTC()
+		rec.UpdatedAt = time.UnixMicro(updatedAt).UTC()
+		parts = append(parts, rec)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate parts: %w", err)
+	}
+
+	return parts, nil
+}
+
+// Complete turns an IN_PROGRESS upload into the COMMITTED version of its key
+// in one transaction and returns its version ID. The previous version is
+// superseded as for a PUT, uploaded parts not listed in obj are queued for
+// deletion, and the upload becomes COMPLETED. The new version gets the
+// metadata the upload was created with and the bucket's default retention.
+// If the previous version can't be retired because of an object lock,
+// nothing changes and ErrObjectLocked is returned. If any listed part is no
+// longer UPLOADED, for example because it was replaced by a concurrent
+// re-upload, nothing changes and ErrPartNotUploaded is returned.
+func (s *multipartStore) Complete(ctx context.Context, uploadID string, obj CompletedUpload, updatedAt time.Time) (string, error) {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("complete upload, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	upload, err := lockUpload(ctx, tx, uploadID)
+	if err != nil {
+		return "", fmt.Errorf("complete upload: %w", err)
+	}
+
+	for _, blobID := range obj.BlobIDs {
+		var uploaded bool
+		err := tx.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM parts WHERE blob_id = ? AND upload_id = ? AND state = 'UPLOADED'
+			)
+		`, blobID, uploadID).Scan(&uploaded)
+		if err != nil {
+			return "", fmt.Errorf("complete upload, check part: %w", err)
+		}
+		if !uploaded {
+			return "", fmt.Errorf("complete upload: %w", ErrPartNotUploaded)
+		}
+	}
+
+	listed := make(map[string]bool, len(obj.BlobIDs))
+	for _, blobID := range obj.BlobIDs {
+		listed[blobID] = true
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT blob_id FROM parts WHERE upload_id = ? AND state = 'UPLOADED'`, uploadID)
+	if err != nil {
+		return "", fmt.Errorf("complete upload, list parts: %w", err)
+	}
+	var unlisted []string
+	for rows.Next() {
+		var blobID string
+		if err := rows.Scan(&blobID); err != nil {
+			rows.Close()
+			return "", fmt.Errorf("complete upload, scan part: %w", err)
+		}
+		if !listed[blobID] {
+			unlisted = append(unlisted, blobID)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("complete upload, iterate parts: %w", err)
+	}
+
+	for _, blobID := range unlisted {
+		if _, err := queuePartBlobs(ctx, tx, `p.blob_id = ?`, micros, blobID); err != nil {
+			return "", fmt.Errorf("complete upload, drop unlisted part: %w", err)
+		}
+	}
+
+	versioning, err := bucketVersioning(ctx, tx, upload.BucketID)
+	if err != nil {
+		return "", fmt.Errorf("complete upload: %w", err)
+	}
+
+	versionID, err := supersede(ctx, tx, versioning, upload.BucketID, upload.Key, uploadID, micros)
+	if err != nil {
+		return "", fmt.Errorf("complete upload, replace previous: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO objects (object_id, bucket_id, key, state, size_expected, size_actual, last_modified,
+		                     content_type, cradle_server_id, etag, part_count, version_id, committed_at, created_at, updated_at)
+		VALUES (?, ?, ?, 'COMMITTED', ?, ?, ?, NULLIF(?, ''), ?, ?, ?, ?, ?, ?, ?)
+	`, uploadID, upload.BucketID, upload.Key, obj.Size, obj.Size, obj.LastModifiedMs,
+		upload.ContentType, obj.CradleServerID, obj.ETag, len(obj.BlobIDs), versionColumn(versionID), micros, micros, micros)
+	if err != nil {
+		return "", fmt.Errorf("complete upload, insert object: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO object_metadata (object_id, name, value)
+		SELECT upload_id, name, value FROM upload_metadata WHERE upload_id = ?
+	`, uploadID); err != nil {
+		return "", fmt.Errorf("complete upload, copy metadata: %w", err)
+	}
+
+	if err := defaultRetention(ctx, tx, upload.BucketID, uploadID, micros); err != nil {
+		return "", fmt.Errorf("complete upload: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE multipart_uploads
+		SET state = 'COMPLETED',
+		    updated_at = ?
+		WHERE upload_id = ?
+	`, micros, uploadID); err != nil {
+		return "", fmt.Errorf("complete upload: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("complete upload, commit: %w", err)
+	}
+
+	return versionID, nil
+}
+
+// Abort marks an IN_PROGRESS upload ABORTED and queues its uploaded part
+// blobs for deletion. Parts still being written are queued when their
+// CommitPart arrives.
+func (s *multipartStore) Abort(ctx context.Context, uploadID string, updatedAt time.Time) error {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("abort upload, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := lockUpload(ctx, tx, uploadID); err != nil {
+		return fmt.Errorf("abort upload: %w", err)
+	}
+
+	if err := abortUpload(ctx, tx, uploadID, micros); err != nil {
+		return fmt.Errorf("abort upload: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// ListInProgress returns up to limit IN_PROGRESS uploads in a bucket whose
+// keys start with prefix, ordered by key and then upload ID. Upload IDs are
+// ULIDs, so the second ordering is initiation order. Listing resumes after
+// afterKey, or after the upload afterUploadID of afterKey when it is set.
+func (s *multipartStore) ListInProgress(ctx context.Context, bucketID, prefix, afterKey, afterUploadID string, limit int) ([]MultipartUploadRecord, error) {
+	const selectUploads = `
+SELECT upload_id, bucket_id, key, state, COALESCE(content_type, ''), created_at, updated_at
+FROM multipart_uploads
+WHERE bucket_id = ?
+  AND state = 'IN_PROGRESS'
+  AND (key > ? OR (key = ? AND ? <> '' AND upload_id > ?))
+  AND key >= ?
+  AND key < ?
+ORDER BY key, upload_id
+LIMIT ?
+`
+
+	rows, err := s.db.QueryContext(ctx, selectUploads, bucketID, afterKey, afterKey, afterUploadID, afterUploadID,
+		prefix, prefix+KeysetCeiling, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list multipart uploads: %w", err)
+	}
+	defer rows.Close()
+
+	uploads := make([]MultipartUploadRecord, 0)
+	for rows.Next() {
+		var (
+			rec       MultipartUploadRecord
+			createdAt int64
+			updatedAt int64
+		)
+		if err := rows.Scan(&rec.ID, &rec.BucketID, &rec.Key, &rec.State, &rec.ContentType, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("scan multipart upload: %w", err)
+		}
+		rec.CreatedAt = time.UnixMicro(createdAt).UTC()
+		rec.UpdatedAt = time.UnixMicro(updatedAt).UTC()
+		uploads = append(uploads, rec)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate multipart uploads: %w", err)
+	}
+
+	return uploads, nil
+}
+
+// AbortStale aborts up to limit IN_PROGRESS uploads created before
+// createdBefore, oldest first, in one transaction and returns their IDs.
+// Their uploaded parts are queued for deletion just as Abort queues them.
+func (s *multipartStore) AbortStale(ctx context.Context, createdBefore time.Time, limit int, updatedAt time.Time) ([]string, error) {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("abort stale uploads, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT upload_id
+		FROM multipart_uploads
+		WHERE state = 'IN_PROGRESS'
+		  AND created_at < ?
+		ORDER BY created_at
+		LIMIT ?
+	`, createdBefore.UTC().UnixMicro(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("abort stale uploads, list: %w", err)
+	}
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("abort stale uploads, scan: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("abort stale uploads, iterate: %w", err)
+	}
+
+	for _, id := range ids {
+		if err := abortUpload(ctx, tx, id, micros); err != nil {
+			return nil, fmt.Errorf("abort stale upload %s: %w", id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("abort stale uploads, commit: %w", err)
+	}
+
+	return ids, nil
+}
+
+// abortUpload does the work of Abort inside tx without checking the upload's
+// state.
+func abortUpload(ctx context.Context, tx *sql.Tx, uploadID string, micros int64) error {
+	if _, err := queuePartBlobs(ctx, tx, `p.upload_id = ? AND p.state = 'UPLOADED'`, micros, uploadID); err != nil {
+		return fmt.Errorf("queue parts: %w", err)
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		UPDATE multipart_uploads
+		SET state = 'ABORTED',
+		    updated_at = ?
+		WHERE upload_id = ?
+	`, micros, uploadID)
+	return err
+}
+
+// lockUpload reads an upload inside tx, failing unless it is IN_PROGRESS.
+func lockUpload(ctx context.Context, tx *sql.Tx, uploadID string) (MultipartUploadRecord, error) {
+	var rec MultipartUploadRecord
+	err := tx.QueryRowContext(ctx, `
+		SELECT upload_id, bucket_id, key, state, COALESCE(content_type, '')
+		FROM multipart_uploads
+		WHERE upload_id = ?
+	`, uploadID).Scan(&rec.ID, &rec.BucketID, &rec.Key, &rec.State, &rec.ContentType)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return MultipartUploadRecord{}, ErrUploadNotFound
+		}
+		return MultipartUploadRecord{}, err
+	}
+
+	if rec.State != "IN_PROGRESS" {
+		return MultipartUploadRecord{}, ErrUploadNotInProgress
+	}
+
+	return rec, nil
+}
+
+// queuePartBlobs moves the part rows matching where (over parts p joined to
+// multipart_uploads u) into blob_deletions so the cleanup worker removes
+// their blobs. It returns the number of blobs queued.
+func queuePartBlobs(ctx context.Context, tx *sql.Tx, where string, micros int64, args ...any) (int64, error) {
+	queueArgs := append([]any{micros, micros}, args...)
+	result, err := tx.ExecContext(ctx, `
+		INSERT OR IGNORE INTO blob_deletions (object_id, bucket_name, cradle_server_id, created_at, updated_at)
+		SELECT p.blob_id, b.name, p.cradle_server_id, ?, ?
+		FROM parts p
+		JOIN multipart_uploads u ON u.upload_id = p.upload_id
+		JOIN buckets b ON b.id = u.bucket_id
+		WHERE `+where, queueArgs...)
+	if err != nil {
+		return 0, err
+	}
+
+	queued, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM parts
+		WHERE blob_id IN (
+			SELECT p.blob_id
+			FROM parts p
+			JOIN multipart_uploads u ON u.upload_id = p.upload_id
+			WHERE `+where+`
+		)`, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return queued, nil
+}
@@ -0,0 +1,678 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	store "github.com/ratdaddy/blockcloset/gantry/internal/store"
+)
+
+func TestMultipartStore_CreateAndGet(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		contentType string
+		getID       string
+		wantErr     error
+	}
+
+	cases := []tc{
+		{
+			name:        "creates IN_PROGRESS upload",
+			contentType: "video/mp4",
+			getID:       "upload-id-create",
+		},
+		{
+			name:  "empty content type stored as NULL",
+			getID: "upload-id-create",
+		},
+		{
+			name:    "unknown upload returns ErrUploadNotFound",
+			getID:   "upload-id-missing",
+			wantErr: store.ErrUploadNotFound,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			s := store.NewMultipartStore(db)
+			createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+			setupPrerequisites(ctx, t, db, "bucket-id-mpu", "cradle-id-mpu", createdAt, false, false)
+
+			created, err := s.Create(ctx, "upload-id-create", "bucket-id-mpu", "videos/big.mp4", c.contentType, nil, createdAt)
+			if err != nil {
+				t.Fatalf("Create: unexpected error: %v", err)
+			}
+			if created.State != "IN_PROGRESS" {
+				t.Fatalf("Create state: got %q want %q", created.State, "IN_PROGRESS")
+			}
+
+			rec, err := s.Get(ctx, c.getID)
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("Get error: got %v want %v", err, c.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Get: unexpected error: %v", err)
+			}
+
+			if rec != created {
+				t.Fatalf("Get: got %+v want %+v", rec, created)
+			}
+		})
+	}
+}
+
+// partETag is the hex MD5 flatbed reports for a committed part.
+const partETag = "e4d909c290d0fb1ca068ffaddf22cbd0"
+
+func TestMultipartStore_CommitPart(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		uploadState string
+		prior       bool
+		blobID      string
+		wantErr     error
+		wantQueued  []string
+		wantParts   []string
+	}
+
+	cases := []tc{
+		{
+			name:        "transitions PENDING part to UPLOADED",
+			uploadState: "IN_PROGRESS",
+			blobID:      "blob-id-new",
+			wantParts:   []string{"blob-id-new"},
+		},
+		{
+			name:        "re-upload replaces previous part",
+			uploadState: "IN_PROGRESS",
+			prior:       true,
+			blobID:      "blob-id-new",
+			wantQueued:  []string{"blob-id-prior"},
+			wantParts:   []string{"blob-id-new"},
+		},
+		{
+			name:        "aborted upload queues the new blob",
+			uploadState: "ABORTED",
+			blobID:      "blob-id-new",
+			wantErr:     store.ErrUploadNotInProgress,
+			wantQueued:  []string{"blob-id-new"},
+		},
+		{
+			name:        "unknown part returns ErrPartNotPending",
+			uploadState: "IN_PROGRESS",
+			blobID:      "blob-id-missing",
+			wantErr:     store.ErrPartNotPending,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			s := store.NewMultipartStore(db)
+			createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+			setupPrerequisites(ctx, t, db, "bucket-id-mpu", "cradle-id-mpu", createdAt, false, false)
+			insertUpload(ctx, t, db, "upload-id", "bucket-id-mpu", "videos/big.mp4", "IN_PROGRESS", createdAt)
+			if c.prior {
+				insertUploadedPart(ctx, t, db, "blob-id-prior", "upload-id", 1, "cradle-id-mpu", createdAt)
+			}
+			if _, err := s.CreatePendingPart(ctx, "blob-id-new", "upload-id", 1, 2048, "cradle-id-mpu", createdAt); err != nil {
+				t.Fatalf("CreatePendingPart: %v", err)
+			}
+			if c.uploadState != "IN_PROGRESS" {
+				if _, err := db.ExecContext(ctx, `UPDATE multipart_uploads SET state = ? WHERE upload_id = ?`, c.uploadState, "upload-id"); err != nil {
+					t.Fatalf("set upload state: %v", err)
+				}
+			}
+
+			err := s.CommitPart(ctx, c.blobID, 2048, 1735689600000, partETag, createdAt.Add(time.Minute))
+
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("CommitPart error: got %v want %v", err, c.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("CommitPart: unexpected error: %v", err)
+			}
+
+			if got := queuedBlobs(ctx, t, db); !slices.Equal(got, c.wantQueued) {
+				t.Fatalf("queued blobs: got %v want %v", got, c.wantQueued)
+			}
+
+			parts, err := s.ListUploadedParts(ctx, "upload-id")
+			if err != nil {
+				t.Fatalf("ListUploadedParts: %v", err)
+			}
+			var gotParts []string
+			for _, p := range parts {
+				gotParts = append(gotParts, p.BlobID)
+				if p.SizeActual != 2048 || p.LastModifiedMs != 1735689600000 {
+					t.Fatalf("part %s: size %d last_modified %d", p.BlobID, p.SizeActual, p.LastModifiedMs)
+				}
+				if p.ETag != partETag {
+					t.Fatalf("part %s etag: got %q want %q", p.BlobID, p.ETag, partETag)
+				}
+			}
+			if !slices.Equal(gotParts, c.wantParts) {
+				t.Fatalf("uploaded parts: got %v want %v", gotParts, c.wantParts)
+			}
+		})
+	}
+}
+
+func TestMultipartStore_ListUploadedParts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	s := store.NewMultipartStore(db)
+	createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	setupPrerequisites(ctx, t, db, "bucket-id-mpu", "cradle-id-mpu", createdAt, false, false)
+	insertUpload(ctx, t, db, "upload-id", "bucket-id-mpu", "videos/big.mp4", "IN_PROGRESS", createdAt)
+	insertUpload(ctx, t, db, "upload-id-other", "bucket-id-mpu", "videos/big.mp4", "IN_PROGRESS", createdAt)
+	insertUploadedPart(ctx, t, db, "blob-id-3", "upload-id", 3, "cradle-id-mpu", createdAt)
+	insertUploadedPart(ctx, t, db, "blob-id-1", "upload-id", 1, "cradle-id-mpu", createdAt)
+	insertUploadedPart(ctx, t, db, "blob-id-other", "upload-id-other", 2, "cradle-id-mpu", createdAt)
+	if _, err := s.CreatePendingPart(ctx, "blob-id-pending", "upload-id", 2, 1024, "cradle-id-mpu", createdAt); err != nil {
+		t.Fatalf("CreatePendingPart: %v", err)
+	}
+
+	parts, err := s.ListUploadedParts(ctx, "upload-id")
+	if err != nil {
+		t.Fatalf("ListUploadedParts: unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, p := range parts {
+		got = append(got, p.BlobID)
+		if p.CradleAddress != "127.0.0.1:9444" {
+			t.Fatalf("part %s cradle address: got %q want %q", p.BlobID, p.CradleAddress, "127.0.0.1:9444")
+		}
+		if p.ETag != p.BlobID {
+			t.Fatalf("part %s without a stored etag: got etag %q want blob ID", p.BlobID, p.ETag)
+		}
+	}
+	if want := []string{"blob-id-1", "blob-id-3"}; !slices.Equal(got, want) {
+		t.Fatalf("parts: got %v want %v", got, want)
+	}
+}
+
+func TestMultipartStore_Complete(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		uploadState string
+		seed        func(context.Context, *testing.T, *sql.DB, time.Time)
+		blobIDs     []string
+		wantErr     error
+		wantQueued  []string
+		wantStates  map[string]string
+	}
+
+	cases := []tc{
+		{
+			name:        "commits object and drops unlisted parts",
+			uploadState: "IN_PROGRESS",
+			blobIDs:     []string{"blob-id-1", "blob-id-3"},
+			wantQueued:  []string{"blob-id-2"},
+		},
+		{
+			name:        "replaces previous single-blob version",
+			uploadState: "IN_PROGRESS",
+			seed: func(ctx context.Context, t *testing.T, db *sql.DB, createdAt time.Time) {
+				insertCommittedObject(ctx, t, db, "object-id-prior", "bucket-id-mpu", "videos/big.mp4", "cradle-id-mpu", createdAt)
+			},
+			blobIDs:    []string{"blob-id-1", "blob-id-2", "blob-id-3"},
+			wantStates: map[string]string{"object-id-prior": "REPLACED"},
+		},
+		{
+			name:        "replaces previous multipart version",
+			uploadState: "IN_PROGRESS",
+			seed: func(ctx context.Context, t *testing.T, db *sql.DB, createdAt time.Time) {
+				insertUpload(ctx, t, db, "upload-id-prior", "bucket-id-mpu", "videos/big.mp4", "COMPLETED", createdAt)
+				insertUploadedPart(ctx, t, db, "blob-id-prior", "upload-id-prior", 1, "cradle-id-mpu", createdAt)
+				insertMultipartObject(ctx, t, db, "upload-id-prior", "bucket-id-mpu", "videos/big.mp4", 1, "cradle-id-mpu", createdAt)
+			},
+			blobIDs:    []string{"blob-id-1", "blob-id-2", "blob-id-3"},
+			wantQueued: []string{"blob-id-prior"},
+			wantStates: map[string]string{"upload-id-prior": "DELETED"},
+		},
+		{
+			name:        "listed part no longer uploaded returns ErrPartNotUploaded",
+			uploadState: "IN_PROGRESS",
+			blobIDs:     []string{"blob-id-1", "blob-id-gone"},
+			wantErr:     store.ErrPartNotUploaded,
+		},
+		{
+			name:        "aborted upload returns ErrUploadNotInProgress",
+			uploadState: "ABORTED",
+			blobIDs:     []string{"blob-id-1"},
+			wantErr:     store.ErrUploadNotInProgress,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			s := store.NewMultipartStore(db)
+			createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+			completedAt := createdAt.Add(time.Hour)
+
+			setupPrerequisites(ctx, t, db, "bucket-id-mpu", "cradle-id-mpu", createdAt, false, false)
+			if c.seed != nil {
+				c.seed(ctx, t, db, createdAt)
+			}
+			insertUpload(ctx, t, db, "upload-id", "bucket-id-mpu", "videos/big.mp4", c.uploadState, createdAt)
+			for i, blobID := range []string{"blob-id-1", "blob-id-2", "blob-id-3"} {
+				insertUploadedPart(ctx, t, db, blobID, "upload-id", int32(i+1), "cradle-id-mpu", createdAt)
+			}
+
+			_, err := s.Complete(ctx, "upload-id", store.CompletedUpload{
+				BlobIDs:        c.blobIDs,
+				Size:           int64(len(c.blobIDs)) * 1024,
+				ETag:           "0123456789abcdef0123456789abcdef-2",
+				LastModifiedMs: 1735689600000,
+				CradleServerID: "cradle-id-mpu",
+			}, completedAt)
+
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("Complete error: got %v want %v", err, c.wantErr)
+				}
+				if got := queuedBlobs(ctx, t, db); len(got) != 0 {
+					t.Fatalf("queued blobs after failed complete: got %v want none", got)
+				}
+				if got := uploadState(ctx, t, db, "upload-id"); got != c.uploadState {
+					t.Fatalf("upload state: got %q want %q", got, c.uploadState)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Complete: unexpected error: %v", err)
+			}
+
+			rec, err := store.NewObjectStore(db).GetCommitted(ctx, "bucket-id-mpu", "videos/big.mp4")
+			if err != nil {
+				t.Fatalf("GetCommitted: %v", err)
+			}
+			if rec.ID != "upload-id" {
+				t.Fatalf("object id: got %q want %q", rec.ID, "upload-id")
+			}
+			if rec.ETag != "0123456789abcdef0123456789abcdef-2" {
+				t.Fatalf("etag: got %q", rec.ETag)
+			}
+			if rec.PartCount != len(c.blobIDs) {
+				t.Fatalf("part count: got %d want %d", rec.PartCount, len(c.blobIDs))
+			}
+			if rec.SizeActual != int64(len(c.blobIDs))*1024 {
+				t.Fatalf("size: got %d want %d", rec.SizeActual, len(c.blobIDs)*1024)
+			}
+			if rec.ContentType != "video/mp4" {
+				t.Fatalf("content type: got %q want %q", rec.ContentType, "video/mp4")
+			}
+
+			if got := uploadState(ctx, t, db, "upload-id"); got != "COMPLETED" {
+				t.Fatalf("upload state: got %q want %q", got, "COMPLETED")
+			}
+
+			parts, err := s.ListUploadedParts(ctx, "upload-id")
+			if err != nil {
+				t.Fatalf("ListUploadedParts: %v", err)
+			}
+			var gotParts []string
+			for _, p := range parts {
+				gotParts = append(gotParts, p.BlobID)
+			}
+			if !slices.Equal(gotParts, c.blobIDs) {
+				t.Fatalf("object parts: got %v want %v", gotParts, c.blobIDs)
+			}
+
+			if got := queuedBlobs(ctx, t, db); !slices.Equal(got, c.wantQueued) {
+				t.Fatalf("queued blobs: got %v want %v", got, c.wantQueued)
+			}
+
+			for id, want := range c.wantStates {
+				if got := objectState(ctx, t, db, id); got != want {
+					t.Errorf("state of %s: got %q, want %q", id, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestMultipartStore_CompleteCopiesMetadata(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	s := store.NewMultipartStore(db)
+	createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	setupPrerequisites(ctx, t, db, "bucket-id-mpu", "cradle-id-mpu", createdAt, false, false)
+
+	metadata := map[string]string{
+		"cache-control":       "max-age=60",
+		"content-disposition": `attachment; filename="big.mp4"`,
+		"content-encoding":    "gzip",
+		"expires":             "Thu, 01 Jan 2026 00:00:00 GMT",
+		"x-amz-meta-camera":   "front",
+	}
+	if _, err := s.Create(ctx, "upload-id", "bucket-id-mpu", "videos/big.mp4", "video/mp4", metadata, createdAt); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	insertUploadedPart(ctx, t, db, "blob-id-1", "upload-id", 1, "cradle-id-mpu", createdAt)
+
+	if _, err := s.Complete(ctx, "upload-id", store.CompletedUpload{
+		BlobIDs:        []string{"blob-id-1"},
+		Size:           1024,
+		ETag:           "0123456789abcdef0123456789abcdef-1",
+		LastModifiedMs: 1735689600000,
+		CradleServerID: "cradle-id-mpu",
+	}, createdAt.Add(time.Hour)); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+
+	rec, err := store.NewObjectStore(db).GetCommitted(ctx, "bucket-id-mpu", "videos/big.mp4")
+	if err != nil {
+		t.Fatalf("GetCommitted: %v", err)
+	}
+	if !maps.Equal(rec.Metadata, metadata) {
+		t.Fatalf("metadata: got %v want %v", rec.Metadata, metadata)
+	}
+	if rec.ContentType != "video/mp4" {
+		t.Fatalf("content type: got %q want %q", rec.ContentType, "video/mp4")
+	}
+}
+
+func TestMultipartStore_Abort(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		uploadID    string
+		uploadState string
+		wantErr     error
+		wantQueued  []string
+	}
+
+	cases := []tc{
+		{
+			name:        "aborts upload and queues uploaded parts",
+			uploadID:    "upload-id",
+			uploadState: "IN_PROGRESS",
+			wantQueued:  []string{"blob-id-1", "blob-id-2"},
+		},
+		{
+			name:        "completed upload returns ErrUploadNotInProgress",
+			uploadID:    "upload-id",
+			uploadState: "COMPLETED",
+			wantErr:     store.ErrUploadNotInProgress,
+		},
+		{
+			name:        "unknown upload returns ErrUploadNotFound",
+			uploadID:    "upload-id-missing",
+			uploadState: "IN_PROGRESS",
+			wantErr:     store.ErrUploadNotFound,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			s := store.NewMultipartStore(db)
+			createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+			setupPrerequisites(ctx, t, db, "bucket-id-mpu", "cradle-id-mpu", createdAt, false, false)
+			insertUpload(ctx, t, db, "upload-id", "bucket-id-mpu", "videos/big.mp4", c.uploadState, createdAt)
+			insertUploadedPart(ctx, t, db, "blob-id-1", "upload-id", 1, "cradle-id-mpu", createdAt)
+			insertUploadedPart(ctx, t, db, "blob-id-2", "upload-id", 2, "cradle-id-mpu", createdAt)
+
+			err := s.Abort(ctx, c.uploadID, createdAt.Add(time.Minute))
+
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("Abort error: got %v want %v", err, c.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Abort: unexpected error: %v", err)
+			}
+
+			if got := uploadState(ctx, t, db, "upload-id"); got != "ABORTED" {
+				t.Fatalf("upload state: got %q want %q", got, "ABORTED")
+			}
+			if got := queuedBlobs(ctx, t, db); !slices.Equal(got, c.wantQueued) {
+				t.Fatalf("queued blobs: got %v want %v", got, c.wantQueued)
+			}
+
+			var remaining int
+			if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM parts WHERE upload_id = ?`, "upload-id").Scan(&remaining); err != nil {
+				t.Fatalf("count parts: %v", err)
+			}
+			if remaining != 0 {
+				t.Fatalf("parts remaining: got %d want 0", remaining)
+			}
+		})
+	}
+}
+
+func TestMultipartStore_ListInProgress(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name          string
+		prefix        string
+		afterKey      string
+		afterUploadID string
+		limit         int
+		want          []string
+	}
+
+	cases := []tc{
+		{
+			name:  "lists in-progress uploads by key then upload id",
+			limit: 10,
+			want:  []string{"upload-a", "upload-b1", "upload-b2", "upload-c"},
+		},
+		{
+			name:   "prefix filters keys",
+			prefix: "videos/",
+			limit:  10,
+			want:   []string{"upload-b1", "upload-b2", "upload-c"},
+		},
+		{
+			name:     "key marker skips every upload of that key",
+			afterKey: "videos/b.mp4",
+			limit:    10,
+			want:     []string{"upload-c"},
+		},
+		{
+			name:          "upload id marker resumes within a key",
+			afterKey:      "videos/b.mp4",
+			afterUploadID: "upload-b1",
+			limit:         10,
+			want:          []string{"upload-b2", "upload-c"},
+		},
+		{
+			name:  "limit caps the page",
+			limit: 2,
+			want:  []string{"upload-a", "upload-b1"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			s := store.NewMultipartStore(db)
+			createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+			setupPrerequisites(ctx, t, db, "bucket-id-mpu", "cradle-id-mpu", createdAt, false, false)
+			insertUpload(ctx, t, db, "upload-c", "bucket-id-mpu", "videos/c.mp4", "IN_PROGRESS", createdAt)
+			insertUpload(ctx, t, db, "upload-b2", "bucket-id-mpu", "videos/b.mp4", "IN_PROGRESS", createdAt)
+			insertUpload(ctx, t, db, "upload-b1", "bucket-id-mpu", "videos/b.mp4", "IN_PROGRESS", createdAt)
+			insertUpload(ctx, t, db, "upload-a", "bucket-id-mpu", "backups/a.img", "IN_PROGRESS", createdAt)
+			insertUpload(ctx, t, db, "upload-done", "bucket-id-mpu", "videos/a.mp4", "COMPLETED", createdAt)
+			insertUpload(ctx, t, db, "upload-gone", "bucket-id-mpu", "videos/d.mp4", "ABORTED", createdAt)
+
+			uploads, err := s.ListInProgress(ctx, "bucket-id-mpu", c.prefix, c.afterKey, c.afterUploadID, c.limit)
+			if err != nil {
+				t.Fatalf("ListInProgress: unexpected error: %v", err)
+			}
+
+			got := make([]string, 0, len(uploads))
+			for _, u := range uploads {
+				got = append(got, u.ID)
+			}
+			if !slices.Equal(got, c.want) {
+				t.Fatalf("uploads: got %v want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestMultipartStore_AbortStale(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	s := store.NewMultipartStore(db)
+	now := time.Date(2025, time.January, 8, 12, 0, 0, 0, time.UTC)
+	cutoff := now.Add(-7 * 24 * time.Hour)
+
+	setupPrerequisites(ctx, t, db, "bucket-id-mpu", "cradle-id-mpu", cutoff.Add(-time.Hour), false, false)
+	insertUpload(ctx, t, db, "upload-stale-2", "bucket-id-mpu", "backups/b.img", "IN_PROGRESS", cutoff.Add(-time.Minute))
+	insertUpload(ctx, t, db, "upload-stale-1", "bucket-id-mpu", "backups/a.img", "IN_PROGRESS", cutoff.Add(-time.Hour))
+	insertUpload(ctx, t, db, "upload-fresh", "bucket-id-mpu", "backups/c.img", "IN_PROGRESS", cutoff.Add(time.Minute))
+	insertUpload(ctx, t, db, "upload-done", "bucket-id-mpu", "backups/d.img", "COMPLETED", cutoff.Add(-time.Hour))
+	insertUploadedPart(ctx, t, db, "blob-stale-1", "upload-stale-1", 1, "cradle-id-mpu", cutoff.Add(-time.Hour))
+	insertUploadedPart(ctx, t, db, "blob-stale-2", "upload-stale-2", 1, "cradle-id-mpu", cutoff.Add(-time.Minute))
+	insertUploadedPart(ctx, t, db, "blob-fresh", "upload-fresh", 1, "cradle-id-mpu", cutoff.Add(time.Minute))
+
+	ids, err := s.AbortStale(ctx, cutoff, 1, now)
+	if err != nil {
+		t.Fatalf("AbortStale: unexpected error: %v", err)
+	}
+	if want := []string{"upload-stale-1"}; !slices.Equal(ids, want) {
+		t.Fatalf("first batch: got %v want %v", ids, want)
+	}
+
+	ids, err = s.AbortStale(ctx, cutoff, 10, now)
+	if err != nil {
+		t.Fatalf("AbortStale: unexpected error: %v", err)
+	}
+	if want := []string{"upload-stale-2"}; !slices.Equal(ids, want) {
+		t.Fatalf("second batch: got %v want %v", ids, want)
+	}
+
+	for id, want := range map[string]string{
+		"upload-stale-1": "ABORTED",
+		"upload-stale-2": "ABORTED",
+		"upload-fresh":   "IN_PROGRESS",
+		"upload-done":    "COMPLETED",
+	} {
+		if got := uploadState(ctx, t, db, id); got != want {
+			t.Fatalf("%s state: got %q want %q", id, got, want)
+		}
+	}
+
+	if got, want := queuedBlobs(ctx, t, db), []string{"blob-stale-1", "blob-stale-2"}; !slices.Equal(got, want) {
+		t.Fatalf("queued blobs: got %v want %v", got, want)
+	}
+}
+
+func insertUpload(ctx context.Context, t *testing.T, db *sql.DB, uploadID, bucketID, key, state string, createdAt time.Time) {
+	t.Helper()
+	stamp := createdAt.UTC().Truncate(time.Microsecond).UnixMicro()
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO multipart_uploads (upload_id, bucket_id, key, state, content_type, created_at, updated_at)
+		VALUES (?, ?, ?, ?, 'video/mp4', ?, ?)
+	`, uploadID, bucketID, key, state, stamp, stamp)
+	if err != nil {
+		t.Fatalf("insertUpload: %v", err)
+	}
+}
+
+func insertUploadedPart(ctx context.Context, t *testing.T, db *sql.DB, blobID, uploadID string, partNumber int32, cradleServerID string, createdAt time.Time) {
+	t.Helper()
+	stamp := createdAt.UTC().Truncate(time.Microsecond).UnixMicro()
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO parts (blob_id, upload_id, part_number, state, size_expected, size_actual, last_modified, cradle_server_id, created_at, updated_at)
+		VALUES (?, ?, ?, 'UPLOADED', 1024, 1024, ?, ?, ?, ?)
+	`, blobID, uploadID, partNumber, stamp, cradleServerID, stamp, stamp)
+	if err != nil {
+		t.Fatalf("insertUploadedPart: %v", err)
+	}
+}
+
+func insertMultipartObject(ctx context.Context, t *testing.T, db *sql.DB, uploadID, bucketID, key string, partCount int, cradleServerID string, createdAt time.Time) {
+	t.Helper()
+	stamp := createdAt.UTC().Truncate(time.Microsecond).UnixMicro()
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO objects (object_id, bucket_id, key, state, size_expected, size_actual, last_modified, cradle_server_id, etag, part_count, committed_at, created_at, updated_at)
+		VALUES (?, ?, ?, 'COMMITTED', 1024, 1024, ?, ?, 'etag-multipart', ?, ?, ?, ?)
+	`, uploadID, bucketID, key, stamp, cradleServerID, partCount, stamp, stamp, stamp)
+	if err != nil {
+		t.Fatalf("insertMultipartObject: %v", err)
+	}
+}
+
+func uploadState(ctx context.Context, t *testing.T, db *sql.DB, uploadID string) string {
+	t.Helper()
+	var state string
+	if err := db.QueryRowContext(ctx, `SELECT state FROM multipart_uploads WHERE upload_id = ?`, uploadID).Scan(&state); err != nil {
+		t.Fatalf("query upload state: %v", err)
+	}
+	return state
+}
+
+func queuedBlobs(ctx context.Context, t *testing.T, db *sql.DB) []string {
+	t.Helper()
+	rows, err := db.QueryContext(ctx, `SELECT object_id FROM blob_deletions ORDER BY object_id`)
+	if err != nil {
+		t.Fatalf("query blob_deletions: %v", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			t.Fatalf("scan blob_deletions: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -3,12 +3,34 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
-var ErrObjectNotPending = errors.New("object not found or not in PENDING state")
+var (
+	ErrObjectNotPending     = errors.New("object not found or not in PENDING state")
+	ErrObjectNotFound       = errors.New("object not found")
+	ErrObjectNotReclaimable = errors.New("object not found or not in REPLACED or FAILED state")
+	ErrObjectExists         = errors.New("object already exists")
+	ErrObjectIsDeleteMarker = errors.New("object version is a delete marker")
+	ErrObjectLocked         = errors.New("object version is locked")
+)
+
+// Object lock retention modes, named as in S3. A GOVERNANCE retention may be
+// shortened or removed by a caller allowed to bypass it; a COMPLIANCE
+// retention can't be by anyone.
+const (
+	RetentionGovernance = "GOVERNANCE"
+	RetentionCompliance = "COMPLIANCE"
+)
+
+// NullVersionID is S3's name for the version of an object written while its
+// bucket's versioning was never enabled or was suspended. Such rows have a
+// NULL version_id.
+const NullVersionID = "null"
 
 type objectStore struct {
 	db *sql.DB
@@ -24,60 +46,210 @@ type ObjectRecord struct {
 	Key            string
 	State          string
 	SizeExpected   int64
+	SizeActual     int64
+	ContentType    string
+	LastModifiedMs int64
 	CradleServerID string
+	ETag           string
+	PartCount      int
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
+
+	// ChecksumAlgorithm and Checksum hold the x-amz-checksum-* value the
+	// client uploaded with, if any.
+	ChecksumAlgorithm string
+	Checksum          string
+
+	// VersionID is the object's S3 version ID, NullVersionID for the null
+	// version. IsLatest reports whether it is the current version of its
+	// key, which for a DELETE_MARKER means the key reads as deleted.
+	VersionID string
+	IsLatest  bool
+
+	// Metadata maps the lowercased header names an object was uploaded with,
+	// such as cache-control or x-amz-meta-color, to their values. Only
+	// GetCommitted and GetVersion load it.
+	Metadata map[string]string
+
+	// Tags holds the object's tags. Only GetCommitted and GetVersion load
+	// them.
+	Tags map[string]string
+
+	// Lock is the version's object lock.
+	Lock ObjectLock
+}
+
+// ObjectLock is the object lock of a version: a retention in Mode until
+// RetainUntil, if Mode is set, and a legal hold. While either is in effect
+// the version can't be removed or replaced.
+type ObjectLock struct {
+	Mode        string
+	RetainUntil time.Time
+	LegalHold   bool
+}
+
+// retainUntilColumn is the retain_until stored for lock: NULL without a
+// retention.
+func retainUntilColumn(lock ObjectLock) any {
+	if lock.Mode == "" {
+		return nil
+	}
+	return lock.RetainUntil.UTC().UnixMicro()
+}
+
+// ObjectDeletion is what deleting a key or one of its versions did. VersionID
+// names the delete marker created or the version removed, and is empty when
+// the bucket was never versioned. DeleteMarker reports whether that version
+// is a delete marker. Deleted is false when there was nothing to delete.
+// Locked is set by DeleteMany when an object lock kept the version.
+type ObjectDeletion struct {
+	VersionID    string
+	DeleteMarker bool
+	Deleted      bool
+	Locked       bool
+}
+
+// ObjectCommit is what flatbed learned while streaming a PENDING object to
+// its cradle.
+type ObjectCommit struct {
+	SizeActual        int64
+	LastModifiedMs    int64
+	ETag              string
+	ChecksumAlgorithm string
+	Checksum          string
 }
 
-func (s *objectStore) CreatePending(ctx context.Context, id, bucketID, key string, sizeExpected int64, cradleServerID string, createdAt time.Time) (ObjectRecord, error) {
+// CreatePending records an object being written to its cradle, with the
+// metadata, tags and object lock it becomes a version with once committed.
+func (s *objectStore) CreatePending(ctx context.Context, id, bucketID, key string, sizeExpected int64, contentType string, metadata, tags map[string]string, lock ObjectLock, cradleServerID string, createdAt time.Time) (ObjectRecord, error) {
 	stamp := createdAt.UTC().Truncate(time.Microsecond)
 	micros := stamp.UnixMicro()
 
 	const insertObject = `
-INSERT INTO objects (object_id, bucket_id, key, state, size_expected, cradle_server_id, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO objects (object_id, bucket_id, key, state, size_expected, content_type, cradle_server_id,
+                     retention_mode, retain_until, legal_hold, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, NULLIF(?, ''), ?, NULLIF(?, ''), ?, ?, ?, ?)
 `
 
-	_, err := s.db.ExecContext(ctx, insertObject, id, bucketID, key, "PENDING", sizeExpected, cradleServerID, micros, micros)
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
+		return ObjectRecord{}, fmt.Errorf("insert object, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, insertObject, id, bucketID, key, "PENDING", sizeExpected, contentType, cradleServerID,
+		lock.Mode, retainUntilColumn(lock), lock.LegalHold, micros, micros); err != nil {
 		return ObjectRecord{}, fmt.Errorf("insert object: %w", err)
 	}
 
+	for name, value := range metadata {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO object_metadata (object_id, name, value) VALUES (?, ?, ?)`, id, name, value); err != nil {
+			return ObjectRecord{}, fmt.Errorf("insert object metadata: %w", err)
+		}
+	}
+
+	if err := insertTags(ctx, tx, id, tags); err != nil {
+		return ObjectRecord{}, fmt.Errorf("insert object tags: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ObjectRecord{}, fmt.Errorf("insert object, commit: %w", err)
+	}
+
 	return ObjectRecord{
 		ID:             id,
 		BucketID:       bucketID,
 		Key:            key,
 		State:          "PENDING",
 		SizeExpected:   sizeExpected,
+		ContentType:    contentType,
 		CradleServerID: cradleServerID,
 		CreatedAt:      stamp,
 		UpdatedAt:      stamp,
+		Metadata:       metadata,
+		Tags:           tags,
+		Lock:           lock,
 	}, nil
 }
 
-func (s *objectStore) CommitWithReplace(ctx context.Context, objectID string, sizeActual int64, lastModifiedMs int64, updatedAt time.Time) error {
+// CommitWithReplace makes a PENDING object the current version of its key
+// and returns its version ID, which is empty when the bucket was never
+// versioned. See supersede for what happens to the version it replaces. If
+// that version would be retired while under an object lock, the object is
+// marked FAILED instead and ErrObjectLocked is returned. The bucket's default
+// retention applies unless the object was written with one of its own.
+func (s *objectStore) CommitWithReplace(ctx context.Context, objectID string, commit ObjectCommit, updatedAt time.Time) (string, error) {
+	return s.commit(ctx, objectID, commit, updatedAt, false)
+}
+
+// CommitIfAbsent commits a PENDING object only if its key has no COMMITTED
+// version, backing PUT with If-None-Match: *. The check runs in the commit
+// transaction so concurrent uploads of the same key can't both win. A losing
+// object is marked FAILED so the cleanup worker reclaims its blob, and
+// ErrObjectExists is returned. A delete marker doesn't count as a version.
+func (s *objectStore) CommitIfAbsent(ctx context.Context, objectID string, commit ObjectCommit, updatedAt time.Time) (string, error) {
+	return s.commit(ctx, objectID, commit, updatedAt, true)
+}
+
+func (s *objectStore) commit(ctx context.Context, objectID string, commit ObjectCommit, updatedAt time.Time, ifAbsent bool) (string, error) {
 	stamp := updatedAt.UTC().Truncate(time.Microsecond)
 	micros := stamp.UnixMicro()
 
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("commit object, begin tx: %w", err)
+		return "", fmt.Errorf("commit object, begin tx: %w", err)
 	}
 	defer tx.Rollback()
 
-	_, err = tx.ExecContext(ctx, `
-		WITH pending AS (
-			SELECT bucket_id, key FROM objects WHERE object_id = ? AND state = 'PENDING'
-		)
-		UPDATE objects
-		SET state = 'REPLACED',
-		    updated_at = ?
-		WHERE state = 'COMMITTED'
-		  AND bucket_id = (SELECT bucket_id FROM pending)
-		  AND key = (SELECT key FROM pending)
-	`, objectID, micros)
+	var bucketID, key string
+	err = tx.QueryRowContext(ctx, `SELECT bucket_id, key FROM objects WHERE object_id = ? AND state = 'PENDING'`, objectID).Scan(&bucketID, &key)
 	if err != nil {
-		return fmt.Errorf("commit object, replace previous: %w", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("commit object: %w", ErrObjectNotPending)
+		}
+		return "", fmt.Errorf("commit object: %w", err)
+	}
+
+	if ifAbsent {
+		var exists bool
+		err := tx.QueryRowContext(ctx, `
+			SELECT EXISTS (SELECT 1 FROM objects WHERE bucket_id = ? AND key = ? AND state = 'COMMITTED')
+		`, bucketID, key).Scan(&exists)
+		if err != nil {
+			return "", fmt.Errorf("commit object, check existing: %w", err)
+		}
+		if exists {
+			if _, err := tx.ExecContext(ctx, `
+				UPDATE objects SET state = 'FAILED', updated_at = ? WHERE object_id = ? AND state = 'PENDING'
+			`, micros, objectID); err != nil {
+				return "", fmt.Errorf("commit object, fail: %w", err)
+			}
+			if err := tx.Commit(); err != nil {
+				return "", fmt.Errorf("commit object, fail: %w", err)
+			}
+			return "", fmt.Errorf("commit object: %w", ErrObjectExists)
+		}
+	}
+
+	versioning, err := bucketVersioning(ctx, tx, bucketID)
+	if err != nil {
+		return "", fmt.Errorf("commit object: %w", err)
+	}
+
+	versionID, err := supersede(ctx, tx, versioning, bucketID, key, objectID, micros)
+	if errors.Is(err, ErrObjectLocked) {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE objects SET state = 'FAILED', updated_at = ? WHERE object_id = ? AND state = 'PENDING'
+		`, micros, objectID); err != nil {
+			return "", fmt.Errorf("commit object, fail: %w", err)
+		}
+		if err := tx.Commit(); err != nil {
+			return "", fmt.Errorf("commit object, fail: %w", err)
+		}
+		return "", fmt.Errorf("commit object: %w", ErrObjectLocked)
+	}
+	if err != nil {
+		return "", fmt.Errorf("commit object, replace previous: %w", err)
 	}
 
 	result, err := tx.ExecContext(ctx, `
@@ -85,22 +257,994 @@ func (s *objectStore) CommitWithReplace(ctx context.Context, objectID string, si
 		SET state = 'COMMITTED',
 		    size_actual = ?,
 		    last_modified = ?,
+		    etag = NULLIF(?, ''),
+		    checksum_algorithm = NULLIF(?, ''),
+		    checksum = NULLIF(?, ''),
+		    version_id = ?,
+		    committed_at = ?,
 		    updated_at = ?
 		WHERE object_id = ?
 		  AND state = 'PENDING'
-	`, sizeActual, lastModifiedMs, micros, objectID)
+	`, commit.SizeActual, commit.LastModifiedMs, commit.ETag, commit.ChecksumAlgorithm, commit.Checksum,
+		versionColumn(versionID), micros, micros, objectID)
+	if err != nil {
+		return "", fmt.Errorf("commit object: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return "", fmt.Errorf("commit object, rows affected: %w", err)
+	}
+
+	if rows != 1 {
+		return "", fmt.Errorf("commit object: %w", ErrObjectNotPending)
+	}
+
+	if err := defaultRetention(ctx, tx, bucketID, objectID, micros); err != nil {
+		return "", fmt.Errorf("commit object: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("commit object, commit: %w", err)
+	}
+
+	return versionID, nil
+}
+
+func (s *objectStore) GetCommitted(ctx context.Context, bucketID, key string) (ObjectRecord, error) {
+	rec, err := scanVersion(s.db.QueryRowContext(ctx, `
+SELECT `+versionColumns+`
+FROM objects o
+WHERE o.bucket_id = ? AND o.key = ? AND o.state = 'COMMITTED'
+`, bucketID, key))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ObjectRecord{}, ErrObjectNotFound
+		}
+		return ObjectRecord{}, fmt.Errorf("get committed object: %w", err)
+	}
+
+	metadata, err := s.metadata(ctx, rec.ID)
+	if err != nil {
+		return ObjectRecord{}, fmt.Errorf("get committed object: %w", err)
+	}
+	rec.Metadata = metadata
+
+	tags, err := s.tags(ctx, rec.ID)
+	if err != nil {
+		return ObjectRecord{}, fmt.Errorf("get committed object: %w", err)
+	}
+	rec.Tags = tags
+
+	return rec, nil
+}
+
+// GetVersion returns one version of a key, current or not, by its version
+// ID; NullVersionID selects the null version. The version may be a delete
+// marker, which callers tell by its DELETE_MARKER state.
+func (s *objectStore) GetVersion(ctx context.Context, bucketID, key, versionID string) (ObjectRecord, error) {
+	match, args := versionMatch("o", versionID)
+	rec, err := scanVersion(s.db.QueryRowContext(ctx, `
+SELECT `+versionColumns+`
+FROM objects o
+WHERE o.bucket_id = ? AND o.key = ? AND o.state IN `+versionStates+` AND `+match,
+		append([]any{bucketID, key}, args...)...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ObjectRecord{}, ErrObjectNotFound
+		}
+		return ObjectRecord{}, fmt.Errorf("get object version: %w", err)
+	}
+
+	metadata, err := s.metadata(ctx, rec.ID)
+	if err != nil {
+		return ObjectRecord{}, fmt.Errorf("get object version: %w", err)
+	}
+	rec.Metadata = metadata
+
+	tags, err := s.tags(ctx, rec.ID)
+	if err != nil {
+		return ObjectRecord{}, fmt.Errorf("get object version: %w", err)
+	}
+	rec.Tags = tags
+
+	return rec, nil
+}
+
+// versionStates are the states of a key's versions: its current version,
+// the NONCURRENT versions it replaced in a versioned bucket, and delete
+// markers.
+const versionStates = `('COMMITTED','NONCURRENT','DELETE_MARKER')`
+
+// versionColumns selects an objects row aliased o as scanVersion reads it.
+// A version is the latest when no version of its key became current after
+// it; ULID object IDs break ties between versions of the same microsecond.
+const versionColumns = `o.object_id, o.bucket_id, o.key, o.state, o.size_expected, COALESCE(o.size_actual, 0),
+       COALESCE(o.last_modified, 0), COALESCE(o.content_type, ''), COALESCE(o.cradle_server_id, ''), COALESCE(o.etag, ''), o.part_count,
+       COALESCE(o.checksum_algorithm, ''), COALESCE(o.checksum, ''), COALESCE(o.version_id, '` + NullVersionID + `'),
+       NOT EXISTS (
+           SELECT 1 FROM objects n
+           WHERE n.bucket_id = o.bucket_id AND n.key = o.key AND n.state IN ` + versionStates + `
+             AND (n.committed_at > o.committed_at OR (n.committed_at = o.committed_at AND n.object_id > o.object_id))
+       ),
+       COALESCE(o.retention_mode, ''), COALESCE(o.retain_until, 0), o.legal_hold,
+       o.created_at, o.updated_at`
+
+func scanVersion(row rowScanner) (ObjectRecord, error) {
+	var (
+		rec         ObjectRecord
+		retainUntil int64
+		createdAt   int64
+		updatedAt   int64
+	)
+
+	if err := row.Scan(&rec.ID, &rec.BucketID, &rec.Key, &rec.State, &rec.SizeExpected, &rec.SizeActual,
+		&rec.LastModifiedMs, &rec.ContentType, &rec.CradleServerID, &rec.ETag, &rec.PartCount,
+		&rec.ChecksumAlgorithm, &rec.Checksum, &rec.VersionID, &rec.IsLatest,
+		&rec.Lock.Mode, &retainUntil, &rec.Lock.LegalHold, &createdAt, &updatedAt); err != nil {
+		return ObjectRecord{}, err
+	}
+
+	if rec.Lock.Mode != "" {
+		rec.Lock.RetainUntil = time.UnixMicro(retainUntil).UTC()
+	}
+
+	rec.CreatedAt = time.UnixMicro(createdAt).UTC()
+	rec.UpdatedAt = time.UnixMicro(updatedAt).UTC()
+
+	return rec, nil
+}
+
+// versionMatch is a condition on the version_id of the objects row aliased
+// alias, with its arguments, selecting versionID.
+func versionMatch(alias, versionID string) (string, []any) {
+	if versionID == NullVersionID {
+		return alias + ".version_id IS NULL", nil
+	}
+	return alias + ".version_id = ?", []any{versionID}
+}
+
+// versionColumn is the version_id stored for versionID: NULL for the null
+// version and in buckets that were never versioned.
+func versionColumn(versionID string) any {
+	if versionID == "" || versionID == NullVersionID {
+		return nil
+	}
+	return versionID
+}
+
+// metadata returns the object_metadata rows for objectID, or nil if it has
+// none.
+func (s *objectStore) metadata(ctx context.Context, objectID string) (map[string]string, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT name, value FROM object_metadata WHERE object_id = ?`, objectID)
+	if err != nil {
+		return nil, fmt.Errorf("select metadata: %w", err)
+	}
+	defer rows.Close()
+
+	var metadata map[string]string
+	for rows.Next() {
+		var name, value string
+		if err := rows.Scan(&name, &value); err != nil {
+			return nil, fmt.Errorf("scan metadata: %w", err)
+		}
+		if metadata == nil {
+			metadata = make(map[string]string)
+		}
+		metadata[name] = value
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate metadata: %w", err)
+	}
+
+	return metadata, nil
+}
+
+// tags returns the object_tags rows for objectID, or nil if it has none.
+func (s *objectStore) tags(ctx context.Context, objectID string) (map[string]string, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT key, value FROM object_tags WHERE object_id = ?`, objectID)
+	if err != nil {
+		return nil, fmt.Errorf("select tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags map[string]string
+	for rows.Next() {
+		var key, value string
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, fmt.Errorf("scan tags: %w", err)
+		}
+		if tags == nil {
+			tags = make(map[string]string)
+		}
+		tags[key] = value
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// insertTags adds tags to objectID inside tx.
+func insertTags(ctx context.Context, tx *sql.Tx, objectID string, tags map[string]string) error {
+	for key, value := range tags {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO object_tags (object_id, key, value) VALUES (?, ?, ?)`, objectID, key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetTags replaces the tags of one version of a key with tags, and returns
+// that version's ID; no tags removes them all. The version is selected as
+// findVersion describes.
+func (s *objectStore) SetTags(ctx context.Context, bucketID, key, versionID string, tags map[string]string) (string, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("set object tags, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	rec, err := findVersion(ctx, tx, bucketID, key, versionID)
+	if err != nil {
+		return "", fmt.Errorf("set object tags: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM object_tags WHERE object_id = ?`, rec.ID); err != nil {
+		return "", fmt.Errorf("set object tags, clear: %w", err)
+	}
+
+	if err := insertTags(ctx, tx, rec.ID, tags); err != nil {
+		return "", fmt.Errorf("set object tags: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("set object tags, commit: %w", err)
+	}
+
+	return rec.VersionID, nil
+}
+
+// SetRetention replaces the retention of one version of a key, selected as
+// findVersion describes, with one in mode until retainUntil, and returns
+// that version's ID; an empty mode removes it. A retention still in effect
+// at now may be extended, or changed from GOVERNANCE to COMPLIANCE, but
+// shortening, removing or weakening it returns ErrObjectLocked, unless it is
+// a GOVERNANCE retention and bypassGovernance is set.
+func (s *objectStore) SetRetention(ctx context.Context, bucketID, key, versionID, mode string, retainUntil time.Time, bypassGovernance bool, now time.Time) (string, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("set object retention, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	rec, err := findVersion(ctx, tx, bucketID, key, versionID)
+	if err != nil {
+		return "", fmt.Errorf("set object retention: %w", err)
+	}
+
+	if current := rec.Lock; current.Mode != "" && current.RetainUntil.After(now) {
+		weaker := mode == "" || retainUntil.Before(current.RetainUntil) ||
+			(current.Mode == RetentionCompliance && mode != RetentionCompliance)
+		if weaker && (current.Mode == RetentionCompliance || !bypassGovernance) {
+			return "", fmt.Errorf("set object retention: %w", ErrObjectLocked)
+		}
+	}
+
+	lock := ObjectLock{Mode: mode, RetainUntil: retainUntil}
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE objects SET retention_mode = NULLIF(?, ''), retain_until = ? WHERE object_id = ?
+	`, mode, retainUntilColumn(lock), rec.ID); err != nil {
+		return "", fmt.Errorf("set object retention: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("set object retention, commit: %w", err)
+	}
+
+	return rec.VersionID, nil
+}
+
+// SetLegalHold places or lifts a legal hold on one version of a key,
+// selected as findVersion describes, and returns that version's ID.
+func (s *objectStore) SetLegalHold(ctx context.Context, bucketID, key, versionID string, on bool) (string, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("set object legal hold, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	rec, err := findVersion(ctx, tx, bucketID, key, versionID)
+	if err != nil {
+		return "", fmt.Errorf("set object legal hold: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE objects SET legal_hold = ? WHERE object_id = ?`, on, rec.ID); err != nil {
+		return "", fmt.Errorf("set object legal hold: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("set object legal hold, commit: %w", err)
+	}
+
+	return rec.VersionID, nil
+}
+
+// findVersion selects inside tx the version of a key whose tags or object
+// lock are set: the current version when versionID is empty, otherwise the
+// version named, NullVersionID for the null version. Only the ID, VersionID
+// and Lock of the record are set. It returns ErrObjectNotFound when there is
+// no such version and ErrObjectIsDeleteMarker when the version is a delete
+// marker.
+func findVersion(ctx context.Context, tx *sql.Tx, bucketID, key, versionID string) (ObjectRecord, error) {
+	cond := `o.state = 'COMMITTED'`
+	args := []any{bucketID, key}
+	if versionID != "" {
+		match, matchArgs := versionMatch("o", versionID)
+		cond = `o.state IN ` + versionStates + ` AND ` + match
+		args = append(args, matchArgs...)
+	}
+
+	var (
+		rec         ObjectRecord
+		retainUntil int64
+	)
+	err := tx.QueryRowContext(ctx, `
+		SELECT o.object_id, o.state, COALESCE(o.version_id, '`+NullVersionID+`'),
+		       COALESCE(o.retention_mode, ''), COALESCE(o.retain_until, 0), o.legal_hold
+		FROM objects o
+		WHERE o.bucket_id = ? AND o.key = ? AND `+cond, args...).Scan(&rec.ID, &rec.State, &rec.VersionID,
+		&rec.Lock.Mode, &retainUntil, &rec.Lock.LegalHold)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ObjectRecord{}, ErrObjectNotFound
+		}
+		return ObjectRecord{}, err
+	}
+	if rec.State == "DELETE_MARKER" {
+		return ObjectRecord{}, ErrObjectIsDeleteMarker
+	}
+
+	if rec.Lock.Mode != "" {
+		rec.Lock.RetainUntil = time.UnixMicro(retainUntil).UTC()
+	}
+
+	return rec, nil
+}
+
+// DeleteCurrent deletes a key the way DELETE without a version ID does.
+// Without versioning its current version is retired. In a versioned bucket
+// the key's versions are kept and a delete marker with object ID markerID
+// becomes its current version instead, as supersede describes.
+func (s *objectStore) DeleteCurrent(ctx context.Context, bucketID, key, markerID string, updatedAt time.Time) (ObjectDeletion, error) {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ObjectDeletion{}, fmt.Errorf("delete object, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	versioning, err := bucketVersioning(ctx, tx, bucketID)
+	if err != nil {
+		return ObjectDeletion{}, fmt.Errorf("delete object: %w", err)
+	}
+
+	deletion, err := deleteCurrent(ctx, tx, versioning, bucketID, key, markerID, micros)
+	if err != nil {
+		return ObjectDeletion{}, fmt.Errorf("delete object: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ObjectDeletion{}, fmt.Errorf("delete object, commit: %w", err)
+	}
+
+	return deletion, nil
+}
+
+// deleteCurrent deletes a key inside tx as DeleteCurrent describes, given
+// its bucket's versioning status.
+func deleteCurrent(ctx context.Context, tx *sql.Tx, versioning, bucketID, key, markerID string, micros int64) (ObjectDeletion, error) {
+	if versioning == "" {
+		retired, err := retireCommitted(ctx, tx, bucketID, key, micros)
+		if err != nil {
+			return ObjectDeletion{}, err
+		}
+		return ObjectDeletion{Deleted: retired}, nil
+	}
+
+	versionID, err := supersede(ctx, tx, versioning, bucketID, key, markerID, micros)
+	if err != nil {
+		return ObjectDeletion{}, fmt.Errorf("replace previous: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO objects (object_id, bucket_id, key, state, size_expected, size_actual, last_modified,
+		                     version_id, committed_at, created_at, updated_at)
+		VALUES (?, ?, ?, 'DELETE_MARKER', 0, 0, ?, ?, ?, ?, ?)
+	`, markerID, bucketID, key, micros/1000, versionColumn(versionID), micros, micros, micros); err != nil {
+		return ObjectDeletion{}, fmt.Errorf("insert delete marker: %w", err)
+	}
+
+	return ObjectDeletion{VersionID: versionID, DeleteMarker: true, Deleted: true}, nil
+}
+
+// ExpireCurrent deletes the key of objectID as DeleteCurrent does, provided
+// objectID is still that key's current version. The lifecycle worker uses it
+// so that a key rewritten since it was found expired is left alone, which is
+// reported as Deleted false.
+func (s *objectStore) ExpireCurrent(ctx context.Context, objectID, markerID string, updatedAt time.Time) (ObjectDeletion, error) {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ObjectDeletion{}, fmt.Errorf("expire object, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	var bucketID, key string
+	err = tx.QueryRowContext(ctx, `
+		SELECT bucket_id, key FROM objects WHERE object_id = ? AND state = 'COMMITTED'
+	`, objectID).Scan(&bucketID, &key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ObjectDeletion{}, nil
+		}
+		return ObjectDeletion{}, fmt.Errorf("expire object: %w", err)
+	}
+
+	versioning, err := bucketVersioning(ctx, tx, bucketID)
+	if err != nil {
+		return ObjectDeletion{}, fmt.Errorf("expire object: %w", err)
+	}
+
+	deletion, err := deleteCurrent(ctx, tx, versioning, bucketID, key, markerID, micros)
+	if err != nil {
+		return ObjectDeletion{}, fmt.Errorf("expire object: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ObjectDeletion{}, fmt.Errorf("expire object, commit: %w", err)
+	}
+
+	return deletion, nil
+}
+
+// DeleteVersion permanently removes one version of a key, which may be a
+// delete marker; NullVersionID selects the null version. Its blob is
+// reclaimed like a replaced object's. When the current version is removed,
+// the newest remaining version becomes current unless it is a delete marker.
+// Deleting a version that doesn't exist does nothing. A version under an
+// object lock is kept and ErrObjectLocked returned, except that
+// bypassGovernance lets a GOVERNANCE retention be ignored.
+func (s *objectStore) DeleteVersion(ctx context.Context, bucketID, key, versionID string, bypassGovernance bool, updatedAt time.Time) (ObjectDeletion, error) {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ObjectDeletion{}, fmt.Errorf("delete object version, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	deletion, err := deleteVersion(ctx, tx, bucketID, key, versionID, bypassGovernance, micros)
+	if err != nil {
+		return ObjectDeletion{}, fmt.Errorf("delete object version: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ObjectDeletion{}, fmt.Errorf("delete object version, commit: %w", err)
+	}
+
+	return deletion, nil
+}
+
+// deleteVersion removes one version of a key inside tx as DeleteVersion
+// describes.
+func deleteVersion(ctx context.Context, tx *sql.Tx, bucketID, key, versionID string, bypassGovernance bool, micros int64) (ObjectDeletion, error) {
+	match, args := versionMatch("o", versionID)
+	var objectID, state string
+	err := tx.QueryRowContext(ctx, `
+		SELECT o.object_id, o.state FROM objects o
+		WHERE o.bucket_id = ? AND o.key = ? AND o.state IN `+versionStates+` AND `+match,
+		append([]any{bucketID, key}, args...)...).Scan(&objectID, &state)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ObjectDeletion{VersionID: versionID}, nil
+		}
+		return ObjectDeletion{}, err
+	}
+
+	if _, err := retireVersions(ctx, tx, `object_id = ?`, micros, bypassGovernance, objectID); err != nil {
+		return ObjectDeletion{}, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE objects
+		SET state = 'COMMITTED',
+		    updated_at = ?
+		WHERE object_id = (
+		    SELECT object_id FROM objects
+		    WHERE bucket_id = ? AND key = ? AND state IN `+versionStates+`
+		    ORDER BY committed_at DESC, object_id DESC
+		    LIMIT 1
+		)
+		  AND state = 'NONCURRENT'
+	`, micros, bucketID, key); err != nil {
+		return ObjectDeletion{}, fmt.Errorf("promote previous: %w", err)
+	}
+
+	return ObjectDeletion{VersionID: versionID, DeleteMarker: state == "DELETE_MARKER", Deleted: true}, nil
+}
+
+// ObjectDelete names one key or version for DeleteMany to delete. Without a
+// VersionID the key is deleted as DeleteCurrent does, and MarkerID is the
+// object ID of the delete marker that may be created. BypassGovernance is
+// passed on to DeleteVersion.
+type ObjectDelete struct {
+	Key              string
+	VersionID        string
+	MarkerID         string
+	BypassGovernance bool
+}
+
+// DeleteMany deletes keys and versions of a bucket in one transaction, each
+// as DeleteCurrent or DeleteVersion would, and returns what each delete did
+// in the same order. Deleting the same key twice applies both in turn. A
+// version kept by an object lock is reported as Locked and the other deletes
+// still go ahead.
+func (s *objectStore) DeleteMany(ctx context.Context, bucketID string, deletes []ObjectDelete, updatedAt time.Time) ([]ObjectDeletion, error) {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("delete objects, begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	versioning, err := bucketVersioning(ctx, tx, bucketID)
 	if err != nil {
-		return fmt.Errorf("commit object: %w", err)
+		return nil, fmt.Errorf("delete objects: %w", err)
+	}
+
+	deletions := make([]ObjectDeletion, 0, len(deletes))
+	for _, d := range deletes {
+		var deletion ObjectDeletion
+		if d.VersionID == "" {
+			deletion, err = deleteCurrent(ctx, tx, versioning, bucketID, d.Key, d.MarkerID, micros)
+		} else {
+			deletion, err = deleteVersion(ctx, tx, bucketID, d.Key, d.VersionID, d.BypassGovernance, micros)
+		}
+		if errors.Is(err, ErrObjectLocked) {
+			deletion, err = ObjectDeletion{VersionID: d.VersionID, Locked: true}, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("delete objects, key %s: %w", d.Key, err)
+		}
+		deletions = append(deletions, deletion)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("delete objects, commit: %w", err)
+	}
+
+	return deletions, nil
+}
+
+// LifecycleExpiry selects the versions of keys starting with Prefix, and
+// carrying every one of Tags, that one lifecycle rule expires. A zero
+// CurrentBefore or NoncurrentBefore expires no current or noncurrent versions
+// respectively.
+type LifecycleExpiry struct {
+	Prefix string
+	Tags   map[string]string
+
+	// CurrentBefore expires current versions that became current before it.
+	CurrentBefore time.Time
+
+	// NoncurrentBefore expires NONCURRENT versions that were superseded
+	// before it, except for the NewerNoncurrent newest of each key.
+	NoncurrentBefore time.Time
+	NewerNoncurrent  int
+
+	// DeleteMarkers expires delete markers that are the only version left of
+	// their key.
+	DeleteMarkers bool
+
+	// Now is when the rule is applied. Noncurrent versions still under an
+	// object lock then are never expired.
+	Now time.Time
+}
+
+// ListExpired returns up to limit versions in a bucket that expiry selects,
+// in key order and newest first. Callers tell what to do with each by its
+// state: a COMMITTED version is expired with ExpireCurrent, and NONCURRENT
+// versions and delete markers are removed with DeleteVersion.
+func (s *objectStore) ListExpired(ctx context.Context, bucketID string, expiry LifecycleExpiry, limit int) ([]ObjectRecord, error) {
+	var (
+		conds []string
+		args  = []any{bucketID, expiry.Prefix, expiry.Prefix + KeysetCeiling}
+	)
+
+	if !expiry.CurrentBefore.IsZero() {
+		conds = append(conds, `(o.state = 'COMMITTED' AND o.committed_at < ?)`)
+		args = append(args, expiry.CurrentBefore.UTC().UnixMicro())
+	}
+
+	// A NONCURRENT version was last updated when it was superseded.
+	if !expiry.NoncurrentBefore.IsZero() {
+		conds = append(conds, `(o.state = 'NONCURRENT' AND o.updated_at < ? AND (
+		    SELECT COUNT(*) FROM objects n
+		    WHERE n.bucket_id = o.bucket_id AND n.key = o.key AND n.state = 'NONCURRENT'
+		      AND (n.committed_at > o.committed_at OR (n.committed_at = o.committed_at AND n.object_id > o.object_id))
+		) >= ? AND NOT `+lockedVersion(false)+`)`)
+		args = append(args, expiry.NoncurrentBefore.UTC().UnixMicro(), expiry.NewerNoncurrent, expiry.Now.UTC().UnixMicro())
+	}
+
+	if expiry.DeleteMarkers {
+		conds = append(conds, `(o.state = 'DELETE_MARKER' AND NOT EXISTS (
+		    SELECT 1 FROM objects n
+		    WHERE n.bucket_id = o.bucket_id AND n.key = o.key AND n.state IN `+versionStates+`
+		      AND n.object_id != o.object_id
+		))`)
+	}
+
+	if len(conds) == 0 {
+		return nil, nil
+	}
+
+	tagged := ""
+	for key, value := range expiry.Tags {
+		tagged += `
+  AND EXISTS (SELECT 1 FROM object_tags t WHERE t.object_id = o.object_id AND t.key = ? AND t.value = ?)`
+		args = append(args, key, value)
+	}
+	args = append(args, limit)
+
+	rows, err := s.db.QueryContext(ctx, `
+SELECT `+versionColumns+`
+FROM objects o
+WHERE o.bucket_id = ?
+  AND o.key >= ?
+  AND o.key < ?
+  AND (`+strings.Join(conds, " OR ")+`)`+tagged+`
+ORDER BY o.key, o.committed_at DESC, o.object_id DESC
+LIMIT ?
+`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list expired objects: %w", err)
+	}
+	defer rows.Close()
+
+	versions := make([]ObjectRecord, 0)
+	for rows.Next() {
+		rec, err := scanVersion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan expired object: %w", err)
+		}
+		versions = append(versions, rec)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate expired objects: %w", err)
+	}
+
+	return versions, nil
+}
+
+// defaultRetention gives objectID, which just became current at micros, the
+// default retention of its bucket's object lock configuration, unless it was
+// written with a retention of its own.
+func defaultRetention(ctx context.Context, tx *sql.Tx, bucketID, objectID string, micros int64) error {
+	var doc string
+	err := tx.QueryRowContext(ctx, `SELECT COALESCE(object_lock, '') FROM buckets WHERE id = ?`, bucketID).Scan(&doc)
+	if err != nil {
+		return fmt.Errorf("read bucket object lock: %w", err)
+	}
+	if doc == "" {
+		return nil
+	}
+
+	var lock ObjectLockConfig
+	if err := json.Unmarshal([]byte(doc), &lock); err != nil {
+		return fmt.Errorf("decode bucket object lock: %w", err)
+	}
+	if lock.DefaultMode == "" {
+		return nil
+	}
+
+	retainUntil := lock.DefaultRetainUntil(time.UnixMicro(micros).UTC())
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE objects SET retention_mode = ?, retain_until = ? WHERE object_id = ? AND retention_mode IS NULL
+	`, lock.DefaultMode, retainUntil.UnixMicro(), objectID); err != nil {
+		return fmt.Errorf("apply default retention: %w", err)
+	}
+	return nil
+}
+
+// lockedVersion is a condition on the objects row aliased o that holds while
+// it is under a legal hold or a retention running past its one argument, a
+// time in microseconds. With bypassGovernance only a COMPLIANCE retention
+// counts. A row without a retention has a NULL retain_until, so the condition
+// guards it to stay true or false and safe to negate.
+func lockedVersion(bypassGovernance bool) string {
+	if bypassGovernance {
+		return `(o.legal_hold = 1 OR (COALESCE(o.retention_mode, '') = '` + RetentionCompliance + `' AND o.retain_until > ?))`
+	}
+	return `(o.legal_hold = 1 OR (o.retention_mode IS NOT NULL AND o.retain_until > ?))`
+}
+
+// bucketVersioning reads a bucket's versioning status inside tx, empty when
+// versioning was never enabled.
+func bucketVersioning(ctx context.Context, tx *sql.Tx, bucketID string) (string, error) {
+	var versioning string
+	err := tx.QueryRowContext(ctx, `SELECT COALESCE(versioning, '') FROM buckets WHERE id = ?`, bucketID).Scan(&versioning)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrBucketNotFound
+		}
+		return "", fmt.Errorf("read bucket versioning: %w", err)
+	}
+	return versioning, nil
+}
+
+// supersede makes way inside tx for objectID to become the current version of
+// a key and returns the version ID it gets. Without versioning the current
+// version is retired and the new one has no version ID. With versioning
+// enabled the current version is kept as NONCURRENT and the new one is
+// versioned by its object ID. While versioning is suspended the new version is
+// the null version, so an existing null version is retired rather than kept.
+func supersede(ctx context.Context, tx *sql.Tx, versioning, bucketID, key, objectID string, micros int64) (string, error) {
+	versionID := objectID
+	switch versioning {
+	case VersioningEnabled:
+	case VersioningSuspended:
+		versionID = NullVersionID
+		if _, err := retireVersions(ctx, tx, `bucket_id = ? AND key = ? AND version_id IS NULL AND state IN `+versionStates,
+			micros, false, bucketID, key); err != nil {
+			return "", err
+		}
+	default:
+		_, err := retireCommitted(ctx, tx, bucketID, key, micros)
+		return "", err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE objects
+		SET state = 'NONCURRENT',
+		    updated_at = ?
+		WHERE bucket_id = ?
+		  AND key = ?
+		  AND state = 'COMMITTED'
+	`, micros, bucketID, key); err != nil {
+		return "", fmt.Errorf("keep noncurrent: %w", err)
+	}
+
+	return versionID, nil
+}
+
+// retireCommitted takes the COMMITTED version of a key out of service inside
+// tx, as retireVersions describes.
+func retireCommitted(ctx context.Context, tx *sql.Tx, bucketID, key string, micros int64) (bool, error) {
+	retired, err := retireVersions(ctx, tx, `bucket_id = ? AND key = ? AND state = 'COMMITTED'`, micros, false, bucketID, key)
+	return retired > 0, err
+}
+
+// retireVersions takes the objects rows matching where out of service inside
+// tx and returns how many there were. A single-blob object becomes REPLACED
+// for the cleanup worker. A multipart object has no blob of its own, so its
+// part blobs are queued in blob_deletions and the object goes straight to
+// DELETED, as does a delete marker, which has no blob at all. If any of the
+// rows is under an object lock at micros, as lockedVersion decides given
+// bypassGovernance, nothing changes and ErrObjectLocked is returned.
+func retireVersions(ctx context.Context, tx *sql.Tx, where string, micros int64, bypassGovernance bool, args ...any) (int64, error) {
+	var locked bool
+	if err := tx.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM objects o WHERE `+where+` AND `+lockedVersion(bypassGovernance)+`)
+	`, append(args, micros)...).Scan(&locked); err != nil {
+		return 0, fmt.Errorf("check locks: %w", err)
+	}
+	if locked {
+		return 0, ErrObjectLocked
+	}
+
+	multipart := `p.upload_id IN (
+		SELECT object_id FROM objects
+		WHERE ` + where + ` AND part_count > 0
+	) AND p.state = 'UPLOADED'`
+
+	if _, err := queuePartBlobs(ctx, tx, multipart, micros, args...); err != nil {
+		return 0, fmt.Errorf("queue parts: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE objects
+		SET state = CASE WHEN part_count > 0 OR state = 'DELETE_MARKER' THEN 'DELETED' ELSE 'REPLACED' END,
+		    updated_at = ?
+		WHERE `+where, append([]any{micros}, args...)...)
+	if err != nil {
+		return 0, err
 	}
 
 	rows, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("commit object, rows affected: %w", err)
+		return 0, fmt.Errorf("rows affected: %w", err)
+	}
+
+	return rows, nil
+}
+
+// ReclaimableObject is a REPLACED or FAILED blob along with what a cradle
+// needs to delete it.
+type ReclaimableObject struct {
+	ID            string
+	Bucket        string
+	CradleAddress string
+}
+
+// ListReclaimable returns up to limit REPLACED and FAILED blobs, oldest
+// first. A REPLACED version still under a legal hold or a COMPLIANCE
+// retention at now is never returned: retiring it was a mistake no caller
+// may make, so its blob is kept.
+func (s *objectStore) ListReclaimable(ctx context.Context, now time.Time, limit int) ([]ReclaimableObject, error) {
+	selectReclaimable := `
+SELECT o.object_id, b.name, c.address
+FROM objects o
+JOIN buckets b ON b.id = o.bucket_id
+JOIN cradle_servers c ON c.id = o.cradle_server_id
+WHERE o.state IN ('REPLACED','FAILED')
+  AND NOT (o.state = 'REPLACED' AND ` + lockedVersion(true) + `)
+ORDER BY o.updated_at
+LIMIT ?
+`
+
+	rows, err := s.db.QueryContext(ctx, selectReclaimable, now.UTC().UnixMicro(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("list reclaimable objects: %w", err)
+	}
+	defer rows.Close()
+
+	objects := make([]ReclaimableObject, 0)
+	for rows.Next() {
+		var obj ReclaimableObject
+		if err := rows.Scan(&obj.ID, &obj.Bucket, &obj.CradleAddress); err != nil {
+			return nil, fmt.Errorf("scan reclaimable object: %w", err)
+		}
+		objects = append(objects, obj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate reclaimable objects: %w", err)
+	}
+
+	return objects, nil
+}
+
+// MarkDeleted records that a cradle confirmed a REPLACED or FAILED blob is gone.
+func (s *objectStore) MarkDeleted(ctx context.Context, objectID string, updatedAt time.Time) error {
+	micros := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE objects
+		SET state = 'DELETED',
+		    updated_at = ?
+		WHERE object_id = ?
+		  AND state IN ('REPLACED','FAILED')
+	`, micros, objectID)
+	if err != nil {
+		return fmt.Errorf("mark object deleted: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("mark object deleted, rows affected: %w", err)
 	}
 
 	if rows != 1 {
-		return fmt.Errorf("commit object: %w", ErrObjectNotPending)
+		return fmt.Errorf("mark object deleted: %w", ErrObjectNotReclaimable)
+	}
+
+	return nil
+}
+
+// KeysetCeiling sorts after every byte that can appear in a UTF-8 key, so
+// prefix+KeysetCeiling is an exclusive upper bound for keys under prefix.
+const KeysetCeiling = "\xff"
+
+// ListCommitted returns up to limit COMMITTED objects in a bucket whose keys
+// start with prefix and sort after the after cursor, in key order. It walks
+// (bucket_id, key) so each page is a range scan rather than an OFFSET.
+func (s *objectStore) ListCommitted(ctx context.Context, bucketID, prefix, after string, limit int) ([]ObjectRecord, error) {
+	const selectCommitted = `
+SELECT object_id, bucket_id, key, state, size_expected, size_actual, last_modified, COALESCE(content_type, ''), cradle_server_id, COALESCE(etag, ''), part_count, created_at, updated_at
+FROM objects
+WHERE bucket_id = ?
+  AND state = 'COMMITTED'
+  AND key > ?
+  AND key >= ?
+  AND key < ?
+ORDER BY key
+LIMIT ?
+`
+
+	rows, err := s.db.QueryContext(ctx, selectCommitted, bucketID, after, prefix, prefix+KeysetCeiling, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list committed objects: %w", err)
+	}
+	defer rows.Close()
+
+	objects := make([]ObjectRecord, 0)
+	for rows.Next() {
+		var (
+			rec       ObjectRecord
+			createdAt int64
+			updatedAt int64
+		)
+		if err := rows.Scan(&rec.ID, &rec.BucketID, &rec.Key, &rec.State, &rec.SizeExpected, &rec.SizeActual,
+			&rec.LastModifiedMs, &rec.ContentType, &rec.CradleServerID, &rec.ETag, &rec.PartCount, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("scan committed object: %w", err)
+		}
+		rec.CreatedAt = time.UnixMicro(createdAt).UTC()
+		rec.UpdatedAt = time.UnixMicro(updatedAt).UTC()
+		objects = append(objects, rec)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate committed objects: %w", err)
+	}
+
+	return objects, nil
+}
+
+// ListVersions returns up to limit versions, including delete markers, in a
+// bucket whose keys start with prefix, ordered by key and then newest first.
+// Listing resumes after afterKey, or after the version afterVersionID of
+// afterKey when it is set.
+func (s *objectStore) ListVersions(ctx context.Context, bucketID, prefix, afterKey, afterVersionID string, limit int) ([]ObjectRecord, error) {
+	cursor := `o.key > ?`
+	args := []any{bucketID, prefix, prefix + KeysetCeiling, afterKey}
+	if afterVersionID != "" {
+		match, matchArgs := versionMatch("m", afterVersionID)
+		cursor = `(o.key > ? OR (o.key = ? AND EXISTS (
+		    SELECT 1 FROM objects m
+		    WHERE m.bucket_id = o.bucket_id AND m.key = o.key AND m.state IN ` + versionStates + ` AND ` + match + `
+		      AND (o.committed_at < m.committed_at OR (o.committed_at = m.committed_at AND o.object_id < m.object_id))
+		)))`
+		args = append(args, afterKey)
+		args = append(args, matchArgs...)
+	}
+	args = append(args, limit)
+
+	rows, err := s.db.QueryContext(ctx, `
+SELECT `+versionColumns+`
+FROM objects o
+WHERE o.bucket_id = ?
+  AND o.state IN `+versionStates+`
+  AND o.key >= ?
+  AND o.key < ?
+  AND `+cursor+`
+ORDER BY o.key, o.committed_at DESC, o.object_id DESC
+LIMIT ?
+`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list object versions: %w", err)
+	}
+	defer rows.Close()
+
+	versions := make([]ObjectRecord, 0)
+	for rows.Next() {
+		rec, err := scanVersion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan object version: %w", err)
+		}
+		versions = append(versions, rec)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate object versions: %w", err)
 	}
 
-	return tx.Commit()
+	return versions, nil
 }
@@ -4,6 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"maps"
+	"slices"
 	"testing"
 	"time"
 
@@ -19,6 +22,7 @@ func TestObjectStore_Create(t *testing.T) {
 		bucketID         string
 		key              string
 		sizeExpected     int64
+		contentType      string
 		cradleServerID   string
 		skipBucket       bool
 		skipCradleServer bool
@@ -37,6 +41,15 @@ func TestObjectStore_Create(t *testing.T) {
 			sizeExpected:   1024,
 			cradleServerID: "cradle-id-1",
 		},
+		{
+			name:           "stores content type",
+			id:             "object-id-4",
+			bucketID:       "bucket-id-1",
+			key:            "photos/sunset.jpg",
+			sizeExpected:   4096,
+			contentType:    "image/jpeg",
+			cradleServerID: "cradle-id-1",
+		},
 		{
 			name:             "invalid foreign keys return error",
 			id:               "object-id-2",
@@ -57,7 +70,7 @@ func TestObjectStore_Create(t *testing.T) {
 			cradleServerID: "cradle-id-1",
 			setup: func(ctx context.Context, t *testing.T, s *store.ObjectStore, createdAt time.Time, db *sql.DB, bucketID, cradleServerID string) {
 				// Create first object with same bucket+key
-				_, err := (*s).CreatePending(ctx, "object-id-first", bucketID, "duplicate-key.txt", 1024, cradleServerID, createdAt)
+				_, err := (*s).CreatePending(ctx, "object-id-first", bucketID, "duplicate-key.txt", 1024, "", nil, nil, store.ObjectLock{}, cradleServerID, createdAt)
 				if err != nil {
 					t.Fatalf("setup: create first object: %v", err)
 				}
@@ -83,7 +96,7 @@ func TestObjectStore_Create(t *testing.T) {
 				c.setup(ctx, t, &s, createdAt, db, c.bucketID, c.cradleServerID)
 			}
 
-			rec, err := s.CreatePending(ctx, c.id, c.bucketID, c.key, c.sizeExpected, c.cradleServerID, createdAt)
+			rec, err := s.CreatePending(ctx, c.id, c.bucketID, c.key, c.sizeExpected, c.contentType, nil, nil, store.ObjectLock{}, c.cradleServerID, createdAt)
 
 			if c.wantErr {
 				if err == nil {
@@ -97,10 +110,33 @@ func TestObjectStore_Create(t *testing.T) {
 			}
 
 			assertObjectRecord(t, ctx, db, rec, c.id, c.bucketID, c.key, c.sizeExpected, c.cradleServerID, createdAt)
+
+			if rec.ContentType != c.contentType {
+				t.Fatalf("returned ContentType: got %q, want %q", rec.ContentType, c.contentType)
+			}
+
+			var storedContentType sql.NullString
+			if err := db.QueryRowContext(ctx, `SELECT content_type FROM objects WHERE object_id = ?`, rec.ID).Scan(&storedContentType); err != nil {
+				t.Fatalf("fetch stored content_type: %v", err)
+			}
+			if storedContentType.String != c.contentType || storedContentType.Valid != (c.contentType != "") {
+				t.Fatalf("stored content_type: got %v, want %q", storedContentType, c.contentType)
+			}
 		})
 	}
 }
 
+// objectETag is the hex MD5 flatbed reports for a committed object.
+const objectETag = "9e107d9d372bb6826bd81d3542a419d6"
+
+// objectSHA256 is a base64 x-amz-checksum-sha256 value.
+const objectSHA256 = "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek="
+
+// commitOf builds the ObjectCommit flatbed sends for a plain upload.
+func commitOf(sizeActual, lastModifiedMs int64) store.ObjectCommit {
+	return store.ObjectCommit{SizeActual: sizeActual, LastModifiedMs: lastModifiedMs, ETag: objectETag}
+}
+
 func TestObjectStore_CommitWithReplace(t *testing.T) {
 	t.Parallel()
 
@@ -164,7 +200,7 @@ func TestObjectStore_CommitWithReplace(t *testing.T) {
 			}
 
 			if !c.skipSetup {
-				_, err := s.CreatePending(ctx, objectID, bucketID, "photos/sunset.jpg", 1024, cradleServerID, createdAt)
+				_, err := s.CreatePending(ctx, objectID, bucketID, "photos/sunset.jpg", 1024, "image/jpeg", nil, nil, store.ObjectLock{}, cradleServerID, createdAt)
 				if err != nil {
 					t.Fatalf("setup CreatePending: %v", err)
 				}
@@ -175,7 +211,10 @@ func TestObjectStore_CommitWithReplace(t *testing.T) {
 			}
 
 			updatedAt := time.Now()
-			err := s.CommitWithReplace(ctx, objectID, c.sizeActual, c.lastModifiedMs, updatedAt)
+			commit := commitOf(c.sizeActual, c.lastModifiedMs)
+			commit.ChecksumAlgorithm = "SHA256"
+			commit.Checksum = objectSHA256
+			_, err := s.CommitWithReplace(ctx, objectID, commit, updatedAt)
 
 			if c.wantErr != nil {
 				if err == nil {
@@ -195,11 +234,14 @@ func TestObjectStore_CommitWithReplace(t *testing.T) {
 				storedState        string
 				storedSizeActual   sql.NullInt64
 				storedLastModified sql.NullInt64
+				storedETag         sql.NullString
+				storedAlgorithm    sql.NullString
+				storedChecksum     sql.NullString
 				storedUpdatedAt    int64
 			)
 
-			const q = `SELECT state, size_actual, last_modified, updated_at FROM objects WHERE object_id = ?`
-			if err := db.QueryRowContext(ctx, q, objectID).Scan(&storedState, &storedSizeActual, &storedLastModified, &storedUpdatedAt); err != nil {
+			const q = `SELECT state, size_actual, last_modified, etag, checksum_algorithm, checksum, updated_at FROM objects WHERE object_id = ?`
+			if err := db.QueryRowContext(ctx, q, objectID).Scan(&storedState, &storedSizeActual, &storedLastModified, &storedETag, &storedAlgorithm, &storedChecksum, &storedUpdatedAt); err != nil {
 				t.Fatalf("query committed object: %v", err)
 			}
 
@@ -212,6 +254,15 @@ func TestObjectStore_CommitWithReplace(t *testing.T) {
 			if !storedLastModified.Valid || storedLastModified.Int64 != c.lastModifiedMs {
 				t.Errorf("last_modified: got %v, want %d", storedLastModified, c.lastModifiedMs)
 			}
+			if !storedETag.Valid || storedETag.String != objectETag {
+				t.Errorf("etag: got %v, want %q", storedETag, objectETag)
+			}
+			if !storedAlgorithm.Valid || storedAlgorithm.String != "SHA256" {
+				t.Errorf("checksum_algorithm: got %v, want %q", storedAlgorithm, "SHA256")
+			}
+			if !storedChecksum.Valid || storedChecksum.String != objectSHA256 {
+				t.Errorf("checksum: got %v, want %q", storedChecksum, objectSHA256)
+			}
 
 			wantUpdatedAt := updatedAt.UTC().Truncate(time.Microsecond)
 			gotUpdatedAt := time.UnixMicro(storedUpdatedAt).UTC()
@@ -246,13 +297,1408 @@ func TestObjectStore_CommitWithReplace(t *testing.T) {
 	}
 }
 
+func TestObjectStore_CommitIfAbsent(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name          string
+		existing      bool
+		wantErr       error
+		wantState     string
+		wantPrior     string
+		wantReclaimed bool
+	}
+
+	cases := []tc{
+		{
+			name:      "commits when the key is free",
+			wantState: "COMMITTED",
+		},
+		{
+			name:          "existing key fails the new object",
+			existing:      true,
+			wantErr:       store.ErrObjectExists,
+			wantState:     "FAILED",
+			wantPrior:     "COMMITTED",
+			wantReclaimed: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			s := store.NewObjectStore(db)
+
+			createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+			bucketID := "bucket-id-if-absent"
+			cradleServerID := "cradle-id-if-absent"
+			objectID := "object-id-if-absent"
+			priorObjectID := "object-id-prior"
+
+			setupPrerequisites(ctx, t, db, bucketID, cradleServerID, createdAt, false, false)
+
+			if c.existing {
+				insertCommittedObject(ctx, t, db, priorObjectID, bucketID, "photos/sunset.jpg", cradleServerID, createdAt)
+			}
+			if _, err := s.CreatePending(ctx, objectID, bucketID, "photos/sunset.jpg", 1024, "", nil, nil, store.ObjectLock{}, cradleServerID, createdAt); err != nil {
+				t.Fatalf("setup CreatePending: %v", err)
+			}
+
+			_, err := s.CommitIfAbsent(ctx, objectID, commitOf(1024, 1735689600000), time.Now())
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("CommitIfAbsent error: got %v, want %v", err, c.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("CommitIfAbsent: unexpected error: %v", err)
+			}
+
+			var state string
+			if err := db.QueryRowContext(ctx, `SELECT state FROM objects WHERE object_id = ?`, objectID).Scan(&state); err != nil {
+				t.Fatalf("query object: %v", err)
+			}
+			if state != c.wantState {
+				t.Errorf("state: got %q, want %q", state, c.wantState)
+			}
+
+			if c.wantPrior != "" {
+				var priorState string
+				if err := db.QueryRowContext(ctx, `SELECT state FROM objects WHERE object_id = ?`, priorObjectID).Scan(&priorState); err != nil {
+					t.Fatalf("query prior object: %v", err)
+				}
+				if priorState != c.wantPrior {
+					t.Errorf("prior object state: got %q, want %q", priorState, c.wantPrior)
+				}
+			}
+
+			reclaimable, err := s.ListReclaimable(ctx, time.Now(), 10)
+			if err != nil {
+				t.Fatalf("ListReclaimable: %v", err)
+			}
+			if got := len(reclaimable) == 1 && reclaimable[0].ID == objectID; got != c.wantReclaimed {
+				t.Errorf("reclaimable: got %+v, want failed object reclaimable = %v", reclaimable, c.wantReclaimed)
+			}
+		})
+	}
+}
+
+func TestObjectStore_GetCommitted(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name                  string
+		key                   string
+		seed                  func(context.Context, *testing.T, *sql.DB, string, string, time.Time)
+		wantID                string
+		wantContentType       string
+		wantETag              string
+		wantChecksumAlgorithm string
+		wantChecksum          string
+		wantMetadata          map[string]string
+		wantTags              map[string]string
+		wantErr               error
+	}
+
+	cases := []tc{
+		{
+			name: "returns COMMITTED object",
+			key:  "photos/sunset.jpg",
+			seed: func(ctx context.Context, t *testing.T, db *sql.DB, bucketID, cradleServerID string, createdAt time.Time) {
+				insertCommittedObject(ctx, t, db, "object-id-committed", bucketID, "photos/sunset.jpg", cradleServerID, createdAt)
+			},
+			wantID: "object-id-committed",
+		},
+		{
+			name: "returns stored content type",
+			key:  "photos/typed.jpg",
+			seed: func(ctx context.Context, t *testing.T, db *sql.DB, bucketID, cradleServerID string, createdAt time.Time) {
+				objects := store.NewObjectStore(db)
+				if _, err := objects.CreatePending(ctx, "object-id-typed", bucketID, "photos/typed.jpg", 1024, "image/jpeg", nil, nil, store.ObjectLock{}, cradleServerID, createdAt); err != nil {
+					t.Fatalf("seed CreatePending: %v", err)
+				}
+				if _, err := objects.CommitWithReplace(ctx, "object-id-typed", commitOf(1024, createdAt.UnixMicro()), createdAt); err != nil {
+					t.Fatalf("seed CommitWithReplace: %v", err)
+				}
+			},
+			wantID:          "object-id-typed",
+			wantContentType: "image/jpeg",
+			wantETag:        objectETag,
+		},
+		{
+			name: "returns stored checksum",
+			key:  "photos/checked.jpg",
+			seed: func(ctx context.Context, t *testing.T, db *sql.DB, bucketID, cradleServerID string, createdAt time.Time) {
+				objects := store.NewObjectStore(db)
+				if _, err := objects.CreatePending(ctx, "object-id-checked", bucketID, "photos/checked.jpg", 1024, "", nil, nil, store.ObjectLock{}, cradleServerID, createdAt); err != nil {
+					t.Fatalf("seed CreatePending: %v", err)
+				}
+				commit := commitOf(1024, createdAt.UnixMicro())
+				commit.ChecksumAlgorithm = "SHA256"
+				commit.Checksum = objectSHA256
+				if _, err := objects.CommitWithReplace(ctx, "object-id-checked", commit, createdAt); err != nil {
+					t.Fatalf("seed CommitWithReplace: %v", err)
+				}
+			},
+			wantID:                "object-id-checked",
+			wantETag:              objectETag,
+			wantChecksumAlgorithm: "SHA256",
+			wantChecksum:          objectSHA256,
+		},
+		{
+			name: "returns stored metadata",
+			key:  "photos/described.jpg",
+			seed: func(ctx context.Context, t *testing.T, db *sql.DB, bucketID, cradleServerID string, createdAt time.Time) {
+				objects := store.NewObjectStore(db)
+				metadata := map[string]string{"cache-control": "max-age=60", "x-amz-meta-color": "blue"}
+				if _, err := objects.CreatePending(ctx, "object-id-described", bucketID, "photos/described.jpg", 1024, "", metadata, nil, store.ObjectLock{}, cradleServerID, createdAt); err != nil {
+					t.Fatalf("seed CreatePending: %v", err)
+				}
+				if _, err := objects.CommitWithReplace(ctx, "object-id-described", commitOf(1024, createdAt.UnixMicro()), createdAt); err != nil {
+					t.Fatalf("seed CommitWithReplace: %v", err)
+				}
+			},
+			wantID:       "object-id-described",
+			wantETag:     objectETag,
+			wantMetadata: map[string]string{"cache-control": "max-age=60", "x-amz-meta-color": "blue"},
+		},
+		{
+			name: "returns stored tags",
+			key:  "photos/tagged.jpg",
+			seed: func(ctx context.Context, t *testing.T, db *sql.DB, bucketID, cradleServerID string, createdAt time.Time) {
+				objects := store.NewObjectStore(db)
+				tags := map[string]string{"host": "web-1", "kind": "backup"}
+				if _, err := objects.CreatePending(ctx, "object-id-tagged", bucketID, "photos/tagged.jpg", 1024, "", nil, tags, store.ObjectLock{}, cradleServerID, createdAt); err != nil {
+					t.Fatalf("seed CreatePending: %v", err)
+				}
+				if _, err := objects.CommitWithReplace(ctx, "object-id-tagged", commitOf(1024, createdAt.UnixMicro()), createdAt); err != nil {
+					t.Fatalf("seed CommitWithReplace: %v", err)
+				}
+			},
+			wantID:   "object-id-tagged",
+			wantETag: objectETag,
+			wantTags: map[string]string{"host": "web-1", "kind": "backup"},
+		},
+		{
+			name:    "missing key returns ErrObjectNotFound",
+			key:     "photos/missing.jpg",
+			wantErr: store.ErrObjectNotFound,
+		},
+		{
+			name: "PENDING object is not returned",
+			key:  "photos/pending.jpg",
+			seed: func(ctx context.Context, t *testing.T, db *sql.DB, bucketID, cradleServerID string, createdAt time.Time) {
+				if _, err := store.NewObjectStore(db).CreatePending(ctx, "object-id-pending", bucketID, "photos/pending.jpg", 1024, "", nil, nil, store.ObjectLock{}, cradleServerID, createdAt); err != nil {
+					t.Fatalf("seed CreatePending: %v", err)
+				}
+			},
+			wantErr: store.ErrObjectNotFound,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			s := store.NewObjectStore(db)
+
+			createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+			bucketID := "bucket-id-get"
+			cradleServerID := "cradle-id-get"
+
+			setupPrerequisites(ctx, t, db, bucketID, cradleServerID, createdAt, false, false)
+
+			if c.seed != nil {
+				c.seed(ctx, t, db, bucketID, cradleServerID, createdAt)
+			}
+
+			rec, err := s.GetCommitted(ctx, bucketID, c.key)
+
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("GetCommitted error: got %v, want %v", err, c.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("GetCommitted: unexpected error: %v", err)
+			}
+
+			if rec.ID != c.wantID {
+				t.Errorf("ID: got %q, want %q", rec.ID, c.wantID)
+			}
+			if rec.State != "COMMITTED" {
+				t.Errorf("State: got %q, want %q", rec.State, "COMMITTED")
+			}
+			if rec.SizeActual != 1024 {
+				t.Errorf("SizeActual: got %d, want %d", rec.SizeActual, 1024)
+			}
+			if rec.LastModifiedMs != createdAt.UnixMicro() {
+				t.Errorf("LastModifiedMs: got %d, want %d", rec.LastModifiedMs, createdAt.UnixMicro())
+			}
+			if rec.ContentType != c.wantContentType {
+				t.Errorf("ContentType: got %q, want %q", rec.ContentType, c.wantContentType)
+			}
+			if rec.ETag != c.wantETag {
+				t.Errorf("ETag: got %q, want %q", rec.ETag, c.wantETag)
+			}
+			if rec.ChecksumAlgorithm != c.wantChecksumAlgorithm {
+				t.Errorf("ChecksumAlgorithm: got %q, want %q", rec.ChecksumAlgorithm, c.wantChecksumAlgorithm)
+			}
+			if rec.Checksum != c.wantChecksum {
+				t.Errorf("Checksum: got %q, want %q", rec.Checksum, c.wantChecksum)
+			}
+			if !maps.Equal(rec.Metadata, c.wantMetadata) {
+				t.Errorf("Metadata: got %v, want %v", rec.Metadata, c.wantMetadata)
+			}
+			if !maps.Equal(rec.Tags, c.wantTags) {
+				t.Errorf("Tags: got %v, want %v", rec.Tags, c.wantTags)
+			}
+			if rec.CradleServerID != cradleServerID {
+				t.Errorf("CradleServerID: got %q, want %q", rec.CradleServerID, cradleServerID)
+			}
+			if !rec.CreatedAt.Equal(createdAt) {
+				t.Errorf("CreatedAt: got %s, want %s", rec.CreatedAt, createdAt)
+			}
+		})
+	}
+}
+
+func TestObjectStore_SetTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	objects := store.NewObjectStore(db)
+
+	createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	bucketID := "bucket-id-tags"
+	cradleServerID := "cradle-id-tags"
+	setupPrerequisites(ctx, t, db, bucketID, cradleServerID, createdAt, false, false)
+	if err := store.NewBucketStore(db).SetVersioning(ctx, bucketID, store.VersioningEnabled, createdAt); err != nil {
+		t.Fatalf("SetVersioning: %v", err)
+	}
+
+	for i, objectID := range []string{"object-id-old", "object-id-new"} {
+		at := createdAt.Add(time.Duration(i) * time.Second)
+		if _, err := objects.CreatePending(ctx, objectID, bucketID, "backups/db.tar", 1024, "", nil, map[string]string{"host": "web-1"}, store.ObjectLock{}, cradleServerID, at); err != nil {
+			t.Fatalf("CreatePending %s: %v", objectID, err)
+		}
+		if _, err := objects.CommitWithReplace(ctx, objectID, commitOf(1024, at.UnixMilli()), at); err != nil {
+			t.Fatalf("CommitWithReplace %s: %v", objectID, err)
+		}
+	}
+
+	versionID, err := objects.SetTags(ctx, bucketID, "backups/db.tar", "", map[string]string{"host": "web-2", "kind": "nightly"})
+	if err != nil {
+		t.Fatalf("SetTags current: %v", err)
+	}
+	if versionID != "object-id-new" {
+		t.Fatalf("SetTags current: version %q, want object-id-new", versionID)
+	}
+	if _, err := objects.SetTags(ctx, bucketID, "backups/db.tar", "object-id-old", nil); err != nil {
+		t.Fatalf("SetTags old version: %v", err)
+	}
+
+	current, err := objects.GetCommitted(ctx, bucketID, "backups/db.tar")
+	if err != nil {
+		t.Fatalf("GetCommitted: %v", err)
+	}
+	if want := map[string]string{"host": "web-2", "kind": "nightly"}; !maps.Equal(current.Tags, want) {
+		t.Fatalf("current tags: got %v, want %v", current.Tags, want)
+	}
+	old, err := objects.GetVersion(ctx, bucketID, "backups/db.tar", "object-id-old")
+	if err != nil {
+		t.Fatalf("GetVersion: %v", err)
+	}
+	if old.Tags != nil {
+		t.Fatalf("old version tags: got %v, want none", old.Tags)
+	}
+
+	if _, err := objects.SetTags(ctx, bucketID, "backups/missing.tar", "", nil); !errors.Is(err, store.ErrObjectNotFound) {
+		t.Fatalf("SetTags missing key: got %v, want ErrObjectNotFound", err)
+	}
+	if _, err := objects.DeleteCurrent(ctx, bucketID, "backups/db.tar", "marker-id", createdAt.Add(time.Minute)); err != nil {
+		t.Fatalf("DeleteCurrent: %v", err)
+	}
+	if _, err := objects.SetTags(ctx, bucketID, "backups/db.tar", "", nil); !errors.Is(err, store.ErrObjectNotFound) {
+		t.Fatalf("SetTags deleted key: got %v, want ErrObjectNotFound", err)
+	}
+	if _, err := objects.SetTags(ctx, bucketID, "backups/db.tar", "marker-id", nil); !errors.Is(err, store.ErrObjectIsDeleteMarker) {
+		t.Fatalf("SetTags delete marker: got %v, want ErrObjectIsDeleteMarker", err)
+	}
+}
+
+func TestObjectStore_ObjectLock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	objects := store.NewObjectStore(db)
+
+	createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	bucketID := "bucket-id-lock"
+	cradleServerID := "cradle-id-lock"
+	setupPrerequisites(ctx, t, db, bucketID, cradleServerID, createdAt, false, false)
+	if err := store.NewBucketStore(db).SetObjectLock(ctx, bucketID, store.ObjectLockConfig{DefaultMode: store.RetentionGovernance, DefaultDays: 90}, createdAt); err != nil {
+		t.Fatalf("SetObjectLock: %v", err)
+	}
+
+	compliance := store.ObjectLock{Mode: store.RetentionCompliance, RetainUntil: createdAt.AddDate(1, 0, 0)}
+	for i, v := range []struct {
+		objectID string
+		lock     store.ObjectLock
+	}{
+		{"object-id-default", store.ObjectLock{}},
+		{"object-id-compliance", compliance},
+		{"object-id-held", store.ObjectLock{LegalHold: true}},
+	} {
+		at := createdAt.Add(time.Duration(i) * time.Second)
+		if _, err := objects.CreatePending(ctx, v.objectID, bucketID, "backups/db.tar", 1024, "", nil, nil, v.lock, cradleServerID, at); err != nil {
+			t.Fatalf("CreatePending %s: %v", v.objectID, err)
+		}
+		if _, err := objects.CommitWithReplace(ctx, v.objectID, commitOf(1024, at.UnixMilli()), at); err != nil {
+			t.Fatalf("CommitWithReplace %s: %v", v.objectID, err)
+		}
+	}
+
+	rec, err := objects.GetVersion(ctx, bucketID, "backups/db.tar", "object-id-default")
+	if err != nil {
+		t.Fatalf("GetVersion: %v", err)
+	}
+	if want := (store.ObjectLock{Mode: store.RetentionGovernance, RetainUntil: createdAt.AddDate(0, 0, 90)}); rec.Lock != want {
+		t.Fatalf("default retention: got %+v, want %+v", rec.Lock, want)
+	}
+	rec, err = objects.GetCommitted(ctx, bucketID, "backups/db.tar")
+	if err != nil {
+		t.Fatalf("GetCommitted: %v", err)
+	}
+	if want := (store.ObjectLock{Mode: store.RetentionGovernance, RetainUntil: createdAt.Add(2*time.Second).AddDate(0, 0, 90), LegalHold: true}); rec.Lock != want {
+		t.Fatalf("held version lock: got %+v, want %+v", rec.Lock, want)
+	}
+
+	now := createdAt.Add(time.Hour)
+
+	t.Run("retention", func(t *testing.T) {
+		if _, err := objects.SetRetention(ctx, bucketID, "backups/db.tar", "object-id-compliance", store.RetentionGovernance, compliance.RetainUntil, true, now); !errors.Is(err, store.ErrObjectLocked) {
+			t.Fatalf("weaken COMPLIANCE: got %v, want ErrObjectLocked", err)
+		}
+		if _, err := objects.SetRetention(ctx, bucketID, "backups/db.tar", "object-id-compliance", store.RetentionCompliance, createdAt.AddDate(0, 1, 0), true, now); !errors.Is(err, store.ErrObjectLocked) {
+			t.Fatalf("shorten COMPLIANCE: got %v, want ErrObjectLocked", err)
+		}
+		if _, err := objects.SetRetention(ctx, bucketID, "backups/db.tar", "object-id-compliance", store.RetentionCompliance, createdAt.AddDate(2, 0, 0), false, now); err != nil {
+			t.Fatalf("extend COMPLIANCE: %v", err)
+		}
+		if _, err := objects.SetRetention(ctx, bucketID, "backups/db.tar", "object-id-default", "", time.Time{}, false, now); !errors.Is(err, store.ErrObjectLocked) {
+			t.Fatalf("remove GOVERNANCE: got %v, want ErrObjectLocked", err)
+		}
+		versionID, err := objects.SetRetention(ctx, bucketID, "backups/db.tar", "object-id-default", store.RetentionGovernance, createdAt.AddDate(0, 0, 1), true, now)
+		if err != nil {
+			t.Fatalf("shorten GOVERNANCE with bypass: %v", err)
+		}
+		if versionID != "object-id-default" {
+			t.Fatalf("SetRetention: version %q, want object-id-default", versionID)
+		}
+		if _, err := objects.SetRetention(ctx, bucketID, "backups/db.tar", "object-id-default", "", time.Time{}, false, createdAt.AddDate(0, 0, 2)); err != nil {
+			t.Fatalf("remove expired retention: %v", err)
+		}
+		rec, err := objects.GetVersion(ctx, bucketID, "backups/db.tar", "object-id-default")
+		if err != nil {
+			t.Fatalf("GetVersion: %v", err)
+		}
+		if rec.Lock != (store.ObjectLock{}) {
+			t.Fatalf("removed retention: got %+v, want none", rec.Lock)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if _, err := objects.DeleteVersion(ctx, bucketID, "backups/db.tar", "object-id-compliance", true, now); !errors.Is(err, store.ErrObjectLocked) {
+			t.Fatalf("delete COMPLIANCE version: got %v, want ErrObjectLocked", err)
+		}
+		if _, err := objects.DeleteVersion(ctx, bucketID, "backups/db.tar", "object-id-held", true, now); !errors.Is(err, store.ErrObjectLocked) {
+			t.Fatalf("delete held version: got %v, want ErrObjectLocked", err)
+		}
+
+		deletions, err := objects.DeleteMany(ctx, bucketID, []store.ObjectDelete{
+			{Key: "backups/db.tar", VersionID: "object-id-held"},
+			{Key: "backups/db.tar", VersionID: "object-id-default"},
+		}, now)
+		if err != nil {
+			t.Fatalf("DeleteMany: %v", err)
+		}
+		want := []store.ObjectDeletion{{VersionID: "object-id-held", Locked: true}, {VersionID: "object-id-default", Deleted: true}}
+		if !slices.Equal(deletions, want) {
+			t.Fatalf("DeleteMany: got %+v, want %+v", deletions, want)
+		}
+
+		if _, err := objects.SetLegalHold(ctx, bucketID, "backups/db.tar", "", false); err != nil {
+			t.Fatalf("SetLegalHold off: %v", err)
+		}
+		if _, err := objects.DeleteVersion(ctx, bucketID, "backups/db.tar", "object-id-held", false, now); !errors.Is(err, store.ErrObjectLocked) {
+			t.Fatalf("delete GOVERNANCE version: got %v, want ErrObjectLocked", err)
+		}
+		deletion, err := objects.DeleteVersion(ctx, bucketID, "backups/db.tar", "object-id-held", true, now)
+		if err != nil {
+			t.Fatalf("delete GOVERNANCE version with bypass: %v", err)
+		}
+		if !deletion.Deleted {
+			t.Fatalf("delete GOVERNANCE version with bypass: got %+v, want deleted", deletion)
+		}
+
+		for id, want := range map[string]string{"object-id-compliance": "COMMITTED", "object-id-held": "REPLACED", "object-id-default": "REPLACED"} {
+			if got := objectState(ctx, t, db, id); got != want {
+				t.Errorf("state of %s: got %q, want %q", id, got, want)
+			}
+		}
+	})
+
+	t.Run("lifecycle", func(t *testing.T) {
+		if _, err := objects.CreatePending(ctx, "object-id-newest", bucketID, "backups/db.tar", 1024, "", nil, nil, store.ObjectLock{}, cradleServerID, now); err != nil {
+			t.Fatalf("CreatePending: %v", err)
+		}
+		if _, err := objects.CommitWithReplace(ctx, "object-id-newest", commitOf(1024, now.UnixMilli()), now); err != nil {
+			t.Fatalf("CommitWithReplace: %v", err)
+		}
+
+		expiry := store.LifecycleExpiry{Prefix: "backups/", NoncurrentBefore: now.AddDate(1, 0, 0), Now: now}
+		expired, err := objects.ListExpired(ctx, bucketID, expiry, 10)
+		if err != nil {
+			t.Fatalf("ListExpired: %v", err)
+		}
+		if len(expired) != 0 {
+			t.Fatalf("ListExpired while locked: got %+v, want none", expired)
+		}
+
+		expiry.Now = createdAt.AddDate(3, 0, 0)
+		expired, err = objects.ListExpired(ctx, bucketID, expiry, 10)
+		if err != nil {
+			t.Fatalf("ListExpired: %v", err)
+		}
+		if len(expired) != 1 || expired[0].ID != "object-id-compliance" {
+			t.Fatalf("ListExpired after retention: got %+v, want object-id-compliance", expired)
+		}
+	})
+}
+
+func TestObjectStore_CommitOverLockedVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	objects := store.NewObjectStore(db)
+
+	createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	bucketID := "bucket-id-lock-suspended"
+	cradleServerID := "cradle-id-lock-suspended"
+	setupPrerequisites(ctx, t, db, bucketID, cradleServerID, createdAt, false, false)
+	if err := store.NewBucketStore(db).SetVersioning(ctx, bucketID, store.VersioningSuspended, createdAt); err != nil {
+		t.Fatalf("SetVersioning: %v", err)
+	}
+
+	if _, err := objects.CreatePending(ctx, "object-id-held", bucketID, "backups/db.tar", 1024, "", nil, nil, store.ObjectLock{LegalHold: true}, cradleServerID, createdAt); err != nil {
+		t.Fatalf("CreatePending: %v", err)
+	}
+	if _, err := objects.CommitWithReplace(ctx, "object-id-held", commitOf(1024, createdAt.UnixMilli()), createdAt); err != nil {
+		t.Fatalf("CommitWithReplace: %v", err)
+	}
+
+	later := createdAt.Add(time.Minute)
+	if _, err := objects.CreatePending(ctx, "object-id-replacement", bucketID, "backups/db.tar", 1024, "", nil, nil, store.ObjectLock{}, cradleServerID, later); err != nil {
+		t.Fatalf("CreatePending: %v", err)
+	}
+	if _, err := objects.CommitWithReplace(ctx, "object-id-replacement", commitOf(1024, later.UnixMilli()), later); !errors.Is(err, store.ErrObjectLocked) {
+		t.Fatalf("CommitWithReplace over held null version: got %v, want ErrObjectLocked", err)
+	}
+
+	for id, want := range map[string]string{"object-id-held": "COMMITTED", "object-id-replacement": "FAILED"} {
+		if got := objectState(ctx, t, db, id); got != want {
+			t.Errorf("state of %s: got %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestObjectStore_DeleteCurrent(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name        string
+		key         string
+		seed        func(context.Context, *testing.T, *sql.DB, string, string, time.Time)
+		wantRetired bool
+		wantStates  map[string]string
+		wantQueued  []string
+	}
+
+	cases := []tc{
+		{
+			name: "moves COMMITTED object to REPLACED",
+			key:  "photos/sunset.jpg",
+			seed: func(ctx context.Context, t *testing.T, db *sql.DB, bucketID, cradleServerID string, createdAt time.Time) {
+				insertCommittedObject(ctx, t, db, "object-id-committed", bucketID, "photos/sunset.jpg", cradleServerID, createdAt)
+			},
+			wantRetired: true,
+			wantStates:  map[string]string{"object-id-committed": "REPLACED"},
+		},
+		{
+			name: "moves multipart object to DELETED and queues its parts",
+			key:  "videos/big.mp4",
+			seed: func(ctx context.Context, t *testing.T, db *sql.DB, bucketID, cradleServerID string, createdAt time.Time) {
+				insertUpload(ctx, t, db, "upload-id-committed", bucketID, "videos/big.mp4", "COMPLETED", createdAt)
+				insertUploadedPart(ctx, t, db, "blob-id-1", "upload-id-committed", 1, cradleServerID, createdAt)
+				insertUploadedPart(ctx, t, db, "blob-id-2", "upload-id-committed", 2, cradleServerID, createdAt)
+				insertMultipartObject(ctx, t, db, "upload-id-committed", bucketID, "videos/big.mp4", 2, cradleServerID, createdAt)
+			},
+			wantRetired: true,
+			wantStates:  map[string]string{"upload-id-committed": "DELETED"},
+			wantQueued:  []string{"blob-id-1", "blob-id-2"},
+		},
+		{
+			name:        "missing key is not an error",
+			key:         "photos/missing.jpg",
+			wantRetired: false,
+		},
+		{
+			name: "PENDING object is left alone",
+			key:  "photos/pending.jpg",
+			seed: func(ctx context.Context, t *testing.T, db *sql.DB, bucketID, cradleServerID string, createdAt time.Time) {
+				insertObjectWithState(ctx, t, db, "object-id-pending", bucketID, "photos/pending.jpg", "PENDING", cradleServerID, createdAt)
+			},
+			wantRetired: false,
+			wantStates:  map[string]string{"object-id-pending": "PENDING"},
+		},
+		{
+			name: "other keys are left alone",
+			key:  "photos/sunset.jpg",
+			seed: func(ctx context.Context, t *testing.T, db *sql.DB, bucketID, cradleServerID string, createdAt time.Time) {
+				insertCommittedObject(ctx, t, db, "object-id-target", bucketID, "photos/sunset.jpg", cradleServerID, createdAt)
+				insertCommittedObject(ctx, t, db, "object-id-other", bucketID, "photos/sunrise.jpg", cradleServerID, createdAt)
+			},
+			wantRetired: true,
+			wantStates: map[string]string{
+				"object-id-target": "REPLACED",
+				"object-id-other":  "COMMITTED",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			s := store.NewObjectStore(db)
+
+			createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+			bucketID := "bucket-id-retire"
+			cradleServerID := "cradle-id-retire"
+
+			setupPrerequisites(ctx, t, db, bucketID, cradleServerID, createdAt, false, false)
+
+			if c.seed != nil {
+				c.seed(ctx, t, db, bucketID, cradleServerID, createdAt)
+			}
+
+			deletion, err := s.DeleteCurrent(ctx, bucketID, c.key, "marker-id", createdAt.Add(time.Minute))
+			if err != nil {
+				t.Fatalf("DeleteCurrent: unexpected error: %v", err)
+			}
+			if want := (store.ObjectDeletion{Deleted: c.wantRetired}); deletion != want {
+				t.Fatalf("DeleteCurrent: got %+v, want %+v", deletion, want)
+			}
+			var markers int
+			if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM objects WHERE state = 'DELETE_MARKER'`).Scan(&markers); err != nil {
+				t.Fatalf("count delete markers: %v", err)
+			}
+			if markers != 0 {
+				t.Errorf("delete markers: got %d, want none without versioning", markers)
+			}
+
+			for id, want := range c.wantStates {
+				if got := objectState(ctx, t, db, id); got != want {
+					t.Errorf("state of %s: got %q, want %q", id, got, want)
+				}
+			}
+
+			if got := queuedBlobs(ctx, t, db); !slices.Equal(got, c.wantQueued) {
+				t.Errorf("queued blobs: got %v, want %v", got, c.wantQueued)
+			}
+		})
+	}
+}
+
+func TestObjectStore_DeleteMany(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	s := store.NewObjectStore(db)
+
+	createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	bucketID := "bucket-id-many"
+	cradleServerID := "cradle-id-many"
+
+	setupPrerequisites(ctx, t, db, bucketID, cradleServerID, createdAt, false, false)
+	insertCommittedObject(ctx, t, db, "object-id-a", bucketID, "backups/a.tar", cradleServerID, createdAt)
+	insertCommittedObject(ctx, t, db, "object-id-b", bucketID, "backups/b.tar", cradleServerID, createdAt)
+	insertCommittedObject(ctx, t, db, "object-id-c", bucketID, "backups/c.tar", cradleServerID, createdAt)
+
+	deletions, err := s.DeleteMany(ctx, bucketID, []store.ObjectDelete{
+		{Key: "backups/a.tar", MarkerID: "marker-a"},
+		{Key: "backups/missing.tar", MarkerID: "marker-missing"},
+		{Key: "backups/b.tar", MarkerID: "marker-b"},
+	}, createdAt.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("DeleteMany: unexpected error: %v", err)
+	}
+	want := []store.ObjectDeletion{{Deleted: true}, {}, {Deleted: true}}
+	if !slices.Equal(deletions, want) {
+		t.Fatalf("DeleteMany: got %+v, want %+v", deletions, want)
+	}
+
+	for id, want := range map[string]string{"object-id-a": "REPLACED", "object-id-b": "REPLACED", "object-id-c": "COMMITTED"} {
+		if got := objectState(ctx, t, db, id); got != want {
+			t.Errorf("state of %s: got %q, want %q", id, got, want)
+		}
+	}
+
+	if _, err := s.DeleteMany(ctx, "bucket-id-missing", []store.ObjectDelete{{Key: "backups/c.tar"}}, createdAt); !errors.Is(err, store.ErrBucketNotFound) {
+		t.Fatalf("DeleteMany in missing bucket: got %v, want ErrBucketNotFound", err)
+	}
+}
+
+func TestObjectStore_Versioning(t *testing.T) {
+	t.Parallel()
+
+	const key = "docs/report.txt"
+	createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	type fixture struct {
+		ctx      context.Context
+		db       *sql.DB
+		objects  store.ObjectStore
+		bucketID string
+		tick     int
+	}
+
+	setVersioning := func(t *testing.T, f *fixture, versioning string) {
+		t.Helper()
+		if err := store.NewBucketStore(f.db).SetVersioning(f.ctx, f.bucketID, versioning, createdAt); err != nil {
+			t.Fatalf("SetVersioning: %v", err)
+		}
+	}
+
+	setup := func(t *testing.T, versioning string) *fixture {
+		t.Helper()
+		f := &fixture{ctx: context.Background(), db: openIsolatedDB(t), bucketID: "bucket-id-versions"}
+		f.objects = store.NewObjectStore(f.db)
+		setupPrerequisites(f.ctx, t, f.db, f.bucketID, "cradle-id-versions", createdAt, false, false)
+		if versioning != "" {
+			setVersioning(t, f, versioning)
+		}
+		return f
+	}
+
+	// now advances a second per call so versions are ordered.
+	now := func(f *fixture) time.Time {
+		f.tick++
+		return createdAt.Add(time.Duration(f.tick) * time.Second)
+	}
+
+	put := func(t *testing.T, f *fixture, objectID string) string {
+		t.Helper()
+		at := now(f)
+		if _, err := f.objects.CreatePending(f.ctx, objectID, f.bucketID, key, 1024, "", nil, nil, store.ObjectLock{}, "cradle-id-versions", at); err != nil {
+			t.Fatalf("CreatePending %s: %v", objectID, err)
+		}
+		versionID, err := f.objects.CommitWithReplace(f.ctx, objectID, commitOf(1024, at.UnixMilli()), at)
+		if err != nil {
+			t.Fatalf("CommitWithReplace %s: %v", objectID, err)
+		}
+		return versionID
+	}
+
+	listed := func(t *testing.T, f *fixture, afterKey, afterVersionID string, limit int) []string {
+		t.Helper()
+		versions, err := f.objects.ListVersions(f.ctx, f.bucketID, "", afterKey, afterVersionID, limit)
+		if err != nil {
+			t.Fatalf("ListVersions: %v", err)
+		}
+		got := make([]string, 0, len(versions))
+		for _, v := range versions {
+			entry := v.VersionID
+			if v.IsLatest {
+				entry += "*"
+			}
+			got = append(got, entry)
+		}
+		return got
+	}
+
+	current := func(t *testing.T, f *fixture) string {
+		t.Helper()
+		rec, err := f.objects.GetCommitted(f.ctx, f.bucketID, key)
+		if errors.Is(err, store.ErrObjectNotFound) {
+			return ""
+		}
+		if err != nil {
+			t.Fatalf("GetCommitted: %v", err)
+		}
+		return rec.ID
+	}
+
+	t.Run("enabled keeps replaced versions", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, store.VersioningEnabled)
+
+		if got := put(t, f, "object-a"); got != "object-a" {
+			t.Fatalf("version of object-a: got %q", got)
+		}
+		put(t, f, "object-b")
+
+		if got := current(t, f); got != "object-b" {
+			t.Fatalf("current: got %q, want object-b", got)
+		}
+		if got := objectState(f.ctx, t, f.db, "object-a"); got != "NONCURRENT" {
+			t.Fatalf("state of object-a: got %q, want NONCURRENT", got)
+		}
+		if got := listed(t, f, "", "", 10); !slices.Equal(got, []string{"object-b*", "object-a"}) {
+			t.Fatalf("versions: got %v", got)
+		}
+
+		old, err := f.objects.GetVersion(f.ctx, f.bucketID, key, "object-a")
+		if err != nil {
+			t.Fatalf("GetVersion: %v", err)
+		}
+		if old.ID != "object-a" || old.IsLatest || old.CradleServerID != "cradle-id-versions" {
+			t.Fatalf("GetVersion: got %+v", old)
+		}
+		if got := queuedBlobs(f.ctx, t, f.db); len(got) != 0 {
+			t.Fatalf("queued blobs: got %v, want none", got)
+		}
+	})
+
+	t.Run("delete marker hides the key until it is deleted", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, store.VersioningEnabled)
+		put(t, f, "object-a")
+
+		deletion, err := f.objects.DeleteCurrent(f.ctx, f.bucketID, key, "marker-1", now(f))
+		if err != nil {
+			t.Fatalf("DeleteCurrent: %v", err)
+		}
+		if want := (store.ObjectDeletion{VersionID: "marker-1", DeleteMarker: true, Deleted: true}); deletion != want {
+			t.Fatalf("DeleteCurrent: got %+v, want %+v", deletion, want)
+		}
+		if got := current(t, f); got != "" {
+			t.Fatalf("current after delete: got %q, want none", got)
+		}
+		marker, err := f.objects.GetVersion(f.ctx, f.bucketID, key, "marker-1")
+		if err != nil || marker.State != "DELETE_MARKER" || !marker.IsLatest {
+			t.Fatalf("GetVersion marker: got %+v, %v", marker, err)
+		}
+		if got := listed(t, f, "", "", 10); !slices.Equal(got, []string{"marker-1*", "object-a"}) {
+			t.Fatalf("versions: got %v", got)
+		}
+
+		deletion, err = f.objects.DeleteVersion(f.ctx, f.bucketID, key, "marker-1", false, now(f))
+		if err != nil {
+			t.Fatalf("DeleteVersion: %v", err)
+		}
+		if want := (store.ObjectDeletion{VersionID: "marker-1", DeleteMarker: true, Deleted: true}); deletion != want {
+			t.Fatalf("DeleteVersion: got %+v, want %+v", deletion, want)
+		}
+		if got := current(t, f); got != "object-a" {
+			t.Fatalf("current after removing marker: got %q, want object-a", got)
+		}
+		if got := objectState(f.ctx, t, f.db, "marker-1"); got != "DELETED" {
+			t.Fatalf("state of marker-1: got %q, want DELETED", got)
+		}
+	})
+
+	t.Run("deleting the current version restores the previous one", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, store.VersioningEnabled)
+		put(t, f, "object-a")
+		put(t, f, "object-b")
+
+		if _, err := f.objects.DeleteVersion(f.ctx, f.bucketID, key, "object-b", false, now(f)); err != nil {
+			t.Fatalf("DeleteVersion: %v", err)
+		}
+		if got := current(t, f); got != "object-a" {
+			t.Fatalf("current: got %q, want object-a", got)
+		}
+		if got := objectState(f.ctx, t, f.db, "object-b"); got != "REPLACED" {
+			t.Fatalf("state of object-b: got %q, want REPLACED", got)
+		}
+	})
+
+	t.Run("deleting a missing version does nothing", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, store.VersioningEnabled)
+		put(t, f, "object-a")
+
+		deletion, err := f.objects.DeleteVersion(f.ctx, f.bucketID, key, "object-z", false, now(f))
+		if err != nil {
+			t.Fatalf("DeleteVersion: %v", err)
+		}
+		if want := (store.ObjectDeletion{VersionID: "object-z"}); deletion != want {
+			t.Fatalf("DeleteVersion: got %+v, want %+v", deletion, want)
+		}
+		if got := current(t, f); got != "object-a" {
+			t.Fatalf("current: got %q, want object-a", got)
+		}
+	})
+
+	t.Run("DeleteMany applies each delete in turn", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, store.VersioningEnabled)
+		put(t, f, "object-a")
+		put(t, f, "object-b")
+
+		deletions, err := f.objects.DeleteMany(f.ctx, f.bucketID, []store.ObjectDelete{
+			{Key: key, VersionID: "object-a"},
+			{Key: key, MarkerID: "marker-1"},
+			{Key: key, VersionID: "object-z"},
+		}, now(f))
+		if err != nil {
+			t.Fatalf("DeleteMany: %v", err)
+		}
+		want := []store.ObjectDeletion{
+			{VersionID: "object-a", Deleted: true},
+			{VersionID: "marker-1", DeleteMarker: true, Deleted: true},
+			{VersionID: "object-z"},
+		}
+		if !slices.Equal(deletions, want) {
+			t.Fatalf("DeleteMany: got %+v, want %+v", deletions, want)
+		}
+		if got := listed(t, f, "", "", 10); !slices.Equal(got, []string{"marker-1*", "object-b"}) {
+			t.Fatalf("versions: got %v", got)
+		}
+		if got := objectState(f.ctx, t, f.db, "object-a"); got != "REPLACED" {
+			t.Fatalf("state of object-a: got %q, want REPLACED", got)
+		}
+	})
+
+	t.Run("suspended writes replace only the null version", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, "")
+
+		if got := put(t, f, "object-null"); got != "" {
+			t.Fatalf("version before versioning: got %q, want none", got)
+		}
+		setVersioning(t, f, store.VersioningEnabled)
+		put(t, f, "object-a")
+		if got := objectState(f.ctx, t, f.db, "object-null"); got != "NONCURRENT" {
+			t.Fatalf("state of object-null: got %q, want NONCURRENT", got)
+		}
+
+		setVersioning(t, f, store.VersioningSuspended)
+		if got := put(t, f, "object-c"); got != store.NullVersionID {
+			t.Fatalf("version while suspended: got %q, want null", got)
+		}
+		if got := objectState(f.ctx, t, f.db, "object-null"); got != "REPLACED" {
+			t.Fatalf("state of object-null: got %q, want REPLACED", got)
+		}
+		if got := listed(t, f, "", "", 10); !slices.Equal(got, []string{"null*", "object-a"}) {
+			t.Fatalf("versions: got %v", got)
+		}
+
+		rec, err := f.objects.GetVersion(f.ctx, f.bucketID, key, store.NullVersionID)
+		if err != nil || rec.ID != "object-c" {
+			t.Fatalf("GetVersion null: got %+v, %v", rec, err)
+		}
+
+		deletion, err := f.objects.DeleteCurrent(f.ctx, f.bucketID, key, "marker-1", now(f))
+		if err != nil {
+			t.Fatalf("DeleteCurrent: %v", err)
+		}
+		if want := (store.ObjectDeletion{VersionID: store.NullVersionID, DeleteMarker: true, Deleted: true}); deletion != want {
+			t.Fatalf("DeleteCurrent: got %+v, want %+v", deletion, want)
+		}
+		if got := objectState(f.ctx, t, f.db, "object-c"); got != "REPLACED" {
+			t.Fatalf("state of object-c: got %q, want REPLACED", got)
+		}
+	})
+
+	t.Run("ListVersions resumes within a key", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, store.VersioningEnabled)
+		put(t, f, "object-a")
+		put(t, f, "object-b")
+		put(t, f, "object-c")
+
+		if got := listed(t, f, "", "", 2); !slices.Equal(got, []string{"object-c*", "object-b"}) {
+			t.Fatalf("first page: got %v", got)
+		}
+		if got := listed(t, f, key, "object-b", 2); !slices.Equal(got, []string{"object-a"}) {
+			t.Fatalf("second page: got %v", got)
+		}
+		if got := listed(t, f, key, "", 2); len(got) != 0 {
+			t.Fatalf("after key: got %v, want none", got)
+		}
+	})
+}
+
+func TestObjectStore_ListReclaimable(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	s := store.NewObjectStore(db)
+
+	createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	bucketID := "bucket-id-reclaim"
+	cradleServerID := "cradle-id-reclaim"
+
+	setupPrerequisites(ctx, t, db, bucketID, cradleServerID, createdAt, false, false)
+
+	insertObjectWithState(ctx, t, db, "object-id-failed", bucketID, "a", "FAILED", cradleServerID, createdAt.Add(2*time.Second))
+	insertObjectWithState(ctx, t, db, "object-id-replaced", bucketID, "b", "REPLACED", cradleServerID, createdAt.Add(time.Second))
+	insertObjectWithState(ctx, t, db, "object-id-pending", bucketID, "c", "PENDING", cradleServerID, createdAt)
+	insertObjectWithState(ctx, t, db, "object-id-deleted", bucketID, "d", "DELETED", cradleServerID, createdAt)
+	insertCommittedObject(ctx, t, db, "object-id-committed", bucketID, "e", cradleServerID, createdAt)
+	insertObjectWithState(ctx, t, db, "object-id-held", bucketID, "f", "REPLACED", cradleServerID, createdAt)
+	insertObjectWithState(ctx, t, db, "object-id-compliance", bucketID, "g", "REPLACED", cradleServerID, createdAt)
+	insertObjectWithState(ctx, t, db, "object-id-governance", bucketID, "h", "REPLACED", cradleServerID, createdAt.Add(3*time.Second))
+	retainUntil := time.Now().Add(time.Hour).UnixMicro()
+	if _, err := db.ExecContext(ctx, `UPDATE objects SET legal_hold = 1 WHERE object_id = 'object-id-held'`); err != nil {
+		t.Fatalf("place legal hold: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `
+		UPDATE objects SET retention_mode = CASE object_id WHEN 'object-id-compliance' THEN 'COMPLIANCE' ELSE 'GOVERNANCE' END,
+		                   retain_until = ?
+		WHERE object_id IN ('object-id-compliance', 'object-id-governance')
+	`, retainUntil); err != nil {
+		t.Fatalf("set retention: %v", err)
+	}
+
+	got, err := s.ListReclaimable(ctx, time.Now(), 10)
+	if err != nil {
+		t.Fatalf("ListReclaimable: unexpected error: %v", err)
+	}
+
+	want := []store.ReclaimableObject{
+		{ID: "object-id-replaced", Bucket: "test-bucket", CradleAddress: "127.0.0.1:9444"},
+		{ID: "object-id-failed", Bucket: "test-bucket", CradleAddress: "127.0.0.1:9444"},
+		{ID: "object-id-governance", Bucket: "test-bucket", CradleAddress: "127.0.0.1:9444"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ListReclaimable: got %d objects, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("object %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	limited, err := s.ListReclaimable(ctx, time.Now(), 1)
+	if err != nil {
+		t.Fatalf("ListReclaimable limited: unexpected error: %v", err)
+	}
+	if len(limited) != 1 || limited[0].ID != "object-id-replaced" {
+		t.Fatalf("ListReclaimable limited: got %+v, want only object-id-replaced", limited)
+	}
+}
+
+func TestObjectStore_MarkDeleted(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name    string
+		state   string
+		wantErr error
+	}
+
+	cases := []tc{
+		{name: "REPLACED becomes DELETED", state: "REPLACED"},
+		{name: "FAILED becomes DELETED", state: "FAILED"},
+		{name: "COMMITTED returns ErrObjectNotReclaimable", state: "COMMITTED", wantErr: store.ErrObjectNotReclaimable},
+		{name: "PENDING returns ErrObjectNotReclaimable", state: "PENDING", wantErr: store.ErrObjectNotReclaimable},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			s := store.NewObjectStore(db)
+
+			createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+			bucketID := "bucket-id-mark"
+			cradleServerID := "cradle-id-mark"
+			objectID := "object-id-mark"
+
+			setupPrerequisites(ctx, t, db, bucketID, cradleServerID, createdAt, false, false)
+			insertObjectWithState(ctx, t, db, objectID, bucketID, "photos/sunset.jpg", c.state, cradleServerID, createdAt)
+
+			err := s.MarkDeleted(ctx, objectID, createdAt.Add(time.Minute))
+
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("MarkDeleted error: got %v, want %v", err, c.wantErr)
+				}
+				if got := objectState(ctx, t, db, objectID); got != c.state {
+					t.Fatalf("state: got %q, want unchanged %q", got, c.state)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("MarkDeleted: unexpected error: %v", err)
+			}
+			if got := objectState(ctx, t, db, objectID); got != "DELETED" {
+				t.Fatalf("state: got %q, want %q", got, "DELETED")
+			}
+		})
+	}
+}
+
+func TestObjectStore_ListCommitted(t *testing.T) {
+	t.Parallel()
+
+	type seed struct {
+		key   string
+		state string
+	}
+
+	type tc struct {
+		name     string
+		prefix   string
+		after    string
+		limit    int
+		wantKeys []string
+	}
+
+	seeds := []seed{
+		{key: "a.txt", state: "COMMITTED"},
+		{key: "photos/2024/beach.jpg", state: "COMMITTED"},
+		{key: "photos/2024/city.jpg", state: "COMMITTED"},
+		{key: "photos/2025/snow.jpg", state: "COMMITTED"},
+		{key: "photos/draft.jpg", state: "PENDING"},
+		{key: "photos/old.jpg", state: "REPLACED"},
+		{key: "photosynthesis.txt", state: "COMMITTED"},
+		{key: "z.txt", state: "COMMITTED"},
+	}
+
+	cases := []tc{
+		{
+			name:     "lists committed keys in order",
+			limit:    10,
+			wantKeys: []string{"a.txt", "photos/2024/beach.jpg", "photos/2024/city.jpg", "photos/2025/snow.jpg", "photosynthesis.txt", "z.txt"},
+		},
+		{
+			name:     "limit truncates page",
+			limit:    2,
+			wantKeys: []string{"a.txt", "photos/2024/beach.jpg"},
+		},
+		{
+			name:     "prefix filters keys",
+			prefix:   "photos/",
+			limit:    10,
+			wantKeys: []string{"photos/2024/beach.jpg", "photos/2024/city.jpg", "photos/2025/snow.jpg"},
+		},
+		{
+			name:     "after cursor resumes past key",
+			after:    "photos/2024/beach.jpg",
+			limit:    2,
+			wantKeys: []string{"photos/2024/city.jpg", "photos/2025/snow.jpg"},
+		},
+		{
+			name:     "after cursor with prefix",
+			prefix:   "photos/",
+			after:    "photos/2024/city.jpg",
+			limit:    10,
+			wantKeys: []string{"photos/2025/snow.jpg"},
+		},
+		{
+			name:     "ceiling cursor skips everything under a prefix",
+			after:    "photos/2024/" + store.KeysetCeiling,
+			limit:    10,
+			wantKeys: []string{"photos/2025/snow.jpg", "photosynthesis.txt", "z.txt"},
+		},
+		{
+			name:     "after cursor below prefix starts at prefix",
+			prefix:   "photos/2025/",
+			after:    "a.txt",
+			limit:    10,
+			wantKeys: []string{"photos/2025/snow.jpg"},
+		},
+		{
+			name:     "no matches returns empty slice",
+			prefix:   "videos/",
+			limit:    10,
+			wantKeys: []string{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			db := openIsolatedDB(t)
+			s := store.NewObjectStore(db)
+			now := time.Now().UTC()
+
+			const (
+				bucketID       = "bucket-list"
+				otherBucketID  = "bucket-other"
+				cradleServerID = "cradle-list"
+			)
+
+			setupPrerequisites(ctx, t, db, bucketID, cradleServerID, now, false, false)
+			if _, err := store.NewBucketStore(db).Create(ctx, otherBucketID, "other-bucket", "", "", nil, now); err != nil {
+				t.Fatalf("create other bucket: %v", err)
+			}
+
+			for i, seed := range seeds {
+				id := fmt.Sprintf("obj-%d", i)
+				if seed.state == "COMMITTED" {
+					insertCommittedObject(ctx, t, db, id, bucketID, seed.key, cradleServerID, now)
+					continue
+				}
+				insertObjectWithState(ctx, t, db, id, bucketID, seed.key, seed.state, cradleServerID, now)
+			}
+			insertCommittedObject(ctx, t, db, "obj-other", otherBucketID, "photos/2024/other.jpg", cradleServerID, now)
+
+			recs, err := s.ListCommitted(ctx, bucketID, c.prefix, c.after, c.limit)
+			if err != nil {
+				t.Fatalf("ListCommitted: unexpected error: %v", err)
+			}
+
+			gotKeys := make([]string, 0, len(recs))
+			for _, rec := range recs {
+				if rec.State != "COMMITTED" || rec.BucketID != bucketID {
+					t.Fatalf("ListCommitted returned %+v, want COMMITTED in %s", rec, bucketID)
+				}
+				gotKeys = append(gotKeys, rec.Key)
+			}
+
+			if !slices.Equal(gotKeys, c.wantKeys) {
+				t.Fatalf("keys: got %v, want %v", gotKeys, c.wantKeys)
+			}
+		})
+	}
+}
+
+func TestObjectStore_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	type fixture struct {
+		ctx      context.Context
+		db       *sql.DB
+		objects  store.ObjectStore
+		bucketID string
+	}
+
+	setup := func(t *testing.T, versioning string) *fixture {
+		t.Helper()
+		f := &fixture{ctx: context.Background(), db: openIsolatedDB(t), bucketID: "bucket-id-lifecycle"}
+		f.objects = store.NewObjectStore(f.db)
+		setupPrerequisites(f.ctx, t, f.db, f.bucketID, "cradle-id-lifecycle", createdAt, false, false)
+		if versioning != "" {
+			if err := store.NewBucketStore(f.db).SetVersioning(f.ctx, f.bucketID, versioning, createdAt); err != nil {
+				t.Fatalf("SetVersioning: %v", err)
+			}
+		}
+		return f
+	}
+
+	// put writes objectID as the current version of key, days after
+	// createdAt.
+	put := func(t *testing.T, f *fixture, objectID, key string, days int) {
+		t.Helper()
+		at := createdAt.AddDate(0, 0, days)
+		if _, err := f.objects.CreatePending(f.ctx, objectID, f.bucketID, key, 1024, "", nil, nil, store.ObjectLock{}, "cradle-id-lifecycle", at); err != nil {
+			t.Fatalf("CreatePending %s: %v", objectID, err)
+		}
+		if _, err := f.objects.CommitWithReplace(f.ctx, objectID, commitOf(1024, at.UnixMilli()), at); err != nil {
+			t.Fatalf("CommitWithReplace %s: %v", objectID, err)
+		}
+	}
+
+	expired := func(t *testing.T, f *fixture, expiry store.LifecycleExpiry) []string {
+		t.Helper()
+		versions, err := f.objects.ListExpired(f.ctx, f.bucketID, expiry, 10)
+		if err != nil {
+			t.Fatalf("ListExpired: %v", err)
+		}
+		got := make([]string, 0, len(versions))
+		for _, v := range versions {
+			got = append(got, v.ID)
+		}
+		return got
+	}
+
+	t.Run("current versions expire by age within the prefix", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, "")
+		put(t, f, "object-old", "footage/a.mp4", 0)
+		put(t, f, "object-new", "footage/b.mp4", 5)
+		put(t, f, "object-other", "notes/c.txt", 0)
+
+		got := expired(t, f, store.LifecycleExpiry{Prefix: "footage/", CurrentBefore: createdAt.AddDate(0, 0, 1)})
+		if !slices.Equal(got, []string{"object-old"}) {
+			t.Fatalf("expired: got %v, want [object-old]", got)
+		}
+		if got := expired(t, f, store.LifecycleExpiry{Prefix: "footage/"}); len(got) != 0 {
+			t.Fatalf("expired without actions: got %v, want none", got)
+		}
+	})
+
+	t.Run("tag filters match versions carrying every tag", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, "")
+		put(t, f, "object-web", "backups/web.tar", 0)
+		put(t, f, "object-db", "backups/db.tar", 0)
+		put(t, f, "object-untagged", "backups/misc.tar", 0)
+		for key, tags := range map[string]map[string]string{
+			"backups/web.tar": {"host": "web-1", "kind": "backup"},
+			"backups/db.tar":  {"host": "db-1", "kind": "backup"},
+		} {
+			if _, err := f.objects.SetTags(f.ctx, f.bucketID, key, "", tags); err != nil {
+				t.Fatalf("SetTags %s: %v", key, err)
+			}
+		}
+
+		cutoff := createdAt.AddDate(0, 0, 1)
+		if got := expired(t, f, store.LifecycleExpiry{CurrentBefore: cutoff, Tags: map[string]string{"kind": "backup"}}); !slices.Equal(got, []string{"object-db", "object-web"}) {
+			t.Fatalf("expired by kind: got %v", got)
+		}
+		if got := expired(t, f, store.LifecycleExpiry{CurrentBefore: cutoff, Tags: map[string]string{"kind": "backup", "host": "web-1"}}); !slices.Equal(got, []string{"object-web"}) {
+			t.Fatalf("expired by kind and host: got %v", got)
+		}
+	})
+
+	t.Run("ExpireCurrent retires an unversioned object", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, "")
+		put(t, f, "object-a", "footage/a.mp4", 0)
+
+		deletion, err := f.objects.ExpireCurrent(f.ctx, "object-a", "marker-1", createdAt.AddDate(0, 0, 30))
+		if err != nil {
+			t.Fatalf("ExpireCurrent: %v", err)
+		}
+		if want := (store.ObjectDeletion{Deleted: true}); deletion != want {
+			t.Fatalf("ExpireCurrent: got %+v, want %+v", deletion, want)
+		}
+		if got := objectState(f.ctx, t, f.db, "object-a"); got != "REPLACED" {
+			t.Fatalf("state of object-a: got %q, want REPLACED", got)
+		}
+	})
+
+	t.Run("ExpireCurrent leaves a delete marker when versioned", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, store.VersioningEnabled)
+		put(t, f, "object-a", "footage/a.mp4", 0)
+
+		deletion, err := f.objects.ExpireCurrent(f.ctx, "object-a", "marker-1", createdAt.AddDate(0, 0, 30))
+		if err != nil {
+			t.Fatalf("ExpireCurrent: %v", err)
+		}
+		if want := (store.ObjectDeletion{VersionID: "marker-1", DeleteMarker: true, Deleted: true}); deletion != want {
+			t.Fatalf("ExpireCurrent: got %+v, want %+v", deletion, want)
+		}
+		if got := objectState(f.ctx, t, f.db, "object-a"); got != "NONCURRENT" {
+			t.Fatalf("state of object-a: got %q, want NONCURRENT", got)
+		}
+	})
+
+	t.Run("ExpireCurrent skips a key rewritten since", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, "")
+		put(t, f, "object-a", "footage/a.mp4", 0)
+		put(t, f, "object-b", "footage/a.mp4", 1)
+
+		deletion, err := f.objects.ExpireCurrent(f.ctx, "object-a", "marker-1", createdAt.AddDate(0, 0, 30))
+		if err != nil {
+			t.Fatalf("ExpireCurrent: %v", err)
+		}
+		if deletion.Deleted {
+			t.Fatalf("ExpireCurrent: got %+v, want nothing deleted", deletion)
+		}
+		if got := objectState(f.ctx, t, f.db, "object-b"); got != "COMMITTED" {
+			t.Fatalf("state of object-b: got %q, want COMMITTED", got)
+		}
+	})
+
+	t.Run("noncurrent versions expire keeping the newest", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, store.VersioningEnabled)
+		put(t, f, "object-a", "footage/a.mp4", 0)
+		put(t, f, "object-b", "footage/a.mp4", 1)
+		put(t, f, "object-c", "footage/a.mp4", 2)
+		put(t, f, "object-d", "footage/a.mp4", 3)
+
+		cutoff := createdAt.AddDate(0, 0, 10)
+		if got := expired(t, f, store.LifecycleExpiry{NoncurrentBefore: cutoff}); !slices.Equal(got, []string{"object-c", "object-b", "object-a"}) {
+			t.Fatalf("expired: got %v", got)
+		}
+		if got := expired(t, f, store.LifecycleExpiry{NoncurrentBefore: cutoff, NewerNoncurrent: 1}); !slices.Equal(got, []string{"object-b", "object-a"}) {
+			t.Fatalf("expired keeping one: got %v", got)
+		}
+		if got := expired(t, f, store.LifecycleExpiry{NoncurrentBefore: createdAt.AddDate(0, 0, 2)}); !slices.Equal(got, []string{"object-a"}) {
+			t.Fatalf("expired by age: got %v", got)
+		}
+	})
+
+	t.Run("only delete markers without other versions expire", func(t *testing.T) {
+		t.Parallel()
+		f := setup(t, store.VersioningEnabled)
+		put(t, f, "object-a", "footage/a.mp4", 0)
+		put(t, f, "object-b", "footage/b.mp4", 0)
+		for key, marker := range map[string]string{"footage/a.mp4": "marker-a", "footage/b.mp4": "marker-b"} {
+			if _, err := f.objects.DeleteCurrent(f.ctx, f.bucketID, key, marker, createdAt.AddDate(0, 0, 1)); err != nil {
+				t.Fatalf("DeleteCurrent %s: %v", key, err)
+			}
+		}
+		if _, err := f.objects.DeleteVersion(f.ctx, f.bucketID, "footage/b.mp4", "object-b", false, createdAt.AddDate(0, 0, 2)); err != nil {
+			t.Fatalf("DeleteVersion: %v", err)
+		}
+
+		if got := expired(t, f, store.LifecycleExpiry{DeleteMarkers: true}); !slices.Equal(got, []string{"marker-b"}) {
+			t.Fatalf("expired: got %v, want [marker-b]", got)
+		}
+	})
+}
+
+func insertObjectWithState(ctx context.Context, t *testing.T, db *sql.DB, objectID, bucketID, key, state, cradleServerID string, updatedAt time.Time) {
+	t.Helper()
+	stamp := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO objects (object_id, bucket_id, key, state, size_expected, cradle_server_id, created_at, updated_at)
+		VALUES (?, ?, ?, ?, 1024, ?, ?, ?)
+	`, objectID, bucketID, key, state, cradleServerID, stamp, stamp)
+	if err != nil {
+		t.Fatalf("insertObjectWithState: %v", err)
+	}
+}
+
+func objectState(ctx context.Context, t *testing.T, db *sql.DB, objectID string) string {
+	t.Helper()
+	var state string
+	if err := db.QueryRowContext(ctx, `SELECT state FROM objects WHERE object_id = ?`, objectID).Scan(&state); err != nil {
+		t.Fatalf("query object state: %v", err)
+	}
+	return state
+}
+
 func insertCommittedObject(ctx context.Context, t *testing.T, db *sql.DB, objectID, bucketID, key, cradleServerID string, createdAt time.Time) {
 	t.Helper()
 	stamp := createdAt.UTC().Truncate(time.Microsecond).UnixMicro()
 	_, err := db.ExecContext(ctx, `
-		INSERT INTO objects (object_id, bucket_id, key, state, size_expected, size_actual, last_modified, cradle_server_id, created_at, updated_at)
-		VALUES (?, ?, ?, 'COMMITTED', 1024, 1024, ?, ?, ?, ?)
-	`, objectID, bucketID, key, stamp, cradleServerID, stamp, stamp)
+		INSERT INTO objects (object_id, bucket_id, key, state, size_expected, size_actual, last_modified, cradle_server_id, committed_at, created_at, updated_at)
+		VALUES (?, ?, ?, 'COMMITTED', 1024, 1024, ?, ?, ?, ?, ?)
+	`, objectID, bucketID, key, stamp, cradleServerID, stamp, stamp, stamp)
 	if err != nil {
 		t.Fatalf("insertCommittedObject: %v", err)
 	}
@@ -263,7 +1709,7 @@ func setupPrerequisites(ctx context.Context, t *testing.T, db *sql.DB, bucketID,
 
 	if !skipBucket {
 		buckets := store.NewBucketStore(db)
-		_, err := buckets.Create(ctx, bucketID, "test-bucket", createdAt)
+		_, err := buckets.Create(ctx, bucketID, "test-bucket", "", "", nil, createdAt)
 		if err != nil {
 			t.Fatalf("setup: create bucket: %v", err)
 		}
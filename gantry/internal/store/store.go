@@ -4,42 +4,116 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/secretbox"
 )
 
 type BucketStore interface {
-	Create(ctx context.Context, id string, name string, createdAt time.Time) (BucketRecord, error)
+	Create(ctx context.Context, id string, name string, ownerID string, acl string, lock *ObjectLockConfig, createdAt time.Time) (BucketRecord, error)
 	List(ctx context.Context) ([]BucketRecord, error)
 	GetByName(ctx context.Context, name string) (BucketRecord, error)
+	SetACL(ctx context.Context, id string, acl string, updatedAt time.Time) error
+	SetPolicy(ctx context.Context, id string, policy string, updatedAt time.Time) error
+	SetVersioning(ctx context.Context, id string, versioning string, updatedAt time.Time) error
+	SetLifecycle(ctx context.Context, id string, rules []LifecycleRule, updatedAt time.Time) error
+	SetCORS(ctx context.Context, id string, rules []CORSRule, updatedAt time.Time) error
+	SetObjectLock(ctx context.Context, id string, lock ObjectLockConfig, updatedAt time.Time) error
+	AdoptUnowned(ctx context.Context, ownerID string, updatedAt time.Time) (int64, error)
+	Delete(ctx context.Context, id string, force bool, deletedAt time.Time) (int64, error)
 }
 
 type CradleServerStore interface {
 	Upsert(ctx context.Context, id string, address string, createdAt time.Time) (CradleServerRecord, error)
 	SelectForUpload(ctx context.Context) (CradleServerRecord, error)
 	All(ctx context.Context) ([]CradleServerRecord, error)
+	GetByID(ctx context.Context, id string) (CradleServerRecord, error)
 }
 
 type ObjectStore interface {
-	CreatePending(ctx context.Context, id, bucketID, key string, sizeExpected int64, cradleServerID string, createdAt time.Time) (ObjectRecord, error)
-	CommitWithReplace(ctx context.Context, objectID string, sizeActual int64, lastModifiedMs int64, updatedAt time.Time) error
+	CreatePending(ctx context.Context, id, bucketID, key string, sizeExpected int64, contentType string, metadata, tags map[string]string, lock ObjectLock, cradleServerID string, createdAt time.Time) (ObjectRecord, error)
+	CommitWithReplace(ctx context.Context, objectID string, commit ObjectCommit, updatedAt time.Time) (string, error)
+	CommitIfAbsent(ctx context.Context, objectID string, commit ObjectCommit, updatedAt time.Time) (string, error)
+	GetCommitted(ctx context.Context, bucketID, key string) (ObjectRecord, error)
+	GetVersion(ctx context.Context, bucketID, key, versionID string) (ObjectRecord, error)
+	SetTags(ctx context.Context, bucketID, key, versionID string, tags map[string]string) (string, error)
+	SetRetention(ctx context.Context, bucketID, key, versionID, mode string, retainUntil time.Time, bypassGovernance bool, now time.Time) (string, error)
+	SetLegalHold(ctx context.Context, bucketID, key, versionID string, on bool) (string, error)
+	ListCommitted(ctx context.Context, bucketID, prefix, after string, limit int) ([]ObjectRecord, error)
+	ListVersions(ctx context.Context, bucketID, prefix, afterKey, afterVersionID string, limit int) ([]ObjectRecord, error)
+	DeleteCurrent(ctx context.Context, bucketID, key, markerID string, updatedAt time.Time) (ObjectDeletion, error)
+	DeleteVersion(ctx context.Context, bucketID, key, versionID string, bypassGovernance bool, updatedAt time.Time) (ObjectDeletion, error)
+	DeleteMany(ctx context.Context, bucketID string, deletes []ObjectDelete, updatedAt time.Time) ([]ObjectDeletion, error)
+	ListExpired(ctx context.Context, bucketID string, expiry LifecycleExpiry, limit int) ([]ObjectRecord, error)
+	ExpireCurrent(ctx context.Context, objectID, markerID string, updatedAt time.Time) (ObjectDeletion, error)
+	ListReclaimable(ctx context.Context, now time.Time, limit int) ([]ReclaimableObject, error)
+	MarkDeleted(ctx context.Context, objectID string, updatedAt time.Time) error
+}
+
+type MultipartStore interface {
+	Create(ctx context.Context, id, bucketID, key, contentType string, metadata map[string]string, createdAt time.Time) (MultipartUploadRecord, error)
+	Get(ctx context.Context, id string) (MultipartUploadRecord, error)
+	CreatePendingPart(ctx context.Context, blobID, uploadID string, partNumber int32, sizeExpected int64, cradleServerID string, createdAt time.Time) (PartRecord, error)
+	CommitPart(ctx context.Context, blobID string, sizeActual int64, lastModifiedMs int64, etag string, updatedAt time.Time) error
+	ListUploadedParts(ctx context.Context, uploadID string) ([]PartRecord, error)
+	Complete(ctx context.Context, uploadID string, obj CompletedUpload, updatedAt time.Time) (string, error)
+	Abort(ctx context.Context, uploadID string, updatedAt time.Time) error
+	ListInProgress(ctx context.Context, bucketID, prefix, afterKey, afterUploadID string, limit int) ([]MultipartUploadRecord, error)
+	AbortStale(ctx context.Context, createdBefore time.Time, limit int, updatedAt time.Time) ([]string, error)
+}
+
+type BlobDeletionStore interface {
+	List(ctx context.Context, limit int) ([]ReclaimableObject, error)
+	Remove(ctx context.Context, objectID string) error
+}
+
+type UserStore interface {
+	Create(ctx context.Context, id, name string, createdAt time.Time) (UserRecord, error)
+	Ensure(ctx context.Context, id, name string, createdAt time.Time) (UserRecord, error)
+	GetByName(ctx context.Context, name string) (UserRecord, error)
+	GetByID(ctx context.Context, id string) (UserRecord, error)
+	List(ctx context.Context) ([]UserRecord, error)
+}
+
+type AccessKeyStore interface {
+	Create(ctx context.Context, id, userID, accessKeyID, secretAccessKey string, createdAt time.Time) (AccessKeyRecord, error)
+	Upsert(ctx context.Context, id, userID, accessKeyID, secretAccessKey string, updatedAt time.Time) (AccessKeyRecord, error)
+	Get(ctx context.Context, accessKeyID string) (AccessKeyRecord, error)
+	List(ctx context.Context, userID string) ([]AccessKeyRecord, error)
+	SetStatus(ctx context.Context, accessKeyID, status string, updatedAt time.Time) (AccessKeyRecord, error)
+	Rotate(ctx context.Context, accessKeyID, newID, newAccessKeyID, newSecretAccessKey string, rotatedAt time.Time) (AccessKeyRecord, error)
 }
 
 type Store interface {
 	Buckets() BucketStore
 	CradleServers() CradleServerStore
 	Objects() ObjectStore
+	BlobDeletions() BlobDeletionStore
+	Multipart() MultipartStore
+	Users() UserStore
+	AccessKeys() AccessKeyStore
 }
 
 type sqlStore struct {
 	buckets       BucketStore
 	cradleServers CradleServerStore
 	objects       ObjectStore
+	blobDeletions BlobDeletionStore
+	multipart     MultipartStore
+	users         UserStore
+	accessKeys    AccessKeyStore
 }
 
-func New(db *sql.DB) Store {
+// New returns the SQL-backed store. secrets seals access key secrets at
+// rest.
+func New(db *sql.DB, secrets *secretbox.Box) Store {
 	return &sqlStore{
 		buckets:       NewBucketStore(db),
 		cradleServers: NewCradleServerStore(db),
 		objects:       NewObjectStore(db),
+		blobDeletions: NewBlobDeletionStore(db),
+		multipart:     NewMultipartStore(db),
+		users:         NewUserStore(db),
+		accessKeys:    NewAccessKeyStore(db, secrets),
 	}
 }
 
@@ -54,3 +128,19 @@ func (s *sqlStore) CradleServers() CradleServerStore {
 func (s *sqlStore) Objects() ObjectStore {
 	return s.objects
 }
+
+func (s *sqlStore) BlobDeletions() BlobDeletionStore {
+	return s.blobDeletions
+}
+
+func (s *sqlStore) Multipart() MultipartStore {
+	return s.multipart
+}
+
+func (s *sqlStore) Users() UserStore {
+	return s.users
+}
+
+func (s *sqlStore) AccessKeys() AccessKeyStore {
+	return s.accessKeys
+}
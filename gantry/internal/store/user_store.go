@@ -0,0 +1,128 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+var ErrUserAlreadyExists = errors.New("user already exists")
+var ErrUserNotFound = errors.New("user not found")
+
+type userStore struct {
+	db *sql.DB
+}
+
+func NewUserStore(db *sql.DB) UserStore {
+	return &userStore{db: db}
+}
+
+// UserRecord is an identity that owns access keys and buckets.
+type UserRecord struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (s *userStore) Create(ctx context.Context, id, name string, createdAt time.Time) (UserRecord, error) {
+	stamp := createdAt.UTC().Truncate(time.Microsecond)
+	micros := stamp.UnixMicro()
+
+	const insertUser = `INSERT INTO users (id, name, created_at, updated_at) VALUES (?, ?, ?, ?)`
+
+	if _, err := s.db.ExecContext(ctx, insertUser, id, name, micros, micros); err != nil {
+		var sqliteErr *sqlite.Error
+		if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+			return UserRecord{}, fmt.Errorf("insert user: %w", ErrUserAlreadyExists)
+		}
+		return UserRecord{}, fmt.Errorf("insert user: %w", err)
+	}
+
+	return UserRecord{ID: id, Name: name, CreatedAt: stamp, UpdatedAt: stamp}, nil
+}
+
+// Ensure returns the user called name, creating it with id if it doesn't
+// exist yet.
+func (s *userStore) Ensure(ctx context.Context, id, name string, createdAt time.Time) (UserRecord, error) {
+	micros := createdAt.UTC().Truncate(time.Microsecond).UnixMicro()
+
+	const ensureUser = `
+INSERT INTO users (id, name, created_at, updated_at)
+VALUES ($1, $2, $3, $3)
+ON CONFLICT (name) DO NOTHING;
+`
+
+	if _, err := s.db.ExecContext(ctx, ensureUser, id, name, micros); err != nil {
+		return UserRecord{}, fmt.Errorf("ensure user: %w", err)
+	}
+
+	return s.GetByName(ctx, name)
+}
+
+func (s *userStore) GetByName(ctx context.Context, name string) (UserRecord, error) {
+	return s.get(ctx, "get user by name", `SELECT id, name, created_at, updated_at FROM users WHERE name = ?`, name)
+}
+
+func (s *userStore) GetByID(ctx context.Context, id string) (UserRecord, error) {
+	return s.get(ctx, "get user by id", `SELECT id, name, created_at, updated_at FROM users WHERE id = ?`, id)
+}
+
+func (s *userStore) get(ctx context.Context, op, query string, arg string) (UserRecord, error) {
+	var (
+		rec       UserRecord
+		createdAt int64
+		updatedAt int64
+	)
+
+	if err := s.db.QueryRowContext(ctx, query, arg).Scan(&rec.ID, &rec.Name, &createdAt, &updatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return UserRecord{}, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+		return UserRecord{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rec.CreatedAt = time.UnixMicro(createdAt).UTC()
+	rec.UpdatedAt = time.UnixMicro(updatedAt).UTC()
+
+	return rec, nil
+}
+
+func (s *userStore) List(ctx context.Context) ([]UserRecord, error) {
+	const selectUsers = `SELECT id, name, created_at, updated_at FROM users ORDER BY name ASC`
+
+	rows, err := s.db.QueryContext(ctx, selectUsers)
+	if err != nil {
+		return nil, fmt.Errorf("list users: %w", err)
+	}
+	defer rows.Close()
+
+	var records []UserRecord
+
+	for rows.Next() {
+		var (
+			rec       UserRecord
+			createdAt int64
+			updatedAt int64
+		)
+
+		if err := rows.Scan(&rec.ID, &rec.Name, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+
+		rec.CreatedAt = time.UnixMicro(createdAt).UTC()
+		rec.UpdatedAt = time.UnixMicro(updatedAt).UTC()
+
+		records = append(records, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate users: %w", err)
+	}
+
+	return records, nil
+}
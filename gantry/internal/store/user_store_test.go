@@ -0,0 +1,108 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	store "github.com/ratdaddy/blockcloset/gantry/internal/store"
+)
+
+func TestUserStore_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	s := store.NewUserStore(db)
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	got, err := s.Create(ctx, "user-1", "alice", now)
+	if err != nil {
+		t.Fatalf("Create: unexpected error: %v", err)
+	}
+	want := store.UserRecord{ID: "user-1", Name: "alice", CreatedAt: now, UpdatedAt: now}
+	if got != want {
+		t.Fatalf("Create: got %+v, want %+v", got, want)
+	}
+
+	if _, err := s.Create(ctx, "user-2", "alice", now); !errors.Is(err, store.ErrUserAlreadyExists) {
+		t.Fatalf("Create duplicate: got err %v, want %v", err, store.ErrUserAlreadyExists)
+	}
+
+	fetched, err := s.GetByName(ctx, "alice")
+	if err != nil {
+		t.Fatalf("GetByName: unexpected error: %v", err)
+	}
+	if fetched != want {
+		t.Fatalf("GetByName: got %+v, want %+v", fetched, want)
+	}
+
+	if _, err := s.GetByName(ctx, "bob"); !errors.Is(err, store.ErrUserNotFound) {
+		t.Fatalf("GetByName missing: got err %v, want %v", err, store.ErrUserNotFound)
+	}
+
+	byID, err := s.GetByID(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("GetByID: unexpected error: %v", err)
+	}
+	if byID != want {
+		t.Fatalf("GetByID: got %+v, want %+v", byID, want)
+	}
+
+	if _, err := s.GetByID(ctx, "user-2"); !errors.Is(err, store.ErrUserNotFound) {
+		t.Fatalf("GetByID missing: got err %v, want %v", err, store.ErrUserNotFound)
+	}
+}
+
+func TestUserStore_Ensure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	s := store.NewUserStore(db)
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	first, err := s.Ensure(ctx, "user-1", "admin", now)
+	if err != nil {
+		t.Fatalf("Ensure first: unexpected error: %v", err)
+	}
+	second, err := s.Ensure(ctx, "user-2", "admin", now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Ensure second: unexpected error: %v", err)
+	}
+
+	want := store.UserRecord{ID: "user-1", Name: "admin", CreatedAt: now, UpdatedAt: now}
+	if first != want || second != want {
+		t.Fatalf("Ensure: got %+v and %+v, want %+v", first, second, want)
+	}
+}
+
+func TestUserStore_List(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openIsolatedDB(t)
+	s := store.NewUserStore(db)
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	for i, name := range []string{"carol", "alice", "bob"} {
+		if _, err := s.Create(ctx, store.NewID(), name, now.Add(time.Duration(i)*time.Minute)); err != nil {
+			t.Fatalf("Create %s: unexpected error: %v", name, err)
+		}
+	}
+
+	records, err := s.List(ctx)
+	if err != nil {
+		t.Fatalf("List: unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, rec := range records {
+		got = append(got, rec.Name)
+	}
+	want := []string{"alice", "bob", "carol"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Fatalf("List: got %v, want %v", got, want)
+	}
+}
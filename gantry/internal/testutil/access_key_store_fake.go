@@ -0,0 +1,269 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+)
+
+// AccessKeyCreateCall captures the parameters for Create and Upsert
+// invocations.
+type AccessKeyCreateCall struct {
+	ID              string
+	UserID          string
+	AccessKeyID     string
+	SecretAccessKey string
+	Stamp           time.Time
+}
+
+// AccessKeySetStatusCall captures the parameters for SetStatus invocations.
+type AccessKeySetStatusCall struct {
+	AccessKeyID string
+	Status      string
+	UpdatedAt   time.Time
+}
+
+// AccessKeyRotateCall captures the parameters for Rotate invocations.
+type AccessKeyRotateCall struct {
+	AccessKeyID        string
+	NewID              string
+	NewAccessKeyID     string
+	NewSecretAccessKey string
+	RotatedAt          time.Time
+}
+
+// AccessKeyStoreFake implements store.AccessKeyStore for tests. Create,
+// Upsert and Rotate echo their arguments back as the record.
+type AccessKeyStoreFake struct {
+	mu          sync.Mutex
+	createErr   error
+	createCalls []AccessKeyCreateCall
+	upsertErr   error
+	upsertCalls []AccessKeyCreateCall
+
+	getResponse store.AccessKeyRecord
+	getErr      error
+	getCalls    []string
+
+	listRecords []store.AccessKeyRecord
+	listErr     error
+	listCalls   []string
+
+	setStatusErr   error
+	setStatusCalls []AccessKeySetStatusCall
+
+	rotateErr   error
+	rotateCalls []AccessKeyRotateCall
+}
+
+var _ store.AccessKeyStore = (*AccessKeyStoreFake)(nil)
+
+func NewFakeAccessKeyStore() *AccessKeyStoreFake {
+	return &AccessKeyStoreFake{}
+}
+
+func (f *AccessKeyStoreFake) SetCreateError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createErr = err
+}
+
+func (f *AccessKeyStoreFake) Create(ctx context.Context, id, userID, accessKeyID, secretAccessKey string, stamp time.Time) (store.AccessKeyRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	call := AccessKeyCreateCall{ID: id, UserID: userID, AccessKeyID: accessKeyID, SecretAccessKey: secretAccessKey, Stamp: stamp}
+	f.createCalls = append(f.createCalls, call)
+
+	if f.createErr != nil {
+		return store.AccessKeyRecord{}, f.createErr
+	}
+	return call.record(), nil
+}
+
+func (f *AccessKeyStoreFake) CreateCalls() []AccessKeyCreateCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]AccessKeyCreateCall, len(f.createCalls))
+	copy(calls, f.createCalls)
+	return calls
+}
+
+func (f *AccessKeyStoreFake) SetUpsertError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.upsertErr = err
+}
+
+func (f *AccessKeyStoreFake) Upsert(ctx context.Context, id, userID, accessKeyID, secretAccessKey string, stamp time.Time) (store.AccessKeyRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	call := AccessKeyCreateCall{ID: id, UserID: userID, AccessKeyID: accessKeyID, SecretAccessKey: secretAccessKey, Stamp: stamp}
+	f.upsertCalls = append(f.upsertCalls, call)
+
+	if f.upsertErr != nil {
+		return store.AccessKeyRecord{}, f.upsertErr
+	}
+	return call.record(), nil
+}
+
+func (f *AccessKeyStoreFake) UpsertCalls() []AccessKeyCreateCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]AccessKeyCreateCall, len(f.upsertCalls))
+	copy(calls, f.upsertCalls)
+	return calls
+}
+
+func (f *AccessKeyStoreFake) SetGetResponse(rec store.AccessKeyRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getResponse = rec
+}
+
+func (f *AccessKeyStoreFake) SetGetError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getErr = err
+}
+
+func (f *AccessKeyStoreFake) Get(ctx context.Context, accessKeyID string) (store.AccessKeyRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.getCalls = append(f.getCalls, accessKeyID)
+
+	if f.getErr != nil {
+		return store.AccessKeyRecord{}, f.getErr
+	}
+	return f.getResponse, nil
+}
+
+func (f *AccessKeyStoreFake) GetCalls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]string, len(f.getCalls))
+	copy(calls, f.getCalls)
+	return calls
+}
+
+func (f *AccessKeyStoreFake) SetListRecords(records []store.AccessKeyRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listRecords = append([]store.AccessKeyRecord(nil), records...)
+}
+
+func (f *AccessKeyStoreFake) SetListError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listErr = err
+}
+
+func (f *AccessKeyStoreFake) List(ctx context.Context, userID string) ([]store.AccessKeyRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.listCalls = append(f.listCalls, userID)
+
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+
+	records := make([]store.AccessKeyRecord, len(f.listRecords))
+	copy(records, f.listRecords)
+	return records, nil
+}
+
+func (f *AccessKeyStoreFake) ListCalls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]string, len(f.listCalls))
+	copy(calls, f.listCalls)
+	return calls
+}
+
+func (f *AccessKeyStoreFake) SetSetStatusError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setStatusErr = err
+}
+
+func (f *AccessKeyStoreFake) SetStatus(ctx context.Context, accessKeyID, status string, updatedAt time.Time) (store.AccessKeyRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.setStatusCalls = append(f.setStatusCalls, AccessKeySetStatusCall{AccessKeyID: accessKeyID, Status: status, UpdatedAt: updatedAt})
+
+	if f.setStatusErr != nil {
+		return store.AccessKeyRecord{}, f.setStatusErr
+	}
+	return store.AccessKeyRecord{AccessKeyID: accessKeyID, Status: status, UpdatedAt: updatedAt}, nil
+}
+
+func (f *AccessKeyStoreFake) SetStatusCalls() []AccessKeySetStatusCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]AccessKeySetStatusCall, len(f.setStatusCalls))
+	copy(calls, f.setStatusCalls)
+	return calls
+}
+
+func (f *AccessKeyStoreFake) SetRotateError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rotateErr = err
+}
+
+func (f *AccessKeyStoreFake) Rotate(ctx context.Context, accessKeyID, newID, newAccessKeyID, newSecretAccessKey string, rotatedAt time.Time) (store.AccessKeyRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.rotateCalls = append(f.rotateCalls, AccessKeyRotateCall{
+		AccessKeyID:        accessKeyID,
+		NewID:              newID,
+		NewAccessKeyID:     newAccessKeyID,
+		NewSecretAccessKey: newSecretAccessKey,
+		RotatedAt:          rotatedAt,
+	})
+
+	if f.rotateErr != nil {
+		return store.AccessKeyRecord{}, f.rotateErr
+	}
+	return store.AccessKeyRecord{
+		ID:              newID,
+		AccessKeyID:     newAccessKeyID,
+		SecretAccessKey: newSecretAccessKey,
+		Status:          store.AccessKeyActive,
+		CreatedAt:       rotatedAt,
+		UpdatedAt:       rotatedAt,
+	}, nil
+}
+
+func (f *AccessKeyStoreFake) RotateCalls() []AccessKeyRotateCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]AccessKeyRotateCall, len(f.rotateCalls))
+	copy(calls, f.rotateCalls)
+	return calls
+}
+
+func (c AccessKeyCreateCall) record() store.AccessKeyRecord {
+	return store.AccessKeyRecord{
+		ID:              c.ID,
+		AccessKeyID:     c.AccessKeyID,
+		UserID:          c.UserID,
+		SecretAccessKey: c.SecretAccessKey,
+		Status:          store.AccessKeyActive,
+		CreatedAt:       c.Stamp,
+		UpdatedAt:       c.Stamp,
+	}
+}
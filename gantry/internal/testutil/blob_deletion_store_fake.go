@@ -0,0 +1,80 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+)
+
+// BlobDeletionStoreFake implements store.BlobDeletionStore for tests.
+type BlobDeletionStoreFake struct {
+	mu           sync.Mutex
+	listErr      error
+	listResponse []store.ReclaimableObject
+	listCalls    int
+
+	removeErr   error
+	removeCalls []string
+}
+
+var _ store.BlobDeletionStore = (*BlobDeletionStoreFake)(nil)
+
+func NewFakeBlobDeletionStore() *BlobDeletionStoreFake {
+	return &BlobDeletionStoreFake{}
+}
+
+func (f *BlobDeletionStoreFake) SetListError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listErr = err
+}
+
+func (f *BlobDeletionStoreFake) SetListResponse(objs []store.ReclaimableObject) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listResponse = objs
+}
+
+func (f *BlobDeletionStoreFake) List(ctx context.Context, limit int) ([]store.ReclaimableObject, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.listCalls++
+
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+
+	objs := make([]store.ReclaimableObject, len(f.listResponse))
+	copy(objs, f.listResponse)
+	return objs, nil
+}
+
+func (f *BlobDeletionStoreFake) ListCalls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.listCalls
+}
+
+func (f *BlobDeletionStoreFake) SetRemoveError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.removeErr = err
+}
+
+func (f *BlobDeletionStoreFake) Remove(ctx context.Context, objectID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.removeCalls = append(f.removeCalls, objectID)
+	return f.removeErr
+}
+
+func (f *BlobDeletionStoreFake) RemoveCalls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]string, len(f.removeCalls))
+	copy(calls, f.removeCalls)
+	return calls
+}
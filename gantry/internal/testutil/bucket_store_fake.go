@@ -12,9 +12,57 @@ import (
 type BucketCreateCall struct {
 	ID        string
 	Name      string
+	OwnerID   string
+	ACL       string
+	Lock      *store.ObjectLockConfig
 	CreatedAt time.Time
 }
 
+// BucketSetCall captures the parameters for SetACL, SetPolicy and
+// SetVersioning invocations. Value is the ACL, the policy or the versioning
+// status.
+type BucketSetCall struct {
+	ID        string
+	Value     string
+	UpdatedAt time.Time
+}
+
+// BucketSetLifecycleCall captures the parameters for SetLifecycle
+// invocations.
+type BucketSetLifecycleCall struct {
+	ID        string
+	Rules     []store.LifecycleRule
+	UpdatedAt time.Time
+}
+
+// BucketSetCORSCall captures the parameters for SetCORS invocations.
+type BucketSetCORSCall struct {
+	ID        string
+	Rules     []store.CORSRule
+	UpdatedAt time.Time
+}
+
+// BucketSetObjectLockCall captures the parameters for SetObjectLock
+// invocations.
+type BucketSetObjectLockCall struct {
+	ID        string
+	Lock      store.ObjectLockConfig
+	UpdatedAt time.Time
+}
+
+// BucketAdoptCall captures the parameters for AdoptUnowned invocations.
+type BucketAdoptCall struct {
+	OwnerID   string
+	UpdatedAt time.Time
+}
+
+// BucketDeleteCall captures the parameters for Delete invocations.
+type BucketDeleteCall struct {
+	ID        string
+	Force     bool
+	DeletedAt time.Time
+}
+
 // BucketStoreFake implements store.BucketStore for tests.
 type BucketStoreFake struct {
 	mu                sync.Mutex
@@ -30,6 +78,31 @@ type BucketStoreFake struct {
 	getByNameErr      error
 	getByNameResponse store.BucketRecord
 	getByNameCalls    []string
+
+	deleteErr    error
+	deleteQueued int64
+	deleteCalls  []BucketDeleteCall
+
+	setACLErr      error
+	setACLCalls    []BucketSetCall
+	setPolicyErr   error
+	setPolicyCalls []BucketSetCall
+
+	setVersioningErr   error
+	setVersioningCalls []BucketSetCall
+
+	setLifecycleErr   error
+	setLifecycleCalls []BucketSetLifecycleCall
+
+	setCORSErr   error
+	setCORSCalls []BucketSetCORSCall
+
+	setObjectLockErr   error
+	setObjectLockCalls []BucketSetObjectLockCall
+
+	adoptErr     error
+	adoptAdopted int64
+	adoptCalls   []BucketAdoptCall
 }
 
 var _ store.BucketStore = (*BucketStoreFake)(nil)
@@ -75,11 +148,11 @@ func (f *BucketStoreFake) SetGetByNameResponse(rec store.BucketRecord) {
 	f.getByNameResponse = rec
 }
 
-func (f *BucketStoreFake) Create(ctx context.Context, id, name string, createdAt time.Time) (store.BucketRecord, error) {
+func (f *BucketStoreFake) Create(ctx context.Context, id, name, ownerID, acl string, lock *store.ObjectLockConfig, createdAt time.Time) (store.BucketRecord, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	f.createCalls = append(f.createCalls, BucketCreateCall{ID: id, Name: name, CreatedAt: createdAt})
+	f.createCalls = append(f.createCalls, BucketCreateCall{ID: id, Name: name, OwnerID: ownerID, ACL: acl, Lock: lock, CreatedAt: createdAt})
 
 	if f.createErr != nil {
 		return store.BucketRecord{}, f.createErr
@@ -89,7 +162,7 @@ func (f *BucketStoreFake) Create(ctx context.Context, id, name string, createdAt
 		return f.createResponse, nil
 	}
 
-	return store.BucketRecord{ID: id, Name: name, CreatedAt: createdAt, UpdatedAt: createdAt}, nil
+	return store.BucketRecord{ID: id, Name: name, OwnerID: ownerID, ACL: acl, ObjectLock: lock, CreatedAt: createdAt, UpdatedAt: createdAt}, nil
 }
 
 func (f *BucketStoreFake) List(ctx context.Context) ([]store.BucketRecord, error) {
@@ -143,3 +216,202 @@ func (f *BucketStoreFake) ListCallCount() int {
 	defer f.mu.Unlock()
 	return f.listCalls
 }
+
+func (f *BucketStoreFake) SetDeleteError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteErr = err
+}
+
+func (f *BucketStoreFake) SetDeleteQueued(queued int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteQueued = queued
+}
+
+func (f *BucketStoreFake) Delete(ctx context.Context, id string, force bool, deletedAt time.Time) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.deleteCalls = append(f.deleteCalls, BucketDeleteCall{ID: id, Force: force, DeletedAt: deletedAt})
+
+	if f.deleteErr != nil {
+		return 0, f.deleteErr
+	}
+
+	return f.deleteQueued, nil
+}
+
+func (f *BucketStoreFake) DeleteCalls() []BucketDeleteCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]BucketDeleteCall, len(f.deleteCalls))
+	copy(calls, f.deleteCalls)
+	return calls
+}
+
+func (f *BucketStoreFake) SetSetACLError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setACLErr = err
+}
+
+func (f *BucketStoreFake) SetACL(ctx context.Context, id string, acl string, updatedAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.setACLCalls = append(f.setACLCalls, BucketSetCall{ID: id, Value: acl, UpdatedAt: updatedAt})
+	return f.setACLErr
+}
+
+func (f *BucketStoreFake) SetACLCalls() []BucketSetCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]BucketSetCall, len(f.setACLCalls))
+	copy(calls, f.setACLCalls)
+	return calls
+}
+
+func (f *BucketStoreFake) SetSetPolicyError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setPolicyErr = err
+}
+
+func (f *BucketStoreFake) SetPolicy(ctx context.Context, id string, policy string, updatedAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.setPolicyCalls = append(f.setPolicyCalls, BucketSetCall{ID: id, Value: policy, UpdatedAt: updatedAt})
+	return f.setPolicyErr
+}
+
+func (f *BucketStoreFake) SetPolicyCalls() []BucketSetCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]BucketSetCall, len(f.setPolicyCalls))
+	copy(calls, f.setPolicyCalls)
+	return calls
+}
+
+func (f *BucketStoreFake) SetSetVersioningError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setVersioningErr = err
+}
+
+func (f *BucketStoreFake) SetVersioning(ctx context.Context, id string, versioning string, updatedAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.setVersioningCalls = append(f.setVersioningCalls, BucketSetCall{ID: id, Value: versioning, UpdatedAt: updatedAt})
+	return f.setVersioningErr
+}
+
+func (f *BucketStoreFake) SetVersioningCalls() []BucketSetCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]BucketSetCall, len(f.setVersioningCalls))
+	copy(calls, f.setVersioningCalls)
+	return calls
+}
+
+func (f *BucketStoreFake) SetSetLifecycleError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setLifecycleErr = err
+}
+
+func (f *BucketStoreFake) SetLifecycle(ctx context.Context, id string, rules []store.LifecycleRule, updatedAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.setLifecycleCalls = append(f.setLifecycleCalls, BucketSetLifecycleCall{ID: id, Rules: rules, UpdatedAt: updatedAt})
+	return f.setLifecycleErr
+}
+
+func (f *BucketStoreFake) SetLifecycleCalls() []BucketSetLifecycleCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]BucketSetLifecycleCall, len(f.setLifecycleCalls))
+	copy(calls, f.setLifecycleCalls)
+	return calls
+}
+
+func (f *BucketStoreFake) SetSetCORSError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setCORSErr = err
+}
+
+func (f *BucketStoreFake) SetCORS(ctx context.Context, id string, rules []store.CORSRule, updatedAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.setCORSCalls = append(f.setCORSCalls, BucketSetCORSCall{ID: id, Rules: rules, UpdatedAt: updatedAt})
+	return f.setCORSErr
+}
+
+func (f *BucketStoreFake) SetCORSCalls() []BucketSetCORSCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]BucketSetCORSCall, len(f.setCORSCalls))
+	copy(calls, f.setCORSCalls)
+	return calls
+}
+
+func (f *BucketStoreFake) SetSetObjectLockError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setObjectLockErr = err
+}
+
+func (f *BucketStoreFake) SetObjectLock(ctx context.Context, id string, lock store.ObjectLockConfig, updatedAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.setObjectLockCalls = append(f.setObjectLockCalls, BucketSetObjectLockCall{ID: id, Lock: lock, UpdatedAt: updatedAt})
+	return f.setObjectLockErr
+}
+
+func (f *BucketStoreFake) SetObjectLockCalls() []BucketSetObjectLockCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]BucketSetObjectLockCall, len(f.setObjectLockCalls))
+	copy(calls, f.setObjectLockCalls)
+	return calls
+}
+
+func (f *BucketStoreFake) SetAdoptUnownedResponse(adopted int64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.adoptAdopted = adopted
+	f.adoptErr = err
+}
+
+func (f *BucketStoreFake) AdoptUnowned(ctx context.Context, ownerID string, updatedAt time.Time) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.adoptCalls = append(f.adoptCalls, BucketAdoptCall{OwnerID: ownerID, UpdatedAt: updatedAt})
+	if f.adoptErr != nil {
+		return 0, f.adoptErr
+	}
+	return f.adoptAdopted, nil
+}
+
+func (f *BucketStoreFake) AdoptUnownedCalls() []BucketAdoptCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]BucketAdoptCall, len(f.adoptCalls))
+	copy(calls, f.adoptCalls)
+	return calls
+}
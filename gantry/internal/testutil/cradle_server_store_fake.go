@@ -26,6 +26,9 @@ type CradleStoreFake struct {
 	selectForUploadResponse  store.CradleServerRecord
 	selectForUploadErr       error
 	selectForUploadCallCount int
+	getByIDResponse          store.CradleServerRecord
+	getByIDErr               error
+	getByIDCalls             []string
 }
 
 var _ store.CradleServerStore = (*CradleStoreFake)(nil)
@@ -131,3 +134,37 @@ func (f *CradleStoreFake) SelectForUploadCallCount() int {
 	defer f.mu.Unlock()
 	return f.selectForUploadCallCount
 }
+
+func (f *CradleStoreFake) SetGetByIDResponse(rec store.CradleServerRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getByIDResponse = rec
+}
+
+func (f *CradleStoreFake) SetGetByIDError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getByIDErr = err
+}
+
+func (f *CradleStoreFake) GetByID(ctx context.Context, id string) (store.CradleServerRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.getByIDCalls = append(f.getByIDCalls, id)
+
+	if f.getByIDErr != nil {
+		return store.CradleServerRecord{}, f.getByIDErr
+	}
+
+	return f.getByIDResponse, nil
+}
+
+func (f *CradleStoreFake) GetByIDCalls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]string, len(f.getByIDCalls))
+	copy(calls, f.getByIDCalls)
+	return calls
+}
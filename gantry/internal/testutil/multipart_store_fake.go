@@ -0,0 +1,447 @@
+package testutil
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+)
+
+// MultipartCreateCall captures the parameters for Create invocations.
+type MultipartCreateCall struct {
+	ID          string
+	BucketID    string
+	Key         string
+	ContentType string
+	Metadata    map[string]string
+	CreatedAt   time.Time
+}
+
+// MultipartCreatePartCall captures the parameters for CreatePendingPart invocations.
+type MultipartCreatePartCall struct {
+	BlobID         string
+	UploadID       string
+	PartNumber     int32
+	SizeExpected   int64
+	CradleServerID string
+	CreatedAt      time.Time
+}
+
+// MultipartCommitPartCall captures the parameters for CommitPart invocations.
+type MultipartCommitPartCall struct {
+	BlobID         string
+	SizeActual     int64
+	LastModifiedMs int64
+	ETag           string
+	UpdatedAt      time.Time
+}
+
+// MultipartCompleteCall captures the parameters for Complete invocations.
+type MultipartCompleteCall struct {
+	UploadID  string
+	Object    store.CompletedUpload
+	UpdatedAt time.Time
+}
+
+// MultipartListInProgressCall captures the parameters for ListInProgress invocations.
+type MultipartListInProgressCall struct {
+	BucketID      string
+	Prefix        string
+	AfterKey      string
+	AfterUploadID string
+	Limit         int
+}
+
+// MultipartAbortStaleCall captures the parameters for AbortStale invocations.
+type MultipartAbortStaleCall struct {
+	CreatedBefore time.Time
+	Limit         int
+	UpdatedAt     time.Time
+}
+
+// MultipartAbortCall captures the parameters for Abort invocations.
+type MultipartAbortCall struct {
+	UploadID  string
+	UpdatedAt time.Time
+}
+
+// MultipartStoreFake implements store.MultipartStore for tests.
+type MultipartStoreFake struct {
+	mu          sync.Mutex
+	createErr   error
+	createCalls []MultipartCreateCall
+
+	uploads map[string]store.MultipartUploadRecord
+	getErr  error
+
+	createPartErr   error
+	createPartCalls []MultipartCreatePartCall
+
+	commitPartErr   error
+	commitPartCalls []MultipartCommitPartCall
+
+	parts        map[string][]store.PartRecord
+	listPartsErr error
+
+	completeErr       error
+	completeCalls     []MultipartCompleteCall
+	completeVersionID string
+
+	abortErr   error
+	abortCalls []MultipartAbortCall
+
+	listInProgress      []store.MultipartUploadRecord
+	listInProgressErr   error
+	listInProgressCalls []MultipartListInProgressCall
+
+	abortStaleIDs   []string
+	abortStaleErr   error
+	abortStaleCalls []MultipartAbortStaleCall
+}
+
+var _ store.MultipartStore = (*MultipartStoreFake)(nil)
+
+func NewFakeMultipartStore() *MultipartStoreFake {
+	return &MultipartStoreFake{
+		uploads: make(map[string]store.MultipartUploadRecord),
+		parts:   make(map[string][]store.PartRecord),
+	}
+}
+
+func (f *MultipartStoreFake) SetCreateError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createErr = err
+}
+
+func (f *MultipartStoreFake) Create(ctx context.Context, id, bucketID, key, contentType string, metadata map[string]string, createdAt time.Time) (store.MultipartUploadRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.createCalls = append(f.createCalls, MultipartCreateCall{
+		ID:          id,
+		BucketID:    bucketID,
+		Key:         key,
+		ContentType: contentType,
+		Metadata:    metadata,
+		CreatedAt:   createdAt,
+	})
+
+	if f.createErr != nil {
+		return store.MultipartUploadRecord{}, f.createErr
+	}
+
+	rec := store.MultipartUploadRecord{
+		ID:          id,
+		BucketID:    bucketID,
+		Key:         key,
+		State:       "IN_PROGRESS",
+		ContentType: contentType,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+	f.uploads[id] = rec
+	return rec, nil
+}
+
+func (f *MultipartStoreFake) CreateCalls() []MultipartCreateCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]MultipartCreateCall, len(f.createCalls))
+	copy(calls, f.createCalls)
+	return calls
+}
+
+// SetUpload seeds an upload returned by Get.
+func (f *MultipartStoreFake) SetUpload(rec store.MultipartUploadRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.uploads[rec.ID] = rec
+}
+
+func (f *MultipartStoreFake) SetGetError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getErr = err
+}
+
+func (f *MultipartStoreFake) Get(ctx context.Context, id string) (store.MultipartUploadRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.getErr != nil {
+		return store.MultipartUploadRecord{}, f.getErr
+	}
+
+	rec, ok := f.uploads[id]
+	if !ok {
+		return store.MultipartUploadRecord{}, store.ErrUploadNotFound
+	}
+	return rec, nil
+}
+
+func (f *MultipartStoreFake) SetCreatePartError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createPartErr = err
+}
+
+func (f *MultipartStoreFake) CreatePendingPart(ctx context.Context, blobID, uploadID string, partNumber int32, sizeExpected int64, cradleServerID string, createdAt time.Time) (store.PartRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.createPartCalls = append(f.createPartCalls, MultipartCreatePartCall{
+		BlobID:         blobID,
+		UploadID:       uploadID,
+		PartNumber:     partNumber,
+		SizeExpected:   sizeExpected,
+		CradleServerID: cradleServerID,
+		CreatedAt:      createdAt,
+	})
+
+	if f.createPartErr != nil {
+		return store.PartRecord{}, f.createPartErr
+	}
+
+	return store.PartRecord{
+		BlobID:         blobID,
+		UploadID:       uploadID,
+		PartNumber:     partNumber,
+		State:          "PENDING",
+		SizeExpected:   sizeExpected,
+		CradleServerID: cradleServerID,
+		CreatedAt:      createdAt,
+		UpdatedAt:      createdAt,
+	}, nil
+}
+
+func (f *MultipartStoreFake) CreatePartCalls() []MultipartCreatePartCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]MultipartCreatePartCall, len(f.createPartCalls))
+	copy(calls, f.createPartCalls)
+	return calls
+}
+
+func (f *MultipartStoreFake) SetCommitPartError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.commitPartErr = err
+}
+
+func (f *MultipartStoreFake) CommitPart(ctx context.Context, blobID string, sizeActual int64, lastModifiedMs int64, etag string, updatedAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.commitPartCalls = append(f.commitPartCalls, MultipartCommitPartCall{
+		BlobID:         blobID,
+		SizeActual:     sizeActual,
+		LastModifiedMs: lastModifiedMs,
+		ETag:           etag,
+		UpdatedAt:      updatedAt,
+	})
+
+	return f.commitPartErr
+}
+
+func (f *MultipartStoreFake) CommitPartCalls() []MultipartCommitPartCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]MultipartCommitPartCall, len(f.commitPartCalls))
+	copy(calls, f.commitPartCalls)
+	return calls
+}
+
+// SetParts seeds the UPLOADED parts returned by ListUploadedParts for an upload.
+func (f *MultipartStoreFake) SetParts(uploadID string, parts []store.PartRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.parts[uploadID] = append([]store.PartRecord(nil), parts...)
+}
+
+func (f *MultipartStoreFake) SetListPartsError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listPartsErr = err
+}
+
+func (f *MultipartStoreFake) ListUploadedParts(ctx context.Context, uploadID string) ([]store.PartRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.listPartsErr != nil {
+		return nil, f.listPartsErr
+	}
+
+	parts := make([]store.PartRecord, len(f.parts[uploadID]))
+	copy(parts, f.parts[uploadID])
+	return parts, nil
+}
+
+func (f *MultipartStoreFake) SetCompleteError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.completeErr = err
+}
+
+// SetCompleteVersionID sets the version ID Complete returns.
+func (f *MultipartStoreFake) SetCompleteVersionID(versionID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.completeVersionID = versionID
+}
+
+func (f *MultipartStoreFake) Complete(ctx context.Context, uploadID string, obj store.CompletedUpload, updatedAt time.Time) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.completeCalls = append(f.completeCalls, MultipartCompleteCall{
+		UploadID:  uploadID,
+		Object:    obj,
+		UpdatedAt: updatedAt,
+	})
+
+	if f.completeErr != nil {
+		return "", f.completeErr
+	}
+	return f.completeVersionID, nil
+}
+
+func (f *MultipartStoreFake) CompleteCalls() []MultipartCompleteCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]MultipartCompleteCall, len(f.completeCalls))
+	copy(calls, f.completeCalls)
+	return calls
+}
+
+func (f *MultipartStoreFake) SetAbortError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.abortErr = err
+}
+
+func (f *MultipartStoreFake) Abort(ctx context.Context, uploadID string, updatedAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.abortCalls = append(f.abortCalls, MultipartAbortCall{
+		UploadID:  uploadID,
+		UpdatedAt: updatedAt,
+	})
+
+	return f.abortErr
+}
+
+func (f *MultipartStoreFake) AbortCalls() []MultipartAbortCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]MultipartAbortCall, len(f.abortCalls))
+	copy(calls, f.abortCalls)
+	return calls
+}
+
+// SetListInProgressResponse seeds the uploads ListInProgress pages through.
+// The fake applies the prefix, cursor and limit like the real store, so
+// seed uploads already in key and upload ID order.
+func (f *MultipartStoreFake) SetListInProgressResponse(uploads []store.MultipartUploadRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listInProgress = append([]store.MultipartUploadRecord(nil), uploads...)
+}
+
+func (f *MultipartStoreFake) SetListInProgressError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listInProgressErr = err
+}
+
+func (f *MultipartStoreFake) ListInProgress(ctx context.Context, bucketID, prefix, afterKey, afterUploadID string, limit int) ([]store.MultipartUploadRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.listInProgressCalls = append(f.listInProgressCalls, MultipartListInProgressCall{
+		BucketID:      bucketID,
+		Prefix:        prefix,
+		AfterKey:      afterKey,
+		AfterUploadID: afterUploadID,
+		Limit:         limit,
+	})
+
+	if f.listInProgressErr != nil {
+		return nil, f.listInProgressErr
+	}
+
+	uploads := make([]store.MultipartUploadRecord, 0)
+	for _, rec := range f.listInProgress {
+		if len(uploads) == limit {
+			break
+		}
+		if !strings.HasPrefix(rec.Key, prefix) {
+			continue
+		}
+		after := rec.Key > afterKey || (rec.Key == afterKey && afterUploadID != "" && rec.ID > afterUploadID)
+		if !after {
+			continue
+		}
+		uploads = append(uploads, rec)
+	}
+	return uploads, nil
+}
+
+func (f *MultipartStoreFake) ListInProgressCalls() []MultipartListInProgressCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]MultipartListInProgressCall, len(f.listInProgressCalls))
+	copy(calls, f.listInProgressCalls)
+	return calls
+}
+
+// SetAbortStaleResponse sets the upload IDs AbortStale reports as aborted.
+func (f *MultipartStoreFake) SetAbortStaleResponse(ids []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.abortStaleIDs = append([]string(nil), ids...)
+}
+
+func (f *MultipartStoreFake) SetAbortStaleError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.abortStaleErr = err
+}
+
+func (f *MultipartStoreFake) AbortStale(ctx context.Context, createdBefore time.Time, limit int, updatedAt time.Time) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.abortStaleCalls = append(f.abortStaleCalls, MultipartAbortStaleCall{
+		CreatedBefore: createdBefore,
+		Limit:         limit,
+		UpdatedAt:     updatedAt,
+	})
+
+	if f.abortStaleErr != nil {
+		return nil, f.abortStaleErr
+	}
+
+	ids := make([]string, len(f.abortStaleIDs))
+	copy(ids, f.abortStaleIDs)
+	return ids, nil
+}
+
+func (f *MultipartStoreFake) AbortStaleCalls() []MultipartAbortStaleCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]MultipartAbortStaleCall, len(f.abortStaleCalls))
+	copy(calls, f.abortStaleCalls)
+	return calls
+}
@@ -2,6 +2,8 @@ package testutil
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,16 +16,117 @@ type ObjectCreateCall struct {
 	BucketID       string
 	Key            string
 	SizeExpected   int64
+	ContentType    string
+	Metadata       map[string]string
+	Tags           map[string]string
+	Lock           store.ObjectLock
 	CradleServerID string
 	CreatedAt      time.Time
 }
 
-// ObjectCommitCall captures the parameters for CommitWithReplace invocations.
+// ObjectCommitCall captures the parameters for CommitWithReplace and
+// CommitIfAbsent invocations.
 type ObjectCommitCall struct {
-	ObjectID       string
-	SizeActual     int64
-	LastModifiedMs int64
-	UpdatedAt      time.Time
+	ObjectID          string
+	SizeActual        int64
+	LastModifiedMs    int64
+	ETag              string
+	ChecksumAlgorithm string
+	Checksum          string
+	UpdatedAt         time.Time
+	IfAbsent          bool
+}
+
+// ObjectGetCommittedCall captures the parameters for GetCommitted invocations.
+type ObjectGetCommittedCall struct {
+	BucketID string
+	Key      string
+}
+
+// ObjectListCommittedCall captures the parameters for ListCommitted invocations.
+type ObjectListCommittedCall struct {
+	BucketID string
+	Prefix   string
+	After    string
+	Limit    int
+}
+
+// ObjectGetVersionCall captures the parameters for GetVersion invocations.
+type ObjectGetVersionCall struct {
+	BucketID  string
+	Key       string
+	VersionID string
+}
+
+// ObjectSetTagsCall captures the parameters for SetTags invocations.
+type ObjectSetTagsCall struct {
+	BucketID  string
+	Key       string
+	VersionID string
+	Tags      map[string]string
+}
+
+// ObjectSetRetentionCall captures the parameters for SetRetention
+// invocations.
+type ObjectSetRetentionCall struct {
+	BucketID         string
+	Key              string
+	VersionID        string
+	Mode             string
+	RetainUntil      time.Time
+	BypassGovernance bool
+	Now              time.Time
+}
+
+// ObjectSetLegalHoldCall captures the parameters for SetLegalHold
+// invocations.
+type ObjectSetLegalHoldCall struct {
+	BucketID  string
+	Key       string
+	VersionID string
+	On        bool
+}
+
+// ObjectListVersionsCall captures the parameters for ListVersions invocations.
+type ObjectListVersionsCall struct {
+	BucketID       string
+	Prefix         string
+	AfterKey       string
+	AfterVersionID string
+	Limit          int
+}
+
+// ObjectDeleteCall captures the parameters for DeleteCurrent and
+// DeleteVersion invocations. VersionID is the delete marker's object ID for
+// DeleteCurrent and the version to remove for DeleteVersion.
+type ObjectDeleteCall struct {
+	BucketID         string
+	Key              string
+	VersionID        string
+	BypassGovernance bool
+	UpdatedAt        time.Time
+	Version          bool
+}
+
+// ObjectDeleteManyCall captures the parameters for DeleteMany invocations.
+type ObjectDeleteManyCall struct {
+	BucketID  string
+	Deletes   []store.ObjectDelete
+	UpdatedAt time.Time
+}
+
+// ObjectListExpiredCall captures the parameters for ListExpired invocations.
+type ObjectListExpiredCall struct {
+	BucketID string
+	Expiry   store.LifecycleExpiry
+	Limit    int
+}
+
+// ObjectExpireCall captures the parameters for ExpireCurrent invocations.
+type ObjectExpireCall struct {
+	ObjectID  string
+	MarkerID  string
+	UpdatedAt time.Time
 }
 
 // ObjectStoreFake implements store.ObjectStore for tests.
@@ -35,6 +138,56 @@ type ObjectStoreFake struct {
 	hasCreateResponse bool
 	commitErr         error
 	commitCalls       []ObjectCommitCall
+	commitVersionID   string
+
+	getCommittedErr      error
+	getCommittedResponse store.ObjectRecord
+	getCommittedCalls    []ObjectGetCommittedCall
+
+	listCommittedErr     error
+	listCommittedRecords []store.ObjectRecord
+	listCommittedCalls   []ObjectListCommittedCall
+
+	getVersionErr      error
+	getVersionResponse store.ObjectRecord
+	getVersionCalls    []ObjectGetVersionCall
+
+	setTagsErr       error
+	setTagsVersionID string
+	setTagsCalls     []ObjectSetTagsCall
+
+	setRetentionErr   error
+	setRetentionCalls []ObjectSetRetentionCall
+
+	setLegalHoldErr   error
+	setLegalHoldCalls []ObjectSetLegalHoldCall
+
+	listVersionsErr     error
+	listVersionsRecords []store.ObjectRecord
+	listVersionsCalls   []ObjectListVersionsCall
+
+	deleteErr      error
+	deleteResponse store.ObjectDeletion
+	deleteCalls    []ObjectDeleteCall
+
+	deleteManyErr      error
+	deleteManyResponse []store.ObjectDeletion
+	deleteManyCalls    []ObjectDeleteManyCall
+
+	listExpiredErr     error
+	listExpiredRecords map[string][]store.ObjectRecord
+	listExpiredCalls   []ObjectListExpiredCall
+
+	expireErr      error
+	expireResponse store.ObjectDeletion
+	expireCalls    []ObjectExpireCall
+
+	listReclaimableErr      error
+	listReclaimableResponse []store.ReclaimableObject
+	listReclaimableCalls    []time.Time
+
+	markDeletedErr   error
+	markDeletedCalls []string
 }
 
 var _ store.ObjectStore = (*ObjectStoreFake)(nil)
@@ -56,7 +209,7 @@ func (f *ObjectStoreFake) SetCreateResponse(rec store.ObjectRecord) {
 	f.hasCreateResponse = true
 }
 
-func (f *ObjectStoreFake) CreatePending(ctx context.Context, id, bucketID, key string, sizeExpected int64, cradleServerID string, createdAt time.Time) (store.ObjectRecord, error) {
+func (f *ObjectStoreFake) CreatePending(ctx context.Context, id, bucketID, key string, sizeExpected int64, contentType string, metadata, tags map[string]string, lock store.ObjectLock, cradleServerID string, createdAt time.Time) (store.ObjectRecord, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
@@ -65,6 +218,10 @@ func (f *ObjectStoreFake) CreatePending(ctx context.Context, id, bucketID, key s
 		BucketID:       bucketID,
 		Key:            key,
 		SizeExpected:   sizeExpected,
+		ContentType:    contentType,
+		Metadata:       metadata,
+		Tags:           tags,
+		Lock:           lock,
 		CradleServerID: cradleServerID,
 		CreatedAt:      createdAt,
 	})
@@ -83,9 +240,13 @@ func (f *ObjectStoreFake) CreatePending(ctx context.Context, id, bucketID, key s
 		Key:            key,
 		State:          "PENDING",
 		SizeExpected:   sizeExpected,
+		ContentType:    contentType,
 		CradleServerID: cradleServerID,
 		CreatedAt:      createdAt,
 		UpdatedAt:      createdAt,
+		Metadata:       metadata,
+		Tags:           tags,
+		Lock:           lock,
 	}, nil
 }
 
@@ -95,16 +256,49 @@ func (f *ObjectStoreFake) SetCommitError(err error) {
 	f.commitErr = err
 }
 
-func (f *ObjectStoreFake) CommitWithReplace(ctx context.Context, objectID string, sizeActual int64, lastModifiedMs int64, updatedAt time.Time) error {
+// SetCommitVersionID sets the version ID CommitWithReplace and
+// CommitIfAbsent return.
+func (f *ObjectStoreFake) SetCommitVersionID(versionID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.commitVersionID = versionID
+}
+
+func (f *ObjectStoreFake) CommitWithReplace(ctx context.Context, objectID string, commit store.ObjectCommit, updatedAt time.Time) (string, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	f.commitCalls = append(f.commitCalls, ObjectCommitCall{
-		ObjectID:       objectID,
-		SizeActual:     sizeActual,
-		LastModifiedMs: lastModifiedMs,
-		UpdatedAt:      updatedAt,
+		ObjectID:          objectID,
+		SizeActual:        commit.SizeActual,
+		LastModifiedMs:    commit.LastModifiedMs,
+		ETag:              commit.ETag,
+		ChecksumAlgorithm: commit.ChecksumAlgorithm,
+		Checksum:          commit.Checksum,
+		UpdatedAt:         updatedAt,
 	})
-	return f.commitErr
+	if f.commitErr != nil {
+		return "", f.commitErr
+	}
+	return f.commitVersionID, nil
+}
+
+func (f *ObjectStoreFake) CommitIfAbsent(ctx context.Context, objectID string, commit store.ObjectCommit, updatedAt time.Time) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.commitCalls = append(f.commitCalls, ObjectCommitCall{
+		ObjectID:          objectID,
+		SizeActual:        commit.SizeActual,
+		LastModifiedMs:    commit.LastModifiedMs,
+		ETag:              commit.ETag,
+		ChecksumAlgorithm: commit.ChecksumAlgorithm,
+		Checksum:          commit.Checksum,
+		UpdatedAt:         updatedAt,
+		IfAbsent:          true,
+	})
+	if f.commitErr != nil {
+		return "", f.commitErr
+	}
+	return f.commitVersionID, nil
 }
 
 func (f *ObjectStoreFake) CommitCalls() []ObjectCommitCall {
@@ -123,3 +317,500 @@ func (f *ObjectStoreFake) CreatePendingCalls() []ObjectCreateCall {
 	copy(calls, f.createCalls)
 	return calls
 }
+
+func (f *ObjectStoreFake) SetGetCommittedError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getCommittedErr = err
+}
+
+func (f *ObjectStoreFake) SetGetCommittedResponse(rec store.ObjectRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getCommittedResponse = rec
+}
+
+func (f *ObjectStoreFake) GetCommitted(ctx context.Context, bucketID, key string) (store.ObjectRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.getCommittedCalls = append(f.getCommittedCalls, ObjectGetCommittedCall{BucketID: bucketID, Key: key})
+
+	if f.getCommittedErr != nil {
+		return store.ObjectRecord{}, f.getCommittedErr
+	}
+
+	return f.getCommittedResponse, nil
+}
+
+func (f *ObjectStoreFake) GetCommittedCalls() []ObjectGetCommittedCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]ObjectGetCommittedCall, len(f.getCommittedCalls))
+	copy(calls, f.getCommittedCalls)
+	return calls
+}
+
+func (f *ObjectStoreFake) SetListCommittedError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listCommittedErr = err
+}
+
+// SetListCommittedRecords seeds the committed objects ListCommitted pages
+// through; they are filtered and ordered the way the SQL store does.
+func (f *ObjectStoreFake) SetListCommittedRecords(records []store.ObjectRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listCommittedRecords = make([]store.ObjectRecord, len(records))
+	copy(f.listCommittedRecords, records)
+	sort.Slice(f.listCommittedRecords, func(i, j int) bool {
+		return f.listCommittedRecords[i].Key < f.listCommittedRecords[j].Key
+	})
+}
+
+func (f *ObjectStoreFake) ListCommitted(ctx context.Context, bucketID, prefix, after string, limit int) ([]store.ObjectRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.listCommittedCalls = append(f.listCommittedCalls, ObjectListCommittedCall{
+		BucketID: bucketID,
+		Prefix:   prefix,
+		After:    after,
+		Limit:    limit,
+	})
+
+	if f.listCommittedErr != nil {
+		return nil, f.listCommittedErr
+	}
+
+	recs := make([]store.ObjectRecord, 0)
+	for _, rec := range f.listCommittedRecords {
+		if len(recs) == limit {
+			break
+		}
+		if rec.Key > after && strings.HasPrefix(rec.Key, prefix) {
+			recs = append(recs, rec)
+		}
+	}
+	return recs, nil
+}
+
+func (f *ObjectStoreFake) ListCommittedCalls() []ObjectListCommittedCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]ObjectListCommittedCall, len(f.listCommittedCalls))
+	copy(calls, f.listCommittedCalls)
+	return calls
+}
+
+func (f *ObjectStoreFake) SetGetVersionError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getVersionErr = err
+}
+
+func (f *ObjectStoreFake) SetGetVersionResponse(rec store.ObjectRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getVersionResponse = rec
+}
+
+func (f *ObjectStoreFake) GetVersion(ctx context.Context, bucketID, key, versionID string) (store.ObjectRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.getVersionCalls = append(f.getVersionCalls, ObjectGetVersionCall{BucketID: bucketID, Key: key, VersionID: versionID})
+
+	if f.getVersionErr != nil {
+		return store.ObjectRecord{}, f.getVersionErr
+	}
+
+	return f.getVersionResponse, nil
+}
+
+func (f *ObjectStoreFake) GetVersionCalls() []ObjectGetVersionCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]ObjectGetVersionCall, len(f.getVersionCalls))
+	copy(calls, f.getVersionCalls)
+	return calls
+}
+
+func (f *ObjectStoreFake) SetSetTagsError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setTagsErr = err
+}
+
+// SetSetTagsVersionID sets the version ID SetTags returns.
+func (f *ObjectStoreFake) SetSetTagsVersionID(versionID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setTagsVersionID = versionID
+}
+
+func (f *ObjectStoreFake) SetTags(ctx context.Context, bucketID, key, versionID string, tags map[string]string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.setTagsCalls = append(f.setTagsCalls, ObjectSetTagsCall{BucketID: bucketID, Key: key, VersionID: versionID, Tags: tags})
+
+	if f.setTagsErr != nil {
+		return "", f.setTagsErr
+	}
+
+	return f.setTagsVersionID, nil
+}
+
+func (f *ObjectStoreFake) SetTagsCalls() []ObjectSetTagsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]ObjectSetTagsCall, len(f.setTagsCalls))
+	copy(calls, f.setTagsCalls)
+	return calls
+}
+
+func (f *ObjectStoreFake) SetSetRetentionError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setRetentionErr = err
+}
+
+// SetRetention returns the version ID it was given, as SetLegalHold does.
+func (f *ObjectStoreFake) SetRetention(ctx context.Context, bucketID, key, versionID, mode string, retainUntil time.Time, bypassGovernance bool, now time.Time) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.setRetentionCalls = append(f.setRetentionCalls, ObjectSetRetentionCall{
+		BucketID:         bucketID,
+		Key:              key,
+		VersionID:        versionID,
+		Mode:             mode,
+		RetainUntil:      retainUntil,
+		BypassGovernance: bypassGovernance,
+		Now:              now,
+	})
+
+	if f.setRetentionErr != nil {
+		return "", f.setRetentionErr
+	}
+
+	return versionID, nil
+}
+
+func (f *ObjectStoreFake) SetRetentionCalls() []ObjectSetRetentionCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]ObjectSetRetentionCall, len(f.setRetentionCalls))
+	copy(calls, f.setRetentionCalls)
+	return calls
+}
+
+func (f *ObjectStoreFake) SetSetLegalHoldError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setLegalHoldErr = err
+}
+
+func (f *ObjectStoreFake) SetLegalHold(ctx context.Context, bucketID, key, versionID string, on bool) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.setLegalHoldCalls = append(f.setLegalHoldCalls, ObjectSetLegalHoldCall{BucketID: bucketID, Key: key, VersionID: versionID, On: on})
+
+	if f.setLegalHoldErr != nil {
+		return "", f.setLegalHoldErr
+	}
+
+	return versionID, nil
+}
+
+func (f *ObjectStoreFake) SetLegalHoldCalls() []ObjectSetLegalHoldCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]ObjectSetLegalHoldCall, len(f.setLegalHoldCalls))
+	copy(calls, f.setLegalHoldCalls)
+	return calls
+}
+
+func (f *ObjectStoreFake) SetListVersionsError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listVersionsErr = err
+}
+
+// SetListVersionsRecords seeds the versions ListVersions pages through. They
+// must already be in the store's order: by key, newest version first.
+func (f *ObjectStoreFake) SetListVersionsRecords(records []store.ObjectRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listVersionsRecords = make([]store.ObjectRecord, len(records))
+	copy(f.listVersionsRecords, records)
+}
+
+func (f *ObjectStoreFake) ListVersions(ctx context.Context, bucketID, prefix, afterKey, afterVersionID string, limit int) ([]store.ObjectRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.listVersionsCalls = append(f.listVersionsCalls, ObjectListVersionsCall{
+		BucketID:       bucketID,
+		Prefix:         prefix,
+		AfterKey:       afterKey,
+		AfterVersionID: afterVersionID,
+		Limit:          limit,
+	})
+
+	if f.listVersionsErr != nil {
+		return nil, f.listVersionsErr
+	}
+
+	recs := make([]store.ObjectRecord, 0)
+	pastMarker := false
+	for _, rec := range f.listVersionsRecords {
+		if len(recs) == limit {
+			break
+		}
+		after := rec.Key > afterKey || (rec.Key == afterKey && pastMarker)
+		if rec.Key == afterKey && rec.VersionID == afterVersionID {
+			pastMarker = true
+		}
+		if after && strings.HasPrefix(rec.Key, prefix) {
+			recs = append(recs, rec)
+		}
+	}
+	return recs, nil
+}
+
+func (f *ObjectStoreFake) ListVersionsCalls() []ObjectListVersionsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]ObjectListVersionsCall, len(f.listVersionsCalls))
+	copy(calls, f.listVersionsCalls)
+	return calls
+}
+
+func (f *ObjectStoreFake) SetDeleteError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteErr = err
+}
+
+// SetDeleteResponse sets what DeleteCurrent and DeleteVersion report.
+func (f *ObjectStoreFake) SetDeleteResponse(deletion store.ObjectDeletion) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteResponse = deletion
+}
+
+func (f *ObjectStoreFake) DeleteCurrent(ctx context.Context, bucketID, key, markerID string, updatedAt time.Time) (store.ObjectDeletion, error) {
+	return f.delete(ObjectDeleteCall{BucketID: bucketID, Key: key, VersionID: markerID, UpdatedAt: updatedAt})
+}
+
+func (f *ObjectStoreFake) DeleteVersion(ctx context.Context, bucketID, key, versionID string, bypassGovernance bool, updatedAt time.Time) (store.ObjectDeletion, error) {
+	return f.delete(ObjectDeleteCall{BucketID: bucketID, Key: key, VersionID: versionID, BypassGovernance: bypassGovernance, UpdatedAt: updatedAt, Version: true})
+}
+
+func (f *ObjectStoreFake) delete(call ObjectDeleteCall) (store.ObjectDeletion, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.deleteCalls = append(f.deleteCalls, call)
+
+	if f.deleteErr != nil {
+		return store.ObjectDeletion{}, f.deleteErr
+	}
+
+	return f.deleteResponse, nil
+}
+
+func (f *ObjectStoreFake) DeleteCalls() []ObjectDeleteCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]ObjectDeleteCall, len(f.deleteCalls))
+	copy(calls, f.deleteCalls)
+	return calls
+}
+
+func (f *ObjectStoreFake) SetDeleteManyError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteManyErr = err
+}
+
+// SetDeleteManyResponse sets what DeleteMany reports. Without it each delete
+// reports Deleted with the version it named.
+func (f *ObjectStoreFake) SetDeleteManyResponse(deletions []store.ObjectDeletion) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteManyResponse = append([]store.ObjectDeletion(nil), deletions...)
+}
+
+func (f *ObjectStoreFake) DeleteMany(ctx context.Context, bucketID string, deletes []store.ObjectDelete, updatedAt time.Time) ([]store.ObjectDeletion, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.deleteManyCalls = append(f.deleteManyCalls, ObjectDeleteManyCall{
+		BucketID:  bucketID,
+		Deletes:   append([]store.ObjectDelete(nil), deletes...),
+		UpdatedAt: updatedAt,
+	})
+
+	if f.deleteManyErr != nil {
+		return nil, f.deleteManyErr
+	}
+
+	if f.deleteManyResponse != nil {
+		return append([]store.ObjectDeletion(nil), f.deleteManyResponse...), nil
+	}
+
+	deletions := make([]store.ObjectDeletion, 0, len(deletes))
+	for _, d := range deletes {
+		deletions = append(deletions, store.ObjectDeletion{VersionID: d.VersionID, Deleted: true})
+	}
+	return deletions, nil
+}
+
+func (f *ObjectStoreFake) DeleteManyCalls() []ObjectDeleteManyCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]ObjectDeleteManyCall, len(f.deleteManyCalls))
+	copy(calls, f.deleteManyCalls)
+	return calls
+}
+
+func (f *ObjectStoreFake) SetListExpiredError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listExpiredErr = err
+}
+
+// SetListExpiredRecords sets what ListExpired returns for bucketID.
+func (f *ObjectStoreFake) SetListExpiredRecords(bucketID string, recs []store.ObjectRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.listExpiredRecords == nil {
+		f.listExpiredRecords = make(map[string][]store.ObjectRecord)
+	}
+	f.listExpiredRecords[bucketID] = append([]store.ObjectRecord(nil), recs...)
+}
+
+func (f *ObjectStoreFake) ListExpired(ctx context.Context, bucketID string, expiry store.LifecycleExpiry, limit int) ([]store.ObjectRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.listExpiredCalls = append(f.listExpiredCalls, ObjectListExpiredCall{BucketID: bucketID, Expiry: expiry, Limit: limit})
+
+	if f.listExpiredErr != nil {
+		return nil, f.listExpiredErr
+	}
+
+	return append([]store.ObjectRecord(nil), f.listExpiredRecords[bucketID]...), nil
+}
+
+func (f *ObjectStoreFake) ListExpiredCalls() []ObjectListExpiredCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]ObjectListExpiredCall, len(f.listExpiredCalls))
+	copy(calls, f.listExpiredCalls)
+	return calls
+}
+
+func (f *ObjectStoreFake) SetExpireError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.expireErr = err
+}
+
+func (f *ObjectStoreFake) SetExpireResponse(deletion store.ObjectDeletion) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.expireResponse = deletion
+}
+
+func (f *ObjectStoreFake) ExpireCurrent(ctx context.Context, objectID, markerID string, updatedAt time.Time) (store.ObjectDeletion, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.expireCalls = append(f.expireCalls, ObjectExpireCall{ObjectID: objectID, MarkerID: markerID, UpdatedAt: updatedAt})
+
+	if f.expireErr != nil {
+		return store.ObjectDeletion{}, f.expireErr
+	}
+
+	return f.expireResponse, nil
+}
+
+func (f *ObjectStoreFake) ExpireCalls() []ObjectExpireCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]ObjectExpireCall, len(f.expireCalls))
+	copy(calls, f.expireCalls)
+	return calls
+}
+
+func (f *ObjectStoreFake) SetListReclaimableError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listReclaimableErr = err
+}
+
+func (f *ObjectStoreFake) SetListReclaimableResponse(objs []store.ReclaimableObject) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listReclaimableResponse = objs
+}
+
+func (f *ObjectStoreFake) ListReclaimable(ctx context.Context, now time.Time, limit int) ([]store.ReclaimableObject, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.listReclaimableCalls = append(f.listReclaimableCalls, now)
+
+	if f.listReclaimableErr != nil {
+		return nil, f.listReclaimableErr
+	}
+
+	objs := make([]store.ReclaimableObject, len(f.listReclaimableResponse))
+	copy(objs, f.listReclaimableResponse)
+	return objs, nil
+}
+
+func (f *ObjectStoreFake) ListReclaimableCalls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listReclaimableCalls)
+}
+
+func (f *ObjectStoreFake) SetMarkDeletedError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.markDeletedErr = err
+}
+
+func (f *ObjectStoreFake) MarkDeleted(ctx context.Context, objectID string, updatedAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.markDeletedCalls = append(f.markDeletedCalls, objectID)
+	return f.markDeletedErr
+}
+
+func (f *ObjectStoreFake) MarkDeletedCalls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]string, len(f.markDeletedCalls))
+	copy(calls, f.markDeletedCalls)
+	return calls
+}
@@ -9,6 +9,10 @@ type StoreFake struct {
 	BucketsStore store.BucketStore
 	CradleStore  store.CradleServerStore
 	ObjectsStore store.ObjectStore
+	DeleteQueue  store.BlobDeletionStore
+	Uploads      store.MultipartStore
+	UsersStore   store.UserStore
+	Keys         store.AccessKeyStore
 }
 
 var _ store.Store = (*StoreFake)(nil)
@@ -37,6 +41,34 @@ func WithObjects(o store.ObjectStore) StoreOption {
 	}
 }
 
+// WithBlobDeletions sets a custom BlobDeletionStore implementation.
+func WithBlobDeletions(d store.BlobDeletionStore) StoreOption {
+	return func(f *StoreFake) {
+		f.DeleteQueue = d
+	}
+}
+
+// WithMultipart sets a custom MultipartStore implementation.
+func WithMultipart(m store.MultipartStore) StoreOption {
+	return func(f *StoreFake) {
+		f.Uploads = m
+	}
+}
+
+// WithUsers sets a custom UserStore implementation.
+func WithUsers(u store.UserStore) StoreOption {
+	return func(f *StoreFake) {
+		f.UsersStore = u
+	}
+}
+
+// WithAccessKeys sets a custom AccessKeyStore implementation.
+func WithAccessKeys(k store.AccessKeyStore) StoreOption {
+	return func(f *StoreFake) {
+		f.Keys = k
+	}
+}
+
 // NewFakeStore creates a StoreFake with default fakes for all stores.
 // Use options to override specific stores.
 func NewFakeStore(opts ...StoreOption) *StoreFake {
@@ -44,6 +76,10 @@ func NewFakeStore(opts ...StoreOption) *StoreFake {
 		BucketsStore: NewFakeBucketStore(),
 		CradleStore:  NewFakeCradleStore(),
 		ObjectsStore: NewFakeObjectStore(),
+		DeleteQueue:  NewFakeBlobDeletionStore(),
+		Uploads:      NewFakeMultipartStore(),
+		UsersStore:   NewFakeUserStore(),
+		Keys:         NewFakeAccessKeyStore(),
 	}
 	for _, opt := range opts {
 		opt(f)
@@ -62,3 +98,19 @@ func (f *StoreFake) CradleServers() store.CradleServerStore {
 func (f *StoreFake) Objects() store.ObjectStore {
 	return f.ObjectsStore
 }
+
+func (f *StoreFake) BlobDeletions() store.BlobDeletionStore {
+	return f.DeleteQueue
+}
+
+func (f *StoreFake) Multipart() store.MultipartStore {
+	return f.Uploads
+}
+
+func (f *StoreFake) Users() store.UserStore {
+	return f.UsersStore
+}
+
+func (f *StoreFake) AccessKeys() store.AccessKeyStore {
+	return f.Keys
+}
@@ -0,0 +1,187 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/store"
+)
+
+// UserCreateCall captures the parameters for Create and Ensure invocations.
+type UserCreateCall struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+}
+
+// UserStoreFake implements store.UserStore for tests.
+type UserStoreFake struct {
+	mu          sync.Mutex
+	createErr   error
+	createCalls []UserCreateCall
+	ensureErr   error
+	ensureCalls []UserCreateCall
+
+	getByNameErr      error
+	getByNameResponse store.UserRecord
+	getByNameCalls    []string
+
+	getByIDErr      error
+	getByIDResponse store.UserRecord
+	getByIDCalls    []string
+
+	listErr     error
+	listRecords []store.UserRecord
+}
+
+var _ store.UserStore = (*UserStoreFake)(nil)
+
+func NewFakeUserStore() *UserStoreFake {
+	return &UserStoreFake{}
+}
+
+func (f *UserStoreFake) SetCreateError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createErr = err
+}
+
+func (f *UserStoreFake) Create(ctx context.Context, id, name string, createdAt time.Time) (store.UserRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.createCalls = append(f.createCalls, UserCreateCall{ID: id, Name: name, CreatedAt: createdAt})
+
+	if f.createErr != nil {
+		return store.UserRecord{}, f.createErr
+	}
+	return store.UserRecord{ID: id, Name: name, CreatedAt: createdAt, UpdatedAt: createdAt}, nil
+}
+
+func (f *UserStoreFake) CreateCalls() []UserCreateCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]UserCreateCall, len(f.createCalls))
+	copy(calls, f.createCalls)
+	return calls
+}
+
+func (f *UserStoreFake) SetEnsureError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.ensureErr = err
+}
+
+func (f *UserStoreFake) Ensure(ctx context.Context, id, name string, createdAt time.Time) (store.UserRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.ensureCalls = append(f.ensureCalls, UserCreateCall{ID: id, Name: name, CreatedAt: createdAt})
+
+	if f.ensureErr != nil {
+		return store.UserRecord{}, f.ensureErr
+	}
+	return store.UserRecord{ID: id, Name: name, CreatedAt: createdAt, UpdatedAt: createdAt}, nil
+}
+
+func (f *UserStoreFake) EnsureCalls() []UserCreateCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]UserCreateCall, len(f.ensureCalls))
+	copy(calls, f.ensureCalls)
+	return calls
+}
+
+func (f *UserStoreFake) SetGetByNameResponse(rec store.UserRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getByNameResponse = rec
+}
+
+func (f *UserStoreFake) SetGetByNameError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getByNameErr = err
+}
+
+func (f *UserStoreFake) GetByName(ctx context.Context, name string) (store.UserRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.getByNameCalls = append(f.getByNameCalls, name)
+
+	if f.getByNameErr != nil {
+		return store.UserRecord{}, f.getByNameErr
+	}
+	return f.getByNameResponse, nil
+}
+
+func (f *UserStoreFake) GetByNameCalls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]string, len(f.getByNameCalls))
+	copy(calls, f.getByNameCalls)
+	return calls
+}
+
+func (f *UserStoreFake) SetGetByIDResponse(rec store.UserRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getByIDResponse = rec
+}
+
+func (f *UserStoreFake) SetGetByIDError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getByIDErr = err
+}
+
+func (f *UserStoreFake) GetByID(ctx context.Context, id string) (store.UserRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.getByIDCalls = append(f.getByIDCalls, id)
+
+	if f.getByIDErr != nil {
+		return store.UserRecord{}, f.getByIDErr
+	}
+	return f.getByIDResponse, nil
+}
+
+func (f *UserStoreFake) GetByIDCalls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]string, len(f.getByIDCalls))
+	copy(calls, f.getByIDCalls)
+	return calls
+}
+
+func (f *UserStoreFake) SetListRecords(records []store.UserRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listRecords = append([]store.UserRecord(nil), records...)
+}
+
+func (f *UserStoreFake) SetListError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listErr = err
+}
+
+func (f *UserStoreFake) List(ctx context.Context) ([]store.UserRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+
+	records := make([]store.UserRecord, len(f.listRecords))
+	copy(records, f.listRecords)
+	return records, nil
+}
@@ -0,0 +1,60 @@
+package uploadsweep
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// batchSize bounds how many uploads one sweep aborts.
+const batchSize = 100
+
+type UploadStore interface {
+	AbortStale(ctx context.Context, createdBefore time.Time, limit int, updatedAt time.Time) ([]string, error)
+}
+
+// Worker aborts multipart uploads left IN_PROGRESS longer than maxAge, such
+// as those of a client that crashed mid-upload. Aborting queues the uploads'
+// part blobs, which the cleanup worker then deletes from their cradles.
+type Worker struct {
+	uploads  UploadStore
+	maxAge   time.Duration
+	interval time.Duration
+}
+
+func New(uploads UploadStore, maxAge, interval time.Duration) *Worker {
+	return &Worker{
+		uploads,
+		maxAge,
+		interval,
+	}
+}
+
+func (w *Worker) Run(ctx context.Context) {
+	slog.Debug("starting upload sweep worker", "max_age", w.maxAge)
+	w.sweep(ctx)
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			w.sweep(ctx)
+		}
+	}
+}
+
+func (w *Worker) sweep(ctx context.Context) {
+	now := time.Now().UTC()
+	ids, err := w.uploads.AbortStale(ctx, now.Add(-w.maxAge), batchSize, now)
+	if err != nil {
+		slog.Error("upload sweep abort stale", "err", err)
+		return
+	}
+
+	slog.Debug("upload sweep tick", "aborted", len(ids))
+	for _, id := range ids {
+		slog.Info("upload sweep aborted stale upload", "upload_id", id)
+	}
+}
@@ -0,0 +1,88 @@
+package uploadsweep_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ratdaddy/blockcloset/gantry/internal/testutil"
+	"github.com/ratdaddy/blockcloset/gantry/internal/uploadsweep"
+)
+
+func TestWorker_AbortsStaleUploads(t *testing.T) {
+	t.Parallel()
+
+	type tc struct {
+		name     string
+		aborted  []string
+		abortErr error
+	}
+
+	cases := []tc{
+		{
+			name:    "aborts uploads older than max age",
+			aborted: []string{"upload-1", "upload-2"},
+		},
+		{
+			name: "nothing stale",
+		},
+		{
+			name:     "store failure is retried next tick",
+			abortErr: errors.New("database is locked"),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			uploads := testutil.NewFakeMultipartStore()
+			uploads.SetAbortStaleResponse(c.aborted)
+			if c.abortErr != nil {
+				uploads.SetAbortStaleError(c.abortErr)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			maxAge := 7 * 24 * time.Hour
+			worker := uploadsweep.New(uploads, maxAge, time.Hour)
+
+			before := time.Now().UTC()
+			done := make(chan struct{})
+			go func() {
+				worker.Run(ctx)
+				close(done)
+			}()
+
+			deadline := time.After(time.Second)
+			for len(uploads.AbortStaleCalls()) == 0 {
+				select {
+				case <-deadline:
+					t.Fatal("timeout waiting for upload sweep")
+				case <-time.After(time.Millisecond):
+				}
+			}
+
+			cancel()
+			<-done
+
+			calls := uploads.AbortStaleCalls()
+			if len(calls) != 1 {
+				t.Fatalf("AbortStale calls: got %d, want 1", len(calls))
+			}
+
+			call := calls[0]
+			if call.Limit <= 0 {
+				t.Fatalf("AbortStale limit: got %d, want > 0", call.Limit)
+			}
+			if got := call.UpdatedAt.Sub(call.CreatedBefore); got != maxAge {
+				t.Fatalf("AbortStale cutoff: got %v before now, want %v", got, maxAge)
+			}
+			if call.UpdatedAt.Before(before) {
+				t.Fatalf("AbortStale updatedAt %v before sweep started %v", call.UpdatedAt, before)
+			}
+		})
+	}
+}
@@ -0,0 +1 @@
+ALTER TABLE objects ADD COLUMN content_type TEXT;
@@ -0,0 +1,33 @@
+-- SQLite can't alter a CHECK constraint, so the table is rebuilt to allow the
+-- terminal DELETED state recorded once a cradle confirms a blob is gone.
+CREATE TABLE objects_new (
+    object_id TEXT PRIMARY KEY,
+    bucket_id TEXT NOT NULL,
+    key TEXT NOT NULL,
+    state TEXT NOT NULL CHECK (state IN ('PENDING','COMMITTED','FAILED','REPLACED','DELETED')),
+    size_expected INTEGER NOT NULL,
+    size_actual INTEGER,
+    last_modified INTEGER,
+    cradle_server_id TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    content_type TEXT,
+    FOREIGN KEY (bucket_id) REFERENCES buckets(id) ON DELETE RESTRICT,
+    FOREIGN KEY (cradle_server_id) REFERENCES cradle_servers(id) ON DELETE RESTRICT
+);
+
+INSERT INTO objects_new (object_id, bucket_id, key, state, size_expected, size_actual, last_modified, cradle_server_id, created_at, updated_at, content_type)
+SELECT object_id, bucket_id, key, state, size_expected, size_actual, last_modified, cradle_server_id, created_at, updated_at, content_type
+FROM objects;
+
+DROP TABLE objects;
+ALTER TABLE objects_new RENAME TO objects;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_objects_committed_unique
+    ON objects(bucket_id, key) WHERE state = 'COMMITTED';
+
+CREATE INDEX IF NOT EXISTS idx_objects_bucket_key
+    ON objects(bucket_id, key);
+
+CREATE INDEX IF NOT EXISTS idx_objects_reclaimable
+    ON objects(updated_at) WHERE state IN ('REPLACED','FAILED');
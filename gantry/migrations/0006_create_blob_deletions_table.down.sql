@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_blob_deletions_created_at;
+DROP TABLE IF EXISTS blob_deletions;
@@ -0,0 +1,14 @@
+-- Blobs whose object rows were removed with their bucket. The bucket name is
+-- copied here because the bucket row is gone by the time a cradle is asked to
+-- delete the blob.
+CREATE TABLE IF NOT EXISTS blob_deletions (
+    object_id TEXT PRIMARY KEY,
+    bucket_name TEXT NOT NULL,
+    cradle_server_id TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    FOREIGN KEY (cradle_server_id) REFERENCES cradle_servers(id) ON DELETE RESTRICT
+);
+
+CREATE INDEX IF NOT EXISTS idx_blob_deletions_created_at
+    ON blob_deletions(created_at);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_parts_uploaded_unique;
+DROP TABLE IF EXISTS parts;
+DROP INDEX IF EXISTS idx_multipart_uploads_bucket_key;
+DROP TABLE IF EXISTS multipart_uploads;
+ALTER TABLE objects DROP COLUMN part_count;
+ALTER TABLE objects DROP COLUMN etag;
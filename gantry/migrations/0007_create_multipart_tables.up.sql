@@ -0,0 +1,41 @@
+-- A multipart object has no blob of its own: its bytes live in the part blobs
+-- recorded in parts, and part_count says how many there are. etag holds the
+-- S3 "-N" ETag of a completed multipart upload.
+ALTER TABLE objects ADD COLUMN etag TEXT;
+ALTER TABLE objects ADD COLUMN part_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS multipart_uploads (
+    upload_id TEXT PRIMARY KEY,
+    bucket_id TEXT NOT NULL,
+    key TEXT NOT NULL,
+    state TEXT NOT NULL CHECK (state IN ('IN_PROGRESS','COMPLETED','ABORTED')),
+    content_type TEXT,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    FOREIGN KEY (bucket_id) REFERENCES buckets(id) ON DELETE RESTRICT
+);
+
+CREATE INDEX IF NOT EXISTS idx_multipart_uploads_bucket_key
+    ON multipart_uploads(bucket_id, key);
+
+-- Each uploaded part is a blob on a cradle. Re-uploading a part number
+-- replaces the previous blob, so only one UPLOADED row exists per number.
+-- Once an upload completes its parts belong to the object whose object_id
+-- equals the upload_id.
+CREATE TABLE IF NOT EXISTS parts (
+    blob_id TEXT PRIMARY KEY,
+    upload_id TEXT NOT NULL,
+    part_number INTEGER NOT NULL CHECK (part_number BETWEEN 1 AND 10000),
+    state TEXT NOT NULL CHECK (state IN ('PENDING','UPLOADED')),
+    size_expected INTEGER NOT NULL,
+    size_actual INTEGER,
+    last_modified INTEGER,
+    cradle_server_id TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    FOREIGN KEY (upload_id) REFERENCES multipart_uploads(upload_id) ON DELETE RESTRICT,
+    FOREIGN KEY (cradle_server_id) REFERENCES cradle_servers(id) ON DELETE RESTRICT
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_parts_uploaded_unique
+    ON parts(upload_id, part_number) WHERE state = 'UPLOADED';
@@ -0,0 +1,10 @@
+-- Access keys sign S3 requests with SigV4. Flatbed fetches the secret by
+-- access_key_id to check each request's signature, so it is kept
+-- recoverable rather than hashed.
+CREATE TABLE IF NOT EXISTS access_keys (
+    id TEXT PRIMARY KEY,
+    access_key_id TEXT NOT NULL UNIQUE,
+    secret_access_key TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
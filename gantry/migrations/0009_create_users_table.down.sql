@@ -0,0 +1,33 @@
+-- SQLite can't drop a column with a foreign key, so buckets is rebuilt
+-- without owner_id. Foreign keys are off while it is swapped out, or
+-- dropping it would be refused for the objects and uploads that still
+-- reference it.
+PRAGMA foreign_keys = OFF;
+
+CREATE TABLE buckets_old (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+
+INSERT INTO buckets_old (id, name, created_at, updated_at)
+SELECT id, name, created_at, updated_at
+FROM buckets;
+
+DROP TABLE buckets;
+ALTER TABLE buckets_old RENAME TO buckets;
+
+DROP TABLE IF EXISTS access_keys;
+
+CREATE TABLE access_keys (
+    id TEXT PRIMARY KEY,
+    access_key_id TEXT NOT NULL UNIQUE,
+    secret_access_key TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+
+DROP TABLE IF EXISTS users;
+
+PRAGMA foreign_keys = ON;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS users (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+
+-- Every access key now belongs to a user and its secret is stored sealed
+-- with gantry's secret key instead of in plaintext. Keys from 0008 can't be
+-- re-encrypted in SQL, so they are dropped; gantry re-seeds its bootstrap
+-- key on startup.
+DROP TABLE IF EXISTS access_keys;
+
+CREATE TABLE access_keys (
+    id TEXT PRIMARY KEY,
+    access_key_id TEXT NOT NULL UNIQUE,
+    user_id TEXT NOT NULL,
+    secret_ciphertext BLOB NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('Active','Inactive')),
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE RESTRICT
+);
+
+CREATE INDEX IF NOT EXISTS idx_access_keys_user
+    ON access_keys(user_id);
+
+-- Buckets created before identity existed have no owner.
+ALTER TABLE buckets ADD COLUMN owner_id TEXT REFERENCES users(id) ON DELETE RESTRICT;
@@ -0,0 +1,7 @@
+-- Canned ACL and bucket policy, both checked by gantry on every object and
+-- listing request. Existing buckets stay private; the policy document is
+-- stored exactly as it was put.
+ALTER TABLE buckets ADD COLUMN acl TEXT NOT NULL DEFAULT 'private'
+    CHECK (acl IN ('private', 'public-read', 'public-read-write', 'authenticated-read'));
+
+ALTER TABLE buckets ADD COLUMN policy TEXT;
@@ -0,0 +1,4 @@
+-- MD5 of each part's bytes, hex encoded, computed by flatbed while the part
+-- streamed to its cradle. Parts uploaded before this column existed keep a
+-- NULL etag and go on using their blob ID.
+ALTER TABLE parts ADD COLUMN etag TEXT;
@@ -0,0 +1,2 @@
+ALTER TABLE objects DROP COLUMN checksum;
+ALTER TABLE objects DROP COLUMN checksum_algorithm;
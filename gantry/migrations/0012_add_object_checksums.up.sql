@@ -0,0 +1,6 @@
+-- Additional checksum a client sent with a PUT (x-amz-checksum-*): the
+-- algorithm as S3 names it and the base64 digest flatbed verified.
+ALTER TABLE objects ADD COLUMN checksum_algorithm TEXT
+    CHECK (checksum_algorithm IN ('CRC32', 'CRC32C', 'SHA1', 'SHA256'));
+
+ALTER TABLE objects ADD COLUMN checksum TEXT;
@@ -0,0 +1,10 @@
+-- Headers an object was uploaded with, replayed on GET and HEAD: the
+-- standard headers under their lowercased names and user metadata as
+-- x-amz-meta-<name>. Content-Type stays on objects.
+CREATE TABLE IF NOT EXISTS object_metadata (
+    object_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    value TEXT NOT NULL,
+    PRIMARY KEY (object_id, name),
+    FOREIGN KEY (object_id) REFERENCES objects(object_id) ON DELETE CASCADE
+);
@@ -0,0 +1,57 @@
+-- Noncurrent versions become REPLACED so their blobs are reclaimed, and
+-- delete markers, which have no blob, are dropped. A noncurrent multipart
+-- object is marked DELETED without queueing its parts, leaving those blobs
+-- on their cradles. Foreign keys are off while objects is swapped out, or
+-- dropping it would cascade to object_metadata.
+PRAGMA foreign_keys = OFF;
+
+CREATE TABLE objects_old (
+    object_id TEXT PRIMARY KEY,
+    bucket_id TEXT NOT NULL,
+    key TEXT NOT NULL,
+    state TEXT NOT NULL CHECK (state IN ('PENDING','COMMITTED','FAILED','REPLACED','DELETED')),
+    size_expected INTEGER NOT NULL,
+    size_actual INTEGER,
+    last_modified INTEGER,
+    cradle_server_id TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    content_type TEXT,
+    etag TEXT,
+    part_count INTEGER NOT NULL DEFAULT 0,
+    checksum_algorithm TEXT
+        CHECK (checksum_algorithm IN ('CRC32', 'CRC32C', 'SHA1', 'SHA256')),
+    checksum TEXT,
+    FOREIGN KEY (bucket_id) REFERENCES buckets(id) ON DELETE RESTRICT,
+    FOREIGN KEY (cradle_server_id) REFERENCES cradle_servers(id) ON DELETE RESTRICT
+);
+
+INSERT INTO objects_old (object_id, bucket_id, key, state, size_expected, size_actual, last_modified, cradle_server_id, created_at, updated_at, content_type, etag, part_count, checksum_algorithm, checksum)
+SELECT object_id, bucket_id, key,
+    CASE
+        WHEN state = 'NONCURRENT' AND part_count > 0 THEN 'DELETED'
+        WHEN state = 'NONCURRENT' THEN 'REPLACED'
+        ELSE state
+    END,
+    size_expected, size_actual, last_modified, cradle_server_id, created_at, updated_at, content_type, etag, part_count, checksum_algorithm, checksum
+FROM objects
+WHERE state != 'DELETE_MARKER';
+
+DELETE FROM object_metadata
+WHERE object_id NOT IN (SELECT object_id FROM objects_old);
+
+DROP TABLE objects;
+ALTER TABLE objects_old RENAME TO objects;
+
+PRAGMA foreign_keys = ON;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_objects_committed_unique
+    ON objects(bucket_id, key) WHERE state = 'COMMITTED';
+
+CREATE INDEX IF NOT EXISTS idx_objects_bucket_key
+    ON objects(bucket_id, key);
+
+CREATE INDEX IF NOT EXISTS idx_objects_reclaimable
+    ON objects(updated_at) WHERE state IN ('REPLACED','FAILED');
+
+ALTER TABLE buckets DROP COLUMN versioning;
@@ -0,0 +1,60 @@
+-- A bucket's versioning status as S3 names it. NULL means versioning was
+-- never enabled, which S3 reports differently from Suspended.
+ALTER TABLE buckets ADD COLUMN versioning TEXT
+    CHECK (versioning IN ('Enabled', 'Suspended'));
+
+-- SQLite can't alter a CHECK constraint, so the table is rebuilt. In a
+-- versioned bucket a replaced or deleted object is kept as a NONCURRENT
+-- version, and deleting a key records a DELETE_MARKER, which has no blob and
+-- so no cradle. version_id is NULL for S3's "null" version, the only kind an
+-- unversioned bucket has. committed_at orders a key's versions: it is when
+-- the row became the current version. Foreign keys are off while objects is
+-- swapped out, or dropping it would cascade to object_metadata.
+PRAGMA foreign_keys = OFF;
+
+CREATE TABLE objects_new (
+    object_id TEXT PRIMARY KEY,
+    bucket_id TEXT NOT NULL,
+    key TEXT NOT NULL,
+    state TEXT NOT NULL CHECK (state IN ('PENDING','COMMITTED','FAILED','REPLACED','DELETED','NONCURRENT','DELETE_MARKER')),
+    size_expected INTEGER NOT NULL,
+    size_actual INTEGER,
+    last_modified INTEGER,
+    cradle_server_id TEXT,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    content_type TEXT,
+    etag TEXT,
+    part_count INTEGER NOT NULL DEFAULT 0,
+    checksum_algorithm TEXT
+        CHECK (checksum_algorithm IN ('CRC32', 'CRC32C', 'SHA1', 'SHA256')),
+    checksum TEXT,
+    version_id TEXT,
+    committed_at INTEGER,
+    FOREIGN KEY (bucket_id) REFERENCES buckets(id) ON DELETE RESTRICT,
+    FOREIGN KEY (cradle_server_id) REFERENCES cradle_servers(id) ON DELETE RESTRICT
+);
+
+INSERT INTO objects_new (object_id, bucket_id, key, state, size_expected, size_actual, last_modified, cradle_server_id, created_at, updated_at, content_type, etag, part_count, checksum_algorithm, checksum, committed_at)
+SELECT object_id, bucket_id, key, state, size_expected, size_actual, last_modified, cradle_server_id, created_at, updated_at, content_type, etag, part_count, checksum_algorithm, checksum,
+    CASE WHEN state = 'COMMITTED' THEN updated_at END
+FROM objects;
+
+DROP TABLE objects;
+ALTER TABLE objects_new RENAME TO objects;
+
+PRAGMA foreign_keys = ON;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_objects_committed_unique
+    ON objects(bucket_id, key) WHERE state = 'COMMITTED';
+
+-- A key has at most one null version.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_objects_null_version_unique
+    ON objects(bucket_id, key)
+    WHERE version_id IS NULL AND state IN ('COMMITTED','NONCURRENT','DELETE_MARKER');
+
+CREATE INDEX IF NOT EXISTS idx_objects_bucket_key
+    ON objects(bucket_id, key);
+
+CREATE INDEX IF NOT EXISTS idx_objects_reclaimable
+    ON objects(updated_at) WHERE state IN ('REPLACED','FAILED');
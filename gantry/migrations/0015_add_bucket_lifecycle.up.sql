@@ -0,0 +1,3 @@
+-- A bucket's lifecycle rules, as the JSON array the lifecycle worker reads.
+-- NULL means the bucket has no lifecycle configuration.
+ALTER TABLE buckets ADD COLUMN lifecycle TEXT;
@@ -0,0 +1,3 @@
+-- A bucket's CORS rules, as the JSON array flatbed evaluates browser requests
+-- against. NULL means the bucket has no CORS configuration.
+ALTER TABLE buckets ADD COLUMN cors TEXT;
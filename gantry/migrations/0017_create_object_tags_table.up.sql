@@ -0,0 +1,9 @@
+-- Tags on an object version, set on upload with x-amz-tagging or later with
+-- PutObjectTagging. Lifecycle rules can filter on them.
+CREATE TABLE IF NOT EXISTS object_tags (
+    object_id TEXT NOT NULL,
+    key TEXT NOT NULL,
+    value TEXT NOT NULL,
+    PRIMARY KEY (object_id, key),
+    FOREIGN KEY (object_id) REFERENCES objects(object_id) ON DELETE CASCADE
+);
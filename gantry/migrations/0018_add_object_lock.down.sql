@@ -0,0 +1,4 @@
+ALTER TABLE objects DROP COLUMN legal_hold;
+ALTER TABLE objects DROP COLUMN retain_until;
+ALTER TABLE objects DROP COLUMN retention_mode;
+ALTER TABLE buckets DROP COLUMN object_lock;
@@ -0,0 +1,12 @@
+-- A bucket's object lock configuration, as the JSON object naming its
+-- default retention, if it has one. NULL means object lock was never
+-- enabled; once enabled it can't be turned off.
+ALTER TABLE buckets ADD COLUMN object_lock TEXT;
+
+-- A version's object lock: a retention in GOVERNANCE or COMPLIANCE mode
+-- until retain_until, in microseconds, and a legal hold. A version under a
+-- legal hold or an unexpired retention may not be removed or replaced.
+ALTER TABLE objects ADD COLUMN retention_mode TEXT
+    CHECK (retention_mode IN ('GOVERNANCE', 'COMPLIANCE'));
+ALTER TABLE objects ADD COLUMN retain_until INTEGER;
+ALTER TABLE objects ADD COLUMN legal_hold INTEGER NOT NULL DEFAULT 0;
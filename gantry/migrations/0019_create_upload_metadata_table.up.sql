@@ -0,0 +1,9 @@
+-- Headers a multipart upload was initiated with, named as in
+-- object_metadata and copied there when the upload completes.
+CREATE TABLE IF NOT EXISTS upload_metadata (
+    upload_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    value TEXT NOT NULL,
+    PRIMARY KEY (upload_id, name),
+    FOREIGN KEY (upload_id) REFERENCES multipart_uploads(upload_id) ON DELETE CASCADE
+);
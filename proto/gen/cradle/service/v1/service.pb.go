@@ -295,6 +295,407 @@ func (x *HeartbeatResponse) GetAvailableBytes() int64 {
 	return 0
 }
 
+type ReadObjectRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ObjectId string                 `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Bucket   string                 `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// offset and length select a byte range of the blob. A zero length reads
+	// through to the end.
+	Offset        int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length        int64 `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadObjectRequest) Reset() {
+	*x = ReadObjectRequest{}
+	mi := &file_cradle_service_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadObjectRequest) ProtoMessage() {}
+
+func (x *ReadObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cradle_service_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadObjectRequest.ProtoReflect.Descriptor instead.
+func (*ReadObjectRequest) Descriptor() ([]byte, []int) {
+	return file_cradle_service_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReadObjectRequest) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *ReadObjectRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ReadObjectRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReadObjectRequest) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type ReadObjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadObjectResponse) Reset() {
+	*x = ReadObjectResponse{}
+	mi := &file_cradle_service_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadObjectResponse) ProtoMessage() {}
+
+func (x *ReadObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cradle_service_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadObjectResponse.ProtoReflect.Descriptor instead.
+func (*ReadObjectResponse) Descriptor() ([]byte, []int) {
+	return file_cradle_service_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReadObjectResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type DeleteObjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectId      string                 `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Bucket        string                 `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteObjectRequest) Reset() {
+	*x = DeleteObjectRequest{}
+	mi := &file_cradle_service_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteObjectRequest) ProtoMessage() {}
+
+func (x *DeleteObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cradle_service_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteObjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteObjectRequest) Descriptor() ([]byte, []int) {
+	return file_cradle_service_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteObjectRequest) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *DeleteObjectRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type DeleteObjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteObjectResponse) Reset() {
+	*x = DeleteObjectResponse{}
+	mi := &file_cradle_service_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteObjectResponse) ProtoMessage() {}
+
+func (x *DeleteObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cradle_service_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteObjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteObjectResponse) Descriptor() ([]byte, []int) {
+	return file_cradle_service_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+// CopyObjectRequest asks a cradle to store a new blob made of the sources
+// read in order from the cradles that hold them, so copied bytes never pass
+// through flatbed.
+type CopyObjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectId      string                 `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Bucket        string                 `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Sources       []*CopySource          `protobuf:"bytes,4,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyObjectRequest) Reset() {
+	*x = CopyObjectRequest{}
+	mi := &file_cradle_service_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyObjectRequest) ProtoMessage() {}
+
+func (x *CopyObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cradle_service_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyObjectRequest.ProtoReflect.Descriptor instead.
+func (*CopyObjectRequest) Descriptor() ([]byte, []int) {
+	return file_cradle_service_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CopyObjectRequest) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *CopyObjectRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *CopyObjectRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CopyObjectRequest) GetSources() []*CopySource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type CopySource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CradleAddress string                 `protobuf:"bytes,1,opt,name=cradle_address,json=cradleAddress,proto3" json:"cradle_address,omitempty"`
+	ObjectId      string                 `protobuf:"bytes,2,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Bucket        string                 `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopySource) Reset() {
+	*x = CopySource{}
+	mi := &file_cradle_service_v1_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopySource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopySource) ProtoMessage() {}
+
+func (x *CopySource) ProtoReflect() protoreflect.Message {
+	mi := &file_cradle_service_v1_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopySource.ProtoReflect.Descriptor instead.
+func (*CopySource) Descriptor() ([]byte, []int) {
+	return file_cradle_service_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CopySource) GetCradleAddress() string {
+	if x != nil {
+		return x.CradleAddress
+	}
+	return ""
+}
+
+func (x *CopySource) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *CopySource) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *CopySource) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type CopyObjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BytesWritten  int64                  `protobuf:"varint,1,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	CommittedAtMs int64                  `protobuf:"varint,2,opt,name=committed_at_ms,json=committedAtMs,proto3" json:"committed_at_ms,omitempty"`
+	Md5           []byte                 `protobuf:"bytes,3,opt,name=md5,proto3" json:"md5,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyObjectResponse) Reset() {
+	*x = CopyObjectResponse{}
+	mi := &file_cradle_service_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyObjectResponse) ProtoMessage() {}
+
+func (x *CopyObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cradle_service_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyObjectResponse.ProtoReflect.Descriptor instead.
+func (*CopyObjectResponse) Descriptor() ([]byte, []int) {
+	return file_cradle_service_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CopyObjectResponse) GetBytesWritten() int64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+func (x *CopyObjectResponse) GetCommittedAtMs() int64 {
+	if x != nil {
+		return x.CommittedAtMs
+	}
+	return 0
+}
+
+func (x *CopyObjectResponse) GetMd5() []byte {
+	if x != nil {
+		return x.Md5
+	}
+	return nil
+}
+
 var File_cradle_service_v1_service_proto protoreflect.FileDescriptor
 
 const file_cradle_service_v1_service_proto_rawDesc = "" +
@@ -313,10 +714,41 @@ const file_cradle_service_v1_service_proto_rawDesc = "" +
 	"\x0fcommitted_at_ms\x18\x02 \x01(\x03R\rcommittedAtMs\"\x12\n" +
 	"\x10HeartbeatRequest\"B\n" +
 	"\x11HeartbeatResponse\x12'\n" +
-	"\x0favailable_bytes\x18\x01 \x01(\x03R\x0eavailableBytesJ\x04\b\x02\x10\v2\xc7\x01\n" +
+	"\x0favailable_bytes\x18\x01 \x01(\x03R\x0eavailableBytesJ\x04\b\x02\x10\v\"x\n" +
+	"\x11ReadObjectRequest\x12\x1b\n" +
+	"\tobject_id\x18\x01 \x01(\tR\bobjectId\x12\x16\n" +
+	"\x06bucket\x18\x02 \x01(\tR\x06bucket\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\x12\x16\n" +
+	"\x06length\x18\x04 \x01(\x03R\x06length\"*\n" +
+	"\x12ReadObjectResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"J\n" +
+	"\x13DeleteObjectRequest\x12\x1b\n" +
+	"\tobject_id\x18\x01 \x01(\tR\bobjectId\x12\x16\n" +
+	"\x06bucket\x18\x02 \x01(\tR\x06bucket\"\x16\n" +
+	"\x14DeleteObjectResponse\"\x95\x01\n" +
+	"\x11CopyObjectRequest\x12\x1b\n" +
+	"\tobject_id\x18\x01 \x01(\tR\bobjectId\x12\x16\n" +
+	"\x06bucket\x18\x02 \x01(\tR\x06bucket\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x127\n" +
+	"\asources\x18\x04 \x03(\v2\x1d.cradle.service.v1.CopySourceR\asources\"|\n" +
+	"\n" +
+	"CopySource\x12%\n" +
+	"\x0ecradle_address\x18\x01 \x01(\tR\rcradleAddress\x12\x1b\n" +
+	"\tobject_id\x18\x02 \x01(\tR\bobjectId\x12\x16\n" +
+	"\x06bucket\x18\x03 \x01(\tR\x06bucket\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\"s\n" +
+	"\x12CopyObjectResponse\x12#\n" +
+	"\rbytes_written\x18\x01 \x01(\x03R\fbytesWritten\x12&\n" +
+	"\x0fcommitted_at_ms\x18\x02 \x01(\x03R\rcommittedAtMs\x12\x10\n" +
+	"\x03md5\x18\x03 \x01(\fR\x03md52\xe0\x03\n" +
 	"\rCradleService\x12^\n" +
 	"\vWriteObject\x12%.cradle.service.v1.WriteObjectRequest\x1a&.cradle.service.v1.WriteObjectResponse(\x01\x12V\n" +
-	"\tHeartbeat\x12#.cradle.service.v1.HeartbeatRequest\x1a$.cradle.service.v1.HeartbeatResponseB\xd2\x01\n" +
+	"\tHeartbeat\x12#.cradle.service.v1.HeartbeatRequest\x1a$.cradle.service.v1.HeartbeatResponse\x12[\n" +
+	"\n" +
+	"ReadObject\x12$.cradle.service.v1.ReadObjectRequest\x1a%.cradle.service.v1.ReadObjectResponse0\x01\x12_\n" +
+	"\fDeleteObject\x12&.cradle.service.v1.DeleteObjectRequest\x1a'.cradle.service.v1.DeleteObjectResponse\x12Y\n" +
+	"\n" +
+	"CopyObject\x12$.cradle.service.v1.CopyObjectRequest\x1a%.cradle.service.v1.CopyObjectResponseB\xd2\x01\n" +
 	"\x15com.cradle.service.v1B\fServiceProtoP\x01ZEgithub.com/ratdaddy/blockcloset/proto/gen/cradle/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cradle.Service.V1\xca\x02\x11Cradle\\Service\\V1\xe2\x02\x1dCradle\\Service\\V1\\GPBMetadata\xea\x02\x13Cradle::Service::V1b\x06proto3"
 
 var (
@@ -331,25 +763,39 @@ func file_cradle_service_v1_service_proto_rawDescGZIP() []byte {
 	return file_cradle_service_v1_service_proto_rawDescData
 }
 
-var file_cradle_service_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cradle_service_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_cradle_service_v1_service_proto_goTypes = []any{
-	(*WriteObjectRequest)(nil),  // 0: cradle.service.v1.WriteObjectRequest
-	(*WriteObjectMetadata)(nil), // 1: cradle.service.v1.WriteObjectMetadata
-	(*WriteObjectResponse)(nil), // 2: cradle.service.v1.WriteObjectResponse
-	(*HeartbeatRequest)(nil),    // 3: cradle.service.v1.HeartbeatRequest
-	(*HeartbeatResponse)(nil),   // 4: cradle.service.v1.HeartbeatResponse
+	(*WriteObjectRequest)(nil),   // 0: cradle.service.v1.WriteObjectRequest
+	(*WriteObjectMetadata)(nil),  // 1: cradle.service.v1.WriteObjectMetadata
+	(*WriteObjectResponse)(nil),  // 2: cradle.service.v1.WriteObjectResponse
+	(*HeartbeatRequest)(nil),     // 3: cradle.service.v1.HeartbeatRequest
+	(*HeartbeatResponse)(nil),    // 4: cradle.service.v1.HeartbeatResponse
+	(*ReadObjectRequest)(nil),    // 5: cradle.service.v1.ReadObjectRequest
+	(*ReadObjectResponse)(nil),   // 6: cradle.service.v1.ReadObjectResponse
+	(*DeleteObjectRequest)(nil),  // 7: cradle.service.v1.DeleteObjectRequest
+	(*DeleteObjectResponse)(nil), // 8: cradle.service.v1.DeleteObjectResponse
+	(*CopyObjectRequest)(nil),    // 9: cradle.service.v1.CopyObjectRequest
+	(*CopySource)(nil),           // 10: cradle.service.v1.CopySource
+	(*CopyObjectResponse)(nil),   // 11: cradle.service.v1.CopyObjectResponse
 }
 var file_cradle_service_v1_service_proto_depIdxs = []int32{
-	1, // 0: cradle.service.v1.WriteObjectRequest.metadata:type_name -> cradle.service.v1.WriteObjectMetadata
-	0, // 1: cradle.service.v1.CradleService.WriteObject:input_type -> cradle.service.v1.WriteObjectRequest
-	3, // 2: cradle.service.v1.CradleService.Heartbeat:input_type -> cradle.service.v1.HeartbeatRequest
-	2, // 3: cradle.service.v1.CradleService.WriteObject:output_type -> cradle.service.v1.WriteObjectResponse
-	4, // 4: cradle.service.v1.CradleService.Heartbeat:output_type -> cradle.service.v1.HeartbeatResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: cradle.service.v1.WriteObjectRequest.metadata:type_name -> cradle.service.v1.WriteObjectMetadata
+	10, // 1: cradle.service.v1.CopyObjectRequest.sources:type_name -> cradle.service.v1.CopySource
+	0,  // 2: cradle.service.v1.CradleService.WriteObject:input_type -> cradle.service.v1.WriteObjectRequest
+	3,  // 3: cradle.service.v1.CradleService.Heartbeat:input_type -> cradle.service.v1.HeartbeatRequest
+	5,  // 4: cradle.service.v1.CradleService.ReadObject:input_type -> cradle.service.v1.ReadObjectRequest
+	7,  // 5: cradle.service.v1.CradleService.DeleteObject:input_type -> cradle.service.v1.DeleteObjectRequest
+	9,  // 6: cradle.service.v1.CradleService.CopyObject:input_type -> cradle.service.v1.CopyObjectRequest
+	2,  // 7: cradle.service.v1.CradleService.WriteObject:output_type -> cradle.service.v1.WriteObjectResponse
+	4,  // 8: cradle.service.v1.CradleService.Heartbeat:output_type -> cradle.service.v1.HeartbeatResponse
+	6,  // 9: cradle.service.v1.CradleService.ReadObject:output_type -> cradle.service.v1.ReadObjectResponse
+	8,  // 10: cradle.service.v1.CradleService.DeleteObject:output_type -> cradle.service.v1.DeleteObjectResponse
+	11, // 11: cradle.service.v1.CradleService.CopyObject:output_type -> cradle.service.v1.CopyObjectResponse
+	7,  // [7:12] is the sub-list for method output_type
+	2,  // [2:7] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_cradle_service_v1_service_proto_init() }
@@ -367,7 +813,7 @@ func file_cradle_service_v1_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cradle_service_v1_service_proto_rawDesc), len(file_cradle_service_v1_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CradleService_WriteObject_FullMethodName = "/cradle.service.v1.CradleService/WriteObject"
-	CradleService_Heartbeat_FullMethodName   = "/cradle.service.v1.CradleService/Heartbeat"
+	CradleService_WriteObject_FullMethodName  = "/cradle.service.v1.CradleService/WriteObject"
+	CradleService_Heartbeat_FullMethodName    = "/cradle.service.v1.CradleService/Heartbeat"
+	CradleService_ReadObject_FullMethodName   = "/cradle.service.v1.CradleService/ReadObject"
+	CradleService_DeleteObject_FullMethodName = "/cradle.service.v1.CradleService/DeleteObject"
+	CradleService_CopyObject_FullMethodName   = "/cradle.service.v1.CradleService/CopyObject"
 )
 
 // CradleServiceClient is the client API for CradleService service.
@@ -29,6 +32,9 @@ const (
 type CradleServiceClient interface {
 	WriteObject(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[WriteObjectRequest, WriteObjectResponse], error)
 	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	ReadObject(ctx context.Context, in *ReadObjectRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReadObjectResponse], error)
+	DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*DeleteObjectResponse, error)
+	CopyObject(ctx context.Context, in *CopyObjectRequest, opts ...grpc.CallOption) (*CopyObjectResponse, error)
 }
 
 type cradleServiceClient struct {
@@ -62,12 +68,54 @@ func (c *cradleServiceClient) Heartbeat(ctx context.Context, in *HeartbeatReques
 	return out, nil
 }
 
+func (c *cradleServiceClient) ReadObject(ctx context.Context, in *ReadObjectRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReadObjectResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CradleService_ServiceDesc.Streams[1], CradleService_ReadObject_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReadObjectRequest, ReadObjectResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CradleService_ReadObjectClient = grpc.ServerStreamingClient[ReadObjectResponse]
+
+func (c *cradleServiceClient) DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*DeleteObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteObjectResponse)
+	err := c.cc.Invoke(ctx, CradleService_DeleteObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cradleServiceClient) CopyObject(ctx context.Context, in *CopyObjectRequest, opts ...grpc.CallOption) (*CopyObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyObjectResponse)
+	err := c.cc.Invoke(ctx, CradleService_CopyObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CradleServiceServer is the server API for CradleService service.
 // All implementations must embed UnimplementedCradleServiceServer
 // for forward compatibility.
 type CradleServiceServer interface {
 	WriteObject(grpc.ClientStreamingServer[WriteObjectRequest, WriteObjectResponse]) error
 	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	ReadObject(*ReadObjectRequest, grpc.ServerStreamingServer[ReadObjectResponse]) error
+	DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteObjectResponse, error)
+	CopyObject(context.Context, *CopyObjectRequest) (*CopyObjectResponse, error)
 	mustEmbedUnimplementedCradleServiceServer()
 }
 
@@ -84,6 +132,15 @@ func (UnimplementedCradleServiceServer) WriteObject(grpc.ClientStreamingServer[W
 func (UnimplementedCradleServiceServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Heartbeat not implemented")
 }
+func (UnimplementedCradleServiceServer) ReadObject(*ReadObjectRequest, grpc.ServerStreamingServer[ReadObjectResponse]) error {
+	return status.Error(codes.Unimplemented, "method ReadObject not implemented")
+}
+func (UnimplementedCradleServiceServer) DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteObjectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteObject not implemented")
+}
+func (UnimplementedCradleServiceServer) CopyObject(context.Context, *CopyObjectRequest) (*CopyObjectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CopyObject not implemented")
+}
 func (UnimplementedCradleServiceServer) mustEmbedUnimplementedCradleServiceServer() {}
 func (UnimplementedCradleServiceServer) testEmbeddedByValue()                       {}
 
@@ -130,6 +187,53 @@ func _CradleService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CradleService_ReadObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadObjectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CradleServiceServer).ReadObject(m, &grpc.GenericServerStream[ReadObjectRequest, ReadObjectResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CradleService_ReadObjectServer = grpc.ServerStreamingServer[ReadObjectResponse]
+
+func _CradleService_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CradleServiceServer).DeleteObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CradleService_DeleteObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CradleServiceServer).DeleteObject(ctx, req.(*DeleteObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CradleService_CopyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CradleServiceServer).CopyObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CradleService_CopyObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CradleServiceServer).CopyObject(ctx, req.(*CopyObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CradleService_ServiceDesc is the grpc.ServiceDesc for CradleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -141,6 +245,14 @@ var CradleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Heartbeat",
 			Handler:    _CradleService_Heartbeat_Handler,
 		},
+		{
+			MethodName: "DeleteObject",
+			Handler:    _CradleService_DeleteObject_Handler,
+		},
+		{
+			MethodName: "CopyObject",
+			Handler:    _CradleService_CopyObject_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -148,6 +260,11 @@ var CradleService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _CradleService_WriteObject_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ReadObject",
+			Handler:       _CradleService_ReadObject_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "cradle/service/v1/service.proto",
 }
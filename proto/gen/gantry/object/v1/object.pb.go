@@ -0,0 +1,457 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: gantry/object/v1/object.proto
+
+package objectv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Object struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ObjectId       string                 `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Key            string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Size           int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	LastModifiedMs int64                  `protobuf:"varint,4,opt,name=last_modified_ms,json=lastModifiedMs,proto3" json:"last_modified_ms,omitempty"`
+	CradleAddress  string                 `protobuf:"bytes,5,opt,name=cradle_address,json=cradleAddress,proto3" json:"cradle_address,omitempty"`
+	ContentType    string                 `protobuf:"bytes,6,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// S3 ETag: the hex MD5 of a single-PUT object or the "-N" ETag of a
+	// completed multipart upload. Empty for objects written before gantry
+	// stored digests.
+	Etag string `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Part blobs of a multipart object in part-number order. Empty for
+	// single-PUT objects, whose bytes live in one blob named object_id.
+	Parts []*ObjectPart `protobuf:"bytes,8,rep,name=parts,proto3" json:"parts,omitempty"`
+	// Additional checksum stored with the object, if the client sent one.
+	ChecksumAlgorithm string `protobuf:"bytes,9,opt,name=checksum_algorithm,json=checksumAlgorithm,proto3" json:"checksum_algorithm,omitempty"`
+	Checksum          string `protobuf:"bytes,10,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// Headers supplied on upload and replayed on GET and HEAD.
+	Metadata *ObjectMetadata `protobuf:"bytes,11,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// S3 version ID, "null" for the null version. Empty when the bucket was
+	// never versioned.
+	VersionId string `protobuf:"bytes,12,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Set in ListObjectVersions: whether this is the key's current version,
+	// and whether it is a delete marker rather than object data.
+	IsLatest     bool `protobuf:"varint,13,opt,name=is_latest,json=isLatest,proto3" json:"is_latest,omitempty"`
+	DeleteMarker bool `protobuf:"varint,14,opt,name=delete_marker,json=deleteMarker,proto3" json:"delete_marker,omitempty"`
+	// Tags on this version, set in LookupObject.
+	Tags map[string]string `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Object lock on this version, set in LookupObject: the retention mode
+	// ("GOVERNANCE" or "COMPLIANCE") and when it ends, both unset without a
+	// retention, and whether a legal hold is in place.
+	ObjectLockMode          string `protobuf:"bytes,16,opt,name=object_lock_mode,json=objectLockMode,proto3" json:"object_lock_mode,omitempty"`
+	ObjectLockRetainUntilMs int64  `protobuf:"varint,17,opt,name=object_lock_retain_until_ms,json=objectLockRetainUntilMs,proto3" json:"object_lock_retain_until_ms,omitempty"`
+	ObjectLockLegalHold     bool   `protobuf:"varint,18,opt,name=object_lock_legal_hold,json=objectLockLegalHold,proto3" json:"object_lock_legal_hold,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Object) Reset() {
+	*x = Object{}
+	mi := &file_gantry_object_v1_object_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object) ProtoMessage() {}
+
+func (x *Object) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_object_v1_object_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object.ProtoReflect.Descriptor instead.
+func (*Object) Descriptor() ([]byte, []int) {
+	return file_gantry_object_v1_object_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Object) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *Object) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Object) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Object) GetLastModifiedMs() int64 {
+	if x != nil {
+		return x.LastModifiedMs
+	}
+	return 0
+}
+
+func (x *Object) GetCradleAddress() string {
+	if x != nil {
+		return x.CradleAddress
+	}
+	return ""
+}
+
+func (x *Object) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Object) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Object) GetParts() []*ObjectPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *Object) GetChecksumAlgorithm() string {
+	if x != nil {
+		return x.ChecksumAlgorithm
+	}
+	return ""
+}
+
+func (x *Object) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *Object) GetMetadata() *ObjectMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Object) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *Object) GetIsLatest() bool {
+	if x != nil {
+		return x.IsLatest
+	}
+	return false
+}
+
+func (x *Object) GetDeleteMarker() bool {
+	if x != nil {
+		return x.DeleteMarker
+	}
+	return false
+}
+
+func (x *Object) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Object) GetObjectLockMode() string {
+	if x != nil {
+		return x.ObjectLockMode
+	}
+	return ""
+}
+
+func (x *Object) GetObjectLockRetainUntilMs() int64 {
+	if x != nil {
+		return x.ObjectLockRetainUntilMs
+	}
+	return 0
+}
+
+func (x *Object) GetObjectLockLegalHold() bool {
+	if x != nil {
+		return x.ObjectLockLegalHold
+	}
+	return false
+}
+
+// ObjectMetadata holds the standard headers and x-amz-meta-* values S3
+// stores with an object. Content-Type is carried separately.
+type ObjectMetadata struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ContentEncoding    string                 `protobuf:"bytes,1,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	ContentDisposition string                 `protobuf:"bytes,2,opt,name=content_disposition,json=contentDisposition,proto3" json:"content_disposition,omitempty"`
+	CacheControl       string                 `protobuf:"bytes,3,opt,name=cache_control,json=cacheControl,proto3" json:"cache_control,omitempty"`
+	Expires            string                 `protobuf:"bytes,4,opt,name=expires,proto3" json:"expires,omitempty"`
+	// x-amz-meta-* values keyed by the lowercased name after the prefix.
+	UserMetadata  map[string]string `protobuf:"bytes,5,rep,name=user_metadata,json=userMetadata,proto3" json:"user_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectMetadata) Reset() {
+	*x = ObjectMetadata{}
+	mi := &file_gantry_object_v1_object_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectMetadata) ProtoMessage() {}
+
+func (x *ObjectMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_object_v1_object_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectMetadata.ProtoReflect.Descriptor instead.
+func (*ObjectMetadata) Descriptor() ([]byte, []int) {
+	return file_gantry_object_v1_object_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ObjectMetadata) GetContentEncoding() string {
+	if x != nil {
+		return x.ContentEncoding
+	}
+	return ""
+}
+
+func (x *ObjectMetadata) GetContentDisposition() string {
+	if x != nil {
+		return x.ContentDisposition
+	}
+	return ""
+}
+
+func (x *ObjectMetadata) GetCacheControl() string {
+	if x != nil {
+		return x.CacheControl
+	}
+	return ""
+}
+
+func (x *ObjectMetadata) GetExpires() string {
+	if x != nil {
+		return x.Expires
+	}
+	return ""
+}
+
+func (x *ObjectMetadata) GetUserMetadata() map[string]string {
+	if x != nil {
+		return x.UserMetadata
+	}
+	return nil
+}
+
+type ObjectPart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlobId        string                 `protobuf:"bytes,1,opt,name=blob_id,json=blobId,proto3" json:"blob_id,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	CradleAddress string                 `protobuf:"bytes,3,opt,name=cradle_address,json=cradleAddress,proto3" json:"cradle_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectPart) Reset() {
+	*x = ObjectPart{}
+	mi := &file_gantry_object_v1_object_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectPart) ProtoMessage() {}
+
+func (x *ObjectPart) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_object_v1_object_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectPart.ProtoReflect.Descriptor instead.
+func (*ObjectPart) Descriptor() ([]byte, []int) {
+	return file_gantry_object_v1_object_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ObjectPart) GetBlobId() string {
+	if x != nil {
+		return x.BlobId
+	}
+	return ""
+}
+
+func (x *ObjectPart) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ObjectPart) GetCradleAddress() string {
+	if x != nil {
+		return x.CradleAddress
+	}
+	return ""
+}
+
+var File_gantry_object_v1_object_proto protoreflect.FileDescriptor
+
+const file_gantry_object_v1_object_proto_rawDesc = "" +
+	"\n" +
+	"\x1dgantry/object/v1/object.proto\x12\x10gantry.object.v1\"\xff\x05\n" +
+	"\x06Object\x12\x1b\n" +
+	"\tobject_id\x18\x01 \x01(\tR\bobjectId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12(\n" +
+	"\x10last_modified_ms\x18\x04 \x01(\x03R\x0elastModifiedMs\x12%\n" +
+	"\x0ecradle_address\x18\x05 \x01(\tR\rcradleAddress\x12!\n" +
+	"\fcontent_type\x18\x06 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etag\x122\n" +
+	"\x05parts\x18\b \x03(\v2\x1c.gantry.object.v1.ObjectPartR\x05parts\x12-\n" +
+	"\x12checksum_algorithm\x18\t \x01(\tR\x11checksumAlgorithm\x12\x1a\n" +
+	"\bchecksum\x18\n" +
+	" \x01(\tR\bchecksum\x12<\n" +
+	"\bmetadata\x18\v \x01(\v2 .gantry.object.v1.ObjectMetadataR\bmetadata\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\f \x01(\tR\tversionId\x12\x1b\n" +
+	"\tis_latest\x18\r \x01(\bR\bisLatest\x12#\n" +
+	"\rdelete_marker\x18\x0e \x01(\bR\fdeleteMarker\x126\n" +
+	"\x04tags\x18\x0f \x03(\v2\".gantry.object.v1.Object.TagsEntryR\x04tags\x12(\n" +
+	"\x10object_lock_mode\x18\x10 \x01(\tR\x0eobjectLockMode\x12<\n" +
+	"\x1bobject_lock_retain_until_ms\x18\x11 \x01(\x03R\x17objectLockRetainUntilMs\x123\n" +
+	"\x16object_lock_legal_hold\x18\x12 \x01(\bR\x13objectLockLegalHold\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc5\x02\n" +
+	"\x0eObjectMetadata\x12)\n" +
+	"\x10content_encoding\x18\x01 \x01(\tR\x0fcontentEncoding\x12/\n" +
+	"\x13content_disposition\x18\x02 \x01(\tR\x12contentDisposition\x12#\n" +
+	"\rcache_control\x18\x03 \x01(\tR\fcacheControl\x12\x18\n" +
+	"\aexpires\x18\x04 \x01(\tR\aexpires\x12W\n" +
+	"\ruser_metadata\x18\x05 \x03(\v22.gantry.object.v1.ObjectMetadata.UserMetadataEntryR\fuserMetadata\x1a?\n" +
+	"\x11UserMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"`\n" +
+	"\n" +
+	"ObjectPart\x12\x17\n" +
+	"\ablob_id\x18\x01 \x01(\tR\x06blobId\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12%\n" +
+	"\x0ecradle_address\x18\x03 \x01(\tR\rcradleAddressB\xca\x01\n" +
+	"\x14com.gantry.object.v1B\vObjectProtoP\x01ZCgithub.com/ratdaddy/blockcloset/proto/gen/gantry/object/v1;objectv1\xa2\x02\x03GOX\xaa\x02\x10Gantry.Object.V1\xca\x02\x10Gantry\\Object\\V1\xe2\x02\x1cGantry\\Object\\V1\\GPBMetadata\xea\x02\x12Gantry::Object::V1b\x06proto3"
+
+var (
+	file_gantry_object_v1_object_proto_rawDescOnce sync.Once
+	file_gantry_object_v1_object_proto_rawDescData []byte
+)
+
+func file_gantry_object_v1_object_proto_rawDescGZIP() []byte {
+	file_gantry_object_v1_object_proto_rawDescOnce.Do(func() {
+		file_gantry_object_v1_object_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gantry_object_v1_object_proto_rawDesc), len(file_gantry_object_v1_object_proto_rawDesc)))
+	})
+	return file_gantry_object_v1_object_proto_rawDescData
+}
+
+var file_gantry_object_v1_object_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_gantry_object_v1_object_proto_goTypes = []any{
+	(*Object)(nil),         // 0: gantry.object.v1.Object
+	(*ObjectMetadata)(nil), // 1: gantry.object.v1.ObjectMetadata
+	(*ObjectPart)(nil),     // 2: gantry.object.v1.ObjectPart
+	nil,                    // 3: gantry.object.v1.Object.TagsEntry
+	nil,                    // 4: gantry.object.v1.ObjectMetadata.UserMetadataEntry
+}
+var file_gantry_object_v1_object_proto_depIdxs = []int32{
+	2, // 0: gantry.object.v1.Object.parts:type_name -> gantry.object.v1.ObjectPart
+	1, // 1: gantry.object.v1.Object.metadata:type_name -> gantry.object.v1.ObjectMetadata
+	3, // 2: gantry.object.v1.Object.tags:type_name -> gantry.object.v1.Object.TagsEntry
+	4, // 3: gantry.object.v1.ObjectMetadata.user_metadata:type_name -> gantry.object.v1.ObjectMetadata.UserMetadataEntry
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_gantry_object_v1_object_proto_init() }
+func file_gantry_object_v1_object_proto_init() {
+	if File_gantry_object_v1_object_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gantry_object_v1_object_proto_rawDesc), len(file_gantry_object_v1_object_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gantry_object_v1_object_proto_goTypes,
+		DependencyIndexes: file_gantry_object_v1_object_proto_depIdxs,
+		MessageInfos:      file_gantry_object_v1_object_proto_msgTypes,
+	}.Build()
+	File_gantry_object_v1_object_proto = out.File
+	file_gantry_object_v1_object_proto_goTypes = nil
+	file_gantry_object_v1_object_proto_depIdxs = nil
+}
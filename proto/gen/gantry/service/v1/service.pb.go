@@ -8,7 +8,8 @@ package servicev1
 
 import (
 	v1 "github.com/ratdaddy/blockcloset/proto/gen/gantry/bucket/v1"
-	v11 "github.com/ratdaddy/blockcloset/proto/gen/gantry/write_plan/v1"
+	v11 "github.com/ratdaddy/blockcloset/proto/gen/gantry/object/v1"
+	v12 "github.com/ratdaddy/blockcloset/proto/gen/gantry/write_plan/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -23,6 +24,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AccessKeyStatus int32
+
+const (
+	AccessKeyStatus_ACCESS_KEY_STATUS_UNSPECIFIED AccessKeyStatus = 0
+	AccessKeyStatus_ACCESS_KEY_STATUS_ACTIVE      AccessKeyStatus = 1
+	AccessKeyStatus_ACCESS_KEY_STATUS_INACTIVE    AccessKeyStatus = 2
+)
+
+// Enum value maps for AccessKeyStatus.
+var (
+	AccessKeyStatus_name = map[int32]string{
+		0: "ACCESS_KEY_STATUS_UNSPECIFIED",
+		1: "ACCESS_KEY_STATUS_ACTIVE",
+		2: "ACCESS_KEY_STATUS_INACTIVE",
+	}
+	AccessKeyStatus_value = map[string]int32{
+		"ACCESS_KEY_STATUS_UNSPECIFIED": 0,
+		"ACCESS_KEY_STATUS_ACTIVE":      1,
+		"ACCESS_KEY_STATUS_INACTIVE":    2,
+	}
+)
+
+func (x AccessKeyStatus) Enum() *AccessKeyStatus {
+	p := new(AccessKeyStatus)
+	*p = x
+	return p
+}
+
+func (x AccessKeyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessKeyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_gantry_service_v1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (AccessKeyStatus) Type() protoreflect.EnumType {
+	return &file_gantry_service_v1_service_proto_enumTypes[0]
+}
+
+func (x AccessKeyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessKeyStatus.Descriptor instead.
+func (AccessKeyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
 type BucketOwnershipConflict_Reason int32
 
 const (
@@ -56,11 +106,11 @@ func (x BucketOwnershipConflict_Reason) String() string {
 }
 
 func (BucketOwnershipConflict_Reason) Descriptor() protoreflect.EnumDescriptor {
-	return file_gantry_service_v1_service_proto_enumTypes[0].Descriptor()
+	return file_gantry_service_v1_service_proto_enumTypes[1].Descriptor()
 }
 
 func (BucketOwnershipConflict_Reason) Type() protoreflect.EnumType {
-	return &file_gantry_service_v1_service_proto_enumTypes[0]
+	return &file_gantry_service_v1_service_proto_enumTypes[1]
 }
 
 func (x BucketOwnershipConflict_Reason) Number() protoreflect.EnumNumber {
@@ -108,11 +158,11 @@ func (x PlanWriteError_Reason) String() string {
 }
 
 func (PlanWriteError_Reason) Descriptor() protoreflect.EnumDescriptor {
-	return file_gantry_service_v1_service_proto_enumTypes[1].Descriptor()
+	return file_gantry_service_v1_service_proto_enumTypes[2].Descriptor()
 }
 
 func (PlanWriteError_Reason) Type() protoreflect.EnumType {
-	return &file_gantry_service_v1_service_proto_enumTypes[1]
+	return &file_gantry_service_v1_service_proto_enumTypes[2]
 }
 
 func (x PlanWriteError_Reason) Number() protoreflect.EnumNumber {
@@ -121,14 +171,75 @@ func (x PlanWriteError_Reason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlanWriteError_Reason.Descriptor instead.
 func (PlanWriteError_Reason) EnumDescriptor() ([]byte, []int) {
-	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{7, 0}
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type ObjectLookupError_Reason int32
+
+const (
+	ObjectLookupError_REASON_UNSPECIFIED       ObjectLookupError_Reason = 0
+	ObjectLookupError_REASON_BUCKET_NOT_FOUND  ObjectLookupError_Reason = 1
+	ObjectLookupError_REASON_OBJECT_NOT_FOUND  ObjectLookupError_Reason = 2
+	ObjectLookupError_REASON_VERSION_NOT_FOUND ObjectLookupError_Reason = 3
+	ObjectLookupError_REASON_DELETE_MARKER     ObjectLookupError_Reason = 4
+)
+
+// Enum value maps for ObjectLookupError_Reason.
+var (
+	ObjectLookupError_Reason_name = map[int32]string{
+		0: "REASON_UNSPECIFIED",
+		1: "REASON_BUCKET_NOT_FOUND",
+		2: "REASON_OBJECT_NOT_FOUND",
+		3: "REASON_VERSION_NOT_FOUND",
+		4: "REASON_DELETE_MARKER",
+	}
+	ObjectLookupError_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED":       0,
+		"REASON_BUCKET_NOT_FOUND":  1,
+		"REASON_OBJECT_NOT_FOUND":  2,
+		"REASON_VERSION_NOT_FOUND": 3,
+		"REASON_DELETE_MARKER":     4,
+	}
+)
+
+func (x ObjectLookupError_Reason) Enum() *ObjectLookupError_Reason {
+	p := new(ObjectLookupError_Reason)
+	*p = x
+	return p
+}
+
+func (x ObjectLookupError_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ObjectLookupError_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_gantry_service_v1_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ObjectLookupError_Reason) Type() protoreflect.EnumType {
+	return &file_gantry_service_v1_service_proto_enumTypes[3]
+}
+
+func (x ObjectLookupError_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ObjectLookupError_Reason.Descriptor instead.
+func (ObjectLookupError_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{16, 0}
 }
 
 type CreateBucketRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Canned ACL: private (the default), public-read, public-read-write or
+	// authenticated-read. Anything else fails with INVALID_ARGUMENT.
+	Acl string `protobuf:"bytes,2,opt,name=acl,proto3" json:"acl,omitempty"`
+	// Enable object lock, which also enables versioning. Neither can be
+	// turned off again.
+	ObjectLockEnabled bool `protobuf:"varint,3,opt,name=object_lock_enabled,json=objectLockEnabled,proto3" json:"object_lock_enabled,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CreateBucketRequest) Reset() {
@@ -168,6 +279,20 @@ func (x *CreateBucketRequest) GetName() string {
 	return ""
 }
 
+func (x *CreateBucketRequest) GetAcl() string {
+	if x != nil {
+		return x.Acl
+	}
+	return ""
+}
+
+func (x *CreateBucketRequest) GetObjectLockEnabled() bool {
+	if x != nil {
+		return x.ObjectLockEnabled
+	}
+	return false
+}
+
 type CreateBucketResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Bucket        *v1.Bucket             `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
@@ -344,29 +469,27 @@ func (x *ListBucketsResponse) GetBuckets() []*v1.Bucket {
 	return nil
 }
 
-type PlanWriteRequest struct {
+type GetBucketRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlanWriteRequest) Reset() {
-	*x = PlanWriteRequest{}
+func (x *GetBucketRequest) Reset() {
+	*x = GetBucketRequest{}
 	mi := &file_gantry_service_v1_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlanWriteRequest) String() string {
+func (x *GetBucketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlanWriteRequest) ProtoMessage() {}
+func (*GetBucketRequest) ProtoMessage() {}
 
-func (x *PlanWriteRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBucketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gantry_service_v1_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -378,53 +501,39 @@ func (x *PlanWriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlanWriteRequest.ProtoReflect.Descriptor instead.
-func (*PlanWriteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBucketRequest.ProtoReflect.Descriptor instead.
+func (*GetBucketRequest) Descriptor() ([]byte, []int) {
 	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PlanWriteRequest) GetBucket() string {
-	if x != nil {
-		return x.Bucket
-	}
-	return ""
-}
-
-func (x *PlanWriteRequest) GetKey() string {
+func (x *GetBucketRequest) GetName() string {
 	if x != nil {
-		return x.Key
+		return x.Name
 	}
 	return ""
 }
 
-func (x *PlanWriteRequest) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-type PlanWriteResponse struct {
+type GetBucketResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WritePlan     *v11.WritePlan         `protobuf:"bytes,1,opt,name=write_plan,json=writePlan,proto3" json:"write_plan,omitempty"`
+	Bucket        *v1.Bucket             `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlanWriteResponse) Reset() {
-	*x = PlanWriteResponse{}
+func (x *GetBucketResponse) Reset() {
+	*x = GetBucketResponse{}
 	mi := &file_gantry_service_v1_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlanWriteResponse) String() string {
+func (x *GetBucketResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlanWriteResponse) ProtoMessage() {}
+func (*GetBucketResponse) ProtoMessage() {}
 
-func (x *PlanWriteResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBucketResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gantry_service_v1_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -436,41 +545,48 @@ func (x *PlanWriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlanWriteResponse.ProtoReflect.Descriptor instead.
-func (*PlanWriteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBucketResponse.ProtoReflect.Descriptor instead.
+func (*GetBucketResponse) Descriptor() ([]byte, []int) {
 	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PlanWriteResponse) GetWritePlan() *v11.WritePlan {
+func (x *GetBucketResponse) GetBucket() *v1.Bucket {
 	if x != nil {
-		return x.WritePlan
+		return x.Bucket
 	}
 	return nil
 }
 
-// Error detail for failures in PlanWrite
-type PlanWriteError struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reason        PlanWriteError_Reason  `protobuf:"varint,1,opt,name=reason,proto3,enum=gantry.service.v1.PlanWriteError_Reason" json:"reason,omitempty"`
-	Bucket        string                 `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+// DeleteBucketRequest removes an empty bucket. A bucket holding COMMITTED
+// objects or in-flight uploads fails with FAILED_PRECONDITION. Even with
+// force, a bucket holding versions under an object lock fails with
+// PERMISSION_DENIED "AccessDenied".
+type DeleteBucketRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Admin-only: queue every object in the bucket for background deletion
+	// and remove the bucket even if it isn't empty. Only the access key user
+	// gantry seeds at startup may set it, on any bucket, and any other caller
+	// gets PERMISSION_DENIED. Flatbed never sets this.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlanWriteError) Reset() {
-	*x = PlanWriteError{}
+func (x *DeleteBucketRequest) Reset() {
+	*x = DeleteBucketRequest{}
 	mi := &file_gantry_service_v1_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlanWriteError) String() string {
+func (x *DeleteBucketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlanWriteError) ProtoMessage() {}
+func (*DeleteBucketRequest) ProtoMessage() {}
 
-func (x *PlanWriteError) ProtoReflect() protoreflect.Message {
+func (x *DeleteBucketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gantry_service_v1_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -482,52 +598,47 @@ func (x *PlanWriteError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlanWriteError.ProtoReflect.Descriptor instead.
-func (*PlanWriteError) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBucketRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBucketRequest) Descriptor() ([]byte, []int) {
 	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PlanWriteError) GetReason() PlanWriteError_Reason {
+func (x *DeleteBucketRequest) GetName() string {
 	if x != nil {
-		return x.Reason
+		return x.Name
 	}
-	return PlanWriteError_REASON_UNSPECIFIED
+	return ""
 }
 
-func (x *PlanWriteError) GetBucket() string {
+func (x *DeleteBucketRequest) GetForce() bool {
 	if x != nil {
-		return x.Bucket
+		return x.Force
 	}
-	return ""
+	return false
 }
 
-// CommitObjectRequest transitions an object from PENDING to COMMITTED state.
-type CommitObjectRequest struct {
+type DeleteBucketResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The object ID returned from PlanWrite (ULID format).
-	ObjectId string `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	// The actual number of bytes written to storage.
-	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	// Unix timestamp in milliseconds when the object was committed to storage.
-	LastModifiedMs int64 `protobuf:"varint,3,opt,name=last_modified_ms,json=lastModifiedMs,proto3" json:"last_modified_ms,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Number of blobs queued for deletion from their cradles.
+	ObjectsQueued int64 `protobuf:"varint,1,opt,name=objects_queued,json=objectsQueued,proto3" json:"objects_queued,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommitObjectRequest) Reset() {
-	*x = CommitObjectRequest{}
+func (x *DeleteBucketResponse) Reset() {
+	*x = DeleteBucketResponse{}
 	mi := &file_gantry_service_v1_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommitObjectRequest) String() string {
+func (x *DeleteBucketResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitObjectRequest) ProtoMessage() {}
+func (*DeleteBucketResponse) ProtoMessage() {}
 
-func (x *CommitObjectRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteBucketResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gantry_service_v1_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -539,55 +650,159 @@ func (x *CommitObjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitObjectRequest.ProtoReflect.Descriptor instead.
-func (*CommitObjectRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBucketResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBucketResponse) Descriptor() ([]byte, []int) {
 	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CommitObjectRequest) GetObjectId() string {
+func (x *DeleteBucketResponse) GetObjectsQueued() int64 {
 	if x != nil {
-		return x.ObjectId
+		return x.ObjectsQueued
+	}
+	return 0
+}
+
+type PlanWriteRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key    string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Size   int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Content-Type supplied on upload, replayed on GET and HEAD.
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Other headers supplied on upload, replayed on GET and HEAD.
+	Metadata *v11.ObjectMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Tags from x-amz-tagging, limited as in PutObjectTaggingRequest. Setting
+	// any also needs s3:PutObjectTagging.
+	Tags map[string]string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Object lock from x-amz-object-lock-mode and
+	// x-amz-object-lock-retain-until-date, set together as in
+	// PutObjectRetentionRequest, and from x-amz-object-lock-legal-hold. They
+	// need a bucket with object lock enabled, else INVALID_ARGUMENT
+	// "InvalidRequest", and also need s3:PutObjectRetention and
+	// s3:PutObjectLegalHold. Without a retention of its own the version gets
+	// the bucket's default retention, if any, when it is committed.
+	ObjectLockMode          string `protobuf:"bytes,7,opt,name=object_lock_mode,json=objectLockMode,proto3" json:"object_lock_mode,omitempty"`
+	ObjectLockRetainUntilMs int64  `protobuf:"varint,8,opt,name=object_lock_retain_until_ms,json=objectLockRetainUntilMs,proto3" json:"object_lock_retain_until_ms,omitempty"`
+	ObjectLockLegalHold     bool   `protobuf:"varint,9,opt,name=object_lock_legal_hold,json=objectLockLegalHold,proto3" json:"object_lock_legal_hold,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *PlanWriteRequest) Reset() {
+	*x = PlanWriteRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanWriteRequest) ProtoMessage() {}
+
+func (x *PlanWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanWriteRequest.ProtoReflect.Descriptor instead.
+func (*PlanWriteRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PlanWriteRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
 	}
 	return ""
 }
 
-func (x *CommitObjectRequest) GetSize() int64 {
+func (x *PlanWriteRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PlanWriteRequest) GetSize() int64 {
 	if x != nil {
 		return x.Size
 	}
 	return 0
 }
 
-func (x *CommitObjectRequest) GetLastModifiedMs() int64 {
+func (x *PlanWriteRequest) GetContentType() string {
 	if x != nil {
-		return x.LastModifiedMs
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *PlanWriteRequest) GetMetadata() *v11.ObjectMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PlanWriteRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PlanWriteRequest) GetObjectLockMode() string {
+	if x != nil {
+		return x.ObjectLockMode
+	}
+	return ""
+}
+
+func (x *PlanWriteRequest) GetObjectLockRetainUntilMs() int64 {
+	if x != nil {
+		return x.ObjectLockRetainUntilMs
 	}
 	return 0
 }
 
-// CommitObjectResponse indicates successful commit.
-// An empty response means the object was successfully committed.
-type CommitObjectResponse struct {
+func (x *PlanWriteRequest) GetObjectLockLegalHold() bool {
+	if x != nil {
+		return x.ObjectLockLegalHold
+	}
+	return false
+}
+
+type PlanWriteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	WritePlan     *v12.WritePlan         `protobuf:"bytes,1,opt,name=write_plan,json=writePlan,proto3" json:"write_plan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommitObjectResponse) Reset() {
-	*x = CommitObjectResponse{}
-	mi := &file_gantry_service_v1_service_proto_msgTypes[9]
+func (x *PlanWriteResponse) Reset() {
+	*x = PlanWriteResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommitObjectResponse) String() string {
+func (x *PlanWriteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitObjectResponse) ProtoMessage() {}
+func (*PlanWriteResponse) ProtoMessage() {}
 
-func (x *CommitObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gantry_service_v1_service_proto_msgTypes[9]
+func (x *PlanWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,18 +813,5646 @@ func (x *CommitObjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitObjectResponse.ProtoReflect.Descriptor instead.
-func (*CommitObjectResponse) Descriptor() ([]byte, []int) {
-	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use PlanWriteResponse.ProtoReflect.Descriptor instead.
+func (*PlanWriteResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{10}
 }
 
-var File_gantry_service_v1_service_proto protoreflect.FileDescriptor
+func (x *PlanWriteResponse) GetWritePlan() *v12.WritePlan {
+	if x != nil {
+		return x.WritePlan
+	}
+	return nil
+}
+
+// Error detail for failures in PlanWrite
+type PlanWriteError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        PlanWriteError_Reason  `protobuf:"varint,1,opt,name=reason,proto3,enum=gantry.service.v1.PlanWriteError_Reason" json:"reason,omitempty"`
+	Bucket        string                 `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanWriteError) Reset() {
+	*x = PlanWriteError{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanWriteError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanWriteError) ProtoMessage() {}
+
+func (x *PlanWriteError) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanWriteError.ProtoReflect.Descriptor instead.
+func (*PlanWriteError) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PlanWriteError) GetReason() PlanWriteError_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return PlanWriteError_REASON_UNSPECIFIED
+}
+
+func (x *PlanWriteError) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+// CommitObjectRequest transitions an object from PENDING to COMMITTED state.
+type CommitObjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The object ID returned from PlanWrite (ULID format).
+	ObjectId string `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	// The actual number of bytes written to storage.
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Unix timestamp in milliseconds when the object was committed to storage.
+	LastModifiedMs int64 `protobuf:"varint,3,opt,name=last_modified_ms,json=lastModifiedMs,proto3" json:"last_modified_ms,omitempty"`
+	// Commit only if the key has no COMMITTED version (PUT with
+	// If-None-Match: *). Otherwise the object is marked FAILED and the call
+	// fails with FAILED_PRECONDITION "PreconditionFailed". The object is also
+	// marked FAILED, failing with PERMISSION_DENIED "AccessDenied", if it
+	// would replace a version under an object lock.
+	IfNoneMatch bool `protobuf:"varint,4,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
+	// Hex-encoded MD5 of the object's bytes, served as its ETag.
+	Etag string `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Additional checksum the client asked for: the algorithm as S3 names it
+	// (CRC32, CRC32C, SHA1 or SHA256) and the base64 digest flatbed computed
+	// and checked while streaming. Both are empty when none was requested.
+	ChecksumAlgorithm string `protobuf:"bytes,6,opt,name=checksum_algorithm,json=checksumAlgorithm,proto3" json:"checksum_algorithm,omitempty"`
+	Checksum          string `protobuf:"bytes,7,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CommitObjectRequest) Reset() {
+	*x = CommitObjectRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitObjectRequest) ProtoMessage() {}
+
+func (x *CommitObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitObjectRequest.ProtoReflect.Descriptor instead.
+func (*CommitObjectRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CommitObjectRequest) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *CommitObjectRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CommitObjectRequest) GetLastModifiedMs() int64 {
+	if x != nil {
+		return x.LastModifiedMs
+	}
+	return 0
+}
+
+func (x *CommitObjectRequest) GetIfNoneMatch() bool {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return false
+}
+
+func (x *CommitObjectRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *CommitObjectRequest) GetChecksumAlgorithm() string {
+	if x != nil {
+		return x.ChecksumAlgorithm
+	}
+	return ""
+}
+
+func (x *CommitObjectRequest) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+// CommitObjectResponse indicates successful commit. In a versioned bucket
+// the version the object replaced is kept as a noncurrent version.
+type CommitObjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The committed version's ID: the object ID while versioning is enabled,
+	// "null" while it is suspended, and empty if it was never enabled.
+	VersionId     string `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitObjectResponse) Reset() {
+	*x = CommitObjectResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitObjectResponse) ProtoMessage() {}
+
+func (x *CommitObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitObjectResponse.ProtoReflect.Descriptor instead.
+func (*CommitObjectResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CommitObjectResponse) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+// LookupObjectRequest resolves the COMMITTED version of an object to the
+// cradle server holding its data.
+type LookupObjectRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key    string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Resolve this version, current or not, instead; "null" names the null
+	// version. A missing version fails with REASON_VERSION_NOT_FOUND and a
+	// delete marker with REASON_DELETE_MARKER.
+	VersionId     string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupObjectRequest) Reset() {
+	*x = LookupObjectRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupObjectRequest) ProtoMessage() {}
+
+func (x *LookupObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupObjectRequest.ProtoReflect.Descriptor instead.
+func (*LookupObjectRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LookupObjectRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *LookupObjectRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LookupObjectRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+type LookupObjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Object        *v11.Object            `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupObjectResponse) Reset() {
+	*x = LookupObjectResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupObjectResponse) ProtoMessage() {}
+
+func (x *LookupObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupObjectResponse.ProtoReflect.Descriptor instead.
+func (*LookupObjectResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LookupObjectResponse) GetObject() *v11.Object {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+// Error detail for failures in object lookups
+type ObjectLookupError struct {
+	state  protoimpl.MessageState   `protogen:"open.v1"`
+	Reason ObjectLookupError_Reason `protobuf:"varint,1,opt,name=reason,proto3,enum=gantry.service.v1.ObjectLookupError_Reason" json:"reason,omitempty"`
+	Bucket string                   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key    string                   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// The version asked for, with REASON_VERSION_NOT_FOUND and
+	// REASON_DELETE_MARKER.
+	VersionId     string `protobuf:"bytes,4,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectLookupError) Reset() {
+	*x = ObjectLookupError{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectLookupError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectLookupError) ProtoMessage() {}
+
+func (x *ObjectLookupError) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectLookupError.ProtoReflect.Descriptor instead.
+func (*ObjectLookupError) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ObjectLookupError) GetReason() ObjectLookupError_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return ObjectLookupError_REASON_UNSPECIFIED
+}
+
+func (x *ObjectLookupError) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ObjectLookupError) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ObjectLookupError) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+// DeleteObjectRequest retires the COMMITTED version of an object. The blob is
+// removed from its cradle later by the cleanup worker. In a versioned bucket
+// the object is kept as a noncurrent version and a delete marker becomes the
+// current version instead.
+type DeleteObjectRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key    string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Permanently delete this version, which may be a delete marker; "null"
+	// names the null version. If it was the current version, the newest
+	// remaining version becomes current. A version under a legal hold or an
+	// unexpired retention fails with PERMISSION_DENIED "AccessDenied".
+	VersionId string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Delete a version under a GOVERNANCE retention anyway, which needs
+	// s3:BypassGovernanceRetention. COMPLIANCE retentions and legal holds
+	// can't be bypassed.
+	BypassGovernanceRetention bool `protobuf:"varint,4,opt,name=bypass_governance_retention,json=bypassGovernanceRetention,proto3" json:"bypass_governance_retention,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *DeleteObjectRequest) Reset() {
+	*x = DeleteObjectRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteObjectRequest) ProtoMessage() {}
+
+func (x *DeleteObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteObjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteObjectRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteObjectRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *DeleteObjectRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DeleteObjectRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *DeleteObjectRequest) GetBypassGovernanceRetention() bool {
+	if x != nil {
+		return x.BypassGovernanceRetention
+	}
+	return false
+}
+
+// DeleteObjectResponse indicates the key no longer has a live version.
+// Deleting a key or version that doesn't exist also succeeds, matching S3.
+type DeleteObjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The delete marker created or the version deleted. Empty if the bucket
+	// was never versioned.
+	VersionId string `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Whether that version is a delete marker.
+	DeleteMarker  bool `protobuf:"varint,2,opt,name=delete_marker,json=deleteMarker,proto3" json:"delete_marker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteObjectResponse) Reset() {
+	*x = DeleteObjectResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteObjectResponse) ProtoMessage() {}
+
+func (x *DeleteObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteObjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteObjectResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteObjectResponse) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *DeleteObjectResponse) GetDeleteMarker() bool {
+	if x != nil {
+		return x.DeleteMarker
+	}
+	return false
+}
+
+// DeleteObjectsRequest deletes up to 1000 keys or versions of one bucket in
+// a single transaction, each as DeleteObjectRequest would. A missing bucket
+// fails the whole request; a key the caller may not delete, or that is not a
+// valid key, is reported in DeleteObjectsResponse.errors and the rest are
+// still deleted. So is a version kept by an object lock, reported as
+// "AccessDenied".
+type DeleteObjectsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Bucket  string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Objects []*ObjectIdentifier    `protobuf:"bytes,2,rep,name=objects,proto3" json:"objects,omitempty"`
+	// As in DeleteObjectRequest, for every version named.
+	BypassGovernanceRetention bool `protobuf:"varint,3,opt,name=bypass_governance_retention,json=bypassGovernanceRetention,proto3" json:"bypass_governance_retention,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *DeleteObjectsRequest) Reset() {
+	*x = DeleteObjectsRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteObjectsRequest) ProtoMessage() {}
+
+func (x *DeleteObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteObjectsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteObjectsRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *DeleteObjectsRequest) GetObjects() []*ObjectIdentifier {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *DeleteObjectsRequest) GetBypassGovernanceRetention() bool {
+	if x != nil {
+		return x.BypassGovernanceRetention
+	}
+	return false
+}
+
+type ObjectIdentifier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectIdentifier) Reset() {
+	*x = ObjectIdentifier{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectIdentifier) ProtoMessage() {}
+
+func (x *ObjectIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectIdentifier.ProtoReflect.Descriptor instead.
+func (*ObjectIdentifier) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ObjectIdentifier) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ObjectIdentifier) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+// DeleteObjectsResponse reports each object of the request either in deleted
+// or in errors, both in request order.
+type DeleteObjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       []*DeletedObject       `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Errors        []*DeleteObjectError   `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteObjectsResponse) Reset() {
+	*x = DeleteObjectsResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteObjectsResponse) ProtoMessage() {}
+
+func (x *DeleteObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteObjectsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteObjectsResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteObjectsResponse) GetDeleted() []*DeletedObject {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+func (x *DeleteObjectsResponse) GetErrors() []*DeleteObjectError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type DeletedObject struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The version_id the request named, if any.
+	VersionId string `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Whether a delete marker was created or deleted, and its version ID.
+	DeleteMarker          bool   `protobuf:"varint,3,opt,name=delete_marker,json=deleteMarker,proto3" json:"delete_marker,omitempty"`
+	DeleteMarkerVersionId string `protobuf:"bytes,4,opt,name=delete_marker_version_id,json=deleteMarkerVersionId,proto3" json:"delete_marker_version_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DeletedObject) Reset() {
+	*x = DeletedObject{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletedObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedObject) ProtoMessage() {}
+
+func (x *DeletedObject) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedObject.ProtoReflect.Descriptor instead.
+func (*DeletedObject) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeletedObject) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DeletedObject) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *DeletedObject) GetDeleteMarker() bool {
+	if x != nil {
+		return x.DeleteMarker
+	}
+	return false
+}
+
+func (x *DeletedObject) GetDeleteMarkerVersionId() string {
+	if x != nil {
+		return x.DeleteMarkerVersionId
+	}
+	return ""
+}
+
+type DeleteObjectError struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Key       string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	VersionId string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// The S3 error code, such as "AccessDenied".
+	Code          string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteObjectError) Reset() {
+	*x = DeleteObjectError{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteObjectError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteObjectError) ProtoMessage() {}
+
+func (x *DeleteObjectError) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteObjectError.ProtoReflect.Descriptor instead.
+func (*DeleteObjectError) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteObjectError) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DeleteObjectError) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *DeleteObjectError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// ListObjectsRequest pages through the COMMITTED objects of a bucket in key
+// order, following the S3 ListObjectsV2 semantics.
+type ListObjectsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Only keys beginning with prefix are listed.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Keys containing delimiter after the prefix are rolled up into a single
+	// common prefix ending at the first delimiter.
+	Delimiter string `protobuf:"bytes,3,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	// Maximum number of keys plus common prefixes to return. Unset means 1000;
+	// larger values are capped at 1000.
+	MaxKeys *int32 `protobuf:"varint,4,opt,name=max_keys,json=maxKeys,proto3,oneof" json:"max_keys,omitempty"`
+	// List keys lexically after this key. Ignored when continuation_token is set.
+	StartAfter string `protobuf:"bytes,5,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
+	// Opaque token from a previous truncated response.
+	ContinuationToken string `protobuf:"bytes,6,opt,name=continuation_token,json=continuationToken,proto3" json:"continuation_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListObjectsRequest) Reset() {
+	*x = ListObjectsRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectsRequest) ProtoMessage() {}
+
+func (x *ListObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListObjectsRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetMaxKeys() int32 {
+	if x != nil && x.MaxKeys != nil {
+		return *x.MaxKeys
+	}
+	return 0
+}
+
+func (x *ListObjectsRequest) GetStartAfter() string {
+	if x != nil {
+		return x.StartAfter
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetContinuationToken() string {
+	if x != nil {
+		return x.ContinuationToken
+	}
+	return ""
+}
+
+type ListObjectsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Objects        []*v11.Object          `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	CommonPrefixes []string               `protobuf:"bytes,2,rep,name=common_prefixes,json=commonPrefixes,proto3" json:"common_prefixes,omitempty"`
+	IsTruncated    bool                   `protobuf:"varint,3,opt,name=is_truncated,json=isTruncated,proto3" json:"is_truncated,omitempty"`
+	// Set when is_truncated is true; pass it back to fetch the next page.
+	NextContinuationToken string `protobuf:"bytes,4,opt,name=next_continuation_token,json=nextContinuationToken,proto3" json:"next_continuation_token,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ListObjectsResponse) Reset() {
+	*x = ListObjectsResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectsResponse) ProtoMessage() {}
+
+func (x *ListObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListObjectsResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListObjectsResponse) GetObjects() []*v11.Object {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *ListObjectsResponse) GetCommonPrefixes() []string {
+	if x != nil {
+		return x.CommonPrefixes
+	}
+	return nil
+}
+
+func (x *ListObjectsResponse) GetIsTruncated() bool {
+	if x != nil {
+		return x.IsTruncated
+	}
+	return false
+}
+
+func (x *ListObjectsResponse) GetNextContinuationToken() string {
+	if x != nil {
+		return x.NextContinuationToken
+	}
+	return ""
+}
+
+// CreateMultipartUploadRequest starts an upload whose parts are written
+// separately and assembled by CompleteMultipartUpload.
+type CreateMultipartUploadRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key    string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Content-Type supplied on initiation, replayed on GET and HEAD once the
+	// upload completes.
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Other headers supplied on initiation, limited and replayed as in
+	// PlanWriteRequest once the upload completes.
+	Metadata      *v11.ObjectMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMultipartUploadRequest) Reset() {
+	*x = CreateMultipartUploadRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMultipartUploadRequest) ProtoMessage() {}
+
+func (x *CreateMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*CreateMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateMultipartUploadRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *CreateMultipartUploadRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateMultipartUploadRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreateMultipartUploadRequest) GetMetadata() *v11.ObjectMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type CreateMultipartUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMultipartUploadResponse) Reset() {
+	*x = CreateMultipartUploadResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMultipartUploadResponse) ProtoMessage() {}
+
+func (x *CreateMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*CreateMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateMultipartUploadResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+// PlanPartRequest reserves a blob on a cradle for one part of an upload.
+// Uploading the same part number again replaces the earlier part once the
+// new one is committed.
+type PlanPartRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Bucket   string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key      string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	UploadId string                 `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// 1 through 10000.
+	PartNumber    int32 `protobuf:"varint,4,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	Size          int64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanPartRequest) Reset() {
+	*x = PlanPartRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPartRequest) ProtoMessage() {}
+
+func (x *PlanPartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPartRequest.ProtoReflect.Descriptor instead.
+func (*PlanPartRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PlanPartRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *PlanPartRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PlanPartRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *PlanPartRequest) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *PlanPartRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type PlanPartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// write_plan.object_id is the part's blob ID.
+	WritePlan     *v12.WritePlan `protobuf:"bytes,1,opt,name=write_plan,json=writePlan,proto3" json:"write_plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanPartResponse) Reset() {
+	*x = PlanPartResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPartResponse) ProtoMessage() {}
+
+func (x *PlanPartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPartResponse.ProtoReflect.Descriptor instead.
+func (*PlanPartResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PlanPartResponse) GetWritePlan() *v12.WritePlan {
+	if x != nil {
+		return x.WritePlan
+	}
+	return nil
+}
+
+// CommitPartRequest records that a planned part blob was written.
+type CommitPartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The blob ID returned from PlanPart.
+	BlobId string `protobuf:"bytes,1,opt,name=blob_id,json=blobId,proto3" json:"blob_id,omitempty"`
+	// The actual number of bytes written to storage.
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Unix timestamp in milliseconds when the part was written.
+	LastModifiedMs int64 `protobuf:"varint,3,opt,name=last_modified_ms,json=lastModifiedMs,proto3" json:"last_modified_ms,omitempty"`
+	// Hex-encoded MD5 of the part's bytes, served as its ETag.
+	Etag          string `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitPartRequest) Reset() {
+	*x = CommitPartRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitPartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitPartRequest) ProtoMessage() {}
+
+func (x *CommitPartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitPartRequest.ProtoReflect.Descriptor instead.
+func (*CommitPartRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CommitPartRequest) GetBlobId() string {
+	if x != nil {
+		return x.BlobId
+	}
+	return ""
+}
+
+func (x *CommitPartRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CommitPartRequest) GetLastModifiedMs() int64 {
+	if x != nil {
+		return x.LastModifiedMs
+	}
+	return 0
+}
+
+func (x *CommitPartRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type CommitPartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The part's ETag, without quotes.
+	Etag          string `protobuf:"bytes,1,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitPartResponse) Reset() {
+	*x = CommitPartResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitPartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitPartResponse) ProtoMessage() {}
+
+func (x *CommitPartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitPartResponse.ProtoReflect.Descriptor instead.
+func (*CommitPartResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CommitPartResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type CompletedPart struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PartNumber int32                  `protobuf:"varint,1,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	// ETag returned for the part by UploadPart. Surrounding quotes are ignored.
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompletedPart) Reset() {
+	*x = CompletedPart{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletedPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletedPart) ProtoMessage() {}
+
+func (x *CompletedPart) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletedPart.ProtoReflect.Descriptor instead.
+func (*CompletedPart) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CompletedPart) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *CompletedPart) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// CompleteMultipartUploadRequest assembles the listed parts into the
+// COMMITTED version of the key, replacing any previous version. Uploaded
+// parts that aren't listed are queued for deletion.
+type CompleteMultipartUploadRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Bucket   string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key      string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	UploadId string                 `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// Parts in ascending part-number order.
+	Parts         []*CompletedPart `protobuf:"bytes,4,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteMultipartUploadRequest) Reset() {
+	*x = CompleteMultipartUploadRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMultipartUploadRequest) ProtoMessage() {}
+
+func (x *CompleteMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompleteMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CompleteMultipartUploadRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *CompleteMultipartUploadRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CompleteMultipartUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *CompleteMultipartUploadRequest) GetParts() []*CompletedPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+type CompleteMultipartUploadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The object's "-N" ETag, without quotes.
+	Etag string `protobuf:"bytes,1,opt,name=etag,proto3" json:"etag,omitempty"`
+	// The object's version ID, as in CommitObjectResponse.
+	VersionId     string `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteMultipartUploadResponse) Reset() {
+	*x = CompleteMultipartUploadResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMultipartUploadResponse) ProtoMessage() {}
+
+func (x *CompleteMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*CompleteMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CompleteMultipartUploadResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *CompleteMultipartUploadResponse) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+// AbortMultipartUploadRequest discards an upload. Its part blobs are removed
+// from their cradles by the cleanup worker.
+type AbortMultipartUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	UploadId      string                 `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortMultipartUploadRequest) Reset() {
+	*x = AbortMultipartUploadRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortMultipartUploadRequest) ProtoMessage() {}
+
+func (x *AbortMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*AbortMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AbortMultipartUploadRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *AbortMultipartUploadRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AbortMultipartUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+type AbortMultipartUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortMultipartUploadResponse) Reset() {
+	*x = AbortMultipartUploadResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortMultipartUploadResponse) ProtoMessage() {}
+
+func (x *AbortMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*AbortMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{36}
+}
+
+// ListMultipartUploadsRequest pages through the IN_PROGRESS uploads of a
+// bucket ordered by key and then by initiation time, following the S3
+// ListMultipartUploads semantics.
+type ListMultipartUploadsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Only uploads of keys beginning with prefix are listed.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Keys containing delimiter after the prefix are rolled up into a single
+	// common prefix ending at the first delimiter.
+	Delimiter string `protobuf:"bytes,3,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	// Maximum number of uploads plus common prefixes to return. Unset means
+	// 1000; larger values are capped at 1000.
+	MaxUploads *int32 `protobuf:"varint,4,opt,name=max_uploads,json=maxUploads,proto3,oneof" json:"max_uploads,omitempty"`
+	// List uploads of keys lexically after this key. With upload_id_marker,
+	// uploads of key_marker itself initiated after that upload are included.
+	KeyMarker      string `protobuf:"bytes,5,opt,name=key_marker,json=keyMarker,proto3" json:"key_marker,omitempty"`
+	UploadIdMarker string `protobuf:"bytes,6,opt,name=upload_id_marker,json=uploadIdMarker,proto3" json:"upload_id_marker,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListMultipartUploadsRequest) Reset() {
+	*x = ListMultipartUploadsRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMultipartUploadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMultipartUploadsRequest) ProtoMessage() {}
+
+func (x *ListMultipartUploadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMultipartUploadsRequest.ProtoReflect.Descriptor instead.
+func (*ListMultipartUploadsRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListMultipartUploadsRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ListMultipartUploadsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ListMultipartUploadsRequest) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *ListMultipartUploadsRequest) GetMaxUploads() int32 {
+	if x != nil && x.MaxUploads != nil {
+		return *x.MaxUploads
+	}
+	return 0
+}
+
+func (x *ListMultipartUploadsRequest) GetKeyMarker() string {
+	if x != nil {
+		return x.KeyMarker
+	}
+	return ""
+}
+
+func (x *ListMultipartUploadsRequest) GetUploadIdMarker() string {
+	if x != nil {
+		return x.UploadIdMarker
+	}
+	return ""
+}
+
+type MultipartUpload struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Key      string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	UploadId string                 `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// Unix timestamp in milliseconds when the upload was created.
+	InitiatedMs   int64 `protobuf:"varint,3,opt,name=initiated_ms,json=initiatedMs,proto3" json:"initiated_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipartUpload) Reset() {
+	*x = MultipartUpload{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipartUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipartUpload) ProtoMessage() {}
+
+func (x *MultipartUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipartUpload.ProtoReflect.Descriptor instead.
+func (*MultipartUpload) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MultipartUpload) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MultipartUpload) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *MultipartUpload) GetInitiatedMs() int64 {
+	if x != nil {
+		return x.InitiatedMs
+	}
+	return 0
+}
+
+type ListMultipartUploadsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uploads        []*MultipartUpload     `protobuf:"bytes,1,rep,name=uploads,proto3" json:"uploads,omitempty"`
+	CommonPrefixes []string               `protobuf:"bytes,2,rep,name=common_prefixes,json=commonPrefixes,proto3" json:"common_prefixes,omitempty"`
+	IsTruncated    bool                   `protobuf:"varint,3,opt,name=is_truncated,json=isTruncated,proto3" json:"is_truncated,omitempty"`
+	// Set when is_truncated is true; pass them back as key_marker and
+	// upload_id_marker to fetch the next page.
+	NextKeyMarker      string `protobuf:"bytes,4,opt,name=next_key_marker,json=nextKeyMarker,proto3" json:"next_key_marker,omitempty"`
+	NextUploadIdMarker string `protobuf:"bytes,5,opt,name=next_upload_id_marker,json=nextUploadIdMarker,proto3" json:"next_upload_id_marker,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListMultipartUploadsResponse) Reset() {
+	*x = ListMultipartUploadsResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMultipartUploadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMultipartUploadsResponse) ProtoMessage() {}
+
+func (x *ListMultipartUploadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMultipartUploadsResponse.ProtoReflect.Descriptor instead.
+func (*ListMultipartUploadsResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListMultipartUploadsResponse) GetUploads() []*MultipartUpload {
+	if x != nil {
+		return x.Uploads
+	}
+	return nil
+}
+
+func (x *ListMultipartUploadsResponse) GetCommonPrefixes() []string {
+	if x != nil {
+		return x.CommonPrefixes
+	}
+	return nil
+}
+
+func (x *ListMultipartUploadsResponse) GetIsTruncated() bool {
+	if x != nil {
+		return x.IsTruncated
+	}
+	return false
+}
+
+func (x *ListMultipartUploadsResponse) GetNextKeyMarker() string {
+	if x != nil {
+		return x.NextKeyMarker
+	}
+	return ""
+}
+
+func (x *ListMultipartUploadsResponse) GetNextUploadIdMarker() string {
+	if x != nil {
+		return x.NextUploadIdMarker
+	}
+	return ""
+}
+
+// ListPartsRequest pages through the uploaded parts of an IN_PROGRESS upload
+// in part-number order.
+type ListPartsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Bucket   string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key      string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	UploadId string                 `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// List parts numbered after this one.
+	PartNumberMarker int32 `protobuf:"varint,4,opt,name=part_number_marker,json=partNumberMarker,proto3" json:"part_number_marker,omitempty"`
+	// Unset means 1000; larger values are capped at 1000.
+	MaxParts      *int32 `protobuf:"varint,5,opt,name=max_parts,json=maxParts,proto3,oneof" json:"max_parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsRequest) Reset() {
+	*x = ListPartsRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsRequest) ProtoMessage() {}
+
+func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
+func (*ListPartsRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListPartsRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetPartNumberMarker() int32 {
+	if x != nil {
+		return x.PartNumberMarker
+	}
+	return 0
+}
+
+func (x *ListPartsRequest) GetMaxParts() int32 {
+	if x != nil && x.MaxParts != nil {
+		return *x.MaxParts
+	}
+	return 0
+}
+
+type UploadedPart struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PartNumber int32                  `protobuf:"varint,1,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	// The part's ETag, without quotes.
+	Etag           string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	Size           int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	LastModifiedMs int64  `protobuf:"varint,4,opt,name=last_modified_ms,json=lastModifiedMs,proto3" json:"last_modified_ms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UploadedPart) Reset() {
+	*x = UploadedPart{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadedPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadedPart) ProtoMessage() {}
+
+func (x *UploadedPart) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadedPart.ProtoReflect.Descriptor instead.
+func (*UploadedPart) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UploadedPart) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *UploadedPart) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UploadedPart) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadedPart) GetLastModifiedMs() int64 {
+	if x != nil {
+		return x.LastModifiedMs
+	}
+	return 0
+}
+
+type ListPartsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Parts       []*UploadedPart        `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	IsTruncated bool                   `protobuf:"varint,2,opt,name=is_truncated,json=isTruncated,proto3" json:"is_truncated,omitempty"`
+	// Set when is_truncated is true; pass it back as part_number_marker.
+	NextPartNumberMarker int32 `protobuf:"varint,3,opt,name=next_part_number_marker,json=nextPartNumberMarker,proto3" json:"next_part_number_marker,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListPartsResponse) Reset() {
+	*x = ListPartsResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsResponse) ProtoMessage() {}
+
+func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartsResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListPartsResponse) GetParts() []*UploadedPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *ListPartsResponse) GetIsTruncated() bool {
+	if x != nil {
+		return x.IsTruncated
+	}
+	return false
+}
+
+func (x *ListPartsResponse) GetNextPartNumberMarker() int32 {
+	if x != nil {
+		return x.NextPartNumberMarker
+	}
+	return 0
+}
+
+// GetAccessKeyRequest fetches the secret for an access key so flatbed can
+// verify a SigV4 signature. An unknown or inactive key returns NotFound.
+// Flatbed may cache the response briefly, so disabling a key takes effect
+// once its cache entry expires.
+type GetAccessKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessKeyId   string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessKeyRequest) Reset() {
+	*x = GetAccessKeyRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessKeyRequest) ProtoMessage() {}
+
+func (x *GetAccessKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetAccessKeyRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetAccessKeyRequest) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+type GetAccessKeyResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccessKeyId     string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	SecretAccessKey string                 `protobuf:"bytes,2,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	// The user the key belongs to. Flatbed sends user_id back as the caller
+	// of the requests the key signs.
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessKeyResponse) Reset() {
+	*x = GetAccessKeyResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessKeyResponse) ProtoMessage() {}
+
+func (x *GetAccessKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetAccessKeyResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetAccessKeyResponse) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+func (x *GetAccessKeyResponse) GetSecretAccessKey() string {
+	if x != nil {
+		return x.SecretAccessKey
+	}
+	return ""
+}
+
+func (x *GetAccessKeyResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAccessKeyResponse) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type User struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Unix timestamp in milliseconds when the user was created.
+	CreatedMs     int64 `protobuf:"varint,3,opt,name=created_ms,json=createdMs,proto3" json:"created_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetCreatedMs() int64 {
+	if x != nil {
+		return x.CreatedMs
+	}
+	return 0
+}
+
+// AccessKey describes a key without its secret, which is only returned when
+// the key is created or rotated.
+type AccessKey struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccessKeyId string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName    string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Status      AccessKeyStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=gantry.service.v1.AccessKeyStatus" json:"status,omitempty"`
+	// Unix timestamp in milliseconds when the key was created.
+	CreatedMs     int64 `protobuf:"varint,5,opt,name=created_ms,json=createdMs,proto3" json:"created_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessKey) Reset() {
+	*x = AccessKey{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessKey) ProtoMessage() {}
+
+func (x *AccessKey) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessKey.ProtoReflect.Descriptor instead.
+func (*AccessKey) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AccessKey) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+func (x *AccessKey) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AccessKey) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *AccessKey) GetStatus() AccessKeyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AccessKeyStatus_ACCESS_KEY_STATUS_UNSPECIFIED
+}
+
+func (x *AccessKey) GetCreatedMs() int64 {
+	if x != nil {
+		return x.CreatedMs
+	}
+	return 0
+}
+
+// CreateUserRequest adds a user. Names are 1 to 64 characters of letters,
+// digits and +=,.@_- and must be unique; a taken name fails with
+// ALREADY_EXISTS "EntityAlreadyExists".
+type CreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{49}
+}
+
+type ListUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by name.
+	Users         []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// CreateAccessKeyRequest generates a new active key for an existing user.
+// An unknown user fails with NOT_FOUND "NoSuchEntity".
+type CreateAccessKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserName      string                 `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessKeyRequest) Reset() {
+	*x = CreateAccessKeyRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessKeyRequest) ProtoMessage() {}
+
+func (x *CreateAccessKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccessKeyRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateAccessKeyRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type CreateAccessKeyResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccessKey *AccessKey             `protobuf:"bytes,1,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	// Only ever returned here and by RotateAccessKey; gantry stores it
+	// encrypted.
+	SecretAccessKey string `protobuf:"bytes,2,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateAccessKeyResponse) Reset() {
+	*x = CreateAccessKeyResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessKeyResponse) ProtoMessage() {}
+
+func (x *CreateAccessKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccessKeyResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateAccessKeyResponse) GetAccessKey() *AccessKey {
+	if x != nil {
+		return x.AccessKey
+	}
+	return nil
+}
+
+func (x *CreateAccessKeyResponse) GetSecretAccessKey() string {
+	if x != nil {
+		return x.SecretAccessKey
+	}
+	return ""
+}
+
+type ListAccessKeysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only keys of this user; empty lists every user's keys.
+	UserName      string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessKeysRequest) Reset() {
+	*x = ListAccessKeysRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessKeysRequest) ProtoMessage() {}
+
+func (x *ListAccessKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessKeysRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListAccessKeysRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type ListAccessKeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by user name and then creation time.
+	AccessKeys    []*AccessKey `protobuf:"bytes,1,rep,name=access_keys,json=accessKeys,proto3" json:"access_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessKeysResponse) Reset() {
+	*x = ListAccessKeysResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessKeysResponse) ProtoMessage() {}
+
+func (x *ListAccessKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessKeysResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListAccessKeysResponse) GetAccessKeys() []*AccessKey {
+	if x != nil {
+		return x.AccessKeys
+	}
+	return nil
+}
+
+// UpdateAccessKeyRequest disables a key or enables it again.
+type UpdateAccessKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessKeyId   string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	Status        AccessKeyStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=gantry.service.v1.AccessKeyStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccessKeyRequest) Reset() {
+	*x = UpdateAccessKeyRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccessKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccessKeyRequest) ProtoMessage() {}
+
+func (x *UpdateAccessKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccessKeyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccessKeyRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateAccessKeyRequest) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+func (x *UpdateAccessKeyRequest) GetStatus() AccessKeyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AccessKeyStatus_ACCESS_KEY_STATUS_UNSPECIFIED
+}
+
+type UpdateAccessKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessKey     *AccessKey             `protobuf:"bytes,1,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccessKeyResponse) Reset() {
+	*x = UpdateAccessKeyResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccessKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccessKeyResponse) ProtoMessage() {}
+
+func (x *UpdateAccessKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccessKeyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccessKeyResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateAccessKeyResponse) GetAccessKey() *AccessKey {
+	if x != nil {
+		return x.AccessKey
+	}
+	return nil
+}
+
+// RotateAccessKeyRequest issues a new key for the same user and disables
+// the old one in a single step.
+type RotateAccessKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessKeyId   string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateAccessKeyRequest) Reset() {
+	*x = RotateAccessKeyRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAccessKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAccessKeyRequest) ProtoMessage() {}
+
+func (x *RotateAccessKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAccessKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateAccessKeyRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RotateAccessKeyRequest) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+type RotateAccessKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new key; the old one is now inactive.
+	AccessKey       *AccessKey `protobuf:"bytes,1,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	SecretAccessKey string     `protobuf:"bytes,2,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RotateAccessKeyResponse) Reset() {
+	*x = RotateAccessKeyResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAccessKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAccessKeyResponse) ProtoMessage() {}
+
+func (x *RotateAccessKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAccessKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateAccessKeyResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RotateAccessKeyResponse) GetAccessKey() *AccessKey {
+	if x != nil {
+		return x.AccessKey
+	}
+	return nil
+}
+
+func (x *RotateAccessKeyResponse) GetSecretAccessKey() string {
+	if x != nil {
+		return x.SecretAccessKey
+	}
+	return ""
+}
+
+// PutBucketPolicyRequest replaces the bucket policy. It must be an IAM
+// policy document of at most 20 KB using Allow/Deny statements with the
+// s3:GetObject, s3:PutObject, s3:ListBucket, s3:DeleteObject,
+// s3:AbortMultipartUpload, s3:ListBucketMultipartUploads,
+// s3:ListMultipartUploadParts, s3:GetObjectVersion, s3:DeleteObjectVersion
+// and s3:ListBucketVersions actions (wildcards allowed), resources in this
+// bucket, and principals "*" or arn:aws:iam:::user/<name>. Anything else
+// fails with INVALID_ARGUMENT "MalformedPolicy".
+type PutBucketPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Policy        string                 `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutBucketPolicyRequest) Reset() {
+	*x = PutBucketPolicyRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutBucketPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutBucketPolicyRequest) ProtoMessage() {}
+
+func (x *PutBucketPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutBucketPolicyRequest.ProtoReflect.Descriptor instead.
+func (*PutBucketPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PutBucketPolicyRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *PutBucketPolicyRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+type PutBucketPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutBucketPolicyResponse) Reset() {
+	*x = PutBucketPolicyResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutBucketPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutBucketPolicyResponse) ProtoMessage() {}
+
+func (x *PutBucketPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutBucketPolicyResponse.ProtoReflect.Descriptor instead.
+func (*PutBucketPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{60}
+}
+
+// GetBucketPolicyRequest returns the policy as it was put. A bucket without
+// one fails with NOT_FOUND "NoSuchBucketPolicy".
+type GetBucketPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketPolicyRequest) Reset() {
+	*x = GetBucketPolicyRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketPolicyRequest) ProtoMessage() {}
+
+func (x *GetBucketPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetBucketPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetBucketPolicyRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type GetBucketPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        string                 `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketPolicyResponse) Reset() {
+	*x = GetBucketPolicyResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketPolicyResponse) ProtoMessage() {}
+
+func (x *GetBucketPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetBucketPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetBucketPolicyResponse) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+type DeleteBucketPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBucketPolicyRequest) Reset() {
+	*x = DeleteBucketPolicyRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBucketPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBucketPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteBucketPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBucketPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBucketPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteBucketPolicyRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type DeleteBucketPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBucketPolicyResponse) Reset() {
+	*x = DeleteBucketPolicyResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBucketPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBucketPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteBucketPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBucketPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBucketPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{64}
+}
+
+type PutBucketAclRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Canned ACL, as in CreateBucketRequest.
+	Acl           string `protobuf:"bytes,2,opt,name=acl,proto3" json:"acl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutBucketAclRequest) Reset() {
+	*x = PutBucketAclRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutBucketAclRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutBucketAclRequest) ProtoMessage() {}
+
+func (x *PutBucketAclRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutBucketAclRequest.ProtoReflect.Descriptor instead.
+func (*PutBucketAclRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PutBucketAclRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *PutBucketAclRequest) GetAcl() string {
+	if x != nil {
+		return x.Acl
+	}
+	return ""
+}
+
+type PutBucketAclResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutBucketAclResponse) Reset() {
+	*x = PutBucketAclResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutBucketAclResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutBucketAclResponse) ProtoMessage() {}
+
+func (x *PutBucketAclResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutBucketAclResponse.ProtoReflect.Descriptor instead.
+func (*PutBucketAclResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{66}
+}
+
+type GetBucketAclRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketAclRequest) Reset() {
+	*x = GetBucketAclRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketAclRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketAclRequest) ProtoMessage() {}
+
+func (x *GetBucketAclRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketAclRequest.ProtoReflect.Descriptor instead.
+func (*GetBucketAclRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetBucketAclRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type GetBucketAclResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty for a bucket without an owner.
+	OwnerId       string `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	OwnerName     string `protobuf:"bytes,2,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	Acl           string `protobuf:"bytes,3,opt,name=acl,proto3" json:"acl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketAclResponse) Reset() {
+	*x = GetBucketAclResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketAclResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketAclResponse) ProtoMessage() {}
+
+func (x *GetBucketAclResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketAclResponse.ProtoReflect.Descriptor instead.
+func (*GetBucketAclResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetBucketAclResponse) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GetBucketAclResponse) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *GetBucketAclResponse) GetAcl() string {
+	if x != nil {
+		return x.Acl
+	}
+	return ""
+}
+
+// PutBucketVersioningRequest enables or suspends versioning. Once enabled it
+// can only be suspended, as in S3; any other status fails with
+// INVALID_ARGUMENT "IllegalVersioningConfigurationException". Suspending it
+// in a bucket with object lock enabled fails with FAILED_PRECONDITION
+// "InvalidBucketState".
+type PutBucketVersioningRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// "Enabled" or "Suspended".
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutBucketVersioningRequest) Reset() {
+	*x = PutBucketVersioningRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutBucketVersioningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutBucketVersioningRequest) ProtoMessage() {}
+
+func (x *PutBucketVersioningRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutBucketVersioningRequest.ProtoReflect.Descriptor instead.
+func (*PutBucketVersioningRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PutBucketVersioningRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *PutBucketVersioningRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type PutBucketVersioningResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutBucketVersioningResponse) Reset() {
+	*x = PutBucketVersioningResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutBucketVersioningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutBucketVersioningResponse) ProtoMessage() {}
+
+func (x *PutBucketVersioningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutBucketVersioningResponse.ProtoReflect.Descriptor instead.
+func (*PutBucketVersioningResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{70}
+}
+
+type GetBucketVersioningRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketVersioningRequest) Reset() {
+	*x = GetBucketVersioningRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketVersioningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketVersioningRequest) ProtoMessage() {}
+
+func (x *GetBucketVersioningRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketVersioningRequest.ProtoReflect.Descriptor instead.
+func (*GetBucketVersioningRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetBucketVersioningRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type GetBucketVersioningResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "Enabled", "Suspended", or empty if versioning was never enabled.
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketVersioningResponse) Reset() {
+	*x = GetBucketVersioningResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketVersioningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketVersioningResponse) ProtoMessage() {}
+
+func (x *GetBucketVersioningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketVersioningResponse.ProtoReflect.Descriptor instead.
+func (*GetBucketVersioningResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetBucketVersioningResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// ListObjectVersionsRequest pages through every version of the objects in a
+// bucket, delete markers included, ordered by key and then newest first,
+// following the S3 ListObjectVersions semantics.
+type ListObjectVersionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Only versions of keys beginning with prefix are listed.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Keys containing delimiter after the prefix are rolled up into a single
+	// common prefix ending at the first delimiter.
+	Delimiter string `protobuf:"bytes,3,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	// Maximum number of versions plus common prefixes to return. Unset means
+	// 1000; larger values are capped at 1000.
+	MaxKeys *int32 `protobuf:"varint,4,opt,name=max_keys,json=maxKeys,proto3,oneof" json:"max_keys,omitempty"`
+	// List versions of keys lexically after this key. With version_id_marker,
+	// versions of key_marker itself older than that version are included.
+	KeyMarker       string `protobuf:"bytes,5,opt,name=key_marker,json=keyMarker,proto3" json:"key_marker,omitempty"`
+	VersionIdMarker string `protobuf:"bytes,6,opt,name=version_id_marker,json=versionIdMarker,proto3" json:"version_id_marker,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListObjectVersionsRequest) Reset() {
+	*x = ListObjectVersionsRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectVersionsRequest) ProtoMessage() {}
+
+func (x *ListObjectVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListObjectVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListObjectVersionsRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ListObjectVersionsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ListObjectVersionsRequest) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *ListObjectVersionsRequest) GetMaxKeys() int32 {
+	if x != nil && x.MaxKeys != nil {
+		return *x.MaxKeys
+	}
+	return 0
+}
+
+func (x *ListObjectVersionsRequest) GetKeyMarker() string {
+	if x != nil {
+		return x.KeyMarker
+	}
+	return ""
+}
+
+func (x *ListObjectVersionsRequest) GetVersionIdMarker() string {
+	if x != nil {
+		return x.VersionIdMarker
+	}
+	return ""
+}
+
+type ListObjectVersionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Each with version_id, is_latest and delete_marker set. Delete markers
+	// carry only their key, version and last_modified_ms.
+	Versions       []*v11.Object `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	CommonPrefixes []string      `protobuf:"bytes,2,rep,name=common_prefixes,json=commonPrefixes,proto3" json:"common_prefixes,omitempty"`
+	IsTruncated    bool          `protobuf:"varint,3,opt,name=is_truncated,json=isTruncated,proto3" json:"is_truncated,omitempty"`
+	// Set when is_truncated is true; pass them back as key_marker and
+	// version_id_marker to fetch the next page.
+	NextKeyMarker       string `protobuf:"bytes,4,opt,name=next_key_marker,json=nextKeyMarker,proto3" json:"next_key_marker,omitempty"`
+	NextVersionIdMarker string `protobuf:"bytes,5,opt,name=next_version_id_marker,json=nextVersionIdMarker,proto3" json:"next_version_id_marker,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListObjectVersionsResponse) Reset() {
+	*x = ListObjectVersionsResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectVersionsResponse) ProtoMessage() {}
+
+func (x *ListObjectVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListObjectVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListObjectVersionsResponse) GetVersions() []*v11.Object {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListObjectVersionsResponse) GetCommonPrefixes() []string {
+	if x != nil {
+		return x.CommonPrefixes
+	}
+	return nil
+}
+
+func (x *ListObjectVersionsResponse) GetIsTruncated() bool {
+	if x != nil {
+		return x.IsTruncated
+	}
+	return false
+}
+
+func (x *ListObjectVersionsResponse) GetNextKeyMarker() string {
+	if x != nil {
+		return x.NextKeyMarker
+	}
+	return ""
+}
+
+func (x *ListObjectVersionsResponse) GetNextVersionIdMarker() string {
+	if x != nil {
+		return x.NextVersionIdMarker
+	}
+	return ""
+}
+
+// LifecycleRule is one rule of a bucket's lifecycle configuration, which
+// gantry's lifecycle worker applies in the background. A rule applies to the
+// versions of keys beginning with prefix whose objects carry every tag in
+// tags, and must have at least one action.
+type LifecycleRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional; at most 255 characters and unique within the configuration.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Disabled rules are kept but not applied.
+	Enabled bool              `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Prefix  string            `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Tags    map[string]string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Current versions expire expiration_days days after they were written,
+	// rounded up to the next midnight UTC, or from expiration_date_rfc3339,
+	// which must be a midnight UTC. Expiring the current version of an
+	// unversioned key deletes it; in a versioned bucket it leaves a delete
+	// marker. At most one of the two may be set.
+	ExpirationDays        int32  `protobuf:"varint,5,opt,name=expiration_days,json=expirationDays,proto3" json:"expiration_days,omitempty"`
+	ExpirationDateRfc3339 string `protobuf:"bytes,6,opt,name=expiration_date_rfc3339,json=expirationDateRfc3339,proto3" json:"expiration_date_rfc3339,omitempty"`
+	// Removes delete markers that are the only version left of their key.
+	// Not allowed together with expiration_days or expiration_date_rfc3339.
+	ExpiredObjectDeleteMarker bool `protobuf:"varint,7,opt,name=expired_object_delete_marker,json=expiredObjectDeleteMarker,proto3" json:"expired_object_delete_marker,omitempty"`
+	// Noncurrent versions are removed noncurrent_days days after they became
+	// noncurrent, except for the newer_noncurrent_versions newest of each key,
+	// which requires noncurrent_days.
+	NoncurrentDays          int32 `protobuf:"varint,8,opt,name=noncurrent_days,json=noncurrentDays,proto3" json:"noncurrent_days,omitempty"`
+	NewerNoncurrentVersions int32 `protobuf:"varint,9,opt,name=newer_noncurrent_versions,json=newerNoncurrentVersions,proto3" json:"newer_noncurrent_versions,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *LifecycleRule) Reset() {
+	*x = LifecycleRule{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LifecycleRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LifecycleRule) ProtoMessage() {}
+
+func (x *LifecycleRule) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LifecycleRule.ProtoReflect.Descriptor instead.
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *LifecycleRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LifecycleRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *LifecycleRule) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *LifecycleRule) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *LifecycleRule) GetExpirationDays() int32 {
+	if x != nil {
+		return x.ExpirationDays
+	}
+	return 0
+}
+
+func (x *LifecycleRule) GetExpirationDateRfc3339() string {
+	if x != nil {
+		return x.ExpirationDateRfc3339
+	}
+	return ""
+}
+
+func (x *LifecycleRule) GetExpiredObjectDeleteMarker() bool {
+	if x != nil {
+		return x.ExpiredObjectDeleteMarker
+	}
+	return false
+}
+
+func (x *LifecycleRule) GetNoncurrentDays() int32 {
+	if x != nil {
+		return x.NoncurrentDays
+	}
+	return 0
+}
+
+func (x *LifecycleRule) GetNewerNoncurrentVersions() int32 {
+	if x != nil {
+		return x.NewerNoncurrentVersions
+	}
+	return 0
+}
+
+// PutBucketLifecycleConfigurationRequest replaces the bucket's lifecycle
+// configuration with between 1 and 1000 rules. A rule with no action fails
+// with INVALID_ARGUMENT "InvalidRequest"; any other invalid rule fails with
+// INVALID_ARGUMENT "InvalidArgument".
+type PutBucketLifecycleConfigurationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Rules         []*LifecycleRule       `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutBucketLifecycleConfigurationRequest) Reset() {
+	*x = PutBucketLifecycleConfigurationRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutBucketLifecycleConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutBucketLifecycleConfigurationRequest) ProtoMessage() {}
+
+func (x *PutBucketLifecycleConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutBucketLifecycleConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*PutBucketLifecycleConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PutBucketLifecycleConfigurationRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *PutBucketLifecycleConfigurationRequest) GetRules() []*LifecycleRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type PutBucketLifecycleConfigurationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutBucketLifecycleConfigurationResponse) Reset() {
+	*x = PutBucketLifecycleConfigurationResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutBucketLifecycleConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutBucketLifecycleConfigurationResponse) ProtoMessage() {}
+
+func (x *PutBucketLifecycleConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutBucketLifecycleConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*PutBucketLifecycleConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{77}
+}
+
+// GetBucketLifecycleConfigurationRequest returns the rules as they were put.
+// A bucket without any fails with NOT_FOUND "NoSuchLifecycleConfiguration".
+type GetBucketLifecycleConfigurationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketLifecycleConfigurationRequest) Reset() {
+	*x = GetBucketLifecycleConfigurationRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketLifecycleConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketLifecycleConfigurationRequest) ProtoMessage() {}
+
+func (x *GetBucketLifecycleConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketLifecycleConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*GetBucketLifecycleConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetBucketLifecycleConfigurationRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type GetBucketLifecycleConfigurationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*LifecycleRule       `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketLifecycleConfigurationResponse) Reset() {
+	*x = GetBucketLifecycleConfigurationResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketLifecycleConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketLifecycleConfigurationResponse) ProtoMessage() {}
+
+func (x *GetBucketLifecycleConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketLifecycleConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*GetBucketLifecycleConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetBucketLifecycleConfigurationResponse) GetRules() []*LifecycleRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type DeleteBucketLifecycleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBucketLifecycleRequest) Reset() {
+	*x = DeleteBucketLifecycleRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBucketLifecycleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBucketLifecycleRequest) ProtoMessage() {}
+
+func (x *DeleteBucketLifecycleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBucketLifecycleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBucketLifecycleRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteBucketLifecycleRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type DeleteBucketLifecycleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBucketLifecycleResponse) Reset() {
+	*x = DeleteBucketLifecycleResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBucketLifecycleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBucketLifecycleResponse) ProtoMessage() {}
+
+func (x *DeleteBucketLifecycleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBucketLifecycleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{81}
+}
+
+// CorsRule is one rule of a bucket's CORS configuration. It allows
+// cross-origin requests from an origin matching one of allowed_origins using
+// one of allowed_methods (GET, PUT, HEAD, POST or DELETE), sending only
+// headers matching allowed_headers. Origins and headers may hold one *
+// wildcard.
+type CorsRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional; at most 255 characters.
+	Id             string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AllowedOrigins []string `protobuf:"bytes,2,rep,name=allowed_origins,json=allowedOrigins,proto3" json:"allowed_origins,omitempty"`
+	AllowedMethods []string `protobuf:"bytes,3,rep,name=allowed_methods,json=allowedMethods,proto3" json:"allowed_methods,omitempty"`
+	AllowedHeaders []string `protobuf:"bytes,4,rep,name=allowed_headers,json=allowedHeaders,proto3" json:"allowed_headers,omitempty"`
+	// Response headers the browser may let the page read.
+	ExposeHeaders []string `protobuf:"bytes,5,rep,name=expose_headers,json=exposeHeaders,proto3" json:"expose_headers,omitempty"`
+	// How long the browser may cache a preflight response; 0 leaves it unset.
+	MaxAgeSeconds int32 `protobuf:"varint,6,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorsRule) Reset() {
+	*x = CorsRule{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorsRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorsRule) ProtoMessage() {}
+
+func (x *CorsRule) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorsRule.ProtoReflect.Descriptor instead.
+func (*CorsRule) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CorsRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CorsRule) GetAllowedOrigins() []string {
+	if x != nil {
+		return x.AllowedOrigins
+	}
+	return nil
+}
+
+func (x *CorsRule) GetAllowedMethods() []string {
+	if x != nil {
+		return x.AllowedMethods
+	}
+	return nil
+}
+
+func (x *CorsRule) GetAllowedHeaders() []string {
+	if x != nil {
+		return x.AllowedHeaders
+	}
+	return nil
+}
+
+func (x *CorsRule) GetExposeHeaders() []string {
+	if x != nil {
+		return x.ExposeHeaders
+	}
+	return nil
+}
+
+func (x *CorsRule) GetMaxAgeSeconds() int32 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+// PutBucketCorsRequest replaces the bucket's CORS configuration with between
+// 1 and 100 rules. A rule without an origin or method, with an unsupported
+// method, or with more than one wildcard in an origin or header fails with
+// INVALID_ARGUMENT "InvalidRequest"; any other invalid rule fails with
+// INVALID_ARGUMENT "InvalidArgument".
+type PutBucketCorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Rules         []*CorsRule            `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutBucketCorsRequest) Reset() {
+	*x = PutBucketCorsRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutBucketCorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutBucketCorsRequest) ProtoMessage() {}
+
+func (x *PutBucketCorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutBucketCorsRequest.ProtoReflect.Descriptor instead.
+func (*PutBucketCorsRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PutBucketCorsRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *PutBucketCorsRequest) GetRules() []*CorsRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type PutBucketCorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutBucketCorsResponse) Reset() {
+	*x = PutBucketCorsResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutBucketCorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutBucketCorsResponse) ProtoMessage() {}
+
+func (x *PutBucketCorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutBucketCorsResponse.ProtoReflect.Descriptor instead.
+func (*PutBucketCorsResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{84}
+}
+
+// GetBucketCorsRequest returns the rules as they were put. A bucket without
+// any fails with NOT_FOUND "NoSuchCORSConfiguration".
+type GetBucketCorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketCorsRequest) Reset() {
+	*x = GetBucketCorsRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketCorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketCorsRequest) ProtoMessage() {}
+
+func (x *GetBucketCorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketCorsRequest.ProtoReflect.Descriptor instead.
+func (*GetBucketCorsRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetBucketCorsRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type GetBucketCorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*CorsRule            `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketCorsResponse) Reset() {
+	*x = GetBucketCorsResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketCorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketCorsResponse) ProtoMessage() {}
+
+func (x *GetBucketCorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketCorsResponse.ProtoReflect.Descriptor instead.
+func (*GetBucketCorsResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetBucketCorsResponse) GetRules() []*CorsRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type DeleteBucketCorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBucketCorsRequest) Reset() {
+	*x = DeleteBucketCorsRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBucketCorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBucketCorsRequest) ProtoMessage() {}
+
+func (x *DeleteBucketCorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBucketCorsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBucketCorsRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DeleteBucketCorsRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type DeleteBucketCorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBucketCorsResponse) Reset() {
+	*x = DeleteBucketCorsResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBucketCorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBucketCorsResponse) ProtoMessage() {}
+
+func (x *DeleteBucketCorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBucketCorsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBucketCorsResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{88}
+}
+
+// LookupBucketCorsRequest fetches a bucket's CORS rules for anyone, so
+// flatbed can answer browser preflights, which are never signed, and add
+// Access-Control-* headers to responses. A bucket without a CORS
+// configuration returns no rules, and so does a missing bucket, so the
+// lookup can't be used to find out which buckets exist.
+type LookupBucketCorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupBucketCorsRequest) Reset() {
+	*x = LookupBucketCorsRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupBucketCorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupBucketCorsRequest) ProtoMessage() {}
+
+func (x *LookupBucketCorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupBucketCorsRequest.ProtoReflect.Descriptor instead.
+func (*LookupBucketCorsRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *LookupBucketCorsRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type LookupBucketCorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*CorsRule            `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupBucketCorsResponse) Reset() {
+	*x = LookupBucketCorsResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupBucketCorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupBucketCorsResponse) ProtoMessage() {}
+
+func (x *LookupBucketCorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupBucketCorsResponse.ProtoReflect.Descriptor instead.
+func (*LookupBucketCorsResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *LookupBucketCorsResponse) GetRules() []*CorsRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// PutObjectTaggingRequest replaces the tags of the current version of a key,
+// or of version_id ("null" for the null version). There may be at most 10
+// tags, with keys of 1 to 128 characters not beginning with "aws:" and
+// values of at most 256 characters; other tags fail with INVALID_ARGUMENT
+// "InvalidTag". A missing bucket, key or version fails with NOT_FOUND
+// "NoSuchBucket", "NoSuchKey" or "NoSuchVersion", and a delete marker with
+// FAILED_PRECONDITION "MethodNotAllowed". No tags removes them all.
+type PutObjectTaggingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	VersionId     string                 `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Tags          map[string]string      `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutObjectTaggingRequest) Reset() {
+	*x = PutObjectTaggingRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutObjectTaggingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectTaggingRequest) ProtoMessage() {}
+
+func (x *PutObjectTaggingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectTaggingRequest.ProtoReflect.Descriptor instead.
+func (*PutObjectTaggingRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PutObjectTaggingRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *PutObjectTaggingRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PutObjectTaggingRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *PutObjectTaggingRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type PutObjectTaggingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The version tagged. Empty if the bucket was never versioned.
+	VersionId     string `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutObjectTaggingResponse) Reset() {
+	*x = PutObjectTaggingResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutObjectTaggingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectTaggingResponse) ProtoMessage() {}
+
+func (x *PutObjectTaggingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectTaggingResponse.ProtoReflect.Descriptor instead.
+func (*PutObjectTaggingResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PutObjectTaggingResponse) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+// GetObjectTaggingRequest returns the tags of a version, failing as
+// PutObjectTaggingRequest does.
+type GetObjectTaggingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	VersionId     string                 `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectTaggingRequest) Reset() {
+	*x = GetObjectTaggingRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectTaggingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectTaggingRequest) ProtoMessage() {}
+
+func (x *GetObjectTaggingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectTaggingRequest.ProtoReflect.Descriptor instead.
+func (*GetObjectTaggingRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetObjectTaggingRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *GetObjectTaggingRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetObjectTaggingRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+type GetObjectTaggingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VersionId     string                 `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Tags          map[string]string      `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectTaggingResponse) Reset() {
+	*x = GetObjectTaggingResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectTaggingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectTaggingResponse) ProtoMessage() {}
+
+func (x *GetObjectTaggingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectTaggingResponse.ProtoReflect.Descriptor instead.
+func (*GetObjectTaggingResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetObjectTaggingResponse) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *GetObjectTaggingResponse) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// DeleteObjectTaggingRequest removes every tag from a version, failing as
+// PutObjectTaggingRequest does.
+type DeleteObjectTaggingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	VersionId     string                 `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteObjectTaggingRequest) Reset() {
+	*x = DeleteObjectTaggingRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteObjectTaggingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteObjectTaggingRequest) ProtoMessage() {}
+
+func (x *DeleteObjectTaggingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteObjectTaggingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteObjectTaggingRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteObjectTaggingRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *DeleteObjectTaggingRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DeleteObjectTaggingRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+type DeleteObjectTaggingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VersionId     string                 `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteObjectTaggingResponse) Reset() {
+	*x = DeleteObjectTaggingResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteObjectTaggingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteObjectTaggingResponse) ProtoMessage() {}
+
+func (x *DeleteObjectTaggingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteObjectTaggingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteObjectTaggingResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *DeleteObjectTaggingResponse) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+// ObjectLockConfiguration is a bucket's object lock configuration and the
+// default retention of versions written to it without one of their own:
+// default_mode "GOVERNANCE" or "COMPLIANCE" for either default_days or
+// default_years from when the version is committed. An empty default_mode
+// means no default retention.
+type ObjectLockConfiguration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DefaultMode   string                 `protobuf:"bytes,1,opt,name=default_mode,json=defaultMode,proto3" json:"default_mode,omitempty"`
+	DefaultDays   int32                  `protobuf:"varint,2,opt,name=default_days,json=defaultDays,proto3" json:"default_days,omitempty"`
+	DefaultYears  int32                  `protobuf:"varint,3,opt,name=default_years,json=defaultYears,proto3" json:"default_years,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectLockConfiguration) Reset() {
+	*x = ObjectLockConfiguration{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectLockConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectLockConfiguration) ProtoMessage() {}
+
+func (x *ObjectLockConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectLockConfiguration.ProtoReflect.Descriptor instead.
+func (*ObjectLockConfiguration) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ObjectLockConfiguration) GetDefaultMode() string {
+	if x != nil {
+		return x.DefaultMode
+	}
+	return ""
+}
+
+func (x *ObjectLockConfiguration) GetDefaultDays() int32 {
+	if x != nil {
+		return x.DefaultDays
+	}
+	return 0
+}
+
+func (x *ObjectLockConfiguration) GetDefaultYears() int32 {
+	if x != nil {
+		return x.DefaultYears
+	}
+	return 0
+}
+
+// PutObjectLockConfigurationRequest enables object lock on a bucket, or
+// replaces its default retention. Object lock can only be enabled on a
+// bucket with versioning enabled, else FAILED_PRECONDITION
+// "InvalidBucketState". A default retention with an unknown mode or other
+// than one positive period fails with INVALID_ARGUMENT "MalformedXML".
+// Object lock can't be disabled again.
+type PutObjectLockConfigurationRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Bucket        string                   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Configuration *ObjectLockConfiguration `protobuf:"bytes,2,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutObjectLockConfigurationRequest) Reset() {
+	*x = PutObjectLockConfigurationRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutObjectLockConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectLockConfigurationRequest) ProtoMessage() {}
+
+func (x *PutObjectLockConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectLockConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*PutObjectLockConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *PutObjectLockConfigurationRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *PutObjectLockConfigurationRequest) GetConfiguration() *ObjectLockConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+type PutObjectLockConfigurationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutObjectLockConfigurationResponse) Reset() {
+	*x = PutObjectLockConfigurationResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutObjectLockConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectLockConfigurationResponse) ProtoMessage() {}
+
+func (x *PutObjectLockConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectLockConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*PutObjectLockConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{99}
+}
+
+// GetObjectLockConfigurationRequest returns a bucket's object lock
+// configuration. A bucket without object lock enabled fails with NOT_FOUND
+// "ObjectLockConfigurationNotFoundError".
+type GetObjectLockConfigurationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectLockConfigurationRequest) Reset() {
+	*x = GetObjectLockConfigurationRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectLockConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectLockConfigurationRequest) ProtoMessage() {}
+
+func (x *GetObjectLockConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectLockConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*GetObjectLockConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetObjectLockConfigurationRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type GetObjectLockConfigurationResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Configuration *ObjectLockConfiguration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectLockConfigurationResponse) Reset() {
+	*x = GetObjectLockConfigurationResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectLockConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectLockConfigurationResponse) ProtoMessage() {}
+
+func (x *GetObjectLockConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectLockConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*GetObjectLockConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetObjectLockConfigurationResponse) GetConfiguration() *ObjectLockConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+// PutObjectRetentionRequest sets the retention of the current version of a
+// key, or of version_id, which can't then be deleted or replaced until
+// retain_until_ms. mode is "GOVERNANCE" or "COMPLIANCE", and retain_until_ms
+// must be in the future; anything else fails with INVALID_ARGUMENT
+// "InvalidArgument". An empty mode and no retain_until_ms remove the
+// retention. A bucket without object lock enabled fails with
+// INVALID_ARGUMENT "InvalidRequest", and a missing key or version, or a
+// delete marker, as PutObjectTaggingRequest does.
+//
+// An unexpired retention may be extended, or made COMPLIANCE, but
+// shortening, removing or relaxing it fails with PERMISSION_DENIED
+// "AccessDenied", unless it is GOVERNANCE and bypass_governance_retention
+// is set, which needs s3:BypassGovernanceRetention.
+type PutObjectRetentionRequest struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Bucket                    string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key                       string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	VersionId                 string                 `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Mode                      string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	RetainUntilMs             int64                  `protobuf:"varint,5,opt,name=retain_until_ms,json=retainUntilMs,proto3" json:"retain_until_ms,omitempty"`
+	BypassGovernanceRetention bool                   `protobuf:"varint,6,opt,name=bypass_governance_retention,json=bypassGovernanceRetention,proto3" json:"bypass_governance_retention,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *PutObjectRetentionRequest) Reset() {
+	*x = PutObjectRetentionRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutObjectRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectRetentionRequest) ProtoMessage() {}
+
+func (x *PutObjectRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectRetentionRequest.ProtoReflect.Descriptor instead.
+func (*PutObjectRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *PutObjectRetentionRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *PutObjectRetentionRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PutObjectRetentionRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *PutObjectRetentionRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *PutObjectRetentionRequest) GetRetainUntilMs() int64 {
+	if x != nil {
+		return x.RetainUntilMs
+	}
+	return 0
+}
+
+func (x *PutObjectRetentionRequest) GetBypassGovernanceRetention() bool {
+	if x != nil {
+		return x.BypassGovernanceRetention
+	}
+	return false
+}
+
+type PutObjectRetentionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VersionId     string                 `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutObjectRetentionResponse) Reset() {
+	*x = PutObjectRetentionResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutObjectRetentionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectRetentionResponse) ProtoMessage() {}
+
+func (x *PutObjectRetentionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectRetentionResponse.ProtoReflect.Descriptor instead.
+func (*PutObjectRetentionResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *PutObjectRetentionResponse) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+// GetObjectRetentionRequest returns the retention of a version, failing as
+// PutObjectRetentionRequest does. A version without one, or whose retention
+// has ended, fails with NOT_FOUND "NoSuchObjectLockConfiguration".
+type GetObjectRetentionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	VersionId     string                 `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectRetentionRequest) Reset() {
+	*x = GetObjectRetentionRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectRetentionRequest) ProtoMessage() {}
+
+func (x *GetObjectRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectRetentionRequest.ProtoReflect.Descriptor instead.
+func (*GetObjectRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetObjectRetentionRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *GetObjectRetentionRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetObjectRetentionRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+type GetObjectRetentionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VersionId     string                 `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	RetainUntilMs int64                  `protobuf:"varint,3,opt,name=retain_until_ms,json=retainUntilMs,proto3" json:"retain_until_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectRetentionResponse) Reset() {
+	*x = GetObjectRetentionResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectRetentionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectRetentionResponse) ProtoMessage() {}
+
+func (x *GetObjectRetentionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectRetentionResponse.ProtoReflect.Descriptor instead.
+func (*GetObjectRetentionResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetObjectRetentionResponse) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *GetObjectRetentionResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *GetObjectRetentionResponse) GetRetainUntilMs() int64 {
+	if x != nil {
+		return x.RetainUntilMs
+	}
+	return 0
+}
+
+// PutObjectLegalHoldRequest places or lifts a legal hold on a version,
+// which can't be deleted or replaced while it is in place, whatever its
+// retention. It fails as PutObjectRetentionRequest does.
+type PutObjectLegalHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	VersionId     string                 `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	On            bool                   `protobuf:"varint,4,opt,name=on,proto3" json:"on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutObjectLegalHoldRequest) Reset() {
+	*x = PutObjectLegalHoldRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutObjectLegalHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectLegalHoldRequest) ProtoMessage() {}
+
+func (x *PutObjectLegalHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectLegalHoldRequest.ProtoReflect.Descriptor instead.
+func (*PutObjectLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *PutObjectLegalHoldRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *PutObjectLegalHoldRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PutObjectLegalHoldRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *PutObjectLegalHoldRequest) GetOn() bool {
+	if x != nil {
+		return x.On
+	}
+	return false
+}
+
+type PutObjectLegalHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VersionId     string                 `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutObjectLegalHoldResponse) Reset() {
+	*x = PutObjectLegalHoldResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutObjectLegalHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectLegalHoldResponse) ProtoMessage() {}
+
+func (x *PutObjectLegalHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectLegalHoldResponse.ProtoReflect.Descriptor instead.
+func (*PutObjectLegalHoldResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *PutObjectLegalHoldResponse) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+// GetObjectLegalHoldRequest returns whether a version is under a legal
+// hold, failing as PutObjectRetentionRequest does.
+type GetObjectLegalHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	VersionId     string                 `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectLegalHoldRequest) Reset() {
+	*x = GetObjectLegalHoldRequest{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectLegalHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectLegalHoldRequest) ProtoMessage() {}
+
+func (x *GetObjectLegalHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectLegalHoldRequest.ProtoReflect.Descriptor instead.
+func (*GetObjectLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetObjectLegalHoldRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *GetObjectLegalHoldRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetObjectLegalHoldRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+type GetObjectLegalHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VersionId     string                 `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	On            bool                   `protobuf:"varint,2,opt,name=on,proto3" json:"on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectLegalHoldResponse) Reset() {
+	*x = GetObjectLegalHoldResponse{}
+	mi := &file_gantry_service_v1_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectLegalHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectLegalHoldResponse) ProtoMessage() {}
+
+func (x *GetObjectLegalHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gantry_service_v1_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectLegalHoldResponse.ProtoReflect.Descriptor instead.
+func (*GetObjectLegalHoldResponse) Descriptor() ([]byte, []int) {
+	return file_gantry_service_v1_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetObjectLegalHoldResponse) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *GetObjectLegalHoldResponse) GetOn() bool {
+	if x != nil {
+		return x.On
+	}
+	return false
+}
+
+var File_gantry_service_v1_service_proto protoreflect.FileDescriptor
 
 const file_gantry_service_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1fgantry/service/v1/service.proto\x12\x11gantry.service.v1\x1a\x1dgantry/bucket/v1/bucket.proto\x1a%gantry/write_plan/v1/write_plan.proto\")\n" +
+	"\x1fgantry/service/v1/service.proto\x12\x11gantry.service.v1\x1a\x1dgantry/bucket/v1/bucket.proto\x1a\x1dgantry/object/v1/object.proto\x1a%gantry/write_plan/v1/write_plan.proto\"k\n" +
 	"\x13CreateBucketRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"H\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03acl\x18\x02 \x01(\tR\x03acl\x12.\n" +
+	"\x13object_lock_enabled\x18\x03 \x01(\bR\x11objectLockEnabled\"H\n" +
 	"\x14CreateBucketResponse\x120\n" +
 	"\x06bucket\x18\x01 \x01(\v2\x18.gantry.bucket.v1.BucketR\x06bucket\"\xe8\x01\n" +
 	"\x17BucketOwnershipConflict\x12I\n" +
@@ -621,11 +6464,29 @@ const file_gantry_service_v1_service_proto_rawDesc = "" +
 	"\x1cREASON_BUCKET_ALREADY_EXISTS\x10\x02\"\x14\n" +
 	"\x12ListBucketsRequest\"I\n" +
 	"\x13ListBucketsResponse\x122\n" +
-	"\abuckets\x18\x01 \x03(\v2\x18.gantry.bucket.v1.BucketR\abuckets\"P\n" +
+	"\abuckets\x18\x01 \x03(\v2\x18.gantry.bucket.v1.BucketR\abuckets\"&\n" +
+	"\x10GetBucketRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"E\n" +
+	"\x11GetBucketResponse\x120\n" +
+	"\x06bucket\x18\x01 \x01(\v2\x18.gantry.bucket.v1.BucketR\x06bucket\"?\n" +
+	"\x13DeleteBucketRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"=\n" +
+	"\x14DeleteBucketResponse\x12%\n" +
+	"\x0eobjects_queued\x18\x01 \x01(\x03R\robjectsQueued\"\xca\x03\n" +
 	"\x10PlanWriteRequest\x12\x16\n" +
 	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
 	"\x03key\x18\x02 \x01(\tR\x03key\x12\x12\n" +
-	"\x04size\x18\x03 \x01(\x03R\x04size\"S\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12<\n" +
+	"\bmetadata\x18\x05 \x01(\v2 .gantry.object.v1.ObjectMetadataR\bmetadata\x12A\n" +
+	"\x04tags\x18\x06 \x03(\v2-.gantry.service.v1.PlanWriteRequest.TagsEntryR\x04tags\x12(\n" +
+	"\x10object_lock_mode\x18\a \x01(\tR\x0eobjectLockMode\x12<\n" +
+	"\x1bobject_lock_retain_until_ms\x18\b \x01(\x03R\x17objectLockRetainUntilMs\x123\n" +
+	"\x16object_lock_legal_hold\x18\t \x01(\bR\x13objectLockLegalHold\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"S\n" +
 	"\x11PlanWriteResponse\x12>\n" +
 	"\n" +
 	"write_plan\x18\x01 \x01(\v2\x1f.gantry.write_plan.v1.WritePlanR\twritePlan\"\xe8\x01\n" +
@@ -636,17 +6497,441 @@ const file_gantry_service_v1_service_proto_rawDesc = "" +
 	"\x12REASON_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17REASON_BUCKET_NOT_FOUND\x10\x01\x12\x1f\n" +
 	"\x1bREASON_BUCKET_ACCESS_DENIED\x10\x02\x12\x1c\n" +
-	"\x18REASON_NO_CRADLE_SERVERS\x10\x03\"p\n" +
+	"\x18REASON_NO_CRADLE_SERVERS\x10\x03\"\xf3\x01\n" +
 	"\x13CommitObjectRequest\x12\x1b\n" +
 	"\tobject_id\x18\x01 \x01(\tR\bobjectId\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x03R\x04size\x12(\n" +
-	"\x10last_modified_ms\x18\x03 \x01(\x03R\x0elastModifiedMs\"\x16\n" +
-	"\x14CommitObjectResponse2\x87\x03\n" +
+	"\x10last_modified_ms\x18\x03 \x01(\x03R\x0elastModifiedMs\x12\"\n" +
+	"\rif_none_match\x18\x04 \x01(\bR\vifNoneMatch\x12\x12\n" +
+	"\x04etag\x18\x05 \x01(\tR\x04etag\x12-\n" +
+	"\x12checksum_algorithm\x18\x06 \x01(\tR\x11checksumAlgorithm\x12\x1a\n" +
+	"\bchecksum\x18\a \x01(\tR\bchecksum\"5\n" +
+	"\x14CommitObjectResponse\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x01 \x01(\tR\tversionId\"^\n" +
+	"\x13LookupObjectRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\"H\n" +
+	"\x14LookupObjectResponse\x120\n" +
+	"\x06object\x18\x01 \x01(\v2\x18.gantry.object.v1.ObjectR\x06object\"\xb6\x02\n" +
+	"\x11ObjectLookupError\x12C\n" +
+	"\x06reason\x18\x01 \x01(\x0e2+.gantry.service.v1.ObjectLookupError.ReasonR\x06reason\x12\x16\n" +
+	"\x06bucket\x18\x02 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x04 \x01(\tR\tversionId\"\x92\x01\n" +
+	"\x06Reason\x12\x16\n" +
+	"\x12REASON_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17REASON_BUCKET_NOT_FOUND\x10\x01\x12\x1b\n" +
+	"\x17REASON_OBJECT_NOT_FOUND\x10\x02\x12\x1c\n" +
+	"\x18REASON_VERSION_NOT_FOUND\x10\x03\x12\x18\n" +
+	"\x14REASON_DELETE_MARKER\x10\x04\"\x9e\x01\n" +
+	"\x13DeleteObjectRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\x12>\n" +
+	"\x1bbypass_governance_retention\x18\x04 \x01(\bR\x19bypassGovernanceRetention\"Z\n" +
+	"\x14DeleteObjectResponse\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x01 \x01(\tR\tversionId\x12#\n" +
+	"\rdelete_marker\x18\x02 \x01(\bR\fdeleteMarker\"\xad\x01\n" +
+	"\x14DeleteObjectsRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12=\n" +
+	"\aobjects\x18\x02 \x03(\v2#.gantry.service.v1.ObjectIdentifierR\aobjects\x12>\n" +
+	"\x1bbypass_governance_retention\x18\x03 \x01(\bR\x19bypassGovernanceRetention\"C\n" +
+	"\x10ObjectIdentifier\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\"\x91\x01\n" +
+	"\x15DeleteObjectsResponse\x12:\n" +
+	"\adeleted\x18\x01 \x03(\v2 .gantry.service.v1.DeletedObjectR\adeleted\x12<\n" +
+	"\x06errors\x18\x02 \x03(\v2$.gantry.service.v1.DeleteObjectErrorR\x06errors\"\x9e\x01\n" +
+	"\rDeletedObject\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\x12#\n" +
+	"\rdelete_marker\x18\x03 \x01(\bR\fdeleteMarker\x127\n" +
+	"\x18delete_marker_version_id\x18\x04 \x01(\tR\x15deleteMarkerVersionId\"X\n" +
+	"\x11DeleteObjectError\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"\xdf\x01\n" +
+	"\x12ListObjectsRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x1c\n" +
+	"\tdelimiter\x18\x03 \x01(\tR\tdelimiter\x12\x1e\n" +
+	"\bmax_keys\x18\x04 \x01(\x05H\x00R\amaxKeys\x88\x01\x01\x12\x1f\n" +
+	"\vstart_after\x18\x05 \x01(\tR\n" +
+	"startAfter\x12-\n" +
+	"\x12continuation_token\x18\x06 \x01(\tR\x11continuationTokenB\v\n" +
+	"\t_max_keys\"\xcd\x01\n" +
+	"\x13ListObjectsResponse\x122\n" +
+	"\aobjects\x18\x01 \x03(\v2\x18.gantry.object.v1.ObjectR\aobjects\x12'\n" +
+	"\x0fcommon_prefixes\x18\x02 \x03(\tR\x0ecommonPrefixes\x12!\n" +
+	"\fis_truncated\x18\x03 \x01(\bR\visTruncated\x126\n" +
+	"\x17next_continuation_token\x18\x04 \x01(\tR\x15nextContinuationToken\"\xa9\x01\n" +
+	"\x1cCreateMultipartUploadRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12<\n" +
+	"\bmetadata\x18\x04 \x01(\v2 .gantry.object.v1.ObjectMetadataR\bmetadata\"<\n" +
+	"\x1dCreateMultipartUploadResponse\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\"\x8d\x01\n" +
+	"\x0fPlanPartRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1b\n" +
+	"\tupload_id\x18\x03 \x01(\tR\buploadId\x12\x1f\n" +
+	"\vpart_number\x18\x04 \x01(\x05R\n" +
+	"partNumber\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\"R\n" +
+	"\x10PlanPartResponse\x12>\n" +
+	"\n" +
+	"write_plan\x18\x01 \x01(\v2\x1f.gantry.write_plan.v1.WritePlanR\twritePlan\"~\n" +
+	"\x11CommitPartRequest\x12\x17\n" +
+	"\ablob_id\x18\x01 \x01(\tR\x06blobId\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12(\n" +
+	"\x10last_modified_ms\x18\x03 \x01(\x03R\x0elastModifiedMs\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"(\n" +
+	"\x12CommitPartResponse\x12\x12\n" +
+	"\x04etag\x18\x01 \x01(\tR\x04etag\"D\n" +
+	"\rCompletedPart\x12\x1f\n" +
+	"\vpart_number\x18\x01 \x01(\x05R\n" +
+	"partNumber\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"\x9f\x01\n" +
+	"\x1eCompleteMultipartUploadRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1b\n" +
+	"\tupload_id\x18\x03 \x01(\tR\buploadId\x126\n" +
+	"\x05parts\x18\x04 \x03(\v2 .gantry.service.v1.CompletedPartR\x05parts\"T\n" +
+	"\x1fCompleteMultipartUploadResponse\x12\x12\n" +
+	"\x04etag\x18\x01 \x01(\tR\x04etag\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\"d\n" +
+	"\x1bAbortMultipartUploadRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1b\n" +
+	"\tupload_id\x18\x03 \x01(\tR\buploadId\"\x1e\n" +
+	"\x1cAbortMultipartUploadResponse\"\xea\x01\n" +
+	"\x1bListMultipartUploadsRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x1c\n" +
+	"\tdelimiter\x18\x03 \x01(\tR\tdelimiter\x12$\n" +
+	"\vmax_uploads\x18\x04 \x01(\x05H\x00R\n" +
+	"maxUploads\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"key_marker\x18\x05 \x01(\tR\tkeyMarker\x12(\n" +
+	"\x10upload_id_marker\x18\x06 \x01(\tR\x0euploadIdMarkerB\x0e\n" +
+	"\f_max_uploads\"c\n" +
+	"\x0fMultipartUpload\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1b\n" +
+	"\tupload_id\x18\x02 \x01(\tR\buploadId\x12!\n" +
+	"\finitiated_ms\x18\x03 \x01(\x03R\vinitiatedMs\"\x83\x02\n" +
+	"\x1cListMultipartUploadsResponse\x12<\n" +
+	"\auploads\x18\x01 \x03(\v2\".gantry.service.v1.MultipartUploadR\auploads\x12'\n" +
+	"\x0fcommon_prefixes\x18\x02 \x03(\tR\x0ecommonPrefixes\x12!\n" +
+	"\fis_truncated\x18\x03 \x01(\bR\visTruncated\x12&\n" +
+	"\x0fnext_key_marker\x18\x04 \x01(\tR\rnextKeyMarker\x121\n" +
+	"\x15next_upload_id_marker\x18\x05 \x01(\tR\x12nextUploadIdMarker\"\xb7\x01\n" +
+	"\x10ListPartsRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1b\n" +
+	"\tupload_id\x18\x03 \x01(\tR\buploadId\x12,\n" +
+	"\x12part_number_marker\x18\x04 \x01(\x05R\x10partNumberMarker\x12 \n" +
+	"\tmax_parts\x18\x05 \x01(\x05H\x00R\bmaxParts\x88\x01\x01B\f\n" +
+	"\n" +
+	"_max_parts\"\x81\x01\n" +
+	"\fUploadedPart\x12\x1f\n" +
+	"\vpart_number\x18\x01 \x01(\x05R\n" +
+	"partNumber\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12(\n" +
+	"\x10last_modified_ms\x18\x04 \x01(\x03R\x0elastModifiedMs\"\xa4\x01\n" +
+	"\x11ListPartsResponse\x125\n" +
+	"\x05parts\x18\x01 \x03(\v2\x1f.gantry.service.v1.UploadedPartR\x05parts\x12!\n" +
+	"\fis_truncated\x18\x02 \x01(\bR\visTruncated\x125\n" +
+	"\x17next_part_number_marker\x18\x03 \x01(\x05R\x14nextPartNumberMarker\"9\n" +
+	"\x13GetAccessKeyRequest\x12\"\n" +
+	"\raccess_key_id\x18\x01 \x01(\tR\vaccessKeyId\"\x9c\x01\n" +
+	"\x14GetAccessKeyResponse\x12\"\n" +
+	"\raccess_key_id\x18\x01 \x01(\tR\vaccessKeyId\x12*\n" +
+	"\x11secret_access_key\x18\x02 \x01(\tR\x0fsecretAccessKey\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x04 \x01(\tR\buserName\"I\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_ms\x18\x03 \x01(\x03R\tcreatedMs\"\xc0\x01\n" +
+	"\tAccessKey\x12\"\n" +
+	"\raccess_key_id\x18\x01 \x01(\tR\vaccessKeyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12:\n" +
+	"\x06status\x18\x04 \x01(\x0e2\".gantry.service.v1.AccessKeyStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_ms\x18\x05 \x01(\x03R\tcreatedMs\"'\n" +
+	"\x11CreateUserRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"A\n" +
+	"\x12CreateUserResponse\x12+\n" +
+	"\x04user\x18\x01 \x01(\v2\x17.gantry.service.v1.UserR\x04user\"\x12\n" +
+	"\x10ListUsersRequest\"B\n" +
+	"\x11ListUsersResponse\x12-\n" +
+	"\x05users\x18\x01 \x03(\v2\x17.gantry.service.v1.UserR\x05users\"5\n" +
+	"\x16CreateAccessKeyRequest\x12\x1b\n" +
+	"\tuser_name\x18\x01 \x01(\tR\buserName\"\x82\x01\n" +
+	"\x17CreateAccessKeyResponse\x12;\n" +
+	"\n" +
+	"access_key\x18\x01 \x01(\v2\x1c.gantry.service.v1.AccessKeyR\taccessKey\x12*\n" +
+	"\x11secret_access_key\x18\x02 \x01(\tR\x0fsecretAccessKey\"4\n" +
+	"\x15ListAccessKeysRequest\x12\x1b\n" +
+	"\tuser_name\x18\x01 \x01(\tR\buserName\"W\n" +
+	"\x16ListAccessKeysResponse\x12=\n" +
+	"\vaccess_keys\x18\x01 \x03(\v2\x1c.gantry.service.v1.AccessKeyR\n" +
+	"accessKeys\"x\n" +
+	"\x16UpdateAccessKeyRequest\x12\"\n" +
+	"\raccess_key_id\x18\x01 \x01(\tR\vaccessKeyId\x12:\n" +
+	"\x06status\x18\x02 \x01(\x0e2\".gantry.service.v1.AccessKeyStatusR\x06status\"V\n" +
+	"\x17UpdateAccessKeyResponse\x12;\n" +
+	"\n" +
+	"access_key\x18\x01 \x01(\v2\x1c.gantry.service.v1.AccessKeyR\taccessKey\"<\n" +
+	"\x16RotateAccessKeyRequest\x12\"\n" +
+	"\raccess_key_id\x18\x01 \x01(\tR\vaccessKeyId\"\x82\x01\n" +
+	"\x17RotateAccessKeyResponse\x12;\n" +
+	"\n" +
+	"access_key\x18\x01 \x01(\v2\x1c.gantry.service.v1.AccessKeyR\taccessKey\x12*\n" +
+	"\x11secret_access_key\x18\x02 \x01(\tR\x0fsecretAccessKey\"H\n" +
+	"\x16PutBucketPolicyRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x16\n" +
+	"\x06policy\x18\x02 \x01(\tR\x06policy\"\x19\n" +
+	"\x17PutBucketPolicyResponse\"0\n" +
+	"\x16GetBucketPolicyRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\"1\n" +
+	"\x17GetBucketPolicyResponse\x12\x16\n" +
+	"\x06policy\x18\x01 \x01(\tR\x06policy\"3\n" +
+	"\x19DeleteBucketPolicyRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\"\x1c\n" +
+	"\x1aDeleteBucketPolicyResponse\"?\n" +
+	"\x13PutBucketAclRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03acl\x18\x02 \x01(\tR\x03acl\"\x16\n" +
+	"\x14PutBucketAclResponse\"-\n" +
+	"\x13GetBucketAclRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\"b\n" +
+	"\x14GetBucketAclResponse\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x1d\n" +
+	"\n" +
+	"owner_name\x18\x02 \x01(\tR\townerName\x12\x10\n" +
+	"\x03acl\x18\x03 \x01(\tR\x03acl\"L\n" +
+	"\x1aPutBucketVersioningRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\x1d\n" +
+	"\x1bPutBucketVersioningResponse\"4\n" +
+	"\x1aGetBucketVersioningRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\"5\n" +
+	"\x1bGetBucketVersioningResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\xe1\x01\n" +
+	"\x19ListObjectVersionsRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x1c\n" +
+	"\tdelimiter\x18\x03 \x01(\tR\tdelimiter\x12\x1e\n" +
+	"\bmax_keys\x18\x04 \x01(\x05H\x00R\amaxKeys\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"key_marker\x18\x05 \x01(\tR\tkeyMarker\x12*\n" +
+	"\x11version_id_marker\x18\x06 \x01(\tR\x0fversionIdMarkerB\v\n" +
+	"\t_max_keys\"\xfb\x01\n" +
+	"\x1aListObjectVersionsResponse\x124\n" +
+	"\bversions\x18\x01 \x03(\v2\x18.gantry.object.v1.ObjectR\bversions\x12'\n" +
+	"\x0fcommon_prefixes\x18\x02 \x03(\tR\x0ecommonPrefixes\x12!\n" +
+	"\fis_truncated\x18\x03 \x01(\bR\visTruncated\x12&\n" +
+	"\x0fnext_key_marker\x18\x04 \x01(\tR\rnextKeyMarker\x123\n" +
+	"\x16next_version_id_marker\x18\x05 \x01(\tR\x13nextVersionIdMarker\"\xd1\x03\n" +
+	"\rLifecycleRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12>\n" +
+	"\x04tags\x18\x04 \x03(\v2*.gantry.service.v1.LifecycleRule.TagsEntryR\x04tags\x12'\n" +
+	"\x0fexpiration_days\x18\x05 \x01(\x05R\x0eexpirationDays\x126\n" +
+	"\x17expiration_date_rfc3339\x18\x06 \x01(\tR\x15expirationDateRfc3339\x12?\n" +
+	"\x1cexpired_object_delete_marker\x18\a \x01(\bR\x19expiredObjectDeleteMarker\x12'\n" +
+	"\x0fnoncurrent_days\x18\b \x01(\x05R\x0enoncurrentDays\x12:\n" +
+	"\x19newer_noncurrent_versions\x18\t \x01(\x05R\x17newerNoncurrentVersions\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"x\n" +
+	"&PutBucketLifecycleConfigurationRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x126\n" +
+	"\x05rules\x18\x02 \x03(\v2 .gantry.service.v1.LifecycleRuleR\x05rules\")\n" +
+	"'PutBucketLifecycleConfigurationResponse\"@\n" +
+	"&GetBucketLifecycleConfigurationRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\"a\n" +
+	"'GetBucketLifecycleConfigurationResponse\x126\n" +
+	"\x05rules\x18\x01 \x03(\v2 .gantry.service.v1.LifecycleRuleR\x05rules\"6\n" +
+	"\x1cDeleteBucketLifecycleRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\"\x1f\n" +
+	"\x1dDeleteBucketLifecycleResponse\"\xe4\x01\n" +
+	"\bCorsRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fallowed_origins\x18\x02 \x03(\tR\x0eallowedOrigins\x12'\n" +
+	"\x0fallowed_methods\x18\x03 \x03(\tR\x0eallowedMethods\x12'\n" +
+	"\x0fallowed_headers\x18\x04 \x03(\tR\x0eallowedHeaders\x12%\n" +
+	"\x0eexpose_headers\x18\x05 \x03(\tR\rexposeHeaders\x12&\n" +
+	"\x0fmax_age_seconds\x18\x06 \x01(\x05R\rmaxAgeSeconds\"a\n" +
+	"\x14PutBucketCorsRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x121\n" +
+	"\x05rules\x18\x02 \x03(\v2\x1b.gantry.service.v1.CorsRuleR\x05rules\"\x17\n" +
+	"\x15PutBucketCorsResponse\".\n" +
+	"\x14GetBucketCorsRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\"J\n" +
+	"\x15GetBucketCorsResponse\x121\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1b.gantry.service.v1.CorsRuleR\x05rules\"1\n" +
+	"\x17DeleteBucketCorsRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\"\x1a\n" +
+	"\x18DeleteBucketCorsResponse\"1\n" +
+	"\x17LookupBucketCorsRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\"M\n" +
+	"\x18LookupBucketCorsResponse\x121\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1b.gantry.service.v1.CorsRuleR\x05rules\"\xe5\x01\n" +
+	"\x17PutObjectTaggingRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\x12H\n" +
+	"\x04tags\x18\x04 \x03(\v24.gantry.service.v1.PutObjectTaggingRequest.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"9\n" +
+	"\x18PutObjectTaggingResponse\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x01 \x01(\tR\tversionId\"b\n" +
+	"\x17GetObjectTaggingRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\"\xbd\x01\n" +
+	"\x18GetObjectTaggingResponse\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x01 \x01(\tR\tversionId\x12I\n" +
+	"\x04tags\x18\x02 \x03(\v25.gantry.service.v1.GetObjectTaggingResponse.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"e\n" +
+	"\x1aDeleteObjectTaggingRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\"<\n" +
+	"\x1bDeleteObjectTaggingResponse\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x01 \x01(\tR\tversionId\"\x84\x01\n" +
+	"\x17ObjectLockConfiguration\x12!\n" +
+	"\fdefault_mode\x18\x01 \x01(\tR\vdefaultMode\x12!\n" +
+	"\fdefault_days\x18\x02 \x01(\x05R\vdefaultDays\x12#\n" +
+	"\rdefault_years\x18\x03 \x01(\x05R\fdefaultYears\"\x8d\x01\n" +
+	"!PutObjectLockConfigurationRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12P\n" +
+	"\rconfiguration\x18\x02 \x01(\v2*.gantry.service.v1.ObjectLockConfigurationR\rconfiguration\"$\n" +
+	"\"PutObjectLockConfigurationResponse\";\n" +
+	"!GetObjectLockConfigurationRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\"v\n" +
+	"\"GetObjectLockConfigurationResponse\x12P\n" +
+	"\rconfiguration\x18\x01 \x01(\v2*.gantry.service.v1.ObjectLockConfigurationR\rconfiguration\"\xe0\x01\n" +
+	"\x19PutObjectRetentionRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x12&\n" +
+	"\x0fretain_until_ms\x18\x05 \x01(\x03R\rretainUntilMs\x12>\n" +
+	"\x1bbypass_governance_retention\x18\x06 \x01(\bR\x19bypassGovernanceRetention\";\n" +
+	"\x1aPutObjectRetentionResponse\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x01 \x01(\tR\tversionId\"d\n" +
+	"\x19GetObjectRetentionRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\"w\n" +
+	"\x1aGetObjectRetentionResponse\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x01 \x01(\tR\tversionId\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12&\n" +
+	"\x0fretain_until_ms\x18\x03 \x01(\x03R\rretainUntilMs\"t\n" +
+	"\x19PutObjectLegalHoldRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\x12\x0e\n" +
+	"\x02on\x18\x04 \x01(\bR\x02on\";\n" +
+	"\x1aPutObjectLegalHoldResponse\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x01 \x01(\tR\tversionId\"d\n" +
+	"\x19GetObjectLegalHoldRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\"K\n" +
+	"\x1aGetObjectLegalHoldResponse\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x01 \x01(\tR\tversionId\x12\x0e\n" +
+	"\x02on\x18\x02 \x01(\bR\x02on*r\n" +
+	"\x0fAccessKeyStatus\x12!\n" +
+	"\x1dACCESS_KEY_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ACCESS_KEY_STATUS_ACTIVE\x10\x01\x12\x1e\n" +
+	"\x1aACCESS_KEY_STATUS_INACTIVE\x10\x022\xe6(\n" +
 	"\rGantryService\x12_\n" +
 	"\fCreateBucket\x12&.gantry.service.v1.CreateBucketRequest\x1a'.gantry.service.v1.CreateBucketResponse\x12\\\n" +
 	"\vListBuckets\x12%.gantry.service.v1.ListBucketsRequest\x1a&.gantry.service.v1.ListBucketsResponse\x12V\n" +
+	"\tGetBucket\x12#.gantry.service.v1.GetBucketRequest\x1a$.gantry.service.v1.GetBucketResponse\x12_\n" +
+	"\fDeleteBucket\x12&.gantry.service.v1.DeleteBucketRequest\x1a'.gantry.service.v1.DeleteBucketResponse\x12V\n" +
 	"\tPlanWrite\x12#.gantry.service.v1.PlanWriteRequest\x1a$.gantry.service.v1.PlanWriteResponse\x12_\n" +
-	"\fCommitObject\x12&.gantry.service.v1.CommitObjectRequest\x1a'.gantry.service.v1.CommitObjectResponseB\xd2\x01\n" +
+	"\fCommitObject\x12&.gantry.service.v1.CommitObjectRequest\x1a'.gantry.service.v1.CommitObjectResponse\x12_\n" +
+	"\fLookupObject\x12&.gantry.service.v1.LookupObjectRequest\x1a'.gantry.service.v1.LookupObjectResponse\x12_\n" +
+	"\fDeleteObject\x12&.gantry.service.v1.DeleteObjectRequest\x1a'.gantry.service.v1.DeleteObjectResponse\x12\\\n" +
+	"\vListObjects\x12%.gantry.service.v1.ListObjectsRequest\x1a&.gantry.service.v1.ListObjectsResponse\x12z\n" +
+	"\x15CreateMultipartUpload\x12/.gantry.service.v1.CreateMultipartUploadRequest\x1a0.gantry.service.v1.CreateMultipartUploadResponse\x12S\n" +
+	"\bPlanPart\x12\".gantry.service.v1.PlanPartRequest\x1a#.gantry.service.v1.PlanPartResponse\x12Y\n" +
+	"\n" +
+	"CommitPart\x12$.gantry.service.v1.CommitPartRequest\x1a%.gantry.service.v1.CommitPartResponse\x12\x80\x01\n" +
+	"\x17CompleteMultipartUpload\x121.gantry.service.v1.CompleteMultipartUploadRequest\x1a2.gantry.service.v1.CompleteMultipartUploadResponse\x12w\n" +
+	"\x14AbortMultipartUpload\x12..gantry.service.v1.AbortMultipartUploadRequest\x1a/.gantry.service.v1.AbortMultipartUploadResponse\x12w\n" +
+	"\x14ListMultipartUploads\x12..gantry.service.v1.ListMultipartUploadsRequest\x1a/.gantry.service.v1.ListMultipartUploadsResponse\x12V\n" +
+	"\tListParts\x12#.gantry.service.v1.ListPartsRequest\x1a$.gantry.service.v1.ListPartsResponse\x12_\n" +
+	"\fGetAccessKey\x12&.gantry.service.v1.GetAccessKeyRequest\x1a'.gantry.service.v1.GetAccessKeyResponse\x12Y\n" +
+	"\n" +
+	"CreateUser\x12$.gantry.service.v1.CreateUserRequest\x1a%.gantry.service.v1.CreateUserResponse\x12V\n" +
+	"\tListUsers\x12#.gantry.service.v1.ListUsersRequest\x1a$.gantry.service.v1.ListUsersResponse\x12h\n" +
+	"\x0fCreateAccessKey\x12).gantry.service.v1.CreateAccessKeyRequest\x1a*.gantry.service.v1.CreateAccessKeyResponse\x12e\n" +
+	"\x0eListAccessKeys\x12(.gantry.service.v1.ListAccessKeysRequest\x1a).gantry.service.v1.ListAccessKeysResponse\x12h\n" +
+	"\x0fUpdateAccessKey\x12).gantry.service.v1.UpdateAccessKeyRequest\x1a*.gantry.service.v1.UpdateAccessKeyResponse\x12h\n" +
+	"\x0fRotateAccessKey\x12).gantry.service.v1.RotateAccessKeyRequest\x1a*.gantry.service.v1.RotateAccessKeyResponse\x12h\n" +
+	"\x0fPutBucketPolicy\x12).gantry.service.v1.PutBucketPolicyRequest\x1a*.gantry.service.v1.PutBucketPolicyResponse\x12h\n" +
+	"\x0fGetBucketPolicy\x12).gantry.service.v1.GetBucketPolicyRequest\x1a*.gantry.service.v1.GetBucketPolicyResponse\x12q\n" +
+	"\x12DeleteBucketPolicy\x12,.gantry.service.v1.DeleteBucketPolicyRequest\x1a-.gantry.service.v1.DeleteBucketPolicyResponse\x12_\n" +
+	"\fPutBucketAcl\x12&.gantry.service.v1.PutBucketAclRequest\x1a'.gantry.service.v1.PutBucketAclResponse\x12_\n" +
+	"\fGetBucketAcl\x12&.gantry.service.v1.GetBucketAclRequest\x1a'.gantry.service.v1.GetBucketAclResponse\x12t\n" +
+	"\x13PutBucketVersioning\x12-.gantry.service.v1.PutBucketVersioningRequest\x1a..gantry.service.v1.PutBucketVersioningResponse\x12t\n" +
+	"\x13GetBucketVersioning\x12-.gantry.service.v1.GetBucketVersioningRequest\x1a..gantry.service.v1.GetBucketVersioningResponse\x12q\n" +
+	"\x12ListObjectVersions\x12,.gantry.service.v1.ListObjectVersionsRequest\x1a-.gantry.service.v1.ListObjectVersionsResponse\x12\x98\x01\n" +
+	"\x1fPutBucketLifecycleConfiguration\x129.gantry.service.v1.PutBucketLifecycleConfigurationRequest\x1a:.gantry.service.v1.PutBucketLifecycleConfigurationResponse\x12\x98\x01\n" +
+	"\x1fGetBucketLifecycleConfiguration\x129.gantry.service.v1.GetBucketLifecycleConfigurationRequest\x1a:.gantry.service.v1.GetBucketLifecycleConfigurationResponse\x12z\n" +
+	"\x15DeleteBucketLifecycle\x12/.gantry.service.v1.DeleteBucketLifecycleRequest\x1a0.gantry.service.v1.DeleteBucketLifecycleResponse\x12b\n" +
+	"\rPutBucketCors\x12'.gantry.service.v1.PutBucketCorsRequest\x1a(.gantry.service.v1.PutBucketCorsResponse\x12b\n" +
+	"\rGetBucketCors\x12'.gantry.service.v1.GetBucketCorsRequest\x1a(.gantry.service.v1.GetBucketCorsResponse\x12k\n" +
+	"\x10DeleteBucketCors\x12*.gantry.service.v1.DeleteBucketCorsRequest\x1a+.gantry.service.v1.DeleteBucketCorsResponse\x12k\n" +
+	"\x10LookupBucketCors\x12*.gantry.service.v1.LookupBucketCorsRequest\x1a+.gantry.service.v1.LookupBucketCorsResponse\x12k\n" +
+	"\x10PutObjectTagging\x12*.gantry.service.v1.PutObjectTaggingRequest\x1a+.gantry.service.v1.PutObjectTaggingResponse\x12k\n" +
+	"\x10GetObjectTagging\x12*.gantry.service.v1.GetObjectTaggingRequest\x1a+.gantry.service.v1.GetObjectTaggingResponse\x12t\n" +
+	"\x13DeleteObjectTagging\x12-.gantry.service.v1.DeleteObjectTaggingRequest\x1a..gantry.service.v1.DeleteObjectTaggingResponse\x12b\n" +
+	"\rDeleteObjects\x12'.gantry.service.v1.DeleteObjectsRequest\x1a(.gantry.service.v1.DeleteObjectsResponse\x12\x89\x01\n" +
+	"\x1aPutObjectLockConfiguration\x124.gantry.service.v1.PutObjectLockConfigurationRequest\x1a5.gantry.service.v1.PutObjectLockConfigurationResponse\x12\x89\x01\n" +
+	"\x1aGetObjectLockConfiguration\x124.gantry.service.v1.GetObjectLockConfigurationRequest\x1a5.gantry.service.v1.GetObjectLockConfigurationResponse\x12q\n" +
+	"\x12PutObjectRetention\x12,.gantry.service.v1.PutObjectRetentionRequest\x1a-.gantry.service.v1.PutObjectRetentionResponse\x12q\n" +
+	"\x12GetObjectRetention\x12,.gantry.service.v1.GetObjectRetentionRequest\x1a-.gantry.service.v1.GetObjectRetentionResponse\x12q\n" +
+	"\x12PutObjectLegalHold\x12,.gantry.service.v1.PutObjectLegalHoldRequest\x1a-.gantry.service.v1.PutObjectLegalHoldResponse\x12q\n" +
+	"\x12GetObjectLegalHold\x12,.gantry.service.v1.GetObjectLegalHoldRequest\x1a-.gantry.service.v1.GetObjectLegalHoldResponseB\xd2\x01\n" +
 	"\x15com.gantry.service.v1B\fServiceProtoP\x01ZEgithub.com/ratdaddy/blockcloset/proto/gen/gantry/service/v1;servicev1\xa2\x02\x03GSX\xaa\x02\x11Gantry.Service.V1\xca\x02\x11Gantry\\Service\\V1\xe2\x02\x1dGantry\\Service\\V1\\GPBMetadata\xea\x02\x13Gantry::Service::V1b\x06proto3"
 
 var (
@@ -661,43 +6946,272 @@ func file_gantry_service_v1_service_proto_rawDescGZIP() []byte {
 	return file_gantry_service_v1_service_proto_rawDescData
 }
 
-var file_gantry_service_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_gantry_service_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_gantry_service_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_gantry_service_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
 var file_gantry_service_v1_service_proto_goTypes = []any{
-	(BucketOwnershipConflict_Reason)(0), // 0: gantry.service.v1.BucketOwnershipConflict.Reason
-	(PlanWriteError_Reason)(0),          // 1: gantry.service.v1.PlanWriteError.Reason
-	(*CreateBucketRequest)(nil),         // 2: gantry.service.v1.CreateBucketRequest
-	(*CreateBucketResponse)(nil),        // 3: gantry.service.v1.CreateBucketResponse
-	(*BucketOwnershipConflict)(nil),     // 4: gantry.service.v1.BucketOwnershipConflict
-	(*ListBucketsRequest)(nil),          // 5: gantry.service.v1.ListBucketsRequest
-	(*ListBucketsResponse)(nil),         // 6: gantry.service.v1.ListBucketsResponse
-	(*PlanWriteRequest)(nil),            // 7: gantry.service.v1.PlanWriteRequest
-	(*PlanWriteResponse)(nil),           // 8: gantry.service.v1.PlanWriteResponse
-	(*PlanWriteError)(nil),              // 9: gantry.service.v1.PlanWriteError
-	(*CommitObjectRequest)(nil),         // 10: gantry.service.v1.CommitObjectRequest
-	(*CommitObjectResponse)(nil),        // 11: gantry.service.v1.CommitObjectResponse
-	(*v1.Bucket)(nil),                   // 12: gantry.bucket.v1.Bucket
-	(*v11.WritePlan)(nil),               // 13: gantry.write_plan.v1.WritePlan
+	(AccessKeyStatus)(0),                            // 0: gantry.service.v1.AccessKeyStatus
+	(BucketOwnershipConflict_Reason)(0),             // 1: gantry.service.v1.BucketOwnershipConflict.Reason
+	(PlanWriteError_Reason)(0),                      // 2: gantry.service.v1.PlanWriteError.Reason
+	(ObjectLookupError_Reason)(0),                   // 3: gantry.service.v1.ObjectLookupError.Reason
+	(*CreateBucketRequest)(nil),                     // 4: gantry.service.v1.CreateBucketRequest
+	(*CreateBucketResponse)(nil),                    // 5: gantry.service.v1.CreateBucketResponse
+	(*BucketOwnershipConflict)(nil),                 // 6: gantry.service.v1.BucketOwnershipConflict
+	(*ListBucketsRequest)(nil),                      // 7: gantry.service.v1.ListBucketsRequest
+	(*ListBucketsResponse)(nil),                     // 8: gantry.service.v1.ListBucketsResponse
+	(*GetBucketRequest)(nil),                        // 9: gantry.service.v1.GetBucketRequest
+	(*GetBucketResponse)(nil),                       // 10: gantry.service.v1.GetBucketResponse
+	(*DeleteBucketRequest)(nil),                     // 11: gantry.service.v1.DeleteBucketRequest
+	(*DeleteBucketResponse)(nil),                    // 12: gantry.service.v1.DeleteBucketResponse
+	(*PlanWriteRequest)(nil),                        // 13: gantry.service.v1.PlanWriteRequest
+	(*PlanWriteResponse)(nil),                       // 14: gantry.service.v1.PlanWriteResponse
+	(*PlanWriteError)(nil),                          // 15: gantry.service.v1.PlanWriteError
+	(*CommitObjectRequest)(nil),                     // 16: gantry.service.v1.CommitObjectRequest
+	(*CommitObjectResponse)(nil),                    // 17: gantry.service.v1.CommitObjectResponse
+	(*LookupObjectRequest)(nil),                     // 18: gantry.service.v1.LookupObjectRequest
+	(*LookupObjectResponse)(nil),                    // 19: gantry.service.v1.LookupObjectResponse
+	(*ObjectLookupError)(nil),                       // 20: gantry.service.v1.ObjectLookupError
+	(*DeleteObjectRequest)(nil),                     // 21: gantry.service.v1.DeleteObjectRequest
+	(*DeleteObjectResponse)(nil),                    // 22: gantry.service.v1.DeleteObjectResponse
+	(*DeleteObjectsRequest)(nil),                    // 23: gantry.service.v1.DeleteObjectsRequest
+	(*ObjectIdentifier)(nil),                        // 24: gantry.service.v1.ObjectIdentifier
+	(*DeleteObjectsResponse)(nil),                   // 25: gantry.service.v1.DeleteObjectsResponse
+	(*DeletedObject)(nil),                           // 26: gantry.service.v1.DeletedObject
+	(*DeleteObjectError)(nil),                       // 27: gantry.service.v1.DeleteObjectError
+	(*ListObjectsRequest)(nil),                      // 28: gantry.service.v1.ListObjectsRequest
+	(*ListObjectsResponse)(nil),                     // 29: gantry.service.v1.ListObjectsResponse
+	(*CreateMultipartUploadRequest)(nil),            // 30: gantry.service.v1.CreateMultipartUploadRequest
+	(*CreateMultipartUploadResponse)(nil),           // 31: gantry.service.v1.CreateMultipartUploadResponse
+	(*PlanPartRequest)(nil),                         // 32: gantry.service.v1.PlanPartRequest
+	(*PlanPartResponse)(nil),                        // 33: gantry.service.v1.PlanPartResponse
+	(*CommitPartRequest)(nil),                       // 34: gantry.service.v1.CommitPartRequest
+	(*CommitPartResponse)(nil),                      // 35: gantry.service.v1.CommitPartResponse
+	(*CompletedPart)(nil),                           // 36: gantry.service.v1.CompletedPart
+	(*CompleteMultipartUploadRequest)(nil),          // 37: gantry.service.v1.CompleteMultipartUploadRequest
+	(*CompleteMultipartUploadResponse)(nil),         // 38: gantry.service.v1.CompleteMultipartUploadResponse
+	(*AbortMultipartUploadRequest)(nil),             // 39: gantry.service.v1.AbortMultipartUploadRequest
+	(*AbortMultipartUploadResponse)(nil),            // 40: gantry.service.v1.AbortMultipartUploadResponse
+	(*ListMultipartUploadsRequest)(nil),             // 41: gantry.service.v1.ListMultipartUploadsRequest
+	(*MultipartUpload)(nil),                         // 42: gantry.service.v1.MultipartUpload
+	(*ListMultipartUploadsResponse)(nil),            // 43: gantry.service.v1.ListMultipartUploadsResponse
+	(*ListPartsRequest)(nil),                        // 44: gantry.service.v1.ListPartsRequest
+	(*UploadedPart)(nil),                            // 45: gantry.service.v1.UploadedPart
+	(*ListPartsResponse)(nil),                       // 46: gantry.service.v1.ListPartsResponse
+	(*GetAccessKeyRequest)(nil),                     // 47: gantry.service.v1.GetAccessKeyRequest
+	(*GetAccessKeyResponse)(nil),                    // 48: gantry.service.v1.GetAccessKeyResponse
+	(*User)(nil),                                    // 49: gantry.service.v1.User
+	(*AccessKey)(nil),                               // 50: gantry.service.v1.AccessKey
+	(*CreateUserRequest)(nil),                       // 51: gantry.service.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),                      // 52: gantry.service.v1.CreateUserResponse
+	(*ListUsersRequest)(nil),                        // 53: gantry.service.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                       // 54: gantry.service.v1.ListUsersResponse
+	(*CreateAccessKeyRequest)(nil),                  // 55: gantry.service.v1.CreateAccessKeyRequest
+	(*CreateAccessKeyResponse)(nil),                 // 56: gantry.service.v1.CreateAccessKeyResponse
+	(*ListAccessKeysRequest)(nil),                   // 57: gantry.service.v1.ListAccessKeysRequest
+	(*ListAccessKeysResponse)(nil),                  // 58: gantry.service.v1.ListAccessKeysResponse
+	(*UpdateAccessKeyRequest)(nil),                  // 59: gantry.service.v1.UpdateAccessKeyRequest
+	(*UpdateAccessKeyResponse)(nil),                 // 60: gantry.service.v1.UpdateAccessKeyResponse
+	(*RotateAccessKeyRequest)(nil),                  // 61: gantry.service.v1.RotateAccessKeyRequest
+	(*RotateAccessKeyResponse)(nil),                 // 62: gantry.service.v1.RotateAccessKeyResponse
+	(*PutBucketPolicyRequest)(nil),                  // 63: gantry.service.v1.PutBucketPolicyRequest
+	(*PutBucketPolicyResponse)(nil),                 // 64: gantry.service.v1.PutBucketPolicyResponse
+	(*GetBucketPolicyRequest)(nil),                  // 65: gantry.service.v1.GetBucketPolicyRequest
+	(*GetBucketPolicyResponse)(nil),                 // 66: gantry.service.v1.GetBucketPolicyResponse
+	(*DeleteBucketPolicyRequest)(nil),               // 67: gantry.service.v1.DeleteBucketPolicyRequest
+	(*DeleteBucketPolicyResponse)(nil),              // 68: gantry.service.v1.DeleteBucketPolicyResponse
+	(*PutBucketAclRequest)(nil),                     // 69: gantry.service.v1.PutBucketAclRequest
+	(*PutBucketAclResponse)(nil),                    // 70: gantry.service.v1.PutBucketAclResponse
+	(*GetBucketAclRequest)(nil),                     // 71: gantry.service.v1.GetBucketAclRequest
+	(*GetBucketAclResponse)(nil),                    // 72: gantry.service.v1.GetBucketAclResponse
+	(*PutBucketVersioningRequest)(nil),              // 73: gantry.service.v1.PutBucketVersioningRequest
+	(*PutBucketVersioningResponse)(nil),             // 74: gantry.service.v1.PutBucketVersioningResponse
+	(*GetBucketVersioningRequest)(nil),              // 75: gantry.service.v1.GetBucketVersioningRequest
+	(*GetBucketVersioningResponse)(nil),             // 76: gantry.service.v1.GetBucketVersioningResponse
+	(*ListObjectVersionsRequest)(nil),               // 77: gantry.service.v1.ListObjectVersionsRequest
+	(*ListObjectVersionsResponse)(nil),              // 78: gantry.service.v1.ListObjectVersionsResponse
+	(*LifecycleRule)(nil),                           // 79: gantry.service.v1.LifecycleRule
+	(*PutBucketLifecycleConfigurationRequest)(nil),  // 80: gantry.service.v1.PutBucketLifecycleConfigurationRequest
+	(*PutBucketLifecycleConfigurationResponse)(nil), // 81: gantry.service.v1.PutBucketLifecycleConfigurationResponse
+	(*GetBucketLifecycleConfigurationRequest)(nil),  // 82: gantry.service.v1.GetBucketLifecycleConfigurationRequest
+	(*GetBucketLifecycleConfigurationResponse)(nil), // 83: gantry.service.v1.GetBucketLifecycleConfigurationResponse
+	(*DeleteBucketLifecycleRequest)(nil),            // 84: gantry.service.v1.DeleteBucketLifecycleRequest
+	(*DeleteBucketLifecycleResponse)(nil),           // 85: gantry.service.v1.DeleteBucketLifecycleResponse
+	(*CorsRule)(nil),                                // 86: gantry.service.v1.CorsRule
+	(*PutBucketCorsRequest)(nil),                    // 87: gantry.service.v1.PutBucketCorsRequest
+	(*PutBucketCorsResponse)(nil),                   // 88: gantry.service.v1.PutBucketCorsResponse
+	(*GetBucketCorsRequest)(nil),                    // 89: gantry.service.v1.GetBucketCorsRequest
+	(*GetBucketCorsResponse)(nil),                   // 90: gantry.service.v1.GetBucketCorsResponse
+	(*DeleteBucketCorsRequest)(nil),                 // 91: gantry.service.v1.DeleteBucketCorsRequest
+	(*DeleteBucketCorsResponse)(nil),                // 92: gantry.service.v1.DeleteBucketCorsResponse
+	(*LookupBucketCorsRequest)(nil),                 // 93: gantry.service.v1.LookupBucketCorsRequest
+	(*LookupBucketCorsResponse)(nil),                // 94: gantry.service.v1.LookupBucketCorsResponse
+	(*PutObjectTaggingRequest)(nil),                 // 95: gantry.service.v1.PutObjectTaggingRequest
+	(*PutObjectTaggingResponse)(nil),                // 96: gantry.service.v1.PutObjectTaggingResponse
+	(*GetObjectTaggingRequest)(nil),                 // 97: gantry.service.v1.GetObjectTaggingRequest
+	(*GetObjectTaggingResponse)(nil),                // 98: gantry.service.v1.GetObjectTaggingResponse
+	(*DeleteObjectTaggingRequest)(nil),              // 99: gantry.service.v1.DeleteObjectTaggingRequest
+	(*DeleteObjectTaggingResponse)(nil),             // 100: gantry.service.v1.DeleteObjectTaggingResponse
+	(*ObjectLockConfiguration)(nil),                 // 101: gantry.service.v1.ObjectLockConfiguration
+	(*PutObjectLockConfigurationRequest)(nil),       // 102: gantry.service.v1.PutObjectLockConfigurationRequest
+	(*PutObjectLockConfigurationResponse)(nil),      // 103: gantry.service.v1.PutObjectLockConfigurationResponse
+	(*GetObjectLockConfigurationRequest)(nil),       // 104: gantry.service.v1.GetObjectLockConfigurationRequest
+	(*GetObjectLockConfigurationResponse)(nil),      // 105: gantry.service.v1.GetObjectLockConfigurationResponse
+	(*PutObjectRetentionRequest)(nil),               // 106: gantry.service.v1.PutObjectRetentionRequest
+	(*PutObjectRetentionResponse)(nil),              // 107: gantry.service.v1.PutObjectRetentionResponse
+	(*GetObjectRetentionRequest)(nil),               // 108: gantry.service.v1.GetObjectRetentionRequest
+	(*GetObjectRetentionResponse)(nil),              // 109: gantry.service.v1.GetObjectRetentionResponse
+	(*PutObjectLegalHoldRequest)(nil),               // 110: gantry.service.v1.PutObjectLegalHoldRequest
+	(*PutObjectLegalHoldResponse)(nil),              // 111: gantry.service.v1.PutObjectLegalHoldResponse
+	(*GetObjectLegalHoldRequest)(nil),               // 112: gantry.service.v1.GetObjectLegalHoldRequest
+	(*GetObjectLegalHoldResponse)(nil),              // 113: gantry.service.v1.GetObjectLegalHoldResponse
+	nil,                                             // 114: gantry.service.v1.PlanWriteRequest.TagsEntry
+	nil,                                             // 115: gantry.service.v1.LifecycleRule.TagsEntry
+	nil,                                             // 116: gantry.service.v1.PutObjectTaggingRequest.TagsEntry
+	nil,                                             // 117: gantry.service.v1.GetObjectTaggingResponse.TagsEntry
+	(*v1.Bucket)(nil),                               // 118: gantry.bucket.v1.Bucket
+	(*v11.ObjectMetadata)(nil),                      // 119: gantry.object.v1.ObjectMetadata
+	(*v12.WritePlan)(nil),                           // 120: gantry.write_plan.v1.WritePlan
+	(*v11.Object)(nil),                              // 121: gantry.object.v1.Object
 }
 var file_gantry_service_v1_service_proto_depIdxs = []int32{
-	12, // 0: gantry.service.v1.CreateBucketResponse.bucket:type_name -> gantry.bucket.v1.Bucket
-	0,  // 1: gantry.service.v1.BucketOwnershipConflict.reason:type_name -> gantry.service.v1.BucketOwnershipConflict.Reason
-	12, // 2: gantry.service.v1.ListBucketsResponse.buckets:type_name -> gantry.bucket.v1.Bucket
-	13, // 3: gantry.service.v1.PlanWriteResponse.write_plan:type_name -> gantry.write_plan.v1.WritePlan
-	1,  // 4: gantry.service.v1.PlanWriteError.reason:type_name -> gantry.service.v1.PlanWriteError.Reason
-	2,  // 5: gantry.service.v1.GantryService.CreateBucket:input_type -> gantry.service.v1.CreateBucketRequest
-	5,  // 6: gantry.service.v1.GantryService.ListBuckets:input_type -> gantry.service.v1.ListBucketsRequest
-	7,  // 7: gantry.service.v1.GantryService.PlanWrite:input_type -> gantry.service.v1.PlanWriteRequest
-	10, // 8: gantry.service.v1.GantryService.CommitObject:input_type -> gantry.service.v1.CommitObjectRequest
-	3,  // 9: gantry.service.v1.GantryService.CreateBucket:output_type -> gantry.service.v1.CreateBucketResponse
-	6,  // 10: gantry.service.v1.GantryService.ListBuckets:output_type -> gantry.service.v1.ListBucketsResponse
-	8,  // 11: gantry.service.v1.GantryService.PlanWrite:output_type -> gantry.service.v1.PlanWriteResponse
-	11, // 12: gantry.service.v1.GantryService.CommitObject:output_type -> gantry.service.v1.CommitObjectResponse
-	9,  // [9:13] is the sub-list for method output_type
-	5,  // [5:9] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	118, // 0: gantry.service.v1.CreateBucketResponse.bucket:type_name -> gantry.bucket.v1.Bucket
+	1,   // 1: gantry.service.v1.BucketOwnershipConflict.reason:type_name -> gantry.service.v1.BucketOwnershipConflict.Reason
+	118, // 2: gantry.service.v1.ListBucketsResponse.buckets:type_name -> gantry.bucket.v1.Bucket
+	118, // 3: gantry.service.v1.GetBucketResponse.bucket:type_name -> gantry.bucket.v1.Bucket
+	119, // 4: gantry.service.v1.PlanWriteRequest.metadata:type_name -> gantry.object.v1.ObjectMetadata
+	114, // 5: gantry.service.v1.PlanWriteRequest.tags:type_name -> gantry.service.v1.PlanWriteRequest.TagsEntry
+	120, // 6: gantry.service.v1.PlanWriteResponse.write_plan:type_name -> gantry.write_plan.v1.WritePlan
+	2,   // 7: gantry.service.v1.PlanWriteError.reason:type_name -> gantry.service.v1.PlanWriteError.Reason
+	121, // 8: gantry.service.v1.LookupObjectResponse.object:type_name -> gantry.object.v1.Object
+	3,   // 9: gantry.service.v1.ObjectLookupError.reason:type_name -> gantry.service.v1.ObjectLookupError.Reason
+	24,  // 10: gantry.service.v1.DeleteObjectsRequest.objects:type_name -> gantry.service.v1.ObjectIdentifier
+	26,  // 11: gantry.service.v1.DeleteObjectsResponse.deleted:type_name -> gantry.service.v1.DeletedObject
+	27,  // 12: gantry.service.v1.DeleteObjectsResponse.errors:type_name -> gantry.service.v1.DeleteObjectError
+	121, // 13: gantry.service.v1.ListObjectsResponse.objects:type_name -> gantry.object.v1.Object
+	119, // 14: gantry.service.v1.CreateMultipartUploadRequest.metadata:type_name -> gantry.object.v1.ObjectMetadata
+	120, // 15: gantry.service.v1.PlanPartResponse.write_plan:type_name -> gantry.write_plan.v1.WritePlan
+	36,  // 16: gantry.service.v1.CompleteMultipartUploadRequest.parts:type_name -> gantry.service.v1.CompletedPart
+	42,  // 17: gantry.service.v1.ListMultipartUploadsResponse.uploads:type_name -> gantry.service.v1.MultipartUpload
+	45,  // 18: gantry.service.v1.ListPartsResponse.parts:type_name -> gantry.service.v1.UploadedPart
+	0,   // 19: gantry.service.v1.AccessKey.status:type_name -> gantry.service.v1.AccessKeyStatus
+	49,  // 20: gantry.service.v1.CreateUserResponse.user:type_name -> gantry.service.v1.User
+	49,  // 21: gantry.service.v1.ListUsersResponse.users:type_name -> gantry.service.v1.User
+	50,  // 22: gantry.service.v1.CreateAccessKeyResponse.access_key:type_name -> gantry.service.v1.AccessKey
+	50,  // 23: gantry.service.v1.ListAccessKeysResponse.access_keys:type_name -> gantry.service.v1.AccessKey
+	0,   // 24: gantry.service.v1.UpdateAccessKeyRequest.status:type_name -> gantry.service.v1.AccessKeyStatus
+	50,  // 25: gantry.service.v1.UpdateAccessKeyResponse.access_key:type_name -> gantry.service.v1.AccessKey
+	50,  // 26: gantry.service.v1.RotateAccessKeyResponse.access_key:type_name -> gantry.service.v1.AccessKey
+	121, // 27: gantry.service.v1.ListObjectVersionsResponse.versions:type_name -> gantry.object.v1.Object
+	115, // 28: gantry.service.v1.LifecycleRule.tags:type_name -> gantry.service.v1.LifecycleRule.TagsEntry
+	79,  // 29: gantry.service.v1.PutBucketLifecycleConfigurationRequest.rules:type_name -> gantry.service.v1.LifecycleRule
+	79,  // 30: gantry.service.v1.GetBucketLifecycleConfigurationResponse.rules:type_name -> gantry.service.v1.LifecycleRule
+	86,  // 31: gantry.service.v1.PutBucketCorsRequest.rules:type_name -> gantry.service.v1.CorsRule
+	86,  // 32: gantry.service.v1.GetBucketCorsResponse.rules:type_name -> gantry.service.v1.CorsRule
+	86,  // 33: gantry.service.v1.LookupBucketCorsResponse.rules:type_name -> gantry.service.v1.CorsRule
+	116, // 34: gantry.service.v1.PutObjectTaggingRequest.tags:type_name -> gantry.service.v1.PutObjectTaggingRequest.TagsEntry
+	117, // 35: gantry.service.v1.GetObjectTaggingResponse.tags:type_name -> gantry.service.v1.GetObjectTaggingResponse.TagsEntry
+	101, // 36: gantry.service.v1.PutObjectLockConfigurationRequest.configuration:type_name -> gantry.service.v1.ObjectLockConfiguration
+	101, // 37: gantry.service.v1.GetObjectLockConfigurationResponse.configuration:type_name -> gantry.service.v1.ObjectLockConfiguration
+	4,   // 38: gantry.service.v1.GantryService.CreateBucket:input_type -> gantry.service.v1.CreateBucketRequest
+	7,   // 39: gantry.service.v1.GantryService.ListBuckets:input_type -> gantry.service.v1.ListBucketsRequest
+	9,   // 40: gantry.service.v1.GantryService.GetBucket:input_type -> gantry.service.v1.GetBucketRequest
+	11,  // 41: gantry.service.v1.GantryService.DeleteBucket:input_type -> gantry.service.v1.DeleteBucketRequest
+	13,  // 42: gantry.service.v1.GantryService.PlanWrite:input_type -> gantry.service.v1.PlanWriteRequest
+	16,  // 43: gantry.service.v1.GantryService.CommitObject:input_type -> gantry.service.v1.CommitObjectRequest
+	18,  // 44: gantry.service.v1.GantryService.LookupObject:input_type -> gantry.service.v1.LookupObjectRequest
+	21,  // 45: gantry.service.v1.GantryService.DeleteObject:input_type -> gantry.service.v1.DeleteObjectRequest
+	28,  // 46: gantry.service.v1.GantryService.ListObjects:input_type -> gantry.service.v1.ListObjectsRequest
+	30,  // 47: gantry.service.v1.GantryService.CreateMultipartUpload:input_type -> gantry.service.v1.CreateMultipartUploadRequest
+	32,  // 48: gantry.service.v1.GantryService.PlanPart:input_type -> gantry.service.v1.PlanPartRequest
+	34,  // 49: gantry.service.v1.GantryService.CommitPart:input_type -> gantry.service.v1.CommitPartRequest
+	37,  // 50: gantry.service.v1.GantryService.CompleteMultipartUpload:input_type -> gantry.service.v1.CompleteMultipartUploadRequest
+	39,  // 51: gantry.service.v1.GantryService.AbortMultipartUpload:input_type -> gantry.service.v1.AbortMultipartUploadRequest
+	41,  // 52: gantry.service.v1.GantryService.ListMultipartUploads:input_type -> gantry.service.v1.ListMultipartUploadsRequest
+	44,  // 53: gantry.service.v1.GantryService.ListParts:input_type -> gantry.service.v1.ListPartsRequest
+	47,  // 54: gantry.service.v1.GantryService.GetAccessKey:input_type -> gantry.service.v1.GetAccessKeyRequest
+	51,  // 55: gantry.service.v1.GantryService.CreateUser:input_type -> gantry.service.v1.CreateUserRequest
+	53,  // 56: gantry.service.v1.GantryService.ListUsers:input_type -> gantry.service.v1.ListUsersRequest
+	55,  // 57: gantry.service.v1.GantryService.CreateAccessKey:input_type -> gantry.service.v1.CreateAccessKeyRequest
+	57,  // 58: gantry.service.v1.GantryService.ListAccessKeys:input_type -> gantry.service.v1.ListAccessKeysRequest
+	59,  // 59: gantry.service.v1.GantryService.UpdateAccessKey:input_type -> gantry.service.v1.UpdateAccessKeyRequest
+	61,  // 60: gantry.service.v1.GantryService.RotateAccessKey:input_type -> gantry.service.v1.RotateAccessKeyRequest
+	63,  // 61: gantry.service.v1.GantryService.PutBucketPolicy:input_type -> gantry.service.v1.PutBucketPolicyRequest
+	65,  // 62: gantry.service.v1.GantryService.GetBucketPolicy:input_type -> gantry.service.v1.GetBucketPolicyRequest
+	67,  // 63: gantry.service.v1.GantryService.DeleteBucketPolicy:input_type -> gantry.service.v1.DeleteBucketPolicyRequest
+	69,  // 64: gantry.service.v1.GantryService.PutBucketAcl:input_type -> gantry.service.v1.PutBucketAclRequest
+	71,  // 65: gantry.service.v1.GantryService.GetBucketAcl:input_type -> gantry.service.v1.GetBucketAclRequest
+	73,  // 66: gantry.service.v1.GantryService.PutBucketVersioning:input_type -> gantry.service.v1.PutBucketVersioningRequest
+	75,  // 67: gantry.service.v1.GantryService.GetBucketVersioning:input_type -> gantry.service.v1.GetBucketVersioningRequest
+	77,  // 68: gantry.service.v1.GantryService.ListObjectVersions:input_type -> gantry.service.v1.ListObjectVersionsRequest
+	80,  // 69: gantry.service.v1.GantryService.PutBucketLifecycleConfiguration:input_type -> gantry.service.v1.PutBucketLifecycleConfigurationRequest
+	82,  // 70: gantry.service.v1.GantryService.GetBucketLifecycleConfiguration:input_type -> gantry.service.v1.GetBucketLifecycleConfigurationRequest
+	84,  // 71: gantry.service.v1.GantryService.DeleteBucketLifecycle:input_type -> gantry.service.v1.DeleteBucketLifecycleRequest
+	87,  // 72: gantry.service.v1.GantryService.PutBucketCors:input_type -> gantry.service.v1.PutBucketCorsRequest
+	89,  // 73: gantry.service.v1.GantryService.GetBucketCors:input_type -> gantry.service.v1.GetBucketCorsRequest
+	91,  // 74: gantry.service.v1.GantryService.DeleteBucketCors:input_type -> gantry.service.v1.DeleteBucketCorsRequest
+	93,  // 75: gantry.service.v1.GantryService.LookupBucketCors:input_type -> gantry.service.v1.LookupBucketCorsRequest
+	95,  // 76: gantry.service.v1.GantryService.PutObjectTagging:input_type -> gantry.service.v1.PutObjectTaggingRequest
+	97,  // 77: gantry.service.v1.GantryService.GetObjectTagging:input_type -> gantry.service.v1.GetObjectTaggingRequest
+	99,  // 78: gantry.service.v1.GantryService.DeleteObjectTagging:input_type -> gantry.service.v1.DeleteObjectTaggingRequest
+	23,  // 79: gantry.service.v1.GantryService.DeleteObjects:input_type -> gantry.service.v1.DeleteObjectsRequest
+	102, // 80: gantry.service.v1.GantryService.PutObjectLockConfiguration:input_type -> gantry.service.v1.PutObjectLockConfigurationRequest
+	104, // 81: gantry.service.v1.GantryService.GetObjectLockConfiguration:input_type -> gantry.service.v1.GetObjectLockConfigurationRequest
+	106, // 82: gantry.service.v1.GantryService.PutObjectRetention:input_type -> gantry.service.v1.PutObjectRetentionRequest
+	108, // 83: gantry.service.v1.GantryService.GetObjectRetention:input_type -> gantry.service.v1.GetObjectRetentionRequest
+	110, // 84: gantry.service.v1.GantryService.PutObjectLegalHold:input_type -> gantry.service.v1.PutObjectLegalHoldRequest
+	112, // 85: gantry.service.v1.GantryService.GetObjectLegalHold:input_type -> gantry.service.v1.GetObjectLegalHoldRequest
+	5,   // 86: gantry.service.v1.GantryService.CreateBucket:output_type -> gantry.service.v1.CreateBucketResponse
+	8,   // 87: gantry.service.v1.GantryService.ListBuckets:output_type -> gantry.service.v1.ListBucketsResponse
+	10,  // 88: gantry.service.v1.GantryService.GetBucket:output_type -> gantry.service.v1.GetBucketResponse
+	12,  // 89: gantry.service.v1.GantryService.DeleteBucket:output_type -> gantry.service.v1.DeleteBucketResponse
+	14,  // 90: gantry.service.v1.GantryService.PlanWrite:output_type -> gantry.service.v1.PlanWriteResponse
+	17,  // 91: gantry.service.v1.GantryService.CommitObject:output_type -> gantry.service.v1.CommitObjectResponse
+	19,  // 92: gantry.service.v1.GantryService.LookupObject:output_type -> gantry.service.v1.LookupObjectResponse
+	22,  // 93: gantry.service.v1.GantryService.DeleteObject:output_type -> gantry.service.v1.DeleteObjectResponse
+	29,  // 94: gantry.service.v1.GantryService.ListObjects:output_type -> gantry.service.v1.ListObjectsResponse
+	31,  // 95: gantry.service.v1.GantryService.CreateMultipartUpload:output_type -> gantry.service.v1.CreateMultipartUploadResponse
+	33,  // 96: gantry.service.v1.GantryService.PlanPart:output_type -> gantry.service.v1.PlanPartResponse
+	35,  // 97: gantry.service.v1.GantryService.CommitPart:output_type -> gantry.service.v1.CommitPartResponse
+	38,  // 98: gantry.service.v1.GantryService.CompleteMultipartUpload:output_type -> gantry.service.v1.CompleteMultipartUploadResponse
+	40,  // 99: gantry.service.v1.GantryService.AbortMultipartUpload:output_type -> gantry.service.v1.AbortMultipartUploadResponse
+	43,  // 100: gantry.service.v1.GantryService.ListMultipartUploads:output_type -> gantry.service.v1.ListMultipartUploadsResponse
+	46,  // 101: gantry.service.v1.GantryService.ListParts:output_type -> gantry.service.v1.ListPartsResponse
+	48,  // 102: gantry.service.v1.GantryService.GetAccessKey:output_type -> gantry.service.v1.GetAccessKeyResponse
+	52,  // 103: gantry.service.v1.GantryService.CreateUser:output_type -> gantry.service.v1.CreateUserResponse
+	54,  // 104: gantry.service.v1.GantryService.ListUsers:output_type -> gantry.service.v1.ListUsersResponse
+	56,  // 105: gantry.service.v1.GantryService.CreateAccessKey:output_type -> gantry.service.v1.CreateAccessKeyResponse
+	58,  // 106: gantry.service.v1.GantryService.ListAccessKeys:output_type -> gantry.service.v1.ListAccessKeysResponse
+	60,  // 107: gantry.service.v1.GantryService.UpdateAccessKey:output_type -> gantry.service.v1.UpdateAccessKeyResponse
+	62,  // 108: gantry.service.v1.GantryService.RotateAccessKey:output_type -> gantry.service.v1.RotateAccessKeyResponse
+	64,  // 109: gantry.service.v1.GantryService.PutBucketPolicy:output_type -> gantry.service.v1.PutBucketPolicyResponse
+	66,  // 110: gantry.service.v1.GantryService.GetBucketPolicy:output_type -> gantry.service.v1.GetBucketPolicyResponse
+	68,  // 111: gantry.service.v1.GantryService.DeleteBucketPolicy:output_type -> gantry.service.v1.DeleteBucketPolicyResponse
+	70,  // 112: gantry.service.v1.GantryService.PutBucketAcl:output_type -> gantry.service.v1.PutBucketAclResponse
+	72,  // 113: gantry.service.v1.GantryService.GetBucketAcl:output_type -> gantry.service.v1.GetBucketAclResponse
+	74,  // 114: gantry.service.v1.GantryService.PutBucketVersioning:output_type -> gantry.service.v1.PutBucketVersioningResponse
+	76,  // 115: gantry.service.v1.GantryService.GetBucketVersioning:output_type -> gantry.service.v1.GetBucketVersioningResponse
+	78,  // 116: gantry.service.v1.GantryService.ListObjectVersions:output_type -> gantry.service.v1.ListObjectVersionsResponse
+	81,  // 117: gantry.service.v1.GantryService.PutBucketLifecycleConfiguration:output_type -> gantry.service.v1.PutBucketLifecycleConfigurationResponse
+	83,  // 118: gantry.service.v1.GantryService.GetBucketLifecycleConfiguration:output_type -> gantry.service.v1.GetBucketLifecycleConfigurationResponse
+	85,  // 119: gantry.service.v1.GantryService.DeleteBucketLifecycle:output_type -> gantry.service.v1.DeleteBucketLifecycleResponse
+	88,  // 120: gantry.service.v1.GantryService.PutBucketCors:output_type -> gantry.service.v1.PutBucketCorsResponse
+	90,  // 121: gantry.service.v1.GantryService.GetBucketCors:output_type -> gantry.service.v1.GetBucketCorsResponse
+	92,  // 122: gantry.service.v1.GantryService.DeleteBucketCors:output_type -> gantry.service.v1.DeleteBucketCorsResponse
+	94,  // 123: gantry.service.v1.GantryService.LookupBucketCors:output_type -> gantry.service.v1.LookupBucketCorsResponse
+	96,  // 124: gantry.service.v1.GantryService.PutObjectTagging:output_type -> gantry.service.v1.PutObjectTaggingResponse
+	98,  // 125: gantry.service.v1.GantryService.GetObjectTagging:output_type -> gantry.service.v1.GetObjectTaggingResponse
+	100, // 126: gantry.service.v1.GantryService.DeleteObjectTagging:output_type -> gantry.service.v1.DeleteObjectTaggingResponse
+	25,  // 127: gantry.service.v1.GantryService.DeleteObjects:output_type -> gantry.service.v1.DeleteObjectsResponse
+	103, // 128: gantry.service.v1.GantryService.PutObjectLockConfiguration:output_type -> gantry.service.v1.PutObjectLockConfigurationResponse
+	105, // 129: gantry.service.v1.GantryService.GetObjectLockConfiguration:output_type -> gantry.service.v1.GetObjectLockConfigurationResponse
+	107, // 130: gantry.service.v1.GantryService.PutObjectRetention:output_type -> gantry.service.v1.PutObjectRetentionResponse
+	109, // 131: gantry.service.v1.GantryService.GetObjectRetention:output_type -> gantry.service.v1.GetObjectRetentionResponse
+	111, // 132: gantry.service.v1.GantryService.PutObjectLegalHold:output_type -> gantry.service.v1.PutObjectLegalHoldResponse
+	113, // 133: gantry.service.v1.GantryService.GetObjectLegalHold:output_type -> gantry.service.v1.GetObjectLegalHoldResponse
+	86,  // [86:134] is the sub-list for method output_type
+	38,  // [38:86] is the sub-list for method input_type
+	38,  // [38:38] is the sub-list for extension type_name
+	38,  // [38:38] is the sub-list for extension extendee
+	0,   // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_gantry_service_v1_service_proto_init() }
@@ -705,13 +7219,17 @@ func file_gantry_service_v1_service_proto_init() {
 	if File_gantry_service_v1_service_proto != nil {
 		return
 	}
+	file_gantry_service_v1_service_proto_msgTypes[24].OneofWrappers = []any{}
+	file_gantry_service_v1_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_gantry_service_v1_service_proto_msgTypes[40].OneofWrappers = []any{}
+	file_gantry_service_v1_service_proto_msgTypes[73].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gantry_service_v1_service_proto_rawDesc), len(file_gantry_service_v1_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   10,
+			NumEnums:      4,
+			NumMessages:   114,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,54 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GantryService_CreateBucket_FullMethodName = "/gantry.service.v1.GantryService/CreateBucket"
-	GantryService_ListBuckets_FullMethodName  = "/gantry.service.v1.GantryService/ListBuckets"
-	GantryService_PlanWrite_FullMethodName    = "/gantry.service.v1.GantryService/PlanWrite"
-	GantryService_CommitObject_FullMethodName = "/gantry.service.v1.GantryService/CommitObject"
+	GantryService_CreateBucket_FullMethodName                    = "/gantry.service.v1.GantryService/CreateBucket"
+	GantryService_ListBuckets_FullMethodName                     = "/gantry.service.v1.GantryService/ListBuckets"
+	GantryService_GetBucket_FullMethodName                       = "/gantry.service.v1.GantryService/GetBucket"
+	GantryService_DeleteBucket_FullMethodName                    = "/gantry.service.v1.GantryService/DeleteBucket"
+	GantryService_PlanWrite_FullMethodName                       = "/gantry.service.v1.GantryService/PlanWrite"
+	GantryService_CommitObject_FullMethodName                    = "/gantry.service.v1.GantryService/CommitObject"
+	GantryService_LookupObject_FullMethodName                    = "/gantry.service.v1.GantryService/LookupObject"
+	GantryService_DeleteObject_FullMethodName                    = "/gantry.service.v1.GantryService/DeleteObject"
+	GantryService_ListObjects_FullMethodName                     = "/gantry.service.v1.GantryService/ListObjects"
+	GantryService_CreateMultipartUpload_FullMethodName           = "/gantry.service.v1.GantryService/CreateMultipartUpload"
+	GantryService_PlanPart_FullMethodName                        = "/gantry.service.v1.GantryService/PlanPart"
+	GantryService_CommitPart_FullMethodName                      = "/gantry.service.v1.GantryService/CommitPart"
+	GantryService_CompleteMultipartUpload_FullMethodName         = "/gantry.service.v1.GantryService/CompleteMultipartUpload"
+	GantryService_AbortMultipartUpload_FullMethodName            = "/gantry.service.v1.GantryService/AbortMultipartUpload"
+	GantryService_ListMultipartUploads_FullMethodName            = "/gantry.service.v1.GantryService/ListMultipartUploads"
+	GantryService_ListParts_FullMethodName                       = "/gantry.service.v1.GantryService/ListParts"
+	GantryService_GetAccessKey_FullMethodName                    = "/gantry.service.v1.GantryService/GetAccessKey"
+	GantryService_CreateUser_FullMethodName                      = "/gantry.service.v1.GantryService/CreateUser"
+	GantryService_ListUsers_FullMethodName                       = "/gantry.service.v1.GantryService/ListUsers"
+	GantryService_CreateAccessKey_FullMethodName                 = "/gantry.service.v1.GantryService/CreateAccessKey"
+	GantryService_ListAccessKeys_FullMethodName                  = "/gantry.service.v1.GantryService/ListAccessKeys"
+	GantryService_UpdateAccessKey_FullMethodName                 = "/gantry.service.v1.GantryService/UpdateAccessKey"
+	GantryService_RotateAccessKey_FullMethodName                 = "/gantry.service.v1.GantryService/RotateAccessKey"
+	GantryService_PutBucketPolicy_FullMethodName                 = "/gantry.service.v1.GantryService/PutBucketPolicy"
+	GantryService_GetBucketPolicy_FullMethodName                 = "/gantry.service.v1.GantryService/GetBucketPolicy"
+	GantryService_DeleteBucketPolicy_FullMethodName              = "/gantry.service.v1.GantryService/DeleteBucketPolicy"
+	GantryService_PutBucketAcl_FullMethodName                    = "/gantry.service.v1.GantryService/PutBucketAcl"
+	GantryService_GetBucketAcl_FullMethodName                    = "/gantry.service.v1.GantryService/GetBucketAcl"
+	GantryService_PutBucketVersioning_FullMethodName             = "/gantry.service.v1.GantryService/PutBucketVersioning"
+	GantryService_GetBucketVersioning_FullMethodName             = "/gantry.service.v1.GantryService/GetBucketVersioning"
+	GantryService_ListObjectVersions_FullMethodName              = "/gantry.service.v1.GantryService/ListObjectVersions"
+	GantryService_PutBucketLifecycleConfiguration_FullMethodName = "/gantry.service.v1.GantryService/PutBucketLifecycleConfiguration"
+	GantryService_GetBucketLifecycleConfiguration_FullMethodName = "/gantry.service.v1.GantryService/GetBucketLifecycleConfiguration"
+	GantryService_DeleteBucketLifecycle_FullMethodName           = "/gantry.service.v1.GantryService/DeleteBucketLifecycle"
+	GantryService_PutBucketCors_FullMethodName                   = "/gantry.service.v1.GantryService/PutBucketCors"
+	GantryService_GetBucketCors_FullMethodName                   = "/gantry.service.v1.GantryService/GetBucketCors"
+	GantryService_DeleteBucketCors_FullMethodName                = "/gantry.service.v1.GantryService/DeleteBucketCors"
+	GantryService_LookupBucketCors_FullMethodName                = "/gantry.service.v1.GantryService/LookupBucketCors"
+	GantryService_PutObjectTagging_FullMethodName                = "/gantry.service.v1.GantryService/PutObjectTagging"
+	GantryService_GetObjectTagging_FullMethodName                = "/gantry.service.v1.GantryService/GetObjectTagging"
+	GantryService_DeleteObjectTagging_FullMethodName             = "/gantry.service.v1.GantryService/DeleteObjectTagging"
+	GantryService_DeleteObjects_FullMethodName                   = "/gantry.service.v1.GantryService/DeleteObjects"
+	GantryService_PutObjectLockConfiguration_FullMethodName      = "/gantry.service.v1.GantryService/PutObjectLockConfiguration"
+	GantryService_GetObjectLockConfiguration_FullMethodName      = "/gantry.service.v1.GantryService/GetObjectLockConfiguration"
+	GantryService_PutObjectRetention_FullMethodName              = "/gantry.service.v1.GantryService/PutObjectRetention"
+	GantryService_GetObjectRetention_FullMethodName              = "/gantry.service.v1.GantryService/GetObjectRetention"
+	GantryService_PutObjectLegalHold_FullMethodName              = "/gantry.service.v1.GantryService/PutObjectLegalHold"
+	GantryService_GetObjectLegalHold_FullMethodName              = "/gantry.service.v1.GantryService/GetObjectLegalHold"
 )
 
 // GantryServiceClient is the client API for GantryService service.
@@ -31,8 +75,52 @@ const (
 type GantryServiceClient interface {
 	CreateBucket(ctx context.Context, in *CreateBucketRequest, opts ...grpc.CallOption) (*CreateBucketResponse, error)
 	ListBuckets(ctx context.Context, in *ListBucketsRequest, opts ...grpc.CallOption) (*ListBucketsResponse, error)
+	GetBucket(ctx context.Context, in *GetBucketRequest, opts ...grpc.CallOption) (*GetBucketResponse, error)
+	DeleteBucket(ctx context.Context, in *DeleteBucketRequest, opts ...grpc.CallOption) (*DeleteBucketResponse, error)
 	PlanWrite(ctx context.Context, in *PlanWriteRequest, opts ...grpc.CallOption) (*PlanWriteResponse, error)
 	CommitObject(ctx context.Context, in *CommitObjectRequest, opts ...grpc.CallOption) (*CommitObjectResponse, error)
+	LookupObject(ctx context.Context, in *LookupObjectRequest, opts ...grpc.CallOption) (*LookupObjectResponse, error)
+	DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*DeleteObjectResponse, error)
+	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
+	CreateMultipartUpload(ctx context.Context, in *CreateMultipartUploadRequest, opts ...grpc.CallOption) (*CreateMultipartUploadResponse, error)
+	PlanPart(ctx context.Context, in *PlanPartRequest, opts ...grpc.CallOption) (*PlanPartResponse, error)
+	CommitPart(ctx context.Context, in *CommitPartRequest, opts ...grpc.CallOption) (*CommitPartResponse, error)
+	CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*CompleteMultipartUploadResponse, error)
+	AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*AbortMultipartUploadResponse, error)
+	ListMultipartUploads(ctx context.Context, in *ListMultipartUploadsRequest, opts ...grpc.CallOption) (*ListMultipartUploadsResponse, error)
+	ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error)
+	GetAccessKey(ctx context.Context, in *GetAccessKeyRequest, opts ...grpc.CallOption) (*GetAccessKeyResponse, error)
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	CreateAccessKey(ctx context.Context, in *CreateAccessKeyRequest, opts ...grpc.CallOption) (*CreateAccessKeyResponse, error)
+	ListAccessKeys(ctx context.Context, in *ListAccessKeysRequest, opts ...grpc.CallOption) (*ListAccessKeysResponse, error)
+	UpdateAccessKey(ctx context.Context, in *UpdateAccessKeyRequest, opts ...grpc.CallOption) (*UpdateAccessKeyResponse, error)
+	RotateAccessKey(ctx context.Context, in *RotateAccessKeyRequest, opts ...grpc.CallOption) (*RotateAccessKeyResponse, error)
+	PutBucketPolicy(ctx context.Context, in *PutBucketPolicyRequest, opts ...grpc.CallOption) (*PutBucketPolicyResponse, error)
+	GetBucketPolicy(ctx context.Context, in *GetBucketPolicyRequest, opts ...grpc.CallOption) (*GetBucketPolicyResponse, error)
+	DeleteBucketPolicy(ctx context.Context, in *DeleteBucketPolicyRequest, opts ...grpc.CallOption) (*DeleteBucketPolicyResponse, error)
+	PutBucketAcl(ctx context.Context, in *PutBucketAclRequest, opts ...grpc.CallOption) (*PutBucketAclResponse, error)
+	GetBucketAcl(ctx context.Context, in *GetBucketAclRequest, opts ...grpc.CallOption) (*GetBucketAclResponse, error)
+	PutBucketVersioning(ctx context.Context, in *PutBucketVersioningRequest, opts ...grpc.CallOption) (*PutBucketVersioningResponse, error)
+	GetBucketVersioning(ctx context.Context, in *GetBucketVersioningRequest, opts ...grpc.CallOption) (*GetBucketVersioningResponse, error)
+	ListObjectVersions(ctx context.Context, in *ListObjectVersionsRequest, opts ...grpc.CallOption) (*ListObjectVersionsResponse, error)
+	PutBucketLifecycleConfiguration(ctx context.Context, in *PutBucketLifecycleConfigurationRequest, opts ...grpc.CallOption) (*PutBucketLifecycleConfigurationResponse, error)
+	GetBucketLifecycleConfiguration(ctx context.Context, in *GetBucketLifecycleConfigurationRequest, opts ...grpc.CallOption) (*GetBucketLifecycleConfigurationResponse, error)
+	DeleteBucketLifecycle(ctx context.Context, in *DeleteBucketLifecycleRequest, opts ...grpc.CallOption) (*DeleteBucketLifecycleResponse, error)
+	PutBucketCors(ctx context.Context, in *PutBucketCorsRequest, opts ...grpc.CallOption) (*PutBucketCorsResponse, error)
+	GetBucketCors(ctx context.Context, in *GetBucketCorsRequest, opts ...grpc.CallOption) (*GetBucketCorsResponse, error)
+	DeleteBucketCors(ctx context.Context, in *DeleteBucketCorsRequest, opts ...grpc.CallOption) (*DeleteBucketCorsResponse, error)
+	LookupBucketCors(ctx context.Context, in *LookupBucketCorsRequest, opts ...grpc.CallOption) (*LookupBucketCorsResponse, error)
+	PutObjectTagging(ctx context.Context, in *PutObjectTaggingRequest, opts ...grpc.CallOption) (*PutObjectTaggingResponse, error)
+	GetObjectTagging(ctx context.Context, in *GetObjectTaggingRequest, opts ...grpc.CallOption) (*GetObjectTaggingResponse, error)
+	DeleteObjectTagging(ctx context.Context, in *DeleteObjectTaggingRequest, opts ...grpc.CallOption) (*DeleteObjectTaggingResponse, error)
+	DeleteObjects(ctx context.Context, in *DeleteObjectsRequest, opts ...grpc.CallOption) (*DeleteObjectsResponse, error)
+	PutObjectLockConfiguration(ctx context.Context, in *PutObjectLockConfigurationRequest, opts ...grpc.CallOption) (*PutObjectLockConfigurationResponse, error)
+	GetObjectLockConfiguration(ctx context.Context, in *GetObjectLockConfigurationRequest, opts ...grpc.CallOption) (*GetObjectLockConfigurationResponse, error)
+	PutObjectRetention(ctx context.Context, in *PutObjectRetentionRequest, opts ...grpc.CallOption) (*PutObjectRetentionResponse, error)
+	GetObjectRetention(ctx context.Context, in *GetObjectRetentionRequest, opts ...grpc.CallOption) (*GetObjectRetentionResponse, error)
+	PutObjectLegalHold(ctx context.Context, in *PutObjectLegalHoldRequest, opts ...grpc.CallOption) (*PutObjectLegalHoldResponse, error)
+	GetObjectLegalHold(ctx context.Context, in *GetObjectLegalHoldRequest, opts ...grpc.CallOption) (*GetObjectLegalHoldResponse, error)
 }
 
 type gantryServiceClient struct {
@@ -63,6 +151,26 @@ func (c *gantryServiceClient) ListBuckets(ctx context.Context, in *ListBucketsRe
 	return out, nil
 }
 
+func (c *gantryServiceClient) GetBucket(ctx context.Context, in *GetBucketRequest, opts ...grpc.CallOption) (*GetBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBucketResponse)
+	err := c.cc.Invoke(ctx, GantryService_GetBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) DeleteBucket(ctx context.Context, in *DeleteBucketRequest, opts ...grpc.CallOption) (*DeleteBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBucketResponse)
+	err := c.cc.Invoke(ctx, GantryService_DeleteBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gantryServiceClient) PlanWrite(ctx context.Context, in *PlanWriteRequest, opts ...grpc.CallOption) (*PlanWriteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PlanWriteResponse)
@@ -70,138 +178,1526 @@ func (c *gantryServiceClient) PlanWrite(ctx context.Context, in *PlanWriteReques
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *gantryServiceClient) CommitObject(ctx context.Context, in *CommitObjectRequest, opts ...grpc.CallOption) (*CommitObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitObjectResponse)
+	err := c.cc.Invoke(ctx, GantryService_CommitObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) LookupObject(ctx context.Context, in *LookupObjectRequest, opts ...grpc.CallOption) (*LookupObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupObjectResponse)
+	err := c.cc.Invoke(ctx, GantryService_LookupObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*DeleteObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteObjectResponse)
+	err := c.cc.Invoke(ctx, GantryService_DeleteObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListObjectsResponse)
+	err := c.cc.Invoke(ctx, GantryService_ListObjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) CreateMultipartUpload(ctx context.Context, in *CreateMultipartUploadRequest, opts ...grpc.CallOption) (*CreateMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, GantryService_CreateMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) PlanPart(ctx context.Context, in *PlanPartRequest, opts ...grpc.CallOption) (*PlanPartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanPartResponse)
+	err := c.cc.Invoke(ctx, GantryService_PlanPart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) CommitPart(ctx context.Context, in *CommitPartRequest, opts ...grpc.CallOption) (*CommitPartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitPartResponse)
+	err := c.cc.Invoke(ctx, GantryService_CommitPart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*CompleteMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, GantryService_CompleteMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*AbortMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbortMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, GantryService_AbortMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) ListMultipartUploads(ctx context.Context, in *ListMultipartUploadsRequest, opts ...grpc.CallOption) (*ListMultipartUploadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMultipartUploadsResponse)
+	err := c.cc.Invoke(ctx, GantryService_ListMultipartUploads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPartsResponse)
+	err := c.cc.Invoke(ctx, GantryService_ListParts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) GetAccessKey(ctx context.Context, in *GetAccessKeyRequest, opts ...grpc.CallOption) (*GetAccessKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccessKeyResponse)
+	err := c.cc.Invoke(ctx, GantryService_GetAccessKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserResponse)
+	err := c.cc.Invoke(ctx, GantryService_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, GantryService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) CreateAccessKey(ctx context.Context, in *CreateAccessKeyRequest, opts ...grpc.CallOption) (*CreateAccessKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccessKeyResponse)
+	err := c.cc.Invoke(ctx, GantryService_CreateAccessKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) ListAccessKeys(ctx context.Context, in *ListAccessKeysRequest, opts ...grpc.CallOption) (*ListAccessKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessKeysResponse)
+	err := c.cc.Invoke(ctx, GantryService_ListAccessKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) UpdateAccessKey(ctx context.Context, in *UpdateAccessKeyRequest, opts ...grpc.CallOption) (*UpdateAccessKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAccessKeyResponse)
+	err := c.cc.Invoke(ctx, GantryService_UpdateAccessKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) RotateAccessKey(ctx context.Context, in *RotateAccessKeyRequest, opts ...grpc.CallOption) (*RotateAccessKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateAccessKeyResponse)
+	err := c.cc.Invoke(ctx, GantryService_RotateAccessKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) PutBucketPolicy(ctx context.Context, in *PutBucketPolicyRequest, opts ...grpc.CallOption) (*PutBucketPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutBucketPolicyResponse)
+	err := c.cc.Invoke(ctx, GantryService_PutBucketPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) GetBucketPolicy(ctx context.Context, in *GetBucketPolicyRequest, opts ...grpc.CallOption) (*GetBucketPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBucketPolicyResponse)
+	err := c.cc.Invoke(ctx, GantryService_GetBucketPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) DeleteBucketPolicy(ctx context.Context, in *DeleteBucketPolicyRequest, opts ...grpc.CallOption) (*DeleteBucketPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBucketPolicyResponse)
+	err := c.cc.Invoke(ctx, GantryService_DeleteBucketPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) PutBucketAcl(ctx context.Context, in *PutBucketAclRequest, opts ...grpc.CallOption) (*PutBucketAclResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutBucketAclResponse)
+	err := c.cc.Invoke(ctx, GantryService_PutBucketAcl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) GetBucketAcl(ctx context.Context, in *GetBucketAclRequest, opts ...grpc.CallOption) (*GetBucketAclResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBucketAclResponse)
+	err := c.cc.Invoke(ctx, GantryService_GetBucketAcl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) PutBucketVersioning(ctx context.Context, in *PutBucketVersioningRequest, opts ...grpc.CallOption) (*PutBucketVersioningResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutBucketVersioningResponse)
+	err := c.cc.Invoke(ctx, GantryService_PutBucketVersioning_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) GetBucketVersioning(ctx context.Context, in *GetBucketVersioningRequest, opts ...grpc.CallOption) (*GetBucketVersioningResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBucketVersioningResponse)
+	err := c.cc.Invoke(ctx, GantryService_GetBucketVersioning_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) ListObjectVersions(ctx context.Context, in *ListObjectVersionsRequest, opts ...grpc.CallOption) (*ListObjectVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListObjectVersionsResponse)
+	err := c.cc.Invoke(ctx, GantryService_ListObjectVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) PutBucketLifecycleConfiguration(ctx context.Context, in *PutBucketLifecycleConfigurationRequest, opts ...grpc.CallOption) (*PutBucketLifecycleConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutBucketLifecycleConfigurationResponse)
+	err := c.cc.Invoke(ctx, GantryService_PutBucketLifecycleConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) GetBucketLifecycleConfiguration(ctx context.Context, in *GetBucketLifecycleConfigurationRequest, opts ...grpc.CallOption) (*GetBucketLifecycleConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBucketLifecycleConfigurationResponse)
+	err := c.cc.Invoke(ctx, GantryService_GetBucketLifecycleConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) DeleteBucketLifecycle(ctx context.Context, in *DeleteBucketLifecycleRequest, opts ...grpc.CallOption) (*DeleteBucketLifecycleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, GantryService_DeleteBucketLifecycle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) PutBucketCors(ctx context.Context, in *PutBucketCorsRequest, opts ...grpc.CallOption) (*PutBucketCorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutBucketCorsResponse)
+	err := c.cc.Invoke(ctx, GantryService_PutBucketCors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) GetBucketCors(ctx context.Context, in *GetBucketCorsRequest, opts ...grpc.CallOption) (*GetBucketCorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBucketCorsResponse)
+	err := c.cc.Invoke(ctx, GantryService_GetBucketCors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) DeleteBucketCors(ctx context.Context, in *DeleteBucketCorsRequest, opts ...grpc.CallOption) (*DeleteBucketCorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBucketCorsResponse)
+	err := c.cc.Invoke(ctx, GantryService_DeleteBucketCors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) LookupBucketCors(ctx context.Context, in *LookupBucketCorsRequest, opts ...grpc.CallOption) (*LookupBucketCorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupBucketCorsResponse)
+	err := c.cc.Invoke(ctx, GantryService_LookupBucketCors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) PutObjectTagging(ctx context.Context, in *PutObjectTaggingRequest, opts ...grpc.CallOption) (*PutObjectTaggingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutObjectTaggingResponse)
+	err := c.cc.Invoke(ctx, GantryService_PutObjectTagging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) GetObjectTagging(ctx context.Context, in *GetObjectTaggingRequest, opts ...grpc.CallOption) (*GetObjectTaggingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObjectTaggingResponse)
+	err := c.cc.Invoke(ctx, GantryService_GetObjectTagging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) DeleteObjectTagging(ctx context.Context, in *DeleteObjectTaggingRequest, opts ...grpc.CallOption) (*DeleteObjectTaggingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteObjectTaggingResponse)
+	err := c.cc.Invoke(ctx, GantryService_DeleteObjectTagging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) DeleteObjects(ctx context.Context, in *DeleteObjectsRequest, opts ...grpc.CallOption) (*DeleteObjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteObjectsResponse)
+	err := c.cc.Invoke(ctx, GantryService_DeleteObjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) PutObjectLockConfiguration(ctx context.Context, in *PutObjectLockConfigurationRequest, opts ...grpc.CallOption) (*PutObjectLockConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutObjectLockConfigurationResponse)
+	err := c.cc.Invoke(ctx, GantryService_PutObjectLockConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) GetObjectLockConfiguration(ctx context.Context, in *GetObjectLockConfigurationRequest, opts ...grpc.CallOption) (*GetObjectLockConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObjectLockConfigurationResponse)
+	err := c.cc.Invoke(ctx, GantryService_GetObjectLockConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) PutObjectRetention(ctx context.Context, in *PutObjectRetentionRequest, opts ...grpc.CallOption) (*PutObjectRetentionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutObjectRetentionResponse)
+	err := c.cc.Invoke(ctx, GantryService_PutObjectRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) GetObjectRetention(ctx context.Context, in *GetObjectRetentionRequest, opts ...grpc.CallOption) (*GetObjectRetentionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObjectRetentionResponse)
+	err := c.cc.Invoke(ctx, GantryService_GetObjectRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) PutObjectLegalHold(ctx context.Context, in *PutObjectLegalHoldRequest, opts ...grpc.CallOption) (*PutObjectLegalHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutObjectLegalHoldResponse)
+	err := c.cc.Invoke(ctx, GantryService_PutObjectLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gantryServiceClient) GetObjectLegalHold(ctx context.Context, in *GetObjectLegalHoldRequest, opts ...grpc.CallOption) (*GetObjectLegalHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObjectLegalHoldResponse)
+	err := c.cc.Invoke(ctx, GantryService_GetObjectLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GantryServiceServer is the server API for GantryService service.
+// All implementations must embed UnimplementedGantryServiceServer
+// for forward compatibility.
+type GantryServiceServer interface {
+	CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error)
+	ListBuckets(context.Context, *ListBucketsRequest) (*ListBucketsResponse, error)
+	GetBucket(context.Context, *GetBucketRequest) (*GetBucketResponse, error)
+	DeleteBucket(context.Context, *DeleteBucketRequest) (*DeleteBucketResponse, error)
+	PlanWrite(context.Context, *PlanWriteRequest) (*PlanWriteResponse, error)
+	CommitObject(context.Context, *CommitObjectRequest) (*CommitObjectResponse, error)
+	LookupObject(context.Context, *LookupObjectRequest) (*LookupObjectResponse, error)
+	DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteObjectResponse, error)
+	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
+	CreateMultipartUpload(context.Context, *CreateMultipartUploadRequest) (*CreateMultipartUploadResponse, error)
+	PlanPart(context.Context, *PlanPartRequest) (*PlanPartResponse, error)
+	CommitPart(context.Context, *CommitPartRequest) (*CommitPartResponse, error)
+	CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*CompleteMultipartUploadResponse, error)
+	AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadResponse, error)
+	ListMultipartUploads(context.Context, *ListMultipartUploadsRequest) (*ListMultipartUploadsResponse, error)
+	ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error)
+	GetAccessKey(context.Context, *GetAccessKeyRequest) (*GetAccessKeyResponse, error)
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	CreateAccessKey(context.Context, *CreateAccessKeyRequest) (*CreateAccessKeyResponse, error)
+	ListAccessKeys(context.Context, *ListAccessKeysRequest) (*ListAccessKeysResponse, error)
+	UpdateAccessKey(context.Context, *UpdateAccessKeyRequest) (*UpdateAccessKeyResponse, error)
+	RotateAccessKey(context.Context, *RotateAccessKeyRequest) (*RotateAccessKeyResponse, error)
+	PutBucketPolicy(context.Context, *PutBucketPolicyRequest) (*PutBucketPolicyResponse, error)
+	GetBucketPolicy(context.Context, *GetBucketPolicyRequest) (*GetBucketPolicyResponse, error)
+	DeleteBucketPolicy(context.Context, *DeleteBucketPolicyRequest) (*DeleteBucketPolicyResponse, error)
+	PutBucketAcl(context.Context, *PutBucketAclRequest) (*PutBucketAclResponse, error)
+	GetBucketAcl(context.Context, *GetBucketAclRequest) (*GetBucketAclResponse, error)
+	PutBucketVersioning(context.Context, *PutBucketVersioningRequest) (*PutBucketVersioningResponse, error)
+	GetBucketVersioning(context.Context, *GetBucketVersioningRequest) (*GetBucketVersioningResponse, error)
+	ListObjectVersions(context.Context, *ListObjectVersionsRequest) (*ListObjectVersionsResponse, error)
+	PutBucketLifecycleConfiguration(context.Context, *PutBucketLifecycleConfigurationRequest) (*PutBucketLifecycleConfigurationResponse, error)
+	GetBucketLifecycleConfiguration(context.Context, *GetBucketLifecycleConfigurationRequest) (*GetBucketLifecycleConfigurationResponse, error)
+	DeleteBucketLifecycle(context.Context, *DeleteBucketLifecycleRequest) (*DeleteBucketLifecycleResponse, error)
+	PutBucketCors(context.Context, *PutBucketCorsRequest) (*PutBucketCorsResponse, error)
+	GetBucketCors(context.Context, *GetBucketCorsRequest) (*GetBucketCorsResponse, error)
+	DeleteBucketCors(context.Context, *DeleteBucketCorsRequest) (*DeleteBucketCorsResponse, error)
+	LookupBucketCors(context.Context, *LookupBucketCorsRequest) (*LookupBucketCorsResponse, error)
+	PutObjectTagging(context.Context, *PutObjectTaggingRequest) (*PutObjectTaggingResponse, error)
+	GetObjectTagging(context.Context, *GetObjectTaggingRequest) (*GetObjectTaggingResponse, error)
+	DeleteObjectTagging(context.Context, *DeleteObjectTaggingRequest) (*DeleteObjectTaggingResponse, error)
+	DeleteObjects(context.Context, *DeleteObjectsRequest) (*DeleteObjectsResponse, error)
+	PutObjectLockConfiguration(context.Context, *PutObjectLockConfigurationRequest) (*PutObjectLockConfigurationResponse, error)
+	GetObjectLockConfiguration(context.Context, *GetObjectLockConfigurationRequest) (*GetObjectLockConfigurationResponse, error)
+	PutObjectRetention(context.Context, *PutObjectRetentionRequest) (*PutObjectRetentionResponse, error)
+	GetObjectRetention(context.Context, *GetObjectRetentionRequest) (*GetObjectRetentionResponse, error)
+	PutObjectLegalHold(context.Context, *PutObjectLegalHoldRequest) (*PutObjectLegalHoldResponse, error)
+	GetObjectLegalHold(context.Context, *GetObjectLegalHoldRequest) (*GetObjectLegalHoldResponse, error)
+	mustEmbedUnimplementedGantryServiceServer()
+}
+
+// UnimplementedGantryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGantryServiceServer struct{}
+
+func (UnimplementedGantryServiceServer) CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBucket not implemented")
+}
+func (UnimplementedGantryServiceServer) ListBuckets(context.Context, *ListBucketsRequest) (*ListBucketsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBuckets not implemented")
+}
+func (UnimplementedGantryServiceServer) GetBucket(context.Context, *GetBucketRequest) (*GetBucketResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBucket not implemented")
+}
+func (UnimplementedGantryServiceServer) DeleteBucket(context.Context, *DeleteBucketRequest) (*DeleteBucketResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBucket not implemented")
+}
+func (UnimplementedGantryServiceServer) PlanWrite(context.Context, *PlanWriteRequest) (*PlanWriteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlanWrite not implemented")
+}
+func (UnimplementedGantryServiceServer) CommitObject(context.Context, *CommitObjectRequest) (*CommitObjectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CommitObject not implemented")
+}
+func (UnimplementedGantryServiceServer) LookupObject(context.Context, *LookupObjectRequest) (*LookupObjectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LookupObject not implemented")
+}
+func (UnimplementedGantryServiceServer) DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteObjectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteObject not implemented")
+}
+func (UnimplementedGantryServiceServer) ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListObjects not implemented")
+}
+func (UnimplementedGantryServiceServer) CreateMultipartUpload(context.Context, *CreateMultipartUploadRequest) (*CreateMultipartUploadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMultipartUpload not implemented")
+}
+func (UnimplementedGantryServiceServer) PlanPart(context.Context, *PlanPartRequest) (*PlanPartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlanPart not implemented")
+}
+func (UnimplementedGantryServiceServer) CommitPart(context.Context, *CommitPartRequest) (*CommitPartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CommitPart not implemented")
+}
+func (UnimplementedGantryServiceServer) CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*CompleteMultipartUploadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteMultipartUpload not implemented")
+}
+func (UnimplementedGantryServiceServer) AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AbortMultipartUpload not implemented")
+}
+func (UnimplementedGantryServiceServer) ListMultipartUploads(context.Context, *ListMultipartUploadsRequest) (*ListMultipartUploadsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMultipartUploads not implemented")
+}
+func (UnimplementedGantryServiceServer) ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListParts not implemented")
+}
+func (UnimplementedGantryServiceServer) GetAccessKey(context.Context, *GetAccessKeyRequest) (*GetAccessKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccessKey not implemented")
+}
+func (UnimplementedGantryServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedGantryServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedGantryServiceServer) CreateAccessKey(context.Context, *CreateAccessKeyRequest) (*CreateAccessKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAccessKey not implemented")
+}
+func (UnimplementedGantryServiceServer) ListAccessKeys(context.Context, *ListAccessKeysRequest) (*ListAccessKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAccessKeys not implemented")
+}
+func (UnimplementedGantryServiceServer) UpdateAccessKey(context.Context, *UpdateAccessKeyRequest) (*UpdateAccessKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAccessKey not implemented")
+}
+func (UnimplementedGantryServiceServer) RotateAccessKey(context.Context, *RotateAccessKeyRequest) (*RotateAccessKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateAccessKey not implemented")
+}
+func (UnimplementedGantryServiceServer) PutBucketPolicy(context.Context, *PutBucketPolicyRequest) (*PutBucketPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PutBucketPolicy not implemented")
+}
+func (UnimplementedGantryServiceServer) GetBucketPolicy(context.Context, *GetBucketPolicyRequest) (*GetBucketPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBucketPolicy not implemented")
+}
+func (UnimplementedGantryServiceServer) DeleteBucketPolicy(context.Context, *DeleteBucketPolicyRequest) (*DeleteBucketPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBucketPolicy not implemented")
+}
+func (UnimplementedGantryServiceServer) PutBucketAcl(context.Context, *PutBucketAclRequest) (*PutBucketAclResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PutBucketAcl not implemented")
+}
+func (UnimplementedGantryServiceServer) GetBucketAcl(context.Context, *GetBucketAclRequest) (*GetBucketAclResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBucketAcl not implemented")
+}
+func (UnimplementedGantryServiceServer) PutBucketVersioning(context.Context, *PutBucketVersioningRequest) (*PutBucketVersioningResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PutBucketVersioning not implemented")
+}
+func (UnimplementedGantryServiceServer) GetBucketVersioning(context.Context, *GetBucketVersioningRequest) (*GetBucketVersioningResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBucketVersioning not implemented")
+}
+func (UnimplementedGantryServiceServer) ListObjectVersions(context.Context, *ListObjectVersionsRequest) (*ListObjectVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListObjectVersions not implemented")
+}
+func (UnimplementedGantryServiceServer) PutBucketLifecycleConfiguration(context.Context, *PutBucketLifecycleConfigurationRequest) (*PutBucketLifecycleConfigurationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PutBucketLifecycleConfiguration not implemented")
+}
+func (UnimplementedGantryServiceServer) GetBucketLifecycleConfiguration(context.Context, *GetBucketLifecycleConfigurationRequest) (*GetBucketLifecycleConfigurationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBucketLifecycleConfiguration not implemented")
+}
+func (UnimplementedGantryServiceServer) DeleteBucketLifecycle(context.Context, *DeleteBucketLifecycleRequest) (*DeleteBucketLifecycleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBucketLifecycle not implemented")
+}
+func (UnimplementedGantryServiceServer) PutBucketCors(context.Context, *PutBucketCorsRequest) (*PutBucketCorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PutBucketCors not implemented")
+}
+func (UnimplementedGantryServiceServer) GetBucketCors(context.Context, *GetBucketCorsRequest) (*GetBucketCorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBucketCors not implemented")
+}
+func (UnimplementedGantryServiceServer) DeleteBucketCors(context.Context, *DeleteBucketCorsRequest) (*DeleteBucketCorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBucketCors not implemented")
+}
+func (UnimplementedGantryServiceServer) LookupBucketCors(context.Context, *LookupBucketCorsRequest) (*LookupBucketCorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LookupBucketCors not implemented")
+}
+func (UnimplementedGantryServiceServer) PutObjectTagging(context.Context, *PutObjectTaggingRequest) (*PutObjectTaggingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PutObjectTagging not implemented")
+}
+func (UnimplementedGantryServiceServer) GetObjectTagging(context.Context, *GetObjectTaggingRequest) (*GetObjectTaggingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetObjectTagging not implemented")
+}
+func (UnimplementedGantryServiceServer) DeleteObjectTagging(context.Context, *DeleteObjectTaggingRequest) (*DeleteObjectTaggingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteObjectTagging not implemented")
+}
+func (UnimplementedGantryServiceServer) DeleteObjects(context.Context, *DeleteObjectsRequest) (*DeleteObjectsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteObjects not implemented")
+}
+func (UnimplementedGantryServiceServer) PutObjectLockConfiguration(context.Context, *PutObjectLockConfigurationRequest) (*PutObjectLockConfigurationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PutObjectLockConfiguration not implemented")
+}
+func (UnimplementedGantryServiceServer) GetObjectLockConfiguration(context.Context, *GetObjectLockConfigurationRequest) (*GetObjectLockConfigurationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetObjectLockConfiguration not implemented")
+}
+func (UnimplementedGantryServiceServer) PutObjectRetention(context.Context, *PutObjectRetentionRequest) (*PutObjectRetentionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PutObjectRetention not implemented")
+}
+func (UnimplementedGantryServiceServer) GetObjectRetention(context.Context, *GetObjectRetentionRequest) (*GetObjectRetentionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetObjectRetention not implemented")
+}
+func (UnimplementedGantryServiceServer) PutObjectLegalHold(context.Context, *PutObjectLegalHoldRequest) (*PutObjectLegalHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PutObjectLegalHold not implemented")
+}
+func (UnimplementedGantryServiceServer) GetObjectLegalHold(context.Context, *GetObjectLegalHoldRequest) (*GetObjectLegalHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetObjectLegalHold not implemented")
+}
+func (UnimplementedGantryServiceServer) mustEmbedUnimplementedGantryServiceServer() {}
+func (UnimplementedGantryServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeGantryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GantryServiceServer will
+// result in compilation errors.
+type UnsafeGantryServiceServer interface {
+	mustEmbedUnimplementedGantryServiceServer()
+}
+
+func RegisterGantryServiceServer(s grpc.ServiceRegistrar, srv GantryServiceServer) {
+	// If the following call panics, it indicates UnimplementedGantryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GantryService_ServiceDesc, srv)
+}
+
+func _GantryService_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).CreateBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_CreateBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).CreateBucket(ctx, req.(*CreateBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_ListBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).ListBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_ListBuckets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).ListBuckets(ctx, req.(*ListBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_GetBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).GetBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_GetBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).GetBucket(ctx, req.(*GetBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_DeleteBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).DeleteBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_DeleteBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).DeleteBucket(ctx, req.(*DeleteBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_PlanWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).PlanWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_PlanWrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).PlanWrite(ctx, req.(*PlanWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_CommitObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).CommitObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_CommitObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).CommitObject(ctx, req.(*CommitObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_LookupObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).LookupObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_LookupObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).LookupObject(ctx, req.(*LookupObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).DeleteObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_DeleteObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).DeleteObject(ctx, req.(*DeleteObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).ListObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_ListObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).ListObjects(ctx, req.(*ListObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_CreateMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).CreateMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_CreateMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).CreateMultipartUpload(ctx, req.(*CreateMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_PlanPart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanPartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).PlanPart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_PlanPart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).PlanPart(ctx, req.(*PlanPartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_CommitPart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitPartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).CommitPart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_CommitPart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).CommitPart(ctx, req.(*CommitPartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_CompleteMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).CompleteMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_CompleteMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).CompleteMultipartUpload(ctx, req.(*CompleteMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_AbortMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).AbortMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_AbortMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).AbortMultipartUpload(ctx, req.(*AbortMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_ListMultipartUploads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMultipartUploadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).ListMultipartUploads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_ListMultipartUploads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).ListMultipartUploads(ctx, req.(*ListMultipartUploadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_ListParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).ListParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_ListParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).ListParts(ctx, req.(*ListPartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_GetAccessKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).GetAccessKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_GetAccessKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).GetAccessKey(ctx, req.(*GetAccessKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_CreateAccessKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).CreateAccessKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_CreateAccessKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).CreateAccessKey(ctx, req.(*CreateAccessKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_ListAccessKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).ListAccessKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_ListAccessKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).ListAccessKeys(ctx, req.(*ListAccessKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_UpdateAccessKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccessKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).UpdateAccessKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_UpdateAccessKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).UpdateAccessKey(ctx, req.(*UpdateAccessKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_RotateAccessKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAccessKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).RotateAccessKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_RotateAccessKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).RotateAccessKey(ctx, req.(*RotateAccessKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_PutBucketPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutBucketPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).PutBucketPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_PutBucketPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).PutBucketPolicy(ctx, req.(*PutBucketPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_GetBucketPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).GetBucketPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_GetBucketPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).GetBucketPolicy(ctx, req.(*GetBucketPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_DeleteBucketPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBucketPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).DeleteBucketPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_DeleteBucketPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).DeleteBucketPolicy(ctx, req.(*DeleteBucketPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_PutBucketAcl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutBucketAclRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).PutBucketAcl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_PutBucketAcl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).PutBucketAcl(ctx, req.(*PutBucketAclRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_GetBucketAcl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketAclRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).GetBucketAcl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_GetBucketAcl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).GetBucketAcl(ctx, req.(*GetBucketAclRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_PutBucketVersioning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutBucketVersioningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).PutBucketVersioning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_PutBucketVersioning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).PutBucketVersioning(ctx, req.(*PutBucketVersioningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_GetBucketVersioning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketVersioningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).GetBucketVersioning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_GetBucketVersioning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).GetBucketVersioning(ctx, req.(*GetBucketVersioningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_ListObjectVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListObjectVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).ListObjectVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_ListObjectVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).ListObjectVersions(ctx, req.(*ListObjectVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_PutBucketLifecycleConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutBucketLifecycleConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).PutBucketLifecycleConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_PutBucketLifecycleConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).PutBucketLifecycleConfiguration(ctx, req.(*PutBucketLifecycleConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_GetBucketLifecycleConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketLifecycleConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).GetBucketLifecycleConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_GetBucketLifecycleConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).GetBucketLifecycleConfiguration(ctx, req.(*GetBucketLifecycleConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_DeleteBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBucketLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).DeleteBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_DeleteBucketLifecycle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).DeleteBucketLifecycle(ctx, req.(*DeleteBucketLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GantryService_PutBucketCors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutBucketCorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).PutBucketCors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_PutBucketCors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).PutBucketCors(ctx, req.(*PutBucketCorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *gantryServiceClient) CommitObject(ctx context.Context, in *CommitObjectRequest, opts ...grpc.CallOption) (*CommitObjectResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CommitObjectResponse)
-	err := c.cc.Invoke(ctx, GantryService_CommitObject_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _GantryService_GetBucketCors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketCorsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GantryServiceServer).GetBucketCors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_GetBucketCors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).GetBucketCors(ctx, req.(*GetBucketCorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// GantryServiceServer is the server API for GantryService service.
-// All implementations must embed UnimplementedGantryServiceServer
-// for forward compatibility.
-type GantryServiceServer interface {
-	CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error)
-	ListBuckets(context.Context, *ListBucketsRequest) (*ListBucketsResponse, error)
-	PlanWrite(context.Context, *PlanWriteRequest) (*PlanWriteResponse, error)
-	CommitObject(context.Context, *CommitObjectRequest) (*CommitObjectResponse, error)
-	mustEmbedUnimplementedGantryServiceServer()
+func _GantryService_DeleteBucketCors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBucketCorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).DeleteBucketCors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_DeleteBucketCors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).DeleteBucketCors(ctx, req.(*DeleteBucketCorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedGantryServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedGantryServiceServer struct{}
+func _GantryService_LookupBucketCors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupBucketCorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).LookupBucketCors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_LookupBucketCors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).LookupBucketCors(ctx, req.(*LookupBucketCorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedGantryServiceServer) CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateBucket not implemented")
+func _GantryService_PutObjectTagging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutObjectTaggingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).PutObjectTagging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_PutObjectTagging_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).PutObjectTagging(ctx, req.(*PutObjectTaggingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGantryServiceServer) ListBuckets(context.Context, *ListBucketsRequest) (*ListBucketsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListBuckets not implemented")
+
+func _GantryService_GetObjectTagging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectTaggingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).GetObjectTagging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_GetObjectTagging_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).GetObjectTagging(ctx, req.(*GetObjectTaggingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGantryServiceServer) PlanWrite(context.Context, *PlanWriteRequest) (*PlanWriteResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method PlanWrite not implemented")
+
+func _GantryService_DeleteObjectTagging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectTaggingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).DeleteObjectTagging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_DeleteObjectTagging_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).DeleteObjectTagging(ctx, req.(*DeleteObjectTaggingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGantryServiceServer) CommitObject(context.Context, *CommitObjectRequest) (*CommitObjectResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CommitObject not implemented")
+
+func _GantryService_DeleteObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).DeleteObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_DeleteObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).DeleteObjects(ctx, req.(*DeleteObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGantryServiceServer) mustEmbedUnimplementedGantryServiceServer() {}
-func (UnimplementedGantryServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeGantryServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GantryServiceServer will
-// result in compilation errors.
-type UnsafeGantryServiceServer interface {
-	mustEmbedUnimplementedGantryServiceServer()
+func _GantryService_PutObjectLockConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutObjectLockConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).PutObjectLockConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_PutObjectLockConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).PutObjectLockConfiguration(ctx, req.(*PutObjectLockConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGantryServiceServer(s grpc.ServiceRegistrar, srv GantryServiceServer) {
-	// If the following call panics, it indicates UnimplementedGantryServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _GantryService_GetObjectLockConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectLockConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&GantryService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(GantryServiceServer).GetObjectLockConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GantryService_GetObjectLockConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).GetObjectLockConfiguration(ctx, req.(*GetObjectLockConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GantryService_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBucketRequest)
+func _GantryService_PutObjectRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutObjectRetentionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GantryServiceServer).CreateBucket(ctx, in)
+		return srv.(GantryServiceServer).PutObjectRetention(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GantryService_CreateBucket_FullMethodName,
+		FullMethod: GantryService_PutObjectRetention_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GantryServiceServer).CreateBucket(ctx, req.(*CreateBucketRequest))
+		return srv.(GantryServiceServer).PutObjectRetention(ctx, req.(*PutObjectRetentionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GantryService_ListBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBucketsRequest)
+func _GantryService_GetObjectRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectRetentionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GantryServiceServer).ListBuckets(ctx, in)
+		return srv.(GantryServiceServer).GetObjectRetention(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GantryService_ListBuckets_FullMethodName,
+		FullMethod: GantryService_GetObjectRetention_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GantryServiceServer).ListBuckets(ctx, req.(*ListBucketsRequest))
+		return srv.(GantryServiceServer).GetObjectRetention(ctx, req.(*GetObjectRetentionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GantryService_PlanWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PlanWriteRequest)
+func _GantryService_PutObjectLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutObjectLegalHoldRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GantryServiceServer).PlanWrite(ctx, in)
+		return srv.(GantryServiceServer).PutObjectLegalHold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GantryService_PlanWrite_FullMethodName,
+		FullMethod: GantryService_PutObjectLegalHold_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GantryServiceServer).PlanWrite(ctx, req.(*PlanWriteRequest))
+		return srv.(GantryServiceServer).PutObjectLegalHold(ctx, req.(*PutObjectLegalHoldRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GantryService_CommitObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommitObjectRequest)
+func _GantryService_GetObjectLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectLegalHoldRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GantryServiceServer).CommitObject(ctx, in)
+		return srv.(GantryServiceServer).GetObjectLegalHold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GantryService_CommitObject_FullMethodName,
+		FullMethod: GantryService_GetObjectLegalHold_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GantryServiceServer).CommitObject(ctx, req.(*CommitObjectRequest))
+		return srv.(GantryServiceServer).GetObjectLegalHold(ctx, req.(*GetObjectLegalHoldRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -221,6 +1717,14 @@ var GantryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBuckets",
 			Handler:    _GantryService_ListBuckets_Handler,
 		},
+		{
+			MethodName: "GetBucket",
+			Handler:    _GantryService_GetBucket_Handler,
+		},
+		{
+			MethodName: "DeleteBucket",
+			Handler:    _GantryService_DeleteBucket_Handler,
+		},
 		{
 			MethodName: "PlanWrite",
 			Handler:    _GantryService_PlanWrite_Handler,
@@ -229,6 +1733,174 @@ var GantryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommitObject",
 			Handler:    _GantryService_CommitObject_Handler,
 		},
+		{
+			MethodName: "LookupObject",
+			Handler:    _GantryService_LookupObject_Handler,
+		},
+		{
+			MethodName: "DeleteObject",
+			Handler:    _GantryService_DeleteObject_Handler,
+		},
+		{
+			MethodName: "ListObjects",
+			Handler:    _GantryService_ListObjects_Handler,
+		},
+		{
+			MethodName: "CreateMultipartUpload",
+			Handler:    _GantryService_CreateMultipartUpload_Handler,
+		},
+		{
+			MethodName: "PlanPart",
+			Handler:    _GantryService_PlanPart_Handler,
+		},
+		{
+			MethodName: "CommitPart",
+			Handler:    _GantryService_CommitPart_Handler,
+		},
+		{
+			MethodName: "CompleteMultipartUpload",
+			Handler:    _GantryService_CompleteMultipartUpload_Handler,
+		},
+		{
+			MethodName: "AbortMultipartUpload",
+			Handler:    _GantryService_AbortMultipartUpload_Handler,
+		},
+		{
+			MethodName: "ListMultipartUploads",
+			Handler:    _GantryService_ListMultipartUploads_Handler,
+		},
+		{
+			MethodName: "ListParts",
+			Handler:    _GantryService_ListParts_Handler,
+		},
+		{
+			MethodName: "GetAccessKey",
+			Handler:    _GantryService_GetAccessKey_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _GantryService_CreateUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _GantryService_ListUsers_Handler,
+		},
+		{
+			MethodName: "CreateAccessKey",
+			Handler:    _GantryService_CreateAccessKey_Handler,
+		},
+		{
+			MethodName: "ListAccessKeys",
+			Handler:    _GantryService_ListAccessKeys_Handler,
+		},
+		{
+			MethodName: "UpdateAccessKey",
+			Handler:    _GantryService_UpdateAccessKey_Handler,
+		},
+		{
+			MethodName: "RotateAccessKey",
+			Handler:    _GantryService_RotateAccessKey_Handler,
+		},
+		{
+			MethodName: "PutBucketPolicy",
+			Handler:    _GantryService_PutBucketPolicy_Handler,
+		},
+		{
+			MethodName: "GetBucketPolicy",
+			Handler:    _GantryService_GetBucketPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteBucketPolicy",
+			Handler:    _GantryService_DeleteBucketPolicy_Handler,
+		},
+		{
+			MethodName: "PutBucketAcl",
+			Handler:    _GantryService_PutBucketAcl_Handler,
+		},
+		{
+			MethodName: "GetBucketAcl",
+			Handler:    _GantryService_GetBucketAcl_Handler,
+		},
+		{
+			MethodName: "PutBucketVersioning",
+			Handler:    _GantryService_PutBucketVersioning_Handler,
+		},
+		{
+			MethodName: "GetBucketVersioning",
+			Handler:    _GantryService_GetBucketVersioning_Handler,
+		},
+		{
+			MethodName: "ListObjectVersions",
+			Handler:    _GantryService_ListObjectVersions_Handler,
+		},
+		{
+			MethodName: "PutBucketLifecycleConfiguration",
+			Handler:    _GantryService_PutBucketLifecycleConfiguration_Handler,
+		},
+		{
+			MethodName: "GetBucketLifecycleConfiguration",
+			Handler:    _GantryService_GetBucketLifecycleConfiguration_Handler,
+		},
+		{
+			MethodName: "DeleteBucketLifecycle",
+			Handler:    _GantryService_DeleteBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "PutBucketCors",
+			Handler:    _GantryService_PutBucketCors_Handler,
+		},
+		{
+			MethodName: "GetBucketCors",
+			Handler:    _GantryService_GetBucketCors_Handler,
+		},
+		{
+			MethodName: "DeleteBucketCors",
+			Handler:    _GantryService_DeleteBucketCors_Handler,
+		},
+		{
+			MethodName: "LookupBucketCors",
+			Handler:    _GantryService_LookupBucketCors_Handler,
+		},
+		{
+			MethodName: "PutObjectTagging",
+			Handler:    _GantryService_PutObjectTagging_Handler,
+		},
+		{
+			MethodName: "GetObjectTagging",
+			Handler:    _GantryService_GetObjectTagging_Handler,
+		},
+		{
+			MethodName: "DeleteObjectTagging",
+			Handler:    _GantryService_DeleteObjectTagging_Handler,
+		},
+		{
+			MethodName: "DeleteObjects",
+			Handler:    _GantryService_DeleteObjects_Handler,
+		},
+		{
+			MethodName: "PutObjectLockConfiguration",
+			Handler:    _GantryService_PutObjectLockConfiguration_Handler,
+		},
+		{
+			MethodName: "GetObjectLockConfiguration",
+			Handler:    _GantryService_GetObjectLockConfiguration_Handler,
+		},
+		{
+			MethodName: "PutObjectRetention",
+			Handler:    _GantryService_PutObjectRetention_Handler,
+		},
+		{
+			MethodName: "GetObjectRetention",
+			Handler:    _GantryService_GetObjectRetention_Handler,
+		},
+		{
+			MethodName: "PutObjectLegalHold",
+			Handler:    _GantryService_PutObjectLegalHold_Handler,
+		},
+		{
+			MethodName: "GetObjectLegalHold",
+			Handler:    _GantryService_GetObjectLegalHold_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gantry/service/v1/service.proto",